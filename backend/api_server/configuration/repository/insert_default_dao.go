@@ -102,6 +102,54 @@ func (dao *ConfigInitDAO) insertSystemConfigs(ctx context.Context) {
 			SetConfigKey("PATH_STATIC_TEST").SetConfigVal("/kaier/workspace/static"),
 		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
 			SetConfigKey("KAIS_PATH").SetConfigVal("/kaier"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("MULTI_NODE_MASTER_PORT").SetConfigVal("29500"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("DEFAULT_EXECUTOR").SetConfigVal(utils.EXECUTOR_REST),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("SCHEDULER_SWEEP_INTERVAL").SetConfigVal("60"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("SCHEDULER_STEP_POLL_INTERVAL").SetConfigVal("10"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("SLURM_SCRIPT_PATH").SetConfigVal("/kaier/slurm/kais_engine.sh"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("DISPATCH_MAX_ATTEMPTS").SetConfigVal("5"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("DISPATCH_BACKOFF_BASE").SetConfigVal("10"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("DISPATCH_BACKOFF_MAX").SetConfigVal("600"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("WATCHDOG_TIMEOUT").SetConfigVal("1800"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("PREEMPTION_MIN_PRIORITY").SetConfigVal("100"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("PREEMPTION_REQUEUE_WINDOW").SetConfigVal("60"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("DATASET_SETTLE_PERIOD").SetConfigVal("300"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("INFERENCE_ENGINE_ADDR").SetConfigVal("http://localhost:5000"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("EARLY_STOP_INTERVAL").SetConfigVal("30"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("DEVICE_HEALTH_INTERVAL").SetConfigVal("30"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("DEVICE_HEALTH_TIMEOUT").SetConfigVal("5"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("DEVICE_HEALTH_FAILURES").SetConfigVal("2"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("TELEMETRY_RETENTION_MINUTE").SetConfigVal("24"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("TELEMETRY_RETENTION_HOUR").SetConfigVal("30"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("DEVICE_HEARTBEAT_INTERVAL").SetConfigVal("30"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("DEVICE_HEARTBEAT_TIMEOUT").SetConfigVal("90"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("DEVICE_MAINTENANCE_INTERVAL").SetConfigVal("60"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("RESERVATION_DEFAULT_DURATION").SetConfigVal("3600"),
+		dao.dbms.Configuration.Create().SetConfigType(utils.CONFIG_TYPE_SYSTEM).
+			SetConfigKey("ENGINE_MIN_VERSION").SetConfigVal(""),
 	).
 		OnConflict(
 			sql.ConflictColumns(configuration.FieldConfigType, configuration.FieldConfigKey),
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 
 	repo "api_server/configuration/repository"
@@ -13,6 +14,7 @@ import (
 type IConfigService interface {
 	IsIgnoreLicense() bool
 	Get(key string) string
+	GetInt(key string, def int) int
 	Read(group int) ([]*repo.ConfigDTO, *logger.Report)
 	EditMany(configs []repo.ConfigDTO, group int) ([]*repo.ConfigDTO, *logger.Report)
 	EditOne(req repo.ConfigDTO) (*repo.ConfigDTO, *logger.Report)
@@ -69,6 +71,15 @@ func (svc *ConfigService) Get(key string) string {
 	}
 }
 
+// GetInt는 설정값을 정수로 읽습니다. 설정이 없거나 정수가 아니면 def를 반환합니다.
+func (svc *ConfigService) GetInt(key string, def int) int {
+	if v, err := strconv.Atoi(svc.Get(key)); err == nil {
+		return v
+	}
+
+	return def
+}
+
 func (svc *ConfigService) Read(group int) ([]*repo.ConfigDTO, *logger.Report) {
 	fmt.Printf("{'group': %d}\n", group)
 	configs, err := svc.dao.SelectAllByType(svc.ctx, utils.CONFIG_TYPE_USER)
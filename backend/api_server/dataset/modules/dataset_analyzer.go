@@ -1399,7 +1399,7 @@ func (da *DatasetAnalyzer) computeCategoricalHeatmapOnEngine(path string, datase
 		return nil
 	}
 
-	reqClient := NewDatasetRequestClient("/api/dataset/categorical_heatmap", datasetId)
+	reqClient := NewDatasetRequestClient("categorical_heatmap", datasetId)
 
 	type CatHeatReqBody struct {
 		StaticPath          string   `json:"static_path"`
@@ -1436,7 +1436,7 @@ func (da *DatasetAnalyzer) computeCategoricalNumericalHeatmapOnEngine(path strin
 		return nil
 	}
 
-	reqClient := NewDatasetRequestClient("/api/dataset/categorical_numerical_heatmap", datasetId)
+	reqClient := NewDatasetRequestClient("categorical_numerical_heatmap", datasetId)
 
 	type CatNumHeatReqBody struct {
 		StaticPath          string   `json:"static_path"`
@@ -1474,7 +1474,7 @@ func (da *DatasetAnalyzer) analyzeCategoricalFeatureOnEngine(path string, datase
 		return nil
 	}
 
-	reqClient := NewDatasetRequestClient("/api/dataset/categorical_feature", datasetId)
+	reqClient := NewDatasetRequestClient("categorical_feature", datasetId)
 
 	type CatFeatReqBody struct {
 		StaticPath          string   `json:"static_path"`
@@ -1511,7 +1511,7 @@ func (da *DatasetAnalyzer) analyzeNumericalFeatureOnEngine(path string, datasetI
 		return nil
 	}
 
-	reqClient := NewDatasetRequestClient("/api/dataset/numerical_feature", datasetId)
+	reqClient := NewDatasetRequestClient("numerical_feature", datasetId)
 
 	type NumFeatReqBody struct {
 		StaticPath        string   `json:"static_path"`
@@ -1545,7 +1545,7 @@ func (da *DatasetAnalyzer) computeNumericalHeatmapOnEngine(path string, datasetI
 		return nil
 	}
 
-	reqClient := NewDatasetRequestClient("/api/dataset/numerical_heatmap", datasetId)
+	reqClient := NewDatasetRequestClient("numerical_heatmap", datasetId)
 
 	type NumHeatReqBody struct {
 		StaticPath        string   `json:"static_path"`
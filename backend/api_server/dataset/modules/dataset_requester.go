@@ -1,44 +1,36 @@
 package modules
 
 import (
-	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"path/filepath"
 
 	config_service "api_server/configuration/service"
+	device_service "api_server/device/service"
+	engine_client "api_server/engine/client"
 )
 
 type DatasetResBody struct {
 	FilePath string `json:"file_path"`
 }
 type DatasetRequestClient struct {
-	EnginePath string
+	Analysis   string
 	StaticPath string
+	engine     *engine_client.Client
 }
 
-func NewDatasetRequestClient(endpoint string, datasetId int) *DatasetRequestClient {
+// NewDatasetRequestClient는 engine의 POST /api/dataset/{analysis} 분석 요청을 보내는 client를 만듭니다.
+// 분석 API는 REST engine에만 있으므로 REST device의 engine으로 보냅니다.
+func NewDatasetRequestClient(analysis string, datasetId int) *DatasetRequestClient {
 	cf := config_service.NewStatic()
 	return &DatasetRequestClient{
-		EnginePath: "http://localhost:5000" + endpoint,
+		Analysis:   analysis,
 		StaticPath: filepath.Join(cf.Get("PATH_STATIC_TEST"), "dataset"+fmt.Sprint(datasetId)),
+		engine:     device_service.RESTEngine(),
 	}
 }
 
 func (drc *DatasetRequestClient) SendPostRequest(jsonData []byte) ([]byte, error) {
-	client := &http.Client{}
-	req, err := http.NewRequest("POST", drc.EnginePath, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	return io.ReadAll(resp.Body)
+	return drc.engine.Dataset(context.Background(), drc.Analysis, json.RawMessage(jsonData))
 }
@@ -72,6 +72,31 @@ func (w *DatasetWatcher) DetectDatasetModification() {
 
 			w.datasetValidator.Validate(datasetRoot.ID)
 			w.datasetAnalyzer.Analyze(datasetRoot.ID)
+			w.detectFileChanges(datasetRoot.ID)
+		}
+	}
+}
+
+// detectFileChanges compares the file fingerprint of each leaf dataset with the stored one
+// and publishes EVENT_DATASET_CHANGED when files were added, removed or modified.
+// The first fingerprint of a dataset is only stored.
+// Dataset schedules compare the stored fingerprint and its change time on their own tick.
+func (w *DatasetWatcher) detectFileChanges(dr_id int) {
+	for _, ds := range w.datasetDAO.SelectLeafDatasetsByDRID(w.ctx, dr_id) {
+		fingerprint, err := utils.DirFingerprint(ds.Path)
+		if err != nil {
+			logger.Error("Fingerprint dataset : ", err.Error())
+			continue
+		}
+		if fingerprint == ds.Fingerprint {
+			continue
+		}
+
+		if r := w.datasetDAO.UpdateFingerprint(w.ctx, ds.ID, fingerprint); r != nil {
+			continue
+		}
+		if ds.Fingerprint != "" {
+			utils.NewNotifier().Publish(utils.EVENT_DATASET_CHANGED, ds.ID)
 		}
 	}
 }
@@ -26,6 +26,7 @@ type DatasetDAOInterface interface {
 	SelectDataSetByName(ctx context.Context, name string) ([]*ent.Dataset, *logger.Report)
 	SelectByPath(ctx context.Context, path string) (*ent.Dataset, *logger.Report)
 	SelectDatasetsByDRID(ctx context.Context, dr_id int) []*ent.Dataset
+	SelectLeafDatasetsByDRID(ctx context.Context, dr_id int) []*ent.Dataset
 	SelectStatistics(ctx context.Context, id int) (*ent.Dataset, *logger.Report)
 	InsertOne(ctx context.Context, ds DatasetDTO) *ent.Dataset
 	UpdateDatasetDeleted(ctx context.Context, dataset_id int)
@@ -33,6 +34,7 @@ type DatasetDAOInterface interface {
 	UpdateValidation(ctx context.Context, ds DatasetDTO)
 	UpdateStat(ctx context.Context, id int, stat string) *logger.Report
 	UpdateStatPath(ctx context.Context, id int, stat string) *logger.Report
+	UpdateFingerprint(ctx context.Context, id int, fingerprint string) *logger.Report
 	DeleteDataset(ctx context.Context, id int) *logger.Report
 	DeleteDatasetByDRID(ctx context.Context, dr_id int) *logger.Report
 }
@@ -399,6 +401,34 @@ func (dao *DatasetDAO) UpdateStatPath(ctx context.Context, id int, stat_path str
 	return nil
 }
 
+func (dao *DatasetDAO) SelectLeafDatasetsByDRID(ctx context.Context, dr_id int) []*ent.Dataset {
+	dss, err := dao.entClient.Dataset.Query().
+		Select(dataset.FieldID, dataset.FieldPath, dataset.FieldFingerprint).
+		Where(dataset.And(dataset.DrID(dr_id), dataset.IsLeaf(true), dataset.IsDeleted(false))).
+		All(ctx)
+
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return nil
+	}
+
+	return dss
+}
+
+func (dao *DatasetDAO) UpdateFingerprint(ctx context.Context, id int, fingerprint string) *logger.Report {
+	err := dao.entClient.Dataset.Update().
+		Where(dataset.ID(id)).
+		SetFingerprint(fingerprint).
+		SetFingerprintAt(time.Now()).
+		Exec(ctx)
+
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+
+	return nil
+}
+
 func (dao *DatasetDAO) SelectDataSetByName(ctx context.Context,
 	name string) ([]*ent.Dataset, *logger.Report) {
 	dss, err := dao.entClient.Dataset.
@@ -3,11 +3,14 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
+	"time"
 
 	"api_server/ent"
 	"api_server/ent/device"
 	"api_server/ent/gpu"
+	"api_server/ent/schema"
 	"api_server/logger"
 	"api_server/utils"
 
@@ -34,6 +37,78 @@ type IDeviceDAO interface {
 	//   - error: 오류 정보
 	SelectByIPAndPort(ctx context.Context, ip string, port int) ([]*ent.Device, error)
 	UpdateOne(ctx context.Context, d DeviceDTO) (*ent.Device, error)
+
+	// UpdateAvailable는 health check 결과로 Device의 available 값을 변경합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - device_id: 변경할 Device ID
+	//   - available: engine이 응답하면 true
+	//
+	// 반환값:
+	//   - error: 오류 정보
+	UpdateAvailable(ctx context.Context, device_id int, available bool) error
+
+	// UpsertRegistered는 스스로 등록한 engine의 Device를 만들거나 변경합니다.
+	// device_id가 0이면 새로 만들고, 아니면 해당 Device의 주소와 capability를 변경합니다.
+	// 어느 경우든 available과 heartbeat 시각을 현재로 설정합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - device_id: 변경할 Device ID, 0이면 새로 생성
+	//   - req: engine이 보낸 등록 정보
+	//
+	// 반환값:
+	//   - *ent.Device: 생성 또는 변경된 Device
+	//   - error: 오류 정보
+	UpsertRegistered(ctx context.Context, device_id int, req DeviceRegisterDTO) (*ent.Device, error)
+
+	// UpdateHeartbeat는 Device의 heartbeat 시각을 현재로 바꾸고 available로 표시합니다.
+	// capabilities가 nil이면 capability는 그대로 둡니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - device_id: heartbeat를 보낸 Device ID
+	//   - capabilities: engine이 보고한 capability
+	//
+	// 반환값:
+	//   - error: 오류 정보
+	UpdateHeartbeat(ctx context.Context, device_id int, capabilities *schema.DeviceCapabilities) error
+
+	// UpdateCapabilities는 health check에서 engine이 보고한 capability를 저장합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - device_id: 변경할 Device ID
+	//   - capabilities: engine이 보고한 capability
+	//
+	// 반환 값:
+	//   - error: 오류 정보
+	UpdateCapabilities(ctx context.Context, device_id int, capabilities *schema.DeviceCapabilities) error
+
+	// UpdateDrain는 Device의 drain 상태를 변경합니다. drain이 false이면 사유와 시작 시각을 지웁니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - device_id: 변경할 Device ID
+	//   - drain: true이면 새 작업을 배치하지 않음
+	//   - reason: drain 사유
+	//   - window_id: drain을 시작한 maintenance window ID, 관리자가 직접 시작하면 0
+	//
+	// 반환 값:
+	//   - *ent.Device: 변경된 Device
+	//   - error: 오류 정보
+	UpdateDrain(ctx context.Context, device_id int, drain bool, reason string, window_id int) (*ent.Device, error)
+
+	// SelectDraining는 drain 중인 Device를 조회합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//
+	// 반환 값:
+	//   - []*ent.Device: drain 중인 Device 리스트
+	//   - error: 오류 정보
+	SelectDraining(ctx context.Context) ([]*ent.Device, error)
 	DeleteMany(ctx context.Context, ids []int) (int, error)
 	DeleteOne(ctx context.Context, devie_id int) error
 }
@@ -136,6 +211,86 @@ func (dao *DeviceDAO) UpdateOne(ctx context.Context, req DeviceDTO) (*ent.Device
 		Save(ctx)
 }
 
+func (dao *DeviceDAO) UpdateAvailable(ctx context.Context, device_id int, available bool) error {
+	logger.Debug(fmt.Sprintf(`{"device_id": %d, "available": %t}`, device_id, available))
+	return dao.dbms.Device.UpdateOneID(device_id).
+		SetAvailable(strconv.FormatBool(available)).
+		Exec(ctx)
+}
+
+func (dao *DeviceDAO) UpsertRegistered(ctx context.Context, device_id int, req DeviceRegisterDTO) (*ent.Device, error) {
+	logger.Debug(fmt.Sprintf(`{"device_id": %d, "req": %+v}`, device_id, req))
+	now := time.Now()
+	if device_id == 0 {
+		create := dao.dbms.Device.Create().
+			SetName(req.Name).
+			SetIP(req.IP).
+			SetPort(req.Port).
+			SetIsUse(true).
+			SetType("engine").
+			SetAvailable("true").
+			SetHeartbeatAt(now)
+		if req.Connection != "" {
+			create.SetConnection(req.Connection)
+		}
+		if req.Capabilities != nil {
+			create.SetCapabilities(req.Capabilities)
+		}
+		return create.Save(ctx)
+	}
+
+	update := dao.dbms.Device.UpdateOneID(device_id).
+		SetIP(req.IP).
+		SetPort(req.Port).
+		SetAvailable("true").
+		SetHeartbeatAt(now)
+	if req.Name != "" {
+		update.SetName(req.Name)
+	}
+	if req.Connection != "" {
+		update.SetConnection(req.Connection)
+	}
+	if req.Capabilities != nil {
+		update.SetCapabilities(req.Capabilities)
+	}
+	return update.Save(ctx)
+}
+
+func (dao *DeviceDAO) UpdateHeartbeat(ctx context.Context, device_id int, capabilities *schema.DeviceCapabilities) error {
+	update := dao.dbms.Device.UpdateOneID(device_id).
+		SetAvailable("true").
+		SetHeartbeatAt(time.Now())
+	if capabilities != nil {
+		update.SetCapabilities(capabilities)
+	}
+	return update.Exec(ctx)
+}
+
+func (dao *DeviceDAO) UpdateCapabilities(ctx context.Context, device_id int, capabilities *schema.DeviceCapabilities) error {
+	return dao.dbms.Device.UpdateOneID(device_id).SetCapabilities(capabilities).Exec(ctx)
+}
+
+func (dao *DeviceDAO) UpdateDrain(ctx context.Context, device_id int, drain bool, reason string, window_id int) (*ent.Device, error) {
+	logger.Debug(fmt.Sprintf(`{"device_id": %d, "drain": %t, "reason": %s, "window_id": %d}`, device_id, drain, reason, window_id))
+	update := dao.dbms.Device.UpdateOneID(device_id).
+		SetDrain(drain).
+		SetDrainReason(reason).
+		SetDrainWindow(window_id)
+	if drain {
+		update.SetDrainAt(time.Now())
+	} else {
+		update.ClearDrainAt()
+	}
+	return update.Save(ctx)
+}
+
+func (dao *DeviceDAO) SelectDraining(ctx context.Context) ([]*ent.Device, error) {
+	return dao.dbms.Device.Query().
+		Where(device.Drain(true)).
+		Order(device.ByID(sql.OrderAsc())).
+		All(ctx)
+}
+
 func (dao *DeviceDAO) DeleteMany(ctx context.Context, ids []int) (int, error) {
 	logger.Debug(fmt.Sprintf("%+v", ids))
 	return dao.dbms.Device.Delete().
@@ -1,6 +1,11 @@
 package repository
 
-import "api_server/ent"
+import (
+	"time"
+
+	"api_server/ent"
+	"api_server/ent/schema"
+)
 
 type DeviceDTO struct {
 	ID         int    `json:"id,omitempty"`
@@ -10,6 +15,15 @@ type DeviceDTO struct {
 	IsUse      *bool  `json:"is_use,omitempty"`
 	Type       string `json:"type,omitempty"`
 	Connection string `json:"connection,omitempty"`
+	Available  string `json:"available,omitempty"`
+
+	HeartbeatAt  *time.Time                 `json:"heartbeat_at,omitempty"`
+	Capabilities *schema.DeviceCapabilities `json:"capabilities,omitempty"`
+
+	// 점검을 위한 drain 상태, 변경은 /device/:device_id/drain 으로만 합니다.
+	Drain       bool       `json:"drain,omitempty"`
+	DrainReason string     `json:"drain_reason,omitempty"`
+	DrainAt     *time.Time `json:"drain_at,omitempty"`
 }
 
 type DeviceRemoveDTO struct {
@@ -35,5 +49,13 @@ func ConvertEntToDTO(entity *ent.Device) *DeviceDTO {
 		IsUse:      &entity.IsUse,
 		Type:       entity.Type,
 		Connection: entity.Connection,
+		Available:  entity.Available,
+
+		HeartbeatAt:  entity.HeartbeatAt,
+		Capabilities: entity.Capabilities,
+
+		Drain:       entity.Drain,
+		DrainReason: entity.DrainReason,
+		DrainAt:     entity.DrainAt,
 	}
 }
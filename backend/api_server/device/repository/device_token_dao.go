@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"api_server/ent"
+	"api_server/ent/devicetoken"
+	"api_server/logger"
+	"api_server/utils"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type IDeviceTokenDAO interface {
+	// InsertOne은 enrollment token을 저장합니다. token은 hash만 저장합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - req: token 이름, 미리 지정할 Device ID, 발급자
+	//   - token_hash: token의 SHA-256 hex
+	//
+	// 반환 값:
+	//   - *ent.DeviceToken: 저장된 token
+	//   - error: 오류 정보
+	InsertOne(ctx context.Context, req DeviceTokenDTO, token_hash string) (*ent.DeviceToken, error)
+	SelectAll(ctx context.Context) ([]*ent.DeviceToken, error)
+
+	// SelectValid는 hash가 일치하고 폐기되지 않은 token을 조회합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - token_hash: token의 SHA-256 hex
+	//
+	// 반환 값:
+	//   - *ent.DeviceToken: 조회된 token, 없으면 NotFound 오류
+	//   - error: 오류 정보
+	SelectValid(ctx context.Context, token_hash string) (*ent.DeviceToken, error)
+
+	// SelectValidByDevice는 Device에 연결된 폐기되지 않은 token을 조회합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - device_id: token으로 등록된 Device ID
+	//
+	// 반환 값:
+	//   - []*ent.DeviceToken: Device에 연결된 token 목록
+	//   - error: 오류 정보
+	SelectValidByDevice(ctx context.Context, device_id int) ([]*ent.DeviceToken, error)
+
+	// UpdateUsed는 token을 사용한 시각과 token으로 등록된 Device를 기록합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - id: token ID
+	//   - device_id: token으로 등록된 Device ID
+	//
+	// 반환 값:
+	//   - error: 오류 정보
+	UpdateUsed(ctx context.Context, id int, device_id int) error
+
+	// Revoke는 token을 폐기합니다. 폐기된 token으로는 등록이나 heartbeat를 할 수 없습니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - id: token ID
+	//
+	// 반환 값:
+	//   - *ent.DeviceToken: 폐기된 token
+	//   - error: 오류 정보
+	Revoke(ctx context.Context, id int) (*ent.DeviceToken, error)
+}
+
+type DeviceTokenDAO struct {
+	dbms *ent.Client
+}
+
+var onceDeviceToken sync.Once
+var instanceDeviceToken *DeviceTokenDAO
+
+func NewDeviceTokenDAO() *DeviceTokenDAO {
+	onceDeviceToken.Do(func() {
+		logger.Debug("DeviceToken DAO instance")
+		instanceDeviceToken = &DeviceTokenDAO{
+			dbms: utils.GetEntClient(),
+		}
+	})
+
+	return instanceDeviceToken
+}
+
+func (dao *DeviceTokenDAO) InsertOne(ctx context.Context, req DeviceTokenDTO, token_hash string) (*ent.DeviceToken, error) {
+	logger.Debug(fmt.Sprintf(`{"name": %s, "device_id": %d}`, req.Name, req.DeviceID))
+	return dao.dbms.DeviceToken.Create().
+		SetName(req.Name).
+		SetDeviceID(req.DeviceID).
+		SetCreatedBy(req.CreatedBy).
+		SetTokenHash(token_hash).
+		Save(ctx)
+}
+
+func (dao *DeviceTokenDAO) SelectAll(ctx context.Context) ([]*ent.DeviceToken, error) {
+	logger.Debug("Select All device tokens")
+	return dao.dbms.DeviceToken.Query().
+		Order(devicetoken.ByID(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (dao *DeviceTokenDAO) SelectValid(ctx context.Context, token_hash string) (*ent.DeviceToken, error) {
+	return dao.dbms.DeviceToken.Query().
+		Where(
+			devicetoken.TokenHash(token_hash),
+			devicetoken.Revoked(false),
+		).
+		Only(ctx)
+}
+
+func (dao *DeviceTokenDAO) SelectValidByDevice(ctx context.Context, device_id int) ([]*ent.DeviceToken, error) {
+	return dao.dbms.DeviceToken.Query().
+		Where(
+			devicetoken.DeviceID(device_id),
+			devicetoken.Revoked(false),
+		).
+		All(ctx)
+}
+
+func (dao *DeviceTokenDAO) UpdateUsed(ctx context.Context, id int, device_id int) error {
+	return dao.dbms.DeviceToken.UpdateOneID(id).
+		SetDeviceID(device_id).
+		SetUsedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (dao *DeviceTokenDAO) Revoke(ctx context.Context, id int) (*ent.DeviceToken, error) {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	return dao.dbms.DeviceToken.UpdateOneID(id).
+		SetRevoked(true).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"time"
+
+	"api_server/ent"
+	"api_server/ent/schema"
+)
+
+// DeviceTokenDTO는 enrollment token 정보입니다. Token은 발급할 때만 채워집니다.
+type DeviceTokenDTO struct {
+	ID        int        `json:"id"`
+	Name      string     `json:"name"`
+	DeviceID  int        `json:"device_id"`
+	Token     string     `json:"token,omitempty"`
+	Revoked   bool       `json:"revoked"`
+	CreatedBy string     `json:"created_by"`
+	CreatedAt time.Time  `json:"created_at"`
+	UsedAt    *time.Time `json:"used_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+}
+
+// DeviceRegisterDTO는 engine이 스스로 등록할 때 보내는 정보입니다.
+// IP가 비어 있으면 요청을 보낸 주소를 사용합니다.
+type DeviceRegisterDTO struct {
+	Name         string                     `json:"name"`
+	IP           string                     `json:"ip"`
+	Port         int                        `json:"port" binding:"required"`
+	Connection   string                     `json:"connection"`
+	Capabilities *schema.DeviceCapabilities `json:"capabilities"`
+	GPUs         []GPUInfo                  `json:"gpu"`
+}
+
+// DeviceHeartbeatDTO는 engine이 주기적으로 보내는 상태입니다. GPU가 없으면 GPU 정보는 갱신하지 않습니다.
+type DeviceHeartbeatDTO struct {
+	Capabilities *schema.DeviceCapabilities `json:"capabilities"`
+	GPUs         []GPUInfo                  `json:"gpu"`
+}
+
+// DeviceEnrollDTO는 등록과 heartbeat 응답입니다.
+type DeviceEnrollDTO struct {
+	DeviceID          int `json:"device_id"`
+	HeartbeatInterval int `json:"heartbeat_interval"` // 초
+}
+
+func ConvertDeviceTokenEntToDTO(entity *ent.DeviceToken) *DeviceTokenDTO {
+	return &DeviceTokenDTO{
+		ID:        entity.ID,
+		Name:      entity.Name,
+		DeviceID:  entity.DeviceID,
+		Revoked:   entity.Revoked,
+		CreatedBy: entity.CreatedBy,
+		CreatedAt: entity.CreatedAt,
+		UsedAt:    entity.UsedAt,
+		RevokedAt: entity.RevokedAt,
+	}
+}
+
+func ConvertDeviceTokenEntsToDTOs(ents []*ent.DeviceToken) []*DeviceTokenDTO {
+	dtos := []*DeviceTokenDTO{}
+
+	for _, entity := range ents {
+		dtos = append(dtos, ConvertDeviceTokenEntToDTO(entity))
+	}
+
+	return dtos
+}
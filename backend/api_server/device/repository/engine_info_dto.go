@@ -1,6 +1,9 @@
 package repository
 
-import "api_server/ent"
+import (
+	"api_server/ent"
+	"api_server/ent/schema"
+)
 
 type CPUInfo struct {
 	Uilization float64 `json:"utilization_cpu,omitempty"`
@@ -22,6 +25,8 @@ type GPUInfo struct {
 	MemoryUsed        float64 `json:"memory_used,omitempty"`
 	UtilizationGPU    float64 `json:"utilization_gpu,omitempty"`
 	UtilizationMemory float64 `json:"utilization_memory,omitempty"`
+	Temperature       float64 `json:"temperature,omitempty"` // Celsius, engine이 보고하지 않으면 0
+	PowerDraw         float64 `json:"power_draw,omitempty"`  // Watt, engine이 보고하지 않으면 0
 }
 
 type EngineInfoDTO struct {
@@ -30,14 +35,21 @@ type EngineInfoDTO struct {
 	CPU        CPUInfo   `json:"cpu,omitempty"`
 	DISK       DiskInfo  `json:"disk,omitempty"`
 	GPUs       []GPUInfo `json:"gpu,omitempty"`
+
+	// engine이 보고한 실행 가능한 engine type과 build version, 보고하지 않는 engine은 nil
+	Capabilities *schema.DeviceCapabilities `json:"capabilities,omitempty"`
 }
 
 func ConvertGPUInfoEntToDTO(entity *ent.Gpu) GPUInfo {
 	return GPUInfo{
-		ID:    entity.ID,
-		Index: entity.Index,
-		Name:  entity.Name,
-		UUID:  entity.UUID,
+		ID:                entity.ID,
+		Index:             entity.Index,
+		Name:              entity.Name,
+		UUID:              entity.UUID,
+		MemoryTotal:       entity.MemoryTotal,
+		MemoryUsed:        entity.MemoryUsed,
+		UtilizationGPU:    entity.UtilizationGpu,
+		UtilizationMemory: entity.UtilizationMemory,
 	}
 }
 
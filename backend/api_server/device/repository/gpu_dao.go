@@ -17,11 +17,30 @@ import (
 type IGPUDAO interface {
 	UpsertMany(ctx context.Context, req EngineInfoDTO) error
 	SelectIsUse(ctx context.Context) ([]*ent.Device, error)
+	// SelectIdle는 drain 중이 아닌 device의 사용 가능한 idle GPU를 조회합니다.
 	SelectIdle(ctx context.Context) ([]*ent.Gpu, error)
+	SelectMany(ctx context.Context, ids []int) ([]*ent.Gpu, error)
+	SelectBusy(ctx context.Context) ([]*ent.Gpu, error)
 	SelectManyByDeviceID(ctx context.Context, deviceID int) ([]*ent.Gpu, error)
 	UpdateAllDisUse(ctx context.Context) error
+
+	// UpdateDisUseByDevice는 device의 GPU 중 uuids에 없는 GPU를 사용하지 않도록 변경합니다.
+	// engine이 보고하지 않은 GPU나 응답하지 않는 device의 GPU에 사용하며, GPU의 state는 바꾸지 않습니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - device_id: GPU가 속한 Device ID
+	//   - uuids: 계속 사용할 GPU UUID 목록, 비어 있으면 device의 모든 GPU
+	//
+	// 반환값:
+	//   - error: 오류 정보
+	UpdateDisUseByDevice(ctx context.Context, device_id int, uuids []string) error
+	// UpdateUseByDevice는 device의 모든 GPU를 다시 사용하도록 변경합니다. GPU의 state는 바꾸지 않습니다.
+	UpdateUseByDevice(ctx context.Context, device_id int) error
 	UpdateManyState(ctx context.Context, ids []int, state string) error
 	SelectGpuByIndex(ctx context.Context, gpuIndex string) (*ent.Gpu, error)
+	// SelectGpuByDeviceAndIndex는 device에서 사용 중인 index번 GPU를 조회합니다.
+	SelectGpuByDeviceAndIndex(ctx context.Context, device_id int, index int) (*ent.Gpu, error)
 }
 
 type GPUDAO struct {
@@ -52,13 +71,21 @@ func (dao *GPUDAO) UpsertMany(ctx context.Context, req EngineInfoDTO) error {
 				SetIndex(req.GPUs[i].Index).
 				SetName(req.GPUs[i].Name).
 				SetUUID(req.GPUs[i].UUID).
-				SetState(utils.GPU_STATE_IDLE)
+				SetState(utils.GPU_STATE_IDLE).
+				SetMemoryTotal(req.GPUs[i].MemoryTotal).
+				SetMemoryUsed(req.GPUs[i].MemoryUsed).
+				SetUtilizationGpu(req.GPUs[i].UtilizationGPU).
+				SetUtilizationMemory(req.GPUs[i].UtilizationMemory)
 		},
 	).
 		OnConflict(
 			sql.ConflictColumns(gpu.FieldUUID),
 		).
 		UpdateIsUse().
+		UpdateMemoryTotal().
+		UpdateMemoryUsed().
+		UpdateUtilizationGpu().
+		UpdateUtilizationMemory().
 		Exec(ctx)
 }
 
@@ -80,11 +107,26 @@ func (dao *GPUDAO) SelectIdle(ctx context.Context) ([]*ent.Gpu, error) {
 			gpu.And(
 				gpu.IsUse(true),
 				gpu.State(utils.GPU_STATE_IDLE),
+				gpu.HasDeviceWith(device.Drain(false)),
 			),
 		).
 		All(ctx)
 }
 
+func (dao *GPUDAO) SelectMany(ctx context.Context, ids []int) ([]*ent.Gpu, error) {
+	logger.Debug(fmt.Sprintf(`{"ids": %+v}`, ids))
+	return dao.dbms.Gpu.Query().
+		Where(gpu.IDIn(ids...)).
+		All(ctx)
+}
+
+func (dao *GPUDAO) SelectBusy(ctx context.Context) ([]*ent.Gpu, error) {
+	logger.Debug("Select Busy GPUs")
+	return dao.dbms.Gpu.Query().
+		Where(gpu.StateNEQ(utils.GPU_STATE_IDLE)).
+		All(ctx)
+}
+
 func (dao *GPUDAO) UpdateAllDisUse(ctx context.Context) error {
 	logger.Debug("Update all gpus to disuse")
 	return dao.dbms.Gpu.Update().
@@ -93,6 +135,29 @@ func (dao *GPUDAO) UpdateAllDisUse(ctx context.Context) error {
 		Exec(ctx)
 }
 
+func (dao *GPUDAO) UpdateDisUseByDevice(ctx context.Context, device_id int, uuids []string) error {
+	logger.Debug(fmt.Sprintf(`{"device_id": %d, "uuids": %+v}`, device_id, uuids))
+	return dao.dbms.Gpu.Update().
+		Where(
+			gpu.DeviceID(device_id),
+			gpu.UUIDNotIn(uuids...),
+			gpu.IsUse(true),
+		).
+		SetIsUse(false).
+		Exec(ctx)
+}
+
+func (dao *GPUDAO) UpdateUseByDevice(ctx context.Context, device_id int) error {
+	logger.Debug(fmt.Sprintf(`{"device_id": %d}`, device_id))
+	return dao.dbms.Gpu.Update().
+		Where(
+			gpu.DeviceID(device_id),
+			gpu.IsUse(false),
+		).
+		SetIsUse(true).
+		Exec(ctx)
+}
+
 func (dao *GPUDAO) UpdateOneState(ctx context.Context, gpu_id int, state string) error {
 	logger.Debug(fmt.Sprintf(`{"gpu_id": %d, "state": %s}`, gpu_id, state))
 	return dao.dbms.Gpu.UpdateOneID(gpu_id).
@@ -129,3 +194,11 @@ func (dao *GPUDAO) SelectGpuByIndex(ctx context.Context, gpuIndex string) (*ent.
 		Where(gpu.Index(gpuIndexInt), gpu.IsUse(true)).
 		Only(ctx)
 }
+
+func (dao *GPUDAO) SelectGpuByDeviceAndIndex(ctx context.Context, device_id int, index int) (*ent.Gpu, error) {
+	logger.Debug(fmt.Sprintf(`{"device_id": %d, "index": %d}`, device_id, index))
+
+	return dao.dbms.Gpu.Query().
+		Where(gpu.DeviceID(device_id), gpu.Index(index), gpu.IsUse(true)).
+		Only(ctx)
+}
@@ -9,13 +9,17 @@ type DongleGPUStatus struct {
 	CurrentGPUIds   []string `json:"current_gpu_ids"`
 }
 type GPUDTO struct {
-	ID       int    `json:"id"`
-	UUID     string `json:"uuid,omitempty"`
-	Index    int    `json:"index"`
-	Name     string `json:"name,omitempty"`
-	State    string `json:"state,omitempty"`
-	IsUse    bool   `json:"is_use,omitempty"`
-	DeviceID int    `json:"device_id"`
+	ID                int     `json:"id"`
+	UUID              string  `json:"uuid,omitempty"`
+	Index             int     `json:"index"`
+	Name              string  `json:"name,omitempty"`
+	State             string  `json:"state,omitempty"`
+	IsUse             bool    `json:"is_use,omitempty"`
+	DeviceID          int     `json:"device_id"`
+	MemoryTotal       float64 `json:"memory_total"`
+	MemoryUsed        float64 `json:"memory_used"`
+	UtilizationGPU    float64 `json:"utilization_gpu"`
+	UtilizationMemory float64 `json:"utilization_memory"`
 }
 
 func ConvertGPUEntsToGPUDTOs(ents []*ent.Gpu) []*GPUDTO {
@@ -30,12 +34,16 @@ func ConvertGPUEntsToGPUDTOs(ents []*ent.Gpu) []*GPUDTO {
 
 func ConvertGPUEntToGPUDTO(entity *ent.Gpu) *GPUDTO {
 	return &GPUDTO{
-		ID:       entity.ID,
-		UUID:     entity.UUID,
-		Index:    entity.Index,
-		Name:     entity.Name,
-		State:    entity.State,
-		IsUse:    entity.IsUse,
-		DeviceID: entity.DeviceID,
+		ID:                entity.ID,
+		UUID:              entity.UUID,
+		Index:             entity.Index,
+		Name:              entity.Name,
+		State:             entity.State,
+		IsUse:             entity.IsUse,
+		DeviceID:          entity.DeviceID,
+		MemoryTotal:       entity.MemoryTotal,
+		MemoryUsed:        entity.MemoryUsed,
+		UtilizationGPU:    entity.UtilizationGpu,
+		UtilizationMemory: entity.UtilizationMemory,
 	}
 }
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"api_server/ent"
+	"api_server/ent/maintenancewindow"
+	"api_server/logger"
+	"api_server/utils"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type IMaintenanceDAO interface {
+	// InsertOne은 maintenance window를 저장합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - req: drain할 Device ID, 시작/종료 시각, 사유, 모델 이동 여부, 등록자
+	//
+	// 반환 값:
+	//   - *ent.MaintenanceWindow: 저장된 maintenance window
+	//   - error: 오류 정보
+	InsertOne(ctx context.Context, req MaintenanceWindowDTO) (*ent.MaintenanceWindow, error)
+
+	// SelectAll은 maintenance window를 시작 시각 순으로 조회합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - device_id: 조회할 Device ID, 0이면 모든 Device
+	//
+	// 반환 값:
+	//   - []*ent.MaintenanceWindow: 조회된 maintenance window 리스트
+	//   - error: 오류 정보
+	SelectAll(ctx context.Context, device_id int) ([]*ent.MaintenanceWindow, error)
+	SelectOne(ctx context.Context, id int) (*ent.MaintenanceWindow, error)
+
+	// SelectOverlap은 Device의 끝나지 않은 maintenance window 중 starts_at부터 ends_at까지와 겹치는 window를 조회합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - device_id: Device ID
+	//   - starts_at: 시작 시각
+	//   - ends_at: 종료 시각
+	//
+	// 반환 값:
+	//   - []*ent.MaintenanceWindow: 겹치는 maintenance window 리스트
+	//   - error: 오류 정보
+	SelectOverlap(ctx context.Context, device_id int, starts_at time.Time, ends_at time.Time) ([]*ent.MaintenanceWindow, error)
+
+	// SelectDue는 시작 시각이 지났지만 아직 drain을 시작하지 않은 maintenance window를 조회합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - now: 기준 시각
+	//
+	// 반환 값:
+	//   - []*ent.MaintenanceWindow: 시작할 maintenance window 리스트
+	//   - error: 오류 정보
+	SelectDue(ctx context.Context, now time.Time) ([]*ent.MaintenanceWindow, error)
+
+	// SelectExpired는 종료 시각이 지났지만 아직 끝내지 않은 maintenance window를 조회합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - now: 기준 시각
+	//
+	// 반환 값:
+	//   - []*ent.MaintenanceWindow: 끝낼 maintenance window 리스트
+	//   - error: 오류 정보
+	SelectExpired(ctx context.Context, now time.Time) ([]*ent.MaintenanceWindow, error)
+	UpdateStarted(ctx context.Context, id int) error
+	UpdateEnded(ctx context.Context, id int) error
+	DeleteOne(ctx context.Context, id int) error
+}
+
+type MaintenanceDAO struct {
+	dbms *ent.Client
+}
+
+var onceMaintenance sync.Once
+var instanceMaintenance *MaintenanceDAO
+
+func NewMaintenanceDAO() *MaintenanceDAO {
+	onceMaintenance.Do(func() {
+		logger.Debug("Maintenance DAO instance")
+		instanceMaintenance = &MaintenanceDAO{
+			dbms: utils.GetEntClient(),
+		}
+	})
+
+	return instanceMaintenance
+}
+
+func (dao *MaintenanceDAO) InsertOne(ctx context.Context, req MaintenanceWindowDTO) (*ent.MaintenanceWindow, error) {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	return dao.dbms.MaintenanceWindow.Create().
+		SetDeviceID(req.DeviceID).
+		SetStartsAt(req.StartsAt).
+		SetEndsAt(req.EndsAt).
+		SetReason(req.Reason).
+		SetMigrate(req.Migrate).
+		SetCreatedBy(req.CreatedBy).
+		Save(ctx)
+}
+
+func (dao *MaintenanceDAO) SelectAll(ctx context.Context, device_id int) ([]*ent.MaintenanceWindow, error) {
+	logger.Debug(fmt.Sprintf(`{"device_id": %d}`, device_id))
+	query := dao.dbms.MaintenanceWindow.Query()
+	if device_id > 0 {
+		query.Where(maintenancewindow.DeviceID(device_id))
+	}
+	return query.
+		Order(maintenancewindow.ByStartsAt(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (dao *MaintenanceDAO) SelectOne(ctx context.Context, id int) (*ent.MaintenanceWindow, error) {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	return dao.dbms.MaintenanceWindow.Get(ctx, id)
+}
+
+func (dao *MaintenanceDAO) SelectOverlap(ctx context.Context, device_id int, starts_at time.Time, ends_at time.Time) ([]*ent.MaintenanceWindow, error) {
+	return dao.dbms.MaintenanceWindow.Query().
+		Where(
+			maintenancewindow.DeviceID(device_id),
+			maintenancewindow.EndedAtIsNil(),
+			maintenancewindow.StartsAtLT(ends_at),
+			maintenancewindow.EndsAtGT(starts_at),
+		).
+		All(ctx)
+}
+
+func (dao *MaintenanceDAO) SelectDue(ctx context.Context, now time.Time) ([]*ent.MaintenanceWindow, error) {
+	return dao.dbms.MaintenanceWindow.Query().
+		Where(
+			maintenancewindow.StartedAtIsNil(),
+			maintenancewindow.StartsAtLTE(now),
+			maintenancewindow.EndsAtGT(now),
+		).
+		Order(maintenancewindow.ByStartsAt(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (dao *MaintenanceDAO) SelectExpired(ctx context.Context, now time.Time) ([]*ent.MaintenanceWindow, error) {
+	return dao.dbms.MaintenanceWindow.Query().
+		Where(
+			maintenancewindow.EndedAtIsNil(),
+			maintenancewindow.EndsAtLTE(now),
+		).
+		Order(maintenancewindow.ByEndsAt(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (dao *MaintenanceDAO) UpdateStarted(ctx context.Context, id int) error {
+	return dao.dbms.MaintenanceWindow.UpdateOneID(id).
+		SetStartedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (dao *MaintenanceDAO) UpdateEnded(ctx context.Context, id int) error {
+	return dao.dbms.MaintenanceWindow.UpdateOneID(id).
+		SetEndedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (dao *MaintenanceDAO) DeleteOne(ctx context.Context, id int) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	return dao.dbms.MaintenanceWindow.
+		DeleteOneID(id).
+		Exec(ctx)
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"time"
+
+	"api_server/ent"
+)
+
+// DrainRequestDTO는 device drain 요청입니다.
+// Migrate가 true이면 device GPU에 올라간 추론 모델을 다른 device의 idle GPU로 옮기고, 아니면 내립니다.
+type DrainRequestDTO struct {
+	Reason  string `json:"reason"`
+	Migrate bool   `json:"migrate"`
+}
+
+// DrainStatusDTO는 device의 drain 진행 상태입니다.
+// Drained는 drain 중이고 사용 중인 GPU와 올라간 추론 모델이 모두 없을 때 true 입니다.
+type DrainStatusDTO struct {
+	DeviceID     int        `json:"device_id"`
+	Drain        bool       `json:"drain"`
+	Drained      bool       `json:"drained"`
+	Reason       string     `json:"reason"`
+	DrainAt      *time.Time `json:"drain_at"`
+	WindowID     int        `json:"window_id"`     // drain을 시작한 maintenance window, 관리자가 직접 시작하면 0
+	BusyGPUs     []int      `json:"busy_gpus"`     // 아직 작업이 실행 중인 GPU ID
+	LoadedModels int        `json:"loaded_models"` // 아직 올라가 있는 추론 모델 수
+}
+
+// MaintenanceWindowDTO는 starts_at부터 ends_at까지 device를 drain하는 예약입니다.
+type MaintenanceWindowDTO struct {
+	ID        int        `json:"id"`
+	DeviceID  int        `json:"device_id" binding:"required"`
+	StartsAt  time.Time  `json:"starts_at" binding:"required"`
+	EndsAt    time.Time  `json:"ends_at" binding:"required"`
+	Reason    string     `json:"reason"`
+	Migrate   bool       `json:"migrate"`
+	CreatedBy string     `json:"created_by"`
+	CreatedAt time.Time  `json:"created_at"`
+	StartedAt *time.Time `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+}
+
+func ConvertMaintenanceEntToDTO(entity *ent.MaintenanceWindow) *MaintenanceWindowDTO {
+	return &MaintenanceWindowDTO{
+		ID:        entity.ID,
+		DeviceID:  entity.DeviceID,
+		StartsAt:  entity.StartsAt,
+		EndsAt:    entity.EndsAt,
+		Reason:    entity.Reason,
+		Migrate:   entity.Migrate,
+		CreatedBy: entity.CreatedBy,
+		CreatedAt: entity.CreatedAt,
+		StartedAt: entity.StartedAt,
+		EndedAt:   entity.EndedAt,
+	}
+}
+
+func ConvertMaintenanceEntsToDTOs(ents []*ent.MaintenanceWindow) []*MaintenanceWindowDTO {
+	dtos := []*MaintenanceWindowDTO{}
+
+	for _, entity := range ents {
+		dtos = append(dtos, ConvertMaintenanceEntToDTO(entity))
+	}
+
+	return dtos
+}
@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"api_server/ent"
+	"api_server/ent/gputelemetry"
+	"api_server/logger"
+	"api_server/utils"
+
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+type ITelemetryDAO interface {
+	// UpsertSample은 sample을 resolution 구간의 평균에 더합니다. 구간이 없으면 새로 만듭니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - sample: GPU 하나의 수집 값
+	//   - resolution: 구간 크기(초)
+	//
+	// 반환 값:
+	//   - error: 오류 정보
+	UpsertSample(ctx context.Context, sample TelemetrySampleDTO, resolution int) error
+
+	// SelectRange는 조건에 맞는 구간들을 GPU, 시각 순으로 조회합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - query: 조회 조건, Resolution과 From/To는 채워져 있어야 함
+	//
+	// 반환 값:
+	//   - []*ent.GpuTelemetry: 조회된 구간 목록
+	//   - error: 오류 정보
+	SelectRange(ctx context.Context, query TelemetryQueryDTO) ([]*ent.GpuTelemetry, error)
+
+	// DeleteBefore는 resolution 구간 중 before 이전에 시작한 구간을 삭제합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - resolution: 구간 크기(초)
+	//   - before: 보존 기간이 시작되는 시각
+	//
+	// 반환 값:
+	//   - int: 삭제된 구간 수
+	//   - error: 오류 정보
+	DeleteBefore(ctx context.Context, resolution int, before time.Time) (int, error)
+
+	// SelectModelingsInRange는 from~to 사이에 실행된 modeling과 배치된 GPU를 조회합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - from: 조회 시작 시각
+	//   - to: 조회 끝 시각
+	//
+	// 반환 값:
+	//   - []*GPUModelingDTO: modeling 목록
+	//   - error: 오류 정보
+	SelectModelingsInRange(ctx context.Context, from, to time.Time) ([]*GPUModelingDTO, error)
+}
+
+type TelemetryDAO struct {
+	dbms *ent.Client
+}
+
+var onceTelemetry sync.Once
+var instanceTelemetry *TelemetryDAO
+
+func NewTelemetryDAO() *TelemetryDAO {
+	onceTelemetry.Do(func() {
+		logger.Debug("Telemetry DAO instance")
+		instanceTelemetry = &TelemetryDAO{
+			dbms: utils.GetEntClient(),
+		}
+	})
+
+	return instanceTelemetry
+}
+
+func (dao *TelemetryDAO) UpsertSample(ctx context.Context, sample TelemetrySampleDTO, resolution int) error {
+	bucketAt := sample.At.Truncate(time.Duration(resolution) * time.Second)
+	bucket, err := dao.dbms.GpuTelemetry.Query().
+		Where(
+			gputelemetry.GpuID(sample.GPUID),
+			gputelemetry.Resolution(resolution),
+			gputelemetry.BucketAt(bucketAt),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	point := TelemetryPointDTO{Time: bucketAt}
+	if bucket != nil {
+		point = *ConvertTelemetryEntToPointDTO(bucket)
+	}
+	point = FoldSample(point, sample)
+
+	if bucket != nil {
+		return bucket.Update().
+			SetSamples(point.Samples).
+			SetUtilizationGpu(point.UtilizationGPU).
+			SetUtilizationMemory(point.UtilizationMemory).
+			SetMemoryUsed(point.MemoryUsed).
+			SetMemoryTotal(point.MemoryTotal).
+			SetTemperature(point.Temperature).
+			SetPowerDraw(point.PowerDraw).
+			Exec(ctx)
+	}
+
+	return dao.dbms.GpuTelemetry.Create().
+		SetGpuID(sample.GPUID).
+		SetDeviceID(sample.DeviceID).
+		SetResolution(resolution).
+		SetBucketAt(bucketAt).
+		SetSamples(point.Samples).
+		SetUtilizationGpu(point.UtilizationGPU).
+		SetUtilizationMemory(point.UtilizationMemory).
+		SetMemoryUsed(point.MemoryUsed).
+		SetMemoryTotal(point.MemoryTotal).
+		SetTemperature(point.Temperature).
+		SetPowerDraw(point.PowerDraw).
+		Exec(ctx)
+}
+
+func (dao *TelemetryDAO) SelectRange(ctx context.Context, query TelemetryQueryDTO) ([]*ent.GpuTelemetry, error) {
+	logger.Debug(fmt.Sprintf("%+v", query))
+	q := dao.dbms.GpuTelemetry.Query().
+		Where(
+			gputelemetry.Resolution(query.Resolution),
+			gputelemetry.BucketAtGTE(query.From.Truncate(time.Duration(query.Resolution)*time.Second)),
+			gputelemetry.BucketAtLTE(query.To),
+		)
+	if query.GPUID > 0 {
+		q = q.Where(gputelemetry.GpuID(query.GPUID))
+	}
+	if query.DeviceID > 0 {
+		q = q.Where(gputelemetry.DeviceID(query.DeviceID))
+	}
+
+	return q.
+		Order(gputelemetry.ByGpuID(entsql.OrderAsc()), gputelemetry.ByBucketAt(entsql.OrderAsc())).
+		All(ctx)
+}
+
+func (dao *TelemetryDAO) DeleteBefore(ctx context.Context, resolution int, before time.Time) (int, error) {
+	logger.Debug(fmt.Sprintf(`{"resolution": %d, "before": "%s"}`, resolution, before))
+	return dao.dbms.GpuTelemetry.Delete().
+		Where(
+			gputelemetry.Resolution(resolution),
+			gputelemetry.BucketAtLT(before),
+		).
+		Exec(ctx)
+}
+
+func (dao *TelemetryDAO) SelectModelingsInRange(ctx context.Context, from, to time.Time) ([]*GPUModelingDTO, error) {
+	logger.Debug(fmt.Sprintf(`{"from": "%s", "to": "%s"}`, from, to))
+	rows, err := dao.dbms.QueryContext(
+		ctx,
+		`SELECT M.id, M.task_id, M.modeling_step, M.started_at, M.updated_at, (M.params->>0)::jsonb->'device_ids'
+		FROM modeling M
+		WHERE M.started_at IS NOT NULL AND M.started_at <= $1
+			AND (M.updated_at >= $2 OR M.modeling_step = $3)
+		ORDER BY M.started_at ASC;
+		`,
+		to, from, utils.MODELING_STEP_RUN,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	modelings := []*GPUModelingDTO{}
+	for rows.Next() {
+		row := GPUModelingDTO{}
+		updatedAt := time.Time{}
+		deviceIDs := sql.NullString{}
+		if err := rows.Scan(&row.ModelingID, &row.TaskID, &row.ModelingStep, &row.StartedAt, &updatedAt, &deviceIDs); err != nil {
+			logger.Debug(err)
+			continue
+		}
+		if deviceIDs.Valid {
+			_ = json.Unmarshal([]byte(deviceIDs.String), &row.GPUIDs)
+		}
+		if row.ModelingStep != utils.MODELING_STEP_RUN {
+			row.EndedAt = &updatedAt
+		}
+		modelings = append(modelings, &row)
+	}
+
+	return modelings, nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"time"
+
+	"api_server/ent"
+)
+
+// TelemetrySampleDTO는 /api/sys 응답 한 번에서 얻은 GPU 하나의 값입니다.
+type TelemetrySampleDTO struct {
+	GPUID             int       `json:"gpu_id"`
+	DeviceID          int       `json:"device_id"`
+	UtilizationGPU    float64   `json:"utilization_gpu"`
+	UtilizationMemory float64   `json:"utilization_memory"`
+	MemoryUsed        float64   `json:"memory_used"`
+	MemoryTotal       float64   `json:"memory_total"`
+	Temperature       float64   `json:"temperature"`
+	PowerDraw         float64   `json:"power_draw"`
+	At                time.Time `json:"at"`
+}
+
+// TelemetryQueryDTO는 telemetry 조회 조건입니다. 0이나 빈 값은 조건에서 제외합니다.
+type TelemetryQueryDTO struct {
+	GPUID      int       `form:"gpu_id"`
+	DeviceID   int       `form:"device_id"`
+	From       time.Time `form:"from"`
+	To         time.Time `form:"to"`
+	Resolution int       `form:"resolution"` // 초, 0이면 조회 기간에 맞춰 선택
+}
+
+// TelemetrySeriesDTO는 GPU 하나의 시계열과 그 기간에 GPU를 사용한 modeling입니다.
+type TelemetrySeriesDTO struct {
+	GPUID      int                  `json:"gpu_id"`
+	DeviceID   int                  `json:"device_id"`
+	Index      int                  `json:"index"`
+	Name       string               `json:"name"`
+	Resolution int                  `json:"resolution"`
+	Points     []*TelemetryPointDTO `json:"points"`
+	Modelings  []*GPUModelingDTO    `json:"modelings"`
+}
+
+// TelemetryPointDTO는 resolution 구간 하나의 평균 값입니다.
+type TelemetryPointDTO struct {
+	Time              time.Time `json:"time"`
+	Samples           int       `json:"samples"`
+	UtilizationGPU    float64   `json:"utilization_gpu"`
+	UtilizationMemory float64   `json:"utilization_memory"`
+	MemoryUsed        float64   `json:"memory_used"`
+	MemoryTotal       float64   `json:"memory_total"`
+	Temperature       float64   `json:"temperature"`
+	PowerDraw         float64   `json:"power_draw"`
+}
+
+// GPUModelingDTO는 조회 기간에 실행된 modeling과 배치된 GPU입니다.
+// EndedAt은 modeling이 마지막으로 갱신된 시각이며, 실행 중이면 nil입니다.
+type GPUModelingDTO struct {
+	ModelingID   int        `json:"modeling_id"`
+	TaskID       int        `json:"task_id"`
+	ModelingStep string     `json:"modeling_step"`
+	StartedAt    time.Time  `json:"started_at"`
+	EndedAt      *time.Time `json:"ended_at"`
+	GPUIDs       []int      `json:"-"`
+}
+
+func ConvertTelemetryEntToPointDTO(entity *ent.GpuTelemetry) *TelemetryPointDTO {
+	return &TelemetryPointDTO{
+		Time:              entity.BucketAt,
+		Samples:           entity.Samples,
+		UtilizationGPU:    entity.UtilizationGpu,
+		UtilizationMemory: entity.UtilizationMemory,
+		MemoryUsed:        entity.MemoryUsed,
+		MemoryTotal:       entity.MemoryTotal,
+		Temperature:       entity.Temperature,
+		PowerDraw:         entity.PowerDraw,
+	}
+}
+
+// FoldSample은 구간 평균 point에 sample 하나를 더한 평균을 반환합니다.
+func FoldSample(point TelemetryPointDTO, sample TelemetrySampleDTO) TelemetryPointDTO {
+	n := float64(point.Samples)
+	avg := func(a, v float64) float64 { return (a*n + v) / (n + 1) }
+
+	return TelemetryPointDTO{
+		Time:              point.Time,
+		Samples:           point.Samples + 1,
+		UtilizationGPU:    avg(point.UtilizationGPU, sample.UtilizationGPU),
+		UtilizationMemory: avg(point.UtilizationMemory, sample.UtilizationMemory),
+		MemoryUsed:        avg(point.MemoryUsed, sample.MemoryUsed),
+		MemoryTotal:       avg(point.MemoryTotal, sample.MemoryTotal),
+		Temperature:       avg(point.Temperature, sample.Temperature),
+		PowerDraw:         avg(point.PowerDraw, sample.PowerDraw),
+	}
+}
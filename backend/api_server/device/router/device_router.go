@@ -22,12 +22,42 @@ func InitRouter(r *gin.Engine) {
 		apiRouter.DELETE("/:device_id", controller.DeleteById)
 	}
 
+	controller_enroll := NewEnroll(service.NewEnrollService(repository.NewDeviceTokenDAO(), repo))
+
+	apiRouterEnroll := r.Group(utils.API_BASE_URL_V1 + "/device")
+	{
+		// engine이 enrollment token으로 호출
+		apiRouterEnroll.POST("/register", controller_enroll.Register)
+		apiRouterEnroll.POST("/heartbeat", controller_enroll.Heartbeat)
+		// 관리자
+		apiRouterEnroll.GET("/token", utils.JWTAuthMiddleware(), utils.GroupMiddleware(0, 1), controller_enroll.GetTokens)
+		apiRouterEnroll.POST("/token", utils.JWTAuthMiddleware(), utils.GroupMiddleware(0, 1), controller_enroll.CreateToken)
+		apiRouterEnroll.DELETE("/token/:id", utils.JWTAuthMiddleware(), utils.GroupMiddleware(0, 1), controller_enroll.RevokeToken)
+	}
+
 	repo_gpu := repository.NewGPUDAO()
+
+	controller_drain := NewDrain(service.NewDrainService(repo, repo_gpu, repository.NewMaintenanceDAO()))
+
+	apiRouterDrain := r.Group(utils.API_BASE_URL_V1+"/device", utils.JWTAuthMiddleware(), utils.GroupMiddleware(0, 1))
+	{
+		apiRouterDrain.GET("/:device_id/drain", controller_drain.GetStatus)
+		apiRouterDrain.POST("/:device_id/drain", controller_drain.Drain)
+		apiRouterDrain.DELETE("/:device_id/drain", controller_drain.Undrain)
+		apiRouterDrain.GET("/maintenance", controller_drain.GetWindows)
+		apiRouterDrain.POST("/maintenance", controller_drain.CreateWindow)
+		apiRouterDrain.DELETE("/maintenance/:id", controller_drain.DeleteWindow)
+	}
+
 	svc_gpu := service.NewGPUService(repo_gpu)
 	controller_gpu := NewGPU(svc_gpu)
 
+	controller_telemetry := NewTelemetry(service.NewTelemetryService(repository.NewTelemetryDAO(), repo_gpu))
+
 	apiRouterGPU := r.Group(utils.API_BASE_URL_V1 + "/gpu")
 	{
 		apiRouterGPU.GET("", controller_gpu.GetAll)
+		apiRouterGPU.GET("/telemetry", controller_telemetry.GetHistory)
+		apiRouterGPU.GET("/telemetry/latest", controller_telemetry.GetLatest)
 	}
 }
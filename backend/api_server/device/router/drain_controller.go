@@ -0,0 +1,128 @@
+package router
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	repo "api_server/device/repository"
+	"api_server/device/service"
+	"api_server/logger"
+	"api_server/utils"
+)
+
+type DrainController struct {
+	svc service.IDrainService
+}
+
+var onceDrain sync.Once
+var instanceDrain *DrainController
+
+func NewDrain(svc service.IDrainService) *DrainController {
+	onceDrain.Do(func() {
+		logger.Debug("Drain Controller instance")
+		instanceDrain = &DrainController{
+			svc: svc,
+		}
+	})
+
+	return instanceDrain
+}
+
+func (ctlr *DrainController) Drain(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	device_id, err := strconv.Atoi(c.Param("device_id"))
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+	// body가 없으면 사유 없이 모델을 내린다.
+	reqDTO := repo.DrainRequestDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil && !errors.Is(err, io.EOF) {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+
+	data, report := ctlr.svc.Drain(device_id, reqDTO)
+	logger.ApiResponse(c, report, data)
+}
+
+func (ctlr *DrainController) GetStatus(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if device_id, err := strconv.Atoi(c.Param("device_id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.ReadStatus(device_id)
+		logger.ApiResponse(c, report, data)
+	}
+}
+
+func (ctlr *DrainController) Undrain(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if device_id, err := strconv.Atoi(c.Param("device_id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.Undrain(device_id)
+		logger.ApiResponse(c, report, data)
+	}
+}
+
+func (ctlr *DrainController) GetWindows(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	device_id := 0
+	if param := c.Query("device_id"); param != "" {
+		id, err := strconv.Atoi(param)
+		if err != nil {
+			r := logger.CreateReport(&logger.CODE_REQUEST, err)
+			logger.ApiResponse(c, r, nil)
+			return
+		}
+		device_id = id
+	}
+
+	data, report := ctlr.svc.ReadWindows(device_id)
+	logger.ApiResponse(c, report, data)
+}
+
+func (ctlr *DrainController) CreateWindow(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	ctxData, err := utils.GetDataFromToken(c)
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+	reqDTO := repo.MaintenanceWindowDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+
+	data, report := ctlr.svc.CreateWindow(reqDTO, ctxData.Username)
+	logger.ApiResponse(c, report, data)
+}
+
+func (ctlr *DrainController) DeleteWindow(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.DeleteWindow(id)
+		logger.ApiResponse(c, report, data)
+	}
+}
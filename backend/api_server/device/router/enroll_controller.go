@@ -0,0 +1,126 @@
+package router
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	repo "api_server/device/repository"
+	"api_server/device/service"
+	"api_server/logger"
+	"api_server/utils"
+)
+
+type EnrollController struct {
+	svc service.IEnrollService
+}
+
+var onceEnroll sync.Once
+var instanceEnroll *EnrollController
+
+func NewEnroll(svc service.IEnrollService) *EnrollController {
+	onceEnroll.Do(func() {
+		logger.Debug("Enroll Controller instance")
+		instanceEnroll = &EnrollController{
+			svc: svc,
+		}
+	})
+
+	return instanceEnroll
+}
+
+// DeviceTokenMiddleware는 engine이 enrollment token으로 보낸 요청만 허용하는 미들웨어입니다.
+// 등록된 token이면 token에 연결된 Device ID를 컨텍스트의 "device_id"에 저장하고, 그 밖에는 요청을 거부합니다 (401).
+func DeviceTokenMiddleware() gin.HandlerFunc {
+	svc := service.NewEnrollService(repo.NewDeviceTokenDAO(), repo.New())
+
+	return func(c *gin.Context) {
+		device_id, report := svc.Authenticate(deviceToken(c))
+		if report != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid device token"})
+			c.Abort()
+			return
+		}
+
+		c.Set("device_id", device_id)
+		c.Next()
+	}
+}
+
+// deviceToken은 "Authorization: Bearer <token>" 헤더의 enrollment token입니다.
+func deviceToken(c *gin.Context) string {
+	return strings.TrimSpace(strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer "))
+}
+
+func (ctlr *EnrollController) Register(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	reqDTO := repo.DeviceRegisterDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+	if reqDTO.IP == "" {
+		reqDTO.IP = c.ClientIP()
+	}
+
+	data, report := ctlr.svc.Register(deviceToken(c), reqDTO)
+	logger.ApiResponse(c, report, data)
+}
+
+func (ctlr *EnrollController) Heartbeat(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	reqDTO := repo.DeviceHeartbeatDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+
+	data, report := ctlr.svc.Heartbeat(deviceToken(c), reqDTO)
+	logger.ApiResponse(c, report, data)
+}
+
+func (ctlr *EnrollController) CreateToken(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	ctxData, err := utils.GetDataFromToken(c)
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+	reqDTO := repo.DeviceTokenDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+
+	data, report := ctlr.svc.CreateToken(reqDTO, ctxData.Username)
+	logger.ApiResponse(c, report, data)
+}
+
+func (ctlr *EnrollController) GetTokens(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	data, report := ctlr.svc.ReadTokens()
+	logger.ApiResponse(c, report, data)
+}
+
+func (ctlr *EnrollController) RevokeToken(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.RevokeToken(id)
+		logger.ApiResponse(c, report, data)
+	}
+}
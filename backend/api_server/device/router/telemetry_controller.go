@@ -0,0 +1,50 @@
+package router
+
+import (
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	repo "api_server/device/repository"
+	"api_server/device/service"
+	"api_server/logger"
+)
+
+type TelemetryController struct {
+	svc service.ITelemetryService
+}
+
+var onceTelemetry sync.Once
+var instanceTelemetry *TelemetryController
+
+func NewTelemetry(svc service.ITelemetryService) *TelemetryController {
+	onceTelemetry.Do(func() {
+		logger.Debug("Telemetry Controller instance")
+		instanceTelemetry = &TelemetryController{
+			svc: svc,
+		}
+	})
+
+	return instanceTelemetry
+}
+
+func (ctlr *TelemetryController) GetHistory(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	query := repo.TelemetryQueryDTO{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+
+	data, report := ctlr.svc.ReadHistory(query)
+	logger.ApiResponse(c, report, data)
+}
+
+func (ctlr *TelemetryController) GetLatest(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	data := ctlr.svc.ReadLatest()
+	logger.ApiResponse(c, nil, data)
+}
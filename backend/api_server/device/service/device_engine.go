@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	repo "api_server/device/repository"
+	engine_client "api_server/engine/client"
+	"api_server/logger"
+	"api_server/utils"
+)
+
+// DeviceEngine은 device의 connection(gRPC 또는 REST)에 맞는 engine client를 만듭니다.
+// 모델 로딩, 해제, 추론은 모델을 올린 GPU가 설치된 device의 engine으로 보내야 합니다.
+func DeviceEngine(device_id int) (engine_client.Engine, *logger.Report) {
+	device, err := repo.New().SelectOne(context.Background(), device_id)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, fmt.Errorf("device %d: %w", device_id, err))
+	}
+
+	return engine_client.ForDevice(device.IP, device.Port, device.Connection, engine_client.DefaultTimeout), nil
+}
+
+// RESTEngine은 REST engine에만 있는 API(dataset 분석, tabular 예측과 LIME 등)를 보낼 engine입니다.
+// 사용 중이고 응답하는 REST device를 쓰며, 그런 device가 없으면 INFERENCE_ENGINE_ADDR의 engine을 사용합니다.
+func RESTEngine() *engine_client.Client {
+	devices, err := repo.New().SelectActive(context.Background())
+	if err != nil {
+		logger.Warn(fmt.Sprintf("failed to select devices for REST engine API, using INFERENCE_ENGINE_ADDR: %v", err))
+		return engine_client.NewInference()
+	}
+
+	for _, device := range devices {
+		if device.Connection != utils.DEVICE_CONNECTION_GRPC && device.Available == "true" {
+			return engine_client.NewDevice(device.IP, device.Port)
+		}
+	}
+
+	return engine_client.NewInference()
+}
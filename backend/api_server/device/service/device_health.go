@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	config_service "api_server/configuration/service"
+	repo "api_server/device/repository"
+	engine_client "api_server/engine/client"
+	"api_server/ent"
+	"api_server/logger"
+	"api_server/utils"
+)
+
+type IHealthChecker interface {
+	// Check 함수는 사용 중인 device의 engine에 /api/sys(gRPC engine은 Status)를 요청해 상태를 확인합니다.
+	// 응답한 device는 GPU 정보를 갱신하고, DEVICE_HEALTH_FAILURES번 연속 응답하지 않은 device는
+	// offline으로 바꾸고 GPU를 사용하지 않도록 해 scheduler가 배치하지 않게 합니다.
+	// 스스로 등록한 engine은 요청하지 않고, 마지막 heartbeat가 DEVICE_HEARTBEAT_TIMEOUT(초)보다 오래되면 offline으로 바꿉니다.
+	Check()
+
+	// WatchDevices 함수는 DEVICE_HEALTH_INTERVAL(초)마다 Check를 실행합니다.
+	WatchDevices()
+}
+
+type HealthChecker struct {
+	ctx      context.Context
+	dao      repo.IDeviceDAO
+	dao_gpu  repo.IGPUDAO
+	mu       sync.Mutex
+	failures map[int]int // device ID별 연속 실패 횟수
+}
+
+var onceHealth sync.Once
+var instanceHealth *HealthChecker
+
+func NewHealthChecker(dao repo.IDeviceDAO, dao_gpu repo.IGPUDAO) *HealthChecker {
+	onceHealth.Do(func() {
+		logger.Debug("Device health checker instance")
+		instanceHealth = &HealthChecker{
+			ctx:      context.Background(),
+			dao:      dao,
+			dao_gpu:  dao_gpu,
+			failures: make(map[int]int),
+		}
+	})
+
+	return instanceHealth
+}
+
+func (checker *HealthChecker) WatchDevices() {
+	interval := config_service.NewStatic().GetInt("DEVICE_HEALTH_INTERVAL", 30)
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		checker.Check()
+	}
+}
+
+func (checker *HealthChecker) Check() {
+	devices, err := checker.dao.SelectActive(checker.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	cf := config_service.NewStatic()
+	timeout := time.Duration(cf.GetInt("DEVICE_HEALTH_TIMEOUT", int(engine_client.ProbeTimeout/time.Second))) * time.Second
+	threshold := cf.GetInt("DEVICE_HEALTH_FAILURES", 2)
+	heartbeatTimeout := time.Duration(cf.GetInt("DEVICE_HEARTBEAT_TIMEOUT", 90)) * time.Second
+	param := getAPIParam()
+
+	var wg sync.WaitGroup
+	for _, device := range devices {
+		if device.HeartbeatAt != nil {
+			checker.expire(device, time.Now(), heartbeatTimeout)
+			continue
+		}
+		wg.Add(1)
+		go func(device *ent.Device) {
+			defer wg.Done()
+			checker.probe(device, param, timeout, threshold)
+		}(device)
+	}
+	wg.Wait()
+}
+
+// probe는 device 하나를 확인하고 결과를 반영합니다.
+func (checker *HealthChecker) probe(device *ent.Device, param engine_client.SysRequest, timeout time.Duration, threshold int) {
+	engine := engine_client.ForDevice(device.IP, device.Port, device.Connection, timeout)
+	engineInfo, err := engine.Sys(checker.ctx, param)
+	reachable := err == nil
+
+	checker.mu.Lock()
+	if reachable {
+		delete(checker.failures, device.ID)
+	} else {
+		checker.failures[device.ID]++
+	}
+	failures := checker.failures[device.ID]
+	checker.mu.Unlock()
+
+	if reachable {
+		updateGPUInfo(engineInfo, device.ID)
+	} else {
+		logger.Debug(fmt.Sprintf("device %d (%s:%d) health check failed %d times: %v", device.ID, device.IP, device.Port, failures, err))
+	}
+
+	available, changed := healthTransition(device.Available, reachable, failures, threshold)
+	checker.apply(device, available, changed, err)
+}
+
+// expire는 스스로 등록한 engine의 heartbeat가 timeout보다 오래되었으면 offline으로 바꿉니다.
+// online으로 바뀌는 것은 heartbeat를 받을 때 처리합니다.
+func (checker *HealthChecker) expire(device *ent.Device, now time.Time, timeout time.Duration) {
+	if now.Sub(*device.HeartbeatAt) <= timeout {
+		return
+	}
+
+	was, _ := strconv.ParseBool(device.Available)
+	checker.apply(device, false, was, fmt.Errorf("no heartbeat since %s", device.HeartbeatAt.Format(time.RFC3339)))
+}
+
+// apply는 확인 결과를 반영합니다. offline이면 GPU를 사용하지 않도록 하고, 상태가 바뀌었으면 이벤트를 발행합니다.
+func (checker *HealthChecker) apply(device *ent.Device, available bool, changed bool, cause error) {
+	if !available {
+		// 이전에 offline이었더라도 그 사이 다시 사용 처리된 GPU가 없도록 매번 정리한다.
+		if err := checker.dao_gpu.UpdateDisUseByDevice(checker.ctx, device.ID, nil); err != nil {
+			logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+	}
+	if !changed {
+		return
+	}
+
+	if err := checker.dao.UpdateAvailable(checker.ctx, device.ID, available); err != nil {
+		logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		return
+	}
+	if available {
+		logger.Info(fmt.Sprintf("device %d (%s:%d) is online", device.ID, device.IP, device.Port))
+		utils.NewNotifier().Publish(utils.EVENT_DEVICE_ONLINE, device.ID)
+	} else {
+		logger.Warn(fmt.Sprintf("device %d (%s:%d) is offline: %v", device.ID, device.IP, device.Port, cause))
+		utils.NewNotifier().Publish(utils.EVENT_DEVICE_OFFLINE, device.ID)
+	}
+}
+
+// healthTransition은 health check 결과로 device의 다음 available 값과 변경 여부를 정합니다.
+// 응답하면 바로 online이 되고, 응답하지 않으면 threshold번 연속 실패해야 offline이 됩니다.
+func healthTransition(current string, reachable bool, failures int, threshold int) (bool, bool) {
+	was, _ := strconv.ParseBool(current)
+	if reachable {
+		return true, !was
+	}
+	if failures < threshold {
+		return was, false
+	}
+
+	return false, was
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type HealthTestSuite struct {
+	suite.Suite
+}
+
+func (suite *HealthTestSuite) TestOnline() {
+	available, changed := healthTransition("false", true, 0, 2)
+	suite.True(available)
+	suite.True(changed)
+
+	available, changed = healthTransition("true", true, 0, 2)
+	suite.True(available)
+	suite.False(changed)
+}
+
+func (suite *HealthTestSuite) TestOffline() {
+	// 한 번 응답하지 않은 것으로는 offline이 되지 않는다.
+	available, changed := healthTransition("true", false, 1, 2)
+	suite.True(available)
+	suite.False(changed)
+
+	available, changed = healthTransition("true", false, 2, 2)
+	suite.False(available)
+	suite.True(changed)
+
+	available, changed = healthTransition("false", false, 3, 2)
+	suite.False(available)
+	suite.False(changed)
+}
+
+func TestHealthTestSuite(t *testing.T) {
+	suite.Run(t, new(HealthTestSuite))
+}
@@ -7,6 +7,7 @@ import (
 
 	repo "api_server/device/repository"
 	"api_server/logger"
+	"api_server/utils"
 )
 
 type IDeviceService interface {
@@ -39,6 +40,9 @@ func New(dao repo.IDeviceDAO) *DeviceService {
 }
 
 func (svc *DeviceService) Create(req repo.DeviceDTO) ([]*repo.DeviceDTO, *logger.Report) {
+	if err := checkConnection(req.Connection); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
+	}
 	device, err := svc.dao.SelectByIPAndPort(svc.ctx, req.IP, *req.Port)
 	if err != nil {
 		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
@@ -79,6 +83,9 @@ func (svc *DeviceService) ReadOne(id int) (*repo.DeviceDTO, *logger.Report) {
 
 func (svc *DeviceService) Edit(req repo.DeviceDTO) (*repo.DeviceDTO, *logger.Report) {
 	logger.Debug(fmt.Sprintf("%+v", req))
+	if err := checkConnection(req.Connection); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
+	}
 
 	exist, err := svc.dao.SelectByIPAndPort(svc.ctx, req.IP, *req.Port)
 	if err != nil {
@@ -94,6 +101,7 @@ func (svc *DeviceService) Edit(req repo.DeviceDTO) (*repo.DeviceDTO, *logger.Rep
 	if err != nil {
 		return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
 	}
+	utils.NewNotifier().Publish(utils.EVENT_DEVICE_UPDATED, device.ID)
 	return repo.ConvertEntToDTO(device), nil
 }
 
@@ -115,3 +123,13 @@ func (svc *DeviceService) DeleteOne(device_id int) ([]*repo.DeviceDTO, *logger.R
 		return svc.ReadAll()
 	}
 }
+
+// checkConnection은 device의 engine 호출 방법이 지원하는 값인지 확인합니다. 비어 있으면 기본값(rest)을 사용합니다.
+func checkConnection(connection string) error {
+	switch connection {
+	case "", utils.DEVICE_CONNECTION_REST, utils.DEVICE_CONNECTION_GRPC:
+		return nil
+	}
+
+	return fmt.Errorf("connection must be %s or %s: %s", utils.DEVICE_CONNECTION_REST, utils.DEVICE_CONNECTION_GRPC, connection)
+}
@@ -0,0 +1,477 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	config_service "api_server/configuration/service"
+	repo "api_server/device/repository"
+	"api_server/ent"
+	"api_server/logger"
+	"api_server/utils"
+)
+
+// IModelReleaser는 GPU에 추론 모델을 올리는 service입니다. device를 drain할 때 모델을 내리거나 옮기는 데 사용합니다.
+type IModelReleaser interface {
+	// CountModels 함수는 gpu_ids에 올라가 있는 추론 모델 수를 반환합니다.
+	CountModels(gpu_ids []int) int
+
+	// ReleaseModels 함수는 gpu_ids에 올라가 있는 추론 모델을 내립니다.
+	// migrate이면 drain 중이 아닌 device의 idle GPU에 같은 모델을 올린 뒤 내립니다.
+	//
+	// 매개변수:
+	//   - gpu_ids: 비울 GPU ID 목록
+	//   - migrate: 다른 GPU로 옮길지 여부
+	//
+	// 반환값:
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReleaseModels(gpu_ids []int, migrate bool) *logger.Report
+}
+
+var muReleaser sync.Mutex
+var modelReleasers []IModelReleaser
+
+// RegisterModelReleaser는 추론 모델을 관리하는 service를 등록합니다.
+// device/service를 import하는 service가 스스로 등록하므로 순환 import 없이 drain에서 모델을 내릴 수 있습니다.
+func RegisterModelReleaser(releaser IModelReleaser) {
+	muReleaser.Lock()
+	defer muReleaser.Unlock()
+
+	modelReleasers = append(modelReleasers, releaser)
+}
+
+func releasers() []IModelReleaser {
+	muReleaser.Lock()
+	defer muReleaser.Unlock()
+
+	return append([]IModelReleaser{}, modelReleasers...)
+}
+
+type IDrainService interface {
+	// Drain 함수는 device를 drain 상태로 바꿔 scheduler가 새 작업을 배치하지 않게 합니다.
+	// 실행 중인 modeling은 끝까지 실행하며, GPU에 올라간 추론 모델은 내리거나 다른 device로 옮깁니다.
+	// 이미 drain 중이면 상태는 그대로 두고 남은 모델만 다시 내립니다.
+	//
+	// 매개변수:
+	//   - device_id: drain할 Device ID
+	//   - req: 사유와 모델 이동 여부
+	//
+	// 반환값:
+	//   - *repo.DrainStatusDTO: drain 진행 상태
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Drain(device_id int, req repo.DrainRequestDTO) (*repo.DrainStatusDTO, *logger.Report)
+
+	// Undrain 함수는 device의 drain을 풀어 다시 작업을 배치하게 합니다.
+	//
+	// 매개변수:
+	//   - device_id: Device ID
+	//
+	// 반환값:
+	//   - *repo.DrainStatusDTO: drain 진행 상태
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Undrain(device_id int) (*repo.DrainStatusDTO, *logger.Report)
+
+	// ReadStatus 함수는 device의 drain 진행 상태를 조회합니다.
+	// drain 중이고 사용 중인 GPU와 올라간 추론 모델이 없으면 Drained가 true 입니다.
+	//
+	// 매개변수:
+	//   - device_id: Device ID
+	//
+	// 반환값:
+	//   - *repo.DrainStatusDTO: drain 진행 상태
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadStatus(device_id int) (*repo.DrainStatusDTO, *logger.Report)
+
+	// CreateWindow 함수는 starts_at부터 ends_at까지 device를 drain하는 maintenance window를 예약합니다.
+	// 같은 device의 끝나지 않은 window와 겹치면 등록하지 않습니다.
+	//
+	// 매개변수:
+	//   - req: Device ID, 시작/종료 시각, 사유, 모델 이동 여부
+	//   - username: 등록한 관리자
+	//
+	// 반환값:
+	//   - *repo.MaintenanceWindowDTO: 등록된 maintenance window
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	CreateWindow(req repo.MaintenanceWindowDTO, username string) (*repo.MaintenanceWindowDTO, *logger.Report)
+
+	// ReadWindows 함수는 maintenance window 목록을 조회합니다. device_id가 0이면 모든 device의 목록입니다.
+	ReadWindows(device_id int) ([]*repo.MaintenanceWindowDTO, *logger.Report)
+
+	// DeleteWindow 함수는 maintenance window를 삭제합니다. 진행 중인 window이면 그 window가 시작한 drain도 풉니다.
+	//
+	// 매개변수:
+	//   - id: maintenance window ID
+	//
+	// 반환값:
+	//   - *repo.MaintenanceWindowDTO: 삭제된 maintenance window
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	DeleteWindow(id int) (*repo.MaintenanceWindowDTO, *logger.Report)
+
+	// ApplyWindows 함수는 now 기준으로 시작할 window의 device를 drain하고, 끝난 window가 시작한 drain을 풉니다.
+	ApplyWindows(now time.Time)
+
+	// WatchMaintenance 함수는 DEVICE_MAINTENANCE_INTERVAL(초)마다 ApplyWindows를 실행하고,
+	// drain 중인 device가 모두 비면 로그를 남기고 EVENT_DEVICE_DRAINED를 발행합니다.
+	WatchMaintenance()
+}
+
+type DrainService struct {
+	ctx             context.Context
+	dao             repo.IDeviceDAO
+	dao_gpu         repo.IGPUDAO
+	dao_maintenance repo.IMaintenanceDAO
+	mu              sync.Mutex
+	drained         map[int]bool // drain이 끝났다고 알린 device ID
+}
+
+var onceDrain sync.Once
+var instanceDrain *DrainService
+
+func NewDrainService(dao repo.IDeviceDAO, dao_gpu repo.IGPUDAO, dao_maintenance repo.IMaintenanceDAO) *DrainService {
+	onceDrain.Do(func() {
+		logger.Debug("Drain Service instance")
+		instanceDrain = &DrainService{
+			ctx:             context.Background(),
+			dao:             dao,
+			dao_gpu:         dao_gpu,
+			dao_maintenance: dao_maintenance,
+			drained:         make(map[int]bool),
+		}
+	})
+
+	return instanceDrain
+}
+
+func (svc *DrainService) Drain(device_id int, req repo.DrainRequestDTO) (*repo.DrainStatusDTO, *logger.Report) {
+	device, r := svc.device(device_id)
+	if r != nil {
+		return nil, r
+	}
+
+	if !device.Drain {
+		if _, err := svc.dao.UpdateDrain(svc.ctx, device_id, true, req.Reason, 0); err != nil {
+			return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+		logger.Info(fmt.Sprintf("device %d (%s:%d) is draining: %s", device.ID, device.IP, device.Port, req.Reason))
+		utils.NewNotifier().Publish(utils.EVENT_DEVICE_UPDATED, device_id)
+	}
+	svc.release(device_id, req.Migrate)
+
+	return svc.ReadStatus(device_id)
+}
+
+func (svc *DrainService) Undrain(device_id int) (*repo.DrainStatusDTO, *logger.Report) {
+	device, r := svc.device(device_id)
+	if r != nil {
+		return nil, r
+	}
+
+	if device.Drain {
+		if r := svc.undrain(device); r != nil {
+			return nil, r
+		}
+	}
+
+	return svc.ReadStatus(device_id)
+}
+
+func (svc *DrainService) ReadStatus(device_id int) (*repo.DrainStatusDTO, *logger.Report) {
+	device, r := svc.device(device_id)
+	if r != nil {
+		return nil, r
+	}
+
+	gpus, err := svc.dao_gpu.SelectManyByDeviceID(svc.ctx, device_id)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	return drainStatus(device, gpus, countModels(gpus)), nil
+}
+
+func (svc *DrainService) CreateWindow(req repo.MaintenanceWindowDTO, username string) (*repo.MaintenanceWindowDTO, *logger.Report) {
+	if err := validateWindow(req, time.Now()); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_MAINTENANCE_INVALID, err)
+	}
+	if _, r := svc.device(req.DeviceID); r != nil {
+		return nil, r
+	}
+
+	overlaps, err := svc.dao_maintenance.SelectOverlap(svc.ctx, req.DeviceID, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	if len(overlaps) > 0 {
+		return nil, logger.CreateReport(&logger.CODE_MAINTENANCE_INVALID,
+			fmt.Errorf("overlaps maintenance window %d (%s ~ %s)", overlaps[0].ID,
+				overlaps[0].StartsAt.Format(time.RFC3339), overlaps[0].EndsAt.Format(time.RFC3339)))
+	}
+
+	req.CreatedBy = username
+	window, err := svc.dao_maintenance.InsertOne(svc.ctx, req)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_INSERT, err)
+	}
+
+	// 이미 시작 시각이 지났으면 다음 주기를 기다리지 않고 바로 drain한다.
+	svc.ApplyWindows(time.Now())
+
+	window, err = svc.dao_maintenance.SelectOne(svc.ctx, window.ID)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	return repo.ConvertMaintenanceEntToDTO(window), nil
+}
+
+func (svc *DrainService) ReadWindows(device_id int) ([]*repo.MaintenanceWindowDTO, *logger.Report) {
+	windows, err := svc.dao_maintenance.SelectAll(svc.ctx, device_id)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	return repo.ConvertMaintenanceEntsToDTOs(windows), nil
+}
+
+func (svc *DrainService) DeleteWindow(id int) (*repo.MaintenanceWindowDTO, *logger.Report) {
+	window, err := svc.dao_maintenance.SelectOne(svc.ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, logger.CreateReport(&logger.CODE_REQUEST, fmt.Errorf("maintenance window %d not found", id))
+	} else if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	if window.StartedAt != nil && window.EndedAt == nil {
+		if r := svc.endWindow(window); r != nil {
+			return nil, r
+		}
+	}
+	if err := svc.dao_maintenance.DeleteOne(svc.ctx, id); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_DELETE, err)
+	}
+
+	return repo.ConvertMaintenanceEntToDTO(window), nil
+}
+
+func (svc *DrainService) ApplyWindows(now time.Time) {
+	// 이어지는 window가 있으면 앞 window를 먼저 끝내야 다음 window가 drain을 시작한다.
+	expired, err := svc.dao_maintenance.SelectExpired(svc.ctx, now)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+	for _, window := range expired {
+		if window.StartedAt != nil {
+			if r := svc.endWindow(window); r != nil {
+				continue
+			}
+		}
+		if err := svc.dao_maintenance.UpdateEnded(svc.ctx, window.ID); err != nil {
+			logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+	}
+
+	due, err := svc.dao_maintenance.SelectDue(svc.ctx, now)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+	for _, window := range due {
+		svc.startWindow(window)
+	}
+}
+
+func (svc *DrainService) WatchMaintenance() {
+	events := utils.NewNotifier().Subscribe(16, utils.EVENT_GPU_IDLE)
+	interval := config_service.NewStatic().GetInt("DEVICE_MAINTENANCE_INTERVAL", 60)
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+
+	svc.ApplyWindows(time.Now())
+	svc.checkDrained()
+	for {
+		select {
+		case <-events:
+		case <-ticker.C:
+			svc.ApplyWindows(time.Now())
+		}
+		svc.checkDrained()
+	}
+}
+
+// startWindow는 window의 device를 drain합니다. 관리자가 이미 drain한 device는 drain을 시작한 주체를 바꾸지 않습니다.
+func (svc *DrainService) startWindow(window *ent.MaintenanceWindow) {
+	device, err := svc.dao.SelectOne(svc.ctx, window.DeviceID)
+	if ent.IsNotFound(err) {
+		// device가 삭제되었으면 window도 끝낸다.
+		if err := svc.dao_maintenance.UpdateEnded(svc.ctx, window.ID); err != nil {
+			logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+		return
+	} else if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	if !device.Drain {
+		reason := window.Reason
+		if reason == "" {
+			reason = fmt.Sprintf("maintenance window %d", window.ID)
+		}
+		if _, err := svc.dao.UpdateDrain(svc.ctx, device.ID, true, reason, window.ID); err != nil {
+			logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+			return
+		}
+		logger.Info(fmt.Sprintf("device %d (%s:%d) is draining for maintenance window %d until %s",
+			device.ID, device.IP, device.Port, window.ID, window.EndsAt.Format(time.RFC3339)))
+		utils.NewNotifier().Publish(utils.EVENT_DEVICE_UPDATED, device.ID)
+	}
+	svc.release(device.ID, window.Migrate)
+
+	if err := svc.dao_maintenance.UpdateStarted(svc.ctx, window.ID); err != nil {
+		logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+}
+
+// endWindow는 window가 시작한 drain을 풉니다. 관리자가 그 사이 drain을 풀었거나 직접 drain한 device는 그대로 둡니다.
+func (svc *DrainService) endWindow(window *ent.MaintenanceWindow) *logger.Report {
+	device, err := svc.dao.SelectOne(svc.ctx, window.DeviceID)
+	if ent.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	if !device.Drain || device.DrainWindow != window.ID {
+		return nil
+	}
+
+	return svc.undrain(device)
+}
+
+func (svc *DrainService) undrain(device *ent.Device) *logger.Report {
+	if _, err := svc.dao.UpdateDrain(svc.ctx, device.ID, false, "", 0); err != nil {
+		return logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+
+	svc.mu.Lock()
+	delete(svc.drained, device.ID)
+	svc.mu.Unlock()
+
+	logger.Info(fmt.Sprintf("device %d (%s:%d) is back in scheduling", device.ID, device.IP, device.Port))
+	utils.NewNotifier().Publish(utils.EVENT_DEVICE_UPDATED, device.ID)
+
+	return nil
+}
+
+// release는 등록된 service로 device GPU의 추론 모델을 내립니다. 실패한 모델은 상태 조회의 loaded_models에 남습니다.
+func (svc *DrainService) release(device_id int, migrate bool) {
+	gpus, err := svc.dao_gpu.SelectManyByDeviceID(svc.ctx, device_id)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	ids := gpuIDsOf(gpus)
+	for _, releaser := range releasers() {
+		if r := releaser.ReleaseModels(ids, migrate); r != nil {
+			logger.Warn(fmt.Sprintf("device %d: failed to release inference models: %v", device_id, r.Error))
+		}
+	}
+}
+
+// checkDrained는 drain 중인 device 중 새로 비워진 device를 알립니다.
+func (svc *DrainService) checkDrained() {
+	devices, err := svc.dao.SelectDraining(svc.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	for _, device := range devices {
+		gpus, err := svc.dao_gpu.SelectManyByDeviceID(svc.ctx, device.ID)
+		if err != nil {
+			logger.CreateReport(&logger.CODE_DB_SELECT, err)
+			continue
+		}
+		status := drainStatus(device, gpus, countModels(gpus))
+
+		svc.mu.Lock()
+		notified := svc.drained[device.ID]
+		svc.drained[device.ID] = status.Drained
+		svc.mu.Unlock()
+
+		if status.Drained && !notified {
+			logger.Info(fmt.Sprintf("device %d (%s:%d) is drained", device.ID, device.IP, device.Port))
+			utils.NewNotifier().Publish(utils.EVENT_DEVICE_DRAINED, device.ID)
+		}
+	}
+}
+
+func (svc *DrainService) device(device_id int) (*ent.Device, *logger.Report) {
+	device, err := svc.dao.SelectOne(svc.ctx, device_id)
+	if ent.IsNotFound(err) {
+		return nil, logger.CreateReport(&logger.CODE_REQUEST, fmt.Errorf("device %d not found", device_id))
+	} else if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	return device, nil
+}
+
+func gpuIDsOf(gpus []*ent.Gpu) []int {
+	ids := []int{}
+	for _, gpu := range gpus {
+		ids = append(ids, gpu.ID)
+	}
+
+	return ids
+}
+
+func countModels(gpus []*ent.Gpu) int {
+	ids := gpuIDsOf(gpus)
+	count := 0
+	for _, releaser := range releasers() {
+		count += releaser.CountModels(ids)
+	}
+
+	return count
+}
+
+// drainStatus는 device의 drain 진행 상태를 계산합니다. idle이 아닌 GPU는 아직 작업이 실행 중인 GPU 입니다.
+func drainStatus(device *ent.Device, gpus []*ent.Gpu, loaded int) *repo.DrainStatusDTO {
+	status := &repo.DrainStatusDTO{
+		DeviceID:     device.ID,
+		Drain:        device.Drain,
+		Reason:       device.DrainReason,
+		DrainAt:      device.DrainAt,
+		WindowID:     device.DrainWindow,
+		BusyGPUs:     []int{},
+		LoadedModels: loaded,
+	}
+	for _, gpu := range gpus {
+		if gpu.State != utils.GPU_STATE_IDLE {
+			status.BusyGPUs = append(status.BusyGPUs, gpu.ID)
+		}
+	}
+	status.Drained = device.Drain && len(status.BusyGPUs) == 0 && loaded == 0
+
+	return status
+}
+
+// validateWindow는 maintenance window의 device와 시각을 확인합니다.
+func validateWindow(req repo.MaintenanceWindowDTO, now time.Time) error {
+	if req.DeviceID < 1 {
+		return errors.New("device_id is required")
+	}
+	if !req.EndsAt.After(req.StartsAt) {
+		return errors.New("ends_at must be after starts_at")
+	}
+	if !req.EndsAt.After(now) {
+		return errors.New("ends_at has already passed")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	repo "api_server/device/repository"
+	"api_server/ent"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DrainTestSuite struct {
+	suite.Suite
+}
+
+func (suite *DrainTestSuite) TestDrained() {
+	device := &ent.Device{ID: 1, Drain: true}
+	gpus := []*ent.Gpu{
+		{ID: 1, State: utils.GPU_STATE_IDLE},
+		{ID: 2, State: utils.GPU_STATE_MODEING},
+	}
+
+	// 실행 중인 modeling이 있으면 아직 비워지지 않았다.
+	status := drainStatus(device, gpus, 0)
+	suite.False(status.Drained)
+	suite.Equal([]int{2}, status.BusyGPUs)
+
+	gpus[1].State = utils.GPU_STATE_IDLE
+	suite.False(drainStatus(device, gpus, 1).Drained)
+	suite.True(drainStatus(device, gpus, 0).Drained)
+
+	// drain 중이 아니면 비어 있어도 drained가 아니다.
+	suite.False(drainStatus(&ent.Device{ID: 1}, gpus, 0).Drained)
+}
+
+func (suite *DrainTestSuite) TestValidateWindow() {
+	now := time.Now()
+
+	suite.NoError(validateWindow(repo.MaintenanceWindowDTO{DeviceID: 1, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)}, now))
+	suite.Error(validateWindow(repo.MaintenanceWindowDTO{StartsAt: now, EndsAt: now.Add(time.Hour)}, now))
+	suite.Error(validateWindow(repo.MaintenanceWindowDTO{DeviceID: 1, StartsAt: now.Add(time.Hour), EndsAt: now}, now))
+	suite.Error(validateWindow(repo.MaintenanceWindowDTO{DeviceID: 1, StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour)}, now))
+}
+
+func TestDrainTestSuite(t *testing.T) {
+	suite.Run(t, new(DrainTestSuite))
+}
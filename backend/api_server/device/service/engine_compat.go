@@ -0,0 +1,83 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	config_service "api_server/configuration/service"
+	"api_server/ent/schema"
+)
+
+// device의 engine이 요청한 engine type을 실행할 수 없는 경우
+var (
+	ErrEngineUnsupported = errors.New("engine type not supported")
+	ErrEngineVersion     = errors.New("engine version not compatible")
+)
+
+// CheckEngine은 capability를 보고한 engine이 engineType을 실행할 수 있는지 확인합니다.
+// engine type을 보고하지 않은 engine은 모든 engine type을 실행할 수 있다고 보며,
+// ENGINE_MIN_VERSION이 설정되어 있으면 engine build version이 그 이상이어야 합니다.
+func CheckEngine(capabilities *schema.DeviceCapabilities, engineType string) error {
+	return checkEngine(capabilities, engineType, config_service.NewStatic().Get("ENGINE_MIN_VERSION"))
+}
+
+func checkEngine(capabilities *schema.DeviceCapabilities, engineType string, minVersion string) error {
+	if capabilities == nil {
+		capabilities = &schema.DeviceCapabilities{}
+	}
+
+	if engineType != "" && len(capabilities.EngineTypes) > 0 && !slices.Contains(capabilities.EngineTypes, engineType) {
+		return fmt.Errorf("%w: %s (engine supports %s)", ErrEngineUnsupported, engineType, strings.Join(capabilities.EngineTypes, ", "))
+	}
+
+	if minVersion == "" {
+		return nil
+	}
+	if capabilities.EngineVersion == "" {
+		return fmt.Errorf("%w: engine did not report its version, %s or later is required", ErrEngineVersion, minVersion)
+	}
+	if compareVersion(capabilities.EngineVersion, minVersion) < 0 {
+		return fmt.Errorf("%w: engine %s, %s or later is required", ErrEngineVersion, capabilities.EngineVersion, minVersion)
+	}
+
+	return nil
+}
+
+// compareVersion은 "v1.2.3" 형식의 version을 비교합니다. a가 낮으면 음수, 같으면 0, 높으면 양수입니다.
+// "-"나 "+" 뒤의 pre-release, build 정보는 비교하지 않고, 없는 자리는 0으로 봅니다.
+func compareVersion(a, b string) int {
+	as, bs := versionParts(a), versionParts(b)
+	for i := 0; i < max(len(as), len(bs)); i++ {
+		x, y := "0", "0"
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+
+		xn, xerr := strconv.Atoi(x)
+		yn, yerr := strconv.Atoi(y)
+		if xerr == nil && yerr == nil {
+			if xn != yn {
+				return xn - yn
+			}
+		} else if c := strings.Compare(x, y); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+func versionParts(version string) []string {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+
+	return strings.Split(version, ".")
+}
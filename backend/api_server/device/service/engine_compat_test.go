@@ -0,0 +1,45 @@
+package service
+
+import (
+	"testing"
+
+	"api_server/ent/schema"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EngineCompatTestSuite struct {
+	suite.Suite
+}
+
+func (suite *EngineCompatTestSuite) TestEngineType() {
+	tabular := &schema.DeviceCapabilities{EngineTypes: []string{utils.JOB_TYPE_TABLE_CLS}}
+
+	suite.NoError(checkEngine(tabular, utils.JOB_TYPE_TABLE_CLS, ""))
+	suite.ErrorIs(checkEngine(tabular, utils.JOB_TYPE_VISION_AD, ""), ErrEngineUnsupported)
+
+	// engine type을 보고하지 않은 engine은 모든 engine type을 실행한다.
+	suite.NoError(checkEngine(nil, utils.JOB_TYPE_VISION_AD, ""))
+	suite.NoError(checkEngine(&schema.DeviceCapabilities{EngineVersion: "2.0.0"}, utils.JOB_TYPE_VISION_AD, ""))
+}
+
+func (suite *EngineCompatTestSuite) TestEngineVersion() {
+	caps := &schema.DeviceCapabilities{EngineTypes: []string{utils.JOB_TYPE_VISION_CLS_SL}, EngineVersion: "v2.1.0"}
+
+	suite.NoError(checkEngine(caps, utils.JOB_TYPE_VISION_CLS_SL, "2.1"))
+	suite.NoError(checkEngine(caps, utils.JOB_TYPE_VISION_CLS_SL, "2.0.9"))
+	suite.ErrorIs(checkEngine(caps, utils.JOB_TYPE_VISION_CLS_SL, "2.10.0"), ErrEngineVersion)
+	suite.ErrorIs(checkEngine(nil, utils.JOB_TYPE_VISION_CLS_SL, "1.0.0"), ErrEngineVersion)
+}
+
+func (suite *EngineCompatTestSuite) TestCompareVersion() {
+	suite.Zero(compareVersion("1.2.0", "v1.2"))
+	suite.Zero(compareVersion("1.2.0-rc1", "1.2.0+build5"))
+	suite.Negative(compareVersion("1.9.3", "1.10.0"))
+	suite.Positive(compareVersion("2.0.0", "1.99.99"))
+}
+
+func TestEngineCompatTestSuite(t *testing.T) {
+	suite.Run(t, new(EngineCompatTestSuite))
+}
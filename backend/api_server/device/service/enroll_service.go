@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	config_service "api_server/configuration/service"
+	repo "api_server/device/repository"
+	"api_server/ent"
+	"api_server/logger"
+	"api_server/utils"
+)
+
+type IEnrollService interface {
+	// CreateToken 함수는 engine이 스스로 등록할 때 쓸 enrollment token을 발급합니다.
+	// token 값은 응답에서만 확인할 수 있고 DB에는 hash만 저장됩니다.
+	//
+	// 매개변수:
+	//   - req: token 이름과 미리 지정할 Device ID(0이면 첫 등록 시 결정)
+	//   - username: 발급한 관리자
+	//
+	// 반환값:
+	//   - *repo.DeviceTokenDTO: token 값을 포함한 발급 결과
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	CreateToken(req repo.DeviceTokenDTO, username string) (*repo.DeviceTokenDTO, *logger.Report)
+
+	// ReadTokens 함수는 발급한 token 목록을 조회합니다. token 값은 포함하지 않습니다.
+	ReadTokens() ([]*repo.DeviceTokenDTO, *logger.Report)
+
+	// RevokeToken 함수는 token을 폐기합니다. 이후 그 token으로 보낸 heartbeat는 거부되어
+	// DEVICE_HEARTBEAT_TIMEOUT이 지나면 device가 offline으로 바뀝니다.
+	//
+	// 매개변수:
+	//   - id: 폐기할 token ID
+	//
+	// 반환값:
+	//   - *repo.DeviceTokenDTO: 폐기된 token
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	RevokeToken(id int) (*repo.DeviceTokenDTO, *logger.Report)
+
+	// Register 함수는 engine이 보낸 정보로 Device와 GPU를 만들거나 변경합니다.
+	// token에 Device가 지정되어 있지 않으면 같은 IP, port의 Device를 찾고, 없으면 새로 만든 뒤 token에 연결합니다.
+	// 관리자가 추가했거나 다른 token으로 등록된 Device는 이어받지 않습니다.
+	//
+	// 매개변수:
+	//   - token: enrollment token
+	//   - req: engine identity, GPU, capability
+	//
+	// 반환값:
+	//   - *repo.DeviceEnrollDTO: Device ID와 heartbeat 주기
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Register(token string, req repo.DeviceRegisterDTO) (*repo.DeviceEnrollDTO, *logger.Report)
+
+	// Heartbeat 함수는 등록된 engine의 heartbeat를 기록하고, GPU 정보가 있으면 함께 갱신합니다.
+	// offline이던 device가 GPU 정보 없이 heartbeat를 보내면 offline 동안 사용하지 않던 GPU를 다시 사용합니다.
+	//
+	// 매개변수:
+	//   - token: enrollment token
+	//   - req: GPU, capability
+	//
+	// 반환값:
+	//   - *repo.DeviceEnrollDTO: Device ID와 heartbeat 주기
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Heartbeat(token string, req repo.DeviceHeartbeatDTO) (*repo.DeviceEnrollDTO, *logger.Report)
+
+	// Authenticate 함수는 engine이 보낸 요청의 enrollment token을 확인하고 token에 연결된 Device ID를 반환합니다.
+	// 아직 등록하지 않은 token은 거부합니다.
+	//
+	// 매개변수:
+	//   - token: enrollment token
+	//
+	// 반환값:
+	//   - int: token에 연결된 Device ID
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Authenticate(token string) (int, *logger.Report)
+}
+
+type EnrollService struct {
+	ctx        context.Context
+	dao        repo.IDeviceTokenDAO
+	dao_device repo.IDeviceDAO
+}
+
+var onceEnroll sync.Once
+var instanceEnroll *EnrollService
+
+func NewEnrollService(dao repo.IDeviceTokenDAO, dao_device repo.IDeviceDAO) *EnrollService {
+	onceEnroll.Do(func() {
+		logger.Debug("Enroll service instance")
+		instanceEnroll = &EnrollService{
+			ctx:        context.Background(),
+			dao:        dao,
+			dao_device: dao_device,
+		}
+	})
+
+	return instanceEnroll
+}
+
+func (svc *EnrollService) CreateToken(req repo.DeviceTokenDTO, username string) (*repo.DeviceTokenDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf(`{"name": %s, "device_id": %d}`, req.Name, req.DeviceID))
+	if req.DeviceID > 0 {
+		if _, err := svc.dao_device.SelectOne(svc.ctx, req.DeviceID); err != nil {
+			return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		}
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_FAILE, err)
+	}
+	token := hex.EncodeToString(secret)
+
+	req.CreatedBy = username
+	entity, err := svc.dao.InsertOne(svc.ctx, req, hashDeviceToken(token))
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_INSERT, err)
+	}
+
+	dto := repo.ConvertDeviceTokenEntToDTO(entity)
+	dto.Token = token
+	return dto, nil
+}
+
+func (svc *EnrollService) ReadTokens() ([]*repo.DeviceTokenDTO, *logger.Report) {
+	if tokens, err := svc.dao.SelectAll(svc.ctx); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	} else {
+		return repo.ConvertDeviceTokenEntsToDTOs(tokens), nil
+	}
+}
+
+func (svc *EnrollService) RevokeToken(id int) (*repo.DeviceTokenDTO, *logger.Report) {
+	if entity, err := svc.dao.Revoke(svc.ctx, id); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	} else {
+		return repo.ConvertDeviceTokenEntToDTO(entity), nil
+	}
+}
+
+func (svc *EnrollService) Register(token string, req repo.DeviceRegisterDTO) (*repo.DeviceEnrollDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	entity, report := svc.authorize(token)
+	if report != nil {
+		return nil, report
+	}
+	if err := checkConnection(req.Connection); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
+	}
+
+	deviceID := entity.DeviceID
+	if deviceID == 0 {
+		exist, err := svc.dao_device.SelectByIPAndPort(svc.ctx, req.IP, req.Port)
+		if err != nil {
+			return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		}
+		if len(exist) > 0 {
+			if err := svc.checkClaim(exist[0], entity.ID); err != nil {
+				return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
+			}
+			deviceID = exist[0].ID
+		}
+	}
+	if req.Name == "" && deviceID == 0 {
+		req.Name = req.IP + ":" + strconv.Itoa(req.Port)
+	}
+
+	wasAvailable := false
+	if deviceID > 0 {
+		if device, err := svc.dao_device.SelectOne(svc.ctx, deviceID); err == nil {
+			wasAvailable, _ = strconv.ParseBool(device.Available)
+		}
+	}
+	device, err := svc.dao_device.UpsertRegistered(svc.ctx, deviceID, req)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+	if err := svc.dao.UpdateUsed(svc.ctx, entity.ID, device.ID); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+	logger.Info(fmt.Sprintf("device %d (%s:%d) registered with token %d", device.ID, device.IP, device.Port, entity.ID))
+
+	updateGPUInfo(&repo.EngineInfoDTO{DeviceName: device.Name, GPUs: req.GPUs}, device.ID)
+	if !wasAvailable {
+		utils.NewNotifier().Publish(utils.EVENT_DEVICE_ONLINE, device.ID)
+	}
+
+	return svc.enrolled(device.ID), nil
+}
+
+func (svc *EnrollService) Heartbeat(token string, req repo.DeviceHeartbeatDTO) (*repo.DeviceEnrollDTO, *logger.Report) {
+	entity, report := svc.authorize(token)
+	if report != nil {
+		return nil, report
+	}
+	if entity.DeviceID == 0 {
+		return nil, logger.CreateReport(&logger.CODE_DEVICE_TOKEN_INVALID, errors.New("device is not registered yet"))
+	}
+
+	device, err := svc.dao_device.SelectOne(svc.ctx, entity.DeviceID)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	if err := svc.dao_device.UpdateHeartbeat(svc.ctx, device.ID, req.Capabilities); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+	if err := svc.dao.UpdateUsed(svc.ctx, entity.ID, device.ID); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+
+	available, _ := strconv.ParseBool(device.Available)
+	if len(req.GPUs) > 0 {
+		updateGPUInfo(&repo.EngineInfoDTO{DeviceName: device.Name, GPUs: req.GPUs}, device.ID)
+	} else if !available {
+		// offline이 되면서 사용하지 않도록 바꾼 GPU를 되돌린다. engine이 더 이상 보고하지 않는 GPU는
+		// GPU 정보가 담긴 다음 heartbeat에서 다시 정리된다.
+		if err := repo.NewGPUDAO().UpdateUseByDevice(svc.ctx, device.ID); err != nil {
+			return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+	}
+	if !available {
+		logger.Info(fmt.Sprintf("device %d (%s:%d) is online", device.ID, device.IP, device.Port))
+		utils.NewNotifier().Publish(utils.EVENT_DEVICE_ONLINE, device.ID)
+	}
+
+	return svc.enrolled(device.ID), nil
+}
+
+func (svc *EnrollService) Authenticate(token string) (int, *logger.Report) {
+	entity, report := svc.authorize(token)
+	if report != nil {
+		return 0, report
+	}
+	if entity.DeviceID == 0 {
+		return 0, logger.CreateReport(&logger.CODE_DEVICE_TOKEN_INVALID, errors.New("device is not registered yet"))
+	}
+
+	return entity.DeviceID, nil
+}
+
+// checkClaim은 Device가 지정되지 않은 token이 같은 IP, port의 device를 이어받을 수 있는지 확인합니다.
+// 관리자가 추가한 device(heartbeat_at이 없음)나 다른 token으로 등록된 device는 이어받지 않으므로,
+// 그런 device에 등록하려면 device_id를 지정한 token을 발급해야 합니다.
+func (svc *EnrollService) checkClaim(device *ent.Device, token_id int) error {
+	if device.HeartbeatAt == nil {
+		return fmt.Errorf("device %d (%s:%d) was added by an administrator, issue a token with its device_id", device.ID, device.IP, device.Port)
+	}
+
+	tokens, err := svc.dao.SelectValidByDevice(svc.ctx, device.ID)
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		if token.ID != token_id {
+			return fmt.Errorf("device %d (%s:%d) is registered with another token", device.ID, device.IP, device.Port)
+		}
+	}
+
+	return nil
+}
+
+// authorize는 폐기되지 않은 token인지 확인합니다.
+func (svc *EnrollService) authorize(token string) (*ent.DeviceToken, *logger.Report) {
+	if token == "" {
+		return nil, logger.CreateReport(&logger.CODE_DEVICE_TOKEN_INVALID, errors.New("empty device token"))
+	}
+	entity, err := svc.dao.SelectValid(svc.ctx, hashDeviceToken(token))
+	if ent.IsNotFound(err) {
+		return nil, logger.CreateReport(&logger.CODE_DEVICE_TOKEN_INVALID, errors.New("unknown or revoked device token"))
+	} else if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	return entity, nil
+}
+
+func (svc *EnrollService) enrolled(device_id int) *repo.DeviceEnrollDTO {
+	return &repo.DeviceEnrollDTO{
+		DeviceID:          device_id,
+		HeartbeatInterval: config_service.NewStatic().GetInt("DEVICE_HEARTBEAT_INTERVAL", 30),
+	}
+}
+
+// hashDeviceToken은 DB에 저장하고 조회할 token의 SHA-256 hex입니다.
+func hashDeviceToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
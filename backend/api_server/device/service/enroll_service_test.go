@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	repo "api_server/device/repository"
+	"api_server/ent"
+	"api_server/ent/gpu"
+	"api_server/logger"
+	"api_server/utils/dbtest"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeDeviceTokenDAO는 hash로 token을 찾는 부분만 메모리로 구현합니다.
+type fakeDeviceTokenDAO struct {
+	tokens map[string]*ent.DeviceToken
+}
+
+func (dao *fakeDeviceTokenDAO) InsertOne(ctx context.Context, req repo.DeviceTokenDTO, token_hash string) (*ent.DeviceToken, error) {
+	entity := &ent.DeviceToken{ID: len(dao.tokens) + 1, Name: req.Name, DeviceID: req.DeviceID, TokenHash: token_hash}
+	dao.tokens[token_hash] = entity
+	return entity, nil
+}
+
+func (dao *fakeDeviceTokenDAO) SelectAll(ctx context.Context) ([]*ent.DeviceToken, error) {
+	return nil, nil
+}
+
+func (dao *fakeDeviceTokenDAO) SelectValid(ctx context.Context, token_hash string) (*ent.DeviceToken, error) {
+	if entity, ok := dao.tokens[token_hash]; ok && !entity.Revoked {
+		return entity, nil
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (dao *fakeDeviceTokenDAO) SelectValidByDevice(ctx context.Context, device_id int) ([]*ent.DeviceToken, error) {
+	tokens := []*ent.DeviceToken{}
+	for _, entity := range dao.tokens {
+		if entity.DeviceID == device_id && !entity.Revoked {
+			tokens = append(tokens, entity)
+		}
+	}
+	return tokens, nil
+}
+
+func (dao *fakeDeviceTokenDAO) UpdateUsed(ctx context.Context, id int, device_id int) error {
+	return nil
+}
+
+func (dao *fakeDeviceTokenDAO) Revoke(ctx context.Context, id int) (*ent.DeviceToken, error) {
+	for _, entity := range dao.tokens {
+		if entity.ID == id {
+			entity.Revoked = true
+			return entity, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+type EnrollTestSuite struct {
+	suite.Suite
+	svc *EnrollService
+}
+
+func (suite *EnrollTestSuite) SetupTest() {
+	logger.InitLogger("", "/workspace/log/test.log")
+	suite.svc = &EnrollService{
+		ctx: context.Background(),
+		dao: &fakeDeviceTokenDAO{tokens: make(map[string]*ent.DeviceToken)},
+	}
+}
+
+func (suite *EnrollTestSuite) TestToken() {
+	issued, report := suite.svc.CreateToken(repo.DeviceTokenDTO{Name: "gpu-01"}, "admin")
+	suite.Nil(report)
+	suite.Len(issued.Token, 64)
+	// token 값은 저장하지 않는다.
+	_, stored := suite.svc.dao.(*fakeDeviceTokenDAO).tokens[issued.Token]
+	suite.False(stored)
+
+	entity, report := suite.svc.authorize(issued.Token)
+	suite.Nil(report)
+	suite.Equal(issued.ID, entity.ID)
+
+	_, report = suite.svc.authorize("")
+	suite.Equal(logger.CODE_DEVICE_TOKEN_INVALID.Code, report.Code)
+	_, report = suite.svc.authorize(issued.Token + "x")
+	suite.Equal(logger.CODE_DEVICE_TOKEN_INVALID.Code, report.Code)
+
+	_, report = suite.svc.RevokeToken(issued.ID)
+	suite.Nil(report)
+	_, report = suite.svc.authorize(issued.Token)
+	suite.Equal(logger.CODE_DEVICE_TOKEN_INVALID.Code, report.Code)
+}
+
+func TestEnrollTestSuite(t *testing.T) {
+	suite.Run(t, new(EnrollTestSuite))
+}
+
+// EnrollDBTestSuite는 in-memory DB로 등록, heartbeat, heartbeat 만료를 확인합니다.
+type EnrollDBTestSuite struct {
+	suite.Suite
+	client  *ent.Client
+	svc     *EnrollService
+	checker *HealthChecker
+}
+
+func (suite *EnrollDBTestSuite) SetupTest() {
+	logger.InitLogger("", "/workspace/log/test.log")
+	suite.client = dbtest.Open(suite.T())
+	suite.svc = &EnrollService{ctx: context.Background(), dao: repo.NewDeviceTokenDAO(), dao_device: repo.New()}
+	suite.checker = &HealthChecker{ctx: context.Background(), dao: repo.New(), dao_gpu: repo.NewGPUDAO(), failures: make(map[int]int)}
+}
+
+func (suite *EnrollDBTestSuite) token(device_id int) *repo.DeviceTokenDTO {
+	issued, report := suite.svc.CreateToken(repo.DeviceTokenDTO{Name: "engine", DeviceID: device_id}, "admin")
+	suite.Require().Nil(report)
+	return issued
+}
+
+func (suite *EnrollDBTestSuite) register(token string, ip string, uuids ...string) (*repo.DeviceEnrollDTO, *logger.Report) {
+	gpus := []repo.GPUInfo{}
+	for i, uuid := range uuids {
+		gpus = append(gpus, repo.GPUInfo{Index: i, Name: "Test GPU", UUID: uuid})
+	}
+	return suite.svc.Register(token, repo.DeviceRegisterDTO{IP: ip, Port: 5000, GPUs: gpus})
+}
+
+func (suite *EnrollDBTestSuite) usedGPUs(device_id int) int {
+	return suite.client.Gpu.Query().Where(gpu.DeviceID(device_id), gpu.IsUse(true)).CountX(context.Background())
+}
+
+func (suite *EnrollDBTestSuite) TestRegister() {
+	ctx := context.Background()
+	first := suite.token(0)
+	enrolled, report := suite.register(first.Token, "10.0.0.1", "GPU-a", "GPU-b")
+	suite.Require().Nil(report)
+	device := suite.client.Device.GetX(ctx, enrolled.DeviceID)
+	suite.NotNil(device.HeartbeatAt)
+	suite.Equal("true", device.Available)
+	suite.Equal(2, suite.usedGPUs(device.ID))
+
+	// 다시 등록하면 같은 device를 사용한다.
+	again, report := suite.register(first.Token, "10.0.0.1", "GPU-a", "GPU-b")
+	suite.Nil(report)
+	suite.Equal(enrolled.DeviceID, again.DeviceID)
+
+	// 다른 token으로 등록된 device는 이어받지 않는다.
+	other := suite.token(0)
+	_, report = suite.register(other.Token, "10.0.0.1", "GPU-a")
+	suite.Equal(logger.CODE_REQUEST.Code, report.Code)
+
+	// token이 폐기되면 새 token이 이어받는다.
+	_, report = suite.svc.RevokeToken(first.ID)
+	suite.Nil(report)
+	taken, report := suite.register(other.Token, "10.0.0.1", "GPU-a", "GPU-b")
+	suite.Nil(report)
+	suite.Equal(enrolled.DeviceID, taken.DeviceID)
+}
+
+// 관리자가 추가한 device는 그 device를 지정한 token으로만 등록한다.
+func (suite *EnrollDBTestSuite) TestRegisterAdminDevice() {
+	ctx := context.Background()
+	admin := suite.client.Device.Create().SetName("gpu-01").SetIP("10.0.0.2").SetPort(5000).SetAvailable("true").SaveX(ctx)
+
+	_, report := suite.register(suite.token(0).Token, "10.0.0.2")
+	suite.Equal(logger.CODE_REQUEST.Code, report.Code)
+	suite.Nil(suite.client.Device.GetX(ctx, admin.ID).HeartbeatAt)
+
+	enrolled, report := suite.register(suite.token(admin.ID).Token, "10.0.0.2", "GPU-c")
+	suite.Nil(report)
+	suite.Equal(admin.ID, enrolled.DeviceID)
+	suite.NotNil(suite.client.Device.GetX(ctx, admin.ID).HeartbeatAt)
+}
+
+func (suite *EnrollDBTestSuite) TestHeartbeat() {
+	ctx := context.Background()
+	issued := suite.token(0)
+	_, report := suite.svc.Heartbeat(issued.Token, repo.DeviceHeartbeatDTO{})
+	suite.Equal(logger.CODE_DEVICE_TOKEN_INVALID.Code, report.Code)
+
+	enrolled, report := suite.register(issued.Token, "10.0.0.1", "GPU-a", "GPU-b")
+	suite.Require().Nil(report)
+
+	// GPU 정보가 있으면 보고하지 않은 GPU를 사용하지 않는다.
+	_, report = suite.svc.Heartbeat(issued.Token, repo.DeviceHeartbeatDTO{GPUs: []repo.GPUInfo{{Index: 0, Name: "Test GPU", UUID: "GPU-a"}}})
+	suite.Nil(report)
+	suite.Equal(1, suite.usedGPUs(enrolled.DeviceID))
+
+	// offline이 되었다가 GPU 정보 없이 heartbeat를 보내면 GPU를 다시 사용한다.
+	suite.checker.expire(suite.client.Device.GetX(ctx, enrolled.DeviceID), time.Now().Add(time.Hour), time.Minute)
+	suite.Equal("false", suite.client.Device.GetX(ctx, enrolled.DeviceID).Available)
+	suite.Equal(0, suite.usedGPUs(enrolled.DeviceID))
+
+	_, report = suite.svc.Heartbeat(issued.Token, repo.DeviceHeartbeatDTO{})
+	suite.Nil(report)
+	suite.Equal("true", suite.client.Device.GetX(ctx, enrolled.DeviceID).Available)
+	suite.Equal(2, suite.usedGPUs(enrolled.DeviceID))
+}
+
+func (suite *EnrollDBTestSuite) TestExpire() {
+	ctx := context.Background()
+	enrolled, report := suite.register(suite.token(0).Token, "10.0.0.1", "GPU-a")
+	suite.Require().Nil(report)
+	device := suite.client.Device.GetX(ctx, enrolled.DeviceID)
+
+	suite.checker.expire(device, device.HeartbeatAt.Add(30*time.Second), time.Minute)
+	suite.Equal("true", suite.client.Device.GetX(ctx, device.ID).Available)
+	suite.Equal(1, suite.usedGPUs(device.ID))
+
+	suite.checker.expire(device, device.HeartbeatAt.Add(2*time.Minute), time.Minute)
+	suite.Equal("false", suite.client.Device.GetX(ctx, device.ID).Available)
+	suite.Equal(0, suite.usedGPUs(device.ID))
+}
+
+func TestEnrollDBTestSuite(t *testing.T) {
+	suite.Run(t, new(EnrollDBTestSuite))
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync"
 
 	repo "api_server/device/repository"
@@ -30,7 +31,26 @@ type IGPUService interface {
 	//   - []*repo.GPUDTO: 해당 디바이스에 연결된 GPU DTO 리스트
 	//   - *logger.Report: 오류 발생 시 리포트, 없으면 nil
 	ReadManyByDeviceID(deviceID int) ([]*repo.GPUDTO, *logger.Report)
+
+	// ReadIdle은 drain 중이 아닌 device의 사용 가능한 idle GPU 리스트를 조회합니다.
+	//
+	// 반환값:
+	//   - []*repo.GPUDTO: idle GPU DTO 리스트
+	//   - *logger.Report: 오류 발생 시 리포트, 없으면 nil
+	ReadIdle() ([]*repo.GPUDTO, *logger.Report)
 	ViewGpuByIndex(index string) (*repo.GPUDTO, error)
+
+	// ViewGpu는 추론 모델을 올릴 GPU를 device와 GPU index로 찾습니다.
+	// device_id가 0이면 index번 GPU가 한 device에만 있어야 하며, 여러 device에 있으면 device_id를 요구하는 오류를 반환합니다.
+	//
+	// 매개변수:
+	//   - device_id: GPU가 설치된 device ID, 모르면 0
+	//   - index: device 안의 GPU index
+	//
+	// 반환값:
+	//   - *repo.GPUDTO: 찾은 GPU
+	//   - *logger.Report: GPU가 없거나 하나로 정할 수 없으면 리포트, 없으면 nil
+	ViewGpu(device_id int, index int) (*repo.GPUDTO, *logger.Report)
 }
 
 type GPUService struct {
@@ -77,6 +97,15 @@ func (svc *GPUService) ReadManyByDeviceID(deviceID int) ([]*repo.GPUDTO, *logger
 	return repo.ConvertGPUEntsToGPUDTOs(gpuList), nil
 }
 
+// ReadIdle은 drain 중이 아닌 device의 사용 가능한 idle GPU 리스트를 조회합니다.
+func (svc *GPUService) ReadIdle() ([]*repo.GPUDTO, *logger.Report) {
+	gpuList, err := svc.dao.SelectIdle(svc.ctx)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	return repo.ConvertGPUEntsToGPUDTOs(gpuList), nil
+}
+
 func (svc *GPUService) ViewGpuByIndex(gpuIndex string) (*repo.GPUDTO, error) {
 	gpu, err := svc.dao.SelectGpuByIndex(svc.ctx, gpuIndex)
 	if err != nil {
@@ -88,3 +117,24 @@ func (svc *GPUService) ViewGpuByIndex(gpuIndex string) (*repo.GPUDTO, error) {
 		return repo.ConvertGPUEntToGPUDTO(gpu), nil
 	}
 }
+
+func (svc *GPUService) ViewGpu(device_id int, index int) (*repo.GPUDTO, *logger.Report) {
+	var gpu *ent.Gpu
+	var err error
+	if device_id > 0 {
+		gpu, err = svc.dao.SelectGpuByDeviceAndIndex(svc.ctx, device_id, index)
+	} else {
+		gpu, err = svc.dao.SelectGpuByIndex(svc.ctx, strconv.Itoa(index))
+	}
+
+	switch {
+	case err == nil:
+		return repo.ConvertGPUEntToGPUDTO(gpu), nil
+	case ent.IsNotFound(err):
+		return nil, logger.CreateReport(&logger.CODE_MODELING_DEVICE_NOT_EXIST, fmt.Errorf("no usable gpu with device_id=%d, gpu_index=%d", device_id, index))
+	case ent.IsNotSingular(err):
+		return nil, logger.CreateReport(&logger.CODE_REQUEST, fmt.Errorf("gpu_index=%d exists on several devices, device_id is required", index))
+	default:
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	repo "api_server/device/repository"
+	"api_server/ent"
+	"api_server/logger"
+	"api_server/utils/dbtest"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GPUServiceTestSuite struct {
+	suite.Suite
+	client *ent.Client
+	svc    *GPUService
+}
+
+func (suite *GPUServiceTestSuite) SetupTest() {
+	logger.InitLogger("", "/workspace/log/test.log")
+	suite.client = dbtest.Open(suite.T())
+	suite.svc = &GPUService{ctx: context.Background(), dao: repo.NewGPUDAO()}
+}
+
+func (suite *GPUServiceTestSuite) addGPU(device_id int, index int) *ent.Gpu {
+	return suite.client.Gpu.Create().
+		SetUUID(suite.T().Name() + string(rune('a'+device_id)) + string(rune('0'+index))).
+		SetIndex(index).
+		SetName("Test GPU").
+		SetDeviceID(device_id).
+		SaveX(context.Background())
+}
+
+// 같은 GPU index가 여러 device에 있으면 device 없이 찾지 않는다.
+func (suite *GPUServiceTestSuite) TestViewGpu() {
+	first := suite.addGPU(1, 0)
+	second := suite.addGPU(2, 0)
+	only := suite.addGPU(2, 1)
+
+	gpu, r := suite.svc.ViewGpu(2, 0)
+	suite.Nil(r)
+	suite.Equal(second.ID, gpu.ID)
+	gpu, r = suite.svc.ViewGpu(1, 0)
+	suite.Nil(r)
+	suite.Equal(first.ID, gpu.ID)
+
+	_, r = suite.svc.ViewGpu(0, 0)
+	suite.Equal(logger.CODE_REQUEST.Code, r.Code)
+
+	gpu, r = suite.svc.ViewGpu(0, 1)
+	suite.Nil(r)
+	suite.Equal(only.ID, gpu.ID)
+
+	_, r = suite.svc.ViewGpu(1, 1)
+	suite.Equal(logger.CODE_MODELING_DEVICE_NOT_EXIST.Code, r.Code)
+}
+
+func TestGPUServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(GPUServiceTestSuite))
+}
@@ -1,71 +1,61 @@
 package service
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
-	"strconv"
 
 	config_service "api_server/configuration/service"
 	repo "api_server/device/repository"
+	engine_client "api_server/engine/client"
 	"api_server/logger"
 	"api_server/utils"
 )
 
+// GatherDevicesInfo는 KAI.S 시작 시 모든 GPU를 초기화한 뒤 device별 GPU 정보를 다시 수집합니다.
 func GatherDevicesInfo() {
-	svc := New(repo.New())
-	if devices, err := svc.ReadActive(); err != nil {
-		return
-	} else {
-		for _, device := range devices {
-			go getDeviceInfoThroughREST(device)
-		}
+	// 초기화는 한 번만 한다. device마다 하면 먼저 수집한 device의 GPU까지 사용 불가로 바뀐다.
+	if err := repo.NewGPUDAO().UpdateAllDisUse(context.Background()); err != nil {
+		logger.Error(err)
 	}
+
+	go NewHealthChecker(repo.New(), repo.NewGPUDAO()).Check()
 }
 
-func getAPIParam() string {
+func getAPIParam() engine_client.SysRequest {
 	cf := config_service.NewStatic()
 	fileconfig := utils.ReadConfigFromFile()
-	return fmt.Sprintf(
-		"{\"DB_HOST\":\"%s\", \"DB_PORT\":%d, \"DB_NAME\":\"%s\", \"TEST_PATH\":\"%s\"}",
-		fileconfig.DBIP,
-		fileconfig.DBPORT,
-		fileconfig.DB,
-		cf.Get("ROOT_PATH")+"/task/",
-	)
-}
-
-func updateGPUInfo(respBody []byte, device_id int) {
-	engineInfo := repo.EngineInfoDTO{}
-	if errUnmarshal := json.Unmarshal(respBody, &engineInfo); errUnmarshal != nil {
-		logger.Error(errUnmarshal)
-		return
-	} else {
-		ctx := context.Background()
-		dao := repo.NewGPUDAO()
-
-		engineInfo.DeviceID = device_id
-		dao.UpdateAllDisUse(ctx)
-		dao.UpsertMany(ctx, engineInfo)
+	return engine_client.SysRequest{
+		DBHost:   fileconfig.DBIP,
+		DBPort:   fileconfig.DBPORT,
+		DBName:   fileconfig.DB,
+		TestPath: cf.Get("ROOT_PATH") + "/task/",
 	}
 }
 
-func getDeviceInfoThroughREST(device *repo.DeviceDTO) {
-	params := getAPIParam()
-
-	reqBody := bytes.NewBufferString(params)
+// updateGPUInfo는 engine이 보고한 GPU와 capability를 반영하고, device의 GPU 중 보고되지 않은 GPU는 사용하지 않도록 바꿉니다.
+func updateGPUInfo(engineInfo *repo.EngineInfoDTO, device_id int) {
+	ctx := context.Background()
+	dao := repo.NewGPUDAO()
 
-	if resp, err := http.Post(
-		"http://"+device.IP+":"+strconv.Itoa(*device.Port)+"/api/sys",
-		"application/json",
-		reqBody); err != nil {
+	engineInfo.DeviceID = device_id
+	if err := dao.UpsertMany(ctx, *engineInfo); err != nil {
 		logger.Error(err)
-	} else if respBody, err := io.ReadAll(resp.Body); err != nil {
+		return
+	}
+
+	uuids := []string{}
+	for _, gpu := range engineInfo.GPUs {
+		uuids = append(uuids, gpu.UUID)
+	}
+	if err := dao.UpdateDisUseByDevice(ctx, device_id, uuids); err != nil {
 		logger.Error(err)
-	} else {
-		updateGPUInfo(respBody, device.ID)
+		return
+	}
+	if engineInfo.Capabilities != nil {
+		if err := repo.New().UpdateCapabilities(ctx, device_id, engineInfo.Capabilities); err != nil {
+			logger.Error(err)
+		}
 	}
+	utils.NewNotifier().Publish(utils.EVENT_DEVICE_UPDATED, device_id)
+
+	NewTelemetryService(repo.NewTelemetryDAO(), dao).Record(engineInfo)
 }
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	config_service "api_server/configuration/service"
+	repo "api_server/device/repository"
+	"api_server/logger"
+	"api_server/utils"
+)
+
+const (
+	telemetryMinute = 60   // 1분 구간(초)
+	telemetryHour   = 3600 // 1시간 구간(초)
+)
+
+type ITelemetryService interface {
+	// Record 함수는 engine /api/sys 응답의 GPU 값을 1분, 1시간 구간 평균에 더하고 최신 값으로 보관합니다.
+	//
+	// 매개변수:
+	//   - engineInfo: device_id가 채워진 /api/sys 응답
+	Record(engineInfo *repo.EngineInfoDTO)
+
+	// ReadLatest 함수는 GPU별로 가장 최근에 수집한 값을 조회합니다.
+	//
+	// 반환값:
+	//   - []*repo.TelemetrySampleDTO: GPU ID 순으로 정렬된 최신 값
+	ReadLatest() []*repo.TelemetrySampleDTO
+
+	// ReadHistory 함수는 GPU별 telemetry 시계열과 그 기간에 GPU를 사용한 modeling을 조회합니다.
+	//
+	// 매개변수:
+	//   - query: GPU, device, 기간, resolution 조건. 기간이 없으면 최근 1시간,
+	//     resolution이 0이면 24시간 이하는 1분, 그보다 길면 1시간 구간을 사용
+	//
+	// 반환값:
+	//   - []*repo.TelemetrySeriesDTO: GPU별 시계열
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadHistory(query repo.TelemetryQueryDTO) ([]*repo.TelemetrySeriesDTO, *logger.Report)
+
+	// Prune 함수는 보존 기간이 지난 구간을 삭제합니다.
+	// 1분 구간은 TELEMETRY_RETENTION_MINUTE(시간), 1시간 구간은 TELEMETRY_RETENTION_HOUR(일) 동안 보존합니다.
+	Prune()
+
+	// WatchRetention 함수는 1시간마다 Prune을 실행합니다.
+	WatchRetention()
+}
+
+type TelemetryService struct {
+	ctx     context.Context
+	dao     repo.ITelemetryDAO
+	dao_gpu repo.IGPUDAO
+	mu      sync.RWMutex
+	latest  map[int]*repo.TelemetrySampleDTO
+}
+
+var onceTelemetry sync.Once
+var instanceTelemetry *TelemetryService
+
+func NewTelemetryService(dao repo.ITelemetryDAO, dao_gpu repo.IGPUDAO) *TelemetryService {
+	onceTelemetry.Do(func() {
+		logger.Debug("Telemetry service instance")
+		instanceTelemetry = &TelemetryService{
+			ctx:     context.Background(),
+			dao:     dao,
+			dao_gpu: dao_gpu,
+			latest:  make(map[int]*repo.TelemetrySampleDTO),
+		}
+	})
+
+	return instanceTelemetry
+}
+
+func (svc *TelemetryService) Record(engineInfo *repo.EngineInfoDTO) {
+	gpus, err := svc.dao_gpu.SelectManyByDeviceID(svc.ctx, engineInfo.DeviceID)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+	gpuIDs := make(map[string]int)
+	for _, gpu := range gpus {
+		gpuIDs[gpu.UUID] = gpu.ID
+	}
+
+	now := time.Now()
+	for _, info := range engineInfo.GPUs {
+		gpuID, ok := gpuIDs[info.UUID]
+		if !ok {
+			continue
+		}
+		sample := repo.TelemetrySampleDTO{
+			GPUID:             gpuID,
+			DeviceID:          engineInfo.DeviceID,
+			UtilizationGPU:    info.UtilizationGPU,
+			UtilizationMemory: info.UtilizationMemory,
+			MemoryUsed:        info.MemoryUsed,
+			MemoryTotal:       info.MemoryTotal,
+			Temperature:       info.Temperature,
+			PowerDraw:         info.PowerDraw,
+			At:                now,
+		}
+
+		svc.mu.Lock()
+		svc.latest[gpuID] = &sample
+		svc.mu.Unlock()
+
+		for _, resolution := range []int{telemetryMinute, telemetryHour} {
+			if err := svc.dao.UpsertSample(svc.ctx, sample, resolution); err != nil {
+				logger.CreateReport(&logger.CODE_DB_INSERT, err)
+			}
+		}
+	}
+
+	utils.NewNotifier().Publish(utils.EVENT_GPU_TELEMETRY, engineInfo.DeviceID)
+}
+
+func (svc *TelemetryService) ReadLatest() []*repo.TelemetrySampleDTO {
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	samples := []*repo.TelemetrySampleDTO{}
+	for _, sample := range svc.latest {
+		copied := *sample
+		samples = append(samples, &copied)
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i].GPUID < samples[j].GPUID })
+
+	return samples
+}
+
+func (svc *TelemetryService) ReadHistory(query repo.TelemetryQueryDTO) ([]*repo.TelemetrySeriesDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf("%+v", query))
+	query, err := telemetryQueryDefaults(query, time.Now())
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
+	}
+
+	rows, err := svc.dao.SelectRange(svc.ctx, query)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	modelings, err := svc.dao.SelectModelingsInRange(svc.ctx, query.From, query.To)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	seriesList := []*repo.TelemetrySeriesDTO{}
+	seriesMap := make(map[int]*repo.TelemetrySeriesDTO)
+	for _, row := range rows {
+		series, ok := seriesMap[row.GpuID]
+		if !ok {
+			series = &repo.TelemetrySeriesDTO{
+				GPUID:      row.GpuID,
+				DeviceID:   row.DeviceID,
+				Resolution: query.Resolution,
+				Points:     []*repo.TelemetryPointDTO{},
+				Modelings:  []*repo.GPUModelingDTO{},
+			}
+			seriesMap[row.GpuID] = series
+			seriesList = append(seriesList, series)
+		}
+		series.Points = append(series.Points, repo.ConvertTelemetryEntToPointDTO(row))
+	}
+
+	ids := []int{}
+	for id := range seriesMap {
+		ids = append(ids, id)
+	}
+	gpus, err := svc.dao_gpu.SelectMany(svc.ctx, ids)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	for _, gpu := range gpus {
+		seriesMap[gpu.ID].Index = gpu.Index
+		seriesMap[gpu.ID].Name = gpu.Name
+	}
+
+	for _, modeling := range modelings {
+		for _, gpuID := range modeling.GPUIDs {
+			if series, ok := seriesMap[gpuID]; ok {
+				series.Modelings = append(series.Modelings, modeling)
+			}
+		}
+	}
+
+	return seriesList, nil
+}
+
+// telemetryQueryDefaults는 비어 있는 기간과 resolution을 채우고 조건을 확인합니다.
+func telemetryQueryDefaults(query repo.TelemetryQueryDTO, now time.Time) (repo.TelemetryQueryDTO, error) {
+	if query.To.IsZero() {
+		query.To = now
+	}
+	if query.From.IsZero() {
+		query.From = query.To.Add(-time.Hour)
+	}
+	if !query.From.Before(query.To) {
+		return query, errors.New("from must be before to")
+	}
+
+	switch query.Resolution {
+	case 0:
+		query.Resolution = telemetryMinute
+		if query.To.Sub(query.From) > 24*time.Hour {
+			query.Resolution = telemetryHour
+		}
+	case telemetryMinute, telemetryHour:
+	default:
+		return query, fmt.Errorf("resolution must be %d or %d", telemetryMinute, telemetryHour)
+	}
+
+	return query, nil
+}
+
+func (svc *TelemetryService) Prune() {
+	cf := config_service.NewStatic()
+	retention := map[int]time.Duration{
+		telemetryMinute: time.Duration(cf.GetInt("TELEMETRY_RETENTION_MINUTE", 24)) * time.Hour,
+		telemetryHour:   time.Duration(cf.GetInt("TELEMETRY_RETENTION_HOUR", 30)) * 24 * time.Hour,
+	}
+
+	now := time.Now()
+	for resolution, keep := range retention {
+		if deleted, err := svc.dao.DeleteBefore(svc.ctx, resolution, now.Add(-keep)); err != nil {
+			logger.CreateReport(&logger.CODE_DB_DELETE, err)
+		} else if deleted > 0 {
+			logger.Debug(fmt.Sprintf("pruned %d telemetry buckets of %ds", deleted, resolution))
+		}
+	}
+}
+
+func (svc *TelemetryService) WatchRetention() {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	svc.Prune()
+	for range ticker.C {
+		svc.Prune()
+	}
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	repo "api_server/device/repository"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TelemetryTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func (suite *TelemetryTestSuite) SetupTest() {
+	suite.now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (suite *TelemetryTestSuite) TestQueryDefaults() {
+	query, err := telemetryQueryDefaults(repo.TelemetryQueryDTO{}, suite.now)
+	suite.NoError(err)
+	suite.Equal(suite.now, query.To)
+	suite.Equal(suite.now.Add(-time.Hour), query.From)
+	suite.Equal(telemetryMinute, query.Resolution)
+
+	query, err = telemetryQueryDefaults(repo.TelemetryQueryDTO{From: suite.now.Add(-48 * time.Hour)}, suite.now)
+	suite.NoError(err)
+	suite.Equal(telemetryHour, query.Resolution)
+
+	_, err = telemetryQueryDefaults(repo.TelemetryQueryDTO{From: suite.now.Add(time.Hour)}, suite.now)
+	suite.Error(err)
+	_, err = telemetryQueryDefaults(repo.TelemetryQueryDTO{Resolution: 10}, suite.now)
+	suite.Error(err)
+}
+
+func (suite *TelemetryTestSuite) TestFoldSample() {
+	point := repo.TelemetryPointDTO{Time: suite.now}
+	point = repo.FoldSample(point, repo.TelemetrySampleDTO{UtilizationGPU: 20, Temperature: 60, PowerDraw: 100})
+	point = repo.FoldSample(point, repo.TelemetrySampleDTO{UtilizationGPU: 80, Temperature: 70, PowerDraw: 200})
+
+	suite.Equal(2, point.Samples)
+	suite.Equal(suite.now, point.Time)
+	suite.InDelta(50, point.UtilizationGPU, 1e-9)
+	suite.InDelta(65, point.Temperature, 1e-9)
+	suite.InDelta(150, point.PowerDraw, 1e-9)
+}
+
+func TestTelemetryTestSuite(t *testing.T) {
+	suite.Run(t, new(TelemetryTestSuite))
+}
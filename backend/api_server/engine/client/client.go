@@ -0,0 +1,195 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	config_service "api_server/configuration/service"
+	"api_server/logger"
+)
+
+const (
+	// DefaultTimeout는 engine 요청 하나에 허용하는 시간입니다. 모델 로딩과 추론도 이 안에 끝나야 합니다.
+	DefaultTimeout = 2 * time.Minute
+	// ProbeTimeout은 engine이 살아 있는지 확인하는 요청에 허용하는 시간입니다.
+	ProbeTimeout = 5 * time.Second
+
+	defaultInferenceAddr = "http://localhost:5000"
+)
+
+// Client는 KAI.S engine의 REST API를 호출합니다.
+// 모든 요청은 ctx가 취소되거나 timeout이 지나면 중단되며, engine에 연결하지 못하거나 engine이 실패를 응답하면 *Error를 반환합니다.
+type Client struct {
+	addr    string
+	timeout time.Duration
+	http    *http.Client
+}
+
+// New는 addr(예: http://10.0.0.1:5000)의 engine을 호출하는 Client를 만듭니다.
+// timeout이 0 이하이면 ctx 외에는 요청 시간을 제한하지 않습니다.
+func New(addr string, timeout time.Duration) *Client {
+	return &Client{
+		addr:    strings.TrimRight(addr, "/"),
+		timeout: timeout,
+		http:    &http.Client{},
+	}
+}
+
+// NewDevice는 device에 설치된 engine을 호출하는 Client를 만듭니다.
+func NewDevice(ip string, port int) *Client {
+	return New("http://"+ip+":"+strconv.Itoa(port), DefaultTimeout)
+}
+
+// NewInference는 모델 로딩, 추론, dataset 분석을 담당하는 engine을 호출하는 Client를 만듭니다.
+// 주소는 INFERENCE_ENGINE_ADDR 설정을 사용합니다.
+func NewInference() *Client {
+	addr := config_service.NewStatic().Get("INFERENCE_ENGINE_ADDR")
+	if addr == "" {
+		addr = defaultInferenceAddr
+	}
+
+	return New(addr, DefaultTimeout)
+}
+
+// WithTimeout은 요청 시간 제한만 바꾼 Client를 반환합니다.
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	return &Client{addr: c.addr, timeout: timeout, http: c.http}
+}
+
+// Addr는 engine 주소입니다.
+func (c *Client) Addr() string {
+	return c.addr
+}
+
+// Error는 engine에 보낸 요청이 실패한 이유입니다. 요청 본문을 만들지 못한 오류는 Error가 아닙니다.
+// StatusCode가 0이면 engine에 연결하지 못했거나 응답을 받기 전에 요청이 취소된 것입니다.
+type Error struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Body       string
+	Err        error
+}
+
+func (e *Error) Error() string {
+	if e.StatusCode == 0 {
+		return fmt.Sprintf("engine %s %s: %v", e.Method, e.URL, e.Err)
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("engine %s %s: %v (status %d)", e.Method, e.URL, e.Err, e.StatusCode)
+	}
+
+	return fmt.Sprintf("engine %s %s responded %d: %s", e.Method, e.URL, e.StatusCode, e.Body)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// IsUnreachable은 err가 engine에 연결하지 못해 생긴 오류인지 확인합니다.
+func IsUnreachable(err error) bool {
+	var engineErr *Error
+	return errors.As(err, &engineErr) && engineErr.StatusCode == 0
+}
+
+// StatusCode는 err를 만든 engine 응답의 HTTP 상태 코드입니다. engine 응답이 아니면 0 입니다.
+func StatusCode(err error) int {
+	var engineErr *Error
+	if errors.As(err, &engineErr) {
+		return engineErr.StatusCode
+	}
+
+	return 0
+}
+
+// Report는 engine 요청 오류를 리포트 객체로 바꿉니다.
+// 요청을 만들지 못했으면 CODE_REMOTE_CREATE_REQ, 연결하지 못했으면 CODE_REMOTE_REQUEST,
+// engine이 오류를 응답했거나 응답을 읽지 못했으면 CODE_REMOTE_RESPONSE 입니다.
+func Report(err error) *logger.Report {
+	var engineErr *Error
+	if !errors.As(err, &engineErr) {
+		return logger.CreateReport(&logger.CODE_REMOTE_CREATE_REQ, err)
+	}
+	if engineErr.StatusCode == 0 {
+		return logger.CreateReport(&logger.CODE_REMOTE_REQUEST, err)
+	}
+
+	return logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
+}
+
+// do는 요청을 보내고 응답 본문을 반환합니다. 4xx, 5xx 응답은 *Error 입니다.
+func (c *Client) do(ctx context.Context, method, path, contentType string, body io.Reader) ([]byte, error) {
+	url := c.addr + path
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, &Error{Method: method, URL: url, Err: err}
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &Error{Method: method, URL: url, StatusCode: resp.StatusCode, Err: err}
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, &Error{Method: method, URL: url, StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+	logger.Debug(method, url, string(respBody))
+
+	return respBody, nil
+}
+
+// doJSON은 in을 JSON으로 보내고 응답을 out에 읽습니다. in, out이 nil이면 본문을 보내거나 읽지 않습니다.
+// 응답이 비어 있으면 out은 바뀌지 않습니다.
+func (c *Client) doJSON(ctx context.Context, method, path string, in, out interface{}) ([]byte, error) {
+	var body io.Reader
+	if in != nil {
+		jsonBytes, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(jsonBytes)
+	}
+
+	respBody, err := c.do(ctx, method, path, "application/json", body)
+	if err != nil {
+		return nil, err
+	}
+	if err := decode(respBody, out); err != nil {
+		return nil, &Error{Method: method, URL: c.addr + path, StatusCode: http.StatusOK, Body: string(respBody), Err: err}
+	}
+
+	return respBody, nil
+}
+
+func decode(body []byte, out interface{}) error {
+	if out == nil || len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+
+	return nil
+}
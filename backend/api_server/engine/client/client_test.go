@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"api_server/engine/fake"
+	"api_server/logger"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ClientTestSuite struct {
+	suite.Suite
+	engine *fake.Engine
+	client *Client
+}
+
+func (suite *ClientTestSuite) SetupTest() {
+	logger.InitLogger("", "/workspace/log/test.log")
+	suite.engine = fake.New(2)
+	suite.client = New(suite.engine.URL(), time.Second)
+}
+
+func (suite *ClientTestSuite) TearDownTest() {
+	suite.engine.Close()
+}
+
+func (suite *ClientTestSuite) TestTrainLifecycle() {
+	ctx := context.Background()
+
+	suite.NoError(suite.client.Train(ctx, ModelingRequest{ModelingID: 3}))
+	suite.NoError(suite.client.Evaluate(ctx, ModelingRequest{ModelingID: 4}))
+	running, err := suite.client.Running(ctx)
+	suite.NoError(err)
+	suite.Equal([]int{3, 4}, running)
+	suite.NoError(suite.client.StopTrial(ctx, 3, StopTrialRequest{TrialUUID: "t-1", Reason: "patience"}))
+	suite.Equal([]string{"t-1"}, suite.engine.StoppedTrials())
+
+	suite.NoError(suite.client.CancelTrain(ctx, 4))
+	suite.NoError(suite.client.FinishTrain(ctx, 3))
+	running, err = suite.client.Running(ctx)
+	suite.NoError(err)
+	suite.Empty(running)
+	suite.Equal([]int{3}, suite.engine.Finished())
+	suite.Equal(http.StatusNotFound, StatusCode(suite.client.StopTrial(ctx, 3, StopTrialRequest{TrialUUID: "t-2"})))
+}
+
+func (suite *ClientTestSuite) TestEngineError() {
+	suite.engine.Fail(http.MethodPost, "/api/train", http.StatusServiceUnavailable)
+
+	err := suite.client.Train(context.Background(), ModelingRequest{ModelingID: 1})
+	suite.Error(err)
+	suite.Equal(http.StatusServiceUnavailable, StatusCode(err))
+	suite.False(IsUnreachable(err))
+	suite.Equal(logger.CODE_REMOTE_RESPONSE.Code, Report(err).Code)
+}
+
+func (suite *ClientTestSuite) TestUnreachable() {
+	addr := suite.engine.URL()
+	suite.engine.Close()
+
+	_, err := New(addr, time.Second).Running(context.Background())
+	suite.True(IsUnreachable(err))
+	suite.Equal(logger.CODE_REMOTE_REQUEST.Code, Report(err).Code)
+}
+
+func (suite *ClientTestSuite) TestContextCanceled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := suite.client.Running(ctx)
+	suite.ErrorIs(err, context.Canceled)
+	suite.Empty(suite.engine.Requests("/api/train/running"))
+}
+
+func (suite *ClientTestSuite) TestInference() {
+	ctx := context.Background()
+
+	loaded, err := suite.client.Load(ctx, LoadRequest{DeviceID: "0", ModelName: "resnet", ModelPath: "/models/resnet.pt", ModelType: "vision"})
+	suite.NoError(err)
+	num := loaded.Models[0].ModelNum
+
+	result, err := suite.client.InferVCLS(ctx, VCLSRequest{
+		DeviceID:  "0",
+		ModelName: "resnet",
+		ModelNum:  num,
+		Heatmap:   "false",
+		Filename:  "cat.png",
+		Image:     strings.NewReader("png"),
+	})
+	suite.NoError(err)
+	suite.Equal([]interface{}{"fake"}, result["prediction"])
+
+	suite.NoError(suite.client.Unload(ctx, UnloadRequest{DeviceID: "0", ModelName: "resnet", ModelNum: num}))
+	suite.Error(suite.client.Unload(ctx, UnloadRequest{DeviceID: "0", ModelName: "resnet", ModelNum: num}))
+
+	_, err = suite.client.InferVCLS(ctx, VCLSRequest{ModelName: "resnet", ModelNum: num, Filename: "cat.png", Image: strings.NewReader("png")})
+	suite.Equal(http.StatusNotFound, StatusCode(err))
+}
+
+func (suite *ClientTestSuite) TestSys() {
+	info, err := suite.client.Sys(context.Background(), SysRequest{DBHost: "db", DBPort: 5432, DBName: "kais"})
+	suite.NoError(err)
+	suite.Len(info.GPUs, 2)
+
+	body := string(suite.engine.Requests("/api/sys")[0].Body)
+	suite.Contains(body, `"DB_HOST":"db"`)
+	suite.Contains(body, `"DB_PORT":5432`)
+}
+
+func TestClientTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientTestSuite))
+}
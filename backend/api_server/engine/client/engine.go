@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	device_repo "api_server/device/repository"
+	"api_server/utils"
+)
+
+// Engine은 device에 설치된 engine 하나를 호출합니다.
+// Device.connection이 grpc이면 *GRPCClient, 그 밖에는 REST *Client를 사용합니다.
+type Engine interface {
+	Addr() string
+	Train(ctx context.Context, req interface{}) error
+	Evaluate(ctx context.Context, req interface{}) error
+	Blind(ctx context.Context, req interface{}) error
+	FinishTrain(ctx context.Context, modelingID int) error
+	CancelTrain(ctx context.Context, modelingID int) error
+	StopTrial(ctx context.Context, modelingID int, req StopTrialRequest) error
+	Running(ctx context.Context) ([]int, error)
+	Sys(ctx context.Context, req SysRequest) (*device_repo.EngineInfoDTO, error)
+	Load(ctx context.Context, req LoadRequest) (*LoadResponse, error)
+	Unload(ctx context.Context, req UnloadRequest) error
+	InferVCLS(ctx context.Context, req VCLSRequest) (map[string]interface{}, error)
+	InferTabular(ctx context.Context, req TabularRequest) (map[string]interface{}, error)
+	Remove(ctx context.Context, targetPath string) error
+}
+
+var (
+	_ Engine = (*Client)(nil)
+	_ Engine = (*GRPCClient)(nil)
+)
+
+// ForDevice는 device의 connection에 맞는 Engine을 만듭니다. connection이 비어 있으면 REST입니다.
+func ForDevice(ip string, port int, connection string, timeout time.Duration) Engine {
+	if connection == utils.DEVICE_CONNECTION_GRPC {
+		return NewGRPC(net.JoinHostPort(ip, strconv.Itoa(port)), timeout)
+	}
+
+	return New("http://"+ip+":"+strconv.Itoa(port), timeout)
+}
@@ -0,0 +1,278 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	device_repo "api_server/device/repository"
+	task_repo "api_server/task/repository"
+)
+
+// ModelingRequest는 단일 engine에 modeling 실행을 요청하는 본문입니다.
+type ModelingRequest struct {
+	ModelingID int `json:"modeling_id"`
+}
+
+// RunningResponse는 GET /api/train/running 응답입니다.
+type RunningResponse struct {
+	ModelingIDs []int `json:"modeling_ids"`
+}
+
+// StopTrialRequest는 실행 중인 modeling의 trial 하나를 중단하는 POST /api/train/{id}/trial/stop 요청입니다.
+type StopTrialRequest struct {
+	TrialUUID string `json:"trial_uuid"`
+	Reason    string `json:"reason"`
+}
+
+// SysRequest는 engine에 DB 접속 정보를 알려주고 장비 정보를 받는 POST /api/sys 요청입니다.
+type SysRequest struct {
+	DBHost   string `json:"DB_HOST"`
+	DBPort   int    `json:"DB_PORT"`
+	DBName   string `json:"DB_NAME"`
+	TestPath string `json:"TEST_PATH"`
+}
+
+// LoadRequest는 추론할 모델을 GPU에 올리는 POST /api/load 요청입니다.
+type LoadRequest struct {
+	DeviceID  string `json:"device_id"`
+	ModelName string `json:"model_name"`
+	ModelPath string `json:"model_path"`
+	ModelType string `json:"model_type"`
+}
+
+// LoadedModel은 engine이 GPU에 올린 모델입니다.
+type LoadedModel struct {
+	ModelNum  int    `json:"model_num"`
+	ModelFile string `json:"model_file"`
+	Engine    string `json:"engine"`
+}
+
+// LoadResponse는 POST /api/load 응답입니다.
+type LoadResponse struct {
+	Name   string        `json:"name"`
+	Models []LoadedModel `json:"models"`
+}
+
+// UnloadRequest는 GPU에 올린 모델을 내리는 DELETE /api/model 요청입니다.
+type UnloadRequest struct {
+	DeviceID  string `json:"device_id"`
+	ModelName string `json:"model_name"`
+	ModelNum  int    `json:"model_num"`
+}
+
+// VCLSRequest는 이미지 분류 모델로 추론하는 POST /api/vcls 요청입니다.
+type VCLSRequest struct {
+	DeviceID  string
+	ModelName string
+	ModelNum  int
+	Heatmap   string
+	Filename  string
+	Image     io.Reader
+}
+
+// TabularRequest는 tabular 모델로 추론하는 POST /api/tabular 요청입니다. XInput은 입력 feature의 JSON 문자열입니다.
+type TabularRequest struct {
+	DeviceID  string
+	ModelName string
+	ModelNum  int
+	XInput    string
+}
+
+// Train은 engine에 modeling 학습을 요청합니다. req는 ModelingRequest 또는 multi node 요청입니다.
+func (c *Client) Train(ctx context.Context, req interface{}) error {
+	_, err := c.doJSON(ctx, http.MethodPost, "/api/train", req, nil)
+	return err
+}
+
+// Evaluate는 engine에 학습된 modeling의 평가를 요청합니다.
+func (c *Client) Evaluate(ctx context.Context, req interface{}) error {
+	_, err := c.doJSON(ctx, http.MethodPost, "/api/evaluation", req, nil)
+	return err
+}
+
+// Blind는 engine에 vision modeling의 blind test를 요청합니다.
+func (c *Client) Blind(ctx context.Context, req interface{}) error {
+	_, err := c.doJSON(ctx, http.MethodPost, "/api/vision/blind", req, nil)
+	return err
+}
+
+// FinishTrain은 학습이 끝난 modeling의 후처리를 요청합니다.
+func (c *Client) FinishTrain(ctx context.Context, modelingID int) error {
+	_, err := c.doJSON(ctx, http.MethodPost, "/api/train/finish", ModelingRequest{ModelingID: modelingID}, nil)
+	return err
+}
+
+// CancelTrain은 실행 중인 modeling을 중단합니다.
+func (c *Client) CancelTrain(ctx context.Context, modelingID int) error {
+	_, err := c.doJSON(ctx, http.MethodDelete, "/api/train/"+strconv.Itoa(modelingID), nil, nil)
+	return err
+}
+
+// StopTrial은 실행 중인 modeling의 trial 하나만 중단합니다. modeling은 다음 trial을 계속 진행합니다.
+func (c *Client) StopTrial(ctx context.Context, modelingID int, req StopTrialRequest) error {
+	_, err := c.doJSON(ctx, http.MethodPost, "/api/train/"+strconv.Itoa(modelingID)+"/trial/stop", req, nil)
+	return err
+}
+
+// Running은 engine이 실행 중인 modeling ID 목록을 조회합니다.
+func (c *Client) Running(ctx context.Context) ([]int, error) {
+	resp := RunningResponse{}
+	if _, err := c.doJSON(ctx, http.MethodGet, "/api/train/running", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.ModelingIDs, nil
+}
+
+// Sys는 engine에 DB 접속 정보를 전달하고 CPU, disk, GPU 정보를 조회합니다.
+func (c *Client) Sys(ctx context.Context, req SysRequest) (*device_repo.EngineInfoDTO, error) {
+	info := device_repo.EngineInfoDTO{}
+	if _, err := c.doJSON(ctx, http.MethodPost, "/api/sys", req, &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// Load는 추론할 모델을 GPU에 올립니다.
+func (c *Client) Load(ctx context.Context, req LoadRequest) (*LoadResponse, error) {
+	resp := LoadResponse{}
+	if _, err := c.doJSON(ctx, http.MethodPost, "/api/load", req, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Models) < 1 {
+		return nil, &Error{Method: http.MethodPost, URL: c.addr + "/api/load", StatusCode: http.StatusOK, Err: fmt.Errorf("no model loaded for %s", req.ModelName)}
+	}
+
+	return &resp, nil
+}
+
+// Unload는 GPU에 올린 모델을 내립니다. engine이 성공을 알리지 않으면 오류입니다.
+func (c *Client) Unload(ctx context.Context, req UnloadRequest) error {
+	body, err := c.doJSON(ctx, http.MethodDelete, "/api/model", req, nil)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(string(body), "Successfully") {
+		return &Error{Method: http.MethodDelete, URL: c.addr + "/api/model", StatusCode: http.StatusOK, Body: string(body), Err: fmt.Errorf("unload failed: %s", string(body))}
+	}
+
+	return nil
+}
+
+// InferVCLS는 이미지 하나를 이미지 분류 모델로 추론합니다.
+func (c *Client) InferVCLS(ctx context.Context, req VCLSRequest) (map[string]interface{}, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+
+	fw, err := w.CreateFormFile("file", req.Filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(fw, req.Image); err != nil {
+		return nil, err
+	}
+
+	return c.postForm(ctx, "/api/vcls", &b, w, map[string]string{
+		"device_id":  req.DeviceID,
+		"model_name": req.ModelName,
+		"model_num":  strconv.Itoa(req.ModelNum),
+		"heatmap":    req.Heatmap,
+	})
+}
+
+// InferTabular는 tabular 모델로 추론합니다.
+func (c *Client) InferTabular(ctx context.Context, req TabularRequest) (map[string]interface{}, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+
+	return c.postForm(ctx, "/api/tabular", &b, w, map[string]string{
+		"device_id":  req.DeviceID,
+		"model_name": req.ModelName,
+		"model_num":  strconv.Itoa(req.ModelNum),
+		"x_input":    req.XInput,
+	})
+}
+
+// PredictTabular는 목표 y 값을 만드는 x 값을 찾습니다. engine이 빈 본문을 응답하면 nil 입니다.
+func (c *Client) PredictTabular(ctx context.Context, req interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if _, err := c.doJSON(ctx, http.MethodPost, "/api/tabular/predict_x", req, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// LimeTabular는 입력 x 값에 대한 LIME feature importance를 계산합니다. engine이 빈 본문을 응답하면 nil 입니다.
+func (c *Client) LimeTabular(ctx context.Context, req interface{}) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	if _, err := c.doJSON(ctx, http.MethodPost, "/api/tabular/lime", req, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// TabularColumns는 학습에 사용한 dataset의 입력, 목표 column을 조회합니다.
+func (c *Client) TabularColumns(ctx context.Context, dataInformPath string) (*task_repo.ColumnsResponse, error) {
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	if err := w.WriteField("data_inform_path", dataInformPath); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	body, err := c.do(ctx, http.MethodPost, "/api/tabular/columns", w.FormDataContentType(), &b)
+	if err != nil {
+		return nil, err
+	}
+	columns := task_repo.ColumnsResponse{}
+	if err := decode(body, &columns); err != nil {
+		return nil, &Error{Method: http.MethodPost, URL: c.addr + "/api/tabular/columns", StatusCode: http.StatusOK, Body: string(body), Err: err}
+	}
+
+	return &columns, nil
+}
+
+// Remove는 engine이 저장한 학습 결과 경로를 삭제합니다.
+func (c *Client) Remove(ctx context.Context, targetPath string) error {
+	_, err := c.doJSON(ctx, http.MethodPost, "/api/remove", map[string]string{"target_path": targetPath}, nil)
+	return err
+}
+
+// Dataset은 dataset 분석 요청(POST /api/dataset/{name})을 보내고 응답 본문을 반환합니다.
+func (c *Client) Dataset(ctx context.Context, name string, req interface{}) ([]byte, error) {
+	return c.doJSON(ctx, http.MethodPost, "/api/dataset/"+name, req, nil)
+}
+
+// postForm은 fields를 더한 multipart 요청을 보내고 JSON 응답을 읽습니다.
+func (c *Client) postForm(ctx context.Context, path string, b *bytes.Buffer, w *multipart.Writer, fields map[string]string) (map[string]interface{}, error) {
+	for key, value := range fields {
+		if err := w.WriteField(key, value); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	body, err := c.do(ctx, http.MethodPost, path, w.FormDataContentType(), b)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]interface{}
+	if err := decode(body, &result); err != nil {
+		return nil, &Error{Method: http.MethodPost, URL: c.addr + path, StatusCode: http.StatusOK, Body: string(body), Err: err}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,381 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	device_repo "api_server/device/repository"
+	"api_server/engine/enginepb"
+	"api_server/ent/schema"
+	"api_server/logger"
+	"api_server/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// grpcMethod는 gRPC 요청 오류의 Error.Method 입니다.
+const grpcMethod = "gRPC"
+
+// GRPCClient는 KAI.S engine의 gRPC API(engine/enginepb/engine.proto)를 호출합니다.
+// 요청, 응답, 오류는 REST Client와 같은 타입을 사용하므로 호출하는 쪽은 transport를 구분하지 않아도 됩니다.
+type GRPCClient struct {
+	addr    string
+	timeout time.Duration
+	engine  enginepb.EngineClient
+	err     error // 연결을 만들지 못한 이유, 모든 요청이 이 오류로 실패
+}
+
+// grpcConns는 주소별 gRPC 연결입니다. 연결은 요청할 때 맺고, 끊어지면 gRPC가 다시 연결하므로 닫지 않고 재사용합니다.
+var grpcConns = struct {
+	sync.Mutex
+	conns map[string]*grpc.ClientConn
+}{conns: make(map[string]*grpc.ClientConn)}
+
+// NewGRPC는 addr(예: 10.0.0.1:5001)의 engine을 호출하는 GRPCClient를 만듭니다.
+// timeout이 0 이하이면 ctx 외에는 요청 시간을 제한하지 않습니다. Events stream에는 timeout을 적용하지 않습니다.
+func NewGRPC(addr string, timeout time.Duration) *GRPCClient {
+	grpcConns.Lock()
+	defer grpcConns.Unlock()
+
+	conn, ok := grpcConns.conns[addr]
+	if !ok {
+		var err error
+		conn, err = grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return &GRPCClient{addr: addr, timeout: timeout, err: err}
+		}
+		grpcConns.conns[addr] = conn
+	}
+
+	return newGRPCClient(addr, conn, timeout)
+}
+
+func newGRPCClient(addr string, conn grpc.ClientConnInterface, timeout time.Duration) *GRPCClient {
+	return &GRPCClient{addr: addr, timeout: timeout, engine: enginepb.NewEngineClient(conn)}
+}
+
+// WithTimeout은 요청 시간 제한만 바꾼 GRPCClient를 반환합니다.
+func (c *GRPCClient) WithTimeout(timeout time.Duration) *GRPCClient {
+	return &GRPCClient{addr: c.addr, timeout: timeout, engine: c.engine, err: c.err}
+}
+
+// Addr는 engine 주소입니다.
+func (c *GRPCClient) Addr() string {
+	return c.addr
+}
+
+// call은 timeout을 적용해 fn을 실행하고, 실패하면 *Error로 바꿉니다.
+func (c *GRPCClient) call(ctx context.Context, method string, fn func(ctx context.Context) error) error {
+	if c.err != nil {
+		return &Error{Method: grpcMethod, URL: c.addr + "/" + method, Err: c.err}
+	}
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	if err := fn(ctx); err != nil {
+		return grpcError(c.addr, method, err)
+	}
+	logger.Debug(grpcMethod, c.addr, method)
+
+	return nil
+}
+
+// grpcError는 gRPC 오류를 *Error로 바꿉니다. 연결하지 못했거나 시간이 지난 요청은 StatusCode가 0 입니다.
+func grpcError(addr, method string, err error) *Error {
+	s := status.Convert(err)
+	return &Error{Method: grpcMethod, URL: addr + "/" + method, StatusCode: httpStatus(s.Code()), Body: s.Message(), Err: err}
+}
+
+// httpStatus는 gRPC 상태 코드에 해당하는 HTTP 상태 코드입니다.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return 0
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// trainRequest는 REST 요청 본문(ModelingRequest 또는 multi node 요청)을 TrainRequest로 바꿉니다.
+// 두 요청의 JSON field 이름은 proto field 이름과 같습니다.
+func trainRequest(job string, req interface{}) (*enginepb.TrainRequest, error) {
+	jsonBytes, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	train := &enginepb.TrainRequest{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(jsonBytes, train); err != nil {
+		return nil, err
+	}
+	train.Job = job
+
+	return train, nil
+}
+
+func (c *GRPCClient) train(ctx context.Context, job string, req interface{}) error {
+	train, err := trainRequest(job, req)
+	if err != nil {
+		return err
+	}
+
+	return c.call(ctx, "Train", func(ctx context.Context) error {
+		_, err := c.engine.Train(ctx, train)
+		return err
+	})
+}
+
+// Train은 engine에 modeling 학습을 요청합니다. req는 ModelingRequest 또는 multi node 요청입니다.
+func (c *GRPCClient) Train(ctx context.Context, req interface{}) error {
+	return c.train(ctx, utils.EXECUTOR_JOB_TRAIN, req)
+}
+
+// Evaluate는 engine에 학습된 modeling의 평가를 요청합니다.
+func (c *GRPCClient) Evaluate(ctx context.Context, req interface{}) error {
+	return c.train(ctx, utils.EXECUTOR_JOB_EVALUATION, req)
+}
+
+// Blind는 engine에 vision modeling의 blind test를 요청합니다.
+func (c *GRPCClient) Blind(ctx context.Context, req interface{}) error {
+	return c.train(ctx, utils.EXECUTOR_JOB_BLIND, req)
+}
+
+// FinishTrain은 학습이 끝난 modeling의 후처리를 요청합니다.
+func (c *GRPCClient) FinishTrain(ctx context.Context, modelingID int) error {
+	return c.train(ctx, utils.EXECUTOR_JOB_FINISH, ModelingRequest{ModelingID: modelingID})
+}
+
+// CancelTrain은 실행 중인 modeling을 중단합니다.
+func (c *GRPCClient) CancelTrain(ctx context.Context, modelingID int) error {
+	return c.call(ctx, "Cancel", func(ctx context.Context) error {
+		_, err := c.engine.Cancel(ctx, &enginepb.CancelRequest{ModelingId: int32(modelingID)})
+		return err
+	})
+}
+
+// StopTrial은 실행 중인 modeling의 trial 하나만 중단합니다. modeling은 다음 trial을 계속 진행합니다.
+func (c *GRPCClient) StopTrial(ctx context.Context, modelingID int, req StopTrialRequest) error {
+	return c.call(ctx, "Cancel", func(ctx context.Context) error {
+		_, err := c.engine.Cancel(ctx, &enginepb.CancelRequest{ModelingId: int32(modelingID), TrialUuid: req.TrialUUID, Reason: req.Reason})
+		return err
+	})
+}
+
+func (c *GRPCClient) status(ctx context.Context, req *enginepb.StatusRequest) (*enginepb.StatusResponse, error) {
+	var resp *enginepb.StatusResponse
+	err := c.call(ctx, "Status", func(ctx context.Context) (err error) {
+		resp, err = c.engine.Status(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// Running은 engine이 실행 중인 modeling ID 목록을 조회합니다.
+func (c *GRPCClient) Running(ctx context.Context) ([]int, error) {
+	resp, err := c.status(ctx, &enginepb.StatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int{}
+	for _, id := range resp.GetRunningModelingIds() {
+		ids = append(ids, int(id))
+	}
+
+	return ids, nil
+}
+
+// Sys는 CPU, disk, GPU 정보를 조회합니다. gRPC engine은 DB에 접속하지 않으므로 DB 접속 정보는 보내지 않습니다.
+func (c *GRPCClient) Sys(ctx context.Context, req SysRequest) (*device_repo.EngineInfoDTO, error) {
+	resp, err := c.status(ctx, &enginepb.StatusRequest{TestPath: req.TestPath})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertStatusToEngineInfo(resp), nil
+}
+
+func convertStatusToEngineInfo(resp *enginepb.StatusResponse) *device_repo.EngineInfoDTO {
+	info := &device_repo.EngineInfoDTO{
+		DeviceName: resp.GetDeviceName(),
+		CPU:        device_repo.CPUInfo{Uilization: resp.GetCpu().GetUtilizationCpu()},
+		DISK: device_repo.DiskInfo{
+			Free:    resp.GetDisk().GetFree(),
+			Used:    resp.GetDisk().GetUsed(),
+			Total:   resp.GetDisk().GetTotal(),
+			Percent: resp.GetDisk().GetPercent(),
+		},
+	}
+	for _, gpu := range resp.GetGpu() {
+		info.GPUs = append(info.GPUs, device_repo.GPUInfo{
+			Index:             int(gpu.GetIndex()),
+			Name:              gpu.GetName(),
+			UUID:              gpu.GetUuid(),
+			MemoryTotal:       gpu.GetMemoryTotal(),
+			MemoryUsed:        gpu.GetMemoryUsed(),
+			UtilizationGPU:    gpu.GetUtilizationGpu(),
+			UtilizationMemory: gpu.GetUtilizationMemory(),
+			Temperature:       gpu.GetTemperature(),
+			PowerDraw:         gpu.GetPowerDraw(),
+		})
+	}
+	if len(resp.GetEngineTypes()) > 0 || resp.GetEngineVersion() != "" {
+		info.Capabilities = &schema.DeviceCapabilities{
+			EngineTypes:   resp.GetEngineTypes(),
+			EngineVersion: resp.GetEngineVersion(),
+		}
+	}
+
+	return info
+}
+
+// Load는 추론할 모델을 GPU에 올립니다.
+func (c *GRPCClient) Load(ctx context.Context, req LoadRequest) (*LoadResponse, error) {
+	var resp *enginepb.LoadResponse
+	err := c.call(ctx, "Load", func(ctx context.Context) (err error) {
+		resp, err = c.engine.Load(ctx, &enginepb.LoadRequest{
+			DeviceId:  req.DeviceID,
+			ModelName: req.ModelName,
+			ModelPath: req.ModelPath,
+			ModelType: req.ModelType,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetModels()) < 1 {
+		return nil, &Error{Method: grpcMethod, URL: c.addr + "/Load", StatusCode: http.StatusOK, Err: fmt.Errorf("no model loaded for %s", req.ModelName)}
+	}
+
+	loaded := &LoadResponse{Name: resp.GetName()}
+	for _, model := range resp.GetModels() {
+		loaded.Models = append(loaded.Models, LoadedModel{
+			ModelNum:  int(model.GetModelNum()),
+			ModelFile: model.GetModelFile(),
+			Engine:    model.GetEngine(),
+		})
+	}
+
+	return loaded, nil
+}
+
+// Unload는 GPU에 올린 모델을 내립니다.
+func (c *GRPCClient) Unload(ctx context.Context, req UnloadRequest) error {
+	return c.call(ctx, "Unload", func(ctx context.Context) error {
+		_, err := c.engine.Unload(ctx, &enginepb.UnloadRequest{
+			DeviceId:  req.DeviceID,
+			ModelName: req.ModelName,
+			ModelNum:  int32(req.ModelNum),
+		})
+		return err
+	})
+}
+
+func (c *GRPCClient) infer(ctx context.Context, req *enginepb.InferRequest) (map[string]interface{}, error) {
+	var resp *enginepb.InferResponse
+	err := c.call(ctx, "Infer", func(ctx context.Context) (err error) {
+		resp, err = c.engine.Infer(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	if err := decode([]byte(resp.GetResultJson()), &result); err != nil {
+		return nil, &Error{Method: grpcMethod, URL: c.addr + "/Infer", StatusCode: http.StatusOK, Body: resp.GetResultJson(), Err: err}
+	}
+
+	return result, nil
+}
+
+// InferVCLS는 이미지 하나를 이미지 분류 모델로 추론합니다.
+func (c *GRPCClient) InferVCLS(ctx context.Context, req VCLSRequest) (map[string]interface{}, error) {
+	image, err := io.ReadAll(req.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.infer(ctx, &enginepb.InferRequest{
+		DeviceId:  req.DeviceID,
+		ModelName: req.ModelName,
+		ModelNum:  int32(req.ModelNum),
+		Input: &enginepb.InferRequest_Vcls{Vcls: &enginepb.VCLSInput{
+			Filename: req.Filename,
+			Image:    image,
+			Heatmap:  req.Heatmap,
+		}},
+	})
+}
+
+// InferTabular는 tabular 모델로 추론합니다.
+func (c *GRPCClient) InferTabular(ctx context.Context, req TabularRequest) (map[string]interface{}, error) {
+	return c.infer(ctx, &enginepb.InferRequest{
+		DeviceId:  req.DeviceID,
+		ModelName: req.ModelName,
+		ModelNum:  int32(req.ModelNum),
+		Input:     &enginepb.InferRequest_Tabular{Tabular: &enginepb.TabularInput{XInput: req.XInput}},
+	})
+}
+
+// Remove는 engine이 저장한 학습 결과 경로를 삭제합니다.
+func (c *GRPCClient) Remove(ctx context.Context, targetPath string) error {
+	return c.call(ctx, "Remove", func(ctx context.Context) error {
+		_, err := c.engine.Remove(ctx, &enginepb.RemoveRequest{TargetPath: targetPath})
+		return err
+	})
+}
+
+// Events는 engine의 Events stream을 열고 받은 event마다 handle을 호출합니다.
+// ctx가 취소되거나 stream이 끊어지거나 handle이 오류를 반환할 때까지 반환하지 않습니다.
+// engine이 stream을 정상 종료하면 nil 입니다.
+func (c *GRPCClient) Events(ctx context.Context, handle func(event *enginepb.EngineEvent) error) error {
+	if c.err != nil {
+		return &Error{Method: grpcMethod, URL: c.addr + "/Events", Err: c.err}
+	}
+
+	stream, err := c.engine.Events(ctx, &enginepb.EventsRequest{})
+	if err != nil {
+		return grpcError(c.addr, "Events", err)
+	}
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return grpcError(c.addr, "Events", err)
+		}
+		if err := handle(event); err != nil {
+			return err
+		}
+	}
+}
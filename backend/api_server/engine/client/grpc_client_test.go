@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"api_server/engine/enginepb"
+	"api_server/engine/fake"
+	"api_server/logger"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GRPCClientTestSuite struct {
+	suite.Suite
+	engine *fake.GRPCEngine
+	client Engine
+}
+
+func (suite *GRPCClientTestSuite) SetupTest() {
+	logger.InitLogger("", "/workspace/log/test.log")
+	suite.engine = fake.NewGRPC(2)
+	suite.client = ForDevice(suite.engine.IP(), suite.engine.Port(), utils.DEVICE_CONNECTION_GRPC, time.Second)
+}
+
+func (suite *GRPCClientTestSuite) TearDownTest() {
+	suite.engine.Close()
+}
+
+func (suite *GRPCClientTestSuite) TestForDevice() {
+	suite.IsType(&GRPCClient{}, suite.client)
+	suite.IsType(&Client{}, ForDevice("127.0.0.1", 5000, utils.DEVICE_CONNECTION_REST, time.Second))
+	suite.IsType(&Client{}, ForDevice("127.0.0.1", 5000, "", time.Second))
+}
+
+func (suite *GRPCClientTestSuite) TestTrainLifecycle() {
+	ctx := context.Background()
+
+	suite.NoError(suite.client.Train(ctx, ModelingRequest{ModelingID: 3}))
+	suite.NoError(suite.client.Evaluate(ctx, map[string]interface{}{
+		"modeling_id": 4,
+		"rank":        1,
+		"world_size":  2,
+		"master_addr": "10.0.0.1",
+		"device_ids":  []int{7, 8},
+		"nodes":       []map[string]interface{}{{"rank": 0, "ip": "10.0.0.1", "port": 5001, "device_ids": []int{5}}},
+	}))
+	running, err := suite.client.Running(ctx)
+	suite.NoError(err)
+	suite.Equal([]int{3, 4}, running)
+
+	trains := suite.engine.Trains()
+	suite.Equal(utils.EXECUTOR_JOB_TRAIN, trains[0].GetJob())
+	suite.Equal(utils.EXECUTOR_JOB_EVALUATION, trains[1].GetJob())
+	suite.Equal(int32(2), trains[1].GetWorldSize())
+	suite.Equal([]int32{7, 8}, trains[1].GetDeviceIds())
+	suite.Equal("10.0.0.1", trains[1].GetNodes()[0].GetIp())
+
+	suite.NoError(suite.client.StopTrial(ctx, 3, StopTrialRequest{TrialUUID: "t-1", Reason: "patience"}))
+	suite.Equal([]string{"t-1"}, suite.engine.StoppedTrials())
+	suite.NoError(suite.client.CancelTrain(ctx, 4))
+	suite.NoError(suite.client.FinishTrain(ctx, 3))
+	running, err = suite.client.Running(ctx)
+	suite.NoError(err)
+	suite.Empty(running)
+	suite.Equal([]int{3}, suite.engine.Finished())
+
+	err = suite.client.StopTrial(ctx, 3, StopTrialRequest{TrialUUID: "t-2"})
+	suite.Equal(http.StatusNotFound, StatusCode(err))
+	suite.False(IsUnreachable(err))
+	suite.Equal(logger.CODE_REMOTE_RESPONSE.Code, Report(err).Code)
+}
+
+func (suite *GRPCClientTestSuite) TestSys() {
+	info, err := suite.client.Sys(context.Background(), SysRequest{TestPath: "/task/"})
+	suite.NoError(err)
+	suite.Equal("fake-grpc-engine", info.DeviceName)
+	suite.Len(info.GPUs, 2)
+	suite.Equal(float64(16384), info.GPUs[1].MemoryTotal)
+	suite.Equal(uint64(100), info.DISK.Total)
+}
+
+func (suite *GRPCClientTestSuite) TestInference() {
+	ctx := context.Background()
+
+	loaded, err := suite.client.Load(ctx, LoadRequest{DeviceID: "0", ModelName: "m", ModelPath: "/m"})
+	suite.NoError(err)
+	suite.Equal(1, loaded.Models[0].ModelNum)
+
+	result, err := suite.client.InferVCLS(ctx, VCLSRequest{ModelName: "m", ModelNum: 1, Filename: "a.png", Image: strings.NewReader("png")})
+	suite.NoError(err)
+	suite.Equal([]interface{}{"fake"}, result["prediction"])
+	_, err = suite.client.InferTabular(ctx, TabularRequest{ModelName: "m", ModelNum: 1, XInput: "{}"})
+	suite.NoError(err)
+
+	suite.NoError(suite.client.Unload(ctx, UnloadRequest{ModelName: "m", ModelNum: 1}))
+	_, err = suite.client.InferTabular(ctx, TabularRequest{ModelName: "m", ModelNum: 1, XInput: "{}"})
+	suite.Equal(http.StatusNotFound, StatusCode(err))
+}
+
+func (suite *GRPCClientTestSuite) TestEvents() {
+	suite.engine.Emit(&enginepb.EngineEvent{Event: &enginepb.EngineEvent_Log{Log: &enginepb.LogRecord{ModelingId: 3, Message: "epoch 1"}}})
+	suite.engine.Emit(&enginepb.EngineEvent{Event: &enginepb.EngineEvent_Modeling{Modeling: &enginepb.ModelingProgress{ModelingId: 3, Progress: 0.5}}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	received := []*enginepb.EngineEvent{}
+	err := suite.client.(*GRPCClient).Events(ctx, func(event *enginepb.EngineEvent) error {
+		received = append(received, event)
+		if len(received) == 2 {
+			cancel()
+		}
+		return nil
+	})
+	suite.Error(err)
+	suite.Len(received, 2)
+	suite.Equal("epoch 1", received[0].GetLog().GetMessage())
+	suite.Equal(0.5, received[1].GetModeling().GetProgress())
+}
+
+func (suite *GRPCClientTestSuite) TestUnreachable() {
+	addr := suite.engine.Addr()
+	suite.engine.Close()
+
+	_, err := NewGRPC(addr, time.Second).Running(context.Background())
+	suite.True(IsUnreachable(err))
+	suite.Equal(logger.CODE_REMOTE_REQUEST.Code, Report(err).Code)
+}
+
+func TestGRPCClientTestSuite(t *testing.T) {
+	suite.Run(t, new(GRPCClientTestSuite))
+}
@@ -0,0 +1,447 @@
+package fake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+
+	device_repo "api_server/device/repository"
+	"api_server/ent/schema"
+)
+
+// Request는 fake engine이 받은 요청입니다.
+type Request struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// Engine은 GPU 없이 scheduler와 추론 흐름을 시험하기 위한 KAI.S engine REST 서버입니다.
+// 학습 요청을 받은 modeling은 Finish나 취소 요청 전까지 실행 중으로 응답하며,
+// /api/sys는 GPUs 개수만큼의 가짜 GPU 정보를 응답합니다.
+type Engine struct {
+	server *httptest.Server
+	gpus   int
+
+	mu        sync.Mutex
+	running   map[int]bool
+	finished  []int
+	removed   []string
+	stopped   []string       // 중단 요청받은 trial UUID
+	loaded    map[int]string // model_num -> model_name
+	modelNum  int
+	failures  map[string]int // "METHOD path" -> 응답할 HTTP 상태 코드
+	requests  []Request
+	inference map[string]interface{}
+
+	capabilities *schema.DeviceCapabilities
+}
+
+// New는 gpus개의 GPU를 가진 fake engine을 시작합니다. 사용이 끝나면 Close를 호출해야 합니다.
+func New(gpus int) *Engine {
+	e := &Engine{
+		gpus:     gpus,
+		running:  make(map[int]bool),
+		loaded:   make(map[int]string),
+		failures: make(map[string]int),
+		inference: map[string]interface{}{
+			"prediction":     []interface{}{"fake"},
+			"probabilities":  []interface{}{[]interface{}{[]interface{}{"fake", 1.0}}},
+			"inference_time": 0.01,
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/train", e.handleRun)
+	mux.HandleFunc("POST /api/evaluation", e.handleRun)
+	mux.HandleFunc("POST /api/vision/blind", e.handleRun)
+	mux.HandleFunc("POST /api/train/finish", e.handleFinish)
+	mux.HandleFunc("GET /api/train/running", e.handleRunning)
+	mux.HandleFunc("DELETE /api/train/{id}", e.handleCancel)
+	mux.HandleFunc("POST /api/train/{id}/trial/stop", e.handleStopTrial)
+	mux.HandleFunc("POST /api/sys", e.handleSys)
+	mux.HandleFunc("POST /api/load", e.handleLoad)
+	mux.HandleFunc("DELETE /api/model", e.handleUnload)
+	mux.HandleFunc("POST /api/vcls", e.handleInference)
+	mux.HandleFunc("POST /api/tabular", e.handleInference)
+	mux.HandleFunc("POST /api/tabular/predict_x", e.handleInference)
+	mux.HandleFunc("POST /api/tabular/lime", e.handleInference)
+	mux.HandleFunc("POST /api/tabular/columns", e.handleColumns)
+	mux.HandleFunc("POST /api/remove", e.handleRemove)
+	mux.HandleFunc("POST /api/dataset/{name}", e.handleDataset)
+
+	e.server = httptest.NewServer(e.record(mux))
+
+	return e
+}
+
+// Close는 fake engine을 종료합니다.
+func (e *Engine) Close() {
+	e.server.Close()
+}
+
+// URL은 fake engine 주소(http://127.0.0.1:port)입니다.
+func (e *Engine) URL() string {
+	return e.server.URL
+}
+
+// IP는 device로 등록할 때 사용할 fake engine의 IP 입니다.
+func (e *Engine) IP() string {
+	host, _, _ := net.SplitHostPort(e.server.Listener.Addr().String())
+	return host
+}
+
+// Port는 device로 등록할 때 사용할 fake engine의 port 입니다.
+func (e *Engine) Port() int {
+	_, port, _ := net.SplitHostPort(e.server.Listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return p
+}
+
+// Fail은 method path 요청에 status로 응답하게 합니다. status가 0이면 정상 응답으로 되돌립니다.
+func (e *Engine) Fail(method, path string, status int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if status == 0 {
+		delete(e.failures, method+" "+path)
+	} else {
+		e.failures[method+" "+path] = status
+	}
+}
+
+// SetInference는 /api/vcls, /api/tabular 등의 추론 요청에 응답할 결과를 바꿉니다.
+func (e *Engine) SetInference(result map[string]interface{}) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.inference = result
+}
+
+// Running은 실행 중인 modeling ID 목록입니다.
+func (e *Engine) Running() []int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.runningIDs()
+}
+
+// Finish는 실행 중인 modeling이 끝난 것처럼 실행 목록에서 뺍니다.
+func (e *Engine) Finish(modelingID int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	delete(e.running, modelingID)
+}
+
+// Finished는 후처리(/api/train/finish)를 요청받은 modeling ID 목록입니다.
+func (e *Engine) Finished() []int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]int{}, e.finished...)
+}
+
+// StoppedTrials는 중단을 요청받은 trial UUID 목록입니다.
+func (e *Engine) StoppedTrials() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]string{}, e.stopped...)
+}
+
+// Loaded는 올라가 있는 모델 이름 목록입니다.
+func (e *Engine) Loaded() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	names := []string{}
+	for _, name := range e.loaded {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Removed는 삭제를 요청받은 경로 목록입니다.
+func (e *Engine) Removed() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]string{}, e.removed...)
+}
+
+// Requests는 path로 받은 요청 목록입니다. path가 비어 있으면 모든 요청입니다.
+func (e *Engine) Requests(path string) []Request {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	requests := []Request{}
+	for _, req := range e.requests {
+		if path == "" || req.Path == path {
+			requests = append(requests, req)
+		}
+	}
+
+	return requests
+}
+
+func (e *Engine) runningIDs() []int {
+	ids := []int{}
+	for id := range e.running {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	return ids
+}
+
+// record는 요청을 기록하고 Fail로 지정한 요청에는 오류를 응답합니다.
+func (e *Engine) record(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		e.mu.Lock()
+		e.requests = append(e.requests, Request{Method: r.Method, Path: r.URL.Path, Body: body})
+		status, fail := e.failures[r.Method+" "+r.URL.Path]
+		e.mu.Unlock()
+
+		if fail {
+			http.Error(w, fmt.Sprintf("fake engine failure %d", status), status)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (e *Engine) handleRun(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		ModelingID int `json:"modeling_id"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.ModelingID < 1 {
+		http.Error(w, "modeling_id is required", http.StatusBadRequest)
+		return
+	}
+
+	e.mu.Lock()
+	e.running[req.ModelingID] = true
+	e.mu.Unlock()
+
+	writeJSON(w, map[string]interface{}{"modeling_id": req.ModelingID, "status": "started"})
+}
+
+func (e *Engine) handleFinish(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		ModelingID int `json:"modeling_id"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	e.mu.Lock()
+	delete(e.running, req.ModelingID)
+	e.finished = append(e.finished, req.ModelingID)
+	e.mu.Unlock()
+
+	writeJSON(w, map[string]interface{}{"modeling_id": req.ModelingID, "status": "finished"})
+}
+
+func (e *Engine) handleRunning(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	ids := e.runningIDs()
+	e.mu.Unlock()
+
+	writeJSON(w, map[string]interface{}{"modeling_ids": ids})
+}
+
+func (e *Engine) handleCancel(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	e.mu.Lock()
+	delete(e.running, id)
+	e.mu.Unlock()
+
+	writeJSON(w, map[string]interface{}{"modeling_id": id, "status": "canceled"})
+}
+
+func (e *Engine) handleStopTrial(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	req := struct {
+		TrialUUID string `json:"trial_uuid"`
+	}{}
+	if err == nil {
+		err = json.NewDecoder(r.Body).Decode(&req)
+	}
+	if err != nil || req.TrialUUID == "" {
+		http.Error(w, "trial_uuid is required", http.StatusBadRequest)
+		return
+	}
+
+	e.mu.Lock()
+	running := e.running[id]
+	if running {
+		e.stopped = append(e.stopped, req.TrialUUID)
+	}
+	e.mu.Unlock()
+
+	if !running {
+		http.Error(w, "modeling is not running", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, map[string]interface{}{"modeling_id": id, "trial_uuid": req.TrialUUID, "status": "stopped"})
+}
+
+// SetCapabilities는 /api/sys로 보고할 engine type과 build version을 정합니다. nil이면 보고하지 않습니다.
+func (e *Engine) SetCapabilities(capabilities *schema.DeviceCapabilities) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.capabilities = capabilities
+}
+
+func (e *Engine) handleSys(w http.ResponseWriter, r *http.Request) {
+	info := device_repo.EngineInfoDTO{
+		DeviceName: "fake-engine",
+		CPU:        device_repo.CPUInfo{Uilization: 1},
+		DISK:       device_repo.DiskInfo{Free: 1 << 30, Total: 1 << 31, Used: 1 << 30, Percent: 50},
+		GPUs:       []device_repo.GPUInfo{},
+	}
+	e.mu.Lock()
+	info.Capabilities = e.capabilities
+	e.mu.Unlock()
+	for i := 0; i < e.gpus; i++ {
+		info.GPUs = append(info.GPUs, device_repo.GPUInfo{
+			Index:       i,
+			Name:        "Fake GPU",
+			UUID:        fmt.Sprintf("GPU-fake-%s-%d", e.server.Listener.Addr().String(), i),
+			MemoryTotal: 16384,
+		})
+	}
+
+	writeJSON(w, info)
+}
+
+func (e *Engine) handleLoad(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		ModelName string `json:"model_name"`
+		ModelPath string `json:"model_path"`
+		ModelType string `json:"model_type"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.ModelName == "" {
+		http.Error(w, "model_name is required", http.StatusBadRequest)
+		return
+	}
+
+	e.mu.Lock()
+	e.modelNum++
+	num := e.modelNum
+	e.loaded[num] = req.ModelName
+	e.mu.Unlock()
+
+	writeJSON(w, map[string]interface{}{
+		"name": req.ModelName,
+		"models": []map[string]interface{}{
+			{"model_num": num, "model_file": req.ModelPath, "engine": req.ModelType},
+		},
+	})
+}
+
+func (e *Engine) handleUnload(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		ModelName string `json:"model_name"`
+		ModelNum  int    `json:"model_num"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	e.mu.Lock()
+	name, ok := e.loaded[req.ModelNum]
+	if ok && name == req.ModelName {
+		delete(e.loaded, req.ModelNum)
+	}
+	e.mu.Unlock()
+
+	if !ok || name != req.ModelName {
+		_, _ = w.Write([]byte("Model not found"))
+		return
+	}
+	_, _ = w.Write([]byte("Successfully unloaded " + req.ModelName))
+}
+
+func (e *Engine) handleInference(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/vcls" || r.URL.Path == "/api/tabular" {
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		num, _ := strconv.Atoi(r.FormValue("model_num"))
+		e.mu.Lock()
+		name, ok := e.loaded[num]
+		e.mu.Unlock()
+		if !ok || name != r.FormValue("model_name") {
+			http.Error(w, "model is not loaded", http.StatusNotFound)
+			return
+		}
+	}
+
+	e.mu.Lock()
+	result := e.inference
+	e.mu.Unlock()
+
+	writeJSON(w, result)
+}
+
+func (e *Engine) handleColumns(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]interface{}{
+		"label":           map[string]interface{}{},
+		"label_col_names": []string{"y"},
+		"input_col_names": []string{"x1", "x2"},
+		"numerical_cols":  []string{"x1", "x2"},
+	})
+}
+
+func (e *Engine) handleRemove(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		TargetPath string `json:"target_path"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	e.mu.Lock()
+	e.removed = append(e.removed, req.TargetPath)
+	e.mu.Unlock()
+
+	writeJSON(w, map[string]interface{}{"target_path": req.TargetPath, "status": "removed"})
+}
+
+func (e *Engine) handleDataset(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		StaticPath string `json:"static_path"`
+	}{}
+	_ = json.NewDecoder(r.Body).Decode(&req)
+
+	writeJSON(w, map[string]interface{}{"file_path": req.StaticPath + "/" + r.PathValue("name") + ".json"})
+}
@@ -0,0 +1,283 @@
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+
+	"api_server/engine/enginepb"
+	"api_server/ent/schema"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCEngine은 Device.connection이 grpc인 engine을 시험하기 위한 KAI.S engine gRPC 서버입니다.
+// REST Engine과 같이 학습 요청을 받은 modeling은 Finish나 취소 요청 전까지 실행 중으로 응답하며,
+// Emit으로 넣은 event는 Events stream으로 보냅니다.
+type GRPCEngine struct {
+	enginepb.UnimplementedEngineServer
+
+	server   *grpc.Server
+	listener net.Listener
+	gpus     int
+
+	mu       sync.Mutex
+	running  map[int]bool
+	finished []int
+	stopped  []string
+	removed  []string
+	trains   []*enginepb.TrainRequest
+	loaded   map[int]string
+	modelNum int
+	events   chan *enginepb.EngineEvent
+
+	capabilities *schema.DeviceCapabilities
+}
+
+// NewGRPC는 gpus개의 GPU를 가진 fake gRPC engine을 시작합니다. 사용이 끝나면 Close를 호출해야 합니다.
+func NewGRPC(gpus int) *GRPCEngine {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(fmt.Sprintf("fake grpc engine: %v", err))
+	}
+
+	e := &GRPCEngine{
+		server:   grpc.NewServer(),
+		listener: listener,
+		gpus:     gpus,
+		running:  make(map[int]bool),
+		loaded:   make(map[int]string),
+		events:   make(chan *enginepb.EngineEvent, 64),
+	}
+	enginepb.RegisterEngineServer(e.server, e)
+	go func() { _ = e.server.Serve(listener) }()
+
+	return e
+}
+
+// Close는 fake engine을 종료합니다.
+func (e *GRPCEngine) Close() {
+	e.server.Stop()
+}
+
+// Addr는 fake engine 주소(127.0.0.1:port)입니다.
+func (e *GRPCEngine) Addr() string {
+	return e.listener.Addr().String()
+}
+
+// IP는 device로 등록할 때 사용할 fake engine의 IP 입니다.
+func (e *GRPCEngine) IP() string {
+	host, _, _ := net.SplitHostPort(e.Addr())
+	return host
+}
+
+// Port는 device로 등록할 때 사용할 fake engine의 port 입니다.
+func (e *GRPCEngine) Port() int {
+	_, port, _ := net.SplitHostPort(e.Addr())
+	p, _ := strconv.Atoi(port)
+	return p
+}
+
+// Emit은 Events stream으로 보낼 event를 넣습니다.
+func (e *GRPCEngine) Emit(event *enginepb.EngineEvent) {
+	e.events <- event
+}
+
+// Running은 실행 중인 modeling ID 목록입니다.
+func (e *GRPCEngine) Running() []int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.runningIDs()
+}
+
+// Finished는 후처리를 요청받은 modeling ID 목록입니다.
+func (e *GRPCEngine) Finished() []int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]int{}, e.finished...)
+}
+
+// StoppedTrials는 중단을 요청받은 trial UUID 목록입니다.
+func (e *GRPCEngine) StoppedTrials() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]string{}, e.stopped...)
+}
+
+// Loaded는 올라가 있는 모델 이름 목록입니다.
+func (e *GRPCEngine) Loaded() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	names := []string{}
+	for _, name := range e.loaded {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Removed는 삭제를 요청받은 경로 목록입니다.
+func (e *GRPCEngine) Removed() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]string{}, e.removed...)
+}
+
+// Trains는 받은 Train 요청 목록입니다.
+func (e *GRPCEngine) Trains() []*enginepb.TrainRequest {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]*enginepb.TrainRequest{}, e.trains...)
+}
+
+func (e *GRPCEngine) runningIDs() []int {
+	ids := []int{}
+	for id := range e.running {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	return ids
+}
+
+func (e *GRPCEngine) Train(ctx context.Context, req *enginepb.TrainRequest) (*enginepb.TrainResponse, error) {
+	if req.GetModelingId() < 1 {
+		return nil, status.Error(codes.InvalidArgument, "modeling_id is required")
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.trains = append(e.trains, req)
+	if req.GetJob() == "finish" {
+		delete(e.running, int(req.GetModelingId()))
+		e.finished = append(e.finished, int(req.GetModelingId()))
+	} else {
+		e.running[int(req.GetModelingId())] = true
+	}
+
+	return &enginepb.TrainResponse{}, nil
+}
+
+func (e *GRPCEngine) Cancel(ctx context.Context, req *enginepb.CancelRequest) (*enginepb.CancelResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	id := int(req.GetModelingId())
+	if req.GetTrialUuid() == "" {
+		delete(e.running, id)
+		return &enginepb.CancelResponse{}, nil
+	}
+	if !e.running[id] {
+		return nil, status.Error(codes.NotFound, "modeling is not running")
+	}
+	e.stopped = append(e.stopped, req.GetTrialUuid())
+
+	return &enginepb.CancelResponse{}, nil
+}
+
+// SetCapabilities는 Status로 보고할 engine type과 build version을 정합니다. nil이면 보고하지 않습니다.
+func (e *GRPCEngine) SetCapabilities(capabilities *schema.DeviceCapabilities) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.capabilities = capabilities
+}
+
+func (e *GRPCEngine) Status(ctx context.Context, req *enginepb.StatusRequest) (*enginepb.StatusResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	resp := &enginepb.StatusResponse{
+		DeviceName: "fake-grpc-engine",
+		Cpu:        &enginepb.CPU{UtilizationCpu: 1},
+		Disk:       &enginepb.Disk{Free: 100, Used: 0, Total: 100},
+	}
+	for i := 0; i < e.gpus; i++ {
+		resp.Gpu = append(resp.Gpu, &enginepb.GPU{
+			Index:       int32(i),
+			Name:        "Fake GPU",
+			Uuid:        fmt.Sprintf("GPU-fake-grpc-%s-%d", e.Addr(), i),
+			MemoryTotal: 16384,
+		})
+	}
+	if e.capabilities != nil {
+		resp.EngineTypes = e.capabilities.EngineTypes
+		resp.EngineVersion = e.capabilities.EngineVersion
+	}
+	for _, id := range e.runningIDs() {
+		resp.RunningModelingIds = append(resp.RunningModelingIds, int32(id))
+	}
+
+	return resp, nil
+}
+
+func (e *GRPCEngine) Load(ctx context.Context, req *enginepb.LoadRequest) (*enginepb.LoadResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.modelNum++
+	e.loaded[e.modelNum] = req.GetModelName()
+
+	return &enginepb.LoadResponse{
+		Name:   req.GetModelName(),
+		Models: []*enginepb.LoadedModel{{ModelNum: int32(e.modelNum), ModelFile: req.GetModelPath(), Engine: "fake"}},
+	}, nil
+}
+
+func (e *GRPCEngine) Unload(ctx context.Context, req *enginepb.UnloadRequest) (*enginepb.UnloadResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.loaded[int(req.GetModelNum())] != req.GetModelName() {
+		return nil, status.Error(codes.NotFound, "model is not loaded")
+	}
+	delete(e.loaded, int(req.GetModelNum()))
+
+	return &enginepb.UnloadResponse{}, nil
+}
+
+func (e *GRPCEngine) Infer(ctx context.Context, req *enginepb.InferRequest) (*enginepb.InferResponse, error) {
+	e.mu.Lock()
+	_, ok := e.loaded[int(req.GetModelNum())]
+	e.mu.Unlock()
+	if !ok {
+		return nil, status.Error(codes.NotFound, "model is not loaded")
+	}
+
+	result, _ := json.Marshal(map[string]interface{}{"prediction": []interface{}{"fake"}, "inference_time": 0.01})
+	return &enginepb.InferResponse{ResultJson: string(result)}, nil
+}
+
+func (e *GRPCEngine) Remove(ctx context.Context, req *enginepb.RemoveRequest) (*enginepb.RemoveResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.removed = append(e.removed, req.GetTargetPath())
+	return &enginepb.RemoveResponse{}, nil
+}
+
+func (e *GRPCEngine) Events(req *enginepb.EventsRequest, stream grpc.ServerStreamingServer[enginepb.EngineEvent]) error {
+	for {
+		select {
+		case event := <-e.events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
@@ -15,14 +15,23 @@ import (
 	"api_server/ent/dataset"
 	"api_server/ent/datasetroot"
 	"api_server/ent/device"
+	"api_server/ent/devicetoken"
+	"api_server/ent/earlystop"
 	"api_server/ent/enginelog"
 	"api_server/ent/gpu"
+	"api_server/ent/gputelemetry"
 	"api_server/ent/hyperparamshistory"
+	"api_server/ent/maintenancewindow"
 	"api_server/ent/menu"
 	"api_server/ent/modeling"
 	"api_server/ent/modelingdetails"
 	"api_server/ent/modelingmodels"
+	"api_server/ent/preemption"
 	"api_server/ent/project"
+	"api_server/ent/quota"
+	"api_server/ent/reservation"
+	"api_server/ent/schedule"
+	"api_server/ent/schedulehistory"
 	"api_server/ent/task"
 	"api_server/ent/trial"
 	"api_server/ent/trialdetails"
@@ -52,12 +61,20 @@ type Client struct {
 	DatasetRoot *DatasetRootClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// DeviceToken is the client for interacting with the DeviceToken builders.
+	DeviceToken *DeviceTokenClient
+	// EarlyStop is the client for interacting with the EarlyStop builders.
+	EarlyStop *EarlyStopClient
 	// EngineLog is the client for interacting with the EngineLog builders.
 	EngineLog *EngineLogClient
 	// Gpu is the client for interacting with the Gpu builders.
 	Gpu *GpuClient
+	// GpuTelemetry is the client for interacting with the GpuTelemetry builders.
+	GpuTelemetry *GpuTelemetryClient
 	// HyperParamsHistory is the client for interacting with the HyperParamsHistory builders.
 	HyperParamsHistory *HyperParamsHistoryClient
+	// MaintenanceWindow is the client for interacting with the MaintenanceWindow builders.
+	MaintenanceWindow *MaintenanceWindowClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
 	// Modeling is the client for interacting with the Modeling builders.
@@ -66,8 +83,18 @@ type Client struct {
 	ModelingDetails *ModelingDetailsClient
 	// ModelingModels is the client for interacting with the ModelingModels builders.
 	ModelingModels *ModelingModelsClient
+	// Preemption is the client for interacting with the Preemption builders.
+	Preemption *PreemptionClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
+	// Quota is the client for interacting with the Quota builders.
+	Quota *QuotaClient
+	// Reservation is the client for interacting with the Reservation builders.
+	Reservation *ReservationClient
+	// Schedule is the client for interacting with the Schedule builders.
+	Schedule *ScheduleClient
+	// ScheduleHistory is the client for interacting with the ScheduleHistory builders.
+	ScheduleHistory *ScheduleHistoryClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// Trial is the client for interacting with the Trial builders.
@@ -97,14 +124,23 @@ func (c *Client) init() {
 	c.Dataset = NewDatasetClient(c.config)
 	c.DatasetRoot = NewDatasetRootClient(c.config)
 	c.Device = NewDeviceClient(c.config)
+	c.DeviceToken = NewDeviceTokenClient(c.config)
+	c.EarlyStop = NewEarlyStopClient(c.config)
 	c.EngineLog = NewEngineLogClient(c.config)
 	c.Gpu = NewGpuClient(c.config)
+	c.GpuTelemetry = NewGpuTelemetryClient(c.config)
 	c.HyperParamsHistory = NewHyperParamsHistoryClient(c.config)
+	c.MaintenanceWindow = NewMaintenanceWindowClient(c.config)
 	c.Menu = NewMenuClient(c.config)
 	c.Modeling = NewModelingClient(c.config)
 	c.ModelingDetails = NewModelingDetailsClient(c.config)
 	c.ModelingModels = NewModelingModelsClient(c.config)
+	c.Preemption = NewPreemptionClient(c.config)
 	c.Project = NewProjectClient(c.config)
+	c.Quota = NewQuotaClient(c.config)
+	c.Reservation = NewReservationClient(c.config)
+	c.Schedule = NewScheduleClient(c.config)
+	c.ScheduleHistory = NewScheduleHistoryClient(c.config)
 	c.Task = NewTaskClient(c.config)
 	c.Trial = NewTrialClient(c.config)
 	c.TrialDetails = NewTrialDetailsClient(c.config)
@@ -208,14 +244,23 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Dataset:            NewDatasetClient(cfg),
 		DatasetRoot:        NewDatasetRootClient(cfg),
 		Device:             NewDeviceClient(cfg),
+		DeviceToken:        NewDeviceTokenClient(cfg),
+		EarlyStop:          NewEarlyStopClient(cfg),
 		EngineLog:          NewEngineLogClient(cfg),
 		Gpu:                NewGpuClient(cfg),
+		GpuTelemetry:       NewGpuTelemetryClient(cfg),
 		HyperParamsHistory: NewHyperParamsHistoryClient(cfg),
+		MaintenanceWindow:  NewMaintenanceWindowClient(cfg),
 		Menu:               NewMenuClient(cfg),
 		Modeling:           NewModelingClient(cfg),
 		ModelingDetails:    NewModelingDetailsClient(cfg),
 		ModelingModels:     NewModelingModelsClient(cfg),
+		Preemption:         NewPreemptionClient(cfg),
 		Project:            NewProjectClient(cfg),
+		Quota:              NewQuotaClient(cfg),
+		Reservation:        NewReservationClient(cfg),
+		Schedule:           NewScheduleClient(cfg),
+		ScheduleHistory:    NewScheduleHistoryClient(cfg),
 		Task:               NewTaskClient(cfg),
 		Trial:              NewTrialClient(cfg),
 		TrialDetails:       NewTrialDetailsClient(cfg),
@@ -246,14 +291,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Dataset:            NewDatasetClient(cfg),
 		DatasetRoot:        NewDatasetRootClient(cfg),
 		Device:             NewDeviceClient(cfg),
+		DeviceToken:        NewDeviceTokenClient(cfg),
+		EarlyStop:          NewEarlyStopClient(cfg),
 		EngineLog:          NewEngineLogClient(cfg),
 		Gpu:                NewGpuClient(cfg),
+		GpuTelemetry:       NewGpuTelemetryClient(cfg),
 		HyperParamsHistory: NewHyperParamsHistoryClient(cfg),
+		MaintenanceWindow:  NewMaintenanceWindowClient(cfg),
 		Menu:               NewMenuClient(cfg),
 		Modeling:           NewModelingClient(cfg),
 		ModelingDetails:    NewModelingDetailsClient(cfg),
 		ModelingModels:     NewModelingModelsClient(cfg),
+		Preemption:         NewPreemptionClient(cfg),
 		Project:            NewProjectClient(cfg),
+		Quota:              NewQuotaClient(cfg),
+		Reservation:        NewReservationClient(cfg),
+		Schedule:           NewScheduleClient(cfg),
+		ScheduleHistory:    NewScheduleHistoryClient(cfg),
 		Task:               NewTaskClient(cfg),
 		Trial:              NewTrialClient(cfg),
 		TrialDetails:       NewTrialDetailsClient(cfg),
@@ -290,10 +344,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Configuration, c.Dataset, c.DatasetRoot, c.Device, c.EngineLog, c.Gpu,
-		c.HyperParamsHistory, c.Menu, c.Modeling, c.ModelingDetails, c.ModelingModels,
-		c.Project, c.Task, c.Trial, c.TrialDetails, c.TrialStatus, c.User, c.UserGroup,
-		c.UserProject,
+		c.Configuration, c.Dataset, c.DatasetRoot, c.Device, c.DeviceToken, c.EarlyStop,
+		c.EngineLog, c.Gpu, c.GpuTelemetry, c.HyperParamsHistory, c.MaintenanceWindow,
+		c.Menu, c.Modeling, c.ModelingDetails, c.ModelingModels, c.Preemption,
+		c.Project, c.Quota, c.Reservation, c.Schedule, c.ScheduleHistory, c.Task,
+		c.Trial, c.TrialDetails, c.TrialStatus, c.User, c.UserGroup, c.UserProject,
 	} {
 		n.Use(hooks...)
 	}
@@ -303,10 +358,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Configuration, c.Dataset, c.DatasetRoot, c.Device, c.EngineLog, c.Gpu,
-		c.HyperParamsHistory, c.Menu, c.Modeling, c.ModelingDetails, c.ModelingModels,
-		c.Project, c.Task, c.Trial, c.TrialDetails, c.TrialStatus, c.User, c.UserGroup,
-		c.UserProject,
+		c.Configuration, c.Dataset, c.DatasetRoot, c.Device, c.DeviceToken, c.EarlyStop,
+		c.EngineLog, c.Gpu, c.GpuTelemetry, c.HyperParamsHistory, c.MaintenanceWindow,
+		c.Menu, c.Modeling, c.ModelingDetails, c.ModelingModels, c.Preemption,
+		c.Project, c.Quota, c.Reservation, c.Schedule, c.ScheduleHistory, c.Task,
+		c.Trial, c.TrialDetails, c.TrialStatus, c.User, c.UserGroup, c.UserProject,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -323,12 +379,20 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.DatasetRoot.mutate(ctx, m)
 	case *DeviceMutation:
 		return c.Device.mutate(ctx, m)
+	case *DeviceTokenMutation:
+		return c.DeviceToken.mutate(ctx, m)
+	case *EarlyStopMutation:
+		return c.EarlyStop.mutate(ctx, m)
 	case *EngineLogMutation:
 		return c.EngineLog.mutate(ctx, m)
 	case *GpuMutation:
 		return c.Gpu.mutate(ctx, m)
+	case *GpuTelemetryMutation:
+		return c.GpuTelemetry.mutate(ctx, m)
 	case *HyperParamsHistoryMutation:
 		return c.HyperParamsHistory.mutate(ctx, m)
+	case *MaintenanceWindowMutation:
+		return c.MaintenanceWindow.mutate(ctx, m)
 	case *MenuMutation:
 		return c.Menu.mutate(ctx, m)
 	case *ModelingMutation:
@@ -337,8 +401,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ModelingDetails.mutate(ctx, m)
 	case *ModelingModelsMutation:
 		return c.ModelingModels.mutate(ctx, m)
+	case *PreemptionMutation:
+		return c.Preemption.mutate(ctx, m)
 	case *ProjectMutation:
 		return c.Project.mutate(ctx, m)
+	case *QuotaMutation:
+		return c.Quota.mutate(ctx, m)
+	case *ReservationMutation:
+		return c.Reservation.mutate(ctx, m)
+	case *ScheduleMutation:
+		return c.Schedule.mutate(ctx, m)
+	case *ScheduleHistoryMutation:
+		return c.ScheduleHistory.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
 	case *TrialMutation:
@@ -938,6 +1012,272 @@ func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, er
 	}
 }
 
+// DeviceTokenClient is a client for the DeviceToken schema.
+type DeviceTokenClient struct {
+	config
+}
+
+// NewDeviceTokenClient returns a client for the DeviceToken from the given config.
+func NewDeviceTokenClient(c config) *DeviceTokenClient {
+	return &DeviceTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `devicetoken.Hooks(f(g(h())))`.
+func (c *DeviceTokenClient) Use(hooks ...Hook) {
+	c.hooks.DeviceToken = append(c.hooks.DeviceToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `devicetoken.Intercept(f(g(h())))`.
+func (c *DeviceTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceToken = append(c.inters.DeviceToken, interceptors...)
+}
+
+// Create returns a builder for creating a DeviceToken entity.
+func (c *DeviceTokenClient) Create() *DeviceTokenCreate {
+	mutation := newDeviceTokenMutation(c.config, OpCreate)
+	return &DeviceTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeviceToken entities.
+func (c *DeviceTokenClient) CreateBulk(builders ...*DeviceTokenCreate) *DeviceTokenCreateBulk {
+	return &DeviceTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceTokenClient) MapCreateBulk(slice any, setFunc func(*DeviceTokenCreate, int)) *DeviceTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceTokenCreateBulk{err: fmt.Errorf("calling to DeviceTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeviceToken.
+func (c *DeviceTokenClient) Update() *DeviceTokenUpdate {
+	mutation := newDeviceTokenMutation(c.config, OpUpdate)
+	return &DeviceTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceTokenClient) UpdateOne(dt *DeviceToken) *DeviceTokenUpdateOne {
+	mutation := newDeviceTokenMutation(c.config, OpUpdateOne, withDeviceToken(dt))
+	return &DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceTokenClient) UpdateOneID(id int) *DeviceTokenUpdateOne {
+	mutation := newDeviceTokenMutation(c.config, OpUpdateOne, withDeviceTokenID(id))
+	return &DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeviceToken.
+func (c *DeviceTokenClient) Delete() *DeviceTokenDelete {
+	mutation := newDeviceTokenMutation(c.config, OpDelete)
+	return &DeviceTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceTokenClient) DeleteOne(dt *DeviceToken) *DeviceTokenDeleteOne {
+	return c.DeleteOneID(dt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceTokenClient) DeleteOneID(id int) *DeviceTokenDeleteOne {
+	builder := c.Delete().Where(devicetoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for DeviceToken.
+func (c *DeviceTokenClient) Query() *DeviceTokenQuery {
+	return &DeviceTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeviceToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeviceToken entity by its id.
+func (c *DeviceTokenClient) Get(ctx context.Context, id int) (*DeviceToken, error) {
+	return c.Query().Where(devicetoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceTokenClient) GetX(ctx context.Context, id int) *DeviceToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceTokenClient) Hooks() []Hook {
+	return c.hooks.DeviceToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceTokenClient) Interceptors() []Interceptor {
+	return c.inters.DeviceToken
+}
+
+func (c *DeviceTokenClient) mutate(ctx context.Context, m *DeviceTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeviceToken mutation op: %q", m.Op())
+	}
+}
+
+// EarlyStopClient is a client for the EarlyStop schema.
+type EarlyStopClient struct {
+	config
+}
+
+// NewEarlyStopClient returns a client for the EarlyStop from the given config.
+func NewEarlyStopClient(c config) *EarlyStopClient {
+	return &EarlyStopClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `earlystop.Hooks(f(g(h())))`.
+func (c *EarlyStopClient) Use(hooks ...Hook) {
+	c.hooks.EarlyStop = append(c.hooks.EarlyStop, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `earlystop.Intercept(f(g(h())))`.
+func (c *EarlyStopClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EarlyStop = append(c.inters.EarlyStop, interceptors...)
+}
+
+// Create returns a builder for creating a EarlyStop entity.
+func (c *EarlyStopClient) Create() *EarlyStopCreate {
+	mutation := newEarlyStopMutation(c.config, OpCreate)
+	return &EarlyStopCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EarlyStop entities.
+func (c *EarlyStopClient) CreateBulk(builders ...*EarlyStopCreate) *EarlyStopCreateBulk {
+	return &EarlyStopCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EarlyStopClient) MapCreateBulk(slice any, setFunc func(*EarlyStopCreate, int)) *EarlyStopCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EarlyStopCreateBulk{err: fmt.Errorf("calling to EarlyStopClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EarlyStopCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EarlyStopCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EarlyStop.
+func (c *EarlyStopClient) Update() *EarlyStopUpdate {
+	mutation := newEarlyStopMutation(c.config, OpUpdate)
+	return &EarlyStopUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EarlyStopClient) UpdateOne(es *EarlyStop) *EarlyStopUpdateOne {
+	mutation := newEarlyStopMutation(c.config, OpUpdateOne, withEarlyStop(es))
+	return &EarlyStopUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EarlyStopClient) UpdateOneID(id int) *EarlyStopUpdateOne {
+	mutation := newEarlyStopMutation(c.config, OpUpdateOne, withEarlyStopID(id))
+	return &EarlyStopUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EarlyStop.
+func (c *EarlyStopClient) Delete() *EarlyStopDelete {
+	mutation := newEarlyStopMutation(c.config, OpDelete)
+	return &EarlyStopDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EarlyStopClient) DeleteOne(es *EarlyStop) *EarlyStopDeleteOne {
+	return c.DeleteOneID(es.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EarlyStopClient) DeleteOneID(id int) *EarlyStopDeleteOne {
+	builder := c.Delete().Where(earlystop.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EarlyStopDeleteOne{builder}
+}
+
+// Query returns a query builder for EarlyStop.
+func (c *EarlyStopClient) Query() *EarlyStopQuery {
+	return &EarlyStopQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEarlyStop},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EarlyStop entity by its id.
+func (c *EarlyStopClient) Get(ctx context.Context, id int) (*EarlyStop, error) {
+	return c.Query().Where(earlystop.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EarlyStopClient) GetX(ctx context.Context, id int) *EarlyStop {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EarlyStopClient) Hooks() []Hook {
+	return c.hooks.EarlyStop
+}
+
+// Interceptors returns the client interceptors.
+func (c *EarlyStopClient) Interceptors() []Interceptor {
+	return c.inters.EarlyStop
+}
+
+func (c *EarlyStopClient) mutate(ctx context.Context, m *EarlyStopMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EarlyStopCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EarlyStopUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EarlyStopUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EarlyStopDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EarlyStop mutation op: %q", m.Op())
+	}
+}
+
 // EngineLogClient is a client for the EngineLog schema.
 type EngineLogClient struct {
 	config
@@ -1220,6 +1560,139 @@ func (c *GpuClient) mutate(ctx context.Context, m *GpuMutation) (Value, error) {
 	}
 }
 
+// GpuTelemetryClient is a client for the GpuTelemetry schema.
+type GpuTelemetryClient struct {
+	config
+}
+
+// NewGpuTelemetryClient returns a client for the GpuTelemetry from the given config.
+func NewGpuTelemetryClient(c config) *GpuTelemetryClient {
+	return &GpuTelemetryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gputelemetry.Hooks(f(g(h())))`.
+func (c *GpuTelemetryClient) Use(hooks ...Hook) {
+	c.hooks.GpuTelemetry = append(c.hooks.GpuTelemetry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gputelemetry.Intercept(f(g(h())))`.
+func (c *GpuTelemetryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GpuTelemetry = append(c.inters.GpuTelemetry, interceptors...)
+}
+
+// Create returns a builder for creating a GpuTelemetry entity.
+func (c *GpuTelemetryClient) Create() *GpuTelemetryCreate {
+	mutation := newGpuTelemetryMutation(c.config, OpCreate)
+	return &GpuTelemetryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GpuTelemetry entities.
+func (c *GpuTelemetryClient) CreateBulk(builders ...*GpuTelemetryCreate) *GpuTelemetryCreateBulk {
+	return &GpuTelemetryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GpuTelemetryClient) MapCreateBulk(slice any, setFunc func(*GpuTelemetryCreate, int)) *GpuTelemetryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GpuTelemetryCreateBulk{err: fmt.Errorf("calling to GpuTelemetryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GpuTelemetryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GpuTelemetryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GpuTelemetry.
+func (c *GpuTelemetryClient) Update() *GpuTelemetryUpdate {
+	mutation := newGpuTelemetryMutation(c.config, OpUpdate)
+	return &GpuTelemetryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GpuTelemetryClient) UpdateOne(gt *GpuTelemetry) *GpuTelemetryUpdateOne {
+	mutation := newGpuTelemetryMutation(c.config, OpUpdateOne, withGpuTelemetry(gt))
+	return &GpuTelemetryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GpuTelemetryClient) UpdateOneID(id int) *GpuTelemetryUpdateOne {
+	mutation := newGpuTelemetryMutation(c.config, OpUpdateOne, withGpuTelemetryID(id))
+	return &GpuTelemetryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GpuTelemetry.
+func (c *GpuTelemetryClient) Delete() *GpuTelemetryDelete {
+	mutation := newGpuTelemetryMutation(c.config, OpDelete)
+	return &GpuTelemetryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GpuTelemetryClient) DeleteOne(gt *GpuTelemetry) *GpuTelemetryDeleteOne {
+	return c.DeleteOneID(gt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GpuTelemetryClient) DeleteOneID(id int) *GpuTelemetryDeleteOne {
+	builder := c.Delete().Where(gputelemetry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GpuTelemetryDeleteOne{builder}
+}
+
+// Query returns a query builder for GpuTelemetry.
+func (c *GpuTelemetryClient) Query() *GpuTelemetryQuery {
+	return &GpuTelemetryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGpuTelemetry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GpuTelemetry entity by its id.
+func (c *GpuTelemetryClient) Get(ctx context.Context, id int) (*GpuTelemetry, error) {
+	return c.Query().Where(gputelemetry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GpuTelemetryClient) GetX(ctx context.Context, id int) *GpuTelemetry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GpuTelemetryClient) Hooks() []Hook {
+	return c.hooks.GpuTelemetry
+}
+
+// Interceptors returns the client interceptors.
+func (c *GpuTelemetryClient) Interceptors() []Interceptor {
+	return c.inters.GpuTelemetry
+}
+
+func (c *GpuTelemetryClient) mutate(ctx context.Context, m *GpuTelemetryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GpuTelemetryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GpuTelemetryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GpuTelemetryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GpuTelemetryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GpuTelemetry mutation op: %q", m.Op())
+	}
+}
+
 // HyperParamsHistoryClient is a client for the HyperParamsHistory schema.
 type HyperParamsHistoryClient struct {
 	config
@@ -1353,74 +1826,207 @@ func (c *HyperParamsHistoryClient) mutate(ctx context.Context, m *HyperParamsHis
 	}
 }
 
-// MenuClient is a client for the Menu schema.
-type MenuClient struct {
+// MaintenanceWindowClient is a client for the MaintenanceWindow schema.
+type MaintenanceWindowClient struct {
 	config
 }
 
-// NewMenuClient returns a client for the Menu from the given config.
-func NewMenuClient(c config) *MenuClient {
-	return &MenuClient{config: c}
+// NewMaintenanceWindowClient returns a client for the MaintenanceWindow from the given config.
+func NewMaintenanceWindowClient(c config) *MaintenanceWindowClient {
+	return &MaintenanceWindowClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `menu.Hooks(f(g(h())))`.
-func (c *MenuClient) Use(hooks ...Hook) {
-	c.hooks.Menu = append(c.hooks.Menu, hooks...)
+// A call to `Use(f, g, h)` equals to `maintenancewindow.Hooks(f(g(h())))`.
+func (c *MaintenanceWindowClient) Use(hooks ...Hook) {
+	c.hooks.MaintenanceWindow = append(c.hooks.MaintenanceWindow, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `menu.Intercept(f(g(h())))`.
-func (c *MenuClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Menu = append(c.inters.Menu, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `maintenancewindow.Intercept(f(g(h())))`.
+func (c *MaintenanceWindowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MaintenanceWindow = append(c.inters.MaintenanceWindow, interceptors...)
 }
 
-// Create returns a builder for creating a Menu entity.
-func (c *MenuClient) Create() *MenuCreate {
-	mutation := newMenuMutation(c.config, OpCreate)
-	return &MenuCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MaintenanceWindow entity.
+func (c *MaintenanceWindowClient) Create() *MaintenanceWindowCreate {
+	mutation := newMaintenanceWindowMutation(c.config, OpCreate)
+	return &MaintenanceWindowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Menu entities.
-func (c *MenuClient) CreateBulk(builders ...*MenuCreate) *MenuCreateBulk {
-	return &MenuCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MaintenanceWindow entities.
+func (c *MaintenanceWindowClient) CreateBulk(builders ...*MaintenanceWindowCreate) *MaintenanceWindowCreateBulk {
+	return &MaintenanceWindowCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MenuClient) MapCreateBulk(slice any, setFunc func(*MenuCreate, int)) *MenuCreateBulk {
+func (c *MaintenanceWindowClient) MapCreateBulk(slice any, setFunc func(*MaintenanceWindowCreate, int)) *MaintenanceWindowCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MenuCreateBulk{err: fmt.Errorf("calling to MenuClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MaintenanceWindowCreateBulk{err: fmt.Errorf("calling to MaintenanceWindowClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MenuCreate, rv.Len())
+	builders := make([]*MaintenanceWindowCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MenuCreateBulk{config: c.config, builders: builders}
+	return &MaintenanceWindowCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Menu.
-func (c *MenuClient) Update() *MenuUpdate {
-	mutation := newMenuMutation(c.config, OpUpdate)
-	return &MenuUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Update() *MaintenanceWindowUpdate {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdate)
+	return &MaintenanceWindowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MenuClient) UpdateOne(m *Menu) *MenuUpdateOne {
-	mutation := newMenuMutation(c.config, OpUpdateOne, withMenu(m))
-	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MaintenanceWindowClient) UpdateOne(mw *MaintenanceWindow) *MaintenanceWindowUpdateOne {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdateOne, withMaintenanceWindow(mw))
+	return &MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MenuClient) UpdateOneID(id string) *MenuUpdateOne {
-	mutation := newMenuMutation(c.config, OpUpdateOne, withMenuID(id))
-	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MaintenanceWindowClient) UpdateOneID(id int) *MaintenanceWindowUpdateOne {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdateOne, withMaintenanceWindowID(id))
+	return &MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Menu.
-func (c *MenuClient) Delete() *MenuDelete {
+// Delete returns a delete builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Delete() *MaintenanceWindowDelete {
+	mutation := newMaintenanceWindowMutation(c.config, OpDelete)
+	return &MaintenanceWindowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MaintenanceWindowClient) DeleteOne(mw *MaintenanceWindow) *MaintenanceWindowDeleteOne {
+	return c.DeleteOneID(mw.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MaintenanceWindowClient) DeleteOneID(id int) *MaintenanceWindowDeleteOne {
+	builder := c.Delete().Where(maintenancewindow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MaintenanceWindowDeleteOne{builder}
+}
+
+// Query returns a query builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Query() *MaintenanceWindowQuery {
+	return &MaintenanceWindowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMaintenanceWindow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MaintenanceWindow entity by its id.
+func (c *MaintenanceWindowClient) Get(ctx context.Context, id int) (*MaintenanceWindow, error) {
+	return c.Query().Where(maintenancewindow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MaintenanceWindowClient) GetX(ctx context.Context, id int) *MaintenanceWindow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MaintenanceWindowClient) Hooks() []Hook {
+	return c.hooks.MaintenanceWindow
+}
+
+// Interceptors returns the client interceptors.
+func (c *MaintenanceWindowClient) Interceptors() []Interceptor {
+	return c.inters.MaintenanceWindow
+}
+
+func (c *MaintenanceWindowClient) mutate(ctx context.Context, m *MaintenanceWindowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MaintenanceWindowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MaintenanceWindowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MaintenanceWindowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MaintenanceWindow mutation op: %q", m.Op())
+	}
+}
+
+// MenuClient is a client for the Menu schema.
+type MenuClient struct {
+	config
+}
+
+// NewMenuClient returns a client for the Menu from the given config.
+func NewMenuClient(c config) *MenuClient {
+	return &MenuClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `menu.Hooks(f(g(h())))`.
+func (c *MenuClient) Use(hooks ...Hook) {
+	c.hooks.Menu = append(c.hooks.Menu, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `menu.Intercept(f(g(h())))`.
+func (c *MenuClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Menu = append(c.inters.Menu, interceptors...)
+}
+
+// Create returns a builder for creating a Menu entity.
+func (c *MenuClient) Create() *MenuCreate {
+	mutation := newMenuMutation(c.config, OpCreate)
+	return &MenuCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Menu entities.
+func (c *MenuClient) CreateBulk(builders ...*MenuCreate) *MenuCreateBulk {
+	return &MenuCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MenuClient) MapCreateBulk(slice any, setFunc func(*MenuCreate, int)) *MenuCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MenuCreateBulk{err: fmt.Errorf("calling to MenuClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MenuCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MenuCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Menu.
+func (c *MenuClient) Update() *MenuUpdate {
+	mutation := newMenuMutation(c.config, OpUpdate)
+	return &MenuUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MenuClient) UpdateOne(m *Menu) *MenuUpdateOne {
+	mutation := newMenuMutation(c.config, OpUpdateOne, withMenu(m))
+	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MenuClient) UpdateOneID(id string) *MenuUpdateOne {
+	mutation := newMenuMutation(c.config, OpUpdateOne, withMenuID(id))
+	return &MenuUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Menu.
+func (c *MenuClient) Delete() *MenuDelete {
 	mutation := newMenuMutation(c.config, OpDelete)
 	return &MenuDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
@@ -2013,6 +2619,139 @@ func (c *ModelingModelsClient) mutate(ctx context.Context, m *ModelingModelsMuta
 	}
 }
 
+// PreemptionClient is a client for the Preemption schema.
+type PreemptionClient struct {
+	config
+}
+
+// NewPreemptionClient returns a client for the Preemption from the given config.
+func NewPreemptionClient(c config) *PreemptionClient {
+	return &PreemptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `preemption.Hooks(f(g(h())))`.
+func (c *PreemptionClient) Use(hooks ...Hook) {
+	c.hooks.Preemption = append(c.hooks.Preemption, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `preemption.Intercept(f(g(h())))`.
+func (c *PreemptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Preemption = append(c.inters.Preemption, interceptors...)
+}
+
+// Create returns a builder for creating a Preemption entity.
+func (c *PreemptionClient) Create() *PreemptionCreate {
+	mutation := newPreemptionMutation(c.config, OpCreate)
+	return &PreemptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Preemption entities.
+func (c *PreemptionClient) CreateBulk(builders ...*PreemptionCreate) *PreemptionCreateBulk {
+	return &PreemptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PreemptionClient) MapCreateBulk(slice any, setFunc func(*PreemptionCreate, int)) *PreemptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PreemptionCreateBulk{err: fmt.Errorf("calling to PreemptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PreemptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PreemptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Preemption.
+func (c *PreemptionClient) Update() *PreemptionUpdate {
+	mutation := newPreemptionMutation(c.config, OpUpdate)
+	return &PreemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PreemptionClient) UpdateOne(pr *Preemption) *PreemptionUpdateOne {
+	mutation := newPreemptionMutation(c.config, OpUpdateOne, withPreemption(pr))
+	return &PreemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PreemptionClient) UpdateOneID(id int) *PreemptionUpdateOne {
+	mutation := newPreemptionMutation(c.config, OpUpdateOne, withPreemptionID(id))
+	return &PreemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Preemption.
+func (c *PreemptionClient) Delete() *PreemptionDelete {
+	mutation := newPreemptionMutation(c.config, OpDelete)
+	return &PreemptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PreemptionClient) DeleteOne(pr *Preemption) *PreemptionDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PreemptionClient) DeleteOneID(id int) *PreemptionDeleteOne {
+	builder := c.Delete().Where(preemption.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PreemptionDeleteOne{builder}
+}
+
+// Query returns a query builder for Preemption.
+func (c *PreemptionClient) Query() *PreemptionQuery {
+	return &PreemptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePreemption},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Preemption entity by its id.
+func (c *PreemptionClient) Get(ctx context.Context, id int) (*Preemption, error) {
+	return c.Query().Where(preemption.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PreemptionClient) GetX(ctx context.Context, id int) *Preemption {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PreemptionClient) Hooks() []Hook {
+	return c.hooks.Preemption
+}
+
+// Interceptors returns the client interceptors.
+func (c *PreemptionClient) Interceptors() []Interceptor {
+	return c.inters.Preemption
+}
+
+func (c *PreemptionClient) mutate(ctx context.Context, m *PreemptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PreemptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PreemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PreemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PreemptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Preemption mutation op: %q", m.Op())
+	}
+}
+
 // ProjectClient is a client for the Project schema.
 type ProjectClient struct {
 	config
@@ -2178,107 +2917,107 @@ func (c *ProjectClient) mutate(ctx context.Context, m *ProjectMutation) (Value,
 	}
 }
 
-// TaskClient is a client for the Task schema.
-type TaskClient struct {
+// QuotaClient is a client for the Quota schema.
+type QuotaClient struct {
 	config
 }
 
-// NewTaskClient returns a client for the Task from the given config.
-func NewTaskClient(c config) *TaskClient {
-	return &TaskClient{config: c}
+// NewQuotaClient returns a client for the Quota from the given config.
+func NewQuotaClient(c config) *QuotaClient {
+	return &QuotaClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `task.Hooks(f(g(h())))`.
-func (c *TaskClient) Use(hooks ...Hook) {
-	c.hooks.Task = append(c.hooks.Task, hooks...)
+// A call to `Use(f, g, h)` equals to `quota.Hooks(f(g(h())))`.
+func (c *QuotaClient) Use(hooks ...Hook) {
+	c.hooks.Quota = append(c.hooks.Quota, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `task.Intercept(f(g(h())))`.
-func (c *TaskClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Task = append(c.inters.Task, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `quota.Intercept(f(g(h())))`.
+func (c *QuotaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Quota = append(c.inters.Quota, interceptors...)
 }
 
-// Create returns a builder for creating a Task entity.
-func (c *TaskClient) Create() *TaskCreate {
-	mutation := newTaskMutation(c.config, OpCreate)
-	return &TaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Quota entity.
+func (c *QuotaClient) Create() *QuotaCreate {
+	mutation := newQuotaMutation(c.config, OpCreate)
+	return &QuotaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Task entities.
-func (c *TaskClient) CreateBulk(builders ...*TaskCreate) *TaskCreateBulk {
-	return &TaskCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Quota entities.
+func (c *QuotaClient) CreateBulk(builders ...*QuotaCreate) *QuotaCreateBulk {
+	return &QuotaCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TaskClient) MapCreateBulk(slice any, setFunc func(*TaskCreate, int)) *TaskCreateBulk {
+func (c *QuotaClient) MapCreateBulk(slice any, setFunc func(*QuotaCreate, int)) *QuotaCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TaskCreateBulk{err: fmt.Errorf("calling to TaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &QuotaCreateBulk{err: fmt.Errorf("calling to QuotaClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TaskCreate, rv.Len())
+	builders := make([]*QuotaCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TaskCreateBulk{config: c.config, builders: builders}
+	return &QuotaCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Task.
-func (c *TaskClient) Update() *TaskUpdate {
-	mutation := newTaskMutation(c.config, OpUpdate)
-	return &TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Quota.
+func (c *QuotaClient) Update() *QuotaUpdate {
+	mutation := newQuotaMutation(c.config, OpUpdate)
+	return &QuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TaskClient) UpdateOne(t *Task) *TaskUpdateOne {
-	mutation := newTaskMutation(c.config, OpUpdateOne, withTask(t))
-	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuotaClient) UpdateOne(q *Quota) *QuotaUpdateOne {
+	mutation := newQuotaMutation(c.config, OpUpdateOne, withQuota(q))
+	return &QuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TaskClient) UpdateOneID(id int) *TaskUpdateOne {
-	mutation := newTaskMutation(c.config, OpUpdateOne, withTaskID(id))
-	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *QuotaClient) UpdateOneID(id int) *QuotaUpdateOne {
+	mutation := newQuotaMutation(c.config, OpUpdateOne, withQuotaID(id))
+	return &QuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Task.
-func (c *TaskClient) Delete() *TaskDelete {
-	mutation := newTaskMutation(c.config, OpDelete)
-	return &TaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Quota.
+func (c *QuotaClient) Delete() *QuotaDelete {
+	mutation := newQuotaMutation(c.config, OpDelete)
+	return &QuotaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TaskClient) DeleteOne(t *Task) *TaskDeleteOne {
-	return c.DeleteOneID(t.ID)
+func (c *QuotaClient) DeleteOne(q *Quota) *QuotaDeleteOne {
+	return c.DeleteOneID(q.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TaskClient) DeleteOneID(id int) *TaskDeleteOne {
-	builder := c.Delete().Where(task.ID(id))
+func (c *QuotaClient) DeleteOneID(id int) *QuotaDeleteOne {
+	builder := c.Delete().Where(quota.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TaskDeleteOne{builder}
+	return &QuotaDeleteOne{builder}
 }
 
-// Query returns a query builder for Task.
-func (c *TaskClient) Query() *TaskQuery {
-	return &TaskQuery{
+// Query returns a query builder for Quota.
+func (c *QuotaClient) Query() *QuotaQuery {
+	return &QuotaQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTask},
+		ctx:    &QueryContext{Type: TypeQuota},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Task entity by its id.
-func (c *TaskClient) Get(ctx context.Context, id int) (*Task, error) {
-	return c.Query().Where(task.ID(id)).Only(ctx)
+// Get returns a Quota entity by its id.
+func (c *QuotaClient) Get(ctx context.Context, id int) (*Quota, error) {
+	return c.Query().Where(quota.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TaskClient) GetX(ctx context.Context, id int) *Task {
+func (c *QuotaClient) GetX(ctx context.Context, id int) *Quota {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2286,31 +3025,627 @@ func (c *TaskClient) GetX(ctx context.Context, id int) *Task {
 	return obj
 }
 
-// QueryProject queries the project edge of a Task.
-func (c *TaskClient) QueryProject(t *Task) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(task.Table, task.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, task.ProjectTable, task.ProjectColumn),
-		)
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *QuotaClient) Hooks() []Hook {
+	return c.hooks.Quota
 }
 
-// QueryModelings queries the modelings edge of a Task.
-func (c *TaskClient) QueryModelings(t *Task) *ModelingQuery {
-	query := (&ModelingClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(task.Table, task.FieldID, id),
-			sqlgraph.To(modeling.Table, modeling.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, task.ModelingsTable, task.ModelingsColumn),
+// Interceptors returns the client interceptors.
+func (c *QuotaClient) Interceptors() []Interceptor {
+	return c.inters.Quota
+}
+
+func (c *QuotaClient) mutate(ctx context.Context, m *QuotaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuotaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuotaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Quota mutation op: %q", m.Op())
+	}
+}
+
+// ReservationClient is a client for the Reservation schema.
+type ReservationClient struct {
+	config
+}
+
+// NewReservationClient returns a client for the Reservation from the given config.
+func NewReservationClient(c config) *ReservationClient {
+	return &ReservationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reservation.Hooks(f(g(h())))`.
+func (c *ReservationClient) Use(hooks ...Hook) {
+	c.hooks.Reservation = append(c.hooks.Reservation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reservation.Intercept(f(g(h())))`.
+func (c *ReservationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Reservation = append(c.inters.Reservation, interceptors...)
+}
+
+// Create returns a builder for creating a Reservation entity.
+func (c *ReservationClient) Create() *ReservationCreate {
+	mutation := newReservationMutation(c.config, OpCreate)
+	return &ReservationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Reservation entities.
+func (c *ReservationClient) CreateBulk(builders ...*ReservationCreate) *ReservationCreateBulk {
+	return &ReservationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReservationClient) MapCreateBulk(slice any, setFunc func(*ReservationCreate, int)) *ReservationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReservationCreateBulk{err: fmt.Errorf("calling to ReservationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReservationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReservationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Reservation.
+func (c *ReservationClient) Update() *ReservationUpdate {
+	mutation := newReservationMutation(c.config, OpUpdate)
+	return &ReservationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReservationClient) UpdateOne(r *Reservation) *ReservationUpdateOne {
+	mutation := newReservationMutation(c.config, OpUpdateOne, withReservation(r))
+	return &ReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReservationClient) UpdateOneID(id int) *ReservationUpdateOne {
+	mutation := newReservationMutation(c.config, OpUpdateOne, withReservationID(id))
+	return &ReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Reservation.
+func (c *ReservationClient) Delete() *ReservationDelete {
+	mutation := newReservationMutation(c.config, OpDelete)
+	return &ReservationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReservationClient) DeleteOne(r *Reservation) *ReservationDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReservationClient) DeleteOneID(id int) *ReservationDeleteOne {
+	builder := c.Delete().Where(reservation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReservationDeleteOne{builder}
+}
+
+// Query returns a query builder for Reservation.
+func (c *ReservationClient) Query() *ReservationQuery {
+	return &ReservationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReservation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Reservation entity by its id.
+func (c *ReservationClient) Get(ctx context.Context, id int) (*Reservation, error) {
+	return c.Query().Where(reservation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReservationClient) GetX(ctx context.Context, id int) *Reservation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReservationClient) Hooks() []Hook {
+	return c.hooks.Reservation
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReservationClient) Interceptors() []Interceptor {
+	return c.inters.Reservation
+}
+
+func (c *ReservationClient) mutate(ctx context.Context, m *ReservationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReservationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReservationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReservationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Reservation mutation op: %q", m.Op())
+	}
+}
+
+// ScheduleClient is a client for the Schedule schema.
+type ScheduleClient struct {
+	config
+}
+
+// NewScheduleClient returns a client for the Schedule from the given config.
+func NewScheduleClient(c config) *ScheduleClient {
+	return &ScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `schedule.Hooks(f(g(h())))`.
+func (c *ScheduleClient) Use(hooks ...Hook) {
+	c.hooks.Schedule = append(c.hooks.Schedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `schedule.Intercept(f(g(h())))`.
+func (c *ScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Schedule = append(c.inters.Schedule, interceptors...)
+}
+
+// Create returns a builder for creating a Schedule entity.
+func (c *ScheduleClient) Create() *ScheduleCreate {
+	mutation := newScheduleMutation(c.config, OpCreate)
+	return &ScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Schedule entities.
+func (c *ScheduleClient) CreateBulk(builders ...*ScheduleCreate) *ScheduleCreateBulk {
+	return &ScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScheduleClient) MapCreateBulk(slice any, setFunc func(*ScheduleCreate, int)) *ScheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScheduleCreateBulk{err: fmt.Errorf("calling to ScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Schedule.
+func (c *ScheduleClient) Update() *ScheduleUpdate {
+	mutation := newScheduleMutation(c.config, OpUpdate)
+	return &ScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScheduleClient) UpdateOne(s *Schedule) *ScheduleUpdateOne {
+	mutation := newScheduleMutation(c.config, OpUpdateOne, withSchedule(s))
+	return &ScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScheduleClient) UpdateOneID(id int) *ScheduleUpdateOne {
+	mutation := newScheduleMutation(c.config, OpUpdateOne, withScheduleID(id))
+	return &ScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Schedule.
+func (c *ScheduleClient) Delete() *ScheduleDelete {
+	mutation := newScheduleMutation(c.config, OpDelete)
+	return &ScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScheduleClient) DeleteOne(s *Schedule) *ScheduleDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScheduleClient) DeleteOneID(id int) *ScheduleDeleteOne {
+	builder := c.Delete().Where(schedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for Schedule.
+func (c *ScheduleClient) Query() *ScheduleQuery {
+	return &ScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Schedule entity by its id.
+func (c *ScheduleClient) Get(ctx context.Context, id int) (*Schedule, error) {
+	return c.Query().Where(schedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScheduleClient) GetX(ctx context.Context, id int) *Schedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a Schedule.
+func (c *ScheduleClient) QueryTask(s *Schedule) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(schedule.Table, schedule.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, schedule.TaskTable, schedule.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHistories queries the histories edge of a Schedule.
+func (c *ScheduleClient) QueryHistories(s *Schedule) *ScheduleHistoryQuery {
+	query := (&ScheduleHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(schedule.Table, schedule.FieldID, id),
+			sqlgraph.To(schedulehistory.Table, schedulehistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, schedule.HistoriesTable, schedule.HistoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScheduleClient) Hooks() []Hook {
+	return c.hooks.Schedule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScheduleClient) Interceptors() []Interceptor {
+	return c.inters.Schedule
+}
+
+func (c *ScheduleClient) mutate(ctx context.Context, m *ScheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Schedule mutation op: %q", m.Op())
+	}
+}
+
+// ScheduleHistoryClient is a client for the ScheduleHistory schema.
+type ScheduleHistoryClient struct {
+	config
+}
+
+// NewScheduleHistoryClient returns a client for the ScheduleHistory from the given config.
+func NewScheduleHistoryClient(c config) *ScheduleHistoryClient {
+	return &ScheduleHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `schedulehistory.Hooks(f(g(h())))`.
+func (c *ScheduleHistoryClient) Use(hooks ...Hook) {
+	c.hooks.ScheduleHistory = append(c.hooks.ScheduleHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `schedulehistory.Intercept(f(g(h())))`.
+func (c *ScheduleHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduleHistory = append(c.inters.ScheduleHistory, interceptors...)
+}
+
+// Create returns a builder for creating a ScheduleHistory entity.
+func (c *ScheduleHistoryClient) Create() *ScheduleHistoryCreate {
+	mutation := newScheduleHistoryMutation(c.config, OpCreate)
+	return &ScheduleHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScheduleHistory entities.
+func (c *ScheduleHistoryClient) CreateBulk(builders ...*ScheduleHistoryCreate) *ScheduleHistoryCreateBulk {
+	return &ScheduleHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScheduleHistoryClient) MapCreateBulk(slice any, setFunc func(*ScheduleHistoryCreate, int)) *ScheduleHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScheduleHistoryCreateBulk{err: fmt.Errorf("calling to ScheduleHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScheduleHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScheduleHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScheduleHistory.
+func (c *ScheduleHistoryClient) Update() *ScheduleHistoryUpdate {
+	mutation := newScheduleHistoryMutation(c.config, OpUpdate)
+	return &ScheduleHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScheduleHistoryClient) UpdateOne(sh *ScheduleHistory) *ScheduleHistoryUpdateOne {
+	mutation := newScheduleHistoryMutation(c.config, OpUpdateOne, withScheduleHistory(sh))
+	return &ScheduleHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScheduleHistoryClient) UpdateOneID(id int) *ScheduleHistoryUpdateOne {
+	mutation := newScheduleHistoryMutation(c.config, OpUpdateOne, withScheduleHistoryID(id))
+	return &ScheduleHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScheduleHistory.
+func (c *ScheduleHistoryClient) Delete() *ScheduleHistoryDelete {
+	mutation := newScheduleHistoryMutation(c.config, OpDelete)
+	return &ScheduleHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScheduleHistoryClient) DeleteOne(sh *ScheduleHistory) *ScheduleHistoryDeleteOne {
+	return c.DeleteOneID(sh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScheduleHistoryClient) DeleteOneID(id int) *ScheduleHistoryDeleteOne {
+	builder := c.Delete().Where(schedulehistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScheduleHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for ScheduleHistory.
+func (c *ScheduleHistoryClient) Query() *ScheduleHistoryQuery {
+	return &ScheduleHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScheduleHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScheduleHistory entity by its id.
+func (c *ScheduleHistoryClient) Get(ctx context.Context, id int) (*ScheduleHistory, error) {
+	return c.Query().Where(schedulehistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScheduleHistoryClient) GetX(ctx context.Context, id int) *ScheduleHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySchedule queries the schedule edge of a ScheduleHistory.
+func (c *ScheduleHistoryClient) QuerySchedule(sh *ScheduleHistory) *ScheduleQuery {
+	query := (&ScheduleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(schedulehistory.Table, schedulehistory.FieldID, id),
+			sqlgraph.To(schedule.Table, schedule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, schedulehistory.ScheduleTable, schedulehistory.ScheduleColumn),
+		)
+		fromV = sqlgraph.Neighbors(sh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScheduleHistoryClient) Hooks() []Hook {
+	return c.hooks.ScheduleHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScheduleHistoryClient) Interceptors() []Interceptor {
+	return c.inters.ScheduleHistory
+}
+
+func (c *ScheduleHistoryClient) mutate(ctx context.Context, m *ScheduleHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScheduleHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScheduleHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScheduleHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScheduleHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScheduleHistory mutation op: %q", m.Op())
+	}
+}
+
+// TaskClient is a client for the Task schema.
+type TaskClient struct {
+	config
+}
+
+// NewTaskClient returns a client for the Task from the given config.
+func NewTaskClient(c config) *TaskClient {
+	return &TaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `task.Hooks(f(g(h())))`.
+func (c *TaskClient) Use(hooks ...Hook) {
+	c.hooks.Task = append(c.hooks.Task, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `task.Intercept(f(g(h())))`.
+func (c *TaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Task = append(c.inters.Task, interceptors...)
+}
+
+// Create returns a builder for creating a Task entity.
+func (c *TaskClient) Create() *TaskCreate {
+	mutation := newTaskMutation(c.config, OpCreate)
+	return &TaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Task entities.
+func (c *TaskClient) CreateBulk(builders ...*TaskCreate) *TaskCreateBulk {
+	return &TaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskClient) MapCreateBulk(slice any, setFunc func(*TaskCreate, int)) *TaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskCreateBulk{err: fmt.Errorf("calling to TaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Task.
+func (c *TaskClient) Update() *TaskUpdate {
+	mutation := newTaskMutation(c.config, OpUpdate)
+	return &TaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskClient) UpdateOne(t *Task) *TaskUpdateOne {
+	mutation := newTaskMutation(c.config, OpUpdateOne, withTask(t))
+	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskClient) UpdateOneID(id int) *TaskUpdateOne {
+	mutation := newTaskMutation(c.config, OpUpdateOne, withTaskID(id))
+	return &TaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Task.
+func (c *TaskClient) Delete() *TaskDelete {
+	mutation := newTaskMutation(c.config, OpDelete)
+	return &TaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskClient) DeleteOne(t *Task) *TaskDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskClient) DeleteOneID(id int) *TaskDeleteOne {
+	builder := c.Delete().Where(task.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskDeleteOne{builder}
+}
+
+// Query returns a query builder for Task.
+func (c *TaskClient) Query() *TaskQuery {
+	return &TaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Task entity by its id.
+func (c *TaskClient) Get(ctx context.Context, id int) (*Task, error) {
+	return c.Query().Where(task.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskClient) GetX(ctx context.Context, id int) *Task {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Task.
+func (c *TaskClient) QueryProject(t *Task) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, task.ProjectTable, task.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryModelings queries the modelings edge of a Task.
+func (c *TaskClient) QueryModelings(t *Task) *ModelingQuery {
+	query := (&ModelingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(modeling.Table, modeling.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.ModelingsTable, task.ModelingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySchedules queries the schedules edge of a Task.
+func (c *TaskClient) QuerySchedules(t *Task) *ScheduleQuery {
+	query := (&ScheduleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(schedule.Table, schedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.SchedulesTable, task.SchedulesColumn),
 		)
 		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
 		return fromV, nil
@@ -3176,14 +4511,18 @@ func (c *UserProjectClient) mutate(ctx context.Context, m *UserProjectMutation)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Configuration, Dataset, DatasetRoot, Device, EngineLog, Gpu, HyperParamsHistory,
-		Menu, Modeling, ModelingDetails, ModelingModels, Project, Task, Trial,
-		TrialDetails, TrialStatus, User, UserGroup, UserProject []ent.Hook
+		Configuration, Dataset, DatasetRoot, Device, DeviceToken, EarlyStop, EngineLog,
+		Gpu, GpuTelemetry, HyperParamsHistory, MaintenanceWindow, Menu, Modeling,
+		ModelingDetails, ModelingModels, Preemption, Project, Quota, Reservation,
+		Schedule, ScheduleHistory, Task, Trial, TrialDetails, TrialStatus, User,
+		UserGroup, UserProject []ent.Hook
 	}
 	inters struct {
-		Configuration, Dataset, DatasetRoot, Device, EngineLog, Gpu, HyperParamsHistory,
-		Menu, Modeling, ModelingDetails, ModelingModels, Project, Task, Trial,
-		TrialDetails, TrialStatus, User, UserGroup, UserProject []ent.Interceptor
+		Configuration, Dataset, DatasetRoot, Device, DeviceToken, EarlyStop, EngineLog,
+		Gpu, GpuTelemetry, HyperParamsHistory, MaintenanceWindow, Menu, Modeling,
+		ModelingDetails, ModelingModels, Preemption, Project, Quota, Reservation,
+		Schedule, ScheduleHistory, Task, Trial, TrialDetails, TrialStatus, User,
+		UserGroup, UserProject []ent.Interceptor
 	}
 )
 
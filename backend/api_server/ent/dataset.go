@@ -55,6 +55,10 @@ type Dataset struct {
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// DrID holds the value of the "dr_id" field.
 	DrID int `json:"dr_id,omitempty"`
+	// Fingerprint holds the value of the "fingerprint" field.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// FingerprintAt holds the value of the "fingerprint_at" field.
+	FingerprintAt *time.Time `json:"fingerprint_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DatasetQuery when eager-loading is set.
 	Edges                 DatasetEdges `json:"edges"`
@@ -93,9 +97,9 @@ func (*Dataset) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case dataset.FieldID, dataset.FieldParentID, dataset.FieldDrID:
 			values[i] = new(sql.NullInt64)
-		case dataset.FieldName, dataset.FieldDescription, dataset.FieldPath, dataset.FieldStatPath, dataset.FieldDataType:
+		case dataset.FieldName, dataset.FieldDescription, dataset.FieldPath, dataset.FieldStatPath, dataset.FieldDataType, dataset.FieldFingerprint:
 			values[i] = new(sql.NullString)
-		case dataset.FieldCreatedAt, dataset.FieldUpdatedAt, dataset.FieldDeletedAt:
+		case dataset.FieldCreatedAt, dataset.FieldUpdatedAt, dataset.FieldDeletedAt, dataset.FieldFingerprintAt:
 			values[i] = new(sql.NullTime)
 		case dataset.ForeignKeys[0]: // dataset_root_datasets
 			values[i] = new(sql.NullInt64)
@@ -232,6 +236,19 @@ func (d *Dataset) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.DrID = int(value.Int64)
 			}
+		case dataset.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				d.Fingerprint = value.String
+			}
+		case dataset.FieldFingerprintAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint_at", values[i])
+			} else if value.Valid {
+				d.FingerprintAt = new(time.Time)
+				*d.FingerprintAt = value.Time
+			}
 		case dataset.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field dataset_root_datasets", value)
@@ -333,6 +350,14 @@ func (d *Dataset) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("dr_id=")
 	builder.WriteString(fmt.Sprintf("%v", d.DrID))
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(d.Fingerprint)
+	builder.WriteString(", ")
+	if v := d.FingerprintAt; v != nil {
+		builder.WriteString("fingerprint_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -50,6 +50,10 @@ const (
 	FieldDeletedAt = "deleted_at"
 	// FieldDrID holds the string denoting the dr_id field in the database.
 	FieldDrID = "dr_id"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
+	// FieldFingerprintAt holds the string denoting the fingerprint_at field in the database.
+	FieldFingerprintAt = "fingerprint_at"
 	// EdgeDatasetroot holds the string denoting the datasetroot edge name in mutations.
 	EdgeDatasetroot = "datasetroot"
 	// Table holds the table name of the dataset in the database.
@@ -84,6 +88,8 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldDeletedAt,
 	FieldDrID,
+	FieldFingerprint,
+	FieldFingerprintAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "dataset"
@@ -128,6 +134,8 @@ var (
 	DefaultDeletedAt func() time.Time
 	// DefaultDrID holds the default value on creation for the "dr_id" field.
 	DefaultDrID int
+	// DefaultFingerprint holds the default value on creation for the "fingerprint" field.
+	DefaultFingerprint string
 )
 
 // OrderOption defines the ordering options for the Dataset queries.
@@ -218,6 +226,16 @@ func ByDrID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDrID, opts...).ToFunc()
 }
 
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
+// ByFingerprintAt orders the results by the fingerprint_at field.
+func ByFingerprintAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprintAt, opts...).ToFunc()
+}
+
 // ByDatasetrootField orders the results by datasetroot field.
 func ByDatasetrootField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
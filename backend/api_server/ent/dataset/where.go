@@ -135,6 +135,16 @@ func DrID(v int) predicate.Dataset {
 	return predicate.Dataset(sql.FieldEQ(FieldDrID, v))
 }
 
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintAt applies equality check predicate on the "fingerprint_at" field. It's identical to FingerprintAtEQ.
+func FingerprintAt(v time.Time) predicate.Dataset {
+	return predicate.Dataset(sql.FieldEQ(FieldFingerprintAt, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Dataset {
 	return predicate.Dataset(sql.FieldEQ(FieldName, v))
@@ -770,6 +780,121 @@ func DrIDNotNil() predicate.Dataset {
 	return predicate.Dataset(sql.FieldNotNull(FieldDrID))
 }
 
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.Dataset {
+	return predicate.Dataset(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// FingerprintAtEQ applies the EQ predicate on the "fingerprint_at" field.
+func FingerprintAtEQ(v time.Time) predicate.Dataset {
+	return predicate.Dataset(sql.FieldEQ(FieldFingerprintAt, v))
+}
+
+// FingerprintAtNEQ applies the NEQ predicate on the "fingerprint_at" field.
+func FingerprintAtNEQ(v time.Time) predicate.Dataset {
+	return predicate.Dataset(sql.FieldNEQ(FieldFingerprintAt, v))
+}
+
+// FingerprintAtIn applies the In predicate on the "fingerprint_at" field.
+func FingerprintAtIn(vs ...time.Time) predicate.Dataset {
+	return predicate.Dataset(sql.FieldIn(FieldFingerprintAt, vs...))
+}
+
+// FingerprintAtNotIn applies the NotIn predicate on the "fingerprint_at" field.
+func FingerprintAtNotIn(vs ...time.Time) predicate.Dataset {
+	return predicate.Dataset(sql.FieldNotIn(FieldFingerprintAt, vs...))
+}
+
+// FingerprintAtGT applies the GT predicate on the "fingerprint_at" field.
+func FingerprintAtGT(v time.Time) predicate.Dataset {
+	return predicate.Dataset(sql.FieldGT(FieldFingerprintAt, v))
+}
+
+// FingerprintAtGTE applies the GTE predicate on the "fingerprint_at" field.
+func FingerprintAtGTE(v time.Time) predicate.Dataset {
+	return predicate.Dataset(sql.FieldGTE(FieldFingerprintAt, v))
+}
+
+// FingerprintAtLT applies the LT predicate on the "fingerprint_at" field.
+func FingerprintAtLT(v time.Time) predicate.Dataset {
+	return predicate.Dataset(sql.FieldLT(FieldFingerprintAt, v))
+}
+
+// FingerprintAtLTE applies the LTE predicate on the "fingerprint_at" field.
+func FingerprintAtLTE(v time.Time) predicate.Dataset {
+	return predicate.Dataset(sql.FieldLTE(FieldFingerprintAt, v))
+}
+
+// FingerprintAtIsNil applies the IsNil predicate on the "fingerprint_at" field.
+func FingerprintAtIsNil() predicate.Dataset {
+	return predicate.Dataset(sql.FieldIsNull(FieldFingerprintAt))
+}
+
+// FingerprintAtNotNil applies the NotNil predicate on the "fingerprint_at" field.
+func FingerprintAtNotNil() predicate.Dataset {
+	return predicate.Dataset(sql.FieldNotNull(FieldFingerprintAt))
+}
+
 // HasDatasetroot applies the HasEdge predicate on the "datasetroot" edge.
 func HasDatasetroot() predicate.Dataset {
 	return predicate.Dataset(func(s *sql.Selector) {
@@ -235,6 +235,34 @@ func (dc *DatasetCreate) SetNillableDrID(i *int) *DatasetCreate {
 	return dc
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (dc *DatasetCreate) SetFingerprint(s string) *DatasetCreate {
+	dc.mutation.SetFingerprint(s)
+	return dc
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (dc *DatasetCreate) SetNillableFingerprint(s *string) *DatasetCreate {
+	if s != nil {
+		dc.SetFingerprint(*s)
+	}
+	return dc
+}
+
+// SetFingerprintAt sets the "fingerprint_at" field.
+func (dc *DatasetCreate) SetFingerprintAt(t time.Time) *DatasetCreate {
+	dc.mutation.SetFingerprintAt(t)
+	return dc
+}
+
+// SetNillableFingerprintAt sets the "fingerprint_at" field if the given value is not nil.
+func (dc *DatasetCreate) SetNillableFingerprintAt(t *time.Time) *DatasetCreate {
+	if t != nil {
+		dc.SetFingerprintAt(*t)
+	}
+	return dc
+}
+
 // SetDatasetrootID sets the "datasetroot" edge to the DatasetRoot entity by ID.
 func (dc *DatasetCreate) SetDatasetrootID(id int) *DatasetCreate {
 	dc.mutation.SetDatasetrootID(id)
@@ -329,6 +357,10 @@ func (dc *DatasetCreate) defaults() {
 		v := dataset.DefaultDrID
 		dc.mutation.SetDrID(v)
 	}
+	if _, ok := dc.mutation.Fingerprint(); !ok {
+		v := dataset.DefaultFingerprint
+		dc.mutation.SetFingerprint(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -372,6 +404,9 @@ func (dc *DatasetCreate) check() error {
 	if _, ok := dc.mutation.DeletedAt(); !ok {
 		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "Dataset.deleted_at"`)}
 	}
+	if _, ok := dc.mutation.Fingerprint(); !ok {
+		return &ValidationError{Name: "fingerprint", err: errors.New(`ent: missing required field "Dataset.fingerprint"`)}
+	}
 	return nil
 }
 
@@ -471,6 +506,14 @@ func (dc *DatasetCreate) createSpec() (*Dataset, *sqlgraph.CreateSpec) {
 		_spec.SetField(dataset.FieldDrID, field.TypeInt, value)
 		_node.DrID = value
 	}
+	if value, ok := dc.mutation.Fingerprint(); ok {
+		_spec.SetField(dataset.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := dc.mutation.FingerprintAt(); ok {
+		_spec.SetField(dataset.FieldFingerprintAt, field.TypeTime, value)
+		_node.FingerprintAt = &value
+	}
 	if nodes := dc.mutation.DatasetrootIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -798,6 +841,36 @@ func (u *DatasetUpsert) ClearDrID() *DatasetUpsert {
 	return u
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (u *DatasetUpsert) SetFingerprint(v string) *DatasetUpsert {
+	u.Set(dataset.FieldFingerprint, v)
+	return u
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *DatasetUpsert) UpdateFingerprint() *DatasetUpsert {
+	u.SetExcluded(dataset.FieldFingerprint)
+	return u
+}
+
+// SetFingerprintAt sets the "fingerprint_at" field.
+func (u *DatasetUpsert) SetFingerprintAt(v time.Time) *DatasetUpsert {
+	u.Set(dataset.FieldFingerprintAt, v)
+	return u
+}
+
+// UpdateFingerprintAt sets the "fingerprint_at" field to the value that was provided on create.
+func (u *DatasetUpsert) UpdateFingerprintAt() *DatasetUpsert {
+	u.SetExcluded(dataset.FieldFingerprintAt)
+	return u
+}
+
+// ClearFingerprintAt clears the value of the "fingerprint_at" field.
+func (u *DatasetUpsert) ClearFingerprintAt() *DatasetUpsert {
+	u.SetNull(dataset.FieldFingerprintAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1139,6 +1212,41 @@ func (u *DatasetUpsertOne) ClearDrID() *DatasetUpsertOne {
 	})
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (u *DatasetUpsertOne) SetFingerprint(v string) *DatasetUpsertOne {
+	return u.Update(func(s *DatasetUpsert) {
+		s.SetFingerprint(v)
+	})
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *DatasetUpsertOne) UpdateFingerprint() *DatasetUpsertOne {
+	return u.Update(func(s *DatasetUpsert) {
+		s.UpdateFingerprint()
+	})
+}
+
+// SetFingerprintAt sets the "fingerprint_at" field.
+func (u *DatasetUpsertOne) SetFingerprintAt(v time.Time) *DatasetUpsertOne {
+	return u.Update(func(s *DatasetUpsert) {
+		s.SetFingerprintAt(v)
+	})
+}
+
+// UpdateFingerprintAt sets the "fingerprint_at" field to the value that was provided on create.
+func (u *DatasetUpsertOne) UpdateFingerprintAt() *DatasetUpsertOne {
+	return u.Update(func(s *DatasetUpsert) {
+		s.UpdateFingerprintAt()
+	})
+}
+
+// ClearFingerprintAt clears the value of the "fingerprint_at" field.
+func (u *DatasetUpsertOne) ClearFingerprintAt() *DatasetUpsertOne {
+	return u.Update(func(s *DatasetUpsert) {
+		s.ClearFingerprintAt()
+	})
+}
+
 // Exec executes the query.
 func (u *DatasetUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1644,6 +1752,41 @@ func (u *DatasetUpsertBulk) ClearDrID() *DatasetUpsertBulk {
 	})
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (u *DatasetUpsertBulk) SetFingerprint(v string) *DatasetUpsertBulk {
+	return u.Update(func(s *DatasetUpsert) {
+		s.SetFingerprint(v)
+	})
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *DatasetUpsertBulk) UpdateFingerprint() *DatasetUpsertBulk {
+	return u.Update(func(s *DatasetUpsert) {
+		s.UpdateFingerprint()
+	})
+}
+
+// SetFingerprintAt sets the "fingerprint_at" field.
+func (u *DatasetUpsertBulk) SetFingerprintAt(v time.Time) *DatasetUpsertBulk {
+	return u.Update(func(s *DatasetUpsert) {
+		s.SetFingerprintAt(v)
+	})
+}
+
+// UpdateFingerprintAt sets the "fingerprint_at" field to the value that was provided on create.
+func (u *DatasetUpsertBulk) UpdateFingerprintAt() *DatasetUpsertBulk {
+	return u.Update(func(s *DatasetUpsert) {
+		s.UpdateFingerprintAt()
+	})
+}
+
+// ClearFingerprintAt clears the value of the "fingerprint_at" field.
+func (u *DatasetUpsertBulk) ClearFingerprintAt() *DatasetUpsertBulk {
+	return u.Update(func(s *DatasetUpsert) {
+		s.ClearFingerprintAt()
+	})
+}
+
 // Exec executes the query.
 func (u *DatasetUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
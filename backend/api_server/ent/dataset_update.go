@@ -323,6 +323,40 @@ func (du *DatasetUpdate) ClearDrID() *DatasetUpdate {
 	return du
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (du *DatasetUpdate) SetFingerprint(s string) *DatasetUpdate {
+	du.mutation.SetFingerprint(s)
+	return du
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (du *DatasetUpdate) SetNillableFingerprint(s *string) *DatasetUpdate {
+	if s != nil {
+		du.SetFingerprint(*s)
+	}
+	return du
+}
+
+// SetFingerprintAt sets the "fingerprint_at" field.
+func (du *DatasetUpdate) SetFingerprintAt(t time.Time) *DatasetUpdate {
+	du.mutation.SetFingerprintAt(t)
+	return du
+}
+
+// SetNillableFingerprintAt sets the "fingerprint_at" field if the given value is not nil.
+func (du *DatasetUpdate) SetNillableFingerprintAt(t *time.Time) *DatasetUpdate {
+	if t != nil {
+		du.SetFingerprintAt(*t)
+	}
+	return du
+}
+
+// ClearFingerprintAt clears the value of the "fingerprint_at" field.
+func (du *DatasetUpdate) ClearFingerprintAt() *DatasetUpdate {
+	du.mutation.ClearFingerprintAt()
+	return du
+}
+
 // SetDatasetrootID sets the "datasetroot" edge to the DatasetRoot entity by ID.
 func (du *DatasetUpdate) SetDatasetrootID(id int) *DatasetUpdate {
 	du.mutation.SetDatasetrootID(id)
@@ -480,6 +514,15 @@ func (du *DatasetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if du.mutation.DrIDCleared() {
 		_spec.ClearField(dataset.FieldDrID, field.TypeInt)
 	}
+	if value, ok := du.mutation.Fingerprint(); ok {
+		_spec.SetField(dataset.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := du.mutation.FingerprintAt(); ok {
+		_spec.SetField(dataset.FieldFingerprintAt, field.TypeTime, value)
+	}
+	if du.mutation.FingerprintAtCleared() {
+		_spec.ClearField(dataset.FieldFingerprintAt, field.TypeTime)
+	}
 	if du.mutation.DatasetrootCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -823,6 +866,40 @@ func (duo *DatasetUpdateOne) ClearDrID() *DatasetUpdateOne {
 	return duo
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (duo *DatasetUpdateOne) SetFingerprint(s string) *DatasetUpdateOne {
+	duo.mutation.SetFingerprint(s)
+	return duo
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (duo *DatasetUpdateOne) SetNillableFingerprint(s *string) *DatasetUpdateOne {
+	if s != nil {
+		duo.SetFingerprint(*s)
+	}
+	return duo
+}
+
+// SetFingerprintAt sets the "fingerprint_at" field.
+func (duo *DatasetUpdateOne) SetFingerprintAt(t time.Time) *DatasetUpdateOne {
+	duo.mutation.SetFingerprintAt(t)
+	return duo
+}
+
+// SetNillableFingerprintAt sets the "fingerprint_at" field if the given value is not nil.
+func (duo *DatasetUpdateOne) SetNillableFingerprintAt(t *time.Time) *DatasetUpdateOne {
+	if t != nil {
+		duo.SetFingerprintAt(*t)
+	}
+	return duo
+}
+
+// ClearFingerprintAt clears the value of the "fingerprint_at" field.
+func (duo *DatasetUpdateOne) ClearFingerprintAt() *DatasetUpdateOne {
+	duo.mutation.ClearFingerprintAt()
+	return duo
+}
+
 // SetDatasetrootID sets the "datasetroot" edge to the DatasetRoot entity by ID.
 func (duo *DatasetUpdateOne) SetDatasetrootID(id int) *DatasetUpdateOne {
 	duo.mutation.SetDatasetrootID(id)
@@ -1010,6 +1087,15 @@ func (duo *DatasetUpdateOne) sqlSave(ctx context.Context) (_node *Dataset, err e
 	if duo.mutation.DrIDCleared() {
 		_spec.ClearField(dataset.FieldDrID, field.TypeInt)
 	}
+	if value, ok := duo.mutation.Fingerprint(); ok {
+		_spec.SetField(dataset.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.FingerprintAt(); ok {
+		_spec.SetField(dataset.FieldFingerprintAt, field.TypeTime, value)
+	}
+	if duo.mutation.FingerprintAtCleared() {
+		_spec.ClearField(dataset.FieldFingerprintAt, field.TypeTime)
+	}
 	if duo.mutation.DatasetrootCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -4,8 +4,11 @@ package ent
 
 import (
 	"api_server/ent/device"
+	"api_server/ent/schema"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -30,6 +33,18 @@ type Device struct {
 	Connection string `json:"connection,omitempty"`
 	// Available holds the value of the "available" field.
 	Available string `json:"available,omitempty"`
+	// HeartbeatAt holds the value of the "heartbeat_at" field.
+	HeartbeatAt *time.Time `json:"heartbeat_at,omitempty"`
+	// Capabilities holds the value of the "capabilities" field.
+	Capabilities *schema.DeviceCapabilities `json:"capabilities,omitempty"`
+	// Drain holds the value of the "drain" field.
+	Drain bool `json:"drain,omitempty"`
+	// DrainReason holds the value of the "drain_reason" field.
+	DrainReason string `json:"drain_reason,omitempty"`
+	// DrainAt holds the value of the "drain_at" field.
+	DrainAt *time.Time `json:"drain_at,omitempty"`
+	// DrainWindow holds the value of the "drain_window" field.
+	DrainWindow int `json:"drain_window,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DeviceQuery when eager-loading is set.
 	Edges        DeviceEdges `json:"edges"`
@@ -59,12 +74,16 @@ func (*Device) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case device.FieldIsUse:
+		case device.FieldCapabilities:
+			values[i] = new([]byte)
+		case device.FieldIsUse, device.FieldDrain:
 			values[i] = new(sql.NullBool)
-		case device.FieldID, device.FieldPort:
+		case device.FieldID, device.FieldPort, device.FieldDrainWindow:
 			values[i] = new(sql.NullInt64)
-		case device.FieldName, device.FieldIP, device.FieldType, device.FieldConnection, device.FieldAvailable:
+		case device.FieldName, device.FieldIP, device.FieldType, device.FieldConnection, device.FieldAvailable, device.FieldDrainReason:
 			values[i] = new(sql.NullString)
+		case device.FieldHeartbeatAt, device.FieldDrainAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -128,6 +147,46 @@ func (d *Device) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				d.Available = value.String
 			}
+		case device.FieldHeartbeatAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field heartbeat_at", values[i])
+			} else if value.Valid {
+				d.HeartbeatAt = new(time.Time)
+				*d.HeartbeatAt = value.Time
+			}
+		case device.FieldCapabilities:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field capabilities", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.Capabilities); err != nil {
+					return fmt.Errorf("unmarshal field capabilities: %w", err)
+				}
+			}
+		case device.FieldDrain:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field drain", values[i])
+			} else if value.Valid {
+				d.Drain = value.Bool
+			}
+		case device.FieldDrainReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field drain_reason", values[i])
+			} else if value.Valid {
+				d.DrainReason = value.String
+			}
+		case device.FieldDrainAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field drain_at", values[i])
+			} else if value.Valid {
+				d.DrainAt = new(time.Time)
+				*d.DrainAt = value.Time
+			}
+		case device.FieldDrainWindow:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field drain_window", values[i])
+			} else if value.Valid {
+				d.DrainWindow = int(value.Int64)
+			}
 		default:
 			d.selectValues.Set(columns[i], values[i])
 		}
@@ -189,6 +248,28 @@ func (d *Device) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("available=")
 	builder.WriteString(d.Available)
+	builder.WriteString(", ")
+	if v := d.HeartbeatAt; v != nil {
+		builder.WriteString("heartbeat_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("capabilities=")
+	builder.WriteString(fmt.Sprintf("%v", d.Capabilities))
+	builder.WriteString(", ")
+	builder.WriteString("drain=")
+	builder.WriteString(fmt.Sprintf("%v", d.Drain))
+	builder.WriteString(", ")
+	builder.WriteString("drain_reason=")
+	builder.WriteString(d.DrainReason)
+	builder.WriteString(", ")
+	if v := d.DrainAt; v != nil {
+		builder.WriteString("drain_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("drain_window=")
+	builder.WriteString(fmt.Sprintf("%v", d.DrainWindow))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -26,6 +26,18 @@ const (
 	FieldConnection = "connection"
 	// FieldAvailable holds the string denoting the available field in the database.
 	FieldAvailable = "available"
+	// FieldHeartbeatAt holds the string denoting the heartbeat_at field in the database.
+	FieldHeartbeatAt = "heartbeat_at"
+	// FieldCapabilities holds the string denoting the capabilities field in the database.
+	FieldCapabilities = "capabilities"
+	// FieldDrain holds the string denoting the drain field in the database.
+	FieldDrain = "drain"
+	// FieldDrainReason holds the string denoting the drain_reason field in the database.
+	FieldDrainReason = "drain_reason"
+	// FieldDrainAt holds the string denoting the drain_at field in the database.
+	FieldDrainAt = "drain_at"
+	// FieldDrainWindow holds the string denoting the drain_window field in the database.
+	FieldDrainWindow = "drain_window"
 	// EdgeGpu holds the string denoting the gpu edge name in mutations.
 	EdgeGpu = "gpu"
 	// Table holds the table name of the device in the database.
@@ -49,6 +61,12 @@ var Columns = []string{
 	FieldType,
 	FieldConnection,
 	FieldAvailable,
+	FieldHeartbeatAt,
+	FieldCapabilities,
+	FieldDrain,
+	FieldDrainReason,
+	FieldDrainAt,
+	FieldDrainWindow,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -70,6 +88,12 @@ var (
 	DefaultConnection string
 	// DefaultAvailable holds the default value on creation for the "available" field.
 	DefaultAvailable string
+	// DefaultDrain holds the default value on creation for the "drain" field.
+	DefaultDrain bool
+	// DefaultDrainReason holds the default value on creation for the "drain_reason" field.
+	DefaultDrainReason string
+	// DefaultDrainWindow holds the default value on creation for the "drain_window" field.
+	DefaultDrainWindow int
 )
 
 // OrderOption defines the ordering options for the Device queries.
@@ -115,6 +139,31 @@ func ByAvailable(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvailable, opts...).ToFunc()
 }
 
+// ByHeartbeatAt orders the results by the heartbeat_at field.
+func ByHeartbeatAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeartbeatAt, opts...).ToFunc()
+}
+
+// ByDrain orders the results by the drain field.
+func ByDrain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrain, opts...).ToFunc()
+}
+
+// ByDrainReason orders the results by the drain_reason field.
+func ByDrainReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrainReason, opts...).ToFunc()
+}
+
+// ByDrainAt orders the results by the drain_at field.
+func ByDrainAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrainAt, opts...).ToFunc()
+}
+
+// ByDrainWindow orders the results by the drain_window field.
+func ByDrainWindow(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrainWindow, opts...).ToFunc()
+}
+
 // ByGpuCount orders the results by gpu count.
 func ByGpuCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -4,6 +4,7 @@ package device
 
 import (
 	"api_server/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -89,6 +90,31 @@ func Available(v string) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldAvailable, v))
 }
 
+// HeartbeatAt applies equality check predicate on the "heartbeat_at" field. It's identical to HeartbeatAtEQ.
+func HeartbeatAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldHeartbeatAt, v))
+}
+
+// Drain applies equality check predicate on the "drain" field. It's identical to DrainEQ.
+func Drain(v bool) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDrain, v))
+}
+
+// DrainReason applies equality check predicate on the "drain_reason" field. It's identical to DrainReasonEQ.
+func DrainReason(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDrainReason, v))
+}
+
+// DrainAt applies equality check predicate on the "drain_at" field. It's identical to DrainAtEQ.
+func DrainAt(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDrainAt, v))
+}
+
+// DrainWindow applies equality check predicate on the "drain_window" field. It's identical to DrainWindowEQ.
+func DrainWindow(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDrainWindow, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldName, v))
@@ -464,6 +490,231 @@ func AvailableContainsFold(v string) predicate.Device {
 	return predicate.Device(sql.FieldContainsFold(FieldAvailable, v))
 }
 
+// HeartbeatAtEQ applies the EQ predicate on the "heartbeat_at" field.
+func HeartbeatAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtNEQ applies the NEQ predicate on the "heartbeat_at" field.
+func HeartbeatAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtIn applies the In predicate on the "heartbeat_at" field.
+func HeartbeatAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldHeartbeatAt, vs...))
+}
+
+// HeartbeatAtNotIn applies the NotIn predicate on the "heartbeat_at" field.
+func HeartbeatAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldHeartbeatAt, vs...))
+}
+
+// HeartbeatAtGT applies the GT predicate on the "heartbeat_at" field.
+func HeartbeatAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtGTE applies the GTE predicate on the "heartbeat_at" field.
+func HeartbeatAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtLT applies the LT predicate on the "heartbeat_at" field.
+func HeartbeatAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtLTE applies the LTE predicate on the "heartbeat_at" field.
+func HeartbeatAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldHeartbeatAt, v))
+}
+
+// HeartbeatAtIsNil applies the IsNil predicate on the "heartbeat_at" field.
+func HeartbeatAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldHeartbeatAt))
+}
+
+// HeartbeatAtNotNil applies the NotNil predicate on the "heartbeat_at" field.
+func HeartbeatAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldHeartbeatAt))
+}
+
+// CapabilitiesIsNil applies the IsNil predicate on the "capabilities" field.
+func CapabilitiesIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldCapabilities))
+}
+
+// CapabilitiesNotNil applies the NotNil predicate on the "capabilities" field.
+func CapabilitiesNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldCapabilities))
+}
+
+// DrainEQ applies the EQ predicate on the "drain" field.
+func DrainEQ(v bool) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDrain, v))
+}
+
+// DrainNEQ applies the NEQ predicate on the "drain" field.
+func DrainNEQ(v bool) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldDrain, v))
+}
+
+// DrainReasonEQ applies the EQ predicate on the "drain_reason" field.
+func DrainReasonEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDrainReason, v))
+}
+
+// DrainReasonNEQ applies the NEQ predicate on the "drain_reason" field.
+func DrainReasonNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldDrainReason, v))
+}
+
+// DrainReasonIn applies the In predicate on the "drain_reason" field.
+func DrainReasonIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldDrainReason, vs...))
+}
+
+// DrainReasonNotIn applies the NotIn predicate on the "drain_reason" field.
+func DrainReasonNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldDrainReason, vs...))
+}
+
+// DrainReasonGT applies the GT predicate on the "drain_reason" field.
+func DrainReasonGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldDrainReason, v))
+}
+
+// DrainReasonGTE applies the GTE predicate on the "drain_reason" field.
+func DrainReasonGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldDrainReason, v))
+}
+
+// DrainReasonLT applies the LT predicate on the "drain_reason" field.
+func DrainReasonLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldDrainReason, v))
+}
+
+// DrainReasonLTE applies the LTE predicate on the "drain_reason" field.
+func DrainReasonLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldDrainReason, v))
+}
+
+// DrainReasonContains applies the Contains predicate on the "drain_reason" field.
+func DrainReasonContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldDrainReason, v))
+}
+
+// DrainReasonHasPrefix applies the HasPrefix predicate on the "drain_reason" field.
+func DrainReasonHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldDrainReason, v))
+}
+
+// DrainReasonHasSuffix applies the HasSuffix predicate on the "drain_reason" field.
+func DrainReasonHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldDrainReason, v))
+}
+
+// DrainReasonEqualFold applies the EqualFold predicate on the "drain_reason" field.
+func DrainReasonEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldDrainReason, v))
+}
+
+// DrainReasonContainsFold applies the ContainsFold predicate on the "drain_reason" field.
+func DrainReasonContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldDrainReason, v))
+}
+
+// DrainAtEQ applies the EQ predicate on the "drain_at" field.
+func DrainAtEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDrainAt, v))
+}
+
+// DrainAtNEQ applies the NEQ predicate on the "drain_at" field.
+func DrainAtNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldDrainAt, v))
+}
+
+// DrainAtIn applies the In predicate on the "drain_at" field.
+func DrainAtIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldDrainAt, vs...))
+}
+
+// DrainAtNotIn applies the NotIn predicate on the "drain_at" field.
+func DrainAtNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldDrainAt, vs...))
+}
+
+// DrainAtGT applies the GT predicate on the "drain_at" field.
+func DrainAtGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldDrainAt, v))
+}
+
+// DrainAtGTE applies the GTE predicate on the "drain_at" field.
+func DrainAtGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldDrainAt, v))
+}
+
+// DrainAtLT applies the LT predicate on the "drain_at" field.
+func DrainAtLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldDrainAt, v))
+}
+
+// DrainAtLTE applies the LTE predicate on the "drain_at" field.
+func DrainAtLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldDrainAt, v))
+}
+
+// DrainAtIsNil applies the IsNil predicate on the "drain_at" field.
+func DrainAtIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldDrainAt))
+}
+
+// DrainAtNotNil applies the NotNil predicate on the "drain_at" field.
+func DrainAtNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldDrainAt))
+}
+
+// DrainWindowEQ applies the EQ predicate on the "drain_window" field.
+func DrainWindowEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDrainWindow, v))
+}
+
+// DrainWindowNEQ applies the NEQ predicate on the "drain_window" field.
+func DrainWindowNEQ(v int) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldDrainWindow, v))
+}
+
+// DrainWindowIn applies the In predicate on the "drain_window" field.
+func DrainWindowIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldDrainWindow, vs...))
+}
+
+// DrainWindowNotIn applies the NotIn predicate on the "drain_window" field.
+func DrainWindowNotIn(vs ...int) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldDrainWindow, vs...))
+}
+
+// DrainWindowGT applies the GT predicate on the "drain_window" field.
+func DrainWindowGT(v int) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldDrainWindow, v))
+}
+
+// DrainWindowGTE applies the GTE predicate on the "drain_window" field.
+func DrainWindowGTE(v int) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldDrainWindow, v))
+}
+
+// DrainWindowLT applies the LT predicate on the "drain_window" field.
+func DrainWindowLT(v int) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldDrainWindow, v))
+}
+
+// DrainWindowLTE applies the LTE predicate on the "drain_window" field.
+func DrainWindowLTE(v int) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldDrainWindow, v))
+}
+
 // HasGpu applies the HasEdge predicate on the "gpu" edge.
 func HasGpu() predicate.Device {
 	return predicate.Device(func(s *sql.Selector) {
@@ -5,9 +5,11 @@ package ent
 import (
 	"api_server/ent/device"
 	"api_server/ent/gpu"
+	"api_server/ent/schema"
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -96,6 +98,82 @@ func (dc *DeviceCreate) SetNillableAvailable(s *string) *DeviceCreate {
 	return dc
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (dc *DeviceCreate) SetHeartbeatAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetHeartbeatAt(t)
+	return dc
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableHeartbeatAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetHeartbeatAt(*t)
+	}
+	return dc
+}
+
+// SetCapabilities sets the "capabilities" field.
+func (dc *DeviceCreate) SetCapabilities(sc *schema.DeviceCapabilities) *DeviceCreate {
+	dc.mutation.SetCapabilities(sc)
+	return dc
+}
+
+// SetDrain sets the "drain" field.
+func (dc *DeviceCreate) SetDrain(b bool) *DeviceCreate {
+	dc.mutation.SetDrain(b)
+	return dc
+}
+
+// SetNillableDrain sets the "drain" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableDrain(b *bool) *DeviceCreate {
+	if b != nil {
+		dc.SetDrain(*b)
+	}
+	return dc
+}
+
+// SetDrainReason sets the "drain_reason" field.
+func (dc *DeviceCreate) SetDrainReason(s string) *DeviceCreate {
+	dc.mutation.SetDrainReason(s)
+	return dc
+}
+
+// SetNillableDrainReason sets the "drain_reason" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableDrainReason(s *string) *DeviceCreate {
+	if s != nil {
+		dc.SetDrainReason(*s)
+	}
+	return dc
+}
+
+// SetDrainAt sets the "drain_at" field.
+func (dc *DeviceCreate) SetDrainAt(t time.Time) *DeviceCreate {
+	dc.mutation.SetDrainAt(t)
+	return dc
+}
+
+// SetNillableDrainAt sets the "drain_at" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableDrainAt(t *time.Time) *DeviceCreate {
+	if t != nil {
+		dc.SetDrainAt(*t)
+	}
+	return dc
+}
+
+// SetDrainWindow sets the "drain_window" field.
+func (dc *DeviceCreate) SetDrainWindow(i int) *DeviceCreate {
+	dc.mutation.SetDrainWindow(i)
+	return dc
+}
+
+// SetNillableDrainWindow sets the "drain_window" field if the given value is not nil.
+func (dc *DeviceCreate) SetNillableDrainWindow(i *int) *DeviceCreate {
+	if i != nil {
+		dc.SetDrainWindow(*i)
+	}
+	return dc
+}
+
 // SetID sets the "id" field.
 func (dc *DeviceCreate) SetID(i int) *DeviceCreate {
 	dc.mutation.SetID(i)
@@ -168,6 +246,18 @@ func (dc *DeviceCreate) defaults() {
 		v := device.DefaultAvailable
 		dc.mutation.SetAvailable(v)
 	}
+	if _, ok := dc.mutation.Drain(); !ok {
+		v := device.DefaultDrain
+		dc.mutation.SetDrain(v)
+	}
+	if _, ok := dc.mutation.DrainReason(); !ok {
+		v := device.DefaultDrainReason
+		dc.mutation.SetDrainReason(v)
+	}
+	if _, ok := dc.mutation.DrainWindow(); !ok {
+		v := device.DefaultDrainWindow
+		dc.mutation.SetDrainWindow(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -193,6 +283,15 @@ func (dc *DeviceCreate) check() error {
 	if _, ok := dc.mutation.Available(); !ok {
 		return &ValidationError{Name: "available", err: errors.New(`ent: missing required field "Device.available"`)}
 	}
+	if _, ok := dc.mutation.Drain(); !ok {
+		return &ValidationError{Name: "drain", err: errors.New(`ent: missing required field "Device.drain"`)}
+	}
+	if _, ok := dc.mutation.DrainReason(); !ok {
+		return &ValidationError{Name: "drain_reason", err: errors.New(`ent: missing required field "Device.drain_reason"`)}
+	}
+	if _, ok := dc.mutation.DrainWindow(); !ok {
+		return &ValidationError{Name: "drain_window", err: errors.New(`ent: missing required field "Device.drain_window"`)}
+	}
 	return nil
 }
 
@@ -254,6 +353,30 @@ func (dc *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_spec.SetField(device.FieldAvailable, field.TypeString, value)
 		_node.Available = value
 	}
+	if value, ok := dc.mutation.HeartbeatAt(); ok {
+		_spec.SetField(device.FieldHeartbeatAt, field.TypeTime, value)
+		_node.HeartbeatAt = &value
+	}
+	if value, ok := dc.mutation.Capabilities(); ok {
+		_spec.SetField(device.FieldCapabilities, field.TypeJSON, value)
+		_node.Capabilities = value
+	}
+	if value, ok := dc.mutation.Drain(); ok {
+		_spec.SetField(device.FieldDrain, field.TypeBool, value)
+		_node.Drain = value
+	}
+	if value, ok := dc.mutation.DrainReason(); ok {
+		_spec.SetField(device.FieldDrainReason, field.TypeString, value)
+		_node.DrainReason = value
+	}
+	if value, ok := dc.mutation.DrainAt(); ok {
+		_spec.SetField(device.FieldDrainAt, field.TypeTime, value)
+		_node.DrainAt = &value
+	}
+	if value, ok := dc.mutation.DrainWindow(); ok {
+		_spec.SetField(device.FieldDrainWindow, field.TypeInt, value)
+		_node.DrainWindow = value
+	}
 	if nodes := dc.mutation.GpuIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -412,6 +535,102 @@ func (u *DeviceUpsert) UpdateAvailable() *DeviceUpsert {
 	return u
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *DeviceUpsert) SetHeartbeatAt(v time.Time) *DeviceUpsert {
+	u.Set(device.FieldHeartbeatAt, v)
+	return u
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateHeartbeatAt() *DeviceUpsert {
+	u.SetExcluded(device.FieldHeartbeatAt)
+	return u
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (u *DeviceUpsert) ClearHeartbeatAt() *DeviceUpsert {
+	u.SetNull(device.FieldHeartbeatAt)
+	return u
+}
+
+// SetCapabilities sets the "capabilities" field.
+func (u *DeviceUpsert) SetCapabilities(v *schema.DeviceCapabilities) *DeviceUpsert {
+	u.Set(device.FieldCapabilities, v)
+	return u
+}
+
+// UpdateCapabilities sets the "capabilities" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateCapabilities() *DeviceUpsert {
+	u.SetExcluded(device.FieldCapabilities)
+	return u
+}
+
+// ClearCapabilities clears the value of the "capabilities" field.
+func (u *DeviceUpsert) ClearCapabilities() *DeviceUpsert {
+	u.SetNull(device.FieldCapabilities)
+	return u
+}
+
+// SetDrain sets the "drain" field.
+func (u *DeviceUpsert) SetDrain(v bool) *DeviceUpsert {
+	u.Set(device.FieldDrain, v)
+	return u
+}
+
+// UpdateDrain sets the "drain" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateDrain() *DeviceUpsert {
+	u.SetExcluded(device.FieldDrain)
+	return u
+}
+
+// SetDrainReason sets the "drain_reason" field.
+func (u *DeviceUpsert) SetDrainReason(v string) *DeviceUpsert {
+	u.Set(device.FieldDrainReason, v)
+	return u
+}
+
+// UpdateDrainReason sets the "drain_reason" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateDrainReason() *DeviceUpsert {
+	u.SetExcluded(device.FieldDrainReason)
+	return u
+}
+
+// SetDrainAt sets the "drain_at" field.
+func (u *DeviceUpsert) SetDrainAt(v time.Time) *DeviceUpsert {
+	u.Set(device.FieldDrainAt, v)
+	return u
+}
+
+// UpdateDrainAt sets the "drain_at" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateDrainAt() *DeviceUpsert {
+	u.SetExcluded(device.FieldDrainAt)
+	return u
+}
+
+// ClearDrainAt clears the value of the "drain_at" field.
+func (u *DeviceUpsert) ClearDrainAt() *DeviceUpsert {
+	u.SetNull(device.FieldDrainAt)
+	return u
+}
+
+// SetDrainWindow sets the "drain_window" field.
+func (u *DeviceUpsert) SetDrainWindow(v int) *DeviceUpsert {
+	u.Set(device.FieldDrainWindow, v)
+	return u
+}
+
+// UpdateDrainWindow sets the "drain_window" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateDrainWindow() *DeviceUpsert {
+	u.SetExcluded(device.FieldDrainWindow)
+	return u
+}
+
+// AddDrainWindow adds v to the "drain_window" field.
+func (u *DeviceUpsert) AddDrainWindow(v int) *DeviceUpsert {
+	u.Add(device.FieldDrainWindow, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -565,6 +784,118 @@ func (u *DeviceUpsertOne) UpdateAvailable() *DeviceUpsertOne {
 	})
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *DeviceUpsertOne) SetHeartbeatAt(v time.Time) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetHeartbeatAt(v)
+	})
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateHeartbeatAt() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateHeartbeatAt()
+	})
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (u *DeviceUpsertOne) ClearHeartbeatAt() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearHeartbeatAt()
+	})
+}
+
+// SetCapabilities sets the "capabilities" field.
+func (u *DeviceUpsertOne) SetCapabilities(v *schema.DeviceCapabilities) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetCapabilities(v)
+	})
+}
+
+// UpdateCapabilities sets the "capabilities" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateCapabilities() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateCapabilities()
+	})
+}
+
+// ClearCapabilities clears the value of the "capabilities" field.
+func (u *DeviceUpsertOne) ClearCapabilities() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearCapabilities()
+	})
+}
+
+// SetDrain sets the "drain" field.
+func (u *DeviceUpsertOne) SetDrain(v bool) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetDrain(v)
+	})
+}
+
+// UpdateDrain sets the "drain" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateDrain() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateDrain()
+	})
+}
+
+// SetDrainReason sets the "drain_reason" field.
+func (u *DeviceUpsertOne) SetDrainReason(v string) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetDrainReason(v)
+	})
+}
+
+// UpdateDrainReason sets the "drain_reason" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateDrainReason() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateDrainReason()
+	})
+}
+
+// SetDrainAt sets the "drain_at" field.
+func (u *DeviceUpsertOne) SetDrainAt(v time.Time) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetDrainAt(v)
+	})
+}
+
+// UpdateDrainAt sets the "drain_at" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateDrainAt() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateDrainAt()
+	})
+}
+
+// ClearDrainAt clears the value of the "drain_at" field.
+func (u *DeviceUpsertOne) ClearDrainAt() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearDrainAt()
+	})
+}
+
+// SetDrainWindow sets the "drain_window" field.
+func (u *DeviceUpsertOne) SetDrainWindow(v int) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetDrainWindow(v)
+	})
+}
+
+// AddDrainWindow adds v to the "drain_window" field.
+func (u *DeviceUpsertOne) AddDrainWindow(v int) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.AddDrainWindow(v)
+	})
+}
+
+// UpdateDrainWindow sets the "drain_window" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateDrainWindow() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateDrainWindow()
+	})
+}
+
 // Exec executes the query.
 func (u *DeviceUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -884,6 +1215,118 @@ func (u *DeviceUpsertBulk) UpdateAvailable() *DeviceUpsertBulk {
 	})
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (u *DeviceUpsertBulk) SetHeartbeatAt(v time.Time) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetHeartbeatAt(v)
+	})
+}
+
+// UpdateHeartbeatAt sets the "heartbeat_at" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateHeartbeatAt() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateHeartbeatAt()
+	})
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (u *DeviceUpsertBulk) ClearHeartbeatAt() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearHeartbeatAt()
+	})
+}
+
+// SetCapabilities sets the "capabilities" field.
+func (u *DeviceUpsertBulk) SetCapabilities(v *schema.DeviceCapabilities) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetCapabilities(v)
+	})
+}
+
+// UpdateCapabilities sets the "capabilities" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateCapabilities() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateCapabilities()
+	})
+}
+
+// ClearCapabilities clears the value of the "capabilities" field.
+func (u *DeviceUpsertBulk) ClearCapabilities() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearCapabilities()
+	})
+}
+
+// SetDrain sets the "drain" field.
+func (u *DeviceUpsertBulk) SetDrain(v bool) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetDrain(v)
+	})
+}
+
+// UpdateDrain sets the "drain" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateDrain() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateDrain()
+	})
+}
+
+// SetDrainReason sets the "drain_reason" field.
+func (u *DeviceUpsertBulk) SetDrainReason(v string) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetDrainReason(v)
+	})
+}
+
+// UpdateDrainReason sets the "drain_reason" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateDrainReason() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateDrainReason()
+	})
+}
+
+// SetDrainAt sets the "drain_at" field.
+func (u *DeviceUpsertBulk) SetDrainAt(v time.Time) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetDrainAt(v)
+	})
+}
+
+// UpdateDrainAt sets the "drain_at" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateDrainAt() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateDrainAt()
+	})
+}
+
+// ClearDrainAt clears the value of the "drain_at" field.
+func (u *DeviceUpsertBulk) ClearDrainAt() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearDrainAt()
+	})
+}
+
+// SetDrainWindow sets the "drain_window" field.
+func (u *DeviceUpsertBulk) SetDrainWindow(v int) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetDrainWindow(v)
+	})
+}
+
+// AddDrainWindow adds v to the "drain_window" field.
+func (u *DeviceUpsertBulk) AddDrainWindow(v int) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.AddDrainWindow(v)
+	})
+}
+
+// UpdateDrainWindow sets the "drain_window" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateDrainWindow() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateDrainWindow()
+	})
+}
+
 // Exec executes the query.
 func (u *DeviceUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -6,9 +6,11 @@ import (
 	"api_server/ent/device"
 	"api_server/ent/gpu"
 	"api_server/ent/predicate"
+	"api_server/ent/schema"
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -134,6 +136,107 @@ func (du *DeviceUpdate) SetNillableAvailable(s *string) *DeviceUpdate {
 	return du
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (du *DeviceUpdate) SetHeartbeatAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetHeartbeatAt(t)
+	return du
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableHeartbeatAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetHeartbeatAt(*t)
+	}
+	return du
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (du *DeviceUpdate) ClearHeartbeatAt() *DeviceUpdate {
+	du.mutation.ClearHeartbeatAt()
+	return du
+}
+
+// SetCapabilities sets the "capabilities" field.
+func (du *DeviceUpdate) SetCapabilities(sc *schema.DeviceCapabilities) *DeviceUpdate {
+	du.mutation.SetCapabilities(sc)
+	return du
+}
+
+// ClearCapabilities clears the value of the "capabilities" field.
+func (du *DeviceUpdate) ClearCapabilities() *DeviceUpdate {
+	du.mutation.ClearCapabilities()
+	return du
+}
+
+// SetDrain sets the "drain" field.
+func (du *DeviceUpdate) SetDrain(b bool) *DeviceUpdate {
+	du.mutation.SetDrain(b)
+	return du
+}
+
+// SetNillableDrain sets the "drain" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableDrain(b *bool) *DeviceUpdate {
+	if b != nil {
+		du.SetDrain(*b)
+	}
+	return du
+}
+
+// SetDrainReason sets the "drain_reason" field.
+func (du *DeviceUpdate) SetDrainReason(s string) *DeviceUpdate {
+	du.mutation.SetDrainReason(s)
+	return du
+}
+
+// SetNillableDrainReason sets the "drain_reason" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableDrainReason(s *string) *DeviceUpdate {
+	if s != nil {
+		du.SetDrainReason(*s)
+	}
+	return du
+}
+
+// SetDrainAt sets the "drain_at" field.
+func (du *DeviceUpdate) SetDrainAt(t time.Time) *DeviceUpdate {
+	du.mutation.SetDrainAt(t)
+	return du
+}
+
+// SetNillableDrainAt sets the "drain_at" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableDrainAt(t *time.Time) *DeviceUpdate {
+	if t != nil {
+		du.SetDrainAt(*t)
+	}
+	return du
+}
+
+// ClearDrainAt clears the value of the "drain_at" field.
+func (du *DeviceUpdate) ClearDrainAt() *DeviceUpdate {
+	du.mutation.ClearDrainAt()
+	return du
+}
+
+// SetDrainWindow sets the "drain_window" field.
+func (du *DeviceUpdate) SetDrainWindow(i int) *DeviceUpdate {
+	du.mutation.ResetDrainWindow()
+	du.mutation.SetDrainWindow(i)
+	return du
+}
+
+// SetNillableDrainWindow sets the "drain_window" field if the given value is not nil.
+func (du *DeviceUpdate) SetNillableDrainWindow(i *int) *DeviceUpdate {
+	if i != nil {
+		du.SetDrainWindow(*i)
+	}
+	return du
+}
+
+// AddDrainWindow adds i to the "drain_window" field.
+func (du *DeviceUpdate) AddDrainWindow(i int) *DeviceUpdate {
+	du.mutation.AddDrainWindow(i)
+	return du
+}
+
 // AddGpuIDs adds the "gpu" edge to the Gpu entity by IDs.
 func (du *DeviceUpdate) AddGpuIDs(ids ...int) *DeviceUpdate {
 	du.mutation.AddGpuIDs(ids...)
@@ -241,6 +344,36 @@ func (du *DeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := du.mutation.Available(); ok {
 		_spec.SetField(device.FieldAvailable, field.TypeString, value)
 	}
+	if value, ok := du.mutation.HeartbeatAt(); ok {
+		_spec.SetField(device.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if du.mutation.HeartbeatAtCleared() {
+		_spec.ClearField(device.FieldHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.Capabilities(); ok {
+		_spec.SetField(device.FieldCapabilities, field.TypeJSON, value)
+	}
+	if du.mutation.CapabilitiesCleared() {
+		_spec.ClearField(device.FieldCapabilities, field.TypeJSON)
+	}
+	if value, ok := du.mutation.Drain(); ok {
+		_spec.SetField(device.FieldDrain, field.TypeBool, value)
+	}
+	if value, ok := du.mutation.DrainReason(); ok {
+		_spec.SetField(device.FieldDrainReason, field.TypeString, value)
+	}
+	if value, ok := du.mutation.DrainAt(); ok {
+		_spec.SetField(device.FieldDrainAt, field.TypeTime, value)
+	}
+	if du.mutation.DrainAtCleared() {
+		_spec.ClearField(device.FieldDrainAt, field.TypeTime)
+	}
+	if value, ok := du.mutation.DrainWindow(); ok {
+		_spec.SetField(device.FieldDrainWindow, field.TypeInt, value)
+	}
+	if value, ok := du.mutation.AddedDrainWindow(); ok {
+		_spec.AddField(device.FieldDrainWindow, field.TypeInt, value)
+	}
 	if du.mutation.GpuCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -413,6 +546,107 @@ func (duo *DeviceUpdateOne) SetNillableAvailable(s *string) *DeviceUpdateOne {
 	return duo
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (duo *DeviceUpdateOne) SetHeartbeatAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetHeartbeatAt(t)
+	return duo
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableHeartbeatAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetHeartbeatAt(*t)
+	}
+	return duo
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (duo *DeviceUpdateOne) ClearHeartbeatAt() *DeviceUpdateOne {
+	duo.mutation.ClearHeartbeatAt()
+	return duo
+}
+
+// SetCapabilities sets the "capabilities" field.
+func (duo *DeviceUpdateOne) SetCapabilities(sc *schema.DeviceCapabilities) *DeviceUpdateOne {
+	duo.mutation.SetCapabilities(sc)
+	return duo
+}
+
+// ClearCapabilities clears the value of the "capabilities" field.
+func (duo *DeviceUpdateOne) ClearCapabilities() *DeviceUpdateOne {
+	duo.mutation.ClearCapabilities()
+	return duo
+}
+
+// SetDrain sets the "drain" field.
+func (duo *DeviceUpdateOne) SetDrain(b bool) *DeviceUpdateOne {
+	duo.mutation.SetDrain(b)
+	return duo
+}
+
+// SetNillableDrain sets the "drain" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableDrain(b *bool) *DeviceUpdateOne {
+	if b != nil {
+		duo.SetDrain(*b)
+	}
+	return duo
+}
+
+// SetDrainReason sets the "drain_reason" field.
+func (duo *DeviceUpdateOne) SetDrainReason(s string) *DeviceUpdateOne {
+	duo.mutation.SetDrainReason(s)
+	return duo
+}
+
+// SetNillableDrainReason sets the "drain_reason" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableDrainReason(s *string) *DeviceUpdateOne {
+	if s != nil {
+		duo.SetDrainReason(*s)
+	}
+	return duo
+}
+
+// SetDrainAt sets the "drain_at" field.
+func (duo *DeviceUpdateOne) SetDrainAt(t time.Time) *DeviceUpdateOne {
+	duo.mutation.SetDrainAt(t)
+	return duo
+}
+
+// SetNillableDrainAt sets the "drain_at" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableDrainAt(t *time.Time) *DeviceUpdateOne {
+	if t != nil {
+		duo.SetDrainAt(*t)
+	}
+	return duo
+}
+
+// ClearDrainAt clears the value of the "drain_at" field.
+func (duo *DeviceUpdateOne) ClearDrainAt() *DeviceUpdateOne {
+	duo.mutation.ClearDrainAt()
+	return duo
+}
+
+// SetDrainWindow sets the "drain_window" field.
+func (duo *DeviceUpdateOne) SetDrainWindow(i int) *DeviceUpdateOne {
+	duo.mutation.ResetDrainWindow()
+	duo.mutation.SetDrainWindow(i)
+	return duo
+}
+
+// SetNillableDrainWindow sets the "drain_window" field if the given value is not nil.
+func (duo *DeviceUpdateOne) SetNillableDrainWindow(i *int) *DeviceUpdateOne {
+	if i != nil {
+		duo.SetDrainWindow(*i)
+	}
+	return duo
+}
+
+// AddDrainWindow adds i to the "drain_window" field.
+func (duo *DeviceUpdateOne) AddDrainWindow(i int) *DeviceUpdateOne {
+	duo.mutation.AddDrainWindow(i)
+	return duo
+}
+
 // AddGpuIDs adds the "gpu" edge to the Gpu entity by IDs.
 func (duo *DeviceUpdateOne) AddGpuIDs(ids ...int) *DeviceUpdateOne {
 	duo.mutation.AddGpuIDs(ids...)
@@ -550,6 +784,36 @@ func (duo *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err err
 	if value, ok := duo.mutation.Available(); ok {
 		_spec.SetField(device.FieldAvailable, field.TypeString, value)
 	}
+	if value, ok := duo.mutation.HeartbeatAt(); ok {
+		_spec.SetField(device.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if duo.mutation.HeartbeatAtCleared() {
+		_spec.ClearField(device.FieldHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.Capabilities(); ok {
+		_spec.SetField(device.FieldCapabilities, field.TypeJSON, value)
+	}
+	if duo.mutation.CapabilitiesCleared() {
+		_spec.ClearField(device.FieldCapabilities, field.TypeJSON)
+	}
+	if value, ok := duo.mutation.Drain(); ok {
+		_spec.SetField(device.FieldDrain, field.TypeBool, value)
+	}
+	if value, ok := duo.mutation.DrainReason(); ok {
+		_spec.SetField(device.FieldDrainReason, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.DrainAt(); ok {
+		_spec.SetField(device.FieldDrainAt, field.TypeTime, value)
+	}
+	if duo.mutation.DrainAtCleared() {
+		_spec.ClearField(device.FieldDrainAt, field.TypeTime)
+	}
+	if value, ok := duo.mutation.DrainWindow(); ok {
+		_spec.SetField(device.FieldDrainWindow, field.TypeInt, value)
+	}
+	if value, ok := duo.mutation.AddedDrainWindow(); ok {
+		_spec.AddField(device.FieldDrainWindow, field.TypeInt, value)
+	}
 	if duo.mutation.GpuCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
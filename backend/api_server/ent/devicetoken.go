@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/devicetoken"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// engine이 스스로 등록하고 heartbeat를 보낼 때 사용하는 device별 enrollment token
+type DeviceToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Label for admins
+	Name string `json:"name,omitempty"`
+	// SHA-256 of the token, the token itself is shown only once
+	TokenHash string `json:"-"`
+	// Device registered with the token, 0 until the first registration
+	DeviceID int `json:"device_id,omitempty"`
+	// Revoked holds the value of the "revoked" field.
+	Revoked bool `json:"revoked,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last registration or heartbeat
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeviceToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case devicetoken.FieldRevoked:
+			values[i] = new(sql.NullBool)
+		case devicetoken.FieldID, devicetoken.FieldDeviceID:
+			values[i] = new(sql.NullInt64)
+		case devicetoken.FieldName, devicetoken.FieldTokenHash, devicetoken.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case devicetoken.FieldCreatedAt, devicetoken.FieldUsedAt, devicetoken.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeviceToken fields.
+func (dt *DeviceToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case devicetoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			dt.ID = int(value.Int64)
+		case devicetoken.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				dt.Name = value.String
+			}
+		case devicetoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				dt.TokenHash = value.String
+			}
+		case devicetoken.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				dt.DeviceID = int(value.Int64)
+			}
+		case devicetoken.FieldRevoked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked", values[i])
+			} else if value.Valid {
+				dt.Revoked = value.Bool
+			}
+		case devicetoken.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				dt.CreatedBy = value.String
+			}
+		case devicetoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dt.CreatedAt = value.Time
+			}
+		case devicetoken.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				dt.UsedAt = new(time.Time)
+				*dt.UsedAt = value.Time
+			}
+		case devicetoken.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				dt.RevokedAt = new(time.Time)
+				*dt.RevokedAt = value.Time
+			}
+		default:
+			dt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeviceToken.
+// This includes values selected through modifiers, order, etc.
+func (dt *DeviceToken) Value(name string) (ent.Value, error) {
+	return dt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DeviceToken.
+// Note that you need to call DeviceToken.Unwrap() before calling this method if this DeviceToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dt *DeviceToken) Update() *DeviceTokenUpdateOne {
+	return NewDeviceTokenClient(dt.config).UpdateOne(dt)
+}
+
+// Unwrap unwraps the DeviceToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dt *DeviceToken) Unwrap() *DeviceToken {
+	_tx, ok := dt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeviceToken is not a transactional entity")
+	}
+	dt.config.driver = _tx.drv
+	return dt
+}
+
+// String implements the fmt.Stringer.
+func (dt *DeviceToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeviceToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dt.ID))
+	builder.WriteString("name=")
+	builder.WriteString(dt.Name)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(fmt.Sprintf("%v", dt.DeviceID))
+	builder.WriteString(", ")
+	builder.WriteString("revoked=")
+	builder.WriteString(fmt.Sprintf("%v", dt.Revoked))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(dt.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(dt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := dt.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := dt.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeviceTokens is a parsable slice of DeviceToken.
+type DeviceTokens []*DeviceToken
@@ -0,0 +1,958 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/devicetoken"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeviceTokenCreate is the builder for creating a DeviceToken entity.
+type DeviceTokenCreate struct {
+	config
+	mutation *DeviceTokenMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (dtc *DeviceTokenCreate) SetName(s string) *DeviceTokenCreate {
+	dtc.mutation.SetName(s)
+	return dtc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (dtc *DeviceTokenCreate) SetNillableName(s *string) *DeviceTokenCreate {
+	if s != nil {
+		dtc.SetName(*s)
+	}
+	return dtc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (dtc *DeviceTokenCreate) SetTokenHash(s string) *DeviceTokenCreate {
+	dtc.mutation.SetTokenHash(s)
+	return dtc
+}
+
+// SetDeviceID sets the "device_id" field.
+func (dtc *DeviceTokenCreate) SetDeviceID(i int) *DeviceTokenCreate {
+	dtc.mutation.SetDeviceID(i)
+	return dtc
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (dtc *DeviceTokenCreate) SetNillableDeviceID(i *int) *DeviceTokenCreate {
+	if i != nil {
+		dtc.SetDeviceID(*i)
+	}
+	return dtc
+}
+
+// SetRevoked sets the "revoked" field.
+func (dtc *DeviceTokenCreate) SetRevoked(b bool) *DeviceTokenCreate {
+	dtc.mutation.SetRevoked(b)
+	return dtc
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (dtc *DeviceTokenCreate) SetNillableRevoked(b *bool) *DeviceTokenCreate {
+	if b != nil {
+		dtc.SetRevoked(*b)
+	}
+	return dtc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (dtc *DeviceTokenCreate) SetCreatedBy(s string) *DeviceTokenCreate {
+	dtc.mutation.SetCreatedBy(s)
+	return dtc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (dtc *DeviceTokenCreate) SetNillableCreatedBy(s *string) *DeviceTokenCreate {
+	if s != nil {
+		dtc.SetCreatedBy(*s)
+	}
+	return dtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dtc *DeviceTokenCreate) SetCreatedAt(t time.Time) *DeviceTokenCreate {
+	dtc.mutation.SetCreatedAt(t)
+	return dtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dtc *DeviceTokenCreate) SetNillableCreatedAt(t *time.Time) *DeviceTokenCreate {
+	if t != nil {
+		dtc.SetCreatedAt(*t)
+	}
+	return dtc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (dtc *DeviceTokenCreate) SetUsedAt(t time.Time) *DeviceTokenCreate {
+	dtc.mutation.SetUsedAt(t)
+	return dtc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (dtc *DeviceTokenCreate) SetNillableUsedAt(t *time.Time) *DeviceTokenCreate {
+	if t != nil {
+		dtc.SetUsedAt(*t)
+	}
+	return dtc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (dtc *DeviceTokenCreate) SetRevokedAt(t time.Time) *DeviceTokenCreate {
+	dtc.mutation.SetRevokedAt(t)
+	return dtc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (dtc *DeviceTokenCreate) SetNillableRevokedAt(t *time.Time) *DeviceTokenCreate {
+	if t != nil {
+		dtc.SetRevokedAt(*t)
+	}
+	return dtc
+}
+
+// SetID sets the "id" field.
+func (dtc *DeviceTokenCreate) SetID(i int) *DeviceTokenCreate {
+	dtc.mutation.SetID(i)
+	return dtc
+}
+
+// Mutation returns the DeviceTokenMutation object of the builder.
+func (dtc *DeviceTokenCreate) Mutation() *DeviceTokenMutation {
+	return dtc.mutation
+}
+
+// Save creates the DeviceToken in the database.
+func (dtc *DeviceTokenCreate) Save(ctx context.Context) (*DeviceToken, error) {
+	dtc.defaults()
+	return withHooks(ctx, dtc.sqlSave, dtc.mutation, dtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dtc *DeviceTokenCreate) SaveX(ctx context.Context) *DeviceToken {
+	v, err := dtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dtc *DeviceTokenCreate) Exec(ctx context.Context) error {
+	_, err := dtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dtc *DeviceTokenCreate) ExecX(ctx context.Context) {
+	if err := dtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dtc *DeviceTokenCreate) defaults() {
+	if _, ok := dtc.mutation.Name(); !ok {
+		v := devicetoken.DefaultName
+		dtc.mutation.SetName(v)
+	}
+	if _, ok := dtc.mutation.DeviceID(); !ok {
+		v := devicetoken.DefaultDeviceID
+		dtc.mutation.SetDeviceID(v)
+	}
+	if _, ok := dtc.mutation.Revoked(); !ok {
+		v := devicetoken.DefaultRevoked
+		dtc.mutation.SetRevoked(v)
+	}
+	if _, ok := dtc.mutation.CreatedBy(); !ok {
+		v := devicetoken.DefaultCreatedBy
+		dtc.mutation.SetCreatedBy(v)
+	}
+	if _, ok := dtc.mutation.CreatedAt(); !ok {
+		v := devicetoken.DefaultCreatedAt()
+		dtc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dtc *DeviceTokenCreate) check() error {
+	if _, ok := dtc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "DeviceToken.name"`)}
+	}
+	if _, ok := dtc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "DeviceToken.token_hash"`)}
+	}
+	if _, ok := dtc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "DeviceToken.device_id"`)}
+	}
+	if _, ok := dtc.mutation.Revoked(); !ok {
+		return &ValidationError{Name: "revoked", err: errors.New(`ent: missing required field "DeviceToken.revoked"`)}
+	}
+	if _, ok := dtc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "DeviceToken.created_by"`)}
+	}
+	if _, ok := dtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeviceToken.created_at"`)}
+	}
+	return nil
+}
+
+func (dtc *DeviceTokenCreate) sqlSave(ctx context.Context) (*DeviceToken, error) {
+	if err := dtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	dtc.mutation.id = &_node.ID
+	dtc.mutation.done = true
+	return _node, nil
+}
+
+func (dtc *DeviceTokenCreate) createSpec() (*DeviceToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeviceToken{config: dtc.config}
+		_spec = sqlgraph.NewCreateSpec(devicetoken.Table, sqlgraph.NewFieldSpec(devicetoken.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = dtc.conflict
+	if id, ok := dtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dtc.mutation.Name(); ok {
+		_spec.SetField(devicetoken.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := dtc.mutation.TokenHash(); ok {
+		_spec.SetField(devicetoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := dtc.mutation.DeviceID(); ok {
+		_spec.SetField(devicetoken.FieldDeviceID, field.TypeInt, value)
+		_node.DeviceID = value
+	}
+	if value, ok := dtc.mutation.Revoked(); ok {
+		_spec.SetField(devicetoken.FieldRevoked, field.TypeBool, value)
+		_node.Revoked = value
+	}
+	if value, ok := dtc.mutation.CreatedBy(); ok {
+		_spec.SetField(devicetoken.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := dtc.mutation.CreatedAt(); ok {
+		_spec.SetField(devicetoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dtc.mutation.UsedAt(); ok {
+		_spec.SetField(devicetoken.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := dtc.mutation.RevokedAt(); ok {
+		_spec.SetField(devicetoken.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeviceToken.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeviceTokenUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (dtc *DeviceTokenCreate) OnConflict(opts ...sql.ConflictOption) *DeviceTokenUpsertOne {
+	dtc.conflict = opts
+	return &DeviceTokenUpsertOne{
+		create: dtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeviceToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dtc *DeviceTokenCreate) OnConflictColumns(columns ...string) *DeviceTokenUpsertOne {
+	dtc.conflict = append(dtc.conflict, sql.ConflictColumns(columns...))
+	return &DeviceTokenUpsertOne{
+		create: dtc,
+	}
+}
+
+type (
+	// DeviceTokenUpsertOne is the builder for "upsert"-ing
+	//  one DeviceToken node.
+	DeviceTokenUpsertOne struct {
+		create *DeviceTokenCreate
+	}
+
+	// DeviceTokenUpsert is the "OnConflict" setter.
+	DeviceTokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *DeviceTokenUpsert) SetName(v string) *DeviceTokenUpsert {
+	u.Set(devicetoken.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DeviceTokenUpsert) UpdateName() *DeviceTokenUpsert {
+	u.SetExcluded(devicetoken.FieldName)
+	return u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *DeviceTokenUpsert) SetTokenHash(v string) *DeviceTokenUpsert {
+	u.Set(devicetoken.FieldTokenHash, v)
+	return u
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *DeviceTokenUpsert) UpdateTokenHash() *DeviceTokenUpsert {
+	u.SetExcluded(devicetoken.FieldTokenHash)
+	return u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *DeviceTokenUpsert) SetDeviceID(v int) *DeviceTokenUpsert {
+	u.Set(devicetoken.FieldDeviceID, v)
+	return u
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *DeviceTokenUpsert) UpdateDeviceID() *DeviceTokenUpsert {
+	u.SetExcluded(devicetoken.FieldDeviceID)
+	return u
+}
+
+// AddDeviceID adds v to the "device_id" field.
+func (u *DeviceTokenUpsert) AddDeviceID(v int) *DeviceTokenUpsert {
+	u.Add(devicetoken.FieldDeviceID, v)
+	return u
+}
+
+// SetRevoked sets the "revoked" field.
+func (u *DeviceTokenUpsert) SetRevoked(v bool) *DeviceTokenUpsert {
+	u.Set(devicetoken.FieldRevoked, v)
+	return u
+}
+
+// UpdateRevoked sets the "revoked" field to the value that was provided on create.
+func (u *DeviceTokenUpsert) UpdateRevoked() *DeviceTokenUpsert {
+	u.SetExcluded(devicetoken.FieldRevoked)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *DeviceTokenUpsert) SetCreatedBy(v string) *DeviceTokenUpsert {
+	u.Set(devicetoken.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *DeviceTokenUpsert) UpdateCreatedBy() *DeviceTokenUpsert {
+	u.SetExcluded(devicetoken.FieldCreatedBy)
+	return u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *DeviceTokenUpsert) SetUsedAt(v time.Time) *DeviceTokenUpsert {
+	u.Set(devicetoken.FieldUsedAt, v)
+	return u
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *DeviceTokenUpsert) UpdateUsedAt() *DeviceTokenUpsert {
+	u.SetExcluded(devicetoken.FieldUsedAt)
+	return u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *DeviceTokenUpsert) ClearUsedAt() *DeviceTokenUpsert {
+	u.SetNull(devicetoken.FieldUsedAt)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *DeviceTokenUpsert) SetRevokedAt(v time.Time) *DeviceTokenUpsert {
+	u.Set(devicetoken.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *DeviceTokenUpsert) UpdateRevokedAt() *DeviceTokenUpsert {
+	u.SetExcluded(devicetoken.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *DeviceTokenUpsert) ClearRevokedAt() *DeviceTokenUpsert {
+	u.SetNull(devicetoken.FieldRevokedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeviceToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(devicetoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeviceTokenUpsertOne) UpdateNewValues() *DeviceTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(devicetoken.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(devicetoken.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeviceToken.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeviceTokenUpsertOne) Ignore() *DeviceTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeviceTokenUpsertOne) DoNothing() *DeviceTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeviceTokenCreate.OnConflict
+// documentation for more info.
+func (u *DeviceTokenUpsertOne) Update(set func(*DeviceTokenUpsert)) *DeviceTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeviceTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *DeviceTokenUpsertOne) SetName(v string) *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DeviceTokenUpsertOne) UpdateName() *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *DeviceTokenUpsertOne) SetTokenHash(v string) *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *DeviceTokenUpsertOne) UpdateTokenHash() *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *DeviceTokenUpsertOne) SetDeviceID(v int) *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetDeviceID(v)
+	})
+}
+
+// AddDeviceID adds v to the "device_id" field.
+func (u *DeviceTokenUpsertOne) AddDeviceID(v int) *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.AddDeviceID(v)
+	})
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *DeviceTokenUpsertOne) UpdateDeviceID() *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateDeviceID()
+	})
+}
+
+// SetRevoked sets the "revoked" field.
+func (u *DeviceTokenUpsertOne) SetRevoked(v bool) *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetRevoked(v)
+	})
+}
+
+// UpdateRevoked sets the "revoked" field to the value that was provided on create.
+func (u *DeviceTokenUpsertOne) UpdateRevoked() *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateRevoked()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *DeviceTokenUpsertOne) SetCreatedBy(v string) *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *DeviceTokenUpsertOne) UpdateCreatedBy() *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *DeviceTokenUpsertOne) SetUsedAt(v time.Time) *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *DeviceTokenUpsertOne) UpdateUsedAt() *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *DeviceTokenUpsertOne) ClearUsedAt() *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *DeviceTokenUpsertOne) SetRevokedAt(v time.Time) *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *DeviceTokenUpsertOne) UpdateRevokedAt() *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *DeviceTokenUpsertOne) ClearRevokedAt() *DeviceTokenUpsertOne {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeviceTokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeviceTokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeviceTokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeviceTokenUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeviceTokenUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeviceTokenCreateBulk is the builder for creating many DeviceToken entities in bulk.
+type DeviceTokenCreateBulk struct {
+	config
+	err      error
+	builders []*DeviceTokenCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeviceToken entities in the database.
+func (dtcb *DeviceTokenCreateBulk) Save(ctx context.Context) ([]*DeviceToken, error) {
+	if dtcb.err != nil {
+		return nil, dtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dtcb.builders))
+	nodes := make([]*DeviceToken, len(dtcb.builders))
+	mutators := make([]Mutator, len(dtcb.builders))
+	for i := range dtcb.builders {
+		func(i int, root context.Context) {
+			builder := dtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dtcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dtcb *DeviceTokenCreateBulk) SaveX(ctx context.Context) []*DeviceToken {
+	v, err := dtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dtcb *DeviceTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := dtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dtcb *DeviceTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := dtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeviceToken.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeviceTokenUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (dtcb *DeviceTokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeviceTokenUpsertBulk {
+	dtcb.conflict = opts
+	return &DeviceTokenUpsertBulk{
+		create: dtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeviceToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dtcb *DeviceTokenCreateBulk) OnConflictColumns(columns ...string) *DeviceTokenUpsertBulk {
+	dtcb.conflict = append(dtcb.conflict, sql.ConflictColumns(columns...))
+	return &DeviceTokenUpsertBulk{
+		create: dtcb,
+	}
+}
+
+// DeviceTokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeviceToken nodes.
+type DeviceTokenUpsertBulk struct {
+	create *DeviceTokenCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeviceToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(devicetoken.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeviceTokenUpsertBulk) UpdateNewValues() *DeviceTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(devicetoken.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(devicetoken.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeviceToken.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeviceTokenUpsertBulk) Ignore() *DeviceTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeviceTokenUpsertBulk) DoNothing() *DeviceTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeviceTokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeviceTokenUpsertBulk) Update(set func(*DeviceTokenUpsert)) *DeviceTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeviceTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *DeviceTokenUpsertBulk) SetName(v string) *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DeviceTokenUpsertBulk) UpdateName() *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *DeviceTokenUpsertBulk) SetTokenHash(v string) *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *DeviceTokenUpsertBulk) UpdateTokenHash() *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *DeviceTokenUpsertBulk) SetDeviceID(v int) *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetDeviceID(v)
+	})
+}
+
+// AddDeviceID adds v to the "device_id" field.
+func (u *DeviceTokenUpsertBulk) AddDeviceID(v int) *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.AddDeviceID(v)
+	})
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *DeviceTokenUpsertBulk) UpdateDeviceID() *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateDeviceID()
+	})
+}
+
+// SetRevoked sets the "revoked" field.
+func (u *DeviceTokenUpsertBulk) SetRevoked(v bool) *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetRevoked(v)
+	})
+}
+
+// UpdateRevoked sets the "revoked" field to the value that was provided on create.
+func (u *DeviceTokenUpsertBulk) UpdateRevoked() *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateRevoked()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *DeviceTokenUpsertBulk) SetCreatedBy(v string) *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *DeviceTokenUpsertBulk) UpdateCreatedBy() *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetUsedAt sets the "used_at" field.
+func (u *DeviceTokenUpsertBulk) SetUsedAt(v time.Time) *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetUsedAt(v)
+	})
+}
+
+// UpdateUsedAt sets the "used_at" field to the value that was provided on create.
+func (u *DeviceTokenUpsertBulk) UpdateUsedAt() *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateUsedAt()
+	})
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (u *DeviceTokenUpsertBulk) ClearUsedAt() *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.ClearUsedAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *DeviceTokenUpsertBulk) SetRevokedAt(v time.Time) *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *DeviceTokenUpsertBulk) UpdateRevokedAt() *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *DeviceTokenUpsertBulk) ClearRevokedAt() *DeviceTokenUpsertBulk {
+	return u.Update(func(s *DeviceTokenUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeviceTokenUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeviceTokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeviceTokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeviceTokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
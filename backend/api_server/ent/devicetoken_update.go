@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/devicetoken"
+	"api_server/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeviceTokenUpdate is the builder for updating DeviceToken entities.
+type DeviceTokenUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DeviceTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DeviceTokenUpdate builder.
+func (dtu *DeviceTokenUpdate) Where(ps ...predicate.DeviceToken) *DeviceTokenUpdate {
+	dtu.mutation.Where(ps...)
+	return dtu
+}
+
+// SetName sets the "name" field.
+func (dtu *DeviceTokenUpdate) SetName(s string) *DeviceTokenUpdate {
+	dtu.mutation.SetName(s)
+	return dtu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (dtu *DeviceTokenUpdate) SetNillableName(s *string) *DeviceTokenUpdate {
+	if s != nil {
+		dtu.SetName(*s)
+	}
+	return dtu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (dtu *DeviceTokenUpdate) SetTokenHash(s string) *DeviceTokenUpdate {
+	dtu.mutation.SetTokenHash(s)
+	return dtu
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (dtu *DeviceTokenUpdate) SetNillableTokenHash(s *string) *DeviceTokenUpdate {
+	if s != nil {
+		dtu.SetTokenHash(*s)
+	}
+	return dtu
+}
+
+// SetDeviceID sets the "device_id" field.
+func (dtu *DeviceTokenUpdate) SetDeviceID(i int) *DeviceTokenUpdate {
+	dtu.mutation.ResetDeviceID()
+	dtu.mutation.SetDeviceID(i)
+	return dtu
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (dtu *DeviceTokenUpdate) SetNillableDeviceID(i *int) *DeviceTokenUpdate {
+	if i != nil {
+		dtu.SetDeviceID(*i)
+	}
+	return dtu
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (dtu *DeviceTokenUpdate) AddDeviceID(i int) *DeviceTokenUpdate {
+	dtu.mutation.AddDeviceID(i)
+	return dtu
+}
+
+// SetRevoked sets the "revoked" field.
+func (dtu *DeviceTokenUpdate) SetRevoked(b bool) *DeviceTokenUpdate {
+	dtu.mutation.SetRevoked(b)
+	return dtu
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (dtu *DeviceTokenUpdate) SetNillableRevoked(b *bool) *DeviceTokenUpdate {
+	if b != nil {
+		dtu.SetRevoked(*b)
+	}
+	return dtu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (dtu *DeviceTokenUpdate) SetCreatedBy(s string) *DeviceTokenUpdate {
+	dtu.mutation.SetCreatedBy(s)
+	return dtu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (dtu *DeviceTokenUpdate) SetNillableCreatedBy(s *string) *DeviceTokenUpdate {
+	if s != nil {
+		dtu.SetCreatedBy(*s)
+	}
+	return dtu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (dtu *DeviceTokenUpdate) SetUsedAt(t time.Time) *DeviceTokenUpdate {
+	dtu.mutation.SetUsedAt(t)
+	return dtu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (dtu *DeviceTokenUpdate) SetNillableUsedAt(t *time.Time) *DeviceTokenUpdate {
+	if t != nil {
+		dtu.SetUsedAt(*t)
+	}
+	return dtu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (dtu *DeviceTokenUpdate) ClearUsedAt() *DeviceTokenUpdate {
+	dtu.mutation.ClearUsedAt()
+	return dtu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (dtu *DeviceTokenUpdate) SetRevokedAt(t time.Time) *DeviceTokenUpdate {
+	dtu.mutation.SetRevokedAt(t)
+	return dtu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (dtu *DeviceTokenUpdate) SetNillableRevokedAt(t *time.Time) *DeviceTokenUpdate {
+	if t != nil {
+		dtu.SetRevokedAt(*t)
+	}
+	return dtu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (dtu *DeviceTokenUpdate) ClearRevokedAt() *DeviceTokenUpdate {
+	dtu.mutation.ClearRevokedAt()
+	return dtu
+}
+
+// Mutation returns the DeviceTokenMutation object of the builder.
+func (dtu *DeviceTokenUpdate) Mutation() *DeviceTokenMutation {
+	return dtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dtu *DeviceTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, dtu.sqlSave, dtu.mutation, dtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dtu *DeviceTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := dtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dtu *DeviceTokenUpdate) Exec(ctx context.Context) error {
+	_, err := dtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dtu *DeviceTokenUpdate) ExecX(ctx context.Context) {
+	if err := dtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dtu *DeviceTokenUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeviceTokenUpdate {
+	dtu.modifiers = append(dtu.modifiers, modifiers...)
+	return dtu
+}
+
+func (dtu *DeviceTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(devicetoken.Table, devicetoken.Columns, sqlgraph.NewFieldSpec(devicetoken.FieldID, field.TypeInt))
+	if ps := dtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dtu.mutation.Name(); ok {
+		_spec.SetField(devicetoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := dtu.mutation.TokenHash(); ok {
+		_spec.SetField(devicetoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := dtu.mutation.DeviceID(); ok {
+		_spec.SetField(devicetoken.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := dtu.mutation.AddedDeviceID(); ok {
+		_spec.AddField(devicetoken.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := dtu.mutation.Revoked(); ok {
+		_spec.SetField(devicetoken.FieldRevoked, field.TypeBool, value)
+	}
+	if value, ok := dtu.mutation.CreatedBy(); ok {
+		_spec.SetField(devicetoken.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := dtu.mutation.UsedAt(); ok {
+		_spec.SetField(devicetoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if dtu.mutation.UsedAtCleared() {
+		_spec.ClearField(devicetoken.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := dtu.mutation.RevokedAt(); ok {
+		_spec.SetField(devicetoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if dtu.mutation.RevokedAtCleared() {
+		_spec.ClearField(devicetoken.FieldRevokedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(dtu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, dtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{devicetoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dtu.mutation.done = true
+	return n, nil
+}
+
+// DeviceTokenUpdateOne is the builder for updating a single DeviceToken entity.
+type DeviceTokenUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DeviceTokenMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (dtuo *DeviceTokenUpdateOne) SetName(s string) *DeviceTokenUpdateOne {
+	dtuo.mutation.SetName(s)
+	return dtuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (dtuo *DeviceTokenUpdateOne) SetNillableName(s *string) *DeviceTokenUpdateOne {
+	if s != nil {
+		dtuo.SetName(*s)
+	}
+	return dtuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (dtuo *DeviceTokenUpdateOne) SetTokenHash(s string) *DeviceTokenUpdateOne {
+	dtuo.mutation.SetTokenHash(s)
+	return dtuo
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (dtuo *DeviceTokenUpdateOne) SetNillableTokenHash(s *string) *DeviceTokenUpdateOne {
+	if s != nil {
+		dtuo.SetTokenHash(*s)
+	}
+	return dtuo
+}
+
+// SetDeviceID sets the "device_id" field.
+func (dtuo *DeviceTokenUpdateOne) SetDeviceID(i int) *DeviceTokenUpdateOne {
+	dtuo.mutation.ResetDeviceID()
+	dtuo.mutation.SetDeviceID(i)
+	return dtuo
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (dtuo *DeviceTokenUpdateOne) SetNillableDeviceID(i *int) *DeviceTokenUpdateOne {
+	if i != nil {
+		dtuo.SetDeviceID(*i)
+	}
+	return dtuo
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (dtuo *DeviceTokenUpdateOne) AddDeviceID(i int) *DeviceTokenUpdateOne {
+	dtuo.mutation.AddDeviceID(i)
+	return dtuo
+}
+
+// SetRevoked sets the "revoked" field.
+func (dtuo *DeviceTokenUpdateOne) SetRevoked(b bool) *DeviceTokenUpdateOne {
+	dtuo.mutation.SetRevoked(b)
+	return dtuo
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (dtuo *DeviceTokenUpdateOne) SetNillableRevoked(b *bool) *DeviceTokenUpdateOne {
+	if b != nil {
+		dtuo.SetRevoked(*b)
+	}
+	return dtuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (dtuo *DeviceTokenUpdateOne) SetCreatedBy(s string) *DeviceTokenUpdateOne {
+	dtuo.mutation.SetCreatedBy(s)
+	return dtuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (dtuo *DeviceTokenUpdateOne) SetNillableCreatedBy(s *string) *DeviceTokenUpdateOne {
+	if s != nil {
+		dtuo.SetCreatedBy(*s)
+	}
+	return dtuo
+}
+
+// SetUsedAt sets the "used_at" field.
+func (dtuo *DeviceTokenUpdateOne) SetUsedAt(t time.Time) *DeviceTokenUpdateOne {
+	dtuo.mutation.SetUsedAt(t)
+	return dtuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (dtuo *DeviceTokenUpdateOne) SetNillableUsedAt(t *time.Time) *DeviceTokenUpdateOne {
+	if t != nil {
+		dtuo.SetUsedAt(*t)
+	}
+	return dtuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (dtuo *DeviceTokenUpdateOne) ClearUsedAt() *DeviceTokenUpdateOne {
+	dtuo.mutation.ClearUsedAt()
+	return dtuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (dtuo *DeviceTokenUpdateOne) SetRevokedAt(t time.Time) *DeviceTokenUpdateOne {
+	dtuo.mutation.SetRevokedAt(t)
+	return dtuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (dtuo *DeviceTokenUpdateOne) SetNillableRevokedAt(t *time.Time) *DeviceTokenUpdateOne {
+	if t != nil {
+		dtuo.SetRevokedAt(*t)
+	}
+	return dtuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (dtuo *DeviceTokenUpdateOne) ClearRevokedAt() *DeviceTokenUpdateOne {
+	dtuo.mutation.ClearRevokedAt()
+	return dtuo
+}
+
+// Mutation returns the DeviceTokenMutation object of the builder.
+func (dtuo *DeviceTokenUpdateOne) Mutation() *DeviceTokenMutation {
+	return dtuo.mutation
+}
+
+// Where appends a list predicates to the DeviceTokenUpdate builder.
+func (dtuo *DeviceTokenUpdateOne) Where(ps ...predicate.DeviceToken) *DeviceTokenUpdateOne {
+	dtuo.mutation.Where(ps...)
+	return dtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dtuo *DeviceTokenUpdateOne) Select(field string, fields ...string) *DeviceTokenUpdateOne {
+	dtuo.fields = append([]string{field}, fields...)
+	return dtuo
+}
+
+// Save executes the query and returns the updated DeviceToken entity.
+func (dtuo *DeviceTokenUpdateOne) Save(ctx context.Context) (*DeviceToken, error) {
+	return withHooks(ctx, dtuo.sqlSave, dtuo.mutation, dtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dtuo *DeviceTokenUpdateOne) SaveX(ctx context.Context) *DeviceToken {
+	node, err := dtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dtuo *DeviceTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := dtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dtuo *DeviceTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := dtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dtuo *DeviceTokenUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeviceTokenUpdateOne {
+	dtuo.modifiers = append(dtuo.modifiers, modifiers...)
+	return dtuo
+}
+
+func (dtuo *DeviceTokenUpdateOne) sqlSave(ctx context.Context) (_node *DeviceToken, err error) {
+	_spec := sqlgraph.NewUpdateSpec(devicetoken.Table, devicetoken.Columns, sqlgraph.NewFieldSpec(devicetoken.FieldID, field.TypeInt))
+	id, ok := dtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeviceToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, devicetoken.FieldID)
+		for _, f := range fields {
+			if !devicetoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != devicetoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dtuo.mutation.Name(); ok {
+		_spec.SetField(devicetoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := dtuo.mutation.TokenHash(); ok {
+		_spec.SetField(devicetoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := dtuo.mutation.DeviceID(); ok {
+		_spec.SetField(devicetoken.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := dtuo.mutation.AddedDeviceID(); ok {
+		_spec.AddField(devicetoken.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := dtuo.mutation.Revoked(); ok {
+		_spec.SetField(devicetoken.FieldRevoked, field.TypeBool, value)
+	}
+	if value, ok := dtuo.mutation.CreatedBy(); ok {
+		_spec.SetField(devicetoken.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := dtuo.mutation.UsedAt(); ok {
+		_spec.SetField(devicetoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if dtuo.mutation.UsedAtCleared() {
+		_spec.ClearField(devicetoken.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := dtuo.mutation.RevokedAt(); ok {
+		_spec.SetField(devicetoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if dtuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(devicetoken.FieldRevokedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(dtuo.modifiers...)
+	_node = &DeviceToken{config: dtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{devicetoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dtuo.mutation.done = true
+	return _node, nil
+}
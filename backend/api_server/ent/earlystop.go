@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/earlystop"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// early stopping policy가 trial을 중단시킨 기록
+type EarlyStop struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Task ID
+	TaskID int `json:"task_id,omitempty"`
+	// Modeling ID of the stopped trial
+	ModelingID int `json:"modeling_id,omitempty"`
+	// Stopped trial UUID
+	TrialUUID string `json:"trial_uuid,omitempty"`
+	// median | patience | halving
+	Policy string `json:"policy,omitempty"`
+	// Metric the policy evaluated
+	Metric string `json:"metric,omitempty"`
+	// Epoch at the decision
+	Epoch int `json:"epoch,omitempty"`
+	// Metric value of the trial at the decision
+	Value float64 `json:"value,omitempty"`
+	// Why the trial was stopped
+	Reason string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EarlyStop) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case earlystop.FieldValue:
+			values[i] = new(sql.NullFloat64)
+		case earlystop.FieldID, earlystop.FieldTaskID, earlystop.FieldModelingID, earlystop.FieldEpoch:
+			values[i] = new(sql.NullInt64)
+		case earlystop.FieldTrialUUID, earlystop.FieldPolicy, earlystop.FieldMetric, earlystop.FieldReason:
+			values[i] = new(sql.NullString)
+		case earlystop.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EarlyStop fields.
+func (es *EarlyStop) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case earlystop.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			es.ID = int(value.Int64)
+		case earlystop.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				es.TaskID = int(value.Int64)
+			}
+		case earlystop.FieldModelingID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field modeling_id", values[i])
+			} else if value.Valid {
+				es.ModelingID = int(value.Int64)
+			}
+		case earlystop.FieldTrialUUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trial_uuid", values[i])
+			} else if value.Valid {
+				es.TrialUUID = value.String
+			}
+		case earlystop.FieldPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy", values[i])
+			} else if value.Valid {
+				es.Policy = value.String
+			}
+		case earlystop.FieldMetric:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metric", values[i])
+			} else if value.Valid {
+				es.Metric = value.String
+			}
+		case earlystop.FieldEpoch:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field epoch", values[i])
+			} else if value.Valid {
+				es.Epoch = int(value.Int64)
+			}
+		case earlystop.FieldValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				es.Value = value.Float64
+			}
+		case earlystop.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				es.Reason = value.String
+			}
+		case earlystop.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				es.CreatedAt = value.Time
+			}
+		default:
+			es.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the EarlyStop.
+// This includes values selected through modifiers, order, etc.
+func (es *EarlyStop) GetValue(name string) (ent.Value, error) {
+	return es.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EarlyStop.
+// Note that you need to call EarlyStop.Unwrap() before calling this method if this EarlyStop
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (es *EarlyStop) Update() *EarlyStopUpdateOne {
+	return NewEarlyStopClient(es.config).UpdateOne(es)
+}
+
+// Unwrap unwraps the EarlyStop entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (es *EarlyStop) Unwrap() *EarlyStop {
+	_tx, ok := es.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EarlyStop is not a transactional entity")
+	}
+	es.config.driver = _tx.drv
+	return es
+}
+
+// String implements the fmt.Stringer.
+func (es *EarlyStop) String() string {
+	var builder strings.Builder
+	builder.WriteString("EarlyStop(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", es.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", es.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("modeling_id=")
+	builder.WriteString(fmt.Sprintf("%v", es.ModelingID))
+	builder.WriteString(", ")
+	builder.WriteString("trial_uuid=")
+	builder.WriteString(es.TrialUUID)
+	builder.WriteString(", ")
+	builder.WriteString("policy=")
+	builder.WriteString(es.Policy)
+	builder.WriteString(", ")
+	builder.WriteString("metric=")
+	builder.WriteString(es.Metric)
+	builder.WriteString(", ")
+	builder.WriteString("epoch=")
+	builder.WriteString(fmt.Sprintf("%v", es.Epoch))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", es.Value))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(es.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(es.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EarlyStops is a parsable slice of EarlyStop.
+type EarlyStops []*EarlyStop
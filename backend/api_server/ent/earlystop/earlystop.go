@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package earlystop
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the earlystop type in the database.
+	Label = "early_stop"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTaskID holds the string denoting the task_id field in the database.
+	FieldTaskID = "task_id"
+	// FieldModelingID holds the string denoting the modeling_id field in the database.
+	FieldModelingID = "modeling_id"
+	// FieldTrialUUID holds the string denoting the trial_uuid field in the database.
+	FieldTrialUUID = "trial_uuid"
+	// FieldPolicy holds the string denoting the policy field in the database.
+	FieldPolicy = "policy"
+	// FieldMetric holds the string denoting the metric field in the database.
+	FieldMetric = "metric"
+	// FieldEpoch holds the string denoting the epoch field in the database.
+	FieldEpoch = "epoch"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the earlystop in the database.
+	Table = "early_stop"
+)
+
+// Columns holds all SQL columns for earlystop fields.
+var Columns = []string{
+	FieldID,
+	FieldTaskID,
+	FieldModelingID,
+	FieldTrialUUID,
+	FieldPolicy,
+	FieldMetric,
+	FieldEpoch,
+	FieldValue,
+	FieldReason,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMetric holds the default value on creation for the "metric" field.
+	DefaultMetric string
+	// DefaultEpoch holds the default value on creation for the "epoch" field.
+	DefaultEpoch int
+	// DefaultValue holds the default value on creation for the "value" field.
+	DefaultValue float64
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the EarlyStop queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTaskID orders the results by the task_id field.
+func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
+}
+
+// ByModelingID orders the results by the modeling_id field.
+func ByModelingID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModelingID, opts...).ToFunc()
+}
+
+// ByTrialUUID orders the results by the trial_uuid field.
+func ByTrialUUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrialUUID, opts...).ToFunc()
+}
+
+// ByPolicy orders the results by the policy field.
+func ByPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicy, opts...).ToFunc()
+}
+
+// ByMetric orders the results by the metric field.
+func ByMetric(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetric, opts...).ToFunc()
+}
+
+// ByEpoch orders the results by the epoch field.
+func ByEpoch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEpoch, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package earlystop
+
+import (
+	"api_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLTE(FieldID, id))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldTaskID, v))
+}
+
+// ModelingID applies equality check predicate on the "modeling_id" field. It's identical to ModelingIDEQ.
+func ModelingID(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldModelingID, v))
+}
+
+// TrialUUID applies equality check predicate on the "trial_uuid" field. It's identical to TrialUUIDEQ.
+func TrialUUID(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldTrialUUID, v))
+}
+
+// Metric applies equality check predicate on the "metric" field. It's identical to MetricEQ.
+func Metric(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldMetric, v))
+}
+
+// Epoch applies equality check predicate on the "epoch" field. It's identical to EpochEQ.
+func Epoch(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldEpoch, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v float64) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldValue, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLTE(FieldTaskID, v))
+}
+
+// ModelingIDEQ applies the EQ predicate on the "modeling_id" field.
+func ModelingIDEQ(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldModelingID, v))
+}
+
+// ModelingIDNEQ applies the NEQ predicate on the "modeling_id" field.
+func ModelingIDNEQ(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNEQ(FieldModelingID, v))
+}
+
+// ModelingIDIn applies the In predicate on the "modeling_id" field.
+func ModelingIDIn(vs ...int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldIn(FieldModelingID, vs...))
+}
+
+// ModelingIDNotIn applies the NotIn predicate on the "modeling_id" field.
+func ModelingIDNotIn(vs ...int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNotIn(FieldModelingID, vs...))
+}
+
+// ModelingIDGT applies the GT predicate on the "modeling_id" field.
+func ModelingIDGT(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGT(FieldModelingID, v))
+}
+
+// ModelingIDGTE applies the GTE predicate on the "modeling_id" field.
+func ModelingIDGTE(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGTE(FieldModelingID, v))
+}
+
+// ModelingIDLT applies the LT predicate on the "modeling_id" field.
+func ModelingIDLT(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLT(FieldModelingID, v))
+}
+
+// ModelingIDLTE applies the LTE predicate on the "modeling_id" field.
+func ModelingIDLTE(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLTE(FieldModelingID, v))
+}
+
+// TrialUUIDEQ applies the EQ predicate on the "trial_uuid" field.
+func TrialUUIDEQ(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldTrialUUID, v))
+}
+
+// TrialUUIDNEQ applies the NEQ predicate on the "trial_uuid" field.
+func TrialUUIDNEQ(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNEQ(FieldTrialUUID, v))
+}
+
+// TrialUUIDIn applies the In predicate on the "trial_uuid" field.
+func TrialUUIDIn(vs ...string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldIn(FieldTrialUUID, vs...))
+}
+
+// TrialUUIDNotIn applies the NotIn predicate on the "trial_uuid" field.
+func TrialUUIDNotIn(vs ...string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNotIn(FieldTrialUUID, vs...))
+}
+
+// TrialUUIDGT applies the GT predicate on the "trial_uuid" field.
+func TrialUUIDGT(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGT(FieldTrialUUID, v))
+}
+
+// TrialUUIDGTE applies the GTE predicate on the "trial_uuid" field.
+func TrialUUIDGTE(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGTE(FieldTrialUUID, v))
+}
+
+// TrialUUIDLT applies the LT predicate on the "trial_uuid" field.
+func TrialUUIDLT(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLT(FieldTrialUUID, v))
+}
+
+// TrialUUIDLTE applies the LTE predicate on the "trial_uuid" field.
+func TrialUUIDLTE(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLTE(FieldTrialUUID, v))
+}
+
+// TrialUUIDContains applies the Contains predicate on the "trial_uuid" field.
+func TrialUUIDContains(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldContains(FieldTrialUUID, v))
+}
+
+// TrialUUIDHasPrefix applies the HasPrefix predicate on the "trial_uuid" field.
+func TrialUUIDHasPrefix(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldHasPrefix(FieldTrialUUID, v))
+}
+
+// TrialUUIDHasSuffix applies the HasSuffix predicate on the "trial_uuid" field.
+func TrialUUIDHasSuffix(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldHasSuffix(FieldTrialUUID, v))
+}
+
+// TrialUUIDEqualFold applies the EqualFold predicate on the "trial_uuid" field.
+func TrialUUIDEqualFold(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEqualFold(FieldTrialUUID, v))
+}
+
+// TrialUUIDContainsFold applies the ContainsFold predicate on the "trial_uuid" field.
+func TrialUUIDContainsFold(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldContainsFold(FieldTrialUUID, v))
+}
+
+// PolicyEQ applies the EQ predicate on the "policy" field.
+func PolicyEQ(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldPolicy, v))
+}
+
+// PolicyNEQ applies the NEQ predicate on the "policy" field.
+func PolicyNEQ(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNEQ(FieldPolicy, v))
+}
+
+// PolicyIn applies the In predicate on the "policy" field.
+func PolicyIn(vs ...string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldIn(FieldPolicy, vs...))
+}
+
+// PolicyNotIn applies the NotIn predicate on the "policy" field.
+func PolicyNotIn(vs ...string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNotIn(FieldPolicy, vs...))
+}
+
+// PolicyGT applies the GT predicate on the "policy" field.
+func PolicyGT(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGT(FieldPolicy, v))
+}
+
+// PolicyGTE applies the GTE predicate on the "policy" field.
+func PolicyGTE(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGTE(FieldPolicy, v))
+}
+
+// PolicyLT applies the LT predicate on the "policy" field.
+func PolicyLT(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLT(FieldPolicy, v))
+}
+
+// PolicyLTE applies the LTE predicate on the "policy" field.
+func PolicyLTE(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLTE(FieldPolicy, v))
+}
+
+// PolicyContains applies the Contains predicate on the "policy" field.
+func PolicyContains(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldContains(FieldPolicy, v))
+}
+
+// PolicyHasPrefix applies the HasPrefix predicate on the "policy" field.
+func PolicyHasPrefix(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldHasPrefix(FieldPolicy, v))
+}
+
+// PolicyHasSuffix applies the HasSuffix predicate on the "policy" field.
+func PolicyHasSuffix(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldHasSuffix(FieldPolicy, v))
+}
+
+// PolicyEqualFold applies the EqualFold predicate on the "policy" field.
+func PolicyEqualFold(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEqualFold(FieldPolicy, v))
+}
+
+// PolicyContainsFold applies the ContainsFold predicate on the "policy" field.
+func PolicyContainsFold(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldContainsFold(FieldPolicy, v))
+}
+
+// MetricEQ applies the EQ predicate on the "metric" field.
+func MetricEQ(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldMetric, v))
+}
+
+// MetricNEQ applies the NEQ predicate on the "metric" field.
+func MetricNEQ(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNEQ(FieldMetric, v))
+}
+
+// MetricIn applies the In predicate on the "metric" field.
+func MetricIn(vs ...string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldIn(FieldMetric, vs...))
+}
+
+// MetricNotIn applies the NotIn predicate on the "metric" field.
+func MetricNotIn(vs ...string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNotIn(FieldMetric, vs...))
+}
+
+// MetricGT applies the GT predicate on the "metric" field.
+func MetricGT(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGT(FieldMetric, v))
+}
+
+// MetricGTE applies the GTE predicate on the "metric" field.
+func MetricGTE(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGTE(FieldMetric, v))
+}
+
+// MetricLT applies the LT predicate on the "metric" field.
+func MetricLT(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLT(FieldMetric, v))
+}
+
+// MetricLTE applies the LTE predicate on the "metric" field.
+func MetricLTE(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLTE(FieldMetric, v))
+}
+
+// MetricContains applies the Contains predicate on the "metric" field.
+func MetricContains(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldContains(FieldMetric, v))
+}
+
+// MetricHasPrefix applies the HasPrefix predicate on the "metric" field.
+func MetricHasPrefix(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldHasPrefix(FieldMetric, v))
+}
+
+// MetricHasSuffix applies the HasSuffix predicate on the "metric" field.
+func MetricHasSuffix(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldHasSuffix(FieldMetric, v))
+}
+
+// MetricEqualFold applies the EqualFold predicate on the "metric" field.
+func MetricEqualFold(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEqualFold(FieldMetric, v))
+}
+
+// MetricContainsFold applies the ContainsFold predicate on the "metric" field.
+func MetricContainsFold(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldContainsFold(FieldMetric, v))
+}
+
+// EpochEQ applies the EQ predicate on the "epoch" field.
+func EpochEQ(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldEpoch, v))
+}
+
+// EpochNEQ applies the NEQ predicate on the "epoch" field.
+func EpochNEQ(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNEQ(FieldEpoch, v))
+}
+
+// EpochIn applies the In predicate on the "epoch" field.
+func EpochIn(vs ...int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldIn(FieldEpoch, vs...))
+}
+
+// EpochNotIn applies the NotIn predicate on the "epoch" field.
+func EpochNotIn(vs ...int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNotIn(FieldEpoch, vs...))
+}
+
+// EpochGT applies the GT predicate on the "epoch" field.
+func EpochGT(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGT(FieldEpoch, v))
+}
+
+// EpochGTE applies the GTE predicate on the "epoch" field.
+func EpochGTE(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGTE(FieldEpoch, v))
+}
+
+// EpochLT applies the LT predicate on the "epoch" field.
+func EpochLT(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLT(FieldEpoch, v))
+}
+
+// EpochLTE applies the LTE predicate on the "epoch" field.
+func EpochLTE(v int) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLTE(FieldEpoch, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v float64) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v float64) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...float64) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...float64) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v float64) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v float64) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v float64) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v float64) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLTE(FieldValue, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EarlyStop) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EarlyStop) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EarlyStop) predicate.EarlyStop {
+	return predicate.EarlyStop(sql.NotPredicates(p))
+}
@@ -0,0 +1,1021 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/earlystop"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EarlyStopCreate is the builder for creating a EarlyStop entity.
+type EarlyStopCreate struct {
+	config
+	mutation *EarlyStopMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTaskID sets the "task_id" field.
+func (esc *EarlyStopCreate) SetTaskID(i int) *EarlyStopCreate {
+	esc.mutation.SetTaskID(i)
+	return esc
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (esc *EarlyStopCreate) SetModelingID(i int) *EarlyStopCreate {
+	esc.mutation.SetModelingID(i)
+	return esc
+}
+
+// SetTrialUUID sets the "trial_uuid" field.
+func (esc *EarlyStopCreate) SetTrialUUID(s string) *EarlyStopCreate {
+	esc.mutation.SetTrialUUID(s)
+	return esc
+}
+
+// SetPolicy sets the "policy" field.
+func (esc *EarlyStopCreate) SetPolicy(s string) *EarlyStopCreate {
+	esc.mutation.SetPolicy(s)
+	return esc
+}
+
+// SetMetric sets the "metric" field.
+func (esc *EarlyStopCreate) SetMetric(s string) *EarlyStopCreate {
+	esc.mutation.SetMetric(s)
+	return esc
+}
+
+// SetNillableMetric sets the "metric" field if the given value is not nil.
+func (esc *EarlyStopCreate) SetNillableMetric(s *string) *EarlyStopCreate {
+	if s != nil {
+		esc.SetMetric(*s)
+	}
+	return esc
+}
+
+// SetEpoch sets the "epoch" field.
+func (esc *EarlyStopCreate) SetEpoch(i int) *EarlyStopCreate {
+	esc.mutation.SetEpoch(i)
+	return esc
+}
+
+// SetNillableEpoch sets the "epoch" field if the given value is not nil.
+func (esc *EarlyStopCreate) SetNillableEpoch(i *int) *EarlyStopCreate {
+	if i != nil {
+		esc.SetEpoch(*i)
+	}
+	return esc
+}
+
+// SetValue sets the "value" field.
+func (esc *EarlyStopCreate) SetValue(f float64) *EarlyStopCreate {
+	esc.mutation.SetValue(f)
+	return esc
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (esc *EarlyStopCreate) SetNillableValue(f *float64) *EarlyStopCreate {
+	if f != nil {
+		esc.SetValue(*f)
+	}
+	return esc
+}
+
+// SetReason sets the "reason" field.
+func (esc *EarlyStopCreate) SetReason(s string) *EarlyStopCreate {
+	esc.mutation.SetReason(s)
+	return esc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (esc *EarlyStopCreate) SetNillableReason(s *string) *EarlyStopCreate {
+	if s != nil {
+		esc.SetReason(*s)
+	}
+	return esc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (esc *EarlyStopCreate) SetCreatedAt(t time.Time) *EarlyStopCreate {
+	esc.mutation.SetCreatedAt(t)
+	return esc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (esc *EarlyStopCreate) SetNillableCreatedAt(t *time.Time) *EarlyStopCreate {
+	if t != nil {
+		esc.SetCreatedAt(*t)
+	}
+	return esc
+}
+
+// SetID sets the "id" field.
+func (esc *EarlyStopCreate) SetID(i int) *EarlyStopCreate {
+	esc.mutation.SetID(i)
+	return esc
+}
+
+// Mutation returns the EarlyStopMutation object of the builder.
+func (esc *EarlyStopCreate) Mutation() *EarlyStopMutation {
+	return esc.mutation
+}
+
+// Save creates the EarlyStop in the database.
+func (esc *EarlyStopCreate) Save(ctx context.Context) (*EarlyStop, error) {
+	esc.defaults()
+	return withHooks(ctx, esc.sqlSave, esc.mutation, esc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (esc *EarlyStopCreate) SaveX(ctx context.Context) *EarlyStop {
+	v, err := esc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (esc *EarlyStopCreate) Exec(ctx context.Context) error {
+	_, err := esc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esc *EarlyStopCreate) ExecX(ctx context.Context) {
+	if err := esc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (esc *EarlyStopCreate) defaults() {
+	if _, ok := esc.mutation.Metric(); !ok {
+		v := earlystop.DefaultMetric
+		esc.mutation.SetMetric(v)
+	}
+	if _, ok := esc.mutation.Epoch(); !ok {
+		v := earlystop.DefaultEpoch
+		esc.mutation.SetEpoch(v)
+	}
+	if _, ok := esc.mutation.Value(); !ok {
+		v := earlystop.DefaultValue
+		esc.mutation.SetValue(v)
+	}
+	if _, ok := esc.mutation.Reason(); !ok {
+		v := earlystop.DefaultReason
+		esc.mutation.SetReason(v)
+	}
+	if _, ok := esc.mutation.CreatedAt(); !ok {
+		v := earlystop.DefaultCreatedAt()
+		esc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (esc *EarlyStopCreate) check() error {
+	if _, ok := esc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "EarlyStop.task_id"`)}
+	}
+	if _, ok := esc.mutation.ModelingID(); !ok {
+		return &ValidationError{Name: "modeling_id", err: errors.New(`ent: missing required field "EarlyStop.modeling_id"`)}
+	}
+	if _, ok := esc.mutation.TrialUUID(); !ok {
+		return &ValidationError{Name: "trial_uuid", err: errors.New(`ent: missing required field "EarlyStop.trial_uuid"`)}
+	}
+	if _, ok := esc.mutation.Policy(); !ok {
+		return &ValidationError{Name: "policy", err: errors.New(`ent: missing required field "EarlyStop.policy"`)}
+	}
+	if _, ok := esc.mutation.Metric(); !ok {
+		return &ValidationError{Name: "metric", err: errors.New(`ent: missing required field "EarlyStop.metric"`)}
+	}
+	if _, ok := esc.mutation.Epoch(); !ok {
+		return &ValidationError{Name: "epoch", err: errors.New(`ent: missing required field "EarlyStop.epoch"`)}
+	}
+	if _, ok := esc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "EarlyStop.value"`)}
+	}
+	if _, ok := esc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "EarlyStop.reason"`)}
+	}
+	if _, ok := esc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EarlyStop.created_at"`)}
+	}
+	return nil
+}
+
+func (esc *EarlyStopCreate) sqlSave(ctx context.Context) (*EarlyStop, error) {
+	if err := esc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := esc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, esc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	esc.mutation.id = &_node.ID
+	esc.mutation.done = true
+	return _node, nil
+}
+
+func (esc *EarlyStopCreate) createSpec() (*EarlyStop, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EarlyStop{config: esc.config}
+		_spec = sqlgraph.NewCreateSpec(earlystop.Table, sqlgraph.NewFieldSpec(earlystop.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = esc.conflict
+	if id, ok := esc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := esc.mutation.TaskID(); ok {
+		_spec.SetField(earlystop.FieldTaskID, field.TypeInt, value)
+		_node.TaskID = value
+	}
+	if value, ok := esc.mutation.ModelingID(); ok {
+		_spec.SetField(earlystop.FieldModelingID, field.TypeInt, value)
+		_node.ModelingID = value
+	}
+	if value, ok := esc.mutation.TrialUUID(); ok {
+		_spec.SetField(earlystop.FieldTrialUUID, field.TypeString, value)
+		_node.TrialUUID = value
+	}
+	if value, ok := esc.mutation.Policy(); ok {
+		_spec.SetField(earlystop.FieldPolicy, field.TypeString, value)
+		_node.Policy = value
+	}
+	if value, ok := esc.mutation.Metric(); ok {
+		_spec.SetField(earlystop.FieldMetric, field.TypeString, value)
+		_node.Metric = value
+	}
+	if value, ok := esc.mutation.Epoch(); ok {
+		_spec.SetField(earlystop.FieldEpoch, field.TypeInt, value)
+		_node.Epoch = value
+	}
+	if value, ok := esc.mutation.Value(); ok {
+		_spec.SetField(earlystop.FieldValue, field.TypeFloat64, value)
+		_node.Value = value
+	}
+	if value, ok := esc.mutation.Reason(); ok {
+		_spec.SetField(earlystop.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := esc.mutation.CreatedAt(); ok {
+		_spec.SetField(earlystop.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EarlyStop.Create().
+//		SetTaskID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EarlyStopUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (esc *EarlyStopCreate) OnConflict(opts ...sql.ConflictOption) *EarlyStopUpsertOne {
+	esc.conflict = opts
+	return &EarlyStopUpsertOne{
+		create: esc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EarlyStop.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (esc *EarlyStopCreate) OnConflictColumns(columns ...string) *EarlyStopUpsertOne {
+	esc.conflict = append(esc.conflict, sql.ConflictColumns(columns...))
+	return &EarlyStopUpsertOne{
+		create: esc,
+	}
+}
+
+type (
+	// EarlyStopUpsertOne is the builder for "upsert"-ing
+	//  one EarlyStop node.
+	EarlyStopUpsertOne struct {
+		create *EarlyStopCreate
+	}
+
+	// EarlyStopUpsert is the "OnConflict" setter.
+	EarlyStopUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTaskID sets the "task_id" field.
+func (u *EarlyStopUpsert) SetTaskID(v int) *EarlyStopUpsert {
+	u.Set(earlystop.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *EarlyStopUpsert) UpdateTaskID() *EarlyStopUpsert {
+	u.SetExcluded(earlystop.FieldTaskID)
+	return u
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *EarlyStopUpsert) AddTaskID(v int) *EarlyStopUpsert {
+	u.Add(earlystop.FieldTaskID, v)
+	return u
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (u *EarlyStopUpsert) SetModelingID(v int) *EarlyStopUpsert {
+	u.Set(earlystop.FieldModelingID, v)
+	return u
+}
+
+// UpdateModelingID sets the "modeling_id" field to the value that was provided on create.
+func (u *EarlyStopUpsert) UpdateModelingID() *EarlyStopUpsert {
+	u.SetExcluded(earlystop.FieldModelingID)
+	return u
+}
+
+// AddModelingID adds v to the "modeling_id" field.
+func (u *EarlyStopUpsert) AddModelingID(v int) *EarlyStopUpsert {
+	u.Add(earlystop.FieldModelingID, v)
+	return u
+}
+
+// SetTrialUUID sets the "trial_uuid" field.
+func (u *EarlyStopUpsert) SetTrialUUID(v string) *EarlyStopUpsert {
+	u.Set(earlystop.FieldTrialUUID, v)
+	return u
+}
+
+// UpdateTrialUUID sets the "trial_uuid" field to the value that was provided on create.
+func (u *EarlyStopUpsert) UpdateTrialUUID() *EarlyStopUpsert {
+	u.SetExcluded(earlystop.FieldTrialUUID)
+	return u
+}
+
+// SetPolicy sets the "policy" field.
+func (u *EarlyStopUpsert) SetPolicy(v string) *EarlyStopUpsert {
+	u.Set(earlystop.FieldPolicy, v)
+	return u
+}
+
+// UpdatePolicy sets the "policy" field to the value that was provided on create.
+func (u *EarlyStopUpsert) UpdatePolicy() *EarlyStopUpsert {
+	u.SetExcluded(earlystop.FieldPolicy)
+	return u
+}
+
+// SetMetric sets the "metric" field.
+func (u *EarlyStopUpsert) SetMetric(v string) *EarlyStopUpsert {
+	u.Set(earlystop.FieldMetric, v)
+	return u
+}
+
+// UpdateMetric sets the "metric" field to the value that was provided on create.
+func (u *EarlyStopUpsert) UpdateMetric() *EarlyStopUpsert {
+	u.SetExcluded(earlystop.FieldMetric)
+	return u
+}
+
+// SetEpoch sets the "epoch" field.
+func (u *EarlyStopUpsert) SetEpoch(v int) *EarlyStopUpsert {
+	u.Set(earlystop.FieldEpoch, v)
+	return u
+}
+
+// UpdateEpoch sets the "epoch" field to the value that was provided on create.
+func (u *EarlyStopUpsert) UpdateEpoch() *EarlyStopUpsert {
+	u.SetExcluded(earlystop.FieldEpoch)
+	return u
+}
+
+// AddEpoch adds v to the "epoch" field.
+func (u *EarlyStopUpsert) AddEpoch(v int) *EarlyStopUpsert {
+	u.Add(earlystop.FieldEpoch, v)
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *EarlyStopUpsert) SetValue(v float64) *EarlyStopUpsert {
+	u.Set(earlystop.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *EarlyStopUpsert) UpdateValue() *EarlyStopUpsert {
+	u.SetExcluded(earlystop.FieldValue)
+	return u
+}
+
+// AddValue adds v to the "value" field.
+func (u *EarlyStopUpsert) AddValue(v float64) *EarlyStopUpsert {
+	u.Add(earlystop.FieldValue, v)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *EarlyStopUpsert) SetReason(v string) *EarlyStopUpsert {
+	u.Set(earlystop.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *EarlyStopUpsert) UpdateReason() *EarlyStopUpsert {
+	u.SetExcluded(earlystop.FieldReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EarlyStop.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(earlystop.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EarlyStopUpsertOne) UpdateNewValues() *EarlyStopUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(earlystop.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(earlystop.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EarlyStop.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EarlyStopUpsertOne) Ignore() *EarlyStopUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EarlyStopUpsertOne) DoNothing() *EarlyStopUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EarlyStopCreate.OnConflict
+// documentation for more info.
+func (u *EarlyStopUpsertOne) Update(set func(*EarlyStopUpsert)) *EarlyStopUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EarlyStopUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *EarlyStopUpsertOne) SetTaskID(v int) *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *EarlyStopUpsertOne) AddTaskID(v int) *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *EarlyStopUpsertOne) UpdateTaskID() *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (u *EarlyStopUpsertOne) SetModelingID(v int) *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetModelingID(v)
+	})
+}
+
+// AddModelingID adds v to the "modeling_id" field.
+func (u *EarlyStopUpsertOne) AddModelingID(v int) *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.AddModelingID(v)
+	})
+}
+
+// UpdateModelingID sets the "modeling_id" field to the value that was provided on create.
+func (u *EarlyStopUpsertOne) UpdateModelingID() *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateModelingID()
+	})
+}
+
+// SetTrialUUID sets the "trial_uuid" field.
+func (u *EarlyStopUpsertOne) SetTrialUUID(v string) *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetTrialUUID(v)
+	})
+}
+
+// UpdateTrialUUID sets the "trial_uuid" field to the value that was provided on create.
+func (u *EarlyStopUpsertOne) UpdateTrialUUID() *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateTrialUUID()
+	})
+}
+
+// SetPolicy sets the "policy" field.
+func (u *EarlyStopUpsertOne) SetPolicy(v string) *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetPolicy(v)
+	})
+}
+
+// UpdatePolicy sets the "policy" field to the value that was provided on create.
+func (u *EarlyStopUpsertOne) UpdatePolicy() *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdatePolicy()
+	})
+}
+
+// SetMetric sets the "metric" field.
+func (u *EarlyStopUpsertOne) SetMetric(v string) *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetMetric(v)
+	})
+}
+
+// UpdateMetric sets the "metric" field to the value that was provided on create.
+func (u *EarlyStopUpsertOne) UpdateMetric() *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateMetric()
+	})
+}
+
+// SetEpoch sets the "epoch" field.
+func (u *EarlyStopUpsertOne) SetEpoch(v int) *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetEpoch(v)
+	})
+}
+
+// AddEpoch adds v to the "epoch" field.
+func (u *EarlyStopUpsertOne) AddEpoch(v int) *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.AddEpoch(v)
+	})
+}
+
+// UpdateEpoch sets the "epoch" field to the value that was provided on create.
+func (u *EarlyStopUpsertOne) UpdateEpoch() *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateEpoch()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *EarlyStopUpsertOne) SetValue(v float64) *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// AddValue adds v to the "value" field.
+func (u *EarlyStopUpsertOne) AddValue(v float64) *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.AddValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *EarlyStopUpsertOne) UpdateValue() *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *EarlyStopUpsertOne) SetReason(v string) *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *EarlyStopUpsertOne) UpdateReason() *EarlyStopUpsertOne {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// Exec executes the query.
+func (u *EarlyStopUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EarlyStopCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EarlyStopUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EarlyStopUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EarlyStopUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EarlyStopCreateBulk is the builder for creating many EarlyStop entities in bulk.
+type EarlyStopCreateBulk struct {
+	config
+	err      error
+	builders []*EarlyStopCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EarlyStop entities in the database.
+func (escb *EarlyStopCreateBulk) Save(ctx context.Context) ([]*EarlyStop, error) {
+	if escb.err != nil {
+		return nil, escb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(escb.builders))
+	nodes := make([]*EarlyStop, len(escb.builders))
+	mutators := make([]Mutator, len(escb.builders))
+	for i := range escb.builders {
+		func(i int, root context.Context) {
+			builder := escb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EarlyStopMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, escb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = escb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, escb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, escb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (escb *EarlyStopCreateBulk) SaveX(ctx context.Context) []*EarlyStop {
+	v, err := escb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (escb *EarlyStopCreateBulk) Exec(ctx context.Context) error {
+	_, err := escb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (escb *EarlyStopCreateBulk) ExecX(ctx context.Context) {
+	if err := escb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EarlyStop.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EarlyStopUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (escb *EarlyStopCreateBulk) OnConflict(opts ...sql.ConflictOption) *EarlyStopUpsertBulk {
+	escb.conflict = opts
+	return &EarlyStopUpsertBulk{
+		create: escb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EarlyStop.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (escb *EarlyStopCreateBulk) OnConflictColumns(columns ...string) *EarlyStopUpsertBulk {
+	escb.conflict = append(escb.conflict, sql.ConflictColumns(columns...))
+	return &EarlyStopUpsertBulk{
+		create: escb,
+	}
+}
+
+// EarlyStopUpsertBulk is the builder for "upsert"-ing
+// a bulk of EarlyStop nodes.
+type EarlyStopUpsertBulk struct {
+	create *EarlyStopCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EarlyStop.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(earlystop.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EarlyStopUpsertBulk) UpdateNewValues() *EarlyStopUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(earlystop.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(earlystop.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EarlyStop.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EarlyStopUpsertBulk) Ignore() *EarlyStopUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EarlyStopUpsertBulk) DoNothing() *EarlyStopUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EarlyStopCreateBulk.OnConflict
+// documentation for more info.
+func (u *EarlyStopUpsertBulk) Update(set func(*EarlyStopUpsert)) *EarlyStopUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EarlyStopUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *EarlyStopUpsertBulk) SetTaskID(v int) *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *EarlyStopUpsertBulk) AddTaskID(v int) *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *EarlyStopUpsertBulk) UpdateTaskID() *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (u *EarlyStopUpsertBulk) SetModelingID(v int) *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetModelingID(v)
+	})
+}
+
+// AddModelingID adds v to the "modeling_id" field.
+func (u *EarlyStopUpsertBulk) AddModelingID(v int) *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.AddModelingID(v)
+	})
+}
+
+// UpdateModelingID sets the "modeling_id" field to the value that was provided on create.
+func (u *EarlyStopUpsertBulk) UpdateModelingID() *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateModelingID()
+	})
+}
+
+// SetTrialUUID sets the "trial_uuid" field.
+func (u *EarlyStopUpsertBulk) SetTrialUUID(v string) *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetTrialUUID(v)
+	})
+}
+
+// UpdateTrialUUID sets the "trial_uuid" field to the value that was provided on create.
+func (u *EarlyStopUpsertBulk) UpdateTrialUUID() *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateTrialUUID()
+	})
+}
+
+// SetPolicy sets the "policy" field.
+func (u *EarlyStopUpsertBulk) SetPolicy(v string) *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetPolicy(v)
+	})
+}
+
+// UpdatePolicy sets the "policy" field to the value that was provided on create.
+func (u *EarlyStopUpsertBulk) UpdatePolicy() *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdatePolicy()
+	})
+}
+
+// SetMetric sets the "metric" field.
+func (u *EarlyStopUpsertBulk) SetMetric(v string) *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetMetric(v)
+	})
+}
+
+// UpdateMetric sets the "metric" field to the value that was provided on create.
+func (u *EarlyStopUpsertBulk) UpdateMetric() *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateMetric()
+	})
+}
+
+// SetEpoch sets the "epoch" field.
+func (u *EarlyStopUpsertBulk) SetEpoch(v int) *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetEpoch(v)
+	})
+}
+
+// AddEpoch adds v to the "epoch" field.
+func (u *EarlyStopUpsertBulk) AddEpoch(v int) *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.AddEpoch(v)
+	})
+}
+
+// UpdateEpoch sets the "epoch" field to the value that was provided on create.
+func (u *EarlyStopUpsertBulk) UpdateEpoch() *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateEpoch()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *EarlyStopUpsertBulk) SetValue(v float64) *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// AddValue adds v to the "value" field.
+func (u *EarlyStopUpsertBulk) AddValue(v float64) *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.AddValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *EarlyStopUpsertBulk) UpdateValue() *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *EarlyStopUpsertBulk) SetReason(v string) *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *EarlyStopUpsertBulk) UpdateReason() *EarlyStopUpsertBulk {
+	return u.Update(func(s *EarlyStopUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// Exec executes the query.
+func (u *EarlyStopUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EarlyStopCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EarlyStopCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EarlyStopUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
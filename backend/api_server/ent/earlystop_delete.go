@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/earlystop"
+	"api_server/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EarlyStopDelete is the builder for deleting a EarlyStop entity.
+type EarlyStopDelete struct {
+	config
+	hooks    []Hook
+	mutation *EarlyStopMutation
+}
+
+// Where appends a list predicates to the EarlyStopDelete builder.
+func (esd *EarlyStopDelete) Where(ps ...predicate.EarlyStop) *EarlyStopDelete {
+	esd.mutation.Where(ps...)
+	return esd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (esd *EarlyStopDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, esd.sqlExec, esd.mutation, esd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esd *EarlyStopDelete) ExecX(ctx context.Context) int {
+	n, err := esd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (esd *EarlyStopDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(earlystop.Table, sqlgraph.NewFieldSpec(earlystop.FieldID, field.TypeInt))
+	if ps := esd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, esd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	esd.mutation.done = true
+	return affected, err
+}
+
+// EarlyStopDeleteOne is the builder for deleting a single EarlyStop entity.
+type EarlyStopDeleteOne struct {
+	esd *EarlyStopDelete
+}
+
+// Where appends a list predicates to the EarlyStopDelete builder.
+func (esdo *EarlyStopDeleteOne) Where(ps ...predicate.EarlyStop) *EarlyStopDeleteOne {
+	esdo.esd.mutation.Where(ps...)
+	return esdo
+}
+
+// Exec executes the deletion query.
+func (esdo *EarlyStopDeleteOne) Exec(ctx context.Context) error {
+	n, err := esdo.esd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{earlystop.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esdo *EarlyStopDeleteOne) ExecX(ctx context.Context) {
+	if err := esdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,543 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/earlystop"
+	"api_server/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EarlyStopUpdate is the builder for updating EarlyStop entities.
+type EarlyStopUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EarlyStopMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EarlyStopUpdate builder.
+func (esu *EarlyStopUpdate) Where(ps ...predicate.EarlyStop) *EarlyStopUpdate {
+	esu.mutation.Where(ps...)
+	return esu
+}
+
+// SetTaskID sets the "task_id" field.
+func (esu *EarlyStopUpdate) SetTaskID(i int) *EarlyStopUpdate {
+	esu.mutation.ResetTaskID()
+	esu.mutation.SetTaskID(i)
+	return esu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (esu *EarlyStopUpdate) SetNillableTaskID(i *int) *EarlyStopUpdate {
+	if i != nil {
+		esu.SetTaskID(*i)
+	}
+	return esu
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (esu *EarlyStopUpdate) AddTaskID(i int) *EarlyStopUpdate {
+	esu.mutation.AddTaskID(i)
+	return esu
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (esu *EarlyStopUpdate) SetModelingID(i int) *EarlyStopUpdate {
+	esu.mutation.ResetModelingID()
+	esu.mutation.SetModelingID(i)
+	return esu
+}
+
+// SetNillableModelingID sets the "modeling_id" field if the given value is not nil.
+func (esu *EarlyStopUpdate) SetNillableModelingID(i *int) *EarlyStopUpdate {
+	if i != nil {
+		esu.SetModelingID(*i)
+	}
+	return esu
+}
+
+// AddModelingID adds i to the "modeling_id" field.
+func (esu *EarlyStopUpdate) AddModelingID(i int) *EarlyStopUpdate {
+	esu.mutation.AddModelingID(i)
+	return esu
+}
+
+// SetTrialUUID sets the "trial_uuid" field.
+func (esu *EarlyStopUpdate) SetTrialUUID(s string) *EarlyStopUpdate {
+	esu.mutation.SetTrialUUID(s)
+	return esu
+}
+
+// SetNillableTrialUUID sets the "trial_uuid" field if the given value is not nil.
+func (esu *EarlyStopUpdate) SetNillableTrialUUID(s *string) *EarlyStopUpdate {
+	if s != nil {
+		esu.SetTrialUUID(*s)
+	}
+	return esu
+}
+
+// SetPolicy sets the "policy" field.
+func (esu *EarlyStopUpdate) SetPolicy(s string) *EarlyStopUpdate {
+	esu.mutation.SetPolicy(s)
+	return esu
+}
+
+// SetNillablePolicy sets the "policy" field if the given value is not nil.
+func (esu *EarlyStopUpdate) SetNillablePolicy(s *string) *EarlyStopUpdate {
+	if s != nil {
+		esu.SetPolicy(*s)
+	}
+	return esu
+}
+
+// SetMetric sets the "metric" field.
+func (esu *EarlyStopUpdate) SetMetric(s string) *EarlyStopUpdate {
+	esu.mutation.SetMetric(s)
+	return esu
+}
+
+// SetNillableMetric sets the "metric" field if the given value is not nil.
+func (esu *EarlyStopUpdate) SetNillableMetric(s *string) *EarlyStopUpdate {
+	if s != nil {
+		esu.SetMetric(*s)
+	}
+	return esu
+}
+
+// SetEpoch sets the "epoch" field.
+func (esu *EarlyStopUpdate) SetEpoch(i int) *EarlyStopUpdate {
+	esu.mutation.ResetEpoch()
+	esu.mutation.SetEpoch(i)
+	return esu
+}
+
+// SetNillableEpoch sets the "epoch" field if the given value is not nil.
+func (esu *EarlyStopUpdate) SetNillableEpoch(i *int) *EarlyStopUpdate {
+	if i != nil {
+		esu.SetEpoch(*i)
+	}
+	return esu
+}
+
+// AddEpoch adds i to the "epoch" field.
+func (esu *EarlyStopUpdate) AddEpoch(i int) *EarlyStopUpdate {
+	esu.mutation.AddEpoch(i)
+	return esu
+}
+
+// SetValue sets the "value" field.
+func (esu *EarlyStopUpdate) SetValue(f float64) *EarlyStopUpdate {
+	esu.mutation.ResetValue()
+	esu.mutation.SetValue(f)
+	return esu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (esu *EarlyStopUpdate) SetNillableValue(f *float64) *EarlyStopUpdate {
+	if f != nil {
+		esu.SetValue(*f)
+	}
+	return esu
+}
+
+// AddValue adds f to the "value" field.
+func (esu *EarlyStopUpdate) AddValue(f float64) *EarlyStopUpdate {
+	esu.mutation.AddValue(f)
+	return esu
+}
+
+// SetReason sets the "reason" field.
+func (esu *EarlyStopUpdate) SetReason(s string) *EarlyStopUpdate {
+	esu.mutation.SetReason(s)
+	return esu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (esu *EarlyStopUpdate) SetNillableReason(s *string) *EarlyStopUpdate {
+	if s != nil {
+		esu.SetReason(*s)
+	}
+	return esu
+}
+
+// Mutation returns the EarlyStopMutation object of the builder.
+func (esu *EarlyStopUpdate) Mutation() *EarlyStopMutation {
+	return esu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (esu *EarlyStopUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, esu.sqlSave, esu.mutation, esu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (esu *EarlyStopUpdate) SaveX(ctx context.Context) int {
+	affected, err := esu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (esu *EarlyStopUpdate) Exec(ctx context.Context) error {
+	_, err := esu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esu *EarlyStopUpdate) ExecX(ctx context.Context) {
+	if err := esu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (esu *EarlyStopUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EarlyStopUpdate {
+	esu.modifiers = append(esu.modifiers, modifiers...)
+	return esu
+}
+
+func (esu *EarlyStopUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(earlystop.Table, earlystop.Columns, sqlgraph.NewFieldSpec(earlystop.FieldID, field.TypeInt))
+	if ps := esu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := esu.mutation.TaskID(); ok {
+		_spec.SetField(earlystop.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := esu.mutation.AddedTaskID(); ok {
+		_spec.AddField(earlystop.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := esu.mutation.ModelingID(); ok {
+		_spec.SetField(earlystop.FieldModelingID, field.TypeInt, value)
+	}
+	if value, ok := esu.mutation.AddedModelingID(); ok {
+		_spec.AddField(earlystop.FieldModelingID, field.TypeInt, value)
+	}
+	if value, ok := esu.mutation.TrialUUID(); ok {
+		_spec.SetField(earlystop.FieldTrialUUID, field.TypeString, value)
+	}
+	if value, ok := esu.mutation.Policy(); ok {
+		_spec.SetField(earlystop.FieldPolicy, field.TypeString, value)
+	}
+	if value, ok := esu.mutation.Metric(); ok {
+		_spec.SetField(earlystop.FieldMetric, field.TypeString, value)
+	}
+	if value, ok := esu.mutation.Epoch(); ok {
+		_spec.SetField(earlystop.FieldEpoch, field.TypeInt, value)
+	}
+	if value, ok := esu.mutation.AddedEpoch(); ok {
+		_spec.AddField(earlystop.FieldEpoch, field.TypeInt, value)
+	}
+	if value, ok := esu.mutation.Value(); ok {
+		_spec.SetField(earlystop.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := esu.mutation.AddedValue(); ok {
+		_spec.AddField(earlystop.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := esu.mutation.Reason(); ok {
+		_spec.SetField(earlystop.FieldReason, field.TypeString, value)
+	}
+	_spec.AddModifiers(esu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, esu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{earlystop.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	esu.mutation.done = true
+	return n, nil
+}
+
+// EarlyStopUpdateOne is the builder for updating a single EarlyStop entity.
+type EarlyStopUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EarlyStopMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTaskID sets the "task_id" field.
+func (esuo *EarlyStopUpdateOne) SetTaskID(i int) *EarlyStopUpdateOne {
+	esuo.mutation.ResetTaskID()
+	esuo.mutation.SetTaskID(i)
+	return esuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (esuo *EarlyStopUpdateOne) SetNillableTaskID(i *int) *EarlyStopUpdateOne {
+	if i != nil {
+		esuo.SetTaskID(*i)
+	}
+	return esuo
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (esuo *EarlyStopUpdateOne) AddTaskID(i int) *EarlyStopUpdateOne {
+	esuo.mutation.AddTaskID(i)
+	return esuo
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (esuo *EarlyStopUpdateOne) SetModelingID(i int) *EarlyStopUpdateOne {
+	esuo.mutation.ResetModelingID()
+	esuo.mutation.SetModelingID(i)
+	return esuo
+}
+
+// SetNillableModelingID sets the "modeling_id" field if the given value is not nil.
+func (esuo *EarlyStopUpdateOne) SetNillableModelingID(i *int) *EarlyStopUpdateOne {
+	if i != nil {
+		esuo.SetModelingID(*i)
+	}
+	return esuo
+}
+
+// AddModelingID adds i to the "modeling_id" field.
+func (esuo *EarlyStopUpdateOne) AddModelingID(i int) *EarlyStopUpdateOne {
+	esuo.mutation.AddModelingID(i)
+	return esuo
+}
+
+// SetTrialUUID sets the "trial_uuid" field.
+func (esuo *EarlyStopUpdateOne) SetTrialUUID(s string) *EarlyStopUpdateOne {
+	esuo.mutation.SetTrialUUID(s)
+	return esuo
+}
+
+// SetNillableTrialUUID sets the "trial_uuid" field if the given value is not nil.
+func (esuo *EarlyStopUpdateOne) SetNillableTrialUUID(s *string) *EarlyStopUpdateOne {
+	if s != nil {
+		esuo.SetTrialUUID(*s)
+	}
+	return esuo
+}
+
+// SetPolicy sets the "policy" field.
+func (esuo *EarlyStopUpdateOne) SetPolicy(s string) *EarlyStopUpdateOne {
+	esuo.mutation.SetPolicy(s)
+	return esuo
+}
+
+// SetNillablePolicy sets the "policy" field if the given value is not nil.
+func (esuo *EarlyStopUpdateOne) SetNillablePolicy(s *string) *EarlyStopUpdateOne {
+	if s != nil {
+		esuo.SetPolicy(*s)
+	}
+	return esuo
+}
+
+// SetMetric sets the "metric" field.
+func (esuo *EarlyStopUpdateOne) SetMetric(s string) *EarlyStopUpdateOne {
+	esuo.mutation.SetMetric(s)
+	return esuo
+}
+
+// SetNillableMetric sets the "metric" field if the given value is not nil.
+func (esuo *EarlyStopUpdateOne) SetNillableMetric(s *string) *EarlyStopUpdateOne {
+	if s != nil {
+		esuo.SetMetric(*s)
+	}
+	return esuo
+}
+
+// SetEpoch sets the "epoch" field.
+func (esuo *EarlyStopUpdateOne) SetEpoch(i int) *EarlyStopUpdateOne {
+	esuo.mutation.ResetEpoch()
+	esuo.mutation.SetEpoch(i)
+	return esuo
+}
+
+// SetNillableEpoch sets the "epoch" field if the given value is not nil.
+func (esuo *EarlyStopUpdateOne) SetNillableEpoch(i *int) *EarlyStopUpdateOne {
+	if i != nil {
+		esuo.SetEpoch(*i)
+	}
+	return esuo
+}
+
+// AddEpoch adds i to the "epoch" field.
+func (esuo *EarlyStopUpdateOne) AddEpoch(i int) *EarlyStopUpdateOne {
+	esuo.mutation.AddEpoch(i)
+	return esuo
+}
+
+// SetValue sets the "value" field.
+func (esuo *EarlyStopUpdateOne) SetValue(f float64) *EarlyStopUpdateOne {
+	esuo.mutation.ResetValue()
+	esuo.mutation.SetValue(f)
+	return esuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (esuo *EarlyStopUpdateOne) SetNillableValue(f *float64) *EarlyStopUpdateOne {
+	if f != nil {
+		esuo.SetValue(*f)
+	}
+	return esuo
+}
+
+// AddValue adds f to the "value" field.
+func (esuo *EarlyStopUpdateOne) AddValue(f float64) *EarlyStopUpdateOne {
+	esuo.mutation.AddValue(f)
+	return esuo
+}
+
+// SetReason sets the "reason" field.
+func (esuo *EarlyStopUpdateOne) SetReason(s string) *EarlyStopUpdateOne {
+	esuo.mutation.SetReason(s)
+	return esuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (esuo *EarlyStopUpdateOne) SetNillableReason(s *string) *EarlyStopUpdateOne {
+	if s != nil {
+		esuo.SetReason(*s)
+	}
+	return esuo
+}
+
+// Mutation returns the EarlyStopMutation object of the builder.
+func (esuo *EarlyStopUpdateOne) Mutation() *EarlyStopMutation {
+	return esuo.mutation
+}
+
+// Where appends a list predicates to the EarlyStopUpdate builder.
+func (esuo *EarlyStopUpdateOne) Where(ps ...predicate.EarlyStop) *EarlyStopUpdateOne {
+	esuo.mutation.Where(ps...)
+	return esuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (esuo *EarlyStopUpdateOne) Select(field string, fields ...string) *EarlyStopUpdateOne {
+	esuo.fields = append([]string{field}, fields...)
+	return esuo
+}
+
+// Save executes the query and returns the updated EarlyStop entity.
+func (esuo *EarlyStopUpdateOne) Save(ctx context.Context) (*EarlyStop, error) {
+	return withHooks(ctx, esuo.sqlSave, esuo.mutation, esuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (esuo *EarlyStopUpdateOne) SaveX(ctx context.Context) *EarlyStop {
+	node, err := esuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (esuo *EarlyStopUpdateOne) Exec(ctx context.Context) error {
+	_, err := esuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esuo *EarlyStopUpdateOne) ExecX(ctx context.Context) {
+	if err := esuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (esuo *EarlyStopUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EarlyStopUpdateOne {
+	esuo.modifiers = append(esuo.modifiers, modifiers...)
+	return esuo
+}
+
+func (esuo *EarlyStopUpdateOne) sqlSave(ctx context.Context) (_node *EarlyStop, err error) {
+	_spec := sqlgraph.NewUpdateSpec(earlystop.Table, earlystop.Columns, sqlgraph.NewFieldSpec(earlystop.FieldID, field.TypeInt))
+	id, ok := esuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EarlyStop.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := esuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, earlystop.FieldID)
+		for _, f := range fields {
+			if !earlystop.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != earlystop.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := esuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := esuo.mutation.TaskID(); ok {
+		_spec.SetField(earlystop.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := esuo.mutation.AddedTaskID(); ok {
+		_spec.AddField(earlystop.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := esuo.mutation.ModelingID(); ok {
+		_spec.SetField(earlystop.FieldModelingID, field.TypeInt, value)
+	}
+	if value, ok := esuo.mutation.AddedModelingID(); ok {
+		_spec.AddField(earlystop.FieldModelingID, field.TypeInt, value)
+	}
+	if value, ok := esuo.mutation.TrialUUID(); ok {
+		_spec.SetField(earlystop.FieldTrialUUID, field.TypeString, value)
+	}
+	if value, ok := esuo.mutation.Policy(); ok {
+		_spec.SetField(earlystop.FieldPolicy, field.TypeString, value)
+	}
+	if value, ok := esuo.mutation.Metric(); ok {
+		_spec.SetField(earlystop.FieldMetric, field.TypeString, value)
+	}
+	if value, ok := esuo.mutation.Epoch(); ok {
+		_spec.SetField(earlystop.FieldEpoch, field.TypeInt, value)
+	}
+	if value, ok := esuo.mutation.AddedEpoch(); ok {
+		_spec.AddField(earlystop.FieldEpoch, field.TypeInt, value)
+	}
+	if value, ok := esuo.mutation.Value(); ok {
+		_spec.SetField(earlystop.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := esuo.mutation.AddedValue(); ok {
+		_spec.AddField(earlystop.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := esuo.mutation.Reason(); ok {
+		_spec.SetField(earlystop.FieldReason, field.TypeString, value)
+	}
+	_spec.AddModifiers(esuo.modifiers...)
+	_node = &EarlyStop{config: esuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, esuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{earlystop.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	esuo.mutation.done = true
+	return _node, nil
+}
@@ -7,14 +7,23 @@ import (
 	"api_server/ent/dataset"
 	"api_server/ent/datasetroot"
 	"api_server/ent/device"
+	"api_server/ent/devicetoken"
+	"api_server/ent/earlystop"
 	"api_server/ent/enginelog"
 	"api_server/ent/gpu"
+	"api_server/ent/gputelemetry"
 	"api_server/ent/hyperparamshistory"
+	"api_server/ent/maintenancewindow"
 	"api_server/ent/menu"
 	"api_server/ent/modeling"
 	"api_server/ent/modelingdetails"
 	"api_server/ent/modelingmodels"
+	"api_server/ent/preemption"
 	"api_server/ent/project"
+	"api_server/ent/quota"
+	"api_server/ent/reservation"
+	"api_server/ent/schedule"
+	"api_server/ent/schedulehistory"
 	"api_server/ent/task"
 	"api_server/ent/trial"
 	"api_server/ent/trialdetails"
@@ -95,14 +104,23 @@ func checkColumn(table, column string) error {
 			dataset.Table:            dataset.ValidColumn,
 			datasetroot.Table:        datasetroot.ValidColumn,
 			device.Table:             device.ValidColumn,
+			devicetoken.Table:        devicetoken.ValidColumn,
+			earlystop.Table:          earlystop.ValidColumn,
 			enginelog.Table:          enginelog.ValidColumn,
 			gpu.Table:                gpu.ValidColumn,
+			gputelemetry.Table:       gputelemetry.ValidColumn,
 			hyperparamshistory.Table: hyperparamshistory.ValidColumn,
+			maintenancewindow.Table:  maintenancewindow.ValidColumn,
 			menu.Table:               menu.ValidColumn,
 			modeling.Table:           modeling.ValidColumn,
 			modelingdetails.Table:    modelingdetails.ValidColumn,
 			modelingmodels.Table:     modelingmodels.ValidColumn,
+			preemption.Table:         preemption.ValidColumn,
 			project.Table:            project.ValidColumn,
+			quota.Table:              quota.ValidColumn,
+			reservation.Table:        reservation.ValidColumn,
+			schedule.Table:           schedule.ValidColumn,
+			schedulehistory.Table:    schedulehistory.ValidColumn,
 			task.Table:               task.ValidColumn,
 			trial.Table:              trial.ValidColumn,
 			trialdetails.Table:       trialdetails.ValidColumn,
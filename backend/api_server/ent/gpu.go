@@ -29,6 +29,14 @@ type Gpu struct {
 	IsUse bool `json:"is_use,omitempty"`
 	// DeviceID holds the value of the "device_id" field.
 	DeviceID int `json:"device_id,omitempty"`
+	// MemoryTotal holds the value of the "memory_total" field.
+	MemoryTotal float64 `json:"memory_total,omitempty"`
+	// MemoryUsed holds the value of the "memory_used" field.
+	MemoryUsed float64 `json:"memory_used,omitempty"`
+	// UtilizationGpu holds the value of the "utilization_gpu" field.
+	UtilizationGpu float64 `json:"utilization_gpu,omitempty"`
+	// UtilizationMemory holds the value of the "utilization_memory" field.
+	UtilizationMemory float64 `json:"utilization_memory,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GpuQuery when eager-loading is set.
 	Edges        GpuEdges `json:"edges"`
@@ -62,6 +70,8 @@ func (*Gpu) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case gpu.FieldIsUse:
 			values[i] = new(sql.NullBool)
+		case gpu.FieldMemoryTotal, gpu.FieldMemoryUsed, gpu.FieldUtilizationGpu, gpu.FieldUtilizationMemory:
+			values[i] = new(sql.NullFloat64)
 		case gpu.FieldID, gpu.FieldIndex, gpu.FieldDeviceID:
 			values[i] = new(sql.NullInt64)
 		case gpu.FieldUUID, gpu.FieldName, gpu.FieldState:
@@ -123,6 +133,30 @@ func (gp *Gpu) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				gp.DeviceID = int(value.Int64)
 			}
+		case gpu.FieldMemoryTotal:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field memory_total", values[i])
+			} else if value.Valid {
+				gp.MemoryTotal = value.Float64
+			}
+		case gpu.FieldMemoryUsed:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field memory_used", values[i])
+			} else if value.Valid {
+				gp.MemoryUsed = value.Float64
+			}
+		case gpu.FieldUtilizationGpu:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field utilization_gpu", values[i])
+			} else if value.Valid {
+				gp.UtilizationGpu = value.Float64
+			}
+		case gpu.FieldUtilizationMemory:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field utilization_memory", values[i])
+			} else if value.Valid {
+				gp.UtilizationMemory = value.Float64
+			}
 		default:
 			gp.selectValues.Set(columns[i], values[i])
 		}
@@ -181,6 +215,18 @@ func (gp *Gpu) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("device_id=")
 	builder.WriteString(fmt.Sprintf("%v", gp.DeviceID))
+	builder.WriteString(", ")
+	builder.WriteString("memory_total=")
+	builder.WriteString(fmt.Sprintf("%v", gp.MemoryTotal))
+	builder.WriteString(", ")
+	builder.WriteString("memory_used=")
+	builder.WriteString(fmt.Sprintf("%v", gp.MemoryUsed))
+	builder.WriteString(", ")
+	builder.WriteString("utilization_gpu=")
+	builder.WriteString(fmt.Sprintf("%v", gp.UtilizationGpu))
+	builder.WriteString(", ")
+	builder.WriteString("utilization_memory=")
+	builder.WriteString(fmt.Sprintf("%v", gp.UtilizationMemory))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -24,6 +24,14 @@ const (
 	FieldIsUse = "is_use"
 	// FieldDeviceID holds the string denoting the device_id field in the database.
 	FieldDeviceID = "device_id"
+	// FieldMemoryTotal holds the string denoting the memory_total field in the database.
+	FieldMemoryTotal = "memory_total"
+	// FieldMemoryUsed holds the string denoting the memory_used field in the database.
+	FieldMemoryUsed = "memory_used"
+	// FieldUtilizationGpu holds the string denoting the utilization_gpu field in the database.
+	FieldUtilizationGpu = "utilization_gpu"
+	// FieldUtilizationMemory holds the string denoting the utilization_memory field in the database.
+	FieldUtilizationMemory = "utilization_memory"
 	// EdgeDevice holds the string denoting the device edge name in mutations.
 	EdgeDevice = "device"
 	// Table holds the table name of the gpu in the database.
@@ -46,6 +54,10 @@ var Columns = []string{
 	FieldState,
 	FieldIsUse,
 	FieldDeviceID,
+	FieldMemoryTotal,
+	FieldMemoryUsed,
+	FieldUtilizationGpu,
+	FieldUtilizationMemory,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -67,6 +79,14 @@ var (
 	DefaultIsUse bool
 	// DefaultDeviceID holds the default value on creation for the "device_id" field.
 	DefaultDeviceID int
+	// DefaultMemoryTotal holds the default value on creation for the "memory_total" field.
+	DefaultMemoryTotal float64
+	// DefaultMemoryUsed holds the default value on creation for the "memory_used" field.
+	DefaultMemoryUsed float64
+	// DefaultUtilizationGpu holds the default value on creation for the "utilization_gpu" field.
+	DefaultUtilizationGpu float64
+	// DefaultUtilizationMemory holds the default value on creation for the "utilization_memory" field.
+	DefaultUtilizationMemory float64
 )
 
 // OrderOption defines the ordering options for the Gpu queries.
@@ -107,6 +127,26 @@ func ByDeviceID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeviceID, opts...).ToFunc()
 }
 
+// ByMemoryTotal orders the results by the memory_total field.
+func ByMemoryTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemoryTotal, opts...).ToFunc()
+}
+
+// ByMemoryUsed orders the results by the memory_used field.
+func ByMemoryUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemoryUsed, opts...).ToFunc()
+}
+
+// ByUtilizationGpu orders the results by the utilization_gpu field.
+func ByUtilizationGpu(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUtilizationGpu, opts...).ToFunc()
+}
+
+// ByUtilizationMemory orders the results by the utilization_memory field.
+func ByUtilizationMemory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUtilizationMemory, opts...).ToFunc()
+}
+
 // ByDeviceField orders the results by device field.
 func ByDeviceField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -84,6 +84,26 @@ func DeviceID(v int) predicate.Gpu {
 	return predicate.Gpu(sql.FieldEQ(FieldDeviceID, v))
 }
 
+// MemoryTotal applies equality check predicate on the "memory_total" field. It's identical to MemoryTotalEQ.
+func MemoryTotal(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldEQ(FieldMemoryTotal, v))
+}
+
+// MemoryUsed applies equality check predicate on the "memory_used" field. It's identical to MemoryUsedEQ.
+func MemoryUsed(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldEQ(FieldMemoryUsed, v))
+}
+
+// UtilizationGpu applies equality check predicate on the "utilization_gpu" field. It's identical to UtilizationGpuEQ.
+func UtilizationGpu(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldEQ(FieldUtilizationGpu, v))
+}
+
+// UtilizationMemory applies equality check predicate on the "utilization_memory" field. It's identical to UtilizationMemoryEQ.
+func UtilizationMemory(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldEQ(FieldUtilizationMemory, v))
+}
+
 // UUIDEQ applies the EQ predicate on the "uuid" field.
 func UUIDEQ(v string) predicate.Gpu {
 	return predicate.Gpu(sql.FieldEQ(FieldUUID, v))
@@ -359,6 +379,166 @@ func DeviceIDNotNil() predicate.Gpu {
 	return predicate.Gpu(sql.FieldNotNull(FieldDeviceID))
 }
 
+// MemoryTotalEQ applies the EQ predicate on the "memory_total" field.
+func MemoryTotalEQ(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldEQ(FieldMemoryTotal, v))
+}
+
+// MemoryTotalNEQ applies the NEQ predicate on the "memory_total" field.
+func MemoryTotalNEQ(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldNEQ(FieldMemoryTotal, v))
+}
+
+// MemoryTotalIn applies the In predicate on the "memory_total" field.
+func MemoryTotalIn(vs ...float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldIn(FieldMemoryTotal, vs...))
+}
+
+// MemoryTotalNotIn applies the NotIn predicate on the "memory_total" field.
+func MemoryTotalNotIn(vs ...float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldNotIn(FieldMemoryTotal, vs...))
+}
+
+// MemoryTotalGT applies the GT predicate on the "memory_total" field.
+func MemoryTotalGT(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldGT(FieldMemoryTotal, v))
+}
+
+// MemoryTotalGTE applies the GTE predicate on the "memory_total" field.
+func MemoryTotalGTE(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldGTE(FieldMemoryTotal, v))
+}
+
+// MemoryTotalLT applies the LT predicate on the "memory_total" field.
+func MemoryTotalLT(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldLT(FieldMemoryTotal, v))
+}
+
+// MemoryTotalLTE applies the LTE predicate on the "memory_total" field.
+func MemoryTotalLTE(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldLTE(FieldMemoryTotal, v))
+}
+
+// MemoryUsedEQ applies the EQ predicate on the "memory_used" field.
+func MemoryUsedEQ(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldEQ(FieldMemoryUsed, v))
+}
+
+// MemoryUsedNEQ applies the NEQ predicate on the "memory_used" field.
+func MemoryUsedNEQ(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldNEQ(FieldMemoryUsed, v))
+}
+
+// MemoryUsedIn applies the In predicate on the "memory_used" field.
+func MemoryUsedIn(vs ...float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldIn(FieldMemoryUsed, vs...))
+}
+
+// MemoryUsedNotIn applies the NotIn predicate on the "memory_used" field.
+func MemoryUsedNotIn(vs ...float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldNotIn(FieldMemoryUsed, vs...))
+}
+
+// MemoryUsedGT applies the GT predicate on the "memory_used" field.
+func MemoryUsedGT(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldGT(FieldMemoryUsed, v))
+}
+
+// MemoryUsedGTE applies the GTE predicate on the "memory_used" field.
+func MemoryUsedGTE(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldGTE(FieldMemoryUsed, v))
+}
+
+// MemoryUsedLT applies the LT predicate on the "memory_used" field.
+func MemoryUsedLT(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldLT(FieldMemoryUsed, v))
+}
+
+// MemoryUsedLTE applies the LTE predicate on the "memory_used" field.
+func MemoryUsedLTE(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldLTE(FieldMemoryUsed, v))
+}
+
+// UtilizationGpuEQ applies the EQ predicate on the "utilization_gpu" field.
+func UtilizationGpuEQ(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldEQ(FieldUtilizationGpu, v))
+}
+
+// UtilizationGpuNEQ applies the NEQ predicate on the "utilization_gpu" field.
+func UtilizationGpuNEQ(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldNEQ(FieldUtilizationGpu, v))
+}
+
+// UtilizationGpuIn applies the In predicate on the "utilization_gpu" field.
+func UtilizationGpuIn(vs ...float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldIn(FieldUtilizationGpu, vs...))
+}
+
+// UtilizationGpuNotIn applies the NotIn predicate on the "utilization_gpu" field.
+func UtilizationGpuNotIn(vs ...float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldNotIn(FieldUtilizationGpu, vs...))
+}
+
+// UtilizationGpuGT applies the GT predicate on the "utilization_gpu" field.
+func UtilizationGpuGT(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldGT(FieldUtilizationGpu, v))
+}
+
+// UtilizationGpuGTE applies the GTE predicate on the "utilization_gpu" field.
+func UtilizationGpuGTE(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldGTE(FieldUtilizationGpu, v))
+}
+
+// UtilizationGpuLT applies the LT predicate on the "utilization_gpu" field.
+func UtilizationGpuLT(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldLT(FieldUtilizationGpu, v))
+}
+
+// UtilizationGpuLTE applies the LTE predicate on the "utilization_gpu" field.
+func UtilizationGpuLTE(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldLTE(FieldUtilizationGpu, v))
+}
+
+// UtilizationMemoryEQ applies the EQ predicate on the "utilization_memory" field.
+func UtilizationMemoryEQ(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldEQ(FieldUtilizationMemory, v))
+}
+
+// UtilizationMemoryNEQ applies the NEQ predicate on the "utilization_memory" field.
+func UtilizationMemoryNEQ(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldNEQ(FieldUtilizationMemory, v))
+}
+
+// UtilizationMemoryIn applies the In predicate on the "utilization_memory" field.
+func UtilizationMemoryIn(vs ...float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldIn(FieldUtilizationMemory, vs...))
+}
+
+// UtilizationMemoryNotIn applies the NotIn predicate on the "utilization_memory" field.
+func UtilizationMemoryNotIn(vs ...float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldNotIn(FieldUtilizationMemory, vs...))
+}
+
+// UtilizationMemoryGT applies the GT predicate on the "utilization_memory" field.
+func UtilizationMemoryGT(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldGT(FieldUtilizationMemory, v))
+}
+
+// UtilizationMemoryGTE applies the GTE predicate on the "utilization_memory" field.
+func UtilizationMemoryGTE(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldGTE(FieldUtilizationMemory, v))
+}
+
+// UtilizationMemoryLT applies the LT predicate on the "utilization_memory" field.
+func UtilizationMemoryLT(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldLT(FieldUtilizationMemory, v))
+}
+
+// UtilizationMemoryLTE applies the LTE predicate on the "utilization_memory" field.
+func UtilizationMemoryLTE(v float64) predicate.Gpu {
+	return predicate.Gpu(sql.FieldLTE(FieldUtilizationMemory, v))
+}
+
 // HasDevice applies the HasEdge predicate on the "device" edge.
 func HasDevice() predicate.Gpu {
 	return predicate.Gpu(func(s *sql.Selector) {
@@ -90,6 +90,62 @@ func (gc *GpuCreate) SetNillableDeviceID(i *int) *GpuCreate {
 	return gc
 }
 
+// SetMemoryTotal sets the "memory_total" field.
+func (gc *GpuCreate) SetMemoryTotal(f float64) *GpuCreate {
+	gc.mutation.SetMemoryTotal(f)
+	return gc
+}
+
+// SetNillableMemoryTotal sets the "memory_total" field if the given value is not nil.
+func (gc *GpuCreate) SetNillableMemoryTotal(f *float64) *GpuCreate {
+	if f != nil {
+		gc.SetMemoryTotal(*f)
+	}
+	return gc
+}
+
+// SetMemoryUsed sets the "memory_used" field.
+func (gc *GpuCreate) SetMemoryUsed(f float64) *GpuCreate {
+	gc.mutation.SetMemoryUsed(f)
+	return gc
+}
+
+// SetNillableMemoryUsed sets the "memory_used" field if the given value is not nil.
+func (gc *GpuCreate) SetNillableMemoryUsed(f *float64) *GpuCreate {
+	if f != nil {
+		gc.SetMemoryUsed(*f)
+	}
+	return gc
+}
+
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (gc *GpuCreate) SetUtilizationGpu(f float64) *GpuCreate {
+	gc.mutation.SetUtilizationGpu(f)
+	return gc
+}
+
+// SetNillableUtilizationGpu sets the "utilization_gpu" field if the given value is not nil.
+func (gc *GpuCreate) SetNillableUtilizationGpu(f *float64) *GpuCreate {
+	if f != nil {
+		gc.SetUtilizationGpu(*f)
+	}
+	return gc
+}
+
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (gc *GpuCreate) SetUtilizationMemory(f float64) *GpuCreate {
+	gc.mutation.SetUtilizationMemory(f)
+	return gc
+}
+
+// SetNillableUtilizationMemory sets the "utilization_memory" field if the given value is not nil.
+func (gc *GpuCreate) SetNillableUtilizationMemory(f *float64) *GpuCreate {
+	if f != nil {
+		gc.SetUtilizationMemory(*f)
+	}
+	return gc
+}
+
 // SetDevice sets the "device" edge to the Device entity.
 func (gc *GpuCreate) SetDevice(d *Device) *GpuCreate {
 	return gc.SetDeviceID(d.ID)
@@ -146,6 +202,22 @@ func (gc *GpuCreate) defaults() {
 		v := gpu.DefaultDeviceID
 		gc.mutation.SetDeviceID(v)
 	}
+	if _, ok := gc.mutation.MemoryTotal(); !ok {
+		v := gpu.DefaultMemoryTotal
+		gc.mutation.SetMemoryTotal(v)
+	}
+	if _, ok := gc.mutation.MemoryUsed(); !ok {
+		v := gpu.DefaultMemoryUsed
+		gc.mutation.SetMemoryUsed(v)
+	}
+	if _, ok := gc.mutation.UtilizationGpu(); !ok {
+		v := gpu.DefaultUtilizationGpu
+		gc.mutation.SetUtilizationGpu(v)
+	}
+	if _, ok := gc.mutation.UtilizationMemory(); !ok {
+		v := gpu.DefaultUtilizationMemory
+		gc.mutation.SetUtilizationMemory(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -165,6 +237,18 @@ func (gc *GpuCreate) check() error {
 	if _, ok := gc.mutation.IsUse(); !ok {
 		return &ValidationError{Name: "is_use", err: errors.New(`ent: missing required field "Gpu.is_use"`)}
 	}
+	if _, ok := gc.mutation.MemoryTotal(); !ok {
+		return &ValidationError{Name: "memory_total", err: errors.New(`ent: missing required field "Gpu.memory_total"`)}
+	}
+	if _, ok := gc.mutation.MemoryUsed(); !ok {
+		return &ValidationError{Name: "memory_used", err: errors.New(`ent: missing required field "Gpu.memory_used"`)}
+	}
+	if _, ok := gc.mutation.UtilizationGpu(); !ok {
+		return &ValidationError{Name: "utilization_gpu", err: errors.New(`ent: missing required field "Gpu.utilization_gpu"`)}
+	}
+	if _, ok := gc.mutation.UtilizationMemory(); !ok {
+		return &ValidationError{Name: "utilization_memory", err: errors.New(`ent: missing required field "Gpu.utilization_memory"`)}
+	}
 	return nil
 }
 
@@ -212,6 +296,22 @@ func (gc *GpuCreate) createSpec() (*Gpu, *sqlgraph.CreateSpec) {
 		_spec.SetField(gpu.FieldIsUse, field.TypeBool, value)
 		_node.IsUse = value
 	}
+	if value, ok := gc.mutation.MemoryTotal(); ok {
+		_spec.SetField(gpu.FieldMemoryTotal, field.TypeFloat64, value)
+		_node.MemoryTotal = value
+	}
+	if value, ok := gc.mutation.MemoryUsed(); ok {
+		_spec.SetField(gpu.FieldMemoryUsed, field.TypeFloat64, value)
+		_node.MemoryUsed = value
+	}
+	if value, ok := gc.mutation.UtilizationGpu(); ok {
+		_spec.SetField(gpu.FieldUtilizationGpu, field.TypeFloat64, value)
+		_node.UtilizationGpu = value
+	}
+	if value, ok := gc.mutation.UtilizationMemory(); ok {
+		_spec.SetField(gpu.FieldUtilizationMemory, field.TypeFloat64, value)
+		_node.UtilizationMemory = value
+	}
 	if nodes := gc.mutation.DeviceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -365,6 +465,78 @@ func (u *GpuUpsert) ClearDeviceID() *GpuUpsert {
 	return u
 }
 
+// SetMemoryTotal sets the "memory_total" field.
+func (u *GpuUpsert) SetMemoryTotal(v float64) *GpuUpsert {
+	u.Set(gpu.FieldMemoryTotal, v)
+	return u
+}
+
+// UpdateMemoryTotal sets the "memory_total" field to the value that was provided on create.
+func (u *GpuUpsert) UpdateMemoryTotal() *GpuUpsert {
+	u.SetExcluded(gpu.FieldMemoryTotal)
+	return u
+}
+
+// AddMemoryTotal adds v to the "memory_total" field.
+func (u *GpuUpsert) AddMemoryTotal(v float64) *GpuUpsert {
+	u.Add(gpu.FieldMemoryTotal, v)
+	return u
+}
+
+// SetMemoryUsed sets the "memory_used" field.
+func (u *GpuUpsert) SetMemoryUsed(v float64) *GpuUpsert {
+	u.Set(gpu.FieldMemoryUsed, v)
+	return u
+}
+
+// UpdateMemoryUsed sets the "memory_used" field to the value that was provided on create.
+func (u *GpuUpsert) UpdateMemoryUsed() *GpuUpsert {
+	u.SetExcluded(gpu.FieldMemoryUsed)
+	return u
+}
+
+// AddMemoryUsed adds v to the "memory_used" field.
+func (u *GpuUpsert) AddMemoryUsed(v float64) *GpuUpsert {
+	u.Add(gpu.FieldMemoryUsed, v)
+	return u
+}
+
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (u *GpuUpsert) SetUtilizationGpu(v float64) *GpuUpsert {
+	u.Set(gpu.FieldUtilizationGpu, v)
+	return u
+}
+
+// UpdateUtilizationGpu sets the "utilization_gpu" field to the value that was provided on create.
+func (u *GpuUpsert) UpdateUtilizationGpu() *GpuUpsert {
+	u.SetExcluded(gpu.FieldUtilizationGpu)
+	return u
+}
+
+// AddUtilizationGpu adds v to the "utilization_gpu" field.
+func (u *GpuUpsert) AddUtilizationGpu(v float64) *GpuUpsert {
+	u.Add(gpu.FieldUtilizationGpu, v)
+	return u
+}
+
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (u *GpuUpsert) SetUtilizationMemory(v float64) *GpuUpsert {
+	u.Set(gpu.FieldUtilizationMemory, v)
+	return u
+}
+
+// UpdateUtilizationMemory sets the "utilization_memory" field to the value that was provided on create.
+func (u *GpuUpsert) UpdateUtilizationMemory() *GpuUpsert {
+	u.SetExcluded(gpu.FieldUtilizationMemory)
+	return u
+}
+
+// AddUtilizationMemory adds v to the "utilization_memory" field.
+func (u *GpuUpsert) AddUtilizationMemory(v float64) *GpuUpsert {
+	u.Add(gpu.FieldUtilizationMemory, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -503,6 +675,90 @@ func (u *GpuUpsertOne) ClearDeviceID() *GpuUpsertOne {
 	})
 }
 
+// SetMemoryTotal sets the "memory_total" field.
+func (u *GpuUpsertOne) SetMemoryTotal(v float64) *GpuUpsertOne {
+	return u.Update(func(s *GpuUpsert) {
+		s.SetMemoryTotal(v)
+	})
+}
+
+// AddMemoryTotal adds v to the "memory_total" field.
+func (u *GpuUpsertOne) AddMemoryTotal(v float64) *GpuUpsertOne {
+	return u.Update(func(s *GpuUpsert) {
+		s.AddMemoryTotal(v)
+	})
+}
+
+// UpdateMemoryTotal sets the "memory_total" field to the value that was provided on create.
+func (u *GpuUpsertOne) UpdateMemoryTotal() *GpuUpsertOne {
+	return u.Update(func(s *GpuUpsert) {
+		s.UpdateMemoryTotal()
+	})
+}
+
+// SetMemoryUsed sets the "memory_used" field.
+func (u *GpuUpsertOne) SetMemoryUsed(v float64) *GpuUpsertOne {
+	return u.Update(func(s *GpuUpsert) {
+		s.SetMemoryUsed(v)
+	})
+}
+
+// AddMemoryUsed adds v to the "memory_used" field.
+func (u *GpuUpsertOne) AddMemoryUsed(v float64) *GpuUpsertOne {
+	return u.Update(func(s *GpuUpsert) {
+		s.AddMemoryUsed(v)
+	})
+}
+
+// UpdateMemoryUsed sets the "memory_used" field to the value that was provided on create.
+func (u *GpuUpsertOne) UpdateMemoryUsed() *GpuUpsertOne {
+	return u.Update(func(s *GpuUpsert) {
+		s.UpdateMemoryUsed()
+	})
+}
+
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (u *GpuUpsertOne) SetUtilizationGpu(v float64) *GpuUpsertOne {
+	return u.Update(func(s *GpuUpsert) {
+		s.SetUtilizationGpu(v)
+	})
+}
+
+// AddUtilizationGpu adds v to the "utilization_gpu" field.
+func (u *GpuUpsertOne) AddUtilizationGpu(v float64) *GpuUpsertOne {
+	return u.Update(func(s *GpuUpsert) {
+		s.AddUtilizationGpu(v)
+	})
+}
+
+// UpdateUtilizationGpu sets the "utilization_gpu" field to the value that was provided on create.
+func (u *GpuUpsertOne) UpdateUtilizationGpu() *GpuUpsertOne {
+	return u.Update(func(s *GpuUpsert) {
+		s.UpdateUtilizationGpu()
+	})
+}
+
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (u *GpuUpsertOne) SetUtilizationMemory(v float64) *GpuUpsertOne {
+	return u.Update(func(s *GpuUpsert) {
+		s.SetUtilizationMemory(v)
+	})
+}
+
+// AddUtilizationMemory adds v to the "utilization_memory" field.
+func (u *GpuUpsertOne) AddUtilizationMemory(v float64) *GpuUpsertOne {
+	return u.Update(func(s *GpuUpsert) {
+		s.AddUtilizationMemory(v)
+	})
+}
+
+// UpdateUtilizationMemory sets the "utilization_memory" field to the value that was provided on create.
+func (u *GpuUpsertOne) UpdateUtilizationMemory() *GpuUpsertOne {
+	return u.Update(func(s *GpuUpsert) {
+		s.UpdateUtilizationMemory()
+	})
+}
+
 // Exec executes the query.
 func (u *GpuUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -805,6 +1061,90 @@ func (u *GpuUpsertBulk) ClearDeviceID() *GpuUpsertBulk {
 	})
 }
 
+// SetMemoryTotal sets the "memory_total" field.
+func (u *GpuUpsertBulk) SetMemoryTotal(v float64) *GpuUpsertBulk {
+	return u.Update(func(s *GpuUpsert) {
+		s.SetMemoryTotal(v)
+	})
+}
+
+// AddMemoryTotal adds v to the "memory_total" field.
+func (u *GpuUpsertBulk) AddMemoryTotal(v float64) *GpuUpsertBulk {
+	return u.Update(func(s *GpuUpsert) {
+		s.AddMemoryTotal(v)
+	})
+}
+
+// UpdateMemoryTotal sets the "memory_total" field to the value that was provided on create.
+func (u *GpuUpsertBulk) UpdateMemoryTotal() *GpuUpsertBulk {
+	return u.Update(func(s *GpuUpsert) {
+		s.UpdateMemoryTotal()
+	})
+}
+
+// SetMemoryUsed sets the "memory_used" field.
+func (u *GpuUpsertBulk) SetMemoryUsed(v float64) *GpuUpsertBulk {
+	return u.Update(func(s *GpuUpsert) {
+		s.SetMemoryUsed(v)
+	})
+}
+
+// AddMemoryUsed adds v to the "memory_used" field.
+func (u *GpuUpsertBulk) AddMemoryUsed(v float64) *GpuUpsertBulk {
+	return u.Update(func(s *GpuUpsert) {
+		s.AddMemoryUsed(v)
+	})
+}
+
+// UpdateMemoryUsed sets the "memory_used" field to the value that was provided on create.
+func (u *GpuUpsertBulk) UpdateMemoryUsed() *GpuUpsertBulk {
+	return u.Update(func(s *GpuUpsert) {
+		s.UpdateMemoryUsed()
+	})
+}
+
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (u *GpuUpsertBulk) SetUtilizationGpu(v float64) *GpuUpsertBulk {
+	return u.Update(func(s *GpuUpsert) {
+		s.SetUtilizationGpu(v)
+	})
+}
+
+// AddUtilizationGpu adds v to the "utilization_gpu" field.
+func (u *GpuUpsertBulk) AddUtilizationGpu(v float64) *GpuUpsertBulk {
+	return u.Update(func(s *GpuUpsert) {
+		s.AddUtilizationGpu(v)
+	})
+}
+
+// UpdateUtilizationGpu sets the "utilization_gpu" field to the value that was provided on create.
+func (u *GpuUpsertBulk) UpdateUtilizationGpu() *GpuUpsertBulk {
+	return u.Update(func(s *GpuUpsert) {
+		s.UpdateUtilizationGpu()
+	})
+}
+
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (u *GpuUpsertBulk) SetUtilizationMemory(v float64) *GpuUpsertBulk {
+	return u.Update(func(s *GpuUpsert) {
+		s.SetUtilizationMemory(v)
+	})
+}
+
+// AddUtilizationMemory adds v to the "utilization_memory" field.
+func (u *GpuUpsertBulk) AddUtilizationMemory(v float64) *GpuUpsertBulk {
+	return u.Update(func(s *GpuUpsert) {
+		s.AddUtilizationMemory(v)
+	})
+}
+
+// UpdateUtilizationMemory sets the "utilization_memory" field to the value that was provided on create.
+func (u *GpuUpsertBulk) UpdateUtilizationMemory() *GpuUpsertBulk {
+	return u.Update(func(s *GpuUpsert) {
+		s.UpdateUtilizationMemory()
+	})
+}
+
 // Exec executes the query.
 func (u *GpuUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -126,6 +126,90 @@ func (gu *GpuUpdate) ClearDeviceID() *GpuUpdate {
 	return gu
 }
 
+// SetMemoryTotal sets the "memory_total" field.
+func (gu *GpuUpdate) SetMemoryTotal(f float64) *GpuUpdate {
+	gu.mutation.ResetMemoryTotal()
+	gu.mutation.SetMemoryTotal(f)
+	return gu
+}
+
+// SetNillableMemoryTotal sets the "memory_total" field if the given value is not nil.
+func (gu *GpuUpdate) SetNillableMemoryTotal(f *float64) *GpuUpdate {
+	if f != nil {
+		gu.SetMemoryTotal(*f)
+	}
+	return gu
+}
+
+// AddMemoryTotal adds f to the "memory_total" field.
+func (gu *GpuUpdate) AddMemoryTotal(f float64) *GpuUpdate {
+	gu.mutation.AddMemoryTotal(f)
+	return gu
+}
+
+// SetMemoryUsed sets the "memory_used" field.
+func (gu *GpuUpdate) SetMemoryUsed(f float64) *GpuUpdate {
+	gu.mutation.ResetMemoryUsed()
+	gu.mutation.SetMemoryUsed(f)
+	return gu
+}
+
+// SetNillableMemoryUsed sets the "memory_used" field if the given value is not nil.
+func (gu *GpuUpdate) SetNillableMemoryUsed(f *float64) *GpuUpdate {
+	if f != nil {
+		gu.SetMemoryUsed(*f)
+	}
+	return gu
+}
+
+// AddMemoryUsed adds f to the "memory_used" field.
+func (gu *GpuUpdate) AddMemoryUsed(f float64) *GpuUpdate {
+	gu.mutation.AddMemoryUsed(f)
+	return gu
+}
+
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (gu *GpuUpdate) SetUtilizationGpu(f float64) *GpuUpdate {
+	gu.mutation.ResetUtilizationGpu()
+	gu.mutation.SetUtilizationGpu(f)
+	return gu
+}
+
+// SetNillableUtilizationGpu sets the "utilization_gpu" field if the given value is not nil.
+func (gu *GpuUpdate) SetNillableUtilizationGpu(f *float64) *GpuUpdate {
+	if f != nil {
+		gu.SetUtilizationGpu(*f)
+	}
+	return gu
+}
+
+// AddUtilizationGpu adds f to the "utilization_gpu" field.
+func (gu *GpuUpdate) AddUtilizationGpu(f float64) *GpuUpdate {
+	gu.mutation.AddUtilizationGpu(f)
+	return gu
+}
+
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (gu *GpuUpdate) SetUtilizationMemory(f float64) *GpuUpdate {
+	gu.mutation.ResetUtilizationMemory()
+	gu.mutation.SetUtilizationMemory(f)
+	return gu
+}
+
+// SetNillableUtilizationMemory sets the "utilization_memory" field if the given value is not nil.
+func (gu *GpuUpdate) SetNillableUtilizationMemory(f *float64) *GpuUpdate {
+	if f != nil {
+		gu.SetUtilizationMemory(*f)
+	}
+	return gu
+}
+
+// AddUtilizationMemory adds f to the "utilization_memory" field.
+func (gu *GpuUpdate) AddUtilizationMemory(f float64) *GpuUpdate {
+	gu.mutation.AddUtilizationMemory(f)
+	return gu
+}
+
 // SetDevice sets the "device" edge to the Device entity.
 func (gu *GpuUpdate) SetDevice(d *Device) *GpuUpdate {
 	return gu.SetDeviceID(d.ID)
@@ -202,6 +286,30 @@ func (gu *GpuUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := gu.mutation.IsUse(); ok {
 		_spec.SetField(gpu.FieldIsUse, field.TypeBool, value)
 	}
+	if value, ok := gu.mutation.MemoryTotal(); ok {
+		_spec.SetField(gpu.FieldMemoryTotal, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.AddedMemoryTotal(); ok {
+		_spec.AddField(gpu.FieldMemoryTotal, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.MemoryUsed(); ok {
+		_spec.SetField(gpu.FieldMemoryUsed, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.AddedMemoryUsed(); ok {
+		_spec.AddField(gpu.FieldMemoryUsed, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.UtilizationGpu(); ok {
+		_spec.SetField(gpu.FieldUtilizationGpu, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.AddedUtilizationGpu(); ok {
+		_spec.AddField(gpu.FieldUtilizationGpu, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.UtilizationMemory(); ok {
+		_spec.SetField(gpu.FieldUtilizationMemory, field.TypeFloat64, value)
+	}
+	if value, ok := gu.mutation.AddedUtilizationMemory(); ok {
+		_spec.AddField(gpu.FieldUtilizationMemory, field.TypeFloat64, value)
+	}
 	if gu.mutation.DeviceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -350,6 +458,90 @@ func (guo *GpuUpdateOne) ClearDeviceID() *GpuUpdateOne {
 	return guo
 }
 
+// SetMemoryTotal sets the "memory_total" field.
+func (guo *GpuUpdateOne) SetMemoryTotal(f float64) *GpuUpdateOne {
+	guo.mutation.ResetMemoryTotal()
+	guo.mutation.SetMemoryTotal(f)
+	return guo
+}
+
+// SetNillableMemoryTotal sets the "memory_total" field if the given value is not nil.
+func (guo *GpuUpdateOne) SetNillableMemoryTotal(f *float64) *GpuUpdateOne {
+	if f != nil {
+		guo.SetMemoryTotal(*f)
+	}
+	return guo
+}
+
+// AddMemoryTotal adds f to the "memory_total" field.
+func (guo *GpuUpdateOne) AddMemoryTotal(f float64) *GpuUpdateOne {
+	guo.mutation.AddMemoryTotal(f)
+	return guo
+}
+
+// SetMemoryUsed sets the "memory_used" field.
+func (guo *GpuUpdateOne) SetMemoryUsed(f float64) *GpuUpdateOne {
+	guo.mutation.ResetMemoryUsed()
+	guo.mutation.SetMemoryUsed(f)
+	return guo
+}
+
+// SetNillableMemoryUsed sets the "memory_used" field if the given value is not nil.
+func (guo *GpuUpdateOne) SetNillableMemoryUsed(f *float64) *GpuUpdateOne {
+	if f != nil {
+		guo.SetMemoryUsed(*f)
+	}
+	return guo
+}
+
+// AddMemoryUsed adds f to the "memory_used" field.
+func (guo *GpuUpdateOne) AddMemoryUsed(f float64) *GpuUpdateOne {
+	guo.mutation.AddMemoryUsed(f)
+	return guo
+}
+
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (guo *GpuUpdateOne) SetUtilizationGpu(f float64) *GpuUpdateOne {
+	guo.mutation.ResetUtilizationGpu()
+	guo.mutation.SetUtilizationGpu(f)
+	return guo
+}
+
+// SetNillableUtilizationGpu sets the "utilization_gpu" field if the given value is not nil.
+func (guo *GpuUpdateOne) SetNillableUtilizationGpu(f *float64) *GpuUpdateOne {
+	if f != nil {
+		guo.SetUtilizationGpu(*f)
+	}
+	return guo
+}
+
+// AddUtilizationGpu adds f to the "utilization_gpu" field.
+func (guo *GpuUpdateOne) AddUtilizationGpu(f float64) *GpuUpdateOne {
+	guo.mutation.AddUtilizationGpu(f)
+	return guo
+}
+
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (guo *GpuUpdateOne) SetUtilizationMemory(f float64) *GpuUpdateOne {
+	guo.mutation.ResetUtilizationMemory()
+	guo.mutation.SetUtilizationMemory(f)
+	return guo
+}
+
+// SetNillableUtilizationMemory sets the "utilization_memory" field if the given value is not nil.
+func (guo *GpuUpdateOne) SetNillableUtilizationMemory(f *float64) *GpuUpdateOne {
+	if f != nil {
+		guo.SetUtilizationMemory(*f)
+	}
+	return guo
+}
+
+// AddUtilizationMemory adds f to the "utilization_memory" field.
+func (guo *GpuUpdateOne) AddUtilizationMemory(f float64) *GpuUpdateOne {
+	guo.mutation.AddUtilizationMemory(f)
+	return guo
+}
+
 // SetDevice sets the "device" edge to the Device entity.
 func (guo *GpuUpdateOne) SetDevice(d *Device) *GpuUpdateOne {
 	return guo.SetDeviceID(d.ID)
@@ -456,6 +648,30 @@ func (guo *GpuUpdateOne) sqlSave(ctx context.Context) (_node *Gpu, err error) {
 	if value, ok := guo.mutation.IsUse(); ok {
 		_spec.SetField(gpu.FieldIsUse, field.TypeBool, value)
 	}
+	if value, ok := guo.mutation.MemoryTotal(); ok {
+		_spec.SetField(gpu.FieldMemoryTotal, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.AddedMemoryTotal(); ok {
+		_spec.AddField(gpu.FieldMemoryTotal, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.MemoryUsed(); ok {
+		_spec.SetField(gpu.FieldMemoryUsed, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.AddedMemoryUsed(); ok {
+		_spec.AddField(gpu.FieldMemoryUsed, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.UtilizationGpu(); ok {
+		_spec.SetField(gpu.FieldUtilizationGpu, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.AddedUtilizationGpu(); ok {
+		_spec.AddField(gpu.FieldUtilizationGpu, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.UtilizationMemory(); ok {
+		_spec.SetField(gpu.FieldUtilizationMemory, field.TypeFloat64, value)
+	}
+	if value, ok := guo.mutation.AddedUtilizationMemory(); ok {
+		_spec.AddField(gpu.FieldUtilizationMemory, field.TypeFloat64, value)
+	}
 	if guo.mutation.DeviceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
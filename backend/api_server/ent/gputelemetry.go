@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/gputelemetry"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// engine /api/sys로 수집한 GPU 상태를 resolution 단위 구간의 평균으로 저장한 시계열
+type GpuTelemetry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GPU ID
+	GpuID int `json:"gpu_id,omitempty"`
+	// Device ID of the GPU
+	DeviceID int `json:"device_id,omitempty"`
+	// Bucket size in seconds
+	Resolution int `json:"resolution,omitempty"`
+	// Start of the bucket
+	BucketAt time.Time `json:"bucket_at,omitempty"`
+	// Number of samples averaged in the bucket
+	Samples int `json:"samples,omitempty"`
+	// %
+	UtilizationGpu float64 `json:"utilization_gpu,omitempty"`
+	// %
+	UtilizationMemory float64 `json:"utilization_memory,omitempty"`
+	// MiB
+	MemoryUsed float64 `json:"memory_used,omitempty"`
+	// MiB
+	MemoryTotal float64 `json:"memory_total,omitempty"`
+	// Celsius
+	Temperature float64 `json:"temperature,omitempty"`
+	// Watt
+	PowerDraw float64 `json:"power_draw,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GpuTelemetry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gputelemetry.FieldUtilizationGpu, gputelemetry.FieldUtilizationMemory, gputelemetry.FieldMemoryUsed, gputelemetry.FieldMemoryTotal, gputelemetry.FieldTemperature, gputelemetry.FieldPowerDraw:
+			values[i] = new(sql.NullFloat64)
+		case gputelemetry.FieldID, gputelemetry.FieldGpuID, gputelemetry.FieldDeviceID, gputelemetry.FieldResolution, gputelemetry.FieldSamples:
+			values[i] = new(sql.NullInt64)
+		case gputelemetry.FieldBucketAt, gputelemetry.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GpuTelemetry fields.
+func (gt *GpuTelemetry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gputelemetry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gt.ID = int(value.Int64)
+		case gputelemetry.FieldGpuID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gpu_id", values[i])
+			} else if value.Valid {
+				gt.GpuID = int(value.Int64)
+			}
+		case gputelemetry.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				gt.DeviceID = int(value.Int64)
+			}
+		case gputelemetry.FieldResolution:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution", values[i])
+			} else if value.Valid {
+				gt.Resolution = int(value.Int64)
+			}
+		case gputelemetry.FieldBucketAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket_at", values[i])
+			} else if value.Valid {
+				gt.BucketAt = value.Time
+			}
+		case gputelemetry.FieldSamples:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field samples", values[i])
+			} else if value.Valid {
+				gt.Samples = int(value.Int64)
+			}
+		case gputelemetry.FieldUtilizationGpu:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field utilization_gpu", values[i])
+			} else if value.Valid {
+				gt.UtilizationGpu = value.Float64
+			}
+		case gputelemetry.FieldUtilizationMemory:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field utilization_memory", values[i])
+			} else if value.Valid {
+				gt.UtilizationMemory = value.Float64
+			}
+		case gputelemetry.FieldMemoryUsed:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field memory_used", values[i])
+			} else if value.Valid {
+				gt.MemoryUsed = value.Float64
+			}
+		case gputelemetry.FieldMemoryTotal:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field memory_total", values[i])
+			} else if value.Valid {
+				gt.MemoryTotal = value.Float64
+			}
+		case gputelemetry.FieldTemperature:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field temperature", values[i])
+			} else if value.Valid {
+				gt.Temperature = value.Float64
+			}
+		case gputelemetry.FieldPowerDraw:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field power_draw", values[i])
+			} else if value.Valid {
+				gt.PowerDraw = value.Float64
+			}
+		case gputelemetry.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				gt.UpdatedAt = value.Time
+			}
+		default:
+			gt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GpuTelemetry.
+// This includes values selected through modifiers, order, etc.
+func (gt *GpuTelemetry) Value(name string) (ent.Value, error) {
+	return gt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GpuTelemetry.
+// Note that you need to call GpuTelemetry.Unwrap() before calling this method if this GpuTelemetry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gt *GpuTelemetry) Update() *GpuTelemetryUpdateOne {
+	return NewGpuTelemetryClient(gt.config).UpdateOne(gt)
+}
+
+// Unwrap unwraps the GpuTelemetry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gt *GpuTelemetry) Unwrap() *GpuTelemetry {
+	_tx, ok := gt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GpuTelemetry is not a transactional entity")
+	}
+	gt.config.driver = _tx.drv
+	return gt
+}
+
+// String implements the fmt.Stringer.
+func (gt *GpuTelemetry) String() string {
+	var builder strings.Builder
+	builder.WriteString("GpuTelemetry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gt.ID))
+	builder.WriteString("gpu_id=")
+	builder.WriteString(fmt.Sprintf("%v", gt.GpuID))
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(fmt.Sprintf("%v", gt.DeviceID))
+	builder.WriteString(", ")
+	builder.WriteString("resolution=")
+	builder.WriteString(fmt.Sprintf("%v", gt.Resolution))
+	builder.WriteString(", ")
+	builder.WriteString("bucket_at=")
+	builder.WriteString(gt.BucketAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("samples=")
+	builder.WriteString(fmt.Sprintf("%v", gt.Samples))
+	builder.WriteString(", ")
+	builder.WriteString("utilization_gpu=")
+	builder.WriteString(fmt.Sprintf("%v", gt.UtilizationGpu))
+	builder.WriteString(", ")
+	builder.WriteString("utilization_memory=")
+	builder.WriteString(fmt.Sprintf("%v", gt.UtilizationMemory))
+	builder.WriteString(", ")
+	builder.WriteString("memory_used=")
+	builder.WriteString(fmt.Sprintf("%v", gt.MemoryUsed))
+	builder.WriteString(", ")
+	builder.WriteString("memory_total=")
+	builder.WriteString(fmt.Sprintf("%v", gt.MemoryTotal))
+	builder.WriteString(", ")
+	builder.WriteString("temperature=")
+	builder.WriteString(fmt.Sprintf("%v", gt.Temperature))
+	builder.WriteString(", ")
+	builder.WriteString("power_draw=")
+	builder.WriteString(fmt.Sprintf("%v", gt.PowerDraw))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(gt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GpuTelemetries is a parsable slice of GpuTelemetry.
+type GpuTelemetries []*GpuTelemetry
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gputelemetry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the gputelemetry type in the database.
+	Label = "gpu_telemetry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGpuID holds the string denoting the gpu_id field in the database.
+	FieldGpuID = "gpu_id"
+	// FieldDeviceID holds the string denoting the device_id field in the database.
+	FieldDeviceID = "device_id"
+	// FieldResolution holds the string denoting the resolution field in the database.
+	FieldResolution = "resolution"
+	// FieldBucketAt holds the string denoting the bucket_at field in the database.
+	FieldBucketAt = "bucket_at"
+	// FieldSamples holds the string denoting the samples field in the database.
+	FieldSamples = "samples"
+	// FieldUtilizationGpu holds the string denoting the utilization_gpu field in the database.
+	FieldUtilizationGpu = "utilization_gpu"
+	// FieldUtilizationMemory holds the string denoting the utilization_memory field in the database.
+	FieldUtilizationMemory = "utilization_memory"
+	// FieldMemoryUsed holds the string denoting the memory_used field in the database.
+	FieldMemoryUsed = "memory_used"
+	// FieldMemoryTotal holds the string denoting the memory_total field in the database.
+	FieldMemoryTotal = "memory_total"
+	// FieldTemperature holds the string denoting the temperature field in the database.
+	FieldTemperature = "temperature"
+	// FieldPowerDraw holds the string denoting the power_draw field in the database.
+	FieldPowerDraw = "power_draw"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the gputelemetry in the database.
+	Table = "gpu_telemetry"
+)
+
+// Columns holds all SQL columns for gputelemetry fields.
+var Columns = []string{
+	FieldID,
+	FieldGpuID,
+	FieldDeviceID,
+	FieldResolution,
+	FieldBucketAt,
+	FieldSamples,
+	FieldUtilizationGpu,
+	FieldUtilizationMemory,
+	FieldMemoryUsed,
+	FieldMemoryTotal,
+	FieldTemperature,
+	FieldPowerDraw,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultSamples holds the default value on creation for the "samples" field.
+	DefaultSamples int
+	// DefaultUtilizationGpu holds the default value on creation for the "utilization_gpu" field.
+	DefaultUtilizationGpu float64
+	// DefaultUtilizationMemory holds the default value on creation for the "utilization_memory" field.
+	DefaultUtilizationMemory float64
+	// DefaultMemoryUsed holds the default value on creation for the "memory_used" field.
+	DefaultMemoryUsed float64
+	// DefaultMemoryTotal holds the default value on creation for the "memory_total" field.
+	DefaultMemoryTotal float64
+	// DefaultTemperature holds the default value on creation for the "temperature" field.
+	DefaultTemperature float64
+	// DefaultPowerDraw holds the default value on creation for the "power_draw" field.
+	DefaultPowerDraw float64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the GpuTelemetry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGpuID orders the results by the gpu_id field.
+func ByGpuID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGpuID, opts...).ToFunc()
+}
+
+// ByDeviceID orders the results by the device_id field.
+func ByDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceID, opts...).ToFunc()
+}
+
+// ByResolution orders the results by the resolution field.
+func ByResolution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolution, opts...).ToFunc()
+}
+
+// ByBucketAt orders the results by the bucket_at field.
+func ByBucketAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBucketAt, opts...).ToFunc()
+}
+
+// BySamples orders the results by the samples field.
+func BySamples(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSamples, opts...).ToFunc()
+}
+
+// ByUtilizationGpu orders the results by the utilization_gpu field.
+func ByUtilizationGpu(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUtilizationGpu, opts...).ToFunc()
+}
+
+// ByUtilizationMemory orders the results by the utilization_memory field.
+func ByUtilizationMemory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUtilizationMemory, opts...).ToFunc()
+}
+
+// ByMemoryUsed orders the results by the memory_used field.
+func ByMemoryUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemoryUsed, opts...).ToFunc()
+}
+
+// ByMemoryTotal orders the results by the memory_total field.
+func ByMemoryTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemoryTotal, opts...).ToFunc()
+}
+
+// ByTemperature orders the results by the temperature field.
+func ByTemperature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemperature, opts...).ToFunc()
+}
+
+// ByPowerDraw orders the results by the power_draw field.
+func ByPowerDraw(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPowerDraw, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
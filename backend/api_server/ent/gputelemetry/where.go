@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gputelemetry
+
+import (
+	"api_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldID, id))
+}
+
+// GpuID applies equality check predicate on the "gpu_id" field. It's identical to GpuIDEQ.
+func GpuID(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldGpuID, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// Resolution applies equality check predicate on the "resolution" field. It's identical to ResolutionEQ.
+func Resolution(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldResolution, v))
+}
+
+// BucketAt applies equality check predicate on the "bucket_at" field. It's identical to BucketAtEQ.
+func BucketAt(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldBucketAt, v))
+}
+
+// Samples applies equality check predicate on the "samples" field. It's identical to SamplesEQ.
+func Samples(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldSamples, v))
+}
+
+// UtilizationGpu applies equality check predicate on the "utilization_gpu" field. It's identical to UtilizationGpuEQ.
+func UtilizationGpu(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldUtilizationGpu, v))
+}
+
+// UtilizationMemory applies equality check predicate on the "utilization_memory" field. It's identical to UtilizationMemoryEQ.
+func UtilizationMemory(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldUtilizationMemory, v))
+}
+
+// MemoryUsed applies equality check predicate on the "memory_used" field. It's identical to MemoryUsedEQ.
+func MemoryUsed(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldMemoryUsed, v))
+}
+
+// MemoryTotal applies equality check predicate on the "memory_total" field. It's identical to MemoryTotalEQ.
+func MemoryTotal(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldMemoryTotal, v))
+}
+
+// Temperature applies equality check predicate on the "temperature" field. It's identical to TemperatureEQ.
+func Temperature(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldTemperature, v))
+}
+
+// PowerDraw applies equality check predicate on the "power_draw" field. It's identical to PowerDrawEQ.
+func PowerDraw(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldPowerDraw, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// GpuIDEQ applies the EQ predicate on the "gpu_id" field.
+func GpuIDEQ(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldGpuID, v))
+}
+
+// GpuIDNEQ applies the NEQ predicate on the "gpu_id" field.
+func GpuIDNEQ(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldGpuID, v))
+}
+
+// GpuIDIn applies the In predicate on the "gpu_id" field.
+func GpuIDIn(vs ...int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldGpuID, vs...))
+}
+
+// GpuIDNotIn applies the NotIn predicate on the "gpu_id" field.
+func GpuIDNotIn(vs ...int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldGpuID, vs...))
+}
+
+// GpuIDGT applies the GT predicate on the "gpu_id" field.
+func GpuIDGT(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldGpuID, v))
+}
+
+// GpuIDGTE applies the GTE predicate on the "gpu_id" field.
+func GpuIDGTE(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldGpuID, v))
+}
+
+// GpuIDLT applies the LT predicate on the "gpu_id" field.
+func GpuIDLT(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldGpuID, v))
+}
+
+// GpuIDLTE applies the LTE predicate on the "gpu_id" field.
+func GpuIDLTE(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldGpuID, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// ResolutionEQ applies the EQ predicate on the "resolution" field.
+func ResolutionEQ(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldResolution, v))
+}
+
+// ResolutionNEQ applies the NEQ predicate on the "resolution" field.
+func ResolutionNEQ(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldResolution, v))
+}
+
+// ResolutionIn applies the In predicate on the "resolution" field.
+func ResolutionIn(vs ...int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldResolution, vs...))
+}
+
+// ResolutionNotIn applies the NotIn predicate on the "resolution" field.
+func ResolutionNotIn(vs ...int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldResolution, vs...))
+}
+
+// ResolutionGT applies the GT predicate on the "resolution" field.
+func ResolutionGT(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldResolution, v))
+}
+
+// ResolutionGTE applies the GTE predicate on the "resolution" field.
+func ResolutionGTE(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldResolution, v))
+}
+
+// ResolutionLT applies the LT predicate on the "resolution" field.
+func ResolutionLT(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldResolution, v))
+}
+
+// ResolutionLTE applies the LTE predicate on the "resolution" field.
+func ResolutionLTE(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldResolution, v))
+}
+
+// BucketAtEQ applies the EQ predicate on the "bucket_at" field.
+func BucketAtEQ(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldBucketAt, v))
+}
+
+// BucketAtNEQ applies the NEQ predicate on the "bucket_at" field.
+func BucketAtNEQ(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldBucketAt, v))
+}
+
+// BucketAtIn applies the In predicate on the "bucket_at" field.
+func BucketAtIn(vs ...time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldBucketAt, vs...))
+}
+
+// BucketAtNotIn applies the NotIn predicate on the "bucket_at" field.
+func BucketAtNotIn(vs ...time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldBucketAt, vs...))
+}
+
+// BucketAtGT applies the GT predicate on the "bucket_at" field.
+func BucketAtGT(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldBucketAt, v))
+}
+
+// BucketAtGTE applies the GTE predicate on the "bucket_at" field.
+func BucketAtGTE(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldBucketAt, v))
+}
+
+// BucketAtLT applies the LT predicate on the "bucket_at" field.
+func BucketAtLT(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldBucketAt, v))
+}
+
+// BucketAtLTE applies the LTE predicate on the "bucket_at" field.
+func BucketAtLTE(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldBucketAt, v))
+}
+
+// SamplesEQ applies the EQ predicate on the "samples" field.
+func SamplesEQ(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldSamples, v))
+}
+
+// SamplesNEQ applies the NEQ predicate on the "samples" field.
+func SamplesNEQ(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldSamples, v))
+}
+
+// SamplesIn applies the In predicate on the "samples" field.
+func SamplesIn(vs ...int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldSamples, vs...))
+}
+
+// SamplesNotIn applies the NotIn predicate on the "samples" field.
+func SamplesNotIn(vs ...int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldSamples, vs...))
+}
+
+// SamplesGT applies the GT predicate on the "samples" field.
+func SamplesGT(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldSamples, v))
+}
+
+// SamplesGTE applies the GTE predicate on the "samples" field.
+func SamplesGTE(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldSamples, v))
+}
+
+// SamplesLT applies the LT predicate on the "samples" field.
+func SamplesLT(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldSamples, v))
+}
+
+// SamplesLTE applies the LTE predicate on the "samples" field.
+func SamplesLTE(v int) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldSamples, v))
+}
+
+// UtilizationGpuEQ applies the EQ predicate on the "utilization_gpu" field.
+func UtilizationGpuEQ(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldUtilizationGpu, v))
+}
+
+// UtilizationGpuNEQ applies the NEQ predicate on the "utilization_gpu" field.
+func UtilizationGpuNEQ(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldUtilizationGpu, v))
+}
+
+// UtilizationGpuIn applies the In predicate on the "utilization_gpu" field.
+func UtilizationGpuIn(vs ...float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldUtilizationGpu, vs...))
+}
+
+// UtilizationGpuNotIn applies the NotIn predicate on the "utilization_gpu" field.
+func UtilizationGpuNotIn(vs ...float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldUtilizationGpu, vs...))
+}
+
+// UtilizationGpuGT applies the GT predicate on the "utilization_gpu" field.
+func UtilizationGpuGT(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldUtilizationGpu, v))
+}
+
+// UtilizationGpuGTE applies the GTE predicate on the "utilization_gpu" field.
+func UtilizationGpuGTE(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldUtilizationGpu, v))
+}
+
+// UtilizationGpuLT applies the LT predicate on the "utilization_gpu" field.
+func UtilizationGpuLT(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldUtilizationGpu, v))
+}
+
+// UtilizationGpuLTE applies the LTE predicate on the "utilization_gpu" field.
+func UtilizationGpuLTE(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldUtilizationGpu, v))
+}
+
+// UtilizationMemoryEQ applies the EQ predicate on the "utilization_memory" field.
+func UtilizationMemoryEQ(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldUtilizationMemory, v))
+}
+
+// UtilizationMemoryNEQ applies the NEQ predicate on the "utilization_memory" field.
+func UtilizationMemoryNEQ(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldUtilizationMemory, v))
+}
+
+// UtilizationMemoryIn applies the In predicate on the "utilization_memory" field.
+func UtilizationMemoryIn(vs ...float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldUtilizationMemory, vs...))
+}
+
+// UtilizationMemoryNotIn applies the NotIn predicate on the "utilization_memory" field.
+func UtilizationMemoryNotIn(vs ...float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldUtilizationMemory, vs...))
+}
+
+// UtilizationMemoryGT applies the GT predicate on the "utilization_memory" field.
+func UtilizationMemoryGT(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldUtilizationMemory, v))
+}
+
+// UtilizationMemoryGTE applies the GTE predicate on the "utilization_memory" field.
+func UtilizationMemoryGTE(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldUtilizationMemory, v))
+}
+
+// UtilizationMemoryLT applies the LT predicate on the "utilization_memory" field.
+func UtilizationMemoryLT(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldUtilizationMemory, v))
+}
+
+// UtilizationMemoryLTE applies the LTE predicate on the "utilization_memory" field.
+func UtilizationMemoryLTE(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldUtilizationMemory, v))
+}
+
+// MemoryUsedEQ applies the EQ predicate on the "memory_used" field.
+func MemoryUsedEQ(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldMemoryUsed, v))
+}
+
+// MemoryUsedNEQ applies the NEQ predicate on the "memory_used" field.
+func MemoryUsedNEQ(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldMemoryUsed, v))
+}
+
+// MemoryUsedIn applies the In predicate on the "memory_used" field.
+func MemoryUsedIn(vs ...float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldMemoryUsed, vs...))
+}
+
+// MemoryUsedNotIn applies the NotIn predicate on the "memory_used" field.
+func MemoryUsedNotIn(vs ...float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldMemoryUsed, vs...))
+}
+
+// MemoryUsedGT applies the GT predicate on the "memory_used" field.
+func MemoryUsedGT(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldMemoryUsed, v))
+}
+
+// MemoryUsedGTE applies the GTE predicate on the "memory_used" field.
+func MemoryUsedGTE(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldMemoryUsed, v))
+}
+
+// MemoryUsedLT applies the LT predicate on the "memory_used" field.
+func MemoryUsedLT(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldMemoryUsed, v))
+}
+
+// MemoryUsedLTE applies the LTE predicate on the "memory_used" field.
+func MemoryUsedLTE(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldMemoryUsed, v))
+}
+
+// MemoryTotalEQ applies the EQ predicate on the "memory_total" field.
+func MemoryTotalEQ(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldMemoryTotal, v))
+}
+
+// MemoryTotalNEQ applies the NEQ predicate on the "memory_total" field.
+func MemoryTotalNEQ(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldMemoryTotal, v))
+}
+
+// MemoryTotalIn applies the In predicate on the "memory_total" field.
+func MemoryTotalIn(vs ...float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldMemoryTotal, vs...))
+}
+
+// MemoryTotalNotIn applies the NotIn predicate on the "memory_total" field.
+func MemoryTotalNotIn(vs ...float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldMemoryTotal, vs...))
+}
+
+// MemoryTotalGT applies the GT predicate on the "memory_total" field.
+func MemoryTotalGT(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldMemoryTotal, v))
+}
+
+// MemoryTotalGTE applies the GTE predicate on the "memory_total" field.
+func MemoryTotalGTE(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldMemoryTotal, v))
+}
+
+// MemoryTotalLT applies the LT predicate on the "memory_total" field.
+func MemoryTotalLT(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldMemoryTotal, v))
+}
+
+// MemoryTotalLTE applies the LTE predicate on the "memory_total" field.
+func MemoryTotalLTE(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldMemoryTotal, v))
+}
+
+// TemperatureEQ applies the EQ predicate on the "temperature" field.
+func TemperatureEQ(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldTemperature, v))
+}
+
+// TemperatureNEQ applies the NEQ predicate on the "temperature" field.
+func TemperatureNEQ(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldTemperature, v))
+}
+
+// TemperatureIn applies the In predicate on the "temperature" field.
+func TemperatureIn(vs ...float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldTemperature, vs...))
+}
+
+// TemperatureNotIn applies the NotIn predicate on the "temperature" field.
+func TemperatureNotIn(vs ...float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldTemperature, vs...))
+}
+
+// TemperatureGT applies the GT predicate on the "temperature" field.
+func TemperatureGT(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldTemperature, v))
+}
+
+// TemperatureGTE applies the GTE predicate on the "temperature" field.
+func TemperatureGTE(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldTemperature, v))
+}
+
+// TemperatureLT applies the LT predicate on the "temperature" field.
+func TemperatureLT(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldTemperature, v))
+}
+
+// TemperatureLTE applies the LTE predicate on the "temperature" field.
+func TemperatureLTE(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldTemperature, v))
+}
+
+// PowerDrawEQ applies the EQ predicate on the "power_draw" field.
+func PowerDrawEQ(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldPowerDraw, v))
+}
+
+// PowerDrawNEQ applies the NEQ predicate on the "power_draw" field.
+func PowerDrawNEQ(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldPowerDraw, v))
+}
+
+// PowerDrawIn applies the In predicate on the "power_draw" field.
+func PowerDrawIn(vs ...float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldPowerDraw, vs...))
+}
+
+// PowerDrawNotIn applies the NotIn predicate on the "power_draw" field.
+func PowerDrawNotIn(vs ...float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldPowerDraw, vs...))
+}
+
+// PowerDrawGT applies the GT predicate on the "power_draw" field.
+func PowerDrawGT(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldPowerDraw, v))
+}
+
+// PowerDrawGTE applies the GTE predicate on the "power_draw" field.
+func PowerDrawGTE(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldPowerDraw, v))
+}
+
+// PowerDrawLT applies the LT predicate on the "power_draw" field.
+func PowerDrawLT(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldPowerDraw, v))
+}
+
+// PowerDrawLTE applies the LTE predicate on the "power_draw" field.
+func PowerDrawLTE(v float64) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldPowerDraw, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GpuTelemetry) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GpuTelemetry) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GpuTelemetry) predicate.GpuTelemetry {
+	return predicate.GpuTelemetry(sql.NotPredicates(p))
+}
@@ -0,0 +1,1370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/gputelemetry"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GpuTelemetryCreate is the builder for creating a GpuTelemetry entity.
+type GpuTelemetryCreate struct {
+	config
+	mutation *GpuTelemetryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGpuID sets the "gpu_id" field.
+func (gtc *GpuTelemetryCreate) SetGpuID(i int) *GpuTelemetryCreate {
+	gtc.mutation.SetGpuID(i)
+	return gtc
+}
+
+// SetDeviceID sets the "device_id" field.
+func (gtc *GpuTelemetryCreate) SetDeviceID(i int) *GpuTelemetryCreate {
+	gtc.mutation.SetDeviceID(i)
+	return gtc
+}
+
+// SetResolution sets the "resolution" field.
+func (gtc *GpuTelemetryCreate) SetResolution(i int) *GpuTelemetryCreate {
+	gtc.mutation.SetResolution(i)
+	return gtc
+}
+
+// SetBucketAt sets the "bucket_at" field.
+func (gtc *GpuTelemetryCreate) SetBucketAt(t time.Time) *GpuTelemetryCreate {
+	gtc.mutation.SetBucketAt(t)
+	return gtc
+}
+
+// SetSamples sets the "samples" field.
+func (gtc *GpuTelemetryCreate) SetSamples(i int) *GpuTelemetryCreate {
+	gtc.mutation.SetSamples(i)
+	return gtc
+}
+
+// SetNillableSamples sets the "samples" field if the given value is not nil.
+func (gtc *GpuTelemetryCreate) SetNillableSamples(i *int) *GpuTelemetryCreate {
+	if i != nil {
+		gtc.SetSamples(*i)
+	}
+	return gtc
+}
+
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (gtc *GpuTelemetryCreate) SetUtilizationGpu(f float64) *GpuTelemetryCreate {
+	gtc.mutation.SetUtilizationGpu(f)
+	return gtc
+}
+
+// SetNillableUtilizationGpu sets the "utilization_gpu" field if the given value is not nil.
+func (gtc *GpuTelemetryCreate) SetNillableUtilizationGpu(f *float64) *GpuTelemetryCreate {
+	if f != nil {
+		gtc.SetUtilizationGpu(*f)
+	}
+	return gtc
+}
+
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (gtc *GpuTelemetryCreate) SetUtilizationMemory(f float64) *GpuTelemetryCreate {
+	gtc.mutation.SetUtilizationMemory(f)
+	return gtc
+}
+
+// SetNillableUtilizationMemory sets the "utilization_memory" field if the given value is not nil.
+func (gtc *GpuTelemetryCreate) SetNillableUtilizationMemory(f *float64) *GpuTelemetryCreate {
+	if f != nil {
+		gtc.SetUtilizationMemory(*f)
+	}
+	return gtc
+}
+
+// SetMemoryUsed sets the "memory_used" field.
+func (gtc *GpuTelemetryCreate) SetMemoryUsed(f float64) *GpuTelemetryCreate {
+	gtc.mutation.SetMemoryUsed(f)
+	return gtc
+}
+
+// SetNillableMemoryUsed sets the "memory_used" field if the given value is not nil.
+func (gtc *GpuTelemetryCreate) SetNillableMemoryUsed(f *float64) *GpuTelemetryCreate {
+	if f != nil {
+		gtc.SetMemoryUsed(*f)
+	}
+	return gtc
+}
+
+// SetMemoryTotal sets the "memory_total" field.
+func (gtc *GpuTelemetryCreate) SetMemoryTotal(f float64) *GpuTelemetryCreate {
+	gtc.mutation.SetMemoryTotal(f)
+	return gtc
+}
+
+// SetNillableMemoryTotal sets the "memory_total" field if the given value is not nil.
+func (gtc *GpuTelemetryCreate) SetNillableMemoryTotal(f *float64) *GpuTelemetryCreate {
+	if f != nil {
+		gtc.SetMemoryTotal(*f)
+	}
+	return gtc
+}
+
+// SetTemperature sets the "temperature" field.
+func (gtc *GpuTelemetryCreate) SetTemperature(f float64) *GpuTelemetryCreate {
+	gtc.mutation.SetTemperature(f)
+	return gtc
+}
+
+// SetNillableTemperature sets the "temperature" field if the given value is not nil.
+func (gtc *GpuTelemetryCreate) SetNillableTemperature(f *float64) *GpuTelemetryCreate {
+	if f != nil {
+		gtc.SetTemperature(*f)
+	}
+	return gtc
+}
+
+// SetPowerDraw sets the "power_draw" field.
+func (gtc *GpuTelemetryCreate) SetPowerDraw(f float64) *GpuTelemetryCreate {
+	gtc.mutation.SetPowerDraw(f)
+	return gtc
+}
+
+// SetNillablePowerDraw sets the "power_draw" field if the given value is not nil.
+func (gtc *GpuTelemetryCreate) SetNillablePowerDraw(f *float64) *GpuTelemetryCreate {
+	if f != nil {
+		gtc.SetPowerDraw(*f)
+	}
+	return gtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gtc *GpuTelemetryCreate) SetUpdatedAt(t time.Time) *GpuTelemetryCreate {
+	gtc.mutation.SetUpdatedAt(t)
+	return gtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (gtc *GpuTelemetryCreate) SetNillableUpdatedAt(t *time.Time) *GpuTelemetryCreate {
+	if t != nil {
+		gtc.SetUpdatedAt(*t)
+	}
+	return gtc
+}
+
+// SetID sets the "id" field.
+func (gtc *GpuTelemetryCreate) SetID(i int) *GpuTelemetryCreate {
+	gtc.mutation.SetID(i)
+	return gtc
+}
+
+// Mutation returns the GpuTelemetryMutation object of the builder.
+func (gtc *GpuTelemetryCreate) Mutation() *GpuTelemetryMutation {
+	return gtc.mutation
+}
+
+// Save creates the GpuTelemetry in the database.
+func (gtc *GpuTelemetryCreate) Save(ctx context.Context) (*GpuTelemetry, error) {
+	gtc.defaults()
+	return withHooks(ctx, gtc.sqlSave, gtc.mutation, gtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gtc *GpuTelemetryCreate) SaveX(ctx context.Context) *GpuTelemetry {
+	v, err := gtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gtc *GpuTelemetryCreate) Exec(ctx context.Context) error {
+	_, err := gtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtc *GpuTelemetryCreate) ExecX(ctx context.Context) {
+	if err := gtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gtc *GpuTelemetryCreate) defaults() {
+	if _, ok := gtc.mutation.Samples(); !ok {
+		v := gputelemetry.DefaultSamples
+		gtc.mutation.SetSamples(v)
+	}
+	if _, ok := gtc.mutation.UtilizationGpu(); !ok {
+		v := gputelemetry.DefaultUtilizationGpu
+		gtc.mutation.SetUtilizationGpu(v)
+	}
+	if _, ok := gtc.mutation.UtilizationMemory(); !ok {
+		v := gputelemetry.DefaultUtilizationMemory
+		gtc.mutation.SetUtilizationMemory(v)
+	}
+	if _, ok := gtc.mutation.MemoryUsed(); !ok {
+		v := gputelemetry.DefaultMemoryUsed
+		gtc.mutation.SetMemoryUsed(v)
+	}
+	if _, ok := gtc.mutation.MemoryTotal(); !ok {
+		v := gputelemetry.DefaultMemoryTotal
+		gtc.mutation.SetMemoryTotal(v)
+	}
+	if _, ok := gtc.mutation.Temperature(); !ok {
+		v := gputelemetry.DefaultTemperature
+		gtc.mutation.SetTemperature(v)
+	}
+	if _, ok := gtc.mutation.PowerDraw(); !ok {
+		v := gputelemetry.DefaultPowerDraw
+		gtc.mutation.SetPowerDraw(v)
+	}
+	if _, ok := gtc.mutation.UpdatedAt(); !ok {
+		v := gputelemetry.DefaultUpdatedAt()
+		gtc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gtc *GpuTelemetryCreate) check() error {
+	if _, ok := gtc.mutation.GpuID(); !ok {
+		return &ValidationError{Name: "gpu_id", err: errors.New(`ent: missing required field "GpuTelemetry.gpu_id"`)}
+	}
+	if _, ok := gtc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "GpuTelemetry.device_id"`)}
+	}
+	if _, ok := gtc.mutation.Resolution(); !ok {
+		return &ValidationError{Name: "resolution", err: errors.New(`ent: missing required field "GpuTelemetry.resolution"`)}
+	}
+	if _, ok := gtc.mutation.BucketAt(); !ok {
+		return &ValidationError{Name: "bucket_at", err: errors.New(`ent: missing required field "GpuTelemetry.bucket_at"`)}
+	}
+	if _, ok := gtc.mutation.Samples(); !ok {
+		return &ValidationError{Name: "samples", err: errors.New(`ent: missing required field "GpuTelemetry.samples"`)}
+	}
+	if _, ok := gtc.mutation.UtilizationGpu(); !ok {
+		return &ValidationError{Name: "utilization_gpu", err: errors.New(`ent: missing required field "GpuTelemetry.utilization_gpu"`)}
+	}
+	if _, ok := gtc.mutation.UtilizationMemory(); !ok {
+		return &ValidationError{Name: "utilization_memory", err: errors.New(`ent: missing required field "GpuTelemetry.utilization_memory"`)}
+	}
+	if _, ok := gtc.mutation.MemoryUsed(); !ok {
+		return &ValidationError{Name: "memory_used", err: errors.New(`ent: missing required field "GpuTelemetry.memory_used"`)}
+	}
+	if _, ok := gtc.mutation.MemoryTotal(); !ok {
+		return &ValidationError{Name: "memory_total", err: errors.New(`ent: missing required field "GpuTelemetry.memory_total"`)}
+	}
+	if _, ok := gtc.mutation.Temperature(); !ok {
+		return &ValidationError{Name: "temperature", err: errors.New(`ent: missing required field "GpuTelemetry.temperature"`)}
+	}
+	if _, ok := gtc.mutation.PowerDraw(); !ok {
+		return &ValidationError{Name: "power_draw", err: errors.New(`ent: missing required field "GpuTelemetry.power_draw"`)}
+	}
+	if _, ok := gtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "GpuTelemetry.updated_at"`)}
+	}
+	return nil
+}
+
+func (gtc *GpuTelemetryCreate) sqlSave(ctx context.Context) (*GpuTelemetry, error) {
+	if err := gtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	gtc.mutation.id = &_node.ID
+	gtc.mutation.done = true
+	return _node, nil
+}
+
+func (gtc *GpuTelemetryCreate) createSpec() (*GpuTelemetry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GpuTelemetry{config: gtc.config}
+		_spec = sqlgraph.NewCreateSpec(gputelemetry.Table, sqlgraph.NewFieldSpec(gputelemetry.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = gtc.conflict
+	if id, ok := gtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := gtc.mutation.GpuID(); ok {
+		_spec.SetField(gputelemetry.FieldGpuID, field.TypeInt, value)
+		_node.GpuID = value
+	}
+	if value, ok := gtc.mutation.DeviceID(); ok {
+		_spec.SetField(gputelemetry.FieldDeviceID, field.TypeInt, value)
+		_node.DeviceID = value
+	}
+	if value, ok := gtc.mutation.Resolution(); ok {
+		_spec.SetField(gputelemetry.FieldResolution, field.TypeInt, value)
+		_node.Resolution = value
+	}
+	if value, ok := gtc.mutation.BucketAt(); ok {
+		_spec.SetField(gputelemetry.FieldBucketAt, field.TypeTime, value)
+		_node.BucketAt = value
+	}
+	if value, ok := gtc.mutation.Samples(); ok {
+		_spec.SetField(gputelemetry.FieldSamples, field.TypeInt, value)
+		_node.Samples = value
+	}
+	if value, ok := gtc.mutation.UtilizationGpu(); ok {
+		_spec.SetField(gputelemetry.FieldUtilizationGpu, field.TypeFloat64, value)
+		_node.UtilizationGpu = value
+	}
+	if value, ok := gtc.mutation.UtilizationMemory(); ok {
+		_spec.SetField(gputelemetry.FieldUtilizationMemory, field.TypeFloat64, value)
+		_node.UtilizationMemory = value
+	}
+	if value, ok := gtc.mutation.MemoryUsed(); ok {
+		_spec.SetField(gputelemetry.FieldMemoryUsed, field.TypeFloat64, value)
+		_node.MemoryUsed = value
+	}
+	if value, ok := gtc.mutation.MemoryTotal(); ok {
+		_spec.SetField(gputelemetry.FieldMemoryTotal, field.TypeFloat64, value)
+		_node.MemoryTotal = value
+	}
+	if value, ok := gtc.mutation.Temperature(); ok {
+		_spec.SetField(gputelemetry.FieldTemperature, field.TypeFloat64, value)
+		_node.Temperature = value
+	}
+	if value, ok := gtc.mutation.PowerDraw(); ok {
+		_spec.SetField(gputelemetry.FieldPowerDraw, field.TypeFloat64, value)
+		_node.PowerDraw = value
+	}
+	if value, ok := gtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(gputelemetry.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GpuTelemetry.Create().
+//		SetGpuID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GpuTelemetryUpsert) {
+//			SetGpuID(v+v).
+//		}).
+//		Exec(ctx)
+func (gtc *GpuTelemetryCreate) OnConflict(opts ...sql.ConflictOption) *GpuTelemetryUpsertOne {
+	gtc.conflict = opts
+	return &GpuTelemetryUpsertOne{
+		create: gtc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GpuTelemetry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gtc *GpuTelemetryCreate) OnConflictColumns(columns ...string) *GpuTelemetryUpsertOne {
+	gtc.conflict = append(gtc.conflict, sql.ConflictColumns(columns...))
+	return &GpuTelemetryUpsertOne{
+		create: gtc,
+	}
+}
+
+type (
+	// GpuTelemetryUpsertOne is the builder for "upsert"-ing
+	//  one GpuTelemetry node.
+	GpuTelemetryUpsertOne struct {
+		create *GpuTelemetryCreate
+	}
+
+	// GpuTelemetryUpsert is the "OnConflict" setter.
+	GpuTelemetryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGpuID sets the "gpu_id" field.
+func (u *GpuTelemetryUpsert) SetGpuID(v int) *GpuTelemetryUpsert {
+	u.Set(gputelemetry.FieldGpuID, v)
+	return u
+}
+
+// UpdateGpuID sets the "gpu_id" field to the value that was provided on create.
+func (u *GpuTelemetryUpsert) UpdateGpuID() *GpuTelemetryUpsert {
+	u.SetExcluded(gputelemetry.FieldGpuID)
+	return u
+}
+
+// AddGpuID adds v to the "gpu_id" field.
+func (u *GpuTelemetryUpsert) AddGpuID(v int) *GpuTelemetryUpsert {
+	u.Add(gputelemetry.FieldGpuID, v)
+	return u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *GpuTelemetryUpsert) SetDeviceID(v int) *GpuTelemetryUpsert {
+	u.Set(gputelemetry.FieldDeviceID, v)
+	return u
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *GpuTelemetryUpsert) UpdateDeviceID() *GpuTelemetryUpsert {
+	u.SetExcluded(gputelemetry.FieldDeviceID)
+	return u
+}
+
+// AddDeviceID adds v to the "device_id" field.
+func (u *GpuTelemetryUpsert) AddDeviceID(v int) *GpuTelemetryUpsert {
+	u.Add(gputelemetry.FieldDeviceID, v)
+	return u
+}
+
+// SetResolution sets the "resolution" field.
+func (u *GpuTelemetryUpsert) SetResolution(v int) *GpuTelemetryUpsert {
+	u.Set(gputelemetry.FieldResolution, v)
+	return u
+}
+
+// UpdateResolution sets the "resolution" field to the value that was provided on create.
+func (u *GpuTelemetryUpsert) UpdateResolution() *GpuTelemetryUpsert {
+	u.SetExcluded(gputelemetry.FieldResolution)
+	return u
+}
+
+// AddResolution adds v to the "resolution" field.
+func (u *GpuTelemetryUpsert) AddResolution(v int) *GpuTelemetryUpsert {
+	u.Add(gputelemetry.FieldResolution, v)
+	return u
+}
+
+// SetBucketAt sets the "bucket_at" field.
+func (u *GpuTelemetryUpsert) SetBucketAt(v time.Time) *GpuTelemetryUpsert {
+	u.Set(gputelemetry.FieldBucketAt, v)
+	return u
+}
+
+// UpdateBucketAt sets the "bucket_at" field to the value that was provided on create.
+func (u *GpuTelemetryUpsert) UpdateBucketAt() *GpuTelemetryUpsert {
+	u.SetExcluded(gputelemetry.FieldBucketAt)
+	return u
+}
+
+// SetSamples sets the "samples" field.
+func (u *GpuTelemetryUpsert) SetSamples(v int) *GpuTelemetryUpsert {
+	u.Set(gputelemetry.FieldSamples, v)
+	return u
+}
+
+// UpdateSamples sets the "samples" field to the value that was provided on create.
+func (u *GpuTelemetryUpsert) UpdateSamples() *GpuTelemetryUpsert {
+	u.SetExcluded(gputelemetry.FieldSamples)
+	return u
+}
+
+// AddSamples adds v to the "samples" field.
+func (u *GpuTelemetryUpsert) AddSamples(v int) *GpuTelemetryUpsert {
+	u.Add(gputelemetry.FieldSamples, v)
+	return u
+}
+
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (u *GpuTelemetryUpsert) SetUtilizationGpu(v float64) *GpuTelemetryUpsert {
+	u.Set(gputelemetry.FieldUtilizationGpu, v)
+	return u
+}
+
+// UpdateUtilizationGpu sets the "utilization_gpu" field to the value that was provided on create.
+func (u *GpuTelemetryUpsert) UpdateUtilizationGpu() *GpuTelemetryUpsert {
+	u.SetExcluded(gputelemetry.FieldUtilizationGpu)
+	return u
+}
+
+// AddUtilizationGpu adds v to the "utilization_gpu" field.
+func (u *GpuTelemetryUpsert) AddUtilizationGpu(v float64) *GpuTelemetryUpsert {
+	u.Add(gputelemetry.FieldUtilizationGpu, v)
+	return u
+}
+
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (u *GpuTelemetryUpsert) SetUtilizationMemory(v float64) *GpuTelemetryUpsert {
+	u.Set(gputelemetry.FieldUtilizationMemory, v)
+	return u
+}
+
+// UpdateUtilizationMemory sets the "utilization_memory" field to the value that was provided on create.
+func (u *GpuTelemetryUpsert) UpdateUtilizationMemory() *GpuTelemetryUpsert {
+	u.SetExcluded(gputelemetry.FieldUtilizationMemory)
+	return u
+}
+
+// AddUtilizationMemory adds v to the "utilization_memory" field.
+func (u *GpuTelemetryUpsert) AddUtilizationMemory(v float64) *GpuTelemetryUpsert {
+	u.Add(gputelemetry.FieldUtilizationMemory, v)
+	return u
+}
+
+// SetMemoryUsed sets the "memory_used" field.
+func (u *GpuTelemetryUpsert) SetMemoryUsed(v float64) *GpuTelemetryUpsert {
+	u.Set(gputelemetry.FieldMemoryUsed, v)
+	return u
+}
+
+// UpdateMemoryUsed sets the "memory_used" field to the value that was provided on create.
+func (u *GpuTelemetryUpsert) UpdateMemoryUsed() *GpuTelemetryUpsert {
+	u.SetExcluded(gputelemetry.FieldMemoryUsed)
+	return u
+}
+
+// AddMemoryUsed adds v to the "memory_used" field.
+func (u *GpuTelemetryUpsert) AddMemoryUsed(v float64) *GpuTelemetryUpsert {
+	u.Add(gputelemetry.FieldMemoryUsed, v)
+	return u
+}
+
+// SetMemoryTotal sets the "memory_total" field.
+func (u *GpuTelemetryUpsert) SetMemoryTotal(v float64) *GpuTelemetryUpsert {
+	u.Set(gputelemetry.FieldMemoryTotal, v)
+	return u
+}
+
+// UpdateMemoryTotal sets the "memory_total" field to the value that was provided on create.
+func (u *GpuTelemetryUpsert) UpdateMemoryTotal() *GpuTelemetryUpsert {
+	u.SetExcluded(gputelemetry.FieldMemoryTotal)
+	return u
+}
+
+// AddMemoryTotal adds v to the "memory_total" field.
+func (u *GpuTelemetryUpsert) AddMemoryTotal(v float64) *GpuTelemetryUpsert {
+	u.Add(gputelemetry.FieldMemoryTotal, v)
+	return u
+}
+
+// SetTemperature sets the "temperature" field.
+func (u *GpuTelemetryUpsert) SetTemperature(v float64) *GpuTelemetryUpsert {
+	u.Set(gputelemetry.FieldTemperature, v)
+	return u
+}
+
+// UpdateTemperature sets the "temperature" field to the value that was provided on create.
+func (u *GpuTelemetryUpsert) UpdateTemperature() *GpuTelemetryUpsert {
+	u.SetExcluded(gputelemetry.FieldTemperature)
+	return u
+}
+
+// AddTemperature adds v to the "temperature" field.
+func (u *GpuTelemetryUpsert) AddTemperature(v float64) *GpuTelemetryUpsert {
+	u.Add(gputelemetry.FieldTemperature, v)
+	return u
+}
+
+// SetPowerDraw sets the "power_draw" field.
+func (u *GpuTelemetryUpsert) SetPowerDraw(v float64) *GpuTelemetryUpsert {
+	u.Set(gputelemetry.FieldPowerDraw, v)
+	return u
+}
+
+// UpdatePowerDraw sets the "power_draw" field to the value that was provided on create.
+func (u *GpuTelemetryUpsert) UpdatePowerDraw() *GpuTelemetryUpsert {
+	u.SetExcluded(gputelemetry.FieldPowerDraw)
+	return u
+}
+
+// AddPowerDraw adds v to the "power_draw" field.
+func (u *GpuTelemetryUpsert) AddPowerDraw(v float64) *GpuTelemetryUpsert {
+	u.Add(gputelemetry.FieldPowerDraw, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GpuTelemetryUpsert) SetUpdatedAt(v time.Time) *GpuTelemetryUpsert {
+	u.Set(gputelemetry.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GpuTelemetryUpsert) UpdateUpdatedAt() *GpuTelemetryUpsert {
+	u.SetExcluded(gputelemetry.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.GpuTelemetry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(gputelemetry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GpuTelemetryUpsertOne) UpdateNewValues() *GpuTelemetryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(gputelemetry.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GpuTelemetry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GpuTelemetryUpsertOne) Ignore() *GpuTelemetryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GpuTelemetryUpsertOne) DoNothing() *GpuTelemetryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GpuTelemetryCreate.OnConflict
+// documentation for more info.
+func (u *GpuTelemetryUpsertOne) Update(set func(*GpuTelemetryUpsert)) *GpuTelemetryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GpuTelemetryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGpuID sets the "gpu_id" field.
+func (u *GpuTelemetryUpsertOne) SetGpuID(v int) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetGpuID(v)
+	})
+}
+
+// AddGpuID adds v to the "gpu_id" field.
+func (u *GpuTelemetryUpsertOne) AddGpuID(v int) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddGpuID(v)
+	})
+}
+
+// UpdateGpuID sets the "gpu_id" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertOne) UpdateGpuID() *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateGpuID()
+	})
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *GpuTelemetryUpsertOne) SetDeviceID(v int) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetDeviceID(v)
+	})
+}
+
+// AddDeviceID adds v to the "device_id" field.
+func (u *GpuTelemetryUpsertOne) AddDeviceID(v int) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddDeviceID(v)
+	})
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertOne) UpdateDeviceID() *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateDeviceID()
+	})
+}
+
+// SetResolution sets the "resolution" field.
+func (u *GpuTelemetryUpsertOne) SetResolution(v int) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetResolution(v)
+	})
+}
+
+// AddResolution adds v to the "resolution" field.
+func (u *GpuTelemetryUpsertOne) AddResolution(v int) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddResolution(v)
+	})
+}
+
+// UpdateResolution sets the "resolution" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertOne) UpdateResolution() *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateResolution()
+	})
+}
+
+// SetBucketAt sets the "bucket_at" field.
+func (u *GpuTelemetryUpsertOne) SetBucketAt(v time.Time) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetBucketAt(v)
+	})
+}
+
+// UpdateBucketAt sets the "bucket_at" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertOne) UpdateBucketAt() *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateBucketAt()
+	})
+}
+
+// SetSamples sets the "samples" field.
+func (u *GpuTelemetryUpsertOne) SetSamples(v int) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetSamples(v)
+	})
+}
+
+// AddSamples adds v to the "samples" field.
+func (u *GpuTelemetryUpsertOne) AddSamples(v int) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddSamples(v)
+	})
+}
+
+// UpdateSamples sets the "samples" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertOne) UpdateSamples() *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateSamples()
+	})
+}
+
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (u *GpuTelemetryUpsertOne) SetUtilizationGpu(v float64) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetUtilizationGpu(v)
+	})
+}
+
+// AddUtilizationGpu adds v to the "utilization_gpu" field.
+func (u *GpuTelemetryUpsertOne) AddUtilizationGpu(v float64) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddUtilizationGpu(v)
+	})
+}
+
+// UpdateUtilizationGpu sets the "utilization_gpu" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertOne) UpdateUtilizationGpu() *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateUtilizationGpu()
+	})
+}
+
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (u *GpuTelemetryUpsertOne) SetUtilizationMemory(v float64) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetUtilizationMemory(v)
+	})
+}
+
+// AddUtilizationMemory adds v to the "utilization_memory" field.
+func (u *GpuTelemetryUpsertOne) AddUtilizationMemory(v float64) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddUtilizationMemory(v)
+	})
+}
+
+// UpdateUtilizationMemory sets the "utilization_memory" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertOne) UpdateUtilizationMemory() *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateUtilizationMemory()
+	})
+}
+
+// SetMemoryUsed sets the "memory_used" field.
+func (u *GpuTelemetryUpsertOne) SetMemoryUsed(v float64) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetMemoryUsed(v)
+	})
+}
+
+// AddMemoryUsed adds v to the "memory_used" field.
+func (u *GpuTelemetryUpsertOne) AddMemoryUsed(v float64) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddMemoryUsed(v)
+	})
+}
+
+// UpdateMemoryUsed sets the "memory_used" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertOne) UpdateMemoryUsed() *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateMemoryUsed()
+	})
+}
+
+// SetMemoryTotal sets the "memory_total" field.
+func (u *GpuTelemetryUpsertOne) SetMemoryTotal(v float64) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetMemoryTotal(v)
+	})
+}
+
+// AddMemoryTotal adds v to the "memory_total" field.
+func (u *GpuTelemetryUpsertOne) AddMemoryTotal(v float64) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddMemoryTotal(v)
+	})
+}
+
+// UpdateMemoryTotal sets the "memory_total" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertOne) UpdateMemoryTotal() *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateMemoryTotal()
+	})
+}
+
+// SetTemperature sets the "temperature" field.
+func (u *GpuTelemetryUpsertOne) SetTemperature(v float64) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetTemperature(v)
+	})
+}
+
+// AddTemperature adds v to the "temperature" field.
+func (u *GpuTelemetryUpsertOne) AddTemperature(v float64) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddTemperature(v)
+	})
+}
+
+// UpdateTemperature sets the "temperature" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertOne) UpdateTemperature() *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateTemperature()
+	})
+}
+
+// SetPowerDraw sets the "power_draw" field.
+func (u *GpuTelemetryUpsertOne) SetPowerDraw(v float64) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetPowerDraw(v)
+	})
+}
+
+// AddPowerDraw adds v to the "power_draw" field.
+func (u *GpuTelemetryUpsertOne) AddPowerDraw(v float64) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddPowerDraw(v)
+	})
+}
+
+// UpdatePowerDraw sets the "power_draw" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertOne) UpdatePowerDraw() *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdatePowerDraw()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GpuTelemetryUpsertOne) SetUpdatedAt(v time.Time) *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertOne) UpdateUpdatedAt() *GpuTelemetryUpsertOne {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GpuTelemetryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GpuTelemetryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GpuTelemetryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GpuTelemetryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GpuTelemetryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GpuTelemetryCreateBulk is the builder for creating many GpuTelemetry entities in bulk.
+type GpuTelemetryCreateBulk struct {
+	config
+	err      error
+	builders []*GpuTelemetryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GpuTelemetry entities in the database.
+func (gtcb *GpuTelemetryCreateBulk) Save(ctx context.Context) ([]*GpuTelemetry, error) {
+	if gtcb.err != nil {
+		return nil, gtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gtcb.builders))
+	nodes := make([]*GpuTelemetry, len(gtcb.builders))
+	mutators := make([]Mutator, len(gtcb.builders))
+	for i := range gtcb.builders {
+		func(i int, root context.Context) {
+			builder := gtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GpuTelemetryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = gtcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gtcb *GpuTelemetryCreateBulk) SaveX(ctx context.Context) []*GpuTelemetry {
+	v, err := gtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gtcb *GpuTelemetryCreateBulk) Exec(ctx context.Context) error {
+	_, err := gtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtcb *GpuTelemetryCreateBulk) ExecX(ctx context.Context) {
+	if err := gtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GpuTelemetry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GpuTelemetryUpsert) {
+//			SetGpuID(v+v).
+//		}).
+//		Exec(ctx)
+func (gtcb *GpuTelemetryCreateBulk) OnConflict(opts ...sql.ConflictOption) *GpuTelemetryUpsertBulk {
+	gtcb.conflict = opts
+	return &GpuTelemetryUpsertBulk{
+		create: gtcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GpuTelemetry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (gtcb *GpuTelemetryCreateBulk) OnConflictColumns(columns ...string) *GpuTelemetryUpsertBulk {
+	gtcb.conflict = append(gtcb.conflict, sql.ConflictColumns(columns...))
+	return &GpuTelemetryUpsertBulk{
+		create: gtcb,
+	}
+}
+
+// GpuTelemetryUpsertBulk is the builder for "upsert"-ing
+// a bulk of GpuTelemetry nodes.
+type GpuTelemetryUpsertBulk struct {
+	create *GpuTelemetryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GpuTelemetry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(gputelemetry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GpuTelemetryUpsertBulk) UpdateNewValues() *GpuTelemetryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(gputelemetry.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GpuTelemetry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GpuTelemetryUpsertBulk) Ignore() *GpuTelemetryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GpuTelemetryUpsertBulk) DoNothing() *GpuTelemetryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GpuTelemetryCreateBulk.OnConflict
+// documentation for more info.
+func (u *GpuTelemetryUpsertBulk) Update(set func(*GpuTelemetryUpsert)) *GpuTelemetryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GpuTelemetryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGpuID sets the "gpu_id" field.
+func (u *GpuTelemetryUpsertBulk) SetGpuID(v int) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetGpuID(v)
+	})
+}
+
+// AddGpuID adds v to the "gpu_id" field.
+func (u *GpuTelemetryUpsertBulk) AddGpuID(v int) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddGpuID(v)
+	})
+}
+
+// UpdateGpuID sets the "gpu_id" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertBulk) UpdateGpuID() *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateGpuID()
+	})
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *GpuTelemetryUpsertBulk) SetDeviceID(v int) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetDeviceID(v)
+	})
+}
+
+// AddDeviceID adds v to the "device_id" field.
+func (u *GpuTelemetryUpsertBulk) AddDeviceID(v int) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddDeviceID(v)
+	})
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertBulk) UpdateDeviceID() *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateDeviceID()
+	})
+}
+
+// SetResolution sets the "resolution" field.
+func (u *GpuTelemetryUpsertBulk) SetResolution(v int) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetResolution(v)
+	})
+}
+
+// AddResolution adds v to the "resolution" field.
+func (u *GpuTelemetryUpsertBulk) AddResolution(v int) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddResolution(v)
+	})
+}
+
+// UpdateResolution sets the "resolution" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertBulk) UpdateResolution() *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateResolution()
+	})
+}
+
+// SetBucketAt sets the "bucket_at" field.
+func (u *GpuTelemetryUpsertBulk) SetBucketAt(v time.Time) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetBucketAt(v)
+	})
+}
+
+// UpdateBucketAt sets the "bucket_at" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertBulk) UpdateBucketAt() *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateBucketAt()
+	})
+}
+
+// SetSamples sets the "samples" field.
+func (u *GpuTelemetryUpsertBulk) SetSamples(v int) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetSamples(v)
+	})
+}
+
+// AddSamples adds v to the "samples" field.
+func (u *GpuTelemetryUpsertBulk) AddSamples(v int) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddSamples(v)
+	})
+}
+
+// UpdateSamples sets the "samples" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertBulk) UpdateSamples() *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateSamples()
+	})
+}
+
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (u *GpuTelemetryUpsertBulk) SetUtilizationGpu(v float64) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetUtilizationGpu(v)
+	})
+}
+
+// AddUtilizationGpu adds v to the "utilization_gpu" field.
+func (u *GpuTelemetryUpsertBulk) AddUtilizationGpu(v float64) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddUtilizationGpu(v)
+	})
+}
+
+// UpdateUtilizationGpu sets the "utilization_gpu" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertBulk) UpdateUtilizationGpu() *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateUtilizationGpu()
+	})
+}
+
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (u *GpuTelemetryUpsertBulk) SetUtilizationMemory(v float64) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetUtilizationMemory(v)
+	})
+}
+
+// AddUtilizationMemory adds v to the "utilization_memory" field.
+func (u *GpuTelemetryUpsertBulk) AddUtilizationMemory(v float64) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddUtilizationMemory(v)
+	})
+}
+
+// UpdateUtilizationMemory sets the "utilization_memory" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertBulk) UpdateUtilizationMemory() *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateUtilizationMemory()
+	})
+}
+
+// SetMemoryUsed sets the "memory_used" field.
+func (u *GpuTelemetryUpsertBulk) SetMemoryUsed(v float64) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetMemoryUsed(v)
+	})
+}
+
+// AddMemoryUsed adds v to the "memory_used" field.
+func (u *GpuTelemetryUpsertBulk) AddMemoryUsed(v float64) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddMemoryUsed(v)
+	})
+}
+
+// UpdateMemoryUsed sets the "memory_used" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertBulk) UpdateMemoryUsed() *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateMemoryUsed()
+	})
+}
+
+// SetMemoryTotal sets the "memory_total" field.
+func (u *GpuTelemetryUpsertBulk) SetMemoryTotal(v float64) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetMemoryTotal(v)
+	})
+}
+
+// AddMemoryTotal adds v to the "memory_total" field.
+func (u *GpuTelemetryUpsertBulk) AddMemoryTotal(v float64) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddMemoryTotal(v)
+	})
+}
+
+// UpdateMemoryTotal sets the "memory_total" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertBulk) UpdateMemoryTotal() *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateMemoryTotal()
+	})
+}
+
+// SetTemperature sets the "temperature" field.
+func (u *GpuTelemetryUpsertBulk) SetTemperature(v float64) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetTemperature(v)
+	})
+}
+
+// AddTemperature adds v to the "temperature" field.
+func (u *GpuTelemetryUpsertBulk) AddTemperature(v float64) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddTemperature(v)
+	})
+}
+
+// UpdateTemperature sets the "temperature" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertBulk) UpdateTemperature() *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateTemperature()
+	})
+}
+
+// SetPowerDraw sets the "power_draw" field.
+func (u *GpuTelemetryUpsertBulk) SetPowerDraw(v float64) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetPowerDraw(v)
+	})
+}
+
+// AddPowerDraw adds v to the "power_draw" field.
+func (u *GpuTelemetryUpsertBulk) AddPowerDraw(v float64) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.AddPowerDraw(v)
+	})
+}
+
+// UpdatePowerDraw sets the "power_draw" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertBulk) UpdatePowerDraw() *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdatePowerDraw()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GpuTelemetryUpsertBulk) SetUpdatedAt(v time.Time) *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GpuTelemetryUpsertBulk) UpdateUpdatedAt() *GpuTelemetryUpsertBulk {
+	return u.Update(func(s *GpuTelemetryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *GpuTelemetryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GpuTelemetryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GpuTelemetryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GpuTelemetryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
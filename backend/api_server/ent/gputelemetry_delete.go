@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/gputelemetry"
+	"api_server/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GpuTelemetryDelete is the builder for deleting a GpuTelemetry entity.
+type GpuTelemetryDelete struct {
+	config
+	hooks    []Hook
+	mutation *GpuTelemetryMutation
+}
+
+// Where appends a list predicates to the GpuTelemetryDelete builder.
+func (gtd *GpuTelemetryDelete) Where(ps ...predicate.GpuTelemetry) *GpuTelemetryDelete {
+	gtd.mutation.Where(ps...)
+	return gtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gtd *GpuTelemetryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gtd.sqlExec, gtd.mutation, gtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtd *GpuTelemetryDelete) ExecX(ctx context.Context) int {
+	n, err := gtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gtd *GpuTelemetryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(gputelemetry.Table, sqlgraph.NewFieldSpec(gputelemetry.FieldID, field.TypeInt))
+	if ps := gtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gtd.mutation.done = true
+	return affected, err
+}
+
+// GpuTelemetryDeleteOne is the builder for deleting a single GpuTelemetry entity.
+type GpuTelemetryDeleteOne struct {
+	gtd *GpuTelemetryDelete
+}
+
+// Where appends a list predicates to the GpuTelemetryDelete builder.
+func (gtdo *GpuTelemetryDeleteOne) Where(ps ...predicate.GpuTelemetry) *GpuTelemetryDeleteOne {
+	gtdo.gtd.mutation.Where(ps...)
+	return gtdo
+}
+
+// Exec executes the deletion query.
+func (gtdo *GpuTelemetryDeleteOne) Exec(ctx context.Context) error {
+	n, err := gtdo.gtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{gputelemetry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtdo *GpuTelemetryDeleteOne) ExecX(ctx context.Context) {
+	if err := gtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/gputelemetry"
+	"api_server/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GpuTelemetryQuery is the builder for querying GpuTelemetry entities.
+type GpuTelemetryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []gputelemetry.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GpuTelemetry
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GpuTelemetryQuery builder.
+func (gtq *GpuTelemetryQuery) Where(ps ...predicate.GpuTelemetry) *GpuTelemetryQuery {
+	gtq.predicates = append(gtq.predicates, ps...)
+	return gtq
+}
+
+// Limit the number of records to be returned by this query.
+func (gtq *GpuTelemetryQuery) Limit(limit int) *GpuTelemetryQuery {
+	gtq.ctx.Limit = &limit
+	return gtq
+}
+
+// Offset to start from.
+func (gtq *GpuTelemetryQuery) Offset(offset int) *GpuTelemetryQuery {
+	gtq.ctx.Offset = &offset
+	return gtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gtq *GpuTelemetryQuery) Unique(unique bool) *GpuTelemetryQuery {
+	gtq.ctx.Unique = &unique
+	return gtq
+}
+
+// Order specifies how the records should be ordered.
+func (gtq *GpuTelemetryQuery) Order(o ...gputelemetry.OrderOption) *GpuTelemetryQuery {
+	gtq.order = append(gtq.order, o...)
+	return gtq
+}
+
+// First returns the first GpuTelemetry entity from the query.
+// Returns a *NotFoundError when no GpuTelemetry was found.
+func (gtq *GpuTelemetryQuery) First(ctx context.Context) (*GpuTelemetry, error) {
+	nodes, err := gtq.Limit(1).All(setContextOp(ctx, gtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{gputelemetry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gtq *GpuTelemetryQuery) FirstX(ctx context.Context) *GpuTelemetry {
+	node, err := gtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GpuTelemetry ID from the query.
+// Returns a *NotFoundError when no GpuTelemetry ID was found.
+func (gtq *GpuTelemetryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gtq.Limit(1).IDs(setContextOp(ctx, gtq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{gputelemetry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gtq *GpuTelemetryQuery) FirstIDX(ctx context.Context) int {
+	id, err := gtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GpuTelemetry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GpuTelemetry entity is found.
+// Returns a *NotFoundError when no GpuTelemetry entities are found.
+func (gtq *GpuTelemetryQuery) Only(ctx context.Context) (*GpuTelemetry, error) {
+	nodes, err := gtq.Limit(2).All(setContextOp(ctx, gtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{gputelemetry.Label}
+	default:
+		return nil, &NotSingularError{gputelemetry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gtq *GpuTelemetryQuery) OnlyX(ctx context.Context) *GpuTelemetry {
+	node, err := gtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GpuTelemetry ID in the query.
+// Returns a *NotSingularError when more than one GpuTelemetry ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gtq *GpuTelemetryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gtq.Limit(2).IDs(setContextOp(ctx, gtq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{gputelemetry.Label}
+	default:
+		err = &NotSingularError{gputelemetry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gtq *GpuTelemetryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := gtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GpuTelemetries.
+func (gtq *GpuTelemetryQuery) All(ctx context.Context) ([]*GpuTelemetry, error) {
+	ctx = setContextOp(ctx, gtq.ctx, ent.OpQueryAll)
+	if err := gtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GpuTelemetry, *GpuTelemetryQuery]()
+	return withInterceptors[[]*GpuTelemetry](ctx, gtq, qr, gtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gtq *GpuTelemetryQuery) AllX(ctx context.Context) []*GpuTelemetry {
+	nodes, err := gtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GpuTelemetry IDs.
+func (gtq *GpuTelemetryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if gtq.ctx.Unique == nil && gtq.path != nil {
+		gtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gtq.ctx, ent.OpQueryIDs)
+	if err = gtq.Select(gputelemetry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gtq *GpuTelemetryQuery) IDsX(ctx context.Context) []int {
+	ids, err := gtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gtq *GpuTelemetryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gtq.ctx, ent.OpQueryCount)
+	if err := gtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gtq, querierCount[*GpuTelemetryQuery](), gtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gtq *GpuTelemetryQuery) CountX(ctx context.Context) int {
+	count, err := gtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gtq *GpuTelemetryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gtq.ctx, ent.OpQueryExist)
+	switch _, err := gtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gtq *GpuTelemetryQuery) ExistX(ctx context.Context) bool {
+	exist, err := gtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GpuTelemetryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gtq *GpuTelemetryQuery) Clone() *GpuTelemetryQuery {
+	if gtq == nil {
+		return nil
+	}
+	return &GpuTelemetryQuery{
+		config:     gtq.config,
+		ctx:        gtq.ctx.Clone(),
+		order:      append([]gputelemetry.OrderOption{}, gtq.order...),
+		inters:     append([]Interceptor{}, gtq.inters...),
+		predicates: append([]predicate.GpuTelemetry{}, gtq.predicates...),
+		// clone intermediate query.
+		sql:       gtq.sql.Clone(),
+		path:      gtq.path,
+		modifiers: append([]func(*sql.Selector){}, gtq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GpuID int `json:"gpu_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GpuTelemetry.Query().
+//		GroupBy(gputelemetry.FieldGpuID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gtq *GpuTelemetryQuery) GroupBy(field string, fields ...string) *GpuTelemetryGroupBy {
+	gtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GpuTelemetryGroupBy{build: gtq}
+	grbuild.flds = &gtq.ctx.Fields
+	grbuild.label = gputelemetry.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GpuID int `json:"gpu_id,omitempty"`
+//	}
+//
+//	client.GpuTelemetry.Query().
+//		Select(gputelemetry.FieldGpuID).
+//		Scan(ctx, &v)
+func (gtq *GpuTelemetryQuery) Select(fields ...string) *GpuTelemetrySelect {
+	gtq.ctx.Fields = append(gtq.ctx.Fields, fields...)
+	sbuild := &GpuTelemetrySelect{GpuTelemetryQuery: gtq}
+	sbuild.label = gputelemetry.Label
+	sbuild.flds, sbuild.scan = &gtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GpuTelemetrySelect configured with the given aggregations.
+func (gtq *GpuTelemetryQuery) Aggregate(fns ...AggregateFunc) *GpuTelemetrySelect {
+	return gtq.Select().Aggregate(fns...)
+}
+
+func (gtq *GpuTelemetryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gtq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gtq.ctx.Fields {
+		if !gputelemetry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gtq.path != nil {
+		prev, err := gtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gtq.sql = prev
+	}
+	return nil
+}
+
+func (gtq *GpuTelemetryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GpuTelemetry, error) {
+	var (
+		nodes = []*GpuTelemetry{}
+		_spec = gtq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GpuTelemetry).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GpuTelemetry{config: gtq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(gtq.modifiers) > 0 {
+		_spec.Modifiers = gtq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (gtq *GpuTelemetryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gtq.querySpec()
+	if len(gtq.modifiers) > 0 {
+		_spec.Modifiers = gtq.modifiers
+	}
+	_spec.Node.Columns = gtq.ctx.Fields
+	if len(gtq.ctx.Fields) > 0 {
+		_spec.Unique = gtq.ctx.Unique != nil && *gtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gtq.driver, _spec)
+}
+
+func (gtq *GpuTelemetryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(gputelemetry.Table, gputelemetry.Columns, sqlgraph.NewFieldSpec(gputelemetry.FieldID, field.TypeInt))
+	_spec.From = gtq.sql
+	if unique := gtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gputelemetry.FieldID)
+		for i := range fields {
+			if fields[i] != gputelemetry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gtq *GpuTelemetryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gtq.driver.Dialect())
+	t1 := builder.Table(gputelemetry.Table)
+	columns := gtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = gputelemetry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gtq.sql != nil {
+		selector = gtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gtq.ctx.Unique != nil && *gtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range gtq.modifiers {
+		m(selector)
+	}
+	for _, p := range gtq.predicates {
+		p(selector)
+	}
+	for _, p := range gtq.order {
+		p(selector)
+	}
+	if offset := gtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (gtq *GpuTelemetryQuery) Modify(modifiers ...func(s *sql.Selector)) *GpuTelemetrySelect {
+	gtq.modifiers = append(gtq.modifiers, modifiers...)
+	return gtq.Select()
+}
+
+// GpuTelemetryGroupBy is the group-by builder for GpuTelemetry entities.
+type GpuTelemetryGroupBy struct {
+	selector
+	build *GpuTelemetryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gtgb *GpuTelemetryGroupBy) Aggregate(fns ...AggregateFunc) *GpuTelemetryGroupBy {
+	gtgb.fns = append(gtgb.fns, fns...)
+	return gtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gtgb *GpuTelemetryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := gtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GpuTelemetryQuery, *GpuTelemetryGroupBy](ctx, gtgb.build, gtgb, gtgb.build.inters, v)
+}
+
+func (gtgb *GpuTelemetryGroupBy) sqlScan(ctx context.Context, root *GpuTelemetryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gtgb.fns))
+	for _, fn := range gtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gtgb.flds)+len(gtgb.fns))
+		for _, f := range *gtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GpuTelemetrySelect is the builder for selecting fields of GpuTelemetry entities.
+type GpuTelemetrySelect struct {
+	*GpuTelemetryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gts *GpuTelemetrySelect) Aggregate(fns ...AggregateFunc) *GpuTelemetrySelect {
+	gts.fns = append(gts.fns, fns...)
+	return gts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gts *GpuTelemetrySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gts.ctx, ent.OpQuerySelect)
+	if err := gts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GpuTelemetryQuery, *GpuTelemetrySelect](ctx, gts.GpuTelemetryQuery, gts, gts.inters, v)
+}
+
+func (gts *GpuTelemetrySelect) sqlScan(ctx context.Context, root *GpuTelemetryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gts.fns))
+	for _, fn := range gts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (gts *GpuTelemetrySelect) Modify(modifiers ...func(s *sql.Selector)) *GpuTelemetrySelect {
+	gts.modifiers = append(gts.modifiers, modifiers...)
+	return gts
+}
@@ -0,0 +1,802 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/gputelemetry"
+	"api_server/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GpuTelemetryUpdate is the builder for updating GpuTelemetry entities.
+type GpuTelemetryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *GpuTelemetryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the GpuTelemetryUpdate builder.
+func (gtu *GpuTelemetryUpdate) Where(ps ...predicate.GpuTelemetry) *GpuTelemetryUpdate {
+	gtu.mutation.Where(ps...)
+	return gtu
+}
+
+// SetGpuID sets the "gpu_id" field.
+func (gtu *GpuTelemetryUpdate) SetGpuID(i int) *GpuTelemetryUpdate {
+	gtu.mutation.ResetGpuID()
+	gtu.mutation.SetGpuID(i)
+	return gtu
+}
+
+// SetNillableGpuID sets the "gpu_id" field if the given value is not nil.
+func (gtu *GpuTelemetryUpdate) SetNillableGpuID(i *int) *GpuTelemetryUpdate {
+	if i != nil {
+		gtu.SetGpuID(*i)
+	}
+	return gtu
+}
+
+// AddGpuID adds i to the "gpu_id" field.
+func (gtu *GpuTelemetryUpdate) AddGpuID(i int) *GpuTelemetryUpdate {
+	gtu.mutation.AddGpuID(i)
+	return gtu
+}
+
+// SetDeviceID sets the "device_id" field.
+func (gtu *GpuTelemetryUpdate) SetDeviceID(i int) *GpuTelemetryUpdate {
+	gtu.mutation.ResetDeviceID()
+	gtu.mutation.SetDeviceID(i)
+	return gtu
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (gtu *GpuTelemetryUpdate) SetNillableDeviceID(i *int) *GpuTelemetryUpdate {
+	if i != nil {
+		gtu.SetDeviceID(*i)
+	}
+	return gtu
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (gtu *GpuTelemetryUpdate) AddDeviceID(i int) *GpuTelemetryUpdate {
+	gtu.mutation.AddDeviceID(i)
+	return gtu
+}
+
+// SetResolution sets the "resolution" field.
+func (gtu *GpuTelemetryUpdate) SetResolution(i int) *GpuTelemetryUpdate {
+	gtu.mutation.ResetResolution()
+	gtu.mutation.SetResolution(i)
+	return gtu
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (gtu *GpuTelemetryUpdate) SetNillableResolution(i *int) *GpuTelemetryUpdate {
+	if i != nil {
+		gtu.SetResolution(*i)
+	}
+	return gtu
+}
+
+// AddResolution adds i to the "resolution" field.
+func (gtu *GpuTelemetryUpdate) AddResolution(i int) *GpuTelemetryUpdate {
+	gtu.mutation.AddResolution(i)
+	return gtu
+}
+
+// SetBucketAt sets the "bucket_at" field.
+func (gtu *GpuTelemetryUpdate) SetBucketAt(t time.Time) *GpuTelemetryUpdate {
+	gtu.mutation.SetBucketAt(t)
+	return gtu
+}
+
+// SetNillableBucketAt sets the "bucket_at" field if the given value is not nil.
+func (gtu *GpuTelemetryUpdate) SetNillableBucketAt(t *time.Time) *GpuTelemetryUpdate {
+	if t != nil {
+		gtu.SetBucketAt(*t)
+	}
+	return gtu
+}
+
+// SetSamples sets the "samples" field.
+func (gtu *GpuTelemetryUpdate) SetSamples(i int) *GpuTelemetryUpdate {
+	gtu.mutation.ResetSamples()
+	gtu.mutation.SetSamples(i)
+	return gtu
+}
+
+// SetNillableSamples sets the "samples" field if the given value is not nil.
+func (gtu *GpuTelemetryUpdate) SetNillableSamples(i *int) *GpuTelemetryUpdate {
+	if i != nil {
+		gtu.SetSamples(*i)
+	}
+	return gtu
+}
+
+// AddSamples adds i to the "samples" field.
+func (gtu *GpuTelemetryUpdate) AddSamples(i int) *GpuTelemetryUpdate {
+	gtu.mutation.AddSamples(i)
+	return gtu
+}
+
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (gtu *GpuTelemetryUpdate) SetUtilizationGpu(f float64) *GpuTelemetryUpdate {
+	gtu.mutation.ResetUtilizationGpu()
+	gtu.mutation.SetUtilizationGpu(f)
+	return gtu
+}
+
+// SetNillableUtilizationGpu sets the "utilization_gpu" field if the given value is not nil.
+func (gtu *GpuTelemetryUpdate) SetNillableUtilizationGpu(f *float64) *GpuTelemetryUpdate {
+	if f != nil {
+		gtu.SetUtilizationGpu(*f)
+	}
+	return gtu
+}
+
+// AddUtilizationGpu adds f to the "utilization_gpu" field.
+func (gtu *GpuTelemetryUpdate) AddUtilizationGpu(f float64) *GpuTelemetryUpdate {
+	gtu.mutation.AddUtilizationGpu(f)
+	return gtu
+}
+
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (gtu *GpuTelemetryUpdate) SetUtilizationMemory(f float64) *GpuTelemetryUpdate {
+	gtu.mutation.ResetUtilizationMemory()
+	gtu.mutation.SetUtilizationMemory(f)
+	return gtu
+}
+
+// SetNillableUtilizationMemory sets the "utilization_memory" field if the given value is not nil.
+func (gtu *GpuTelemetryUpdate) SetNillableUtilizationMemory(f *float64) *GpuTelemetryUpdate {
+	if f != nil {
+		gtu.SetUtilizationMemory(*f)
+	}
+	return gtu
+}
+
+// AddUtilizationMemory adds f to the "utilization_memory" field.
+func (gtu *GpuTelemetryUpdate) AddUtilizationMemory(f float64) *GpuTelemetryUpdate {
+	gtu.mutation.AddUtilizationMemory(f)
+	return gtu
+}
+
+// SetMemoryUsed sets the "memory_used" field.
+func (gtu *GpuTelemetryUpdate) SetMemoryUsed(f float64) *GpuTelemetryUpdate {
+	gtu.mutation.ResetMemoryUsed()
+	gtu.mutation.SetMemoryUsed(f)
+	return gtu
+}
+
+// SetNillableMemoryUsed sets the "memory_used" field if the given value is not nil.
+func (gtu *GpuTelemetryUpdate) SetNillableMemoryUsed(f *float64) *GpuTelemetryUpdate {
+	if f != nil {
+		gtu.SetMemoryUsed(*f)
+	}
+	return gtu
+}
+
+// AddMemoryUsed adds f to the "memory_used" field.
+func (gtu *GpuTelemetryUpdate) AddMemoryUsed(f float64) *GpuTelemetryUpdate {
+	gtu.mutation.AddMemoryUsed(f)
+	return gtu
+}
+
+// SetMemoryTotal sets the "memory_total" field.
+func (gtu *GpuTelemetryUpdate) SetMemoryTotal(f float64) *GpuTelemetryUpdate {
+	gtu.mutation.ResetMemoryTotal()
+	gtu.mutation.SetMemoryTotal(f)
+	return gtu
+}
+
+// SetNillableMemoryTotal sets the "memory_total" field if the given value is not nil.
+func (gtu *GpuTelemetryUpdate) SetNillableMemoryTotal(f *float64) *GpuTelemetryUpdate {
+	if f != nil {
+		gtu.SetMemoryTotal(*f)
+	}
+	return gtu
+}
+
+// AddMemoryTotal adds f to the "memory_total" field.
+func (gtu *GpuTelemetryUpdate) AddMemoryTotal(f float64) *GpuTelemetryUpdate {
+	gtu.mutation.AddMemoryTotal(f)
+	return gtu
+}
+
+// SetTemperature sets the "temperature" field.
+func (gtu *GpuTelemetryUpdate) SetTemperature(f float64) *GpuTelemetryUpdate {
+	gtu.mutation.ResetTemperature()
+	gtu.mutation.SetTemperature(f)
+	return gtu
+}
+
+// SetNillableTemperature sets the "temperature" field if the given value is not nil.
+func (gtu *GpuTelemetryUpdate) SetNillableTemperature(f *float64) *GpuTelemetryUpdate {
+	if f != nil {
+		gtu.SetTemperature(*f)
+	}
+	return gtu
+}
+
+// AddTemperature adds f to the "temperature" field.
+func (gtu *GpuTelemetryUpdate) AddTemperature(f float64) *GpuTelemetryUpdate {
+	gtu.mutation.AddTemperature(f)
+	return gtu
+}
+
+// SetPowerDraw sets the "power_draw" field.
+func (gtu *GpuTelemetryUpdate) SetPowerDraw(f float64) *GpuTelemetryUpdate {
+	gtu.mutation.ResetPowerDraw()
+	gtu.mutation.SetPowerDraw(f)
+	return gtu
+}
+
+// SetNillablePowerDraw sets the "power_draw" field if the given value is not nil.
+func (gtu *GpuTelemetryUpdate) SetNillablePowerDraw(f *float64) *GpuTelemetryUpdate {
+	if f != nil {
+		gtu.SetPowerDraw(*f)
+	}
+	return gtu
+}
+
+// AddPowerDraw adds f to the "power_draw" field.
+func (gtu *GpuTelemetryUpdate) AddPowerDraw(f float64) *GpuTelemetryUpdate {
+	gtu.mutation.AddPowerDraw(f)
+	return gtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gtu *GpuTelemetryUpdate) SetUpdatedAt(t time.Time) *GpuTelemetryUpdate {
+	gtu.mutation.SetUpdatedAt(t)
+	return gtu
+}
+
+// Mutation returns the GpuTelemetryMutation object of the builder.
+func (gtu *GpuTelemetryUpdate) Mutation() *GpuTelemetryMutation {
+	return gtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gtu *GpuTelemetryUpdate) Save(ctx context.Context) (int, error) {
+	gtu.defaults()
+	return withHooks(ctx, gtu.sqlSave, gtu.mutation, gtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gtu *GpuTelemetryUpdate) SaveX(ctx context.Context) int {
+	affected, err := gtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gtu *GpuTelemetryUpdate) Exec(ctx context.Context) error {
+	_, err := gtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtu *GpuTelemetryUpdate) ExecX(ctx context.Context) {
+	if err := gtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gtu *GpuTelemetryUpdate) defaults() {
+	if _, ok := gtu.mutation.UpdatedAt(); !ok {
+		v := gputelemetry.UpdateDefaultUpdatedAt()
+		gtu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (gtu *GpuTelemetryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GpuTelemetryUpdate {
+	gtu.modifiers = append(gtu.modifiers, modifiers...)
+	return gtu
+}
+
+func (gtu *GpuTelemetryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(gputelemetry.Table, gputelemetry.Columns, sqlgraph.NewFieldSpec(gputelemetry.FieldID, field.TypeInt))
+	if ps := gtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gtu.mutation.GpuID(); ok {
+		_spec.SetField(gputelemetry.FieldGpuID, field.TypeInt, value)
+	}
+	if value, ok := gtu.mutation.AddedGpuID(); ok {
+		_spec.AddField(gputelemetry.FieldGpuID, field.TypeInt, value)
+	}
+	if value, ok := gtu.mutation.DeviceID(); ok {
+		_spec.SetField(gputelemetry.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := gtu.mutation.AddedDeviceID(); ok {
+		_spec.AddField(gputelemetry.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := gtu.mutation.Resolution(); ok {
+		_spec.SetField(gputelemetry.FieldResolution, field.TypeInt, value)
+	}
+	if value, ok := gtu.mutation.AddedResolution(); ok {
+		_spec.AddField(gputelemetry.FieldResolution, field.TypeInt, value)
+	}
+	if value, ok := gtu.mutation.BucketAt(); ok {
+		_spec.SetField(gputelemetry.FieldBucketAt, field.TypeTime, value)
+	}
+	if value, ok := gtu.mutation.Samples(); ok {
+		_spec.SetField(gputelemetry.FieldSamples, field.TypeInt, value)
+	}
+	if value, ok := gtu.mutation.AddedSamples(); ok {
+		_spec.AddField(gputelemetry.FieldSamples, field.TypeInt, value)
+	}
+	if value, ok := gtu.mutation.UtilizationGpu(); ok {
+		_spec.SetField(gputelemetry.FieldUtilizationGpu, field.TypeFloat64, value)
+	}
+	if value, ok := gtu.mutation.AddedUtilizationGpu(); ok {
+		_spec.AddField(gputelemetry.FieldUtilizationGpu, field.TypeFloat64, value)
+	}
+	if value, ok := gtu.mutation.UtilizationMemory(); ok {
+		_spec.SetField(gputelemetry.FieldUtilizationMemory, field.TypeFloat64, value)
+	}
+	if value, ok := gtu.mutation.AddedUtilizationMemory(); ok {
+		_spec.AddField(gputelemetry.FieldUtilizationMemory, field.TypeFloat64, value)
+	}
+	if value, ok := gtu.mutation.MemoryUsed(); ok {
+		_spec.SetField(gputelemetry.FieldMemoryUsed, field.TypeFloat64, value)
+	}
+	if value, ok := gtu.mutation.AddedMemoryUsed(); ok {
+		_spec.AddField(gputelemetry.FieldMemoryUsed, field.TypeFloat64, value)
+	}
+	if value, ok := gtu.mutation.MemoryTotal(); ok {
+		_spec.SetField(gputelemetry.FieldMemoryTotal, field.TypeFloat64, value)
+	}
+	if value, ok := gtu.mutation.AddedMemoryTotal(); ok {
+		_spec.AddField(gputelemetry.FieldMemoryTotal, field.TypeFloat64, value)
+	}
+	if value, ok := gtu.mutation.Temperature(); ok {
+		_spec.SetField(gputelemetry.FieldTemperature, field.TypeFloat64, value)
+	}
+	if value, ok := gtu.mutation.AddedTemperature(); ok {
+		_spec.AddField(gputelemetry.FieldTemperature, field.TypeFloat64, value)
+	}
+	if value, ok := gtu.mutation.PowerDraw(); ok {
+		_spec.SetField(gputelemetry.FieldPowerDraw, field.TypeFloat64, value)
+	}
+	if value, ok := gtu.mutation.AddedPowerDraw(); ok {
+		_spec.AddField(gputelemetry.FieldPowerDraw, field.TypeFloat64, value)
+	}
+	if value, ok := gtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(gputelemetry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(gtu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, gtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gputelemetry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gtu.mutation.done = true
+	return n, nil
+}
+
+// GpuTelemetryUpdateOne is the builder for updating a single GpuTelemetry entity.
+type GpuTelemetryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *GpuTelemetryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetGpuID sets the "gpu_id" field.
+func (gtuo *GpuTelemetryUpdateOne) SetGpuID(i int) *GpuTelemetryUpdateOne {
+	gtuo.mutation.ResetGpuID()
+	gtuo.mutation.SetGpuID(i)
+	return gtuo
+}
+
+// SetNillableGpuID sets the "gpu_id" field if the given value is not nil.
+func (gtuo *GpuTelemetryUpdateOne) SetNillableGpuID(i *int) *GpuTelemetryUpdateOne {
+	if i != nil {
+		gtuo.SetGpuID(*i)
+	}
+	return gtuo
+}
+
+// AddGpuID adds i to the "gpu_id" field.
+func (gtuo *GpuTelemetryUpdateOne) AddGpuID(i int) *GpuTelemetryUpdateOne {
+	gtuo.mutation.AddGpuID(i)
+	return gtuo
+}
+
+// SetDeviceID sets the "device_id" field.
+func (gtuo *GpuTelemetryUpdateOne) SetDeviceID(i int) *GpuTelemetryUpdateOne {
+	gtuo.mutation.ResetDeviceID()
+	gtuo.mutation.SetDeviceID(i)
+	return gtuo
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (gtuo *GpuTelemetryUpdateOne) SetNillableDeviceID(i *int) *GpuTelemetryUpdateOne {
+	if i != nil {
+		gtuo.SetDeviceID(*i)
+	}
+	return gtuo
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (gtuo *GpuTelemetryUpdateOne) AddDeviceID(i int) *GpuTelemetryUpdateOne {
+	gtuo.mutation.AddDeviceID(i)
+	return gtuo
+}
+
+// SetResolution sets the "resolution" field.
+func (gtuo *GpuTelemetryUpdateOne) SetResolution(i int) *GpuTelemetryUpdateOne {
+	gtuo.mutation.ResetResolution()
+	gtuo.mutation.SetResolution(i)
+	return gtuo
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (gtuo *GpuTelemetryUpdateOne) SetNillableResolution(i *int) *GpuTelemetryUpdateOne {
+	if i != nil {
+		gtuo.SetResolution(*i)
+	}
+	return gtuo
+}
+
+// AddResolution adds i to the "resolution" field.
+func (gtuo *GpuTelemetryUpdateOne) AddResolution(i int) *GpuTelemetryUpdateOne {
+	gtuo.mutation.AddResolution(i)
+	return gtuo
+}
+
+// SetBucketAt sets the "bucket_at" field.
+func (gtuo *GpuTelemetryUpdateOne) SetBucketAt(t time.Time) *GpuTelemetryUpdateOne {
+	gtuo.mutation.SetBucketAt(t)
+	return gtuo
+}
+
+// SetNillableBucketAt sets the "bucket_at" field if the given value is not nil.
+func (gtuo *GpuTelemetryUpdateOne) SetNillableBucketAt(t *time.Time) *GpuTelemetryUpdateOne {
+	if t != nil {
+		gtuo.SetBucketAt(*t)
+	}
+	return gtuo
+}
+
+// SetSamples sets the "samples" field.
+func (gtuo *GpuTelemetryUpdateOne) SetSamples(i int) *GpuTelemetryUpdateOne {
+	gtuo.mutation.ResetSamples()
+	gtuo.mutation.SetSamples(i)
+	return gtuo
+}
+
+// SetNillableSamples sets the "samples" field if the given value is not nil.
+func (gtuo *GpuTelemetryUpdateOne) SetNillableSamples(i *int) *GpuTelemetryUpdateOne {
+	if i != nil {
+		gtuo.SetSamples(*i)
+	}
+	return gtuo
+}
+
+// AddSamples adds i to the "samples" field.
+func (gtuo *GpuTelemetryUpdateOne) AddSamples(i int) *GpuTelemetryUpdateOne {
+	gtuo.mutation.AddSamples(i)
+	return gtuo
+}
+
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (gtuo *GpuTelemetryUpdateOne) SetUtilizationGpu(f float64) *GpuTelemetryUpdateOne {
+	gtuo.mutation.ResetUtilizationGpu()
+	gtuo.mutation.SetUtilizationGpu(f)
+	return gtuo
+}
+
+// SetNillableUtilizationGpu sets the "utilization_gpu" field if the given value is not nil.
+func (gtuo *GpuTelemetryUpdateOne) SetNillableUtilizationGpu(f *float64) *GpuTelemetryUpdateOne {
+	if f != nil {
+		gtuo.SetUtilizationGpu(*f)
+	}
+	return gtuo
+}
+
+// AddUtilizationGpu adds f to the "utilization_gpu" field.
+func (gtuo *GpuTelemetryUpdateOne) AddUtilizationGpu(f float64) *GpuTelemetryUpdateOne {
+	gtuo.mutation.AddUtilizationGpu(f)
+	return gtuo
+}
+
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (gtuo *GpuTelemetryUpdateOne) SetUtilizationMemory(f float64) *GpuTelemetryUpdateOne {
+	gtuo.mutation.ResetUtilizationMemory()
+	gtuo.mutation.SetUtilizationMemory(f)
+	return gtuo
+}
+
+// SetNillableUtilizationMemory sets the "utilization_memory" field if the given value is not nil.
+func (gtuo *GpuTelemetryUpdateOne) SetNillableUtilizationMemory(f *float64) *GpuTelemetryUpdateOne {
+	if f != nil {
+		gtuo.SetUtilizationMemory(*f)
+	}
+	return gtuo
+}
+
+// AddUtilizationMemory adds f to the "utilization_memory" field.
+func (gtuo *GpuTelemetryUpdateOne) AddUtilizationMemory(f float64) *GpuTelemetryUpdateOne {
+	gtuo.mutation.AddUtilizationMemory(f)
+	return gtuo
+}
+
+// SetMemoryUsed sets the "memory_used" field.
+func (gtuo *GpuTelemetryUpdateOne) SetMemoryUsed(f float64) *GpuTelemetryUpdateOne {
+	gtuo.mutation.ResetMemoryUsed()
+	gtuo.mutation.SetMemoryUsed(f)
+	return gtuo
+}
+
+// SetNillableMemoryUsed sets the "memory_used" field if the given value is not nil.
+func (gtuo *GpuTelemetryUpdateOne) SetNillableMemoryUsed(f *float64) *GpuTelemetryUpdateOne {
+	if f != nil {
+		gtuo.SetMemoryUsed(*f)
+	}
+	return gtuo
+}
+
+// AddMemoryUsed adds f to the "memory_used" field.
+func (gtuo *GpuTelemetryUpdateOne) AddMemoryUsed(f float64) *GpuTelemetryUpdateOne {
+	gtuo.mutation.AddMemoryUsed(f)
+	return gtuo
+}
+
+// SetMemoryTotal sets the "memory_total" field.
+func (gtuo *GpuTelemetryUpdateOne) SetMemoryTotal(f float64) *GpuTelemetryUpdateOne {
+	gtuo.mutation.ResetMemoryTotal()
+	gtuo.mutation.SetMemoryTotal(f)
+	return gtuo
+}
+
+// SetNillableMemoryTotal sets the "memory_total" field if the given value is not nil.
+func (gtuo *GpuTelemetryUpdateOne) SetNillableMemoryTotal(f *float64) *GpuTelemetryUpdateOne {
+	if f != nil {
+		gtuo.SetMemoryTotal(*f)
+	}
+	return gtuo
+}
+
+// AddMemoryTotal adds f to the "memory_total" field.
+func (gtuo *GpuTelemetryUpdateOne) AddMemoryTotal(f float64) *GpuTelemetryUpdateOne {
+	gtuo.mutation.AddMemoryTotal(f)
+	return gtuo
+}
+
+// SetTemperature sets the "temperature" field.
+func (gtuo *GpuTelemetryUpdateOne) SetTemperature(f float64) *GpuTelemetryUpdateOne {
+	gtuo.mutation.ResetTemperature()
+	gtuo.mutation.SetTemperature(f)
+	return gtuo
+}
+
+// SetNillableTemperature sets the "temperature" field if the given value is not nil.
+func (gtuo *GpuTelemetryUpdateOne) SetNillableTemperature(f *float64) *GpuTelemetryUpdateOne {
+	if f != nil {
+		gtuo.SetTemperature(*f)
+	}
+	return gtuo
+}
+
+// AddTemperature adds f to the "temperature" field.
+func (gtuo *GpuTelemetryUpdateOne) AddTemperature(f float64) *GpuTelemetryUpdateOne {
+	gtuo.mutation.AddTemperature(f)
+	return gtuo
+}
+
+// SetPowerDraw sets the "power_draw" field.
+func (gtuo *GpuTelemetryUpdateOne) SetPowerDraw(f float64) *GpuTelemetryUpdateOne {
+	gtuo.mutation.ResetPowerDraw()
+	gtuo.mutation.SetPowerDraw(f)
+	return gtuo
+}
+
+// SetNillablePowerDraw sets the "power_draw" field if the given value is not nil.
+func (gtuo *GpuTelemetryUpdateOne) SetNillablePowerDraw(f *float64) *GpuTelemetryUpdateOne {
+	if f != nil {
+		gtuo.SetPowerDraw(*f)
+	}
+	return gtuo
+}
+
+// AddPowerDraw adds f to the "power_draw" field.
+func (gtuo *GpuTelemetryUpdateOne) AddPowerDraw(f float64) *GpuTelemetryUpdateOne {
+	gtuo.mutation.AddPowerDraw(f)
+	return gtuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gtuo *GpuTelemetryUpdateOne) SetUpdatedAt(t time.Time) *GpuTelemetryUpdateOne {
+	gtuo.mutation.SetUpdatedAt(t)
+	return gtuo
+}
+
+// Mutation returns the GpuTelemetryMutation object of the builder.
+func (gtuo *GpuTelemetryUpdateOne) Mutation() *GpuTelemetryMutation {
+	return gtuo.mutation
+}
+
+// Where appends a list predicates to the GpuTelemetryUpdate builder.
+func (gtuo *GpuTelemetryUpdateOne) Where(ps ...predicate.GpuTelemetry) *GpuTelemetryUpdateOne {
+	gtuo.mutation.Where(ps...)
+	return gtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gtuo *GpuTelemetryUpdateOne) Select(field string, fields ...string) *GpuTelemetryUpdateOne {
+	gtuo.fields = append([]string{field}, fields...)
+	return gtuo
+}
+
+// Save executes the query and returns the updated GpuTelemetry entity.
+func (gtuo *GpuTelemetryUpdateOne) Save(ctx context.Context) (*GpuTelemetry, error) {
+	gtuo.defaults()
+	return withHooks(ctx, gtuo.sqlSave, gtuo.mutation, gtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gtuo *GpuTelemetryUpdateOne) SaveX(ctx context.Context) *GpuTelemetry {
+	node, err := gtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gtuo *GpuTelemetryUpdateOne) Exec(ctx context.Context) error {
+	_, err := gtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gtuo *GpuTelemetryUpdateOne) ExecX(ctx context.Context) {
+	if err := gtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gtuo *GpuTelemetryUpdateOne) defaults() {
+	if _, ok := gtuo.mutation.UpdatedAt(); !ok {
+		v := gputelemetry.UpdateDefaultUpdatedAt()
+		gtuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (gtuo *GpuTelemetryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GpuTelemetryUpdateOne {
+	gtuo.modifiers = append(gtuo.modifiers, modifiers...)
+	return gtuo
+}
+
+func (gtuo *GpuTelemetryUpdateOne) sqlSave(ctx context.Context) (_node *GpuTelemetry, err error) {
+	_spec := sqlgraph.NewUpdateSpec(gputelemetry.Table, gputelemetry.Columns, sqlgraph.NewFieldSpec(gputelemetry.FieldID, field.TypeInt))
+	id, ok := gtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GpuTelemetry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gputelemetry.FieldID)
+		for _, f := range fields {
+			if !gputelemetry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gputelemetry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gtuo.mutation.GpuID(); ok {
+		_spec.SetField(gputelemetry.FieldGpuID, field.TypeInt, value)
+	}
+	if value, ok := gtuo.mutation.AddedGpuID(); ok {
+		_spec.AddField(gputelemetry.FieldGpuID, field.TypeInt, value)
+	}
+	if value, ok := gtuo.mutation.DeviceID(); ok {
+		_spec.SetField(gputelemetry.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := gtuo.mutation.AddedDeviceID(); ok {
+		_spec.AddField(gputelemetry.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := gtuo.mutation.Resolution(); ok {
+		_spec.SetField(gputelemetry.FieldResolution, field.TypeInt, value)
+	}
+	if value, ok := gtuo.mutation.AddedResolution(); ok {
+		_spec.AddField(gputelemetry.FieldResolution, field.TypeInt, value)
+	}
+	if value, ok := gtuo.mutation.BucketAt(); ok {
+		_spec.SetField(gputelemetry.FieldBucketAt, field.TypeTime, value)
+	}
+	if value, ok := gtuo.mutation.Samples(); ok {
+		_spec.SetField(gputelemetry.FieldSamples, field.TypeInt, value)
+	}
+	if value, ok := gtuo.mutation.AddedSamples(); ok {
+		_spec.AddField(gputelemetry.FieldSamples, field.TypeInt, value)
+	}
+	if value, ok := gtuo.mutation.UtilizationGpu(); ok {
+		_spec.SetField(gputelemetry.FieldUtilizationGpu, field.TypeFloat64, value)
+	}
+	if value, ok := gtuo.mutation.AddedUtilizationGpu(); ok {
+		_spec.AddField(gputelemetry.FieldUtilizationGpu, field.TypeFloat64, value)
+	}
+	if value, ok := gtuo.mutation.UtilizationMemory(); ok {
+		_spec.SetField(gputelemetry.FieldUtilizationMemory, field.TypeFloat64, value)
+	}
+	if value, ok := gtuo.mutation.AddedUtilizationMemory(); ok {
+		_spec.AddField(gputelemetry.FieldUtilizationMemory, field.TypeFloat64, value)
+	}
+	if value, ok := gtuo.mutation.MemoryUsed(); ok {
+		_spec.SetField(gputelemetry.FieldMemoryUsed, field.TypeFloat64, value)
+	}
+	if value, ok := gtuo.mutation.AddedMemoryUsed(); ok {
+		_spec.AddField(gputelemetry.FieldMemoryUsed, field.TypeFloat64, value)
+	}
+	if value, ok := gtuo.mutation.MemoryTotal(); ok {
+		_spec.SetField(gputelemetry.FieldMemoryTotal, field.TypeFloat64, value)
+	}
+	if value, ok := gtuo.mutation.AddedMemoryTotal(); ok {
+		_spec.AddField(gputelemetry.FieldMemoryTotal, field.TypeFloat64, value)
+	}
+	if value, ok := gtuo.mutation.Temperature(); ok {
+		_spec.SetField(gputelemetry.FieldTemperature, field.TypeFloat64, value)
+	}
+	if value, ok := gtuo.mutation.AddedTemperature(); ok {
+		_spec.AddField(gputelemetry.FieldTemperature, field.TypeFloat64, value)
+	}
+	if value, ok := gtuo.mutation.PowerDraw(); ok {
+		_spec.SetField(gputelemetry.FieldPowerDraw, field.TypeFloat64, value)
+	}
+	if value, ok := gtuo.mutation.AddedPowerDraw(); ok {
+		_spec.AddField(gputelemetry.FieldPowerDraw, field.TypeFloat64, value)
+	}
+	if value, ok := gtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(gputelemetry.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(gtuo.modifiers...)
+	_node = &GpuTelemetry{config: gtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gputelemetry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gtuo.mutation.done = true
+	return _node, nil
+}
@@ -27,6 +27,8 @@ type HyperParamsHistory struct {
 	ModelNum int `json:"model_num,omitempty"`
 	// Params holds the value of the "params" field.
 	Params string `json:"params,omitempty"`
+	// search metric of the trial
+	Score *float64 `json:"score,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt    time.Time `json:"created_at,omitempty"`
 	selectValues sql.SelectValues
@@ -37,6 +39,8 @@ func (*HyperParamsHistory) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case hyperparamshistory.FieldScore:
+			values[i] = new(sql.NullFloat64)
 		case hyperparamshistory.FieldID, hyperparamshistory.FieldTrialID, hyperparamshistory.FieldModelNum:
 			values[i] = new(sql.NullInt64)
 		case hyperparamshistory.FieldTrialUUID, hyperparamshistory.FieldModel, hyperparamshistory.FieldParams:
@@ -94,6 +98,13 @@ func (hph *HyperParamsHistory) assignValues(columns []string, values []any) erro
 			} else if value.Valid {
 				hph.Params = value.String
 			}
+		case hyperparamshistory.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				hph.Score = new(float64)
+				*hph.Score = value.Float64
+			}
 		case hyperparamshistory.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -151,6 +162,11 @@ func (hph *HyperParamsHistory) String() string {
 	builder.WriteString("params=")
 	builder.WriteString(hph.Params)
 	builder.WriteString(", ")
+	if v := hph.Score; v != nil {
+		builder.WriteString("score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(hph.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
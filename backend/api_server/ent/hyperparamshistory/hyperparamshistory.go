@@ -23,6 +23,8 @@ const (
 	FieldModelNum = "model_num"
 	// FieldParams holds the string denoting the params field in the database.
 	FieldParams = "params"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// Table holds the table name of the hyperparamshistory in the database.
@@ -37,6 +39,7 @@ var Columns = []string{
 	FieldModel,
 	FieldModelNum,
 	FieldParams,
+	FieldScore,
 	FieldCreatedAt,
 }
 
@@ -98,6 +101,11 @@ func ByParams(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParams, opts...).ToFunc()
 }
 
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -79,6 +79,11 @@ func Params(v string) predicate.HyperParamsHistory {
 	return predicate.HyperParamsHistory(sql.FieldEQ(FieldParams, v))
 }
 
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.HyperParamsHistory {
+	return predicate.HyperParamsHistory(sql.FieldEQ(FieldScore, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.HyperParamsHistory {
 	return predicate.HyperParamsHistory(sql.FieldEQ(FieldCreatedAt, v))
@@ -359,6 +364,56 @@ func ParamsContainsFold(v string) predicate.HyperParamsHistory {
 	return predicate.HyperParamsHistory(sql.FieldContainsFold(FieldParams, v))
 }
 
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.HyperParamsHistory {
+	return predicate.HyperParamsHistory(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.HyperParamsHistory {
+	return predicate.HyperParamsHistory(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.HyperParamsHistory {
+	return predicate.HyperParamsHistory(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.HyperParamsHistory {
+	return predicate.HyperParamsHistory(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.HyperParamsHistory {
+	return predicate.HyperParamsHistory(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.HyperParamsHistory {
+	return predicate.HyperParamsHistory(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.HyperParamsHistory {
+	return predicate.HyperParamsHistory(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.HyperParamsHistory {
+	return predicate.HyperParamsHistory(sql.FieldLTE(FieldScore, v))
+}
+
+// ScoreIsNil applies the IsNil predicate on the "score" field.
+func ScoreIsNil() predicate.HyperParamsHistory {
+	return predicate.HyperParamsHistory(sql.FieldIsNull(FieldScore))
+}
+
+// ScoreNotNil applies the NotNil predicate on the "score" field.
+func ScoreNotNil() predicate.HyperParamsHistory {
+	return predicate.HyperParamsHistory(sql.FieldNotNull(FieldScore))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.HyperParamsHistory {
 	return predicate.HyperParamsHistory(sql.FieldEQ(FieldCreatedAt, v))
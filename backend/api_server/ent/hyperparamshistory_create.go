@@ -92,6 +92,20 @@ func (hphc *HyperParamsHistoryCreate) SetNillableParams(s *string) *HyperParamsH
 	return hphc
 }
 
+// SetScore sets the "score" field.
+func (hphc *HyperParamsHistoryCreate) SetScore(f float64) *HyperParamsHistoryCreate {
+	hphc.mutation.SetScore(f)
+	return hphc
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (hphc *HyperParamsHistoryCreate) SetNillableScore(f *float64) *HyperParamsHistoryCreate {
+	if f != nil {
+		hphc.SetScore(*f)
+	}
+	return hphc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (hphc *HyperParamsHistoryCreate) SetCreatedAt(t time.Time) *HyperParamsHistoryCreate {
 	hphc.mutation.SetCreatedAt(t)
@@ -246,6 +260,10 @@ func (hphc *HyperParamsHistoryCreate) createSpec() (*HyperParamsHistory, *sqlgra
 		_spec.SetField(hyperparamshistory.FieldParams, field.TypeString, value)
 		_node.Params = value
 	}
+	if value, ok := hphc.mutation.Score(); ok {
+		_spec.SetField(hyperparamshistory.FieldScore, field.TypeFloat64, value)
+		_node.Score = &value
+	}
 	if value, ok := hphc.mutation.CreatedAt(); ok {
 		_spec.SetField(hyperparamshistory.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -374,6 +392,30 @@ func (u *HyperParamsHistoryUpsert) UpdateParams() *HyperParamsHistoryUpsert {
 	return u
 }
 
+// SetScore sets the "score" field.
+func (u *HyperParamsHistoryUpsert) SetScore(v float64) *HyperParamsHistoryUpsert {
+	u.Set(hyperparamshistory.FieldScore, v)
+	return u
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *HyperParamsHistoryUpsert) UpdateScore() *HyperParamsHistoryUpsert {
+	u.SetExcluded(hyperparamshistory.FieldScore)
+	return u
+}
+
+// AddScore adds v to the "score" field.
+func (u *HyperParamsHistoryUpsert) AddScore(v float64) *HyperParamsHistoryUpsert {
+	u.Add(hyperparamshistory.FieldScore, v)
+	return u
+}
+
+// ClearScore clears the value of the "score" field.
+func (u *HyperParamsHistoryUpsert) ClearScore() *HyperParamsHistoryUpsert {
+	u.SetNull(hyperparamshistory.FieldScore)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -509,6 +551,34 @@ func (u *HyperParamsHistoryUpsertOne) UpdateParams() *HyperParamsHistoryUpsertOn
 	})
 }
 
+// SetScore sets the "score" field.
+func (u *HyperParamsHistoryUpsertOne) SetScore(v float64) *HyperParamsHistoryUpsertOne {
+	return u.Update(func(s *HyperParamsHistoryUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *HyperParamsHistoryUpsertOne) AddScore(v float64) *HyperParamsHistoryUpsertOne {
+	return u.Update(func(s *HyperParamsHistoryUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *HyperParamsHistoryUpsertOne) UpdateScore() *HyperParamsHistoryUpsertOne {
+	return u.Update(func(s *HyperParamsHistoryUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// ClearScore clears the value of the "score" field.
+func (u *HyperParamsHistoryUpsertOne) ClearScore() *HyperParamsHistoryUpsertOne {
+	return u.Update(func(s *HyperParamsHistoryUpsert) {
+		s.ClearScore()
+	})
+}
+
 // Exec executes the query.
 func (u *HyperParamsHistoryUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -810,6 +880,34 @@ func (u *HyperParamsHistoryUpsertBulk) UpdateParams() *HyperParamsHistoryUpsertB
 	})
 }
 
+// SetScore sets the "score" field.
+func (u *HyperParamsHistoryUpsertBulk) SetScore(v float64) *HyperParamsHistoryUpsertBulk {
+	return u.Update(func(s *HyperParamsHistoryUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *HyperParamsHistoryUpsertBulk) AddScore(v float64) *HyperParamsHistoryUpsertBulk {
+	return u.Update(func(s *HyperParamsHistoryUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *HyperParamsHistoryUpsertBulk) UpdateScore() *HyperParamsHistoryUpsertBulk {
+	return u.Update(func(s *HyperParamsHistoryUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// ClearScore clears the value of the "score" field.
+func (u *HyperParamsHistoryUpsertBulk) ClearScore() *HyperParamsHistoryUpsertBulk {
+	return u.Update(func(s *HyperParamsHistoryUpsert) {
+		s.ClearScore()
+	})
+}
+
 // Exec executes the query.
 func (u *HyperParamsHistoryUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -112,6 +112,33 @@ func (hphu *HyperParamsHistoryUpdate) SetNillableParams(s *string) *HyperParamsH
 	return hphu
 }
 
+// SetScore sets the "score" field.
+func (hphu *HyperParamsHistoryUpdate) SetScore(f float64) *HyperParamsHistoryUpdate {
+	hphu.mutation.ResetScore()
+	hphu.mutation.SetScore(f)
+	return hphu
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (hphu *HyperParamsHistoryUpdate) SetNillableScore(f *float64) *HyperParamsHistoryUpdate {
+	if f != nil {
+		hphu.SetScore(*f)
+	}
+	return hphu
+}
+
+// AddScore adds f to the "score" field.
+func (hphu *HyperParamsHistoryUpdate) AddScore(f float64) *HyperParamsHistoryUpdate {
+	hphu.mutation.AddScore(f)
+	return hphu
+}
+
+// ClearScore clears the value of the "score" field.
+func (hphu *HyperParamsHistoryUpdate) ClearScore() *HyperParamsHistoryUpdate {
+	hphu.mutation.ClearScore()
+	return hphu
+}
+
 // Mutation returns the HyperParamsHistoryMutation object of the builder.
 func (hphu *HyperParamsHistoryUpdate) Mutation() *HyperParamsHistoryMutation {
 	return hphu.mutation
@@ -180,6 +207,15 @@ func (hphu *HyperParamsHistoryUpdate) sqlSave(ctx context.Context) (n int, err e
 	if value, ok := hphu.mutation.Params(); ok {
 		_spec.SetField(hyperparamshistory.FieldParams, field.TypeString, value)
 	}
+	if value, ok := hphu.mutation.Score(); ok {
+		_spec.SetField(hyperparamshistory.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := hphu.mutation.AddedScore(); ok {
+		_spec.AddField(hyperparamshistory.FieldScore, field.TypeFloat64, value)
+	}
+	if hphu.mutation.ScoreCleared() {
+		_spec.ClearField(hyperparamshistory.FieldScore, field.TypeFloat64)
+	}
 	_spec.AddModifiers(hphu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, hphu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -286,6 +322,33 @@ func (hphuo *HyperParamsHistoryUpdateOne) SetNillableParams(s *string) *HyperPar
 	return hphuo
 }
 
+// SetScore sets the "score" field.
+func (hphuo *HyperParamsHistoryUpdateOne) SetScore(f float64) *HyperParamsHistoryUpdateOne {
+	hphuo.mutation.ResetScore()
+	hphuo.mutation.SetScore(f)
+	return hphuo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (hphuo *HyperParamsHistoryUpdateOne) SetNillableScore(f *float64) *HyperParamsHistoryUpdateOne {
+	if f != nil {
+		hphuo.SetScore(*f)
+	}
+	return hphuo
+}
+
+// AddScore adds f to the "score" field.
+func (hphuo *HyperParamsHistoryUpdateOne) AddScore(f float64) *HyperParamsHistoryUpdateOne {
+	hphuo.mutation.AddScore(f)
+	return hphuo
+}
+
+// ClearScore clears the value of the "score" field.
+func (hphuo *HyperParamsHistoryUpdateOne) ClearScore() *HyperParamsHistoryUpdateOne {
+	hphuo.mutation.ClearScore()
+	return hphuo
+}
+
 // Mutation returns the HyperParamsHistoryMutation object of the builder.
 func (hphuo *HyperParamsHistoryUpdateOne) Mutation() *HyperParamsHistoryMutation {
 	return hphuo.mutation
@@ -384,6 +447,15 @@ func (hphuo *HyperParamsHistoryUpdateOne) sqlSave(ctx context.Context) (_node *H
 	if value, ok := hphuo.mutation.Params(); ok {
 		_spec.SetField(hyperparamshistory.FieldParams, field.TypeString, value)
 	}
+	if value, ok := hphuo.mutation.Score(); ok {
+		_spec.SetField(hyperparamshistory.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := hphuo.mutation.AddedScore(); ok {
+		_spec.AddField(hyperparamshistory.FieldScore, field.TypeFloat64, value)
+	}
+	if hphuo.mutation.ScoreCleared() {
+		_spec.ClearField(hyperparamshistory.FieldScore, field.TypeFloat64)
+	}
 	_spec.AddModifiers(hphuo.modifiers...)
 	_node = &HyperParamsHistory{config: hphuo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/maintenancewindow"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// starts_at부터 ends_at까지 device를 drain하는 예약된 점검 시간
+type MaintenanceWindow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Device ID to drain
+	DeviceID int `json:"device_id,omitempty"`
+	// Drain starts
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// Drain ends and the device is scheduled again
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Move loaded inference models to other GPUs instead of unloading them
+	Migrate bool `json:"migrate,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When the drain was applied
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// When the drain was released
+	EndedAt      *time.Time `json:"ended_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MaintenanceWindow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case maintenancewindow.FieldMigrate:
+			values[i] = new(sql.NullBool)
+		case maintenancewindow.FieldID, maintenancewindow.FieldDeviceID:
+			values[i] = new(sql.NullInt64)
+		case maintenancewindow.FieldReason, maintenancewindow.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case maintenancewindow.FieldStartsAt, maintenancewindow.FieldEndsAt, maintenancewindow.FieldCreatedAt, maintenancewindow.FieldStartedAt, maintenancewindow.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MaintenanceWindow fields.
+func (mw *MaintenanceWindow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case maintenancewindow.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mw.ID = int(value.Int64)
+		case maintenancewindow.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				mw.DeviceID = int(value.Int64)
+			}
+		case maintenancewindow.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				mw.StartsAt = value.Time
+			}
+		case maintenancewindow.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				mw.EndsAt = value.Time
+			}
+		case maintenancewindow.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				mw.Reason = value.String
+			}
+		case maintenancewindow.FieldMigrate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field migrate", values[i])
+			} else if value.Valid {
+				mw.Migrate = value.Bool
+			}
+		case maintenancewindow.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				mw.CreatedBy = value.String
+			}
+		case maintenancewindow.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mw.CreatedAt = value.Time
+			}
+		case maintenancewindow.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				mw.StartedAt = new(time.Time)
+				*mw.StartedAt = value.Time
+			}
+		case maintenancewindow.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				mw.EndedAt = new(time.Time)
+				*mw.EndedAt = value.Time
+			}
+		default:
+			mw.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MaintenanceWindow.
+// This includes values selected through modifiers, order, etc.
+func (mw *MaintenanceWindow) Value(name string) (ent.Value, error) {
+	return mw.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MaintenanceWindow.
+// Note that you need to call MaintenanceWindow.Unwrap() before calling this method if this MaintenanceWindow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mw *MaintenanceWindow) Update() *MaintenanceWindowUpdateOne {
+	return NewMaintenanceWindowClient(mw.config).UpdateOne(mw)
+}
+
+// Unwrap unwraps the MaintenanceWindow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mw *MaintenanceWindow) Unwrap() *MaintenanceWindow {
+	_tx, ok := mw.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MaintenanceWindow is not a transactional entity")
+	}
+	mw.config.driver = _tx.drv
+	return mw
+}
+
+// String implements the fmt.Stringer.
+func (mw *MaintenanceWindow) String() string {
+	var builder strings.Builder
+	builder.WriteString("MaintenanceWindow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mw.ID))
+	builder.WriteString("device_id=")
+	builder.WriteString(fmt.Sprintf("%v", mw.DeviceID))
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(mw.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(mw.EndsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(mw.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("migrate=")
+	builder.WriteString(fmt.Sprintf("%v", mw.Migrate))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(mw.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mw.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := mw.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := mw.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MaintenanceWindows is a parsable slice of MaintenanceWindow.
+type MaintenanceWindows []*MaintenanceWindow
@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package maintenancewindow
+
+import (
+	"api_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldID, id))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldReason, v))
+}
+
+// Migrate applies equality check predicate on the "migrate" field. It's identical to MigrateEQ.
+func Migrate(v bool) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldMigrate, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v int) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContainsFold(FieldReason, v))
+}
+
+// MigrateEQ applies the EQ predicate on the "migrate" field.
+func MigrateEQ(v bool) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldMigrate, v))
+}
+
+// MigrateNEQ applies the NEQ predicate on the "migrate" field.
+func MigrateNEQ(v bool) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldMigrate, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotNull(FieldStartedAt))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.FieldNotNull(FieldEndedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MaintenanceWindow) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MaintenanceWindow) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MaintenanceWindow) predicate.MaintenanceWindow {
+	return predicate.MaintenanceWindow(sql.NotPredicates(p))
+}
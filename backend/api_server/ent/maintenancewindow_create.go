@@ -0,0 +1,999 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/maintenancewindow"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MaintenanceWindowCreate is the builder for creating a MaintenanceWindow entity.
+type MaintenanceWindowCreate struct {
+	config
+	mutation *MaintenanceWindowMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDeviceID sets the "device_id" field.
+func (mwc *MaintenanceWindowCreate) SetDeviceID(i int) *MaintenanceWindowCreate {
+	mwc.mutation.SetDeviceID(i)
+	return mwc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (mwc *MaintenanceWindowCreate) SetStartsAt(t time.Time) *MaintenanceWindowCreate {
+	mwc.mutation.SetStartsAt(t)
+	return mwc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (mwc *MaintenanceWindowCreate) SetEndsAt(t time.Time) *MaintenanceWindowCreate {
+	mwc.mutation.SetEndsAt(t)
+	return mwc
+}
+
+// SetReason sets the "reason" field.
+func (mwc *MaintenanceWindowCreate) SetReason(s string) *MaintenanceWindowCreate {
+	mwc.mutation.SetReason(s)
+	return mwc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mwc *MaintenanceWindowCreate) SetNillableReason(s *string) *MaintenanceWindowCreate {
+	if s != nil {
+		mwc.SetReason(*s)
+	}
+	return mwc
+}
+
+// SetMigrate sets the "migrate" field.
+func (mwc *MaintenanceWindowCreate) SetMigrate(b bool) *MaintenanceWindowCreate {
+	mwc.mutation.SetMigrate(b)
+	return mwc
+}
+
+// SetNillableMigrate sets the "migrate" field if the given value is not nil.
+func (mwc *MaintenanceWindowCreate) SetNillableMigrate(b *bool) *MaintenanceWindowCreate {
+	if b != nil {
+		mwc.SetMigrate(*b)
+	}
+	return mwc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (mwc *MaintenanceWindowCreate) SetCreatedBy(s string) *MaintenanceWindowCreate {
+	mwc.mutation.SetCreatedBy(s)
+	return mwc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (mwc *MaintenanceWindowCreate) SetNillableCreatedBy(s *string) *MaintenanceWindowCreate {
+	if s != nil {
+		mwc.SetCreatedBy(*s)
+	}
+	return mwc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mwc *MaintenanceWindowCreate) SetCreatedAt(t time.Time) *MaintenanceWindowCreate {
+	mwc.mutation.SetCreatedAt(t)
+	return mwc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mwc *MaintenanceWindowCreate) SetNillableCreatedAt(t *time.Time) *MaintenanceWindowCreate {
+	if t != nil {
+		mwc.SetCreatedAt(*t)
+	}
+	return mwc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (mwc *MaintenanceWindowCreate) SetStartedAt(t time.Time) *MaintenanceWindowCreate {
+	mwc.mutation.SetStartedAt(t)
+	return mwc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (mwc *MaintenanceWindowCreate) SetNillableStartedAt(t *time.Time) *MaintenanceWindowCreate {
+	if t != nil {
+		mwc.SetStartedAt(*t)
+	}
+	return mwc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (mwc *MaintenanceWindowCreate) SetEndedAt(t time.Time) *MaintenanceWindowCreate {
+	mwc.mutation.SetEndedAt(t)
+	return mwc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (mwc *MaintenanceWindowCreate) SetNillableEndedAt(t *time.Time) *MaintenanceWindowCreate {
+	if t != nil {
+		mwc.SetEndedAt(*t)
+	}
+	return mwc
+}
+
+// SetID sets the "id" field.
+func (mwc *MaintenanceWindowCreate) SetID(i int) *MaintenanceWindowCreate {
+	mwc.mutation.SetID(i)
+	return mwc
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (mwc *MaintenanceWindowCreate) Mutation() *MaintenanceWindowMutation {
+	return mwc.mutation
+}
+
+// Save creates the MaintenanceWindow in the database.
+func (mwc *MaintenanceWindowCreate) Save(ctx context.Context) (*MaintenanceWindow, error) {
+	mwc.defaults()
+	return withHooks(ctx, mwc.sqlSave, mwc.mutation, mwc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mwc *MaintenanceWindowCreate) SaveX(ctx context.Context) *MaintenanceWindow {
+	v, err := mwc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwc *MaintenanceWindowCreate) Exec(ctx context.Context) error {
+	_, err := mwc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwc *MaintenanceWindowCreate) ExecX(ctx context.Context) {
+	if err := mwc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mwc *MaintenanceWindowCreate) defaults() {
+	if _, ok := mwc.mutation.Reason(); !ok {
+		v := maintenancewindow.DefaultReason
+		mwc.mutation.SetReason(v)
+	}
+	if _, ok := mwc.mutation.Migrate(); !ok {
+		v := maintenancewindow.DefaultMigrate
+		mwc.mutation.SetMigrate(v)
+	}
+	if _, ok := mwc.mutation.CreatedBy(); !ok {
+		v := maintenancewindow.DefaultCreatedBy
+		mwc.mutation.SetCreatedBy(v)
+	}
+	if _, ok := mwc.mutation.CreatedAt(); !ok {
+		v := maintenancewindow.DefaultCreatedAt()
+		mwc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mwc *MaintenanceWindowCreate) check() error {
+	if _, ok := mwc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "MaintenanceWindow.device_id"`)}
+	}
+	if _, ok := mwc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "MaintenanceWindow.starts_at"`)}
+	}
+	if _, ok := mwc.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`ent: missing required field "MaintenanceWindow.ends_at"`)}
+	}
+	if _, ok := mwc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "MaintenanceWindow.reason"`)}
+	}
+	if _, ok := mwc.mutation.Migrate(); !ok {
+		return &ValidationError{Name: "migrate", err: errors.New(`ent: missing required field "MaintenanceWindow.migrate"`)}
+	}
+	if _, ok := mwc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "MaintenanceWindow.created_by"`)}
+	}
+	if _, ok := mwc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MaintenanceWindow.created_at"`)}
+	}
+	return nil
+}
+
+func (mwc *MaintenanceWindowCreate) sqlSave(ctx context.Context) (*MaintenanceWindow, error) {
+	if err := mwc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mwc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mwc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	mwc.mutation.id = &_node.ID
+	mwc.mutation.done = true
+	return _node, nil
+}
+
+func (mwc *MaintenanceWindowCreate) createSpec() (*MaintenanceWindow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MaintenanceWindow{config: mwc.config}
+		_spec = sqlgraph.NewCreateSpec(maintenancewindow.Table, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = mwc.conflict
+	if id, ok := mwc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mwc.mutation.DeviceID(); ok {
+		_spec.SetField(maintenancewindow.FieldDeviceID, field.TypeInt, value)
+		_node.DeviceID = value
+	}
+	if value, ok := mwc.mutation.StartsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := mwc.mutation.EndsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if value, ok := mwc.mutation.Reason(); ok {
+		_spec.SetField(maintenancewindow.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := mwc.mutation.Migrate(); ok {
+		_spec.SetField(maintenancewindow.FieldMigrate, field.TypeBool, value)
+		_node.Migrate = value
+	}
+	if value, ok := mwc.mutation.CreatedBy(); ok {
+		_spec.SetField(maintenancewindow.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := mwc.mutation.CreatedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mwc.mutation.StartedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := mwc.mutation.EndedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MaintenanceWindow.Create().
+//		SetDeviceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MaintenanceWindowUpsert) {
+//			SetDeviceID(v+v).
+//		}).
+//		Exec(ctx)
+func (mwc *MaintenanceWindowCreate) OnConflict(opts ...sql.ConflictOption) *MaintenanceWindowUpsertOne {
+	mwc.conflict = opts
+	return &MaintenanceWindowUpsertOne{
+		create: mwc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MaintenanceWindow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mwc *MaintenanceWindowCreate) OnConflictColumns(columns ...string) *MaintenanceWindowUpsertOne {
+	mwc.conflict = append(mwc.conflict, sql.ConflictColumns(columns...))
+	return &MaintenanceWindowUpsertOne{
+		create: mwc,
+	}
+}
+
+type (
+	// MaintenanceWindowUpsertOne is the builder for "upsert"-ing
+	//  one MaintenanceWindow node.
+	MaintenanceWindowUpsertOne struct {
+		create *MaintenanceWindowCreate
+	}
+
+	// MaintenanceWindowUpsert is the "OnConflict" setter.
+	MaintenanceWindowUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDeviceID sets the "device_id" field.
+func (u *MaintenanceWindowUpsert) SetDeviceID(v int) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldDeviceID, v)
+	return u
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateDeviceID() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldDeviceID)
+	return u
+}
+
+// AddDeviceID adds v to the "device_id" field.
+func (u *MaintenanceWindowUpsert) AddDeviceID(v int) *MaintenanceWindowUpsert {
+	u.Add(maintenancewindow.FieldDeviceID, v)
+	return u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *MaintenanceWindowUpsert) SetStartsAt(v time.Time) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldStartsAt, v)
+	return u
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateStartsAt() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldStartsAt)
+	return u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *MaintenanceWindowUpsert) SetEndsAt(v time.Time) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldEndsAt, v)
+	return u
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateEndsAt() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldEndsAt)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *MaintenanceWindowUpsert) SetReason(v string) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateReason() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldReason)
+	return u
+}
+
+// SetMigrate sets the "migrate" field.
+func (u *MaintenanceWindowUpsert) SetMigrate(v bool) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldMigrate, v)
+	return u
+}
+
+// UpdateMigrate sets the "migrate" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateMigrate() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldMigrate)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *MaintenanceWindowUpsert) SetCreatedBy(v string) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateCreatedBy() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldCreatedBy)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *MaintenanceWindowUpsert) SetStartedAt(v time.Time) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateStartedAt() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *MaintenanceWindowUpsert) ClearStartedAt() *MaintenanceWindowUpsert {
+	u.SetNull(maintenancewindow.FieldStartedAt)
+	return u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *MaintenanceWindowUpsert) SetEndedAt(v time.Time) *MaintenanceWindowUpsert {
+	u.Set(maintenancewindow.FieldEndedAt, v)
+	return u
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsert) UpdateEndedAt() *MaintenanceWindowUpsert {
+	u.SetExcluded(maintenancewindow.FieldEndedAt)
+	return u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *MaintenanceWindowUpsert) ClearEndedAt() *MaintenanceWindowUpsert {
+	u.SetNull(maintenancewindow.FieldEndedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MaintenanceWindow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(maintenancewindow.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MaintenanceWindowUpsertOne) UpdateNewValues() *MaintenanceWindowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(maintenancewindow.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(maintenancewindow.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MaintenanceWindow.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MaintenanceWindowUpsertOne) Ignore() *MaintenanceWindowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MaintenanceWindowUpsertOne) DoNothing() *MaintenanceWindowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MaintenanceWindowCreate.OnConflict
+// documentation for more info.
+func (u *MaintenanceWindowUpsertOne) Update(set func(*MaintenanceWindowUpsert)) *MaintenanceWindowUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MaintenanceWindowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *MaintenanceWindowUpsertOne) SetDeviceID(v int) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetDeviceID(v)
+	})
+}
+
+// AddDeviceID adds v to the "device_id" field.
+func (u *MaintenanceWindowUpsertOne) AddDeviceID(v int) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.AddDeviceID(v)
+	})
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateDeviceID() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateDeviceID()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *MaintenanceWindowUpsertOne) SetStartsAt(v time.Time) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateStartsAt() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *MaintenanceWindowUpsertOne) SetEndsAt(v time.Time) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateEndsAt() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *MaintenanceWindowUpsertOne) SetReason(v string) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateReason() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetMigrate sets the "migrate" field.
+func (u *MaintenanceWindowUpsertOne) SetMigrate(v bool) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetMigrate(v)
+	})
+}
+
+// UpdateMigrate sets the "migrate" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateMigrate() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateMigrate()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *MaintenanceWindowUpsertOne) SetCreatedBy(v string) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateCreatedBy() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *MaintenanceWindowUpsertOne) SetStartedAt(v time.Time) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateStartedAt() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *MaintenanceWindowUpsertOne) ClearStartedAt() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *MaintenanceWindowUpsertOne) SetEndedAt(v time.Time) *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertOne) UpdateEndedAt() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *MaintenanceWindowUpsertOne) ClearEndedAt() *MaintenanceWindowUpsertOne {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MaintenanceWindowUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MaintenanceWindowCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MaintenanceWindowUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MaintenanceWindowUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MaintenanceWindowUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MaintenanceWindowCreateBulk is the builder for creating many MaintenanceWindow entities in bulk.
+type MaintenanceWindowCreateBulk struct {
+	config
+	err      error
+	builders []*MaintenanceWindowCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MaintenanceWindow entities in the database.
+func (mwcb *MaintenanceWindowCreateBulk) Save(ctx context.Context) ([]*MaintenanceWindow, error) {
+	if mwcb.err != nil {
+		return nil, mwcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mwcb.builders))
+	nodes := make([]*MaintenanceWindow, len(mwcb.builders))
+	mutators := make([]Mutator, len(mwcb.builders))
+	for i := range mwcb.builders {
+		func(i int, root context.Context) {
+			builder := mwcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MaintenanceWindowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mwcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mwcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mwcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mwcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwcb *MaintenanceWindowCreateBulk) SaveX(ctx context.Context) []*MaintenanceWindow {
+	v, err := mwcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwcb *MaintenanceWindowCreateBulk) Exec(ctx context.Context) error {
+	_, err := mwcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwcb *MaintenanceWindowCreateBulk) ExecX(ctx context.Context) {
+	if err := mwcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MaintenanceWindow.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MaintenanceWindowUpsert) {
+//			SetDeviceID(v+v).
+//		}).
+//		Exec(ctx)
+func (mwcb *MaintenanceWindowCreateBulk) OnConflict(opts ...sql.ConflictOption) *MaintenanceWindowUpsertBulk {
+	mwcb.conflict = opts
+	return &MaintenanceWindowUpsertBulk{
+		create: mwcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MaintenanceWindow.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mwcb *MaintenanceWindowCreateBulk) OnConflictColumns(columns ...string) *MaintenanceWindowUpsertBulk {
+	mwcb.conflict = append(mwcb.conflict, sql.ConflictColumns(columns...))
+	return &MaintenanceWindowUpsertBulk{
+		create: mwcb,
+	}
+}
+
+// MaintenanceWindowUpsertBulk is the builder for "upsert"-ing
+// a bulk of MaintenanceWindow nodes.
+type MaintenanceWindowUpsertBulk struct {
+	create *MaintenanceWindowCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MaintenanceWindow.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(maintenancewindow.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MaintenanceWindowUpsertBulk) UpdateNewValues() *MaintenanceWindowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(maintenancewindow.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(maintenancewindow.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MaintenanceWindow.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MaintenanceWindowUpsertBulk) Ignore() *MaintenanceWindowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MaintenanceWindowUpsertBulk) DoNothing() *MaintenanceWindowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MaintenanceWindowCreateBulk.OnConflict
+// documentation for more info.
+func (u *MaintenanceWindowUpsertBulk) Update(set func(*MaintenanceWindowUpsert)) *MaintenanceWindowUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MaintenanceWindowUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *MaintenanceWindowUpsertBulk) SetDeviceID(v int) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetDeviceID(v)
+	})
+}
+
+// AddDeviceID adds v to the "device_id" field.
+func (u *MaintenanceWindowUpsertBulk) AddDeviceID(v int) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.AddDeviceID(v)
+	})
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateDeviceID() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateDeviceID()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *MaintenanceWindowUpsertBulk) SetStartsAt(v time.Time) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateStartsAt() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *MaintenanceWindowUpsertBulk) SetEndsAt(v time.Time) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateEndsAt() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *MaintenanceWindowUpsertBulk) SetReason(v string) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateReason() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetMigrate sets the "migrate" field.
+func (u *MaintenanceWindowUpsertBulk) SetMigrate(v bool) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetMigrate(v)
+	})
+}
+
+// UpdateMigrate sets the "migrate" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateMigrate() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateMigrate()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *MaintenanceWindowUpsertBulk) SetCreatedBy(v string) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateCreatedBy() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *MaintenanceWindowUpsertBulk) SetStartedAt(v time.Time) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateStartedAt() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *MaintenanceWindowUpsertBulk) ClearStartedAt() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *MaintenanceWindowUpsertBulk) SetEndedAt(v time.Time) *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *MaintenanceWindowUpsertBulk) UpdateEndedAt() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *MaintenanceWindowUpsertBulk) ClearEndedAt() *MaintenanceWindowUpsertBulk {
+	return u.Update(func(s *MaintenanceWindowUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *MaintenanceWindowUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MaintenanceWindowCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MaintenanceWindowCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MaintenanceWindowUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
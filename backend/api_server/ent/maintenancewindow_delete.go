@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/maintenancewindow"
+	"api_server/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MaintenanceWindowDelete is the builder for deleting a MaintenanceWindow entity.
+type MaintenanceWindowDelete struct {
+	config
+	hooks    []Hook
+	mutation *MaintenanceWindowMutation
+}
+
+// Where appends a list predicates to the MaintenanceWindowDelete builder.
+func (mwd *MaintenanceWindowDelete) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowDelete {
+	mwd.mutation.Where(ps...)
+	return mwd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mwd *MaintenanceWindowDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mwd.sqlExec, mwd.mutation, mwd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwd *MaintenanceWindowDelete) ExecX(ctx context.Context) int {
+	n, err := mwd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mwd *MaintenanceWindowDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(maintenancewindow.Table, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt))
+	if ps := mwd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mwd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mwd.mutation.done = true
+	return affected, err
+}
+
+// MaintenanceWindowDeleteOne is the builder for deleting a single MaintenanceWindow entity.
+type MaintenanceWindowDeleteOne struct {
+	mwd *MaintenanceWindowDelete
+}
+
+// Where appends a list predicates to the MaintenanceWindowDelete builder.
+func (mwdo *MaintenanceWindowDeleteOne) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowDeleteOne {
+	mwdo.mwd.mutation.Where(ps...)
+	return mwdo
+}
+
+// Exec executes the deletion query.
+func (mwdo *MaintenanceWindowDeleteOne) Exec(ctx context.Context) error {
+	n, err := mwdo.mwd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{maintenancewindow.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwdo *MaintenanceWindowDeleteOne) ExecX(ctx context.Context) {
+	if err := mwdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
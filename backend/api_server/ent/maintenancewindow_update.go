@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/maintenancewindow"
+	"api_server/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MaintenanceWindowUpdate is the builder for updating MaintenanceWindow entities.
+type MaintenanceWindowUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MaintenanceWindowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MaintenanceWindowUpdate builder.
+func (mwu *MaintenanceWindowUpdate) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowUpdate {
+	mwu.mutation.Where(ps...)
+	return mwu
+}
+
+// SetDeviceID sets the "device_id" field.
+func (mwu *MaintenanceWindowUpdate) SetDeviceID(i int) *MaintenanceWindowUpdate {
+	mwu.mutation.ResetDeviceID()
+	mwu.mutation.SetDeviceID(i)
+	return mwu
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableDeviceID(i *int) *MaintenanceWindowUpdate {
+	if i != nil {
+		mwu.SetDeviceID(*i)
+	}
+	return mwu
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (mwu *MaintenanceWindowUpdate) AddDeviceID(i int) *MaintenanceWindowUpdate {
+	mwu.mutation.AddDeviceID(i)
+	return mwu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (mwu *MaintenanceWindowUpdate) SetStartsAt(t time.Time) *MaintenanceWindowUpdate {
+	mwu.mutation.SetStartsAt(t)
+	return mwu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableStartsAt(t *time.Time) *MaintenanceWindowUpdate {
+	if t != nil {
+		mwu.SetStartsAt(*t)
+	}
+	return mwu
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (mwu *MaintenanceWindowUpdate) SetEndsAt(t time.Time) *MaintenanceWindowUpdate {
+	mwu.mutation.SetEndsAt(t)
+	return mwu
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableEndsAt(t *time.Time) *MaintenanceWindowUpdate {
+	if t != nil {
+		mwu.SetEndsAt(*t)
+	}
+	return mwu
+}
+
+// SetReason sets the "reason" field.
+func (mwu *MaintenanceWindowUpdate) SetReason(s string) *MaintenanceWindowUpdate {
+	mwu.mutation.SetReason(s)
+	return mwu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableReason(s *string) *MaintenanceWindowUpdate {
+	if s != nil {
+		mwu.SetReason(*s)
+	}
+	return mwu
+}
+
+// SetMigrate sets the "migrate" field.
+func (mwu *MaintenanceWindowUpdate) SetMigrate(b bool) *MaintenanceWindowUpdate {
+	mwu.mutation.SetMigrate(b)
+	return mwu
+}
+
+// SetNillableMigrate sets the "migrate" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableMigrate(b *bool) *MaintenanceWindowUpdate {
+	if b != nil {
+		mwu.SetMigrate(*b)
+	}
+	return mwu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (mwu *MaintenanceWindowUpdate) SetCreatedBy(s string) *MaintenanceWindowUpdate {
+	mwu.mutation.SetCreatedBy(s)
+	return mwu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableCreatedBy(s *string) *MaintenanceWindowUpdate {
+	if s != nil {
+		mwu.SetCreatedBy(*s)
+	}
+	return mwu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (mwu *MaintenanceWindowUpdate) SetStartedAt(t time.Time) *MaintenanceWindowUpdate {
+	mwu.mutation.SetStartedAt(t)
+	return mwu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableStartedAt(t *time.Time) *MaintenanceWindowUpdate {
+	if t != nil {
+		mwu.SetStartedAt(*t)
+	}
+	return mwu
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (mwu *MaintenanceWindowUpdate) ClearStartedAt() *MaintenanceWindowUpdate {
+	mwu.mutation.ClearStartedAt()
+	return mwu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (mwu *MaintenanceWindowUpdate) SetEndedAt(t time.Time) *MaintenanceWindowUpdate {
+	mwu.mutation.SetEndedAt(t)
+	return mwu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (mwu *MaintenanceWindowUpdate) SetNillableEndedAt(t *time.Time) *MaintenanceWindowUpdate {
+	if t != nil {
+		mwu.SetEndedAt(*t)
+	}
+	return mwu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (mwu *MaintenanceWindowUpdate) ClearEndedAt() *MaintenanceWindowUpdate {
+	mwu.mutation.ClearEndedAt()
+	return mwu
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (mwu *MaintenanceWindowUpdate) Mutation() *MaintenanceWindowMutation {
+	return mwu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mwu *MaintenanceWindowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mwu.sqlSave, mwu.mutation, mwu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwu *MaintenanceWindowUpdate) SaveX(ctx context.Context) int {
+	affected, err := mwu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mwu *MaintenanceWindowUpdate) Exec(ctx context.Context) error {
+	_, err := mwu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwu *MaintenanceWindowUpdate) ExecX(ctx context.Context) {
+	if err := mwu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mwu *MaintenanceWindowUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MaintenanceWindowUpdate {
+	mwu.modifiers = append(mwu.modifiers, modifiers...)
+	return mwu
+}
+
+func (mwu *MaintenanceWindowUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt))
+	if ps := mwu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwu.mutation.DeviceID(); ok {
+		_spec.SetField(maintenancewindow.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := mwu.mutation.AddedDeviceID(); ok {
+		_spec.AddField(maintenancewindow.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := mwu.mutation.StartsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := mwu.mutation.EndsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := mwu.mutation.Reason(); ok {
+		_spec.SetField(maintenancewindow.FieldReason, field.TypeString, value)
+	}
+	if value, ok := mwu.mutation.Migrate(); ok {
+		_spec.SetField(maintenancewindow.FieldMigrate, field.TypeBool, value)
+	}
+	if value, ok := mwu.mutation.CreatedBy(); ok {
+		_spec.SetField(maintenancewindow.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := mwu.mutation.StartedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartedAt, field.TypeTime, value)
+	}
+	if mwu.mutation.StartedAtCleared() {
+		_spec.ClearField(maintenancewindow.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := mwu.mutation.EndedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndedAt, field.TypeTime, value)
+	}
+	if mwu.mutation.EndedAtCleared() {
+		_spec.ClearField(maintenancewindow.FieldEndedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(mwu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mwu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancewindow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mwu.mutation.done = true
+	return n, nil
+}
+
+// MaintenanceWindowUpdateOne is the builder for updating a single MaintenanceWindow entity.
+type MaintenanceWindowUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MaintenanceWindowMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDeviceID sets the "device_id" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetDeviceID(i int) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.ResetDeviceID()
+	mwuo.mutation.SetDeviceID(i)
+	return mwuo
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableDeviceID(i *int) *MaintenanceWindowUpdateOne {
+	if i != nil {
+		mwuo.SetDeviceID(*i)
+	}
+	return mwuo
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (mwuo *MaintenanceWindowUpdateOne) AddDeviceID(i int) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.AddDeviceID(i)
+	return mwuo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetStartsAt(t time.Time) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetStartsAt(t)
+	return mwuo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableStartsAt(t *time.Time) *MaintenanceWindowUpdateOne {
+	if t != nil {
+		mwuo.SetStartsAt(*t)
+	}
+	return mwuo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetEndsAt(t time.Time) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetEndsAt(t)
+	return mwuo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableEndsAt(t *time.Time) *MaintenanceWindowUpdateOne {
+	if t != nil {
+		mwuo.SetEndsAt(*t)
+	}
+	return mwuo
+}
+
+// SetReason sets the "reason" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetReason(s string) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetReason(s)
+	return mwuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableReason(s *string) *MaintenanceWindowUpdateOne {
+	if s != nil {
+		mwuo.SetReason(*s)
+	}
+	return mwuo
+}
+
+// SetMigrate sets the "migrate" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetMigrate(b bool) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetMigrate(b)
+	return mwuo
+}
+
+// SetNillableMigrate sets the "migrate" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableMigrate(b *bool) *MaintenanceWindowUpdateOne {
+	if b != nil {
+		mwuo.SetMigrate(*b)
+	}
+	return mwuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetCreatedBy(s string) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetCreatedBy(s)
+	return mwuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableCreatedBy(s *string) *MaintenanceWindowUpdateOne {
+	if s != nil {
+		mwuo.SetCreatedBy(*s)
+	}
+	return mwuo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetStartedAt(t time.Time) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetStartedAt(t)
+	return mwuo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableStartedAt(t *time.Time) *MaintenanceWindowUpdateOne {
+	if t != nil {
+		mwuo.SetStartedAt(*t)
+	}
+	return mwuo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (mwuo *MaintenanceWindowUpdateOne) ClearStartedAt() *MaintenanceWindowUpdateOne {
+	mwuo.mutation.ClearStartedAt()
+	return mwuo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (mwuo *MaintenanceWindowUpdateOne) SetEndedAt(t time.Time) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.SetEndedAt(t)
+	return mwuo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (mwuo *MaintenanceWindowUpdateOne) SetNillableEndedAt(t *time.Time) *MaintenanceWindowUpdateOne {
+	if t != nil {
+		mwuo.SetEndedAt(*t)
+	}
+	return mwuo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (mwuo *MaintenanceWindowUpdateOne) ClearEndedAt() *MaintenanceWindowUpdateOne {
+	mwuo.mutation.ClearEndedAt()
+	return mwuo
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (mwuo *MaintenanceWindowUpdateOne) Mutation() *MaintenanceWindowMutation {
+	return mwuo.mutation
+}
+
+// Where appends a list predicates to the MaintenanceWindowUpdate builder.
+func (mwuo *MaintenanceWindowUpdateOne) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowUpdateOne {
+	mwuo.mutation.Where(ps...)
+	return mwuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mwuo *MaintenanceWindowUpdateOne) Select(field string, fields ...string) *MaintenanceWindowUpdateOne {
+	mwuo.fields = append([]string{field}, fields...)
+	return mwuo
+}
+
+// Save executes the query and returns the updated MaintenanceWindow entity.
+func (mwuo *MaintenanceWindowUpdateOne) Save(ctx context.Context) (*MaintenanceWindow, error) {
+	return withHooks(ctx, mwuo.sqlSave, mwuo.mutation, mwuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwuo *MaintenanceWindowUpdateOne) SaveX(ctx context.Context) *MaintenanceWindow {
+	node, err := mwuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mwuo *MaintenanceWindowUpdateOne) Exec(ctx context.Context) error {
+	_, err := mwuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwuo *MaintenanceWindowUpdateOne) ExecX(ctx context.Context) {
+	if err := mwuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mwuo *MaintenanceWindowUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MaintenanceWindowUpdateOne {
+	mwuo.modifiers = append(mwuo.modifiers, modifiers...)
+	return mwuo
+}
+
+func (mwuo *MaintenanceWindowUpdateOne) sqlSave(ctx context.Context) (_node *MaintenanceWindow, err error) {
+	_spec := sqlgraph.NewUpdateSpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeInt))
+	id, ok := mwuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MaintenanceWindow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mwuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, maintenancewindow.FieldID)
+		for _, f := range fields {
+			if !maintenancewindow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != maintenancewindow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mwuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwuo.mutation.DeviceID(); ok {
+		_spec.SetField(maintenancewindow.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := mwuo.mutation.AddedDeviceID(); ok {
+		_spec.AddField(maintenancewindow.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := mwuo.mutation.StartsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := mwuo.mutation.EndsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := mwuo.mutation.Reason(); ok {
+		_spec.SetField(maintenancewindow.FieldReason, field.TypeString, value)
+	}
+	if value, ok := mwuo.mutation.Migrate(); ok {
+		_spec.SetField(maintenancewindow.FieldMigrate, field.TypeBool, value)
+	}
+	if value, ok := mwuo.mutation.CreatedBy(); ok {
+		_spec.SetField(maintenancewindow.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := mwuo.mutation.StartedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartedAt, field.TypeTime, value)
+	}
+	if mwuo.mutation.StartedAtCleared() {
+		_spec.ClearField(maintenancewindow.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := mwuo.mutation.EndedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndedAt, field.TypeTime, value)
+	}
+	if mwuo.mutation.EndedAtCleared() {
+		_spec.ClearField(maintenancewindow.FieldEndedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(mwuo.modifiers...)
+	_node = &MaintenanceWindow{config: mwuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mwuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancewindow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mwuo.mutation.done = true
+	return _node, nil
+}
@@ -52,6 +52,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime},
 		{Name: "dr_id", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "fingerprint", Type: field.TypeString, Default: ""},
+		{Name: "fingerprint_at", Type: field.TypeTime, Nullable: true},
 		{Name: "dataset_root_datasets", Type: field.TypeInt, Nullable: true},
 	}
 	// DatasetTable holds the schema information for the "dataset" table.
@@ -62,7 +64,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "dataset_dataset_root_datasets",
-				Columns:    []*schema.Column{DatasetColumns[19]},
+				Columns:    []*schema.Column{DatasetColumns[21]},
 				RefColumns: []*schema.Column{DatasetRootColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -91,6 +93,12 @@ var (
 		{Name: "type", Type: field.TypeString, Default: "engine"},
 		{Name: "connection", Type: field.TypeString, Default: "rest"},
 		{Name: "available", Type: field.TypeString, Default: "false"},
+		{Name: "heartbeat_at", Type: field.TypeTime, Nullable: true},
+		{Name: "capabilities", Type: field.TypeJSON, Nullable: true},
+		{Name: "drain", Type: field.TypeBool, Default: false},
+		{Name: "drain_reason", Type: field.TypeString, Default: ""},
+		{Name: "drain_at", Type: field.TypeTime, Nullable: true},
+		{Name: "drain_window", Type: field.TypeInt, Default: 0},
 	}
 	// DeviceTable holds the schema information for the "device" table.
 	DeviceTable = &schema.Table{
@@ -98,6 +106,57 @@ var (
 		Columns:    DeviceColumns,
 		PrimaryKey: []*schema.Column{DeviceColumns[0]},
 	}
+	// DeviceTokenColumns holds the columns for the "device_token" table.
+	DeviceTokenColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Comment: "Label for admins", Default: ""},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Comment: "SHA-256 of the token, the token itself is shown only once"},
+		{Name: "device_id", Type: field.TypeInt, Comment: "Device registered with the token, 0 until the first registration", Default: 0},
+		{Name: "revoked", Type: field.TypeBool, Default: false},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true, Comment: "Last registration or heartbeat"},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// DeviceTokenTable holds the schema information for the "device_token" table.
+	DeviceTokenTable = &schema.Table{
+		Name:       "device_token",
+		Comment:    "engine이 스스로 등록하고 heartbeat를 보낼 때 사용하는 device별 enrollment token",
+		Columns:    DeviceTokenColumns,
+		PrimaryKey: []*schema.Column{DeviceTokenColumns[0]},
+	}
+	// EarlyStopColumns holds the columns for the "early_stop" table.
+	EarlyStopColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "task_id", Type: field.TypeInt, Comment: "Task ID"},
+		{Name: "modeling_id", Type: field.TypeInt, Comment: "Modeling ID of the stopped trial"},
+		{Name: "trial_uuid", Type: field.TypeString, Comment: "Stopped trial UUID"},
+		{Name: "policy", Type: field.TypeString, Comment: "median | patience | halving"},
+		{Name: "metric", Type: field.TypeString, Comment: "Metric the policy evaluated", Default: ""},
+		{Name: "epoch", Type: field.TypeInt, Comment: "Epoch at the decision", Default: 0},
+		{Name: "value", Type: field.TypeFloat64, Comment: "Metric value of the trial at the decision", Default: 0},
+		{Name: "reason", Type: field.TypeString, Comment: "Why the trial was stopped", Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EarlyStopTable holds the schema information for the "early_stop" table.
+	EarlyStopTable = &schema.Table{
+		Name:       "early_stop",
+		Comment:    "early stopping policy가 trial을 중단시킨 기록",
+		Columns:    EarlyStopColumns,
+		PrimaryKey: []*schema.Column{EarlyStopColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "earlystop_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{EarlyStopColumns[1]},
+			},
+			{
+				Name:    "earlystop_trial_uuid",
+				Unique:  false,
+				Columns: []*schema.Column{EarlyStopColumns[3]},
+			},
+		},
+	}
 	// EnginelogColumns holds the columns for the "enginelog" table.
 	EnginelogColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -122,6 +181,10 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "state", Type: field.TypeString, Default: "idle"},
 		{Name: "is_use", Type: field.TypeBool, Default: true},
+		{Name: "memory_total", Type: field.TypeFloat64, Default: 0},
+		{Name: "memory_used", Type: field.TypeFloat64, Default: 0},
+		{Name: "utilization_gpu", Type: field.TypeFloat64, Default: 0},
+		{Name: "utilization_memory", Type: field.TypeFloat64, Default: 0},
 		{Name: "device_id", Type: field.TypeInt, Nullable: true, Default: 0},
 	}
 	// GpuTable holds the schema information for the "gpu" table.
@@ -132,12 +195,47 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "gpu_device_gpu",
-				Columns:    []*schema.Column{GpuColumns[6]},
+				Columns:    []*schema.Column{GpuColumns[10]},
 				RefColumns: []*schema.Column{DeviceColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// GpuTelemetryColumns holds the columns for the "gpu_telemetry" table.
+	GpuTelemetryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "gpu_id", Type: field.TypeInt, Comment: "GPU ID"},
+		{Name: "device_id", Type: field.TypeInt, Comment: "Device ID of the GPU"},
+		{Name: "resolution", Type: field.TypeInt, Comment: "Bucket size in seconds"},
+		{Name: "bucket_at", Type: field.TypeTime, Comment: "Start of the bucket"},
+		{Name: "samples", Type: field.TypeInt, Comment: "Number of samples averaged in the bucket", Default: 0},
+		{Name: "utilization_gpu", Type: field.TypeFloat64, Comment: "%", Default: 0},
+		{Name: "utilization_memory", Type: field.TypeFloat64, Comment: "%", Default: 0},
+		{Name: "memory_used", Type: field.TypeFloat64, Comment: "MiB", Default: 0},
+		{Name: "memory_total", Type: field.TypeFloat64, Comment: "MiB", Default: 0},
+		{Name: "temperature", Type: field.TypeFloat64, Comment: "Celsius", Default: 0},
+		{Name: "power_draw", Type: field.TypeFloat64, Comment: "Watt", Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// GpuTelemetryTable holds the schema information for the "gpu_telemetry" table.
+	GpuTelemetryTable = &schema.Table{
+		Name:       "gpu_telemetry",
+		Comment:    "engine /api/sys로 수집한 GPU 상태를 resolution 단위 구간의 평균으로 저장한 시계열",
+		Columns:    GpuTelemetryColumns,
+		PrimaryKey: []*schema.Column{GpuTelemetryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gputelemetry_gpu_id_resolution_bucket_at",
+				Unique:  true,
+				Columns: []*schema.Column{GpuTelemetryColumns[1], GpuTelemetryColumns[3], GpuTelemetryColumns[4]},
+			},
+			{
+				Name:    "gputelemetry_device_id_resolution_bucket_at",
+				Unique:  false,
+				Columns: []*schema.Column{GpuTelemetryColumns[2], GpuTelemetryColumns[3], GpuTelemetryColumns[4]},
+			},
+		},
+	}
 	// HyperParamsHistoryColumns holds the columns for the "hyper_params_history" table.
 	HyperParamsHistoryColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -146,6 +244,7 @@ var (
 		{Name: "model", Type: field.TypeString, Default: ""},
 		{Name: "model_num", Type: field.TypeInt, Default: 0},
 		{Name: "params", Type: field.TypeString, Default: ""},
+		{Name: "score", Type: field.TypeFloat64, Nullable: true, Comment: "search metric of the trial"},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// HyperParamsHistoryTable holds the schema information for the "hyper_params_history" table.
@@ -155,6 +254,33 @@ var (
 		Columns:    HyperParamsHistoryColumns,
 		PrimaryKey: []*schema.Column{HyperParamsHistoryColumns[0]},
 	}
+	// MaintenanceWindowColumns holds the columns for the "maintenance_window" table.
+	MaintenanceWindowColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "device_id", Type: field.TypeInt, Comment: "Device ID to drain"},
+		{Name: "starts_at", Type: field.TypeTime, Comment: "Drain starts"},
+		{Name: "ends_at", Type: field.TypeTime, Comment: "Drain ends and the device is scheduled again"},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "migrate", Type: field.TypeBool, Comment: "Move loaded inference models to other GPUs instead of unloading them", Default: false},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true, Comment: "When the drain was applied"},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true, Comment: "When the drain was released"},
+	}
+	// MaintenanceWindowTable holds the schema information for the "maintenance_window" table.
+	MaintenanceWindowTable = &schema.Table{
+		Name:       "maintenance_window",
+		Comment:    "starts_at부터 ends_at까지 device를 drain하는 예약된 점검 시간",
+		Columns:    MaintenanceWindowColumns,
+		PrimaryKey: []*schema.Column{MaintenanceWindowColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "maintenancewindow_device_id_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{MaintenanceWindowColumns[1], MaintenanceWindowColumns[2]},
+			},
+		},
+	}
 	// MenuColumns holds the columns for the "menu" table.
 	MenuColumns = []*schema.Column{
 		{Name: "menu_key", Type: field.TypeString, Unique: true},
@@ -194,6 +320,18 @@ var (
 		{Name: "modeling_step", Type: field.TypeString, Comment: "current task step", Default: "idle"},
 		{Name: "performance", Type: field.TypeJSON, Comment: "Performance"},
 		{Name: "progress", Type: field.TypeFloat64, Comment: "task progress"},
+		{Name: "priority", Type: field.TypeInt, Comment: "Queue priority, higher runs first", Default: 0},
+		{Name: "username", Type: field.TypeString, Comment: "User who queued the modeling", Default: ""},
+		{Name: "queued_at", Type: field.TypeTime, Comment: "Time the modeling entered the queue"},
+		{Name: "queue_position", Type: field.TypeInt, Nullable: true, Comment: "Admin-set order within the same priority, lower runs first"},
+		{Name: "executor", Type: field.TypeString, Comment: "rest | slurm", Default: "rest"},
+		{Name: "job_id", Type: field.TypeString, Comment: "Executor job ID (slurm job id)", Default: ""},
+		{Name: "job_type", Type: field.TypeString, Comment: "train | evaluation | blind | finish", Default: ""},
+		{Name: "attempts", Type: field.TypeInt, Comment: "Failed dispatch attempts", Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true, Comment: "Do not dispatch before this time"},
+		{Name: "fail_reason", Type: field.TypeString, Comment: "Last dispatch error or failure reason", Default: ""},
+		{Name: "pipeline_stage", Type: field.TypeInt, Comment: "0: not in pipeline, 1: training, n: task.pipeline[n-2]", Default: 0},
+		{Name: "search_trial", Type: field.TypeInt, Comment: "0: not in hyperparameter search, n: n-th trial", Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
@@ -208,7 +346,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "modeling_task_modelings",
-				Columns:    []*schema.Column{ModelingColumns[14]},
+				Columns:    []*schema.Column{ModelingColumns[26]},
 				RefColumns: []*schema.Column{TaskColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -275,6 +413,37 @@ var (
 			},
 		},
 	}
+	// PreemptionColumns holds the columns for the "preemption" table.
+	PreemptionColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "modeling_id", Type: field.TypeInt, Comment: "Preempting modeling ID"},
+		{Name: "priority", Type: field.TypeInt, Comment: "Priority of the preempting modeling", Default: 0},
+		{Name: "username", Type: field.TypeString, Comment: "User who queued the preempting modeling", Default: ""},
+		{Name: "victim_id", Type: field.TypeInt, Comment: "Preempted modeling ID"},
+		{Name: "victim_priority", Type: field.TypeInt, Comment: "Priority of the preempted modeling", Default: 0},
+		{Name: "victim_username", Type: field.TypeString, Comment: "User who queued the preempted modeling", Default: ""},
+		{Name: "gpu_ids", Type: field.TypeJSON, Comment: "GPUs released by the preempted modeling"},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PreemptionTable holds the schema information for the "preemption" table.
+	PreemptionTable = &schema.Table{
+		Name:       "preemption",
+		Comment:    "높은 우선순위 modeling이 실행 중인 modeling을 중단시킨 기록",
+		Columns:    PreemptionColumns,
+		PrimaryKey: []*schema.Column{PreemptionColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "preemption_modeling_id",
+				Unique:  false,
+				Columns: []*schema.Column{PreemptionColumns[1]},
+			},
+			{
+				Name:    "preemption_victim_id",
+				Unique:  false,
+				Columns: []*schema.Column{PreemptionColumns[4]},
+			},
+		},
+	}
 	// ProjectColumns holds the columns for the "project" table.
 	ProjectColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -290,6 +459,115 @@ var (
 		Columns:    ProjectColumns,
 		PrimaryKey: []*schema.Column{ProjectColumns[0]},
 	}
+	// QuotaColumns holds the columns for the "quota" table.
+	QuotaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "target_type", Type: field.TypeString, Comment: "project | user_group"},
+		{Name: "target_id", Type: field.TypeInt, Comment: "Project ID or user group level"},
+		{Name: "max_gpus", Type: field.TypeInt, Comment: "Max concurrent GPUs, 0 is unlimited", Default: 0},
+		{Name: "max_queued", Type: field.TypeInt, Comment: "Max queued modelings, 0 is unlimited", Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// QuotaTable holds the schema information for the "quota" table.
+	QuotaTable = &schema.Table{
+		Name:       "quota",
+		Comment:    "project, user group 별 GPU 사용 제한",
+		Columns:    QuotaColumns,
+		PrimaryKey: []*schema.Column{QuotaColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quota_target_type_target_id",
+				Unique:  true,
+				Columns: []*schema.Column{QuotaColumns[1], QuotaColumns[2]},
+			},
+		},
+	}
+	// ReservationColumns holds the columns for the "reservation" table.
+	ReservationColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString, Default: ""},
+		{Name: "device_id", Type: field.TypeInt, Comment: "Reserved device"},
+		{Name: "gpu_ids", Type: field.TypeJSON, Nullable: true, Comment: "Reserved GPUs of the device, empty reserves every GPU"},
+		{Name: "project_id", Type: field.TypeInt, Comment: "Project that owns the reservation, 0 if reserved for a user", Default: 0},
+		{Name: "username", Type: field.TypeString, Comment: "User that owns the reservation, empty if reserved for a project", Default: ""},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ReservationTable holds the schema information for the "reservation" table.
+	ReservationTable = &schema.Table{
+		Name:       "reservation",
+		Comment:    "project 또는 user가 starts_at부터 ends_at까지 사용할 device, GPU 예약",
+		Columns:    ReservationColumns,
+		PrimaryKey: []*schema.Column{ReservationColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reservation_device_id_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReservationColumns[2], ReservationColumns[6]},
+			},
+		},
+	}
+	// ScheduleColumns holds the columns for the "schedule" table.
+	ScheduleColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "parent_id", Type: field.TypeInt, Comment: "Modeling whose params are used for retraining", Default: 0},
+		{Name: "trigger", Type: field.TypeString, Comment: "cron | dataset", Default: "cron"},
+		{Name: "cron", Type: field.TypeString, Comment: "minute hour day month weekday", Default: ""},
+		{Name: "dataset_id", Type: field.TypeInt, Comment: "Dataset watched by dataset trigger", Default: 0},
+		{Name: "paused", Type: field.TypeBool, Default: false},
+		{Name: "priority", Type: field.TypeInt, Comment: "Queue priority of created modelings", Default: 0},
+		{Name: "username", Type: field.TypeString, Comment: "User who created the schedule", Default: ""},
+		{Name: "trained_fingerprint", Type: field.TypeString, Comment: "Dataset fingerprint when the dataset trigger last created a modeling", Default: ""},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true, Comment: "Next cron run"},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeInt, Nullable: true, Comment: "Parent task ID", Default: 0},
+	}
+	// ScheduleTable holds the schema information for the "schedule" table.
+	ScheduleTable = &schema.Table{
+		Name:       "schedule",
+		Comment:    "task 재학습 일정: cron | dataset 변경",
+		Columns:    ScheduleColumns,
+		PrimaryKey: []*schema.Column{ScheduleColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "schedule_task_schedules",
+				Columns:    []*schema.Column{ScheduleColumns[13]},
+				RefColumns: []*schema.Column{TaskColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ScheduleHistoryColumns holds the columns for the "schedule_history" table.
+	ScheduleHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "modeling_id", Type: field.TypeInt, Comment: "Created modeling ID, 0 if not created", Default: 0},
+		{Name: "trigger", Type: field.TypeString, Comment: "cron | dataset", Default: "cron"},
+		{Name: "status", Type: field.TypeString, Comment: "created | skipped | failed", Default: "created"},
+		{Name: "message", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "schedule_id", Type: field.TypeInt, Nullable: true, Comment: "Parent schedule ID", Default: 0},
+	}
+	// ScheduleHistoryTable holds the schema information for the "schedule_history" table.
+	ScheduleHistoryTable = &schema.Table{
+		Name:       "schedule_history",
+		Comment:    "schedule이 실행된 기록",
+		Columns:    ScheduleHistoryColumns,
+		PrimaryKey: []*schema.Column{ScheduleHistoryColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "schedule_history_schedule_histories",
+				Columns:    []*schema.Column{ScheduleHistoryColumns[6]},
+				RefColumns: []*schema.Column{ScheduleColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// TaskColumns holds the columns for the "task" table.
 	TaskColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -299,6 +577,11 @@ var (
 		{Name: "engine_type", Type: field.TypeString, Comment: "engine type"},
 		{Name: "target_metric", Type: field.TypeString, Default: "wa"},
 		{Name: "params", Type: field.TypeJSON, Comment: "params by engine type"},
+		{Name: "pipeline", Type: field.TypeJSON, Nullable: true, Comment: "stages to run after training"},
+		{Name: "pipeline_status", Type: field.TypeString, Comment: "'' | run | complete | fail | cancel", Default: ""},
+		{Name: "search", Type: field.TypeJSON, Nullable: true, Comment: "hyperparameter search space and budget"},
+		{Name: "search_status", Type: field.TypeString, Comment: "'' | run | complete | fail | cancel", Default: ""},
+		{Name: "early_stop", Type: field.TypeJSON, Nullable: true, Comment: "early stopping policies for trials"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "project_id", Type: field.TypeInt, Nullable: true, Comment: "Project ID", Default: 0},
@@ -312,7 +595,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "task_project_tasks",
-				Columns:    []*schema.Column{TaskColumns[9]},
+				Columns:    []*schema.Column{TaskColumns[14]},
 				RefColumns: []*schema.Column{ProjectColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -444,14 +727,23 @@ var (
 		DatasetTable,
 		DatasetRootTable,
 		DeviceTable,
+		DeviceTokenTable,
+		EarlyStopTable,
 		EnginelogTable,
 		GpuTable,
+		GpuTelemetryTable,
 		HyperParamsHistoryTable,
+		MaintenanceWindowTable,
 		MenuTable,
 		ModelingTable,
 		ModelingDetailsTable,
 		ModelingModelsTable,
+		PreemptionTable,
 		ProjectTable,
+		QuotaTable,
+		ReservationTable,
+		ScheduleTable,
+		ScheduleHistoryTable,
 		TaskTable,
 		TrialTable,
 		TrialDetailsTable,
@@ -476,6 +768,12 @@ func init() {
 	DeviceTable.Annotation = &entsql.Annotation{
 		Table: "device",
 	}
+	DeviceTokenTable.Annotation = &entsql.Annotation{
+		Table: "device_token",
+	}
+	EarlyStopTable.Annotation = &entsql.Annotation{
+		Table: "early_stop",
+	}
 	EnginelogTable.Annotation = &entsql.Annotation{
 		Table: "enginelog",
 	}
@@ -483,9 +781,15 @@ func init() {
 	GpuTable.Annotation = &entsql.Annotation{
 		Table: "gpu",
 	}
+	GpuTelemetryTable.Annotation = &entsql.Annotation{
+		Table: "gpu_telemetry",
+	}
 	HyperParamsHistoryTable.Annotation = &entsql.Annotation{
 		Table: "hyper_params_history",
 	}
+	MaintenanceWindowTable.Annotation = &entsql.Annotation{
+		Table: "maintenance_window",
+	}
 	MenuTable.ForeignKeys[0].RefTable = MenuTable
 	MenuTable.Annotation = &entsql.Annotation{
 		Table: "menu",
@@ -502,9 +806,26 @@ func init() {
 	ModelingModelsTable.Annotation = &entsql.Annotation{
 		Table: "modeling_models",
 	}
+	PreemptionTable.Annotation = &entsql.Annotation{
+		Table: "preemption",
+	}
 	ProjectTable.Annotation = &entsql.Annotation{
 		Table: "project",
 	}
+	QuotaTable.Annotation = &entsql.Annotation{
+		Table: "quota",
+	}
+	ReservationTable.Annotation = &entsql.Annotation{
+		Table: "reservation",
+	}
+	ScheduleTable.ForeignKeys[0].RefTable = TaskTable
+	ScheduleTable.Annotation = &entsql.Annotation{
+		Table: "schedule",
+	}
+	ScheduleHistoryTable.ForeignKeys[0].RefTable = ScheduleTable
+	ScheduleHistoryTable.Annotation = &entsql.Annotation{
+		Table: "schedule_history",
+	}
 	TaskTable.ForeignKeys[0].RefTable = ProjectTable
 	TaskTable.Annotation = &entsql.Annotation{
 		Table: "task",
@@ -41,6 +41,30 @@ type Modeling struct {
 	Performance []string `json:"performance,omitempty"`
 	// task progress
 	Progress float64 `json:"progress,omitempty"`
+	// Queue priority, higher runs first
+	Priority int `json:"priority,omitempty"`
+	// User who queued the modeling
+	Username string `json:"username,omitempty"`
+	// Time the modeling entered the queue
+	QueuedAt time.Time `json:"queued_at,omitempty"`
+	// Admin-set order within the same priority, lower runs first
+	QueuePosition *int `json:"queue_position,omitempty"`
+	// rest | slurm
+	Executor string `json:"executor,omitempty"`
+	// Executor job ID (slurm job id)
+	JobID string `json:"job_id,omitempty"`
+	// train | evaluation | blind | finish
+	JobType string `json:"job_type,omitempty"`
+	// Failed dispatch attempts
+	Attempts int `json:"attempts,omitempty"`
+	// Do not dispatch before this time
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// Last dispatch error or failure reason
+	FailReason string `json:"fail_reason,omitempty"`
+	// 0: not in pipeline, 1: training, n: task.pipeline[n-2]
+	PipelineStage int `json:"pipeline_stage,omitempty"`
+	// 0: not in hyperparameter search, n: n-th trial
+	SearchTrial int `json:"search_trial,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -115,11 +139,11 @@ func (*Modeling) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case modeling.FieldProgress:
 			values[i] = new(sql.NullFloat64)
-		case modeling.FieldID, modeling.FieldLocalID, modeling.FieldTaskID, modeling.FieldParentID, modeling.FieldParentLocalID, modeling.FieldDatasetID:
+		case modeling.FieldID, modeling.FieldLocalID, modeling.FieldTaskID, modeling.FieldParentID, modeling.FieldParentLocalID, modeling.FieldDatasetID, modeling.FieldPriority, modeling.FieldQueuePosition, modeling.FieldAttempts, modeling.FieldPipelineStage, modeling.FieldSearchTrial:
 			values[i] = new(sql.NullInt64)
-		case modeling.FieldModelingType, modeling.FieldModelingStep:
+		case modeling.FieldModelingType, modeling.FieldModelingStep, modeling.FieldUsername, modeling.FieldExecutor, modeling.FieldJobID, modeling.FieldJobType, modeling.FieldFailReason:
 			values[i] = new(sql.NullString)
-		case modeling.FieldCreatedAt, modeling.FieldUpdatedAt, modeling.FieldStartedAt:
+		case modeling.FieldQueuedAt, modeling.FieldNextAttemptAt, modeling.FieldCreatedAt, modeling.FieldUpdatedAt, modeling.FieldStartedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -214,6 +238,80 @@ func (m *Modeling) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.Progress = value.Float64
 			}
+		case modeling.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				m.Priority = int(value.Int64)
+			}
+		case modeling.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				m.Username = value.String
+			}
+		case modeling.FieldQueuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field queued_at", values[i])
+			} else if value.Valid {
+				m.QueuedAt = value.Time
+			}
+		case modeling.FieldQueuePosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field queue_position", values[i])
+			} else if value.Valid {
+				m.QueuePosition = new(int)
+				*m.QueuePosition = int(value.Int64)
+			}
+		case modeling.FieldExecutor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field executor", values[i])
+			} else if value.Valid {
+				m.Executor = value.String
+			}
+		case modeling.FieldJobID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value.Valid {
+				m.JobID = value.String
+			}
+		case modeling.FieldJobType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_type", values[i])
+			} else if value.Valid {
+				m.JobType = value.String
+			}
+		case modeling.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				m.Attempts = int(value.Int64)
+			}
+		case modeling.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				m.NextAttemptAt = new(time.Time)
+				*m.NextAttemptAt = value.Time
+			}
+		case modeling.FieldFailReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fail_reason", values[i])
+			} else if value.Valid {
+				m.FailReason = value.String
+			}
+		case modeling.FieldPipelineStage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline_stage", values[i])
+			} else if value.Valid {
+				m.PipelineStage = int(value.Int64)
+			}
+		case modeling.FieldSearchTrial:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field search_trial", values[i])
+			} else if value.Valid {
+				m.SearchTrial = int(value.Int64)
+			}
 		case modeling.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -322,6 +420,46 @@ func (m *Modeling) String() string {
 	builder.WriteString("progress=")
 	builder.WriteString(fmt.Sprintf("%v", m.Progress))
 	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(m.Username)
+	builder.WriteString(", ")
+	builder.WriteString("queued_at=")
+	builder.WriteString(m.QueuedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := m.QueuePosition; v != nil {
+		builder.WriteString("queue_position=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("executor=")
+	builder.WriteString(m.Executor)
+	builder.WriteString(", ")
+	builder.WriteString("job_id=")
+	builder.WriteString(m.JobID)
+	builder.WriteString(", ")
+	builder.WriteString("job_type=")
+	builder.WriteString(m.JobType)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", m.Attempts))
+	builder.WriteString(", ")
+	if v := m.NextAttemptAt; v != nil {
+		builder.WriteString("next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fail_reason=")
+	builder.WriteString(m.FailReason)
+	builder.WriteString(", ")
+	builder.WriteString("pipeline_stage=")
+	builder.WriteString(fmt.Sprintf("%v", m.PipelineStage))
+	builder.WriteString(", ")
+	builder.WriteString("search_trial=")
+	builder.WriteString(fmt.Sprintf("%v", m.SearchTrial))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
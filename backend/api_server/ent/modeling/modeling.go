@@ -36,6 +36,30 @@ const (
 	FieldPerformance = "performance"
 	// FieldProgress holds the string denoting the progress field in the database.
 	FieldProgress = "progress"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldQueuedAt holds the string denoting the queued_at field in the database.
+	FieldQueuedAt = "queued_at"
+	// FieldQueuePosition holds the string denoting the queue_position field in the database.
+	FieldQueuePosition = "queue_position"
+	// FieldExecutor holds the string denoting the executor field in the database.
+	FieldExecutor = "executor"
+	// FieldJobID holds the string denoting the job_id field in the database.
+	FieldJobID = "job_id"
+	// FieldJobType holds the string denoting the job_type field in the database.
+	FieldJobType = "job_type"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldNextAttemptAt holds the string denoting the next_attempt_at field in the database.
+	FieldNextAttemptAt = "next_attempt_at"
+	// FieldFailReason holds the string denoting the fail_reason field in the database.
+	FieldFailReason = "fail_reason"
+	// FieldPipelineStage holds the string denoting the pipeline_stage field in the database.
+	FieldPipelineStage = "pipeline_stage"
+	// FieldSearchTrial holds the string denoting the search_trial field in the database.
+	FieldSearchTrial = "search_trial"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -96,6 +120,18 @@ var Columns = []string{
 	FieldModelingStep,
 	FieldPerformance,
 	FieldProgress,
+	FieldPriority,
+	FieldUsername,
+	FieldQueuedAt,
+	FieldQueuePosition,
+	FieldExecutor,
+	FieldJobID,
+	FieldJobType,
+	FieldAttempts,
+	FieldNextAttemptAt,
+	FieldFailReason,
+	FieldPipelineStage,
+	FieldSearchTrial,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldStartedAt,
@@ -132,6 +168,26 @@ var (
 	DefaultModelingStep string
 	// DefaultPerformance holds the default value on creation for the "performance" field.
 	DefaultPerformance []string
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultUsername holds the default value on creation for the "username" field.
+	DefaultUsername string
+	// DefaultQueuedAt holds the default value on creation for the "queued_at" field.
+	DefaultQueuedAt func() time.Time
+	// DefaultExecutor holds the default value on creation for the "executor" field.
+	DefaultExecutor string
+	// DefaultJobID holds the default value on creation for the "job_id" field.
+	DefaultJobID string
+	// DefaultJobType holds the default value on creation for the "job_type" field.
+	DefaultJobType string
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultFailReason holds the default value on creation for the "fail_reason" field.
+	DefaultFailReason string
+	// DefaultPipelineStage holds the default value on creation for the "pipeline_stage" field.
+	DefaultPipelineStage int
+	// DefaultSearchTrial holds the default value on creation for the "search_trial" field.
+	DefaultSearchTrial int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -186,6 +242,66 @@ func ByProgress(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProgress, opts...).ToFunc()
 }
 
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByQueuedAt orders the results by the queued_at field.
+func ByQueuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQueuedAt, opts...).ToFunc()
+}
+
+// ByQueuePosition orders the results by the queue_position field.
+func ByQueuePosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQueuePosition, opts...).ToFunc()
+}
+
+// ByExecutor orders the results by the executor field.
+func ByExecutor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExecutor, opts...).ToFunc()
+}
+
+// ByJobID orders the results by the job_id field.
+func ByJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobID, opts...).ToFunc()
+}
+
+// ByJobType orders the results by the job_type field.
+func ByJobType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobType, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByNextAttemptAt orders the results by the next_attempt_at field.
+func ByNextAttemptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextAttemptAt, opts...).ToFunc()
+}
+
+// ByFailReason orders the results by the fail_reason field.
+func ByFailReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailReason, opts...).ToFunc()
+}
+
+// ByPipelineStage orders the results by the pipeline_stage field.
+func ByPipelineStage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPipelineStage, opts...).ToFunc()
+}
+
+// BySearchTrial orders the results by the search_trial field.
+func BySearchTrial(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchTrial, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
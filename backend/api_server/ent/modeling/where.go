@@ -95,6 +95,66 @@ func Progress(v float64) predicate.Modeling {
 	return predicate.Modeling(sql.FieldEQ(FieldProgress, v))
 }
 
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldPriority, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldUsername, v))
+}
+
+// QueuedAt applies equality check predicate on the "queued_at" field. It's identical to QueuedAtEQ.
+func QueuedAt(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldQueuedAt, v))
+}
+
+// QueuePosition applies equality check predicate on the "queue_position" field. It's identical to QueuePositionEQ.
+func QueuePosition(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldQueuePosition, v))
+}
+
+// Executor applies equality check predicate on the "executor" field. It's identical to ExecutorEQ.
+func Executor(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldExecutor, v))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobType applies equality check predicate on the "job_type" field. It's identical to JobTypeEQ.
+func JobType(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldJobType, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// FailReason applies equality check predicate on the "fail_reason" field. It's identical to FailReasonEQ.
+func FailReason(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldFailReason, v))
+}
+
+// PipelineStage applies equality check predicate on the "pipeline_stage" field. It's identical to PipelineStageEQ.
+func PipelineStage(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldPipelineStage, v))
+}
+
+// SearchTrial applies equality check predicate on the "search_trial" field. It's identical to SearchTrialEQ.
+func SearchTrial(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldSearchTrial, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Modeling {
 	return predicate.Modeling(sql.FieldEQ(FieldCreatedAt, v))
@@ -500,6 +560,631 @@ func ProgressLTE(v float64) predicate.Modeling {
 	return predicate.Modeling(sql.FieldLTE(FieldProgress, v))
 }
 
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLTE(FieldPriority, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// QueuedAtEQ applies the EQ predicate on the "queued_at" field.
+func QueuedAtEQ(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldQueuedAt, v))
+}
+
+// QueuedAtNEQ applies the NEQ predicate on the "queued_at" field.
+func QueuedAtNEQ(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNEQ(FieldQueuedAt, v))
+}
+
+// QueuedAtIn applies the In predicate on the "queued_at" field.
+func QueuedAtIn(vs ...time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldIn(FieldQueuedAt, vs...))
+}
+
+// QueuedAtNotIn applies the NotIn predicate on the "queued_at" field.
+func QueuedAtNotIn(vs ...time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotIn(FieldQueuedAt, vs...))
+}
+
+// QueuedAtGT applies the GT predicate on the "queued_at" field.
+func QueuedAtGT(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGT(FieldQueuedAt, v))
+}
+
+// QueuedAtGTE applies the GTE predicate on the "queued_at" field.
+func QueuedAtGTE(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGTE(FieldQueuedAt, v))
+}
+
+// QueuedAtLT applies the LT predicate on the "queued_at" field.
+func QueuedAtLT(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLT(FieldQueuedAt, v))
+}
+
+// QueuedAtLTE applies the LTE predicate on the "queued_at" field.
+func QueuedAtLTE(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLTE(FieldQueuedAt, v))
+}
+
+// QueuePositionEQ applies the EQ predicate on the "queue_position" field.
+func QueuePositionEQ(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldQueuePosition, v))
+}
+
+// QueuePositionNEQ applies the NEQ predicate on the "queue_position" field.
+func QueuePositionNEQ(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNEQ(FieldQueuePosition, v))
+}
+
+// QueuePositionIn applies the In predicate on the "queue_position" field.
+func QueuePositionIn(vs ...int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldIn(FieldQueuePosition, vs...))
+}
+
+// QueuePositionNotIn applies the NotIn predicate on the "queue_position" field.
+func QueuePositionNotIn(vs ...int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotIn(FieldQueuePosition, vs...))
+}
+
+// QueuePositionGT applies the GT predicate on the "queue_position" field.
+func QueuePositionGT(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGT(FieldQueuePosition, v))
+}
+
+// QueuePositionGTE applies the GTE predicate on the "queue_position" field.
+func QueuePositionGTE(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGTE(FieldQueuePosition, v))
+}
+
+// QueuePositionLT applies the LT predicate on the "queue_position" field.
+func QueuePositionLT(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLT(FieldQueuePosition, v))
+}
+
+// QueuePositionLTE applies the LTE predicate on the "queue_position" field.
+func QueuePositionLTE(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLTE(FieldQueuePosition, v))
+}
+
+// QueuePositionIsNil applies the IsNil predicate on the "queue_position" field.
+func QueuePositionIsNil() predicate.Modeling {
+	return predicate.Modeling(sql.FieldIsNull(FieldQueuePosition))
+}
+
+// QueuePositionNotNil applies the NotNil predicate on the "queue_position" field.
+func QueuePositionNotNil() predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotNull(FieldQueuePosition))
+}
+
+// ExecutorEQ applies the EQ predicate on the "executor" field.
+func ExecutorEQ(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldExecutor, v))
+}
+
+// ExecutorNEQ applies the NEQ predicate on the "executor" field.
+func ExecutorNEQ(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNEQ(FieldExecutor, v))
+}
+
+// ExecutorIn applies the In predicate on the "executor" field.
+func ExecutorIn(vs ...string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldIn(FieldExecutor, vs...))
+}
+
+// ExecutorNotIn applies the NotIn predicate on the "executor" field.
+func ExecutorNotIn(vs ...string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotIn(FieldExecutor, vs...))
+}
+
+// ExecutorGT applies the GT predicate on the "executor" field.
+func ExecutorGT(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGT(FieldExecutor, v))
+}
+
+// ExecutorGTE applies the GTE predicate on the "executor" field.
+func ExecutorGTE(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGTE(FieldExecutor, v))
+}
+
+// ExecutorLT applies the LT predicate on the "executor" field.
+func ExecutorLT(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLT(FieldExecutor, v))
+}
+
+// ExecutorLTE applies the LTE predicate on the "executor" field.
+func ExecutorLTE(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLTE(FieldExecutor, v))
+}
+
+// ExecutorContains applies the Contains predicate on the "executor" field.
+func ExecutorContains(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldContains(FieldExecutor, v))
+}
+
+// ExecutorHasPrefix applies the HasPrefix predicate on the "executor" field.
+func ExecutorHasPrefix(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldHasPrefix(FieldExecutor, v))
+}
+
+// ExecutorHasSuffix applies the HasSuffix predicate on the "executor" field.
+func ExecutorHasSuffix(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldHasSuffix(FieldExecutor, v))
+}
+
+// ExecutorEqualFold applies the EqualFold predicate on the "executor" field.
+func ExecutorEqualFold(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEqualFold(FieldExecutor, v))
+}
+
+// ExecutorContainsFold applies the ContainsFold predicate on the "executor" field.
+func ExecutorContainsFold(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldContainsFold(FieldExecutor, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// JobIDGT applies the GT predicate on the "job_id" field.
+func JobIDGT(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGT(FieldJobID, v))
+}
+
+// JobIDGTE applies the GTE predicate on the "job_id" field.
+func JobIDGTE(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGTE(FieldJobID, v))
+}
+
+// JobIDLT applies the LT predicate on the "job_id" field.
+func JobIDLT(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLT(FieldJobID, v))
+}
+
+// JobIDLTE applies the LTE predicate on the "job_id" field.
+func JobIDLTE(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLTE(FieldJobID, v))
+}
+
+// JobIDContains applies the Contains predicate on the "job_id" field.
+func JobIDContains(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldContains(FieldJobID, v))
+}
+
+// JobIDHasPrefix applies the HasPrefix predicate on the "job_id" field.
+func JobIDHasPrefix(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldHasPrefix(FieldJobID, v))
+}
+
+// JobIDHasSuffix applies the HasSuffix predicate on the "job_id" field.
+func JobIDHasSuffix(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldHasSuffix(FieldJobID, v))
+}
+
+// JobIDEqualFold applies the EqualFold predicate on the "job_id" field.
+func JobIDEqualFold(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEqualFold(FieldJobID, v))
+}
+
+// JobIDContainsFold applies the ContainsFold predicate on the "job_id" field.
+func JobIDContainsFold(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldContainsFold(FieldJobID, v))
+}
+
+// JobTypeEQ applies the EQ predicate on the "job_type" field.
+func JobTypeEQ(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldJobType, v))
+}
+
+// JobTypeNEQ applies the NEQ predicate on the "job_type" field.
+func JobTypeNEQ(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNEQ(FieldJobType, v))
+}
+
+// JobTypeIn applies the In predicate on the "job_type" field.
+func JobTypeIn(vs ...string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldIn(FieldJobType, vs...))
+}
+
+// JobTypeNotIn applies the NotIn predicate on the "job_type" field.
+func JobTypeNotIn(vs ...string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotIn(FieldJobType, vs...))
+}
+
+// JobTypeGT applies the GT predicate on the "job_type" field.
+func JobTypeGT(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGT(FieldJobType, v))
+}
+
+// JobTypeGTE applies the GTE predicate on the "job_type" field.
+func JobTypeGTE(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGTE(FieldJobType, v))
+}
+
+// JobTypeLT applies the LT predicate on the "job_type" field.
+func JobTypeLT(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLT(FieldJobType, v))
+}
+
+// JobTypeLTE applies the LTE predicate on the "job_type" field.
+func JobTypeLTE(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLTE(FieldJobType, v))
+}
+
+// JobTypeContains applies the Contains predicate on the "job_type" field.
+func JobTypeContains(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldContains(FieldJobType, v))
+}
+
+// JobTypeHasPrefix applies the HasPrefix predicate on the "job_type" field.
+func JobTypeHasPrefix(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldHasPrefix(FieldJobType, v))
+}
+
+// JobTypeHasSuffix applies the HasSuffix predicate on the "job_type" field.
+func JobTypeHasSuffix(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldHasSuffix(FieldJobType, v))
+}
+
+// JobTypeEqualFold applies the EqualFold predicate on the "job_type" field.
+func JobTypeEqualFold(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEqualFold(FieldJobType, v))
+}
+
+// JobTypeContainsFold applies the ContainsFold predicate on the "job_type" field.
+func JobTypeContainsFold(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldContainsFold(FieldJobType, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLTE(FieldAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIsNil applies the IsNil predicate on the "next_attempt_at" field.
+func NextAttemptAtIsNil() predicate.Modeling {
+	return predicate.Modeling(sql.FieldIsNull(FieldNextAttemptAt))
+}
+
+// NextAttemptAtNotNil applies the NotNil predicate on the "next_attempt_at" field.
+func NextAttemptAtNotNil() predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotNull(FieldNextAttemptAt))
+}
+
+// FailReasonEQ applies the EQ predicate on the "fail_reason" field.
+func FailReasonEQ(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldFailReason, v))
+}
+
+// FailReasonNEQ applies the NEQ predicate on the "fail_reason" field.
+func FailReasonNEQ(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNEQ(FieldFailReason, v))
+}
+
+// FailReasonIn applies the In predicate on the "fail_reason" field.
+func FailReasonIn(vs ...string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldIn(FieldFailReason, vs...))
+}
+
+// FailReasonNotIn applies the NotIn predicate on the "fail_reason" field.
+func FailReasonNotIn(vs ...string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotIn(FieldFailReason, vs...))
+}
+
+// FailReasonGT applies the GT predicate on the "fail_reason" field.
+func FailReasonGT(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGT(FieldFailReason, v))
+}
+
+// FailReasonGTE applies the GTE predicate on the "fail_reason" field.
+func FailReasonGTE(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGTE(FieldFailReason, v))
+}
+
+// FailReasonLT applies the LT predicate on the "fail_reason" field.
+func FailReasonLT(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLT(FieldFailReason, v))
+}
+
+// FailReasonLTE applies the LTE predicate on the "fail_reason" field.
+func FailReasonLTE(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLTE(FieldFailReason, v))
+}
+
+// FailReasonContains applies the Contains predicate on the "fail_reason" field.
+func FailReasonContains(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldContains(FieldFailReason, v))
+}
+
+// FailReasonHasPrefix applies the HasPrefix predicate on the "fail_reason" field.
+func FailReasonHasPrefix(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldHasPrefix(FieldFailReason, v))
+}
+
+// FailReasonHasSuffix applies the HasSuffix predicate on the "fail_reason" field.
+func FailReasonHasSuffix(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldHasSuffix(FieldFailReason, v))
+}
+
+// FailReasonEqualFold applies the EqualFold predicate on the "fail_reason" field.
+func FailReasonEqualFold(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEqualFold(FieldFailReason, v))
+}
+
+// FailReasonContainsFold applies the ContainsFold predicate on the "fail_reason" field.
+func FailReasonContainsFold(v string) predicate.Modeling {
+	return predicate.Modeling(sql.FieldContainsFold(FieldFailReason, v))
+}
+
+// PipelineStageEQ applies the EQ predicate on the "pipeline_stage" field.
+func PipelineStageEQ(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldPipelineStage, v))
+}
+
+// PipelineStageNEQ applies the NEQ predicate on the "pipeline_stage" field.
+func PipelineStageNEQ(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNEQ(FieldPipelineStage, v))
+}
+
+// PipelineStageIn applies the In predicate on the "pipeline_stage" field.
+func PipelineStageIn(vs ...int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldIn(FieldPipelineStage, vs...))
+}
+
+// PipelineStageNotIn applies the NotIn predicate on the "pipeline_stage" field.
+func PipelineStageNotIn(vs ...int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotIn(FieldPipelineStage, vs...))
+}
+
+// PipelineStageGT applies the GT predicate on the "pipeline_stage" field.
+func PipelineStageGT(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGT(FieldPipelineStage, v))
+}
+
+// PipelineStageGTE applies the GTE predicate on the "pipeline_stage" field.
+func PipelineStageGTE(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGTE(FieldPipelineStage, v))
+}
+
+// PipelineStageLT applies the LT predicate on the "pipeline_stage" field.
+func PipelineStageLT(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLT(FieldPipelineStage, v))
+}
+
+// PipelineStageLTE applies the LTE predicate on the "pipeline_stage" field.
+func PipelineStageLTE(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLTE(FieldPipelineStage, v))
+}
+
+// SearchTrialEQ applies the EQ predicate on the "search_trial" field.
+func SearchTrialEQ(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldEQ(FieldSearchTrial, v))
+}
+
+// SearchTrialNEQ applies the NEQ predicate on the "search_trial" field.
+func SearchTrialNEQ(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNEQ(FieldSearchTrial, v))
+}
+
+// SearchTrialIn applies the In predicate on the "search_trial" field.
+func SearchTrialIn(vs ...int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldIn(FieldSearchTrial, vs...))
+}
+
+// SearchTrialNotIn applies the NotIn predicate on the "search_trial" field.
+func SearchTrialNotIn(vs ...int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldNotIn(FieldSearchTrial, vs...))
+}
+
+// SearchTrialGT applies the GT predicate on the "search_trial" field.
+func SearchTrialGT(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGT(FieldSearchTrial, v))
+}
+
+// SearchTrialGTE applies the GTE predicate on the "search_trial" field.
+func SearchTrialGTE(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldGTE(FieldSearchTrial, v))
+}
+
+// SearchTrialLT applies the LT predicate on the "search_trial" field.
+func SearchTrialLT(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLT(FieldSearchTrial, v))
+}
+
+// SearchTrialLTE applies the LTE predicate on the "search_trial" field.
+func SearchTrialLTE(v int) predicate.Modeling {
+	return predicate.Modeling(sql.FieldLTE(FieldSearchTrial, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Modeling {
 	return predicate.Modeling(sql.FieldEQ(FieldCreatedAt, v))
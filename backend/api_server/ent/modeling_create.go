@@ -148,6 +148,174 @@ func (mc *ModelingCreate) SetProgress(f float64) *ModelingCreate {
 	return mc
 }
 
+// SetPriority sets the "priority" field.
+func (mc *ModelingCreate) SetPriority(i int) *ModelingCreate {
+	mc.mutation.SetPriority(i)
+	return mc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (mc *ModelingCreate) SetNillablePriority(i *int) *ModelingCreate {
+	if i != nil {
+		mc.SetPriority(*i)
+	}
+	return mc
+}
+
+// SetUsername sets the "username" field.
+func (mc *ModelingCreate) SetUsername(s string) *ModelingCreate {
+	mc.mutation.SetUsername(s)
+	return mc
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (mc *ModelingCreate) SetNillableUsername(s *string) *ModelingCreate {
+	if s != nil {
+		mc.SetUsername(*s)
+	}
+	return mc
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (mc *ModelingCreate) SetQueuedAt(t time.Time) *ModelingCreate {
+	mc.mutation.SetQueuedAt(t)
+	return mc
+}
+
+// SetNillableQueuedAt sets the "queued_at" field if the given value is not nil.
+func (mc *ModelingCreate) SetNillableQueuedAt(t *time.Time) *ModelingCreate {
+	if t != nil {
+		mc.SetQueuedAt(*t)
+	}
+	return mc
+}
+
+// SetQueuePosition sets the "queue_position" field.
+func (mc *ModelingCreate) SetQueuePosition(i int) *ModelingCreate {
+	mc.mutation.SetQueuePosition(i)
+	return mc
+}
+
+// SetNillableQueuePosition sets the "queue_position" field if the given value is not nil.
+func (mc *ModelingCreate) SetNillableQueuePosition(i *int) *ModelingCreate {
+	if i != nil {
+		mc.SetQueuePosition(*i)
+	}
+	return mc
+}
+
+// SetExecutor sets the "executor" field.
+func (mc *ModelingCreate) SetExecutor(s string) *ModelingCreate {
+	mc.mutation.SetExecutor(s)
+	return mc
+}
+
+// SetNillableExecutor sets the "executor" field if the given value is not nil.
+func (mc *ModelingCreate) SetNillableExecutor(s *string) *ModelingCreate {
+	if s != nil {
+		mc.SetExecutor(*s)
+	}
+	return mc
+}
+
+// SetJobID sets the "job_id" field.
+func (mc *ModelingCreate) SetJobID(s string) *ModelingCreate {
+	mc.mutation.SetJobID(s)
+	return mc
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (mc *ModelingCreate) SetNillableJobID(s *string) *ModelingCreate {
+	if s != nil {
+		mc.SetJobID(*s)
+	}
+	return mc
+}
+
+// SetJobType sets the "job_type" field.
+func (mc *ModelingCreate) SetJobType(s string) *ModelingCreate {
+	mc.mutation.SetJobType(s)
+	return mc
+}
+
+// SetNillableJobType sets the "job_type" field if the given value is not nil.
+func (mc *ModelingCreate) SetNillableJobType(s *string) *ModelingCreate {
+	if s != nil {
+		mc.SetJobType(*s)
+	}
+	return mc
+}
+
+// SetAttempts sets the "attempts" field.
+func (mc *ModelingCreate) SetAttempts(i int) *ModelingCreate {
+	mc.mutation.SetAttempts(i)
+	return mc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (mc *ModelingCreate) SetNillableAttempts(i *int) *ModelingCreate {
+	if i != nil {
+		mc.SetAttempts(*i)
+	}
+	return mc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (mc *ModelingCreate) SetNextAttemptAt(t time.Time) *ModelingCreate {
+	mc.mutation.SetNextAttemptAt(t)
+	return mc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (mc *ModelingCreate) SetNillableNextAttemptAt(t *time.Time) *ModelingCreate {
+	if t != nil {
+		mc.SetNextAttemptAt(*t)
+	}
+	return mc
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (mc *ModelingCreate) SetFailReason(s string) *ModelingCreate {
+	mc.mutation.SetFailReason(s)
+	return mc
+}
+
+// SetNillableFailReason sets the "fail_reason" field if the given value is not nil.
+func (mc *ModelingCreate) SetNillableFailReason(s *string) *ModelingCreate {
+	if s != nil {
+		mc.SetFailReason(*s)
+	}
+	return mc
+}
+
+// SetPipelineStage sets the "pipeline_stage" field.
+func (mc *ModelingCreate) SetPipelineStage(i int) *ModelingCreate {
+	mc.mutation.SetPipelineStage(i)
+	return mc
+}
+
+// SetNillablePipelineStage sets the "pipeline_stage" field if the given value is not nil.
+func (mc *ModelingCreate) SetNillablePipelineStage(i *int) *ModelingCreate {
+	if i != nil {
+		mc.SetPipelineStage(*i)
+	}
+	return mc
+}
+
+// SetSearchTrial sets the "search_trial" field.
+func (mc *ModelingCreate) SetSearchTrial(i int) *ModelingCreate {
+	mc.mutation.SetSearchTrial(i)
+	return mc
+}
+
+// SetNillableSearchTrial sets the "search_trial" field if the given value is not nil.
+func (mc *ModelingCreate) SetNillableSearchTrial(i *int) *ModelingCreate {
+	if i != nil {
+		mc.SetSearchTrial(*i)
+	}
+	return mc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (mc *ModelingCreate) SetCreatedAt(t time.Time) *ModelingCreate {
 	mc.mutation.SetCreatedAt(t)
@@ -321,6 +489,46 @@ func (mc *ModelingCreate) defaults() {
 		v := modeling.DefaultPerformance
 		mc.mutation.SetPerformance(v)
 	}
+	if _, ok := mc.mutation.Priority(); !ok {
+		v := modeling.DefaultPriority
+		mc.mutation.SetPriority(v)
+	}
+	if _, ok := mc.mutation.Username(); !ok {
+		v := modeling.DefaultUsername
+		mc.mutation.SetUsername(v)
+	}
+	if _, ok := mc.mutation.QueuedAt(); !ok {
+		v := modeling.DefaultQueuedAt()
+		mc.mutation.SetQueuedAt(v)
+	}
+	if _, ok := mc.mutation.Executor(); !ok {
+		v := modeling.DefaultExecutor
+		mc.mutation.SetExecutor(v)
+	}
+	if _, ok := mc.mutation.JobID(); !ok {
+		v := modeling.DefaultJobID
+		mc.mutation.SetJobID(v)
+	}
+	if _, ok := mc.mutation.JobType(); !ok {
+		v := modeling.DefaultJobType
+		mc.mutation.SetJobType(v)
+	}
+	if _, ok := mc.mutation.Attempts(); !ok {
+		v := modeling.DefaultAttempts
+		mc.mutation.SetAttempts(v)
+	}
+	if _, ok := mc.mutation.FailReason(); !ok {
+		v := modeling.DefaultFailReason
+		mc.mutation.SetFailReason(v)
+	}
+	if _, ok := mc.mutation.PipelineStage(); !ok {
+		v := modeling.DefaultPipelineStage
+		mc.mutation.SetPipelineStage(v)
+	}
+	if _, ok := mc.mutation.SearchTrial(); !ok {
+		v := modeling.DefaultSearchTrial
+		mc.mutation.SetSearchTrial(v)
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		v := modeling.DefaultCreatedAt()
 		mc.mutation.SetCreatedAt(v)
@@ -354,6 +562,36 @@ func (mc *ModelingCreate) check() error {
 	if _, ok := mc.mutation.Progress(); !ok {
 		return &ValidationError{Name: "progress", err: errors.New(`ent: missing required field "Modeling.progress"`)}
 	}
+	if _, ok := mc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Modeling.priority"`)}
+	}
+	if _, ok := mc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "Modeling.username"`)}
+	}
+	if _, ok := mc.mutation.QueuedAt(); !ok {
+		return &ValidationError{Name: "queued_at", err: errors.New(`ent: missing required field "Modeling.queued_at"`)}
+	}
+	if _, ok := mc.mutation.Executor(); !ok {
+		return &ValidationError{Name: "executor", err: errors.New(`ent: missing required field "Modeling.executor"`)}
+	}
+	if _, ok := mc.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`ent: missing required field "Modeling.job_id"`)}
+	}
+	if _, ok := mc.mutation.JobType(); !ok {
+		return &ValidationError{Name: "job_type", err: errors.New(`ent: missing required field "Modeling.job_type"`)}
+	}
+	if _, ok := mc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "Modeling.attempts"`)}
+	}
+	if _, ok := mc.mutation.FailReason(); !ok {
+		return &ValidationError{Name: "fail_reason", err: errors.New(`ent: missing required field "Modeling.fail_reason"`)}
+	}
+	if _, ok := mc.mutation.PipelineStage(); !ok {
+		return &ValidationError{Name: "pipeline_stage", err: errors.New(`ent: missing required field "Modeling.pipeline_stage"`)}
+	}
+	if _, ok := mc.mutation.SearchTrial(); !ok {
+		return &ValidationError{Name: "search_trial", err: errors.New(`ent: missing required field "Modeling.search_trial"`)}
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Modeling.created_at"`)}
 	}
@@ -433,6 +671,54 @@ func (mc *ModelingCreate) createSpec() (*Modeling, *sqlgraph.CreateSpec) {
 		_spec.SetField(modeling.FieldProgress, field.TypeFloat64, value)
 		_node.Progress = value
 	}
+	if value, ok := mc.mutation.Priority(); ok {
+		_spec.SetField(modeling.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := mc.mutation.Username(); ok {
+		_spec.SetField(modeling.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := mc.mutation.QueuedAt(); ok {
+		_spec.SetField(modeling.FieldQueuedAt, field.TypeTime, value)
+		_node.QueuedAt = value
+	}
+	if value, ok := mc.mutation.QueuePosition(); ok {
+		_spec.SetField(modeling.FieldQueuePosition, field.TypeInt, value)
+		_node.QueuePosition = &value
+	}
+	if value, ok := mc.mutation.Executor(); ok {
+		_spec.SetField(modeling.FieldExecutor, field.TypeString, value)
+		_node.Executor = value
+	}
+	if value, ok := mc.mutation.JobID(); ok {
+		_spec.SetField(modeling.FieldJobID, field.TypeString, value)
+		_node.JobID = value
+	}
+	if value, ok := mc.mutation.JobType(); ok {
+		_spec.SetField(modeling.FieldJobType, field.TypeString, value)
+		_node.JobType = value
+	}
+	if value, ok := mc.mutation.Attempts(); ok {
+		_spec.SetField(modeling.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := mc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(modeling.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := mc.mutation.FailReason(); ok {
+		_spec.SetField(modeling.FieldFailReason, field.TypeString, value)
+		_node.FailReason = value
+	}
+	if value, ok := mc.mutation.PipelineStage(); ok {
+		_spec.SetField(modeling.FieldPipelineStage, field.TypeInt, value)
+		_node.PipelineStage = value
+	}
+	if value, ok := mc.mutation.SearchTrial(); ok {
+		_spec.SetField(modeling.FieldSearchTrial, field.TypeInt, value)
+		_node.SearchTrial = value
+	}
 	if value, ok := mc.mutation.CreatedAt(); ok {
 		_spec.SetField(modeling.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -748,6 +1034,192 @@ func (u *ModelingUpsert) AddProgress(v float64) *ModelingUpsert {
 	return u
 }
 
+// SetPriority sets the "priority" field.
+func (u *ModelingUpsert) SetPriority(v int) *ModelingUpsert {
+	u.Set(modeling.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *ModelingUpsert) UpdatePriority() *ModelingUpsert {
+	u.SetExcluded(modeling.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *ModelingUpsert) AddPriority(v int) *ModelingUpsert {
+	u.Add(modeling.FieldPriority, v)
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *ModelingUpsert) SetUsername(v string) *ModelingUpsert {
+	u.Set(modeling.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *ModelingUpsert) UpdateUsername() *ModelingUpsert {
+	u.SetExcluded(modeling.FieldUsername)
+	return u
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (u *ModelingUpsert) SetQueuedAt(v time.Time) *ModelingUpsert {
+	u.Set(modeling.FieldQueuedAt, v)
+	return u
+}
+
+// UpdateQueuedAt sets the "queued_at" field to the value that was provided on create.
+func (u *ModelingUpsert) UpdateQueuedAt() *ModelingUpsert {
+	u.SetExcluded(modeling.FieldQueuedAt)
+	return u
+}
+
+// SetQueuePosition sets the "queue_position" field.
+func (u *ModelingUpsert) SetQueuePosition(v int) *ModelingUpsert {
+	u.Set(modeling.FieldQueuePosition, v)
+	return u
+}
+
+// UpdateQueuePosition sets the "queue_position" field to the value that was provided on create.
+func (u *ModelingUpsert) UpdateQueuePosition() *ModelingUpsert {
+	u.SetExcluded(modeling.FieldQueuePosition)
+	return u
+}
+
+// AddQueuePosition adds v to the "queue_position" field.
+func (u *ModelingUpsert) AddQueuePosition(v int) *ModelingUpsert {
+	u.Add(modeling.FieldQueuePosition, v)
+	return u
+}
+
+// ClearQueuePosition clears the value of the "queue_position" field.
+func (u *ModelingUpsert) ClearQueuePosition() *ModelingUpsert {
+	u.SetNull(modeling.FieldQueuePosition)
+	return u
+}
+
+// SetExecutor sets the "executor" field.
+func (u *ModelingUpsert) SetExecutor(v string) *ModelingUpsert {
+	u.Set(modeling.FieldExecutor, v)
+	return u
+}
+
+// UpdateExecutor sets the "executor" field to the value that was provided on create.
+func (u *ModelingUpsert) UpdateExecutor() *ModelingUpsert {
+	u.SetExcluded(modeling.FieldExecutor)
+	return u
+}
+
+// SetJobID sets the "job_id" field.
+func (u *ModelingUpsert) SetJobID(v string) *ModelingUpsert {
+	u.Set(modeling.FieldJobID, v)
+	return u
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *ModelingUpsert) UpdateJobID() *ModelingUpsert {
+	u.SetExcluded(modeling.FieldJobID)
+	return u
+}
+
+// SetJobType sets the "job_type" field.
+func (u *ModelingUpsert) SetJobType(v string) *ModelingUpsert {
+	u.Set(modeling.FieldJobType, v)
+	return u
+}
+
+// UpdateJobType sets the "job_type" field to the value that was provided on create.
+func (u *ModelingUpsert) UpdateJobType() *ModelingUpsert {
+	u.SetExcluded(modeling.FieldJobType)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *ModelingUpsert) SetAttempts(v int) *ModelingUpsert {
+	u.Set(modeling.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *ModelingUpsert) UpdateAttempts() *ModelingUpsert {
+	u.SetExcluded(modeling.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *ModelingUpsert) AddAttempts(v int) *ModelingUpsert {
+	u.Add(modeling.FieldAttempts, v)
+	return u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *ModelingUpsert) SetNextAttemptAt(v time.Time) *ModelingUpsert {
+	u.Set(modeling.FieldNextAttemptAt, v)
+	return u
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *ModelingUpsert) UpdateNextAttemptAt() *ModelingUpsert {
+	u.SetExcluded(modeling.FieldNextAttemptAt)
+	return u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *ModelingUpsert) ClearNextAttemptAt() *ModelingUpsert {
+	u.SetNull(modeling.FieldNextAttemptAt)
+	return u
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (u *ModelingUpsert) SetFailReason(v string) *ModelingUpsert {
+	u.Set(modeling.FieldFailReason, v)
+	return u
+}
+
+// UpdateFailReason sets the "fail_reason" field to the value that was provided on create.
+func (u *ModelingUpsert) UpdateFailReason() *ModelingUpsert {
+	u.SetExcluded(modeling.FieldFailReason)
+	return u
+}
+
+// SetPipelineStage sets the "pipeline_stage" field.
+func (u *ModelingUpsert) SetPipelineStage(v int) *ModelingUpsert {
+	u.Set(modeling.FieldPipelineStage, v)
+	return u
+}
+
+// UpdatePipelineStage sets the "pipeline_stage" field to the value that was provided on create.
+func (u *ModelingUpsert) UpdatePipelineStage() *ModelingUpsert {
+	u.SetExcluded(modeling.FieldPipelineStage)
+	return u
+}
+
+// AddPipelineStage adds v to the "pipeline_stage" field.
+func (u *ModelingUpsert) AddPipelineStage(v int) *ModelingUpsert {
+	u.Add(modeling.FieldPipelineStage, v)
+	return u
+}
+
+// SetSearchTrial sets the "search_trial" field.
+func (u *ModelingUpsert) SetSearchTrial(v int) *ModelingUpsert {
+	u.Set(modeling.FieldSearchTrial, v)
+	return u
+}
+
+// UpdateSearchTrial sets the "search_trial" field to the value that was provided on create.
+func (u *ModelingUpsert) UpdateSearchTrial() *ModelingUpsert {
+	u.SetExcluded(modeling.FieldSearchTrial)
+	return u
+}
+
+// AddSearchTrial adds v to the "search_trial" field.
+func (u *ModelingUpsert) AddSearchTrial(v int) *ModelingUpsert {
+	u.Add(modeling.FieldSearchTrial, v)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ModelingUpsert) SetUpdatedAt(v time.Time) *ModelingUpsert {
 	u.Set(modeling.FieldUpdatedAt, v)
@@ -1046,6 +1518,223 @@ func (u *ModelingUpsertOne) UpdateProgress() *ModelingUpsertOne {
 	})
 }
 
+// SetPriority sets the "priority" field.
+func (u *ModelingUpsertOne) SetPriority(v int) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *ModelingUpsertOne) AddPriority(v int) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *ModelingUpsertOne) UpdatePriority() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *ModelingUpsertOne) SetUsername(v string) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *ModelingUpsertOne) UpdateUsername() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (u *ModelingUpsertOne) SetQueuedAt(v time.Time) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetQueuedAt(v)
+	})
+}
+
+// UpdateQueuedAt sets the "queued_at" field to the value that was provided on create.
+func (u *ModelingUpsertOne) UpdateQueuedAt() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateQueuedAt()
+	})
+}
+
+// SetQueuePosition sets the "queue_position" field.
+func (u *ModelingUpsertOne) SetQueuePosition(v int) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetQueuePosition(v)
+	})
+}
+
+// AddQueuePosition adds v to the "queue_position" field.
+func (u *ModelingUpsertOne) AddQueuePosition(v int) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.AddQueuePosition(v)
+	})
+}
+
+// UpdateQueuePosition sets the "queue_position" field to the value that was provided on create.
+func (u *ModelingUpsertOne) UpdateQueuePosition() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateQueuePosition()
+	})
+}
+
+// ClearQueuePosition clears the value of the "queue_position" field.
+func (u *ModelingUpsertOne) ClearQueuePosition() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.ClearQueuePosition()
+	})
+}
+
+// SetExecutor sets the "executor" field.
+func (u *ModelingUpsertOne) SetExecutor(v string) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetExecutor(v)
+	})
+}
+
+// UpdateExecutor sets the "executor" field to the value that was provided on create.
+func (u *ModelingUpsertOne) UpdateExecutor() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateExecutor()
+	})
+}
+
+// SetJobID sets the "job_id" field.
+func (u *ModelingUpsertOne) SetJobID(v string) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetJobID(v)
+	})
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *ModelingUpsertOne) UpdateJobID() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateJobID()
+	})
+}
+
+// SetJobType sets the "job_type" field.
+func (u *ModelingUpsertOne) SetJobType(v string) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetJobType(v)
+	})
+}
+
+// UpdateJobType sets the "job_type" field to the value that was provided on create.
+func (u *ModelingUpsertOne) UpdateJobType() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateJobType()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *ModelingUpsertOne) SetAttempts(v int) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *ModelingUpsertOne) AddAttempts(v int) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *ModelingUpsertOne) UpdateAttempts() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *ModelingUpsertOne) SetNextAttemptAt(v time.Time) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *ModelingUpsertOne) UpdateNextAttemptAt() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *ModelingUpsertOne) ClearNextAttemptAt() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.ClearNextAttemptAt()
+	})
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (u *ModelingUpsertOne) SetFailReason(v string) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetFailReason(v)
+	})
+}
+
+// UpdateFailReason sets the "fail_reason" field to the value that was provided on create.
+func (u *ModelingUpsertOne) UpdateFailReason() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateFailReason()
+	})
+}
+
+// SetPipelineStage sets the "pipeline_stage" field.
+func (u *ModelingUpsertOne) SetPipelineStage(v int) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetPipelineStage(v)
+	})
+}
+
+// AddPipelineStage adds v to the "pipeline_stage" field.
+func (u *ModelingUpsertOne) AddPipelineStage(v int) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.AddPipelineStage(v)
+	})
+}
+
+// UpdatePipelineStage sets the "pipeline_stage" field to the value that was provided on create.
+func (u *ModelingUpsertOne) UpdatePipelineStage() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdatePipelineStage()
+	})
+}
+
+// SetSearchTrial sets the "search_trial" field.
+func (u *ModelingUpsertOne) SetSearchTrial(v int) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetSearchTrial(v)
+	})
+}
+
+// AddSearchTrial adds v to the "search_trial" field.
+func (u *ModelingUpsertOne) AddSearchTrial(v int) *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.AddSearchTrial(v)
+	})
+}
+
+// UpdateSearchTrial sets the "search_trial" field to the value that was provided on create.
+func (u *ModelingUpsertOne) UpdateSearchTrial() *ModelingUpsertOne {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateSearchTrial()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ModelingUpsertOne) SetUpdatedAt(v time.Time) *ModelingUpsertOne {
 	return u.Update(func(s *ModelingUpsert) {
@@ -1515,6 +2204,223 @@ func (u *ModelingUpsertBulk) UpdateProgress() *ModelingUpsertBulk {
 	})
 }
 
+// SetPriority sets the "priority" field.
+func (u *ModelingUpsertBulk) SetPriority(v int) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *ModelingUpsertBulk) AddPriority(v int) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *ModelingUpsertBulk) UpdatePriority() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *ModelingUpsertBulk) SetUsername(v string) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *ModelingUpsertBulk) UpdateUsername() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (u *ModelingUpsertBulk) SetQueuedAt(v time.Time) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetQueuedAt(v)
+	})
+}
+
+// UpdateQueuedAt sets the "queued_at" field to the value that was provided on create.
+func (u *ModelingUpsertBulk) UpdateQueuedAt() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateQueuedAt()
+	})
+}
+
+// SetQueuePosition sets the "queue_position" field.
+func (u *ModelingUpsertBulk) SetQueuePosition(v int) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetQueuePosition(v)
+	})
+}
+
+// AddQueuePosition adds v to the "queue_position" field.
+func (u *ModelingUpsertBulk) AddQueuePosition(v int) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.AddQueuePosition(v)
+	})
+}
+
+// UpdateQueuePosition sets the "queue_position" field to the value that was provided on create.
+func (u *ModelingUpsertBulk) UpdateQueuePosition() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateQueuePosition()
+	})
+}
+
+// ClearQueuePosition clears the value of the "queue_position" field.
+func (u *ModelingUpsertBulk) ClearQueuePosition() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.ClearQueuePosition()
+	})
+}
+
+// SetExecutor sets the "executor" field.
+func (u *ModelingUpsertBulk) SetExecutor(v string) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetExecutor(v)
+	})
+}
+
+// UpdateExecutor sets the "executor" field to the value that was provided on create.
+func (u *ModelingUpsertBulk) UpdateExecutor() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateExecutor()
+	})
+}
+
+// SetJobID sets the "job_id" field.
+func (u *ModelingUpsertBulk) SetJobID(v string) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetJobID(v)
+	})
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *ModelingUpsertBulk) UpdateJobID() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateJobID()
+	})
+}
+
+// SetJobType sets the "job_type" field.
+func (u *ModelingUpsertBulk) SetJobType(v string) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetJobType(v)
+	})
+}
+
+// UpdateJobType sets the "job_type" field to the value that was provided on create.
+func (u *ModelingUpsertBulk) UpdateJobType() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateJobType()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *ModelingUpsertBulk) SetAttempts(v int) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *ModelingUpsertBulk) AddAttempts(v int) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *ModelingUpsertBulk) UpdateAttempts() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *ModelingUpsertBulk) SetNextAttemptAt(v time.Time) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *ModelingUpsertBulk) UpdateNextAttemptAt() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *ModelingUpsertBulk) ClearNextAttemptAt() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.ClearNextAttemptAt()
+	})
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (u *ModelingUpsertBulk) SetFailReason(v string) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetFailReason(v)
+	})
+}
+
+// UpdateFailReason sets the "fail_reason" field to the value that was provided on create.
+func (u *ModelingUpsertBulk) UpdateFailReason() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateFailReason()
+	})
+}
+
+// SetPipelineStage sets the "pipeline_stage" field.
+func (u *ModelingUpsertBulk) SetPipelineStage(v int) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetPipelineStage(v)
+	})
+}
+
+// AddPipelineStage adds v to the "pipeline_stage" field.
+func (u *ModelingUpsertBulk) AddPipelineStage(v int) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.AddPipelineStage(v)
+	})
+}
+
+// UpdatePipelineStage sets the "pipeline_stage" field to the value that was provided on create.
+func (u *ModelingUpsertBulk) UpdatePipelineStage() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdatePipelineStage()
+	})
+}
+
+// SetSearchTrial sets the "search_trial" field.
+func (u *ModelingUpsertBulk) SetSearchTrial(v int) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.SetSearchTrial(v)
+	})
+}
+
+// AddSearchTrial adds v to the "search_trial" field.
+func (u *ModelingUpsertBulk) AddSearchTrial(v int) *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.AddSearchTrial(v)
+	})
+}
+
+// UpdateSearchTrial sets the "search_trial" field to the value that was provided on create.
+func (u *ModelingUpsertBulk) UpdateSearchTrial() *ModelingUpsertBulk {
+	return u.Update(func(s *ModelingUpsert) {
+		s.UpdateSearchTrial()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ModelingUpsertBulk) SetUpdatedAt(v time.Time) *ModelingUpsertBulk {
 	return u.Update(func(s *ModelingUpsert) {
@@ -241,6 +241,221 @@ func (mu *ModelingUpdate) AddProgress(f float64) *ModelingUpdate {
 	return mu
 }
 
+// SetPriority sets the "priority" field.
+func (mu *ModelingUpdate) SetPriority(i int) *ModelingUpdate {
+	mu.mutation.ResetPriority()
+	mu.mutation.SetPriority(i)
+	return mu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (mu *ModelingUpdate) SetNillablePriority(i *int) *ModelingUpdate {
+	if i != nil {
+		mu.SetPriority(*i)
+	}
+	return mu
+}
+
+// AddPriority adds i to the "priority" field.
+func (mu *ModelingUpdate) AddPriority(i int) *ModelingUpdate {
+	mu.mutation.AddPriority(i)
+	return mu
+}
+
+// SetUsername sets the "username" field.
+func (mu *ModelingUpdate) SetUsername(s string) *ModelingUpdate {
+	mu.mutation.SetUsername(s)
+	return mu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (mu *ModelingUpdate) SetNillableUsername(s *string) *ModelingUpdate {
+	if s != nil {
+		mu.SetUsername(*s)
+	}
+	return mu
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (mu *ModelingUpdate) SetQueuedAt(t time.Time) *ModelingUpdate {
+	mu.mutation.SetQueuedAt(t)
+	return mu
+}
+
+// SetNillableQueuedAt sets the "queued_at" field if the given value is not nil.
+func (mu *ModelingUpdate) SetNillableQueuedAt(t *time.Time) *ModelingUpdate {
+	if t != nil {
+		mu.SetQueuedAt(*t)
+	}
+	return mu
+}
+
+// SetQueuePosition sets the "queue_position" field.
+func (mu *ModelingUpdate) SetQueuePosition(i int) *ModelingUpdate {
+	mu.mutation.ResetQueuePosition()
+	mu.mutation.SetQueuePosition(i)
+	return mu
+}
+
+// SetNillableQueuePosition sets the "queue_position" field if the given value is not nil.
+func (mu *ModelingUpdate) SetNillableQueuePosition(i *int) *ModelingUpdate {
+	if i != nil {
+		mu.SetQueuePosition(*i)
+	}
+	return mu
+}
+
+// AddQueuePosition adds i to the "queue_position" field.
+func (mu *ModelingUpdate) AddQueuePosition(i int) *ModelingUpdate {
+	mu.mutation.AddQueuePosition(i)
+	return mu
+}
+
+// ClearQueuePosition clears the value of the "queue_position" field.
+func (mu *ModelingUpdate) ClearQueuePosition() *ModelingUpdate {
+	mu.mutation.ClearQueuePosition()
+	return mu
+}
+
+// SetExecutor sets the "executor" field.
+func (mu *ModelingUpdate) SetExecutor(s string) *ModelingUpdate {
+	mu.mutation.SetExecutor(s)
+	return mu
+}
+
+// SetNillableExecutor sets the "executor" field if the given value is not nil.
+func (mu *ModelingUpdate) SetNillableExecutor(s *string) *ModelingUpdate {
+	if s != nil {
+		mu.SetExecutor(*s)
+	}
+	return mu
+}
+
+// SetJobID sets the "job_id" field.
+func (mu *ModelingUpdate) SetJobID(s string) *ModelingUpdate {
+	mu.mutation.SetJobID(s)
+	return mu
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (mu *ModelingUpdate) SetNillableJobID(s *string) *ModelingUpdate {
+	if s != nil {
+		mu.SetJobID(*s)
+	}
+	return mu
+}
+
+// SetJobType sets the "job_type" field.
+func (mu *ModelingUpdate) SetJobType(s string) *ModelingUpdate {
+	mu.mutation.SetJobType(s)
+	return mu
+}
+
+// SetNillableJobType sets the "job_type" field if the given value is not nil.
+func (mu *ModelingUpdate) SetNillableJobType(s *string) *ModelingUpdate {
+	if s != nil {
+		mu.SetJobType(*s)
+	}
+	return mu
+}
+
+// SetAttempts sets the "attempts" field.
+func (mu *ModelingUpdate) SetAttempts(i int) *ModelingUpdate {
+	mu.mutation.ResetAttempts()
+	mu.mutation.SetAttempts(i)
+	return mu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (mu *ModelingUpdate) SetNillableAttempts(i *int) *ModelingUpdate {
+	if i != nil {
+		mu.SetAttempts(*i)
+	}
+	return mu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (mu *ModelingUpdate) AddAttempts(i int) *ModelingUpdate {
+	mu.mutation.AddAttempts(i)
+	return mu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (mu *ModelingUpdate) SetNextAttemptAt(t time.Time) *ModelingUpdate {
+	mu.mutation.SetNextAttemptAt(t)
+	return mu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (mu *ModelingUpdate) SetNillableNextAttemptAt(t *time.Time) *ModelingUpdate {
+	if t != nil {
+		mu.SetNextAttemptAt(*t)
+	}
+	return mu
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (mu *ModelingUpdate) ClearNextAttemptAt() *ModelingUpdate {
+	mu.mutation.ClearNextAttemptAt()
+	return mu
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (mu *ModelingUpdate) SetFailReason(s string) *ModelingUpdate {
+	mu.mutation.SetFailReason(s)
+	return mu
+}
+
+// SetNillableFailReason sets the "fail_reason" field if the given value is not nil.
+func (mu *ModelingUpdate) SetNillableFailReason(s *string) *ModelingUpdate {
+	if s != nil {
+		mu.SetFailReason(*s)
+	}
+	return mu
+}
+
+// SetPipelineStage sets the "pipeline_stage" field.
+func (mu *ModelingUpdate) SetPipelineStage(i int) *ModelingUpdate {
+	mu.mutation.ResetPipelineStage()
+	mu.mutation.SetPipelineStage(i)
+	return mu
+}
+
+// SetNillablePipelineStage sets the "pipeline_stage" field if the given value is not nil.
+func (mu *ModelingUpdate) SetNillablePipelineStage(i *int) *ModelingUpdate {
+	if i != nil {
+		mu.SetPipelineStage(*i)
+	}
+	return mu
+}
+
+// AddPipelineStage adds i to the "pipeline_stage" field.
+func (mu *ModelingUpdate) AddPipelineStage(i int) *ModelingUpdate {
+	mu.mutation.AddPipelineStage(i)
+	return mu
+}
+
+// SetSearchTrial sets the "search_trial" field.
+func (mu *ModelingUpdate) SetSearchTrial(i int) *ModelingUpdate {
+	mu.mutation.ResetSearchTrial()
+	mu.mutation.SetSearchTrial(i)
+	return mu
+}
+
+// SetNillableSearchTrial sets the "search_trial" field if the given value is not nil.
+func (mu *ModelingUpdate) SetNillableSearchTrial(i *int) *ModelingUpdate {
+	if i != nil {
+		mu.SetSearchTrial(*i)
+	}
+	return mu
+}
+
+// AddSearchTrial adds i to the "search_trial" field.
+func (mu *ModelingUpdate) AddSearchTrial(i int) *ModelingUpdate {
+	mu.mutation.AddSearchTrial(i)
+	return mu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (mu *ModelingUpdate) SetUpdatedAt(t time.Time) *ModelingUpdate {
 	mu.mutation.SetUpdatedAt(t)
@@ -510,6 +725,63 @@ func (mu *ModelingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.AddedProgress(); ok {
 		_spec.AddField(modeling.FieldProgress, field.TypeFloat64, value)
 	}
+	if value, ok := mu.mutation.Priority(); ok {
+		_spec.SetField(modeling.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedPriority(); ok {
+		_spec.AddField(modeling.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.Username(); ok {
+		_spec.SetField(modeling.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.QueuedAt(); ok {
+		_spec.SetField(modeling.FieldQueuedAt, field.TypeTime, value)
+	}
+	if value, ok := mu.mutation.QueuePosition(); ok {
+		_spec.SetField(modeling.FieldQueuePosition, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedQueuePosition(); ok {
+		_spec.AddField(modeling.FieldQueuePosition, field.TypeInt, value)
+	}
+	if mu.mutation.QueuePositionCleared() {
+		_spec.ClearField(modeling.FieldQueuePosition, field.TypeInt)
+	}
+	if value, ok := mu.mutation.Executor(); ok {
+		_spec.SetField(modeling.FieldExecutor, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.JobID(); ok {
+		_spec.SetField(modeling.FieldJobID, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.JobType(); ok {
+		_spec.SetField(modeling.FieldJobType, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Attempts(); ok {
+		_spec.SetField(modeling.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedAttempts(); ok {
+		_spec.AddField(modeling.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(modeling.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if mu.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(modeling.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.FailReason(); ok {
+		_spec.SetField(modeling.FieldFailReason, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.PipelineStage(); ok {
+		_spec.SetField(modeling.FieldPipelineStage, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedPipelineStage(); ok {
+		_spec.AddField(modeling.FieldPipelineStage, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.SearchTrial(); ok {
+		_spec.SetField(modeling.FieldSearchTrial, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedSearchTrial(); ok {
+		_spec.AddField(modeling.FieldSearchTrial, field.TypeInt, value)
+	}
 	if value, ok := mu.mutation.UpdatedAt(); ok {
 		_spec.SetField(modeling.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -912,6 +1184,221 @@ func (muo *ModelingUpdateOne) AddProgress(f float64) *ModelingUpdateOne {
 	return muo
 }
 
+// SetPriority sets the "priority" field.
+func (muo *ModelingUpdateOne) SetPriority(i int) *ModelingUpdateOne {
+	muo.mutation.ResetPriority()
+	muo.mutation.SetPriority(i)
+	return muo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (muo *ModelingUpdateOne) SetNillablePriority(i *int) *ModelingUpdateOne {
+	if i != nil {
+		muo.SetPriority(*i)
+	}
+	return muo
+}
+
+// AddPriority adds i to the "priority" field.
+func (muo *ModelingUpdateOne) AddPriority(i int) *ModelingUpdateOne {
+	muo.mutation.AddPriority(i)
+	return muo
+}
+
+// SetUsername sets the "username" field.
+func (muo *ModelingUpdateOne) SetUsername(s string) *ModelingUpdateOne {
+	muo.mutation.SetUsername(s)
+	return muo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (muo *ModelingUpdateOne) SetNillableUsername(s *string) *ModelingUpdateOne {
+	if s != nil {
+		muo.SetUsername(*s)
+	}
+	return muo
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (muo *ModelingUpdateOne) SetQueuedAt(t time.Time) *ModelingUpdateOne {
+	muo.mutation.SetQueuedAt(t)
+	return muo
+}
+
+// SetNillableQueuedAt sets the "queued_at" field if the given value is not nil.
+func (muo *ModelingUpdateOne) SetNillableQueuedAt(t *time.Time) *ModelingUpdateOne {
+	if t != nil {
+		muo.SetQueuedAt(*t)
+	}
+	return muo
+}
+
+// SetQueuePosition sets the "queue_position" field.
+func (muo *ModelingUpdateOne) SetQueuePosition(i int) *ModelingUpdateOne {
+	muo.mutation.ResetQueuePosition()
+	muo.mutation.SetQueuePosition(i)
+	return muo
+}
+
+// SetNillableQueuePosition sets the "queue_position" field if the given value is not nil.
+func (muo *ModelingUpdateOne) SetNillableQueuePosition(i *int) *ModelingUpdateOne {
+	if i != nil {
+		muo.SetQueuePosition(*i)
+	}
+	return muo
+}
+
+// AddQueuePosition adds i to the "queue_position" field.
+func (muo *ModelingUpdateOne) AddQueuePosition(i int) *ModelingUpdateOne {
+	muo.mutation.AddQueuePosition(i)
+	return muo
+}
+
+// ClearQueuePosition clears the value of the "queue_position" field.
+func (muo *ModelingUpdateOne) ClearQueuePosition() *ModelingUpdateOne {
+	muo.mutation.ClearQueuePosition()
+	return muo
+}
+
+// SetExecutor sets the "executor" field.
+func (muo *ModelingUpdateOne) SetExecutor(s string) *ModelingUpdateOne {
+	muo.mutation.SetExecutor(s)
+	return muo
+}
+
+// SetNillableExecutor sets the "executor" field if the given value is not nil.
+func (muo *ModelingUpdateOne) SetNillableExecutor(s *string) *ModelingUpdateOne {
+	if s != nil {
+		muo.SetExecutor(*s)
+	}
+	return muo
+}
+
+// SetJobID sets the "job_id" field.
+func (muo *ModelingUpdateOne) SetJobID(s string) *ModelingUpdateOne {
+	muo.mutation.SetJobID(s)
+	return muo
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (muo *ModelingUpdateOne) SetNillableJobID(s *string) *ModelingUpdateOne {
+	if s != nil {
+		muo.SetJobID(*s)
+	}
+	return muo
+}
+
+// SetJobType sets the "job_type" field.
+func (muo *ModelingUpdateOne) SetJobType(s string) *ModelingUpdateOne {
+	muo.mutation.SetJobType(s)
+	return muo
+}
+
+// SetNillableJobType sets the "job_type" field if the given value is not nil.
+func (muo *ModelingUpdateOne) SetNillableJobType(s *string) *ModelingUpdateOne {
+	if s != nil {
+		muo.SetJobType(*s)
+	}
+	return muo
+}
+
+// SetAttempts sets the "attempts" field.
+func (muo *ModelingUpdateOne) SetAttempts(i int) *ModelingUpdateOne {
+	muo.mutation.ResetAttempts()
+	muo.mutation.SetAttempts(i)
+	return muo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (muo *ModelingUpdateOne) SetNillableAttempts(i *int) *ModelingUpdateOne {
+	if i != nil {
+		muo.SetAttempts(*i)
+	}
+	return muo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (muo *ModelingUpdateOne) AddAttempts(i int) *ModelingUpdateOne {
+	muo.mutation.AddAttempts(i)
+	return muo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (muo *ModelingUpdateOne) SetNextAttemptAt(t time.Time) *ModelingUpdateOne {
+	muo.mutation.SetNextAttemptAt(t)
+	return muo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (muo *ModelingUpdateOne) SetNillableNextAttemptAt(t *time.Time) *ModelingUpdateOne {
+	if t != nil {
+		muo.SetNextAttemptAt(*t)
+	}
+	return muo
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (muo *ModelingUpdateOne) ClearNextAttemptAt() *ModelingUpdateOne {
+	muo.mutation.ClearNextAttemptAt()
+	return muo
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (muo *ModelingUpdateOne) SetFailReason(s string) *ModelingUpdateOne {
+	muo.mutation.SetFailReason(s)
+	return muo
+}
+
+// SetNillableFailReason sets the "fail_reason" field if the given value is not nil.
+func (muo *ModelingUpdateOne) SetNillableFailReason(s *string) *ModelingUpdateOne {
+	if s != nil {
+		muo.SetFailReason(*s)
+	}
+	return muo
+}
+
+// SetPipelineStage sets the "pipeline_stage" field.
+func (muo *ModelingUpdateOne) SetPipelineStage(i int) *ModelingUpdateOne {
+	muo.mutation.ResetPipelineStage()
+	muo.mutation.SetPipelineStage(i)
+	return muo
+}
+
+// SetNillablePipelineStage sets the "pipeline_stage" field if the given value is not nil.
+func (muo *ModelingUpdateOne) SetNillablePipelineStage(i *int) *ModelingUpdateOne {
+	if i != nil {
+		muo.SetPipelineStage(*i)
+	}
+	return muo
+}
+
+// AddPipelineStage adds i to the "pipeline_stage" field.
+func (muo *ModelingUpdateOne) AddPipelineStage(i int) *ModelingUpdateOne {
+	muo.mutation.AddPipelineStage(i)
+	return muo
+}
+
+// SetSearchTrial sets the "search_trial" field.
+func (muo *ModelingUpdateOne) SetSearchTrial(i int) *ModelingUpdateOne {
+	muo.mutation.ResetSearchTrial()
+	muo.mutation.SetSearchTrial(i)
+	return muo
+}
+
+// SetNillableSearchTrial sets the "search_trial" field if the given value is not nil.
+func (muo *ModelingUpdateOne) SetNillableSearchTrial(i *int) *ModelingUpdateOne {
+	if i != nil {
+		muo.SetSearchTrial(*i)
+	}
+	return muo
+}
+
+// AddSearchTrial adds i to the "search_trial" field.
+func (muo *ModelingUpdateOne) AddSearchTrial(i int) *ModelingUpdateOne {
+	muo.mutation.AddSearchTrial(i)
+	return muo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (muo *ModelingUpdateOne) SetUpdatedAt(t time.Time) *ModelingUpdateOne {
 	muo.mutation.SetUpdatedAt(t)
@@ -1211,6 +1698,63 @@ func (muo *ModelingUpdateOne) sqlSave(ctx context.Context) (_node *Modeling, err
 	if value, ok := muo.mutation.AddedProgress(); ok {
 		_spec.AddField(modeling.FieldProgress, field.TypeFloat64, value)
 	}
+	if value, ok := muo.mutation.Priority(); ok {
+		_spec.SetField(modeling.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedPriority(); ok {
+		_spec.AddField(modeling.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.Username(); ok {
+		_spec.SetField(modeling.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.QueuedAt(); ok {
+		_spec.SetField(modeling.FieldQueuedAt, field.TypeTime, value)
+	}
+	if value, ok := muo.mutation.QueuePosition(); ok {
+		_spec.SetField(modeling.FieldQueuePosition, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedQueuePosition(); ok {
+		_spec.AddField(modeling.FieldQueuePosition, field.TypeInt, value)
+	}
+	if muo.mutation.QueuePositionCleared() {
+		_spec.ClearField(modeling.FieldQueuePosition, field.TypeInt)
+	}
+	if value, ok := muo.mutation.Executor(); ok {
+		_spec.SetField(modeling.FieldExecutor, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.JobID(); ok {
+		_spec.SetField(modeling.FieldJobID, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.JobType(); ok {
+		_spec.SetField(modeling.FieldJobType, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Attempts(); ok {
+		_spec.SetField(modeling.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedAttempts(); ok {
+		_spec.AddField(modeling.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(modeling.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if muo.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(modeling.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.FailReason(); ok {
+		_spec.SetField(modeling.FieldFailReason, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.PipelineStage(); ok {
+		_spec.SetField(modeling.FieldPipelineStage, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedPipelineStage(); ok {
+		_spec.AddField(modeling.FieldPipelineStage, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.SearchTrial(); ok {
+		_spec.SetField(modeling.FieldSearchTrial, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedSearchTrial(); ok {
+		_spec.AddField(modeling.FieldSearchTrial, field.TypeInt, value)
+	}
 	if value, ok := muo.mutation.UpdatedAt(); ok {
 		_spec.SetField(modeling.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -7,15 +7,25 @@ import (
 	"api_server/ent/dataset"
 	"api_server/ent/datasetroot"
 	"api_server/ent/device"
+	"api_server/ent/devicetoken"
+	"api_server/ent/earlystop"
 	"api_server/ent/enginelog"
 	"api_server/ent/gpu"
+	"api_server/ent/gputelemetry"
 	"api_server/ent/hyperparamshistory"
+	"api_server/ent/maintenancewindow"
 	"api_server/ent/menu"
 	"api_server/ent/modeling"
 	"api_server/ent/modelingdetails"
 	"api_server/ent/modelingmodels"
 	"api_server/ent/predicate"
+	"api_server/ent/preemption"
 	"api_server/ent/project"
+	"api_server/ent/quota"
+	"api_server/ent/reservation"
+	"api_server/ent/schedule"
+	"api_server/ent/schedulehistory"
+	"api_server/ent/schema"
 	"api_server/ent/task"
 	"api_server/ent/trial"
 	"api_server/ent/trialdetails"
@@ -46,14 +56,23 @@ const (
 	TypeDataset            = "Dataset"
 	TypeDatasetRoot        = "DatasetRoot"
 	TypeDevice             = "Device"
+	TypeDeviceToken        = "DeviceToken"
+	TypeEarlyStop          = "EarlyStop"
 	TypeEngineLog          = "EngineLog"
 	TypeGpu                = "Gpu"
+	TypeGpuTelemetry       = "GpuTelemetry"
 	TypeHyperParamsHistory = "HyperParamsHistory"
+	TypeMaintenanceWindow  = "MaintenanceWindow"
 	TypeMenu               = "Menu"
 	TypeModeling           = "Modeling"
 	TypeModelingDetails    = "ModelingDetails"
 	TypeModelingModels     = "ModelingModels"
+	TypePreemption         = "Preemption"
 	TypeProject            = "Project"
+	TypeQuota              = "Quota"
+	TypeReservation        = "Reservation"
+	TypeSchedule           = "Schedule"
+	TypeScheduleHistory    = "ScheduleHistory"
 	TypeTask               = "Task"
 	TypeTrial              = "Trial"
 	TypeTrialDetails       = "TrialDetails"
@@ -661,6 +680,8 @@ type DatasetMutation struct {
 	deleted_at         *time.Time
 	dr_id              *int
 	adddr_id           *int
+	fingerprint        *string
+	fingerprint_at     *time.Time
 	clearedFields      map[string]struct{}
 	datasetroot        *int
 	cleareddatasetroot bool
@@ -1553,6 +1574,91 @@ func (m *DatasetMutation) ResetDrID() {
 	delete(m.clearedFields, dataset.FieldDrID)
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (m *DatasetMutation) SetFingerprint(s string) {
+	m.fingerprint = &s
+}
+
+// Fingerprint returns the value of the "fingerprint" field in the mutation.
+func (m *DatasetMutation) Fingerprint() (r string, exists bool) {
+	v := m.fingerprint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFingerprint returns the old "fingerprint" field's value of the Dataset entity.
+// If the Dataset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DatasetMutation) OldFingerprint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFingerprint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFingerprint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFingerprint: %w", err)
+	}
+	return oldValue.Fingerprint, nil
+}
+
+// ResetFingerprint resets all changes to the "fingerprint" field.
+func (m *DatasetMutation) ResetFingerprint() {
+	m.fingerprint = nil
+}
+
+// SetFingerprintAt sets the "fingerprint_at" field.
+func (m *DatasetMutation) SetFingerprintAt(t time.Time) {
+	m.fingerprint_at = &t
+}
+
+// FingerprintAt returns the value of the "fingerprint_at" field in the mutation.
+func (m *DatasetMutation) FingerprintAt() (r time.Time, exists bool) {
+	v := m.fingerprint_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFingerprintAt returns the old "fingerprint_at" field's value of the Dataset entity.
+// If the Dataset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DatasetMutation) OldFingerprintAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFingerprintAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFingerprintAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFingerprintAt: %w", err)
+	}
+	return oldValue.FingerprintAt, nil
+}
+
+// ClearFingerprintAt clears the value of the "fingerprint_at" field.
+func (m *DatasetMutation) ClearFingerprintAt() {
+	m.fingerprint_at = nil
+	m.clearedFields[dataset.FieldFingerprintAt] = struct{}{}
+}
+
+// FingerprintAtCleared returns if the "fingerprint_at" field was cleared in this mutation.
+func (m *DatasetMutation) FingerprintAtCleared() bool {
+	_, ok := m.clearedFields[dataset.FieldFingerprintAt]
+	return ok
+}
+
+// ResetFingerprintAt resets all changes to the "fingerprint_at" field.
+func (m *DatasetMutation) ResetFingerprintAt() {
+	m.fingerprint_at = nil
+	delete(m.clearedFields, dataset.FieldFingerprintAt)
+}
+
 // SetDatasetrootID sets the "datasetroot" edge to the DatasetRoot entity by id.
 func (m *DatasetMutation) SetDatasetrootID(id int) {
 	m.datasetroot = &id
@@ -1626,7 +1732,7 @@ func (m *DatasetMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *DatasetMutation) Fields() []string {
-	fields := make([]string, 0, 18)
+	fields := make([]string, 0, 20)
 	if m.name != nil {
 		fields = append(fields, dataset.FieldName)
 	}
@@ -1681,6 +1787,12 @@ func (m *DatasetMutation) Fields() []string {
 	if m.dr_id != nil {
 		fields = append(fields, dataset.FieldDrID)
 	}
+	if m.fingerprint != nil {
+		fields = append(fields, dataset.FieldFingerprint)
+	}
+	if m.fingerprint_at != nil {
+		fields = append(fields, dataset.FieldFingerprintAt)
+	}
 	return fields
 }
 
@@ -1725,6 +1837,10 @@ func (m *DatasetMutation) Field(name string) (ent.Value, bool) {
 		return m.DeletedAt()
 	case dataset.FieldDrID:
 		return m.DrID()
+	case dataset.FieldFingerprint:
+		return m.Fingerprint()
+	case dataset.FieldFingerprintAt:
+		return m.FingerprintAt()
 	}
 	return nil, false
 }
@@ -1770,6 +1886,10 @@ func (m *DatasetMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldDeletedAt(ctx)
 	case dataset.FieldDrID:
 		return m.OldDrID(ctx)
+	case dataset.FieldFingerprint:
+		return m.OldFingerprint(ctx)
+	case dataset.FieldFingerprintAt:
+		return m.OldFingerprintAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Dataset field %s", name)
 }
@@ -1905,6 +2025,20 @@ func (m *DatasetMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDrID(v)
 		return nil
+	case dataset.FieldFingerprint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFingerprint(v)
+		return nil
+	case dataset.FieldFingerprintAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFingerprintAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Dataset field %s", name)
 }
@@ -1977,6 +2111,9 @@ func (m *DatasetMutation) ClearedFields() []string {
 	if m.FieldCleared(dataset.FieldDrID) {
 		fields = append(fields, dataset.FieldDrID)
 	}
+	if m.FieldCleared(dataset.FieldFingerprintAt) {
+		fields = append(fields, dataset.FieldFingerprintAt)
+	}
 	return fields
 }
 
@@ -2006,6 +2143,9 @@ func (m *DatasetMutation) ClearField(name string) error {
 	case dataset.FieldDrID:
 		m.ClearDrID()
 		return nil
+	case dataset.FieldFingerprintAt:
+		m.ClearFingerprintAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Dataset nullable field %s", name)
 }
@@ -2068,6 +2208,12 @@ func (m *DatasetMutation) ResetField(name string) error {
 	case dataset.FieldDrID:
 		m.ResetDrID()
 		return nil
+	case dataset.FieldFingerprint:
+		m.ResetFingerprint()
+		return nil
+	case dataset.FieldFingerprintAt:
+		m.ResetFingerprintAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Dataset field %s", name)
 }
@@ -2682,24 +2828,31 @@ func (m *DatasetRootMutation) ResetEdge(name string) error {
 // DeviceMutation represents an operation that mutates the Device nodes in the graph.
 type DeviceMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	ip            *string
-	port          *int
-	addport       *int
-	is_use        *bool
-	_type         *string
-	connection    *string
-	available     *string
-	clearedFields map[string]struct{}
-	gpu           map[int]struct{}
-	removedgpu    map[int]struct{}
-	clearedgpu    bool
-	done          bool
-	oldValue      func(context.Context) (*Device, error)
-	predicates    []predicate.Device
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	ip              *string
+	port            *int
+	addport         *int
+	is_use          *bool
+	_type           *string
+	connection      *string
+	available       *string
+	heartbeat_at    *time.Time
+	capabilities    **schema.DeviceCapabilities
+	drain           *bool
+	drain_reason    *string
+	drain_at        *time.Time
+	drain_window    *int
+	adddrain_window *int
+	clearedFields   map[string]struct{}
+	gpu             map[int]struct{}
+	removedgpu      map[int]struct{}
+	clearedgpu      bool
+	done            bool
+	oldValue        func(context.Context) (*Device, error)
+	predicates      []predicate.Device
 }
 
 var _ ent.Mutation = (*DeviceMutation)(nil)
@@ -3078,6 +3231,281 @@ func (m *DeviceMutation) ResetAvailable() {
 	m.available = nil
 }
 
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (m *DeviceMutation) SetHeartbeatAt(t time.Time) {
+	m.heartbeat_at = &t
+}
+
+// HeartbeatAt returns the value of the "heartbeat_at" field in the mutation.
+func (m *DeviceMutation) HeartbeatAt() (r time.Time, exists bool) {
+	v := m.heartbeat_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeartbeatAt returns the old "heartbeat_at" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldHeartbeatAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeartbeatAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeartbeatAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeartbeatAt: %w", err)
+	}
+	return oldValue.HeartbeatAt, nil
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (m *DeviceMutation) ClearHeartbeatAt() {
+	m.heartbeat_at = nil
+	m.clearedFields[device.FieldHeartbeatAt] = struct{}{}
+}
+
+// HeartbeatAtCleared returns if the "heartbeat_at" field was cleared in this mutation.
+func (m *DeviceMutation) HeartbeatAtCleared() bool {
+	_, ok := m.clearedFields[device.FieldHeartbeatAt]
+	return ok
+}
+
+// ResetHeartbeatAt resets all changes to the "heartbeat_at" field.
+func (m *DeviceMutation) ResetHeartbeatAt() {
+	m.heartbeat_at = nil
+	delete(m.clearedFields, device.FieldHeartbeatAt)
+}
+
+// SetCapabilities sets the "capabilities" field.
+func (m *DeviceMutation) SetCapabilities(sc *schema.DeviceCapabilities) {
+	m.capabilities = &sc
+}
+
+// Capabilities returns the value of the "capabilities" field in the mutation.
+func (m *DeviceMutation) Capabilities() (r *schema.DeviceCapabilities, exists bool) {
+	v := m.capabilities
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapabilities returns the old "capabilities" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldCapabilities(ctx context.Context) (v *schema.DeviceCapabilities, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapabilities is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapabilities requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapabilities: %w", err)
+	}
+	return oldValue.Capabilities, nil
+}
+
+// ClearCapabilities clears the value of the "capabilities" field.
+func (m *DeviceMutation) ClearCapabilities() {
+	m.capabilities = nil
+	m.clearedFields[device.FieldCapabilities] = struct{}{}
+}
+
+// CapabilitiesCleared returns if the "capabilities" field was cleared in this mutation.
+func (m *DeviceMutation) CapabilitiesCleared() bool {
+	_, ok := m.clearedFields[device.FieldCapabilities]
+	return ok
+}
+
+// ResetCapabilities resets all changes to the "capabilities" field.
+func (m *DeviceMutation) ResetCapabilities() {
+	m.capabilities = nil
+	delete(m.clearedFields, device.FieldCapabilities)
+}
+
+// SetDrain sets the "drain" field.
+func (m *DeviceMutation) SetDrain(b bool) {
+	m.drain = &b
+}
+
+// Drain returns the value of the "drain" field in the mutation.
+func (m *DeviceMutation) Drain() (r bool, exists bool) {
+	v := m.drain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDrain returns the old "drain" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldDrain(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDrain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDrain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDrain: %w", err)
+	}
+	return oldValue.Drain, nil
+}
+
+// ResetDrain resets all changes to the "drain" field.
+func (m *DeviceMutation) ResetDrain() {
+	m.drain = nil
+}
+
+// SetDrainReason sets the "drain_reason" field.
+func (m *DeviceMutation) SetDrainReason(s string) {
+	m.drain_reason = &s
+}
+
+// DrainReason returns the value of the "drain_reason" field in the mutation.
+func (m *DeviceMutation) DrainReason() (r string, exists bool) {
+	v := m.drain_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDrainReason returns the old "drain_reason" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldDrainReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDrainReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDrainReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDrainReason: %w", err)
+	}
+	return oldValue.DrainReason, nil
+}
+
+// ResetDrainReason resets all changes to the "drain_reason" field.
+func (m *DeviceMutation) ResetDrainReason() {
+	m.drain_reason = nil
+}
+
+// SetDrainAt sets the "drain_at" field.
+func (m *DeviceMutation) SetDrainAt(t time.Time) {
+	m.drain_at = &t
+}
+
+// DrainAt returns the value of the "drain_at" field in the mutation.
+func (m *DeviceMutation) DrainAt() (r time.Time, exists bool) {
+	v := m.drain_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDrainAt returns the old "drain_at" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldDrainAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDrainAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDrainAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDrainAt: %w", err)
+	}
+	return oldValue.DrainAt, nil
+}
+
+// ClearDrainAt clears the value of the "drain_at" field.
+func (m *DeviceMutation) ClearDrainAt() {
+	m.drain_at = nil
+	m.clearedFields[device.FieldDrainAt] = struct{}{}
+}
+
+// DrainAtCleared returns if the "drain_at" field was cleared in this mutation.
+func (m *DeviceMutation) DrainAtCleared() bool {
+	_, ok := m.clearedFields[device.FieldDrainAt]
+	return ok
+}
+
+// ResetDrainAt resets all changes to the "drain_at" field.
+func (m *DeviceMutation) ResetDrainAt() {
+	m.drain_at = nil
+	delete(m.clearedFields, device.FieldDrainAt)
+}
+
+// SetDrainWindow sets the "drain_window" field.
+func (m *DeviceMutation) SetDrainWindow(i int) {
+	m.drain_window = &i
+	m.adddrain_window = nil
+}
+
+// DrainWindow returns the value of the "drain_window" field in the mutation.
+func (m *DeviceMutation) DrainWindow() (r int, exists bool) {
+	v := m.drain_window
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDrainWindow returns the old "drain_window" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldDrainWindow(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDrainWindow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDrainWindow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDrainWindow: %w", err)
+	}
+	return oldValue.DrainWindow, nil
+}
+
+// AddDrainWindow adds i to the "drain_window" field.
+func (m *DeviceMutation) AddDrainWindow(i int) {
+	if m.adddrain_window != nil {
+		*m.adddrain_window += i
+	} else {
+		m.adddrain_window = &i
+	}
+}
+
+// AddedDrainWindow returns the value that was added to the "drain_window" field in this mutation.
+func (m *DeviceMutation) AddedDrainWindow() (r int, exists bool) {
+	v := m.adddrain_window
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDrainWindow resets all changes to the "drain_window" field.
+func (m *DeviceMutation) ResetDrainWindow() {
+	m.drain_window = nil
+	m.adddrain_window = nil
+}
+
 // AddGpuIDs adds the "gpu" edge to the Gpu entity by ids.
 func (m *DeviceMutation) AddGpuIDs(ids ...int) {
 	if m.gpu == nil {
@@ -3166,7 +3594,7 @@ func (m *DeviceMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *DeviceMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 13)
 	if m.name != nil {
 		fields = append(fields, device.FieldName)
 	}
@@ -3188,6 +3616,24 @@ func (m *DeviceMutation) Fields() []string {
 	if m.available != nil {
 		fields = append(fields, device.FieldAvailable)
 	}
+	if m.heartbeat_at != nil {
+		fields = append(fields, device.FieldHeartbeatAt)
+	}
+	if m.capabilities != nil {
+		fields = append(fields, device.FieldCapabilities)
+	}
+	if m.drain != nil {
+		fields = append(fields, device.FieldDrain)
+	}
+	if m.drain_reason != nil {
+		fields = append(fields, device.FieldDrainReason)
+	}
+	if m.drain_at != nil {
+		fields = append(fields, device.FieldDrainAt)
+	}
+	if m.drain_window != nil {
+		fields = append(fields, device.FieldDrainWindow)
+	}
 	return fields
 }
 
@@ -3210,6 +3656,18 @@ func (m *DeviceMutation) Field(name string) (ent.Value, bool) {
 		return m.Connection()
 	case device.FieldAvailable:
 		return m.Available()
+	case device.FieldHeartbeatAt:
+		return m.HeartbeatAt()
+	case device.FieldCapabilities:
+		return m.Capabilities()
+	case device.FieldDrain:
+		return m.Drain()
+	case device.FieldDrainReason:
+		return m.DrainReason()
+	case device.FieldDrainAt:
+		return m.DrainAt()
+	case device.FieldDrainWindow:
+		return m.DrainWindow()
 	}
 	return nil, false
 }
@@ -3233,6 +3691,18 @@ func (m *DeviceMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldConnection(ctx)
 	case device.FieldAvailable:
 		return m.OldAvailable(ctx)
+	case device.FieldHeartbeatAt:
+		return m.OldHeartbeatAt(ctx)
+	case device.FieldCapabilities:
+		return m.OldCapabilities(ctx)
+	case device.FieldDrain:
+		return m.OldDrain(ctx)
+	case device.FieldDrainReason:
+		return m.OldDrainReason(ctx)
+	case device.FieldDrainAt:
+		return m.OldDrainAt(ctx)
+	case device.FieldDrainWindow:
+		return m.OldDrainWindow(ctx)
 	}
 	return nil, fmt.Errorf("unknown Device field %s", name)
 }
@@ -3291,27 +3761,74 @@ func (m *DeviceMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAvailable(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Device field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *DeviceMutation) AddedFields() []string {
-	var fields []string
-	if m.addport != nil {
-		fields = append(fields, device.FieldPort)
-	}
-	return fields
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *DeviceMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case device.FieldPort:
-		return m.AddedPort()
+	case device.FieldHeartbeatAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeartbeatAt(v)
+		return nil
+	case device.FieldCapabilities:
+		v, ok := value.(*schema.DeviceCapabilities)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapabilities(v)
+		return nil
+	case device.FieldDrain:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDrain(v)
+		return nil
+	case device.FieldDrainReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDrainReason(v)
+		return nil
+	case device.FieldDrainAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDrainAt(v)
+		return nil
+	case device.FieldDrainWindow:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDrainWindow(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Device field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeviceMutation) AddedFields() []string {
+	var fields []string
+	if m.addport != nil {
+		fields = append(fields, device.FieldPort)
+	}
+	if m.adddrain_window != nil {
+		fields = append(fields, device.FieldDrainWindow)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeviceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case device.FieldPort:
+		return m.AddedPort()
+	case device.FieldDrainWindow:
+		return m.AddedDrainWindow()
 	}
 	return nil, false
 }
@@ -3328,6 +3845,13 @@ func (m *DeviceMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddPort(v)
 		return nil
+	case device.FieldDrainWindow:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDrainWindow(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Device numeric field %s", name)
 }
@@ -3335,7 +3859,17 @@ func (m *DeviceMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *DeviceMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(device.FieldHeartbeatAt) {
+		fields = append(fields, device.FieldHeartbeatAt)
+	}
+	if m.FieldCleared(device.FieldCapabilities) {
+		fields = append(fields, device.FieldCapabilities)
+	}
+	if m.FieldCleared(device.FieldDrainAt) {
+		fields = append(fields, device.FieldDrainAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -3348,6 +3882,17 @@ func (m *DeviceMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *DeviceMutation) ClearField(name string) error {
+	switch name {
+	case device.FieldHeartbeatAt:
+		m.ClearHeartbeatAt()
+		return nil
+	case device.FieldCapabilities:
+		m.ClearCapabilities()
+		return nil
+	case device.FieldDrainAt:
+		m.ClearDrainAt()
+		return nil
+	}
 	return fmt.Errorf("unknown Device nullable field %s", name)
 }
 
@@ -3376,6 +3921,24 @@ func (m *DeviceMutation) ResetField(name string) error {
 	case device.FieldAvailable:
 		m.ResetAvailable()
 		return nil
+	case device.FieldHeartbeatAt:
+		m.ResetHeartbeatAt()
+		return nil
+	case device.FieldCapabilities:
+		m.ResetCapabilities()
+		return nil
+	case device.FieldDrain:
+		m.ResetDrain()
+		return nil
+	case device.FieldDrainReason:
+		m.ResetDrainReason()
+		return nil
+	case device.FieldDrainAt:
+		m.ResetDrainAt()
+		return nil
+	case device.FieldDrainWindow:
+		m.ResetDrainWindow()
+		return nil
 	}
 	return fmt.Errorf("unknown Device field %s", name)
 }
@@ -3464,37 +4027,38 @@ func (m *DeviceMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Device edge %s", name)
 }
 
-// EngineLogMutation represents an operation that mutates the EngineLog nodes in the graph.
-type EngineLogMutation struct {
+// DeviceTokenMutation represents an operation that mutates the DeviceToken nodes in the graph.
+type DeviceTokenMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	modeling_id    *int
-	addmodeling_id *int
-	level          *string
-	filename       *string
-	line           *int
-	addline        *int
-	message        *string
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*EngineLog, error)
-	predicates     []predicate.EngineLog
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	token_hash    *string
+	device_id     *int
+	adddevice_id  *int
+	revoked       *bool
+	created_by    *string
+	created_at    *time.Time
+	used_at       *time.Time
+	revoked_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DeviceToken, error)
+	predicates    []predicate.DeviceToken
 }
 
-var _ ent.Mutation = (*EngineLogMutation)(nil)
+var _ ent.Mutation = (*DeviceTokenMutation)(nil)
 
-// enginelogOption allows management of the mutation configuration using functional options.
-type enginelogOption func(*EngineLogMutation)
+// devicetokenOption allows management of the mutation configuration using functional options.
+type devicetokenOption func(*DeviceTokenMutation)
 
-// newEngineLogMutation creates new mutation for the EngineLog entity.
-func newEngineLogMutation(c config, op Op, opts ...enginelogOption) *EngineLogMutation {
-	m := &EngineLogMutation{
+// newDeviceTokenMutation creates new mutation for the DeviceToken entity.
+func newDeviceTokenMutation(c config, op Op, opts ...devicetokenOption) *DeviceTokenMutation {
+	m := &DeviceTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEngineLog,
+		typ:           TypeDeviceToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3503,20 +4067,20 @@ func newEngineLogMutation(c config, op Op, opts ...enginelogOption) *EngineLogMu
 	return m
 }
 
-// withEngineLogID sets the ID field of the mutation.
-func withEngineLogID(id int) enginelogOption {
-	return func(m *EngineLogMutation) {
+// withDeviceTokenID sets the ID field of the mutation.
+func withDeviceTokenID(id int) devicetokenOption {
+	return func(m *DeviceTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EngineLog
+			value *DeviceToken
 		)
-		m.oldValue = func(ctx context.Context) (*EngineLog, error) {
+		m.oldValue = func(ctx context.Context) (*DeviceToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EngineLog.Get(ctx, id)
+					value, err = m.Client().DeviceToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3525,10 +4089,10 @@ func withEngineLogID(id int) enginelogOption {
 	}
 }
 
-// withEngineLog sets the old EngineLog of the mutation.
-func withEngineLog(node *EngineLog) enginelogOption {
-	return func(m *EngineLogMutation) {
-		m.oldValue = func(context.Context) (*EngineLog, error) {
+// withDeviceToken sets the old DeviceToken of the mutation.
+func withDeviceToken(node *DeviceToken) devicetokenOption {
+	return func(m *DeviceTokenMutation) {
+		m.oldValue = func(context.Context) (*DeviceToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3537,7 +4101,7 @@ func withEngineLog(node *EngineLog) enginelogOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EngineLogMutation) Client() *Client {
+func (m DeviceTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3545,7 +4109,7 @@ func (m EngineLogMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EngineLogMutation) Tx() (*Tx, error) {
+func (m DeviceTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3555,14 +4119,14 @@ func (m EngineLogMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EngineLog entities.
-func (m *EngineLogMutation) SetID(id int) {
+// operation is only accepted on creation of DeviceToken entities.
+func (m *DeviceTokenMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EngineLogMutation) ID() (id int, exists bool) {
+func (m *DeviceTokenMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3573,7 +4137,7 @@ func (m *EngineLogMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EngineLogMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DeviceTokenMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3582,277 +4146,355 @@ func (m *EngineLogMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EngineLog.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DeviceToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetModelingID sets the "modeling_id" field.
-func (m *EngineLogMutation) SetModelingID(i int) {
-	m.modeling_id = &i
-	m.addmodeling_id = nil
+// SetName sets the "name" field.
+func (m *DeviceTokenMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ModelingID returns the value of the "modeling_id" field in the mutation.
-func (m *EngineLogMutation) ModelingID() (r int, exists bool) {
-	v := m.modeling_id
+// Name returns the value of the "name" field in the mutation.
+func (m *DeviceTokenMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelingID returns the old "modeling_id" field's value of the EngineLog entity.
-// If the EngineLog object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EngineLogMutation) OldModelingID(ctx context.Context) (v int, err error) {
+func (m *DeviceTokenMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelingID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelingID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelingID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.ModelingID, nil
+	return oldValue.Name, nil
 }
 
-// AddModelingID adds i to the "modeling_id" field.
-func (m *EngineLogMutation) AddModelingID(i int) {
-	if m.addmodeling_id != nil {
-		*m.addmodeling_id += i
-	} else {
-		m.addmodeling_id = &i
-	}
+// ResetName resets all changes to the "name" field.
+func (m *DeviceTokenMutation) ResetName() {
+	m.name = nil
 }
 
-// AddedModelingID returns the value that was added to the "modeling_id" field in this mutation.
-func (m *EngineLogMutation) AddedModelingID() (r int, exists bool) {
-	v := m.addmodeling_id
+// SetTokenHash sets the "token_hash" field.
+func (m *DeviceTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *DeviceTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetModelingID resets all changes to the "modeling_id" field.
-func (m *EngineLogMutation) ResetModelingID() {
-	m.modeling_id = nil
-	m.addmodeling_id = nil
+// OldTokenHash returns the old "token_hash" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
 }
 
-// SetLevel sets the "level" field.
-func (m *EngineLogMutation) SetLevel(s string) {
-	m.level = &s
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *DeviceTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// Level returns the value of the "level" field in the mutation.
-func (m *EngineLogMutation) Level() (r string, exists bool) {
-	v := m.level
+// SetDeviceID sets the "device_id" field.
+func (m *DeviceTokenMutation) SetDeviceID(i int) {
+	m.device_id = &i
+	m.adddevice_id = nil
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *DeviceTokenMutation) DeviceID() (r int, exists bool) {
+	v := m.device_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLevel returns the old "level" field's value of the EngineLog entity.
-// If the EngineLog object wasn't provided to the builder, the object is fetched from the database.
+// OldDeviceID returns the old "device_id" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EngineLogMutation) OldLevel(ctx context.Context) (v string, err error) {
+func (m *DeviceTokenMutation) OldDeviceID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLevel requires an ID field in the mutation")
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
 	}
-	return oldValue.Level, nil
+	return oldValue.DeviceID, nil
 }
 
-// ResetLevel resets all changes to the "level" field.
-func (m *EngineLogMutation) ResetLevel() {
-	m.level = nil
+// AddDeviceID adds i to the "device_id" field.
+func (m *DeviceTokenMutation) AddDeviceID(i int) {
+	if m.adddevice_id != nil {
+		*m.adddevice_id += i
+	} else {
+		m.adddevice_id = &i
+	}
 }
 
-// SetFilename sets the "filename" field.
-func (m *EngineLogMutation) SetFilename(s string) {
-	m.filename = &s
+// AddedDeviceID returns the value that was added to the "device_id" field in this mutation.
+func (m *DeviceTokenMutation) AddedDeviceID() (r int, exists bool) {
+	v := m.adddevice_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Filename returns the value of the "filename" field in the mutation.
-func (m *EngineLogMutation) Filename() (r string, exists bool) {
-	v := m.filename
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *DeviceTokenMutation) ResetDeviceID() {
+	m.device_id = nil
+	m.adddevice_id = nil
+}
+
+// SetRevoked sets the "revoked" field.
+func (m *DeviceTokenMutation) SetRevoked(b bool) {
+	m.revoked = &b
+}
+
+// Revoked returns the value of the "revoked" field in the mutation.
+func (m *DeviceTokenMutation) Revoked() (r bool, exists bool) {
+	v := m.revoked
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilename returns the old "filename" field's value of the EngineLog entity.
-// If the EngineLog object wasn't provided to the builder, the object is fetched from the database.
+// OldRevoked returns the old "revoked" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EngineLogMutation) OldFilename(ctx context.Context) (v string, err error) {
+func (m *DeviceTokenMutation) OldRevoked(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilename requires an ID field in the mutation")
+		return v, errors.New("OldRevoked requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
 	}
-	return oldValue.Filename, nil
+	return oldValue.Revoked, nil
 }
 
-// ResetFilename resets all changes to the "filename" field.
-func (m *EngineLogMutation) ResetFilename() {
-	m.filename = nil
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *DeviceTokenMutation) ResetRevoked() {
+	m.revoked = nil
 }
 
-// SetLine sets the "line" field.
-func (m *EngineLogMutation) SetLine(i int) {
-	m.line = &i
-	m.addline = nil
+// SetCreatedBy sets the "created_by" field.
+func (m *DeviceTokenMutation) SetCreatedBy(s string) {
+	m.created_by = &s
 }
 
-// Line returns the value of the "line" field in the mutation.
-func (m *EngineLogMutation) Line() (r int, exists bool) {
-	v := m.line
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *DeviceTokenMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLine returns the old "line" field's value of the EngineLog entity.
-// If the EngineLog object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EngineLogMutation) OldLine(ctx context.Context) (v int, err error) {
+func (m *DeviceTokenMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLine is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLine requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLine: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.Line, nil
+	return oldValue.CreatedBy, nil
 }
 
-// AddLine adds i to the "line" field.
-func (m *EngineLogMutation) AddLine(i int) {
-	if m.addline != nil {
-		*m.addline += i
-	} else {
-		m.addline = &i
-	}
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *DeviceTokenMutation) ResetCreatedBy() {
+	m.created_by = nil
 }
 
-// AddedLine returns the value that was added to the "line" field in this mutation.
-func (m *EngineLogMutation) AddedLine() (r int, exists bool) {
-	v := m.addline
+// SetCreatedAt sets the "created_at" field.
+func (m *DeviceTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeviceTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetLine resets all changes to the "line" field.
-func (m *EngineLogMutation) ResetLine() {
-	m.line = nil
-	m.addline = nil
-}
-
-// SetMessage sets the "message" field.
-func (m *EngineLogMutation) SetMessage(s string) {
-	m.message = &s
+// OldCreatedAt returns the old "created_at" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *EngineLogMutation) Message() (r string, exists bool) {
-	v := m.message
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DeviceTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *DeviceTokenMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *DeviceTokenMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessage returns the old "message" field's value of the EngineLog entity.
-// If the EngineLog object wasn't provided to the builder, the object is fetched from the database.
+// OldUsedAt returns the old "used_at" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EngineLogMutation) OldMessage(ctx context.Context) (v string, err error) {
+func (m *DeviceTokenMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
 	}
-	return oldValue.Message, nil
+	return oldValue.UsedAt, nil
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *EngineLogMutation) ResetMessage() {
-	m.message = nil
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *DeviceTokenMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[devicetoken.FieldUsedAt] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *EngineLogMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *DeviceTokenMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[devicetoken.FieldUsedAt]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EngineLogMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *DeviceTokenMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, devicetoken.FieldUsedAt)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *DeviceTokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *DeviceTokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the EngineLog entity.
-// If the EngineLog object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the DeviceToken entity.
+// If the DeviceToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EngineLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceTokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.RevokedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EngineLogMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *DeviceTokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[devicetoken.FieldRevokedAt] = struct{}{}
 }
 
-// Where appends a list predicates to the EngineLogMutation builder.
-func (m *EngineLogMutation) Where(ps ...predicate.EngineLog) {
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *DeviceTokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[devicetoken.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *DeviceTokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, devicetoken.FieldRevokedAt)
+}
+
+// Where appends a list predicates to the DeviceTokenMutation builder.
+func (m *DeviceTokenMutation) Where(ps ...predicate.DeviceToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EngineLogMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DeviceTokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EngineLogMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EngineLog, len(ps))
+func (m *DeviceTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeviceToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3860,42 +4502,48 @@ func (m *EngineLogMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EngineLogMutation) Op() Op {
+func (m *DeviceTokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EngineLogMutation) SetOp(op Op) {
+func (m *DeviceTokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (EngineLog).
-func (m *EngineLogMutation) Type() string {
+// Type returns the node type of this mutation (DeviceToken).
+func (m *DeviceTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EngineLogMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.modeling_id != nil {
-		fields = append(fields, enginelog.FieldModelingID)
+func (m *DeviceTokenMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, devicetoken.FieldName)
 	}
-	if m.level != nil {
-		fields = append(fields, enginelog.FieldLevel)
+	if m.token_hash != nil {
+		fields = append(fields, devicetoken.FieldTokenHash)
 	}
-	if m.filename != nil {
-		fields = append(fields, enginelog.FieldFilename)
+	if m.device_id != nil {
+		fields = append(fields, devicetoken.FieldDeviceID)
 	}
-	if m.line != nil {
-		fields = append(fields, enginelog.FieldLine)
+	if m.revoked != nil {
+		fields = append(fields, devicetoken.FieldRevoked)
 	}
-	if m.message != nil {
-		fields = append(fields, enginelog.FieldMessage)
+	if m.created_by != nil {
+		fields = append(fields, devicetoken.FieldCreatedBy)
 	}
 	if m.created_at != nil {
-		fields = append(fields, enginelog.FieldCreatedAt)
+		fields = append(fields, devicetoken.FieldCreatedAt)
+	}
+	if m.used_at != nil {
+		fields = append(fields, devicetoken.FieldUsedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, devicetoken.FieldRevokedAt)
 	}
 	return fields
 }
@@ -3903,20 +4551,24 @@ func (m *EngineLogMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EngineLogMutation) Field(name string) (ent.Value, bool) {
+func (m *DeviceTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case enginelog.FieldModelingID:
-		return m.ModelingID()
-	case enginelog.FieldLevel:
-		return m.Level()
-	case enginelog.FieldFilename:
-		return m.Filename()
-	case enginelog.FieldLine:
-		return m.Line()
-	case enginelog.FieldMessage:
-		return m.Message()
-	case enginelog.FieldCreatedAt:
+	case devicetoken.FieldName:
+		return m.Name()
+	case devicetoken.FieldTokenHash:
+		return m.TokenHash()
+	case devicetoken.FieldDeviceID:
+		return m.DeviceID()
+	case devicetoken.FieldRevoked:
+		return m.Revoked()
+	case devicetoken.FieldCreatedBy:
+		return m.CreatedBy()
+	case devicetoken.FieldCreatedAt:
 		return m.CreatedAt()
+	case devicetoken.FieldUsedAt:
+		return m.UsedAt()
+	case devicetoken.FieldRevokedAt:
+		return m.RevokedAt()
 	}
 	return nil, false
 }
@@ -3924,84 +4576,99 @@ func (m *EngineLogMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EngineLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeviceTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case enginelog.FieldModelingID:
-		return m.OldModelingID(ctx)
-	case enginelog.FieldLevel:
-		return m.OldLevel(ctx)
-	case enginelog.FieldFilename:
-		return m.OldFilename(ctx)
-	case enginelog.FieldLine:
-		return m.OldLine(ctx)
-	case enginelog.FieldMessage:
-		return m.OldMessage(ctx)
-	case enginelog.FieldCreatedAt:
+	case devicetoken.FieldName:
+		return m.OldName(ctx)
+	case devicetoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case devicetoken.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case devicetoken.FieldRevoked:
+		return m.OldRevoked(ctx)
+	case devicetoken.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case devicetoken.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case devicetoken.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case devicetoken.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown EngineLog field %s", name)
+	return nil, fmt.Errorf("unknown DeviceToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EngineLogMutation) SetField(name string, value ent.Value) error {
+func (m *DeviceTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case enginelog.FieldModelingID:
-		v, ok := value.(int)
+	case devicetoken.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelingID(v)
+		m.SetName(v)
 		return nil
-	case enginelog.FieldLevel:
+	case devicetoken.FieldTokenHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLevel(v)
+		m.SetTokenHash(v)
 		return nil
-	case enginelog.FieldFilename:
-		v, ok := value.(string)
+	case devicetoken.FieldDeviceID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilename(v)
+		m.SetDeviceID(v)
 		return nil
-	case enginelog.FieldLine:
-		v, ok := value.(int)
+	case devicetoken.FieldRevoked:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLine(v)
+		m.SetRevoked(v)
 		return nil
-	case enginelog.FieldMessage:
+	case devicetoken.FieldCreatedBy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMessage(v)
+		m.SetCreatedBy(v)
 		return nil
-	case enginelog.FieldCreatedAt:
+	case devicetoken.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case devicetoken.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case devicetoken.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown EngineLog field %s", name)
+	return fmt.Errorf("unknown DeviceToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EngineLogMutation) AddedFields() []string {
+func (m *DeviceTokenMutation) AddedFields() []string {
 	var fields []string
-	if m.addmodeling_id != nil {
-		fields = append(fields, enginelog.FieldModelingID)
-	}
-	if m.addline != nil {
-		fields = append(fields, enginelog.FieldLine)
+	if m.adddevice_id != nil {
+		fields = append(fields, devicetoken.FieldDeviceID)
 	}
 	return fields
 }
@@ -4009,12 +4676,10 @@ func (m *EngineLogMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EngineLogMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DeviceTokenMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case enginelog.FieldModelingID:
-		return m.AddedModelingID()
-	case enginelog.FieldLine:
-		return m.AddedLine()
+	case devicetoken.FieldDeviceID:
+		return m.AddedDeviceID()
 	}
 	return nil, false
 }
@@ -4022,150 +4687,169 @@ func (m *EngineLogMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EngineLogMutation) AddField(name string, value ent.Value) error {
+func (m *DeviceTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case enginelog.FieldModelingID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddModelingID(v)
-		return nil
-	case enginelog.FieldLine:
+	case devicetoken.FieldDeviceID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLine(v)
+		m.AddDeviceID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EngineLog numeric field %s", name)
+	return fmt.Errorf("unknown DeviceToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EngineLogMutation) ClearedFields() []string {
-	return nil
+func (m *DeviceTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(devicetoken.FieldUsedAt) {
+		fields = append(fields, devicetoken.FieldUsedAt)
+	}
+	if m.FieldCleared(devicetoken.FieldRevokedAt) {
+		fields = append(fields, devicetoken.FieldRevokedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EngineLogMutation) FieldCleared(name string) bool {
+func (m *DeviceTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EngineLogMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown EngineLog nullable field %s", name)
+func (m *DeviceTokenMutation) ClearField(name string) error {
+	switch name {
+	case devicetoken.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	case devicetoken.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EngineLogMutation) ResetField(name string) error {
+func (m *DeviceTokenMutation) ResetField(name string) error {
 	switch name {
-	case enginelog.FieldModelingID:
-		m.ResetModelingID()
+	case devicetoken.FieldName:
+		m.ResetName()
 		return nil
-	case enginelog.FieldLevel:
-		m.ResetLevel()
+	case devicetoken.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case enginelog.FieldFilename:
-		m.ResetFilename()
+	case devicetoken.FieldDeviceID:
+		m.ResetDeviceID()
 		return nil
-	case enginelog.FieldLine:
-		m.ResetLine()
+	case devicetoken.FieldRevoked:
+		m.ResetRevoked()
 		return nil
-	case enginelog.FieldMessage:
-		m.ResetMessage()
+	case devicetoken.FieldCreatedBy:
+		m.ResetCreatedBy()
 		return nil
-	case enginelog.FieldCreatedAt:
+	case devicetoken.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case devicetoken.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case devicetoken.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown EngineLog field %s", name)
+	return fmt.Errorf("unknown DeviceToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EngineLogMutation) AddedEdges() []string {
+func (m *DeviceTokenMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EngineLogMutation) AddedIDs(name string) []ent.Value {
+func (m *DeviceTokenMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EngineLogMutation) RemovedEdges() []string {
+func (m *DeviceTokenMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EngineLogMutation) RemovedIDs(name string) []ent.Value {
+func (m *DeviceTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EngineLogMutation) ClearedEdges() []string {
+func (m *DeviceTokenMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EngineLogMutation) EdgeCleared(name string) bool {
+func (m *DeviceTokenMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EngineLogMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown EngineLog unique edge %s", name)
+func (m *DeviceTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeviceToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EngineLogMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown EngineLog edge %s", name)
+func (m *DeviceTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeviceToken edge %s", name)
 }
 
-// GpuMutation represents an operation that mutates the Gpu nodes in the graph.
-type GpuMutation struct {
+// EarlyStopMutation represents an operation that mutates the EarlyStop nodes in the graph.
+type EarlyStopMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	uuid          *string
-	index         *int
-	addindex      *int
-	name          *string
-	state         *string
-	is_use        *bool
-	clearedFields map[string]struct{}
-	device        *int
-	cleareddevice bool
-	done          bool
-	oldValue      func(context.Context) (*Gpu, error)
-	predicates    []predicate.Gpu
+	op             Op
+	typ            string
+	id             *int
+	task_id        *int
+	addtask_id     *int
+	modeling_id    *int
+	addmodeling_id *int
+	trial_uuid     *string
+	policy         *string
+	metric         *string
+	epoch          *int
+	addepoch       *int
+	value          *float64
+	addvalue       *float64
+	reason         *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*EarlyStop, error)
+	predicates     []predicate.EarlyStop
 }
 
-var _ ent.Mutation = (*GpuMutation)(nil)
+var _ ent.Mutation = (*EarlyStopMutation)(nil)
 
-// gpuOption allows management of the mutation configuration using functional options.
-type gpuOption func(*GpuMutation)
+// earlystopOption allows management of the mutation configuration using functional options.
+type earlystopOption func(*EarlyStopMutation)
 
-// newGpuMutation creates new mutation for the Gpu entity.
-func newGpuMutation(c config, op Op, opts ...gpuOption) *GpuMutation {
-	m := &GpuMutation{
+// newEarlyStopMutation creates new mutation for the EarlyStop entity.
+func newEarlyStopMutation(c config, op Op, opts ...earlystopOption) *EarlyStopMutation {
+	m := &EarlyStopMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGpu,
+		typ:           TypeEarlyStop,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4174,20 +4858,20 @@ func newGpuMutation(c config, op Op, opts ...gpuOption) *GpuMutation {
 	return m
 }
 
-// withGpuID sets the ID field of the mutation.
-func withGpuID(id int) gpuOption {
-	return func(m *GpuMutation) {
+// withEarlyStopID sets the ID field of the mutation.
+func withEarlyStopID(id int) earlystopOption {
+	return func(m *EarlyStopMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Gpu
+			value *EarlyStop
 		)
-		m.oldValue = func(ctx context.Context) (*Gpu, error) {
+		m.oldValue = func(ctx context.Context) (*EarlyStop, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Gpu.Get(ctx, id)
+					value, err = m.Client().EarlyStop.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4196,10 +4880,10 @@ func withGpuID(id int) gpuOption {
 	}
 }
 
-// withGpu sets the old Gpu of the mutation.
-func withGpu(node *Gpu) gpuOption {
-	return func(m *GpuMutation) {
-		m.oldValue = func(context.Context) (*Gpu, error) {
+// withEarlyStop sets the old EarlyStop of the mutation.
+func withEarlyStop(node *EarlyStop) earlystopOption {
+	return func(m *EarlyStopMutation) {
+		m.oldValue = func(context.Context) (*EarlyStop, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4208,7 +4892,7 @@ func withGpu(node *Gpu) gpuOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GpuMutation) Client() *Client {
+func (m EarlyStopMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4216,7 +4900,7 @@ func (m GpuMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GpuMutation) Tx() (*Tx, error) {
+func (m EarlyStopMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4225,9 +4909,15 @@ func (m GpuMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EarlyStop entities.
+func (m *EarlyStopMutation) SetID(id int) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GpuMutation) ID() (id int, exists bool) {
+func (m *EarlyStopMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4238,7 +4928,7 @@ func (m *GpuMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GpuMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *EarlyStopMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4247,361 +4937,504 @@ func (m *GpuMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Gpu.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EarlyStop.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUUID sets the "uuid" field.
-func (m *GpuMutation) SetUUID(s string) {
-	m.uuid = &s
+// SetTaskID sets the "task_id" field.
+func (m *EarlyStopMutation) SetTaskID(i int) {
+	m.task_id = &i
+	m.addtask_id = nil
 }
 
-// UUID returns the value of the "uuid" field in the mutation.
-func (m *GpuMutation) UUID() (r string, exists bool) {
-	v := m.uuid
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *EarlyStopMutation) TaskID() (r int, exists bool) {
+	v := m.task_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUUID returns the old "uuid" field's value of the Gpu entity.
-// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the EarlyStop entity.
+// If the EarlyStop object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GpuMutation) OldUUID(ctx context.Context) (v string, err error) {
+func (m *EarlyStopMutation) OldTaskID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUUID requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.UUID, nil
+	return oldValue.TaskID, nil
 }
 
-// ResetUUID resets all changes to the "uuid" field.
-func (m *GpuMutation) ResetUUID() {
-	m.uuid = nil
+// AddTaskID adds i to the "task_id" field.
+func (m *EarlyStopMutation) AddTaskID(i int) {
+	if m.addtask_id != nil {
+		*m.addtask_id += i
+	} else {
+		m.addtask_id = &i
+	}
 }
 
-// SetIndex sets the "index" field.
-func (m *GpuMutation) SetIndex(i int) {
-	m.index = &i
-	m.addindex = nil
+// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
+func (m *EarlyStopMutation) AddedTaskID() (r int, exists bool) {
+	v := m.addtask_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Index returns the value of the "index" field in the mutation.
-func (m *GpuMutation) Index() (r int, exists bool) {
-	v := m.index
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *EarlyStopMutation) ResetTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (m *EarlyStopMutation) SetModelingID(i int) {
+	m.modeling_id = &i
+	m.addmodeling_id = nil
+}
+
+// ModelingID returns the value of the "modeling_id" field in the mutation.
+func (m *EarlyStopMutation) ModelingID() (r int, exists bool) {
+	v := m.modeling_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIndex returns the old "index" field's value of the Gpu entity.
-// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
+// OldModelingID returns the old "modeling_id" field's value of the EarlyStop entity.
+// If the EarlyStop object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GpuMutation) OldIndex(ctx context.Context) (v int, err error) {
+func (m *EarlyStopMutation) OldModelingID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
+		return v, errors.New("OldModelingID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIndex requires an ID field in the mutation")
+		return v, errors.New("OldModelingID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
+		return v, fmt.Errorf("querying old value for OldModelingID: %w", err)
 	}
-	return oldValue.Index, nil
+	return oldValue.ModelingID, nil
 }
 
-// AddIndex adds i to the "index" field.
-func (m *GpuMutation) AddIndex(i int) {
-	if m.addindex != nil {
-		*m.addindex += i
+// AddModelingID adds i to the "modeling_id" field.
+func (m *EarlyStopMutation) AddModelingID(i int) {
+	if m.addmodeling_id != nil {
+		*m.addmodeling_id += i
 	} else {
-		m.addindex = &i
+		m.addmodeling_id = &i
 	}
 }
 
-// AddedIndex returns the value that was added to the "index" field in this mutation.
-func (m *GpuMutation) AddedIndex() (r int, exists bool) {
-	v := m.addindex
+// AddedModelingID returns the value that was added to the "modeling_id" field in this mutation.
+func (m *EarlyStopMutation) AddedModelingID() (r int, exists bool) {
+	v := m.addmodeling_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetIndex resets all changes to the "index" field.
-func (m *GpuMutation) ResetIndex() {
-	m.index = nil
-	m.addindex = nil
+// ResetModelingID resets all changes to the "modeling_id" field.
+func (m *EarlyStopMutation) ResetModelingID() {
+	m.modeling_id = nil
+	m.addmodeling_id = nil
 }
 
-// SetName sets the "name" field.
-func (m *GpuMutation) SetName(s string) {
-	m.name = &s
+// SetTrialUUID sets the "trial_uuid" field.
+func (m *EarlyStopMutation) SetTrialUUID(s string) {
+	m.trial_uuid = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *GpuMutation) Name() (r string, exists bool) {
-	v := m.name
+// TrialUUID returns the value of the "trial_uuid" field in the mutation.
+func (m *EarlyStopMutation) TrialUUID() (r string, exists bool) {
+	v := m.trial_uuid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Gpu entity.
-// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
+// OldTrialUUID returns the old "trial_uuid" field's value of the EarlyStop entity.
+// If the EarlyStop object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GpuMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *EarlyStopMutation) OldTrialUUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrialUUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldTrialUUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrialUUID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.TrialUUID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *GpuMutation) ResetName() {
-	m.name = nil
+// ResetTrialUUID resets all changes to the "trial_uuid" field.
+func (m *EarlyStopMutation) ResetTrialUUID() {
+	m.trial_uuid = nil
 }
 
-// SetState sets the "state" field.
-func (m *GpuMutation) SetState(s string) {
-	m.state = &s
+// SetPolicy sets the "policy" field.
+func (m *EarlyStopMutation) SetPolicy(s string) {
+	m.policy = &s
 }
 
-// State returns the value of the "state" field in the mutation.
-func (m *GpuMutation) State() (r string, exists bool) {
-	v := m.state
+// Policy returns the value of the "policy" field in the mutation.
+func (m *EarlyStopMutation) Policy() (r string, exists bool) {
+	v := m.policy
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldState returns the old "state" field's value of the Gpu entity.
-// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
+// OldPolicy returns the old "policy" field's value of the EarlyStop entity.
+// If the EarlyStop object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GpuMutation) OldState(ctx context.Context) (v string, err error) {
+func (m *EarlyStopMutation) OldPolicy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldState is only allowed on UpdateOne operations")
+		return v, errors.New("OldPolicy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldState requires an ID field in the mutation")
+		return v, errors.New("OldPolicy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldState: %w", err)
+		return v, fmt.Errorf("querying old value for OldPolicy: %w", err)
 	}
-	return oldValue.State, nil
+	return oldValue.Policy, nil
 }
 
-// ResetState resets all changes to the "state" field.
-func (m *GpuMutation) ResetState() {
-	m.state = nil
+// ResetPolicy resets all changes to the "policy" field.
+func (m *EarlyStopMutation) ResetPolicy() {
+	m.policy = nil
 }
 
-// SetIsUse sets the "is_use" field.
-func (m *GpuMutation) SetIsUse(b bool) {
-	m.is_use = &b
+// SetMetric sets the "metric" field.
+func (m *EarlyStopMutation) SetMetric(s string) {
+	m.metric = &s
 }
 
-// IsUse returns the value of the "is_use" field in the mutation.
-func (m *GpuMutation) IsUse() (r bool, exists bool) {
-	v := m.is_use
+// Metric returns the value of the "metric" field in the mutation.
+func (m *EarlyStopMutation) Metric() (r string, exists bool) {
+	v := m.metric
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsUse returns the old "is_use" field's value of the Gpu entity.
-// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
+// OldMetric returns the old "metric" field's value of the EarlyStop entity.
+// If the EarlyStop object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GpuMutation) OldIsUse(ctx context.Context) (v bool, err error) {
+func (m *EarlyStopMutation) OldMetric(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsUse is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetric is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsUse requires an ID field in the mutation")
+		return v, errors.New("OldMetric requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsUse: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetric: %w", err)
 	}
-	return oldValue.IsUse, nil
+	return oldValue.Metric, nil
 }
 
-// ResetIsUse resets all changes to the "is_use" field.
-func (m *GpuMutation) ResetIsUse() {
-	m.is_use = nil
+// ResetMetric resets all changes to the "metric" field.
+func (m *EarlyStopMutation) ResetMetric() {
+	m.metric = nil
 }
 
-// SetDeviceID sets the "device_id" field.
-func (m *GpuMutation) SetDeviceID(i int) {
-	m.device = &i
+// SetEpoch sets the "epoch" field.
+func (m *EarlyStopMutation) SetEpoch(i int) {
+	m.epoch = &i
+	m.addepoch = nil
 }
 
-// DeviceID returns the value of the "device_id" field in the mutation.
-func (m *GpuMutation) DeviceID() (r int, exists bool) {
-	v := m.device
+// Epoch returns the value of the "epoch" field in the mutation.
+func (m *EarlyStopMutation) Epoch() (r int, exists bool) {
+	v := m.epoch
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeviceID returns the old "device_id" field's value of the Gpu entity.
-// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
+// OldEpoch returns the old "epoch" field's value of the EarlyStop entity.
+// If the EarlyStop object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GpuMutation) OldDeviceID(ctx context.Context) (v int, err error) {
+func (m *EarlyStopMutation) OldEpoch(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldEpoch is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+		return v, errors.New("OldEpoch requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldEpoch: %w", err)
 	}
-	return oldValue.DeviceID, nil
+	return oldValue.Epoch, nil
 }
 
-// ClearDeviceID clears the value of the "device_id" field.
-func (m *GpuMutation) ClearDeviceID() {
-	m.device = nil
-	m.clearedFields[gpu.FieldDeviceID] = struct{}{}
-}
-
-// DeviceIDCleared returns if the "device_id" field was cleared in this mutation.
-func (m *GpuMutation) DeviceIDCleared() bool {
-	_, ok := m.clearedFields[gpu.FieldDeviceID]
-	return ok
+// AddEpoch adds i to the "epoch" field.
+func (m *EarlyStopMutation) AddEpoch(i int) {
+	if m.addepoch != nil {
+		*m.addepoch += i
+	} else {
+		m.addepoch = &i
+	}
 }
 
-// ResetDeviceID resets all changes to the "device_id" field.
-func (m *GpuMutation) ResetDeviceID() {
-	m.device = nil
-	delete(m.clearedFields, gpu.FieldDeviceID)
+// AddedEpoch returns the value that was added to the "epoch" field in this mutation.
+func (m *EarlyStopMutation) AddedEpoch() (r int, exists bool) {
+	v := m.addepoch
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearDevice clears the "device" edge to the Device entity.
-func (m *GpuMutation) ClearDevice() {
-	m.cleareddevice = true
-	m.clearedFields[gpu.FieldDeviceID] = struct{}{}
+// ResetEpoch resets all changes to the "epoch" field.
+func (m *EarlyStopMutation) ResetEpoch() {
+	m.epoch = nil
+	m.addepoch = nil
 }
 
-// DeviceCleared reports if the "device" edge to the Device entity was cleared.
-func (m *GpuMutation) DeviceCleared() bool {
-	return m.DeviceIDCleared() || m.cleareddevice
+// SetValue sets the "value" field.
+func (m *EarlyStopMutation) SetValue(f float64) {
+	m.value = &f
+	m.addvalue = nil
 }
 
-// DeviceIDs returns the "device" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DeviceID instead. It exists only for internal usage by the builders.
-func (m *GpuMutation) DeviceIDs() (ids []int) {
-	if id := m.device; id != nil {
-		ids = append(ids, *id)
+// Value returns the value of the "value" field in the mutation.
+func (m *EarlyStopMutation) Value() (r float64, exists bool) {
+	v := m.value
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetDevice resets all changes to the "device" edge.
-func (m *GpuMutation) ResetDevice() {
-	m.device = nil
-	m.cleareddevice = false
+// OldValue returns the old "value" field's value of the EarlyStop entity.
+// If the EarlyStop object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EarlyStopMutation) OldValue(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
 }
 
-// Where appends a list predicates to the GpuMutation builder.
-func (m *GpuMutation) Where(ps ...predicate.Gpu) {
-	m.predicates = append(m.predicates, ps...)
+// AddValue adds f to the "value" field.
+func (m *EarlyStopMutation) AddValue(f float64) {
+	if m.addvalue != nil {
+		*m.addvalue += f
+	} else {
+		m.addvalue = &f
+	}
 }
 
-// WhereP appends storage-level predicates to the GpuMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GpuMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Gpu, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedValue returns the value that was added to the "value" field in this mutation.
+func (m *EarlyStopMutation) AddedValue() (r float64, exists bool) {
+	v := m.addvalue
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *GpuMutation) Op() Op {
-	return m.op
+// ResetValue resets all changes to the "value" field.
+func (m *EarlyStopMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *GpuMutation) SetOp(op Op) {
-	m.op = op
+// SetReason sets the "reason" field.
+func (m *EarlyStopMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// Type returns the node type of this mutation (Gpu).
-func (m *GpuMutation) Type() string {
-	return m.typ
+// Reason returns the value of the "reason" field in the mutation.
+func (m *EarlyStopMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *GpuMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.uuid != nil {
-		fields = append(fields, gpu.FieldUUID)
-	}
-	if m.index != nil {
-		fields = append(fields, gpu.FieldIndex)
-	}
-	if m.name != nil {
-		fields = append(fields, gpu.FieldName)
-	}
-	if m.state != nil {
-		fields = append(fields, gpu.FieldState)
+// OldReason returns the old "reason" field's value of the EarlyStop entity.
+// If the EarlyStop object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EarlyStopMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
-	if m.is_use != nil {
-		fields = append(fields, gpu.FieldIsUse)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
-	if m.device != nil {
-		fields = append(fields, gpu.FieldDeviceID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return fields
+	return oldValue.Reason, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *GpuMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case gpu.FieldUUID:
-		return m.UUID()
-	case gpu.FieldIndex:
-		return m.Index()
-	case gpu.FieldName:
-		return m.Name()
-	case gpu.FieldState:
-		return m.State()
-	case gpu.FieldIsUse:
-		return m.IsUse()
-	case gpu.FieldDeviceID:
-		return m.DeviceID()
+// ResetReason resets all changes to the "reason" field.
+func (m *EarlyStopMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EarlyStopMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EarlyStopMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EarlyStop entity.
+// If the EarlyStop object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EarlyStopMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EarlyStopMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the EarlyStopMutation builder.
+func (m *EarlyStopMutation) Where(ps ...predicate.EarlyStop) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EarlyStopMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EarlyStopMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EarlyStop, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EarlyStopMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EarlyStopMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EarlyStop).
+func (m *EarlyStopMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EarlyStopMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.task_id != nil {
+		fields = append(fields, earlystop.FieldTaskID)
+	}
+	if m.modeling_id != nil {
+		fields = append(fields, earlystop.FieldModelingID)
+	}
+	if m.trial_uuid != nil {
+		fields = append(fields, earlystop.FieldTrialUUID)
+	}
+	if m.policy != nil {
+		fields = append(fields, earlystop.FieldPolicy)
+	}
+	if m.metric != nil {
+		fields = append(fields, earlystop.FieldMetric)
+	}
+	if m.epoch != nil {
+		fields = append(fields, earlystop.FieldEpoch)
+	}
+	if m.value != nil {
+		fields = append(fields, earlystop.FieldValue)
+	}
+	if m.reason != nil {
+		fields = append(fields, earlystop.FieldReason)
+	}
+	if m.created_at != nil {
+		fields = append(fields, earlystop.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EarlyStopMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case earlystop.FieldTaskID:
+		return m.TaskID()
+	case earlystop.FieldModelingID:
+		return m.ModelingID()
+	case earlystop.FieldTrialUUID:
+		return m.TrialUUID()
+	case earlystop.FieldPolicy:
+		return m.Policy()
+	case earlystop.FieldMetric:
+		return m.Metric()
+	case earlystop.FieldEpoch:
+		return m.Epoch()
+	case earlystop.FieldValue:
+		return m.Value()
+	case earlystop.FieldReason:
+		return m.Reason()
+	case earlystop.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -4609,81 +5442,117 @@ func (m *GpuMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GpuMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EarlyStopMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case gpu.FieldUUID:
-		return m.OldUUID(ctx)
-	case gpu.FieldIndex:
-		return m.OldIndex(ctx)
-	case gpu.FieldName:
-		return m.OldName(ctx)
-	case gpu.FieldState:
-		return m.OldState(ctx)
-	case gpu.FieldIsUse:
-		return m.OldIsUse(ctx)
-	case gpu.FieldDeviceID:
-		return m.OldDeviceID(ctx)
+	case earlystop.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case earlystop.FieldModelingID:
+		return m.OldModelingID(ctx)
+	case earlystop.FieldTrialUUID:
+		return m.OldTrialUUID(ctx)
+	case earlystop.FieldPolicy:
+		return m.OldPolicy(ctx)
+	case earlystop.FieldMetric:
+		return m.OldMetric(ctx)
+	case earlystop.FieldEpoch:
+		return m.OldEpoch(ctx)
+	case earlystop.FieldValue:
+		return m.OldValue(ctx)
+	case earlystop.FieldReason:
+		return m.OldReason(ctx)
+	case earlystop.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Gpu field %s", name)
+	return nil, fmt.Errorf("unknown EarlyStop field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GpuMutation) SetField(name string, value ent.Value) error {
+func (m *EarlyStopMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case gpu.FieldUUID:
-		v, ok := value.(string)
+	case earlystop.FieldTaskID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUUID(v)
+		m.SetTaskID(v)
 		return nil
-	case gpu.FieldIndex:
+	case earlystop.FieldModelingID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIndex(v)
+		m.SetModelingID(v)
 		return nil
-	case gpu.FieldName:
+	case earlystop.FieldTrialUUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetTrialUUID(v)
 		return nil
-	case gpu.FieldState:
+	case earlystop.FieldPolicy:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetState(v)
+		m.SetPolicy(v)
 		return nil
-	case gpu.FieldIsUse:
-		v, ok := value.(bool)
+	case earlystop.FieldMetric:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsUse(v)
+		m.SetMetric(v)
 		return nil
-	case gpu.FieldDeviceID:
+	case earlystop.FieldEpoch:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeviceID(v)
+		m.SetEpoch(v)
+		return nil
+	case earlystop.FieldValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case earlystop.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case earlystop.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Gpu field %s", name)
+	return fmt.Errorf("unknown EarlyStop field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GpuMutation) AddedFields() []string {
+func (m *EarlyStopMutation) AddedFields() []string {
 	var fields []string
-	if m.addindex != nil {
-		fields = append(fields, gpu.FieldIndex)
+	if m.addtask_id != nil {
+		fields = append(fields, earlystop.FieldTaskID)
+	}
+	if m.addmodeling_id != nil {
+		fields = append(fields, earlystop.FieldModelingID)
+	}
+	if m.addepoch != nil {
+		fields = append(fields, earlystop.FieldEpoch)
+	}
+	if m.addvalue != nil {
+		fields = append(fields, earlystop.FieldValue)
 	}
 	return fields
 }
@@ -4691,10 +5560,16 @@ func (m *GpuMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GpuMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EarlyStopMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case gpu.FieldIndex:
-		return m.AddedIndex()
+	case earlystop.FieldTaskID:
+		return m.AddedTaskID()
+	case earlystop.FieldModelingID:
+		return m.AddedModelingID()
+	case earlystop.FieldEpoch:
+		return m.AddedEpoch()
+	case earlystop.FieldValue:
+		return m.AddedValue()
 	}
 	return nil, false
 }
@@ -4702,178 +5577,173 @@ func (m *GpuMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GpuMutation) AddField(name string, value ent.Value) error {
+func (m *EarlyStopMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case gpu.FieldIndex:
+	case earlystop.FieldTaskID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddIndex(v)
+		m.AddTaskID(v)
+		return nil
+	case earlystop.FieldModelingID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModelingID(v)
+		return nil
+	case earlystop.FieldEpoch:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEpoch(v)
+		return nil
+	case earlystop.FieldValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Gpu numeric field %s", name)
+	return fmt.Errorf("unknown EarlyStop numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GpuMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(gpu.FieldDeviceID) {
-		fields = append(fields, gpu.FieldDeviceID)
-	}
-	return fields
+func (m *EarlyStopMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GpuMutation) FieldCleared(name string) bool {
+func (m *EarlyStopMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GpuMutation) ClearField(name string) error {
-	switch name {
-	case gpu.FieldDeviceID:
-		m.ClearDeviceID()
-		return nil
-	}
-	return fmt.Errorf("unknown Gpu nullable field %s", name)
+func (m *EarlyStopMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EarlyStop nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GpuMutation) ResetField(name string) error {
+func (m *EarlyStopMutation) ResetField(name string) error {
 	switch name {
-	case gpu.FieldUUID:
-		m.ResetUUID()
+	case earlystop.FieldTaskID:
+		m.ResetTaskID()
 		return nil
-	case gpu.FieldIndex:
-		m.ResetIndex()
+	case earlystop.FieldModelingID:
+		m.ResetModelingID()
 		return nil
-	case gpu.FieldName:
-		m.ResetName()
+	case earlystop.FieldTrialUUID:
+		m.ResetTrialUUID()
 		return nil
-	case gpu.FieldState:
-		m.ResetState()
+	case earlystop.FieldPolicy:
+		m.ResetPolicy()
 		return nil
-	case gpu.FieldIsUse:
-		m.ResetIsUse()
+	case earlystop.FieldMetric:
+		m.ResetMetric()
 		return nil
-	case gpu.FieldDeviceID:
-		m.ResetDeviceID()
+	case earlystop.FieldEpoch:
+		m.ResetEpoch()
+		return nil
+	case earlystop.FieldValue:
+		m.ResetValue()
+		return nil
+	case earlystop.FieldReason:
+		m.ResetReason()
+		return nil
+	case earlystop.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Gpu field %s", name)
+	return fmt.Errorf("unknown EarlyStop field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GpuMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.device != nil {
-		edges = append(edges, gpu.EdgeDevice)
-	}
+func (m *EarlyStopMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GpuMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case gpu.EdgeDevice:
-		if id := m.device; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *EarlyStopMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GpuMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *EarlyStopMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GpuMutation) RemovedIDs(name string) []ent.Value {
+func (m *EarlyStopMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GpuMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareddevice {
-		edges = append(edges, gpu.EdgeDevice)
-	}
+func (m *EarlyStopMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GpuMutation) EdgeCleared(name string) bool {
-	switch name {
-	case gpu.EdgeDevice:
-		return m.cleareddevice
-	}
+func (m *EarlyStopMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GpuMutation) ClearEdge(name string) error {
-	switch name {
-	case gpu.EdgeDevice:
-		m.ClearDevice()
-		return nil
-	}
-	return fmt.Errorf("unknown Gpu unique edge %s", name)
+func (m *EarlyStopMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EarlyStop unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GpuMutation) ResetEdge(name string) error {
-	switch name {
-	case gpu.EdgeDevice:
-		m.ResetDevice()
-		return nil
-	}
-	return fmt.Errorf("unknown Gpu edge %s", name)
+func (m *EarlyStopMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EarlyStop edge %s", name)
 }
 
-// HyperParamsHistoryMutation represents an operation that mutates the HyperParamsHistory nodes in the graph.
-type HyperParamsHistoryMutation struct {
+// EngineLogMutation represents an operation that mutates the EngineLog nodes in the graph.
+type EngineLogMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	trial_id      *int
-	addtrial_id   *int
-	trial_uuid    *string
-	model         *string
-	model_num     *int
-	addmodel_num  *int
-	params        *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*HyperParamsHistory, error)
-	predicates    []predicate.HyperParamsHistory
+	op             Op
+	typ            string
+	id             *int
+	modeling_id    *int
+	addmodeling_id *int
+	level          *string
+	filename       *string
+	line           *int
+	addline        *int
+	message        *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*EngineLog, error)
+	predicates     []predicate.EngineLog
 }
 
-var _ ent.Mutation = (*HyperParamsHistoryMutation)(nil)
+var _ ent.Mutation = (*EngineLogMutation)(nil)
 
-// hyperparamshistoryOption allows management of the mutation configuration using functional options.
-type hyperparamshistoryOption func(*HyperParamsHistoryMutation)
+// enginelogOption allows management of the mutation configuration using functional options.
+type enginelogOption func(*EngineLogMutation)
 
-// newHyperParamsHistoryMutation creates new mutation for the HyperParamsHistory entity.
-func newHyperParamsHistoryMutation(c config, op Op, opts ...hyperparamshistoryOption) *HyperParamsHistoryMutation {
-	m := &HyperParamsHistoryMutation{
+// newEngineLogMutation creates new mutation for the EngineLog entity.
+func newEngineLogMutation(c config, op Op, opts ...enginelogOption) *EngineLogMutation {
+	m := &EngineLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHyperParamsHistory,
+		typ:           TypeEngineLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4882,20 +5752,20 @@ func newHyperParamsHistoryMutation(c config, op Op, opts ...hyperparamshistoryOp
 	return m
 }
 
-// withHyperParamsHistoryID sets the ID field of the mutation.
-func withHyperParamsHistoryID(id int) hyperparamshistoryOption {
-	return func(m *HyperParamsHistoryMutation) {
+// withEngineLogID sets the ID field of the mutation.
+func withEngineLogID(id int) enginelogOption {
+	return func(m *EngineLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *HyperParamsHistory
+			value *EngineLog
 		)
-		m.oldValue = func(ctx context.Context) (*HyperParamsHistory, error) {
+		m.oldValue = func(ctx context.Context) (*EngineLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().HyperParamsHistory.Get(ctx, id)
+					value, err = m.Client().EngineLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4904,10 +5774,10 @@ func withHyperParamsHistoryID(id int) hyperparamshistoryOption {
 	}
 }
 
-// withHyperParamsHistory sets the old HyperParamsHistory of the mutation.
-func withHyperParamsHistory(node *HyperParamsHistory) hyperparamshistoryOption {
-	return func(m *HyperParamsHistoryMutation) {
-		m.oldValue = func(context.Context) (*HyperParamsHistory, error) {
+// withEngineLog sets the old EngineLog of the mutation.
+func withEngineLog(node *EngineLog) enginelogOption {
+	return func(m *EngineLogMutation) {
+		m.oldValue = func(context.Context) (*EngineLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4916,7 +5786,7 @@ func withHyperParamsHistory(node *HyperParamsHistory) hyperparamshistoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HyperParamsHistoryMutation) Client() *Client {
+func (m EngineLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4924,7 +5794,7 @@ func (m HyperParamsHistoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HyperParamsHistoryMutation) Tx() (*Tx, error) {
+func (m EngineLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4934,14 +5804,14 @@ func (m HyperParamsHistoryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of HyperParamsHistory entities.
-func (m *HyperParamsHistoryMutation) SetID(id int) {
+// operation is only accepted on creation of EngineLog entities.
+func (m *EngineLogMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HyperParamsHistoryMutation) ID() (id int, exists bool) {
+func (m *EngineLogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4952,7 +5822,7 @@ func (m *HyperParamsHistoryMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HyperParamsHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *EngineLogMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4961,239 +5831,239 @@ func (m *HyperParamsHistoryMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().HyperParamsHistory.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EngineLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTrialID sets the "trial_id" field.
-func (m *HyperParamsHistoryMutation) SetTrialID(i int) {
-	m.trial_id = &i
-	m.addtrial_id = nil
+// SetModelingID sets the "modeling_id" field.
+func (m *EngineLogMutation) SetModelingID(i int) {
+	m.modeling_id = &i
+	m.addmodeling_id = nil
 }
 
-// TrialID returns the value of the "trial_id" field in the mutation.
-func (m *HyperParamsHistoryMutation) TrialID() (r int, exists bool) {
-	v := m.trial_id
+// ModelingID returns the value of the "modeling_id" field in the mutation.
+func (m *EngineLogMutation) ModelingID() (r int, exists bool) {
+	v := m.modeling_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrialID returns the old "trial_id" field's value of the HyperParamsHistory entity.
-// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldModelingID returns the old "modeling_id" field's value of the EngineLog entity.
+// If the EngineLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HyperParamsHistoryMutation) OldTrialID(ctx context.Context) (v int, err error) {
+func (m *EngineLogMutation) OldModelingID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrialID is only allowed on UpdateOne operations")
+		return v, errors.New("OldModelingID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrialID requires an ID field in the mutation")
+		return v, errors.New("OldModelingID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrialID: %w", err)
+		return v, fmt.Errorf("querying old value for OldModelingID: %w", err)
 	}
-	return oldValue.TrialID, nil
+	return oldValue.ModelingID, nil
 }
 
-// AddTrialID adds i to the "trial_id" field.
-func (m *HyperParamsHistoryMutation) AddTrialID(i int) {
-	if m.addtrial_id != nil {
-		*m.addtrial_id += i
+// AddModelingID adds i to the "modeling_id" field.
+func (m *EngineLogMutation) AddModelingID(i int) {
+	if m.addmodeling_id != nil {
+		*m.addmodeling_id += i
 	} else {
-		m.addtrial_id = &i
+		m.addmodeling_id = &i
 	}
 }
 
-// AddedTrialID returns the value that was added to the "trial_id" field in this mutation.
-func (m *HyperParamsHistoryMutation) AddedTrialID() (r int, exists bool) {
-	v := m.addtrial_id
+// AddedModelingID returns the value that was added to the "modeling_id" field in this mutation.
+func (m *EngineLogMutation) AddedModelingID() (r int, exists bool) {
+	v := m.addmodeling_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTrialID resets all changes to the "trial_id" field.
-func (m *HyperParamsHistoryMutation) ResetTrialID() {
-	m.trial_id = nil
-	m.addtrial_id = nil
+// ResetModelingID resets all changes to the "modeling_id" field.
+func (m *EngineLogMutation) ResetModelingID() {
+	m.modeling_id = nil
+	m.addmodeling_id = nil
 }
 
-// SetTrialUUID sets the "trial_uuid" field.
-func (m *HyperParamsHistoryMutation) SetTrialUUID(s string) {
-	m.trial_uuid = &s
+// SetLevel sets the "level" field.
+func (m *EngineLogMutation) SetLevel(s string) {
+	m.level = &s
 }
 
-// TrialUUID returns the value of the "trial_uuid" field in the mutation.
-func (m *HyperParamsHistoryMutation) TrialUUID() (r string, exists bool) {
-	v := m.trial_uuid
+// Level returns the value of the "level" field in the mutation.
+func (m *EngineLogMutation) Level() (r string, exists bool) {
+	v := m.level
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrialUUID returns the old "trial_uuid" field's value of the HyperParamsHistory entity.
-// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldLevel returns the old "level" field's value of the EngineLog entity.
+// If the EngineLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HyperParamsHistoryMutation) OldTrialUUID(ctx context.Context) (v string, err error) {
+func (m *EngineLogMutation) OldLevel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrialUUID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrialUUID requires an ID field in the mutation")
+		return v, errors.New("OldLevel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrialUUID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
 	}
-	return oldValue.TrialUUID, nil
+	return oldValue.Level, nil
 }
 
-// ResetTrialUUID resets all changes to the "trial_uuid" field.
-func (m *HyperParamsHistoryMutation) ResetTrialUUID() {
-	m.trial_uuid = nil
+// ResetLevel resets all changes to the "level" field.
+func (m *EngineLogMutation) ResetLevel() {
+	m.level = nil
 }
 
-// SetModel sets the "model" field.
-func (m *HyperParamsHistoryMutation) SetModel(s string) {
-	m.model = &s
+// SetFilename sets the "filename" field.
+func (m *EngineLogMutation) SetFilename(s string) {
+	m.filename = &s
 }
 
-// Model returns the value of the "model" field in the mutation.
-func (m *HyperParamsHistoryMutation) Model() (r string, exists bool) {
-	v := m.model
+// Filename returns the value of the "filename" field in the mutation.
+func (m *EngineLogMutation) Filename() (r string, exists bool) {
+	v := m.filename
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModel returns the old "model" field's value of the HyperParamsHistory entity.
-// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldFilename returns the old "filename" field's value of the EngineLog entity.
+// If the EngineLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HyperParamsHistoryMutation) OldModel(ctx context.Context) (v string, err error) {
+func (m *EngineLogMutation) OldFilename(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModel requires an ID field in the mutation")
+		return v, errors.New("OldFilename requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
 	}
-	return oldValue.Model, nil
+	return oldValue.Filename, nil
 }
 
-// ResetModel resets all changes to the "model" field.
-func (m *HyperParamsHistoryMutation) ResetModel() {
-	m.model = nil
+// ResetFilename resets all changes to the "filename" field.
+func (m *EngineLogMutation) ResetFilename() {
+	m.filename = nil
 }
 
-// SetModelNum sets the "model_num" field.
-func (m *HyperParamsHistoryMutation) SetModelNum(i int) {
-	m.model_num = &i
-	m.addmodel_num = nil
+// SetLine sets the "line" field.
+func (m *EngineLogMutation) SetLine(i int) {
+	m.line = &i
+	m.addline = nil
 }
 
-// ModelNum returns the value of the "model_num" field in the mutation.
-func (m *HyperParamsHistoryMutation) ModelNum() (r int, exists bool) {
-	v := m.model_num
+// Line returns the value of the "line" field in the mutation.
+func (m *EngineLogMutation) Line() (r int, exists bool) {
+	v := m.line
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelNum returns the old "model_num" field's value of the HyperParamsHistory entity.
-// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldLine returns the old "line" field's value of the EngineLog entity.
+// If the EngineLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HyperParamsHistoryMutation) OldModelNum(ctx context.Context) (v int, err error) {
+func (m *EngineLogMutation) OldLine(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelNum is only allowed on UpdateOne operations")
+		return v, errors.New("OldLine is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelNum requires an ID field in the mutation")
+		return v, errors.New("OldLine requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelNum: %w", err)
+		return v, fmt.Errorf("querying old value for OldLine: %w", err)
 	}
-	return oldValue.ModelNum, nil
+	return oldValue.Line, nil
 }
 
-// AddModelNum adds i to the "model_num" field.
-func (m *HyperParamsHistoryMutation) AddModelNum(i int) {
-	if m.addmodel_num != nil {
-		*m.addmodel_num += i
+// AddLine adds i to the "line" field.
+func (m *EngineLogMutation) AddLine(i int) {
+	if m.addline != nil {
+		*m.addline += i
 	} else {
-		m.addmodel_num = &i
+		m.addline = &i
 	}
 }
 
-// AddedModelNum returns the value that was added to the "model_num" field in this mutation.
-func (m *HyperParamsHistoryMutation) AddedModelNum() (r int, exists bool) {
-	v := m.addmodel_num
+// AddedLine returns the value that was added to the "line" field in this mutation.
+func (m *EngineLogMutation) AddedLine() (r int, exists bool) {
+	v := m.addline
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetModelNum resets all changes to the "model_num" field.
-func (m *HyperParamsHistoryMutation) ResetModelNum() {
-	m.model_num = nil
-	m.addmodel_num = nil
+// ResetLine resets all changes to the "line" field.
+func (m *EngineLogMutation) ResetLine() {
+	m.line = nil
+	m.addline = nil
 }
 
-// SetParams sets the "params" field.
-func (m *HyperParamsHistoryMutation) SetParams(s string) {
-	m.params = &s
+// SetMessage sets the "message" field.
+func (m *EngineLogMutation) SetMessage(s string) {
+	m.message = &s
 }
 
-// Params returns the value of the "params" field in the mutation.
-func (m *HyperParamsHistoryMutation) Params() (r string, exists bool) {
-	v := m.params
-	if v == nil {
+// Message returns the value of the "message" field in the mutation.
+func (m *EngineLogMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParams returns the old "params" field's value of the HyperParamsHistory entity.
-// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldMessage returns the old "message" field's value of the EngineLog entity.
+// If the EngineLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HyperParamsHistoryMutation) OldParams(ctx context.Context) (v string, err error) {
+func (m *EngineLogMutation) OldMessage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParams is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParams requires an ID field in the mutation")
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParams: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return oldValue.Params, nil
+	return oldValue.Message, nil
 }
 
-// ResetParams resets all changes to the "params" field.
-func (m *HyperParamsHistoryMutation) ResetParams() {
-	m.params = nil
+// ResetMessage resets all changes to the "message" field.
+func (m *EngineLogMutation) ResetMessage() {
+	m.message = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *HyperParamsHistoryMutation) SetCreatedAt(t time.Time) {
+func (m *EngineLogMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *HyperParamsHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *EngineLogMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5201,10 +6071,10 @@ func (m *HyperParamsHistoryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the HyperParamsHistory entity.
-// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the EngineLog entity.
+// If the EngineLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HyperParamsHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EngineLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5219,19 +6089,19 @@ func (m *HyperParamsHistoryMutation) OldCreatedAt(ctx context.Context) (v time.T
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *HyperParamsHistoryMutation) ResetCreatedAt() {
+func (m *EngineLogMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the HyperParamsHistoryMutation builder.
-func (m *HyperParamsHistoryMutation) Where(ps ...predicate.HyperParamsHistory) {
+// Where appends a list predicates to the EngineLogMutation builder.
+func (m *EngineLogMutation) Where(ps ...predicate.EngineLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the HyperParamsHistoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the EngineLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HyperParamsHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.HyperParamsHistory, len(ps))
+func (m *EngineLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EngineLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5239,42 +6109,42 @@ func (m *HyperParamsHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *HyperParamsHistoryMutation) Op() Op {
+func (m *EngineLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *HyperParamsHistoryMutation) SetOp(op Op) {
+func (m *EngineLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (HyperParamsHistory).
-func (m *HyperParamsHistoryMutation) Type() string {
+// Type returns the node type of this mutation (EngineLog).
+func (m *EngineLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *HyperParamsHistoryMutation) Fields() []string {
+func (m *EngineLogMutation) Fields() []string {
 	fields := make([]string, 0, 6)
-	if m.trial_id != nil {
-		fields = append(fields, hyperparamshistory.FieldTrialID)
+	if m.modeling_id != nil {
+		fields = append(fields, enginelog.FieldModelingID)
 	}
-	if m.trial_uuid != nil {
-		fields = append(fields, hyperparamshistory.FieldTrialUUID)
+	if m.level != nil {
+		fields = append(fields, enginelog.FieldLevel)
 	}
-	if m.model != nil {
-		fields = append(fields, hyperparamshistory.FieldModel)
+	if m.filename != nil {
+		fields = append(fields, enginelog.FieldFilename)
 	}
-	if m.model_num != nil {
-		fields = append(fields, hyperparamshistory.FieldModelNum)
+	if m.line != nil {
+		fields = append(fields, enginelog.FieldLine)
 	}
-	if m.params != nil {
-		fields = append(fields, hyperparamshistory.FieldParams)
+	if m.message != nil {
+		fields = append(fields, enginelog.FieldMessage)
 	}
 	if m.created_at != nil {
-		fields = append(fields, hyperparamshistory.FieldCreatedAt)
+		fields = append(fields, enginelog.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5282,19 +6152,19 @@ func (m *HyperParamsHistoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HyperParamsHistoryMutation) Field(name string) (ent.Value, bool) {
+func (m *EngineLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case hyperparamshistory.FieldTrialID:
-		return m.TrialID()
-	case hyperparamshistory.FieldTrialUUID:
-		return m.TrialUUID()
-	case hyperparamshistory.FieldModel:
-		return m.Model()
-	case hyperparamshistory.FieldModelNum:
-		return m.ModelNum()
-	case hyperparamshistory.FieldParams:
-		return m.Params()
-	case hyperparamshistory.FieldCreatedAt:
+	case enginelog.FieldModelingID:
+		return m.ModelingID()
+	case enginelog.FieldLevel:
+		return m.Level()
+	case enginelog.FieldFilename:
+		return m.Filename()
+	case enginelog.FieldLine:
+		return m.Line()
+	case enginelog.FieldMessage:
+		return m.Message()
+	case enginelog.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -5303,65 +6173,65 @@ func (m *HyperParamsHistoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HyperParamsHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EngineLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case hyperparamshistory.FieldTrialID:
-		return m.OldTrialID(ctx)
-	case hyperparamshistory.FieldTrialUUID:
-		return m.OldTrialUUID(ctx)
-	case hyperparamshistory.FieldModel:
-		return m.OldModel(ctx)
-	case hyperparamshistory.FieldModelNum:
-		return m.OldModelNum(ctx)
-	case hyperparamshistory.FieldParams:
-		return m.OldParams(ctx)
-	case hyperparamshistory.FieldCreatedAt:
+	case enginelog.FieldModelingID:
+		return m.OldModelingID(ctx)
+	case enginelog.FieldLevel:
+		return m.OldLevel(ctx)
+	case enginelog.FieldFilename:
+		return m.OldFilename(ctx)
+	case enginelog.FieldLine:
+		return m.OldLine(ctx)
+	case enginelog.FieldMessage:
+		return m.OldMessage(ctx)
+	case enginelog.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown HyperParamsHistory field %s", name)
+	return nil, fmt.Errorf("unknown EngineLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HyperParamsHistoryMutation) SetField(name string, value ent.Value) error {
+func (m *EngineLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case hyperparamshistory.FieldTrialID:
+	case enginelog.FieldModelingID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTrialID(v)
+		m.SetModelingID(v)
 		return nil
-	case hyperparamshistory.FieldTrialUUID:
+	case enginelog.FieldLevel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTrialUUID(v)
+		m.SetLevel(v)
 		return nil
-	case hyperparamshistory.FieldModel:
+	case enginelog.FieldFilename:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModel(v)
+		m.SetFilename(v)
 		return nil
-	case hyperparamshistory.FieldModelNum:
+	case enginelog.FieldLine:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelNum(v)
+		m.SetLine(v)
 		return nil
-	case hyperparamshistory.FieldParams:
+	case enginelog.FieldMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParams(v)
+		m.SetMessage(v)
 		return nil
-	case hyperparamshistory.FieldCreatedAt:
+	case enginelog.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5369,18 +6239,18 @@ func (m *HyperParamsHistoryMutation) SetField(name string, value ent.Value) erro
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown HyperParamsHistory field %s", name)
+	return fmt.Errorf("unknown EngineLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HyperParamsHistoryMutation) AddedFields() []string {
+func (m *EngineLogMutation) AddedFields() []string {
 	var fields []string
-	if m.addtrial_id != nil {
-		fields = append(fields, hyperparamshistory.FieldTrialID)
+	if m.addmodeling_id != nil {
+		fields = append(fields, enginelog.FieldModelingID)
 	}
-	if m.addmodel_num != nil {
-		fields = append(fields, hyperparamshistory.FieldModelNum)
+	if m.addline != nil {
+		fields = append(fields, enginelog.FieldLine)
 	}
 	return fields
 }
@@ -5388,12 +6258,12 @@ func (m *HyperParamsHistoryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HyperParamsHistoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EngineLogMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case hyperparamshistory.FieldTrialID:
-		return m.AddedTrialID()
-	case hyperparamshistory.FieldModelNum:
-		return m.AddedModelNum()
+	case enginelog.FieldModelingID:
+		return m.AddedModelingID()
+	case enginelog.FieldLine:
+		return m.AddedLine()
 	}
 	return nil, false
 }
@@ -5401,156 +6271,158 @@ func (m *HyperParamsHistoryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HyperParamsHistoryMutation) AddField(name string, value ent.Value) error {
+func (m *EngineLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case hyperparamshistory.FieldTrialID:
+	case enginelog.FieldModelingID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTrialID(v)
+		m.AddModelingID(v)
 		return nil
-	case hyperparamshistory.FieldModelNum:
+	case enginelog.FieldLine:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddModelNum(v)
+		m.AddLine(v)
 		return nil
 	}
-	return fmt.Errorf("unknown HyperParamsHistory numeric field %s", name)
+	return fmt.Errorf("unknown EngineLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HyperParamsHistoryMutation) ClearedFields() []string {
+func (m *EngineLogMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HyperParamsHistoryMutation) FieldCleared(name string) bool {
+func (m *EngineLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HyperParamsHistoryMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown HyperParamsHistory nullable field %s", name)
+func (m *EngineLogMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EngineLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HyperParamsHistoryMutation) ResetField(name string) error {
+func (m *EngineLogMutation) ResetField(name string) error {
 	switch name {
-	case hyperparamshistory.FieldTrialID:
-		m.ResetTrialID()
+	case enginelog.FieldModelingID:
+		m.ResetModelingID()
 		return nil
-	case hyperparamshistory.FieldTrialUUID:
-		m.ResetTrialUUID()
+	case enginelog.FieldLevel:
+		m.ResetLevel()
 		return nil
-	case hyperparamshistory.FieldModel:
-		m.ResetModel()
+	case enginelog.FieldFilename:
+		m.ResetFilename()
 		return nil
-	case hyperparamshistory.FieldModelNum:
-		m.ResetModelNum()
+	case enginelog.FieldLine:
+		m.ResetLine()
 		return nil
-	case hyperparamshistory.FieldParams:
-		m.ResetParams()
+	case enginelog.FieldMessage:
+		m.ResetMessage()
 		return nil
-	case hyperparamshistory.FieldCreatedAt:
+	case enginelog.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown HyperParamsHistory field %s", name)
+	return fmt.Errorf("unknown EngineLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HyperParamsHistoryMutation) AddedEdges() []string {
+func (m *EngineLogMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HyperParamsHistoryMutation) AddedIDs(name string) []ent.Value {
+func (m *EngineLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HyperParamsHistoryMutation) RemovedEdges() []string {
+func (m *EngineLogMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HyperParamsHistoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *EngineLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HyperParamsHistoryMutation) ClearedEdges() []string {
+func (m *EngineLogMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HyperParamsHistoryMutation) EdgeCleared(name string) bool {
+func (m *EngineLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HyperParamsHistoryMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown HyperParamsHistory unique edge %s", name)
+func (m *EngineLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EngineLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HyperParamsHistoryMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown HyperParamsHistory edge %s", name)
+func (m *EngineLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EngineLog edge %s", name)
 }
 
-// MenuMutation represents an operation that mutates the Menu nodes in the graph.
-type MenuMutation struct {
+// GpuMutation represents an operation that mutates the Gpu nodes in the graph.
+type GpuMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *string
-	label           *string
-	icon            *string
-	url             *string
-	is_use          *bool
-	is_title        *bool
-	menu_order      *int
-	addmenu_order   *int
-	group           *int
-	addgroup        *int
-	clearedFields   map[string]struct{}
-	parent          *string
-	clearedparent   bool
-	children        map[string]struct{}
-	removedchildren map[string]struct{}
-	clearedchildren bool
-	done            bool
-	oldValue        func(context.Context) (*Menu, error)
-	predicates      []predicate.Menu
+	op                    Op
+	typ                   string
+	id                    *int
+	uuid                  *string
+	index                 *int
+	addindex              *int
+	name                  *string
+	state                 *string
+	is_use                *bool
+	memory_total          *float64
+	addmemory_total       *float64
+	memory_used           *float64
+	addmemory_used        *float64
+	utilization_gpu       *float64
+	addutilization_gpu    *float64
+	utilization_memory    *float64
+	addutilization_memory *float64
+	clearedFields         map[string]struct{}
+	device                *int
+	cleareddevice         bool
+	done                  bool
+	oldValue              func(context.Context) (*Gpu, error)
+	predicates            []predicate.Gpu
 }
 
-var _ ent.Mutation = (*MenuMutation)(nil)
+var _ ent.Mutation = (*GpuMutation)(nil)
 
-// menuOption allows management of the mutation configuration using functional options.
-type menuOption func(*MenuMutation)
+// gpuOption allows management of the mutation configuration using functional options.
+type gpuOption func(*GpuMutation)
 
-// newMenuMutation creates new mutation for the Menu entity.
-func newMenuMutation(c config, op Op, opts ...menuOption) *MenuMutation {
-	m := &MenuMutation{
+// newGpuMutation creates new mutation for the Gpu entity.
+func newGpuMutation(c config, op Op, opts ...gpuOption) *GpuMutation {
+	m := &GpuMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMenu,
+		typ:           TypeGpu,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5559,20 +6431,20 @@ func newMenuMutation(c config, op Op, opts ...menuOption) *MenuMutation {
 	return m
 }
 
-// withMenuID sets the ID field of the mutation.
-func withMenuID(id string) menuOption {
-	return func(m *MenuMutation) {
+// withGpuID sets the ID field of the mutation.
+func withGpuID(id int) gpuOption {
+	return func(m *GpuMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Menu
+			value *Gpu
 		)
-		m.oldValue = func(ctx context.Context) (*Menu, error) {
+		m.oldValue = func(ctx context.Context) (*Gpu, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Menu.Get(ctx, id)
+					value, err = m.Client().Gpu.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5581,10 +6453,10 @@ func withMenuID(id string) menuOption {
 	}
 }
 
-// withMenu sets the old Menu of the mutation.
-func withMenu(node *Menu) menuOption {
-	return func(m *MenuMutation) {
-		m.oldValue = func(context.Context) (*Menu, error) {
+// withGpu sets the old Gpu of the mutation.
+func withGpu(node *Gpu) gpuOption {
+	return func(m *GpuMutation) {
+		m.oldValue = func(context.Context) (*Gpu, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5593,7 +6465,7 @@ func withMenu(node *Menu) menuOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MenuMutation) Client() *Client {
+func (m GpuMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5601,7 +6473,7 @@ func (m MenuMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MenuMutation) Tx() (*Tx, error) {
+func (m GpuMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5610,15 +6482,9 @@ func (m MenuMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Menu entities.
-func (m *MenuMutation) SetID(id string) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MenuMutation) ID() (id string, exists bool) {
+func (m *GpuMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5629,149 +6495,192 @@ func (m *MenuMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MenuMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *GpuMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Menu.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Gpu.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetLabel sets the "label" field.
-func (m *MenuMutation) SetLabel(s string) {
-	m.label = &s
+// SetUUID sets the "uuid" field.
+func (m *GpuMutation) SetUUID(s string) {
+	m.uuid = &s
 }
 
-// Label returns the value of the "label" field in the mutation.
-func (m *MenuMutation) Label() (r string, exists bool) {
-	v := m.label
+// UUID returns the value of the "uuid" field in the mutation.
+func (m *GpuMutation) UUID() (r string, exists bool) {
+	v := m.uuid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLabel returns the old "label" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldUUID returns the old "uuid" field's value of the Gpu entity.
+// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldLabel(ctx context.Context) (v string, err error) {
+func (m *GpuMutation) OldUUID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+		return v, errors.New("OldUUID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLabel requires an ID field in the mutation")
+		return v, errors.New("OldUUID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+		return v, fmt.Errorf("querying old value for OldUUID: %w", err)
 	}
-	return oldValue.Label, nil
+	return oldValue.UUID, nil
 }
 
-// ResetLabel resets all changes to the "label" field.
-func (m *MenuMutation) ResetLabel() {
-	m.label = nil
+// ResetUUID resets all changes to the "uuid" field.
+func (m *GpuMutation) ResetUUID() {
+	m.uuid = nil
 }
 
-// SetIcon sets the "icon" field.
-func (m *MenuMutation) SetIcon(s string) {
-	m.icon = &s
+// SetIndex sets the "index" field.
+func (m *GpuMutation) SetIndex(i int) {
+	m.index = &i
+	m.addindex = nil
 }
 
-// Icon returns the value of the "icon" field in the mutation.
-func (m *MenuMutation) Icon() (r string, exists bool) {
-	v := m.icon
+// Index returns the value of the "index" field in the mutation.
+func (m *GpuMutation) Index() (r int, exists bool) {
+	v := m.index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIcon returns the old "icon" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldIndex returns the old "index" field's value of the Gpu entity.
+// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldIcon(ctx context.Context) (v string, err error) {
+func (m *GpuMutation) OldIndex(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+		return v, errors.New("OldIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIcon requires an ID field in the mutation")
+		return v, errors.New("OldIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+		return v, fmt.Errorf("querying old value for OldIndex: %w", err)
 	}
-	return oldValue.Icon, nil
+	return oldValue.Index, nil
 }
 
-// ResetIcon resets all changes to the "icon" field.
-func (m *MenuMutation) ResetIcon() {
-	m.icon = nil
+// AddIndex adds i to the "index" field.
+func (m *GpuMutation) AddIndex(i int) {
+	if m.addindex != nil {
+		*m.addindex += i
+	} else {
+		m.addindex = &i
+	}
 }
 
-// SetURL sets the "url" field.
-func (m *MenuMutation) SetURL(s string) {
-	m.url = &s
+// AddedIndex returns the value that was added to the "index" field in this mutation.
+func (m *GpuMutation) AddedIndex() (r int, exists bool) {
+	v := m.addindex
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *MenuMutation) URL() (r string, exists bool) {
-	v := m.url
+// ResetIndex resets all changes to the "index" field.
+func (m *GpuMutation) ResetIndex() {
+	m.index = nil
+	m.addindex = nil
+}
+
+// SetName sets the "name" field.
+func (m *GpuMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GpuMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Gpu entity.
+// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *GpuMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.Name, nil
 }
 
-// ClearURL clears the value of the "url" field.
-func (m *MenuMutation) ClearURL() {
-	m.url = nil
-	m.clearedFields[menu.FieldURL] = struct{}{}
+// ResetName resets all changes to the "name" field.
+func (m *GpuMutation) ResetName() {
+	m.name = nil
 }
 
-// URLCleared returns if the "url" field was cleared in this mutation.
-func (m *MenuMutation) URLCleared() bool {
-	_, ok := m.clearedFields[menu.FieldURL]
-	return ok
+// SetState sets the "state" field.
+func (m *GpuMutation) SetState(s string) {
+	m.state = &s
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *MenuMutation) ResetURL() {
-	m.url = nil
-	delete(m.clearedFields, menu.FieldURL)
+// State returns the value of the "state" field in the mutation.
+func (m *GpuMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the Gpu entity.
+// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GpuMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *GpuMutation) ResetState() {
+	m.state = nil
 }
 
 // SetIsUse sets the "is_use" field.
-func (m *MenuMutation) SetIsUse(b bool) {
+func (m *GpuMutation) SetIsUse(b bool) {
 	m.is_use = &b
 }
 
 // IsUse returns the value of the "is_use" field in the mutation.
-func (m *MenuMutation) IsUse() (r bool, exists bool) {
+func (m *GpuMutation) IsUse() (r bool, exists bool) {
 	v := m.is_use
 	if v == nil {
 		return
@@ -5779,10 +6688,10 @@ func (m *MenuMutation) IsUse() (r bool, exists bool) {
 	return *v, true
 }
 
-// OldIsUse returns the old "is_use" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldIsUse returns the old "is_use" field's value of the Gpu entity.
+// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldIsUse(ctx context.Context) (v bool, err error) {
+func (m *GpuMutation) OldIsUse(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldIsUse is only allowed on UpdateOne operations")
 	}
@@ -5796,337 +6705,320 @@ func (m *MenuMutation) OldIsUse(ctx context.Context) (v bool, err error) {
 	return oldValue.IsUse, nil
 }
 
-// ClearIsUse clears the value of the "is_use" field.
-func (m *MenuMutation) ClearIsUse() {
-	m.is_use = nil
-	m.clearedFields[menu.FieldIsUse] = struct{}{}
-}
-
-// IsUseCleared returns if the "is_use" field was cleared in this mutation.
-func (m *MenuMutation) IsUseCleared() bool {
-	_, ok := m.clearedFields[menu.FieldIsUse]
-	return ok
-}
-
 // ResetIsUse resets all changes to the "is_use" field.
-func (m *MenuMutation) ResetIsUse() {
+func (m *GpuMutation) ResetIsUse() {
 	m.is_use = nil
-	delete(m.clearedFields, menu.FieldIsUse)
 }
 
-// SetIsTitle sets the "is_title" field.
-func (m *MenuMutation) SetIsTitle(b bool) {
-	m.is_title = &b
+// SetDeviceID sets the "device_id" field.
+func (m *GpuMutation) SetDeviceID(i int) {
+	m.device = &i
 }
 
-// IsTitle returns the value of the "is_title" field in the mutation.
-func (m *MenuMutation) IsTitle() (r bool, exists bool) {
-	v := m.is_title
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *GpuMutation) DeviceID() (r int, exists bool) {
+	v := m.device
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsTitle returns the old "is_title" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldDeviceID returns the old "device_id" field's value of the Gpu entity.
+// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldIsTitle(ctx context.Context) (v bool, err error) {
+func (m *GpuMutation) OldDeviceID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsTitle requires an ID field in the mutation")
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
 	}
-	return oldValue.IsTitle, nil
+	return oldValue.DeviceID, nil
 }
 
-// ClearIsTitle clears the value of the "is_title" field.
-func (m *MenuMutation) ClearIsTitle() {
-	m.is_title = nil
-	m.clearedFields[menu.FieldIsTitle] = struct{}{}
+// ClearDeviceID clears the value of the "device_id" field.
+func (m *GpuMutation) ClearDeviceID() {
+	m.device = nil
+	m.clearedFields[gpu.FieldDeviceID] = struct{}{}
 }
 
-// IsTitleCleared returns if the "is_title" field was cleared in this mutation.
-func (m *MenuMutation) IsTitleCleared() bool {
-	_, ok := m.clearedFields[menu.FieldIsTitle]
+// DeviceIDCleared returns if the "device_id" field was cleared in this mutation.
+func (m *GpuMutation) DeviceIDCleared() bool {
+	_, ok := m.clearedFields[gpu.FieldDeviceID]
 	return ok
 }
 
-// ResetIsTitle resets all changes to the "is_title" field.
-func (m *MenuMutation) ResetIsTitle() {
-	m.is_title = nil
-	delete(m.clearedFields, menu.FieldIsTitle)
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *GpuMutation) ResetDeviceID() {
+	m.device = nil
+	delete(m.clearedFields, gpu.FieldDeviceID)
 }
 
-// SetMenuOrder sets the "menu_order" field.
-func (m *MenuMutation) SetMenuOrder(i int) {
-	m.menu_order = &i
-	m.addmenu_order = nil
+// SetMemoryTotal sets the "memory_total" field.
+func (m *GpuMutation) SetMemoryTotal(f float64) {
+	m.memory_total = &f
+	m.addmemory_total = nil
 }
 
-// MenuOrder returns the value of the "menu_order" field in the mutation.
-func (m *MenuMutation) MenuOrder() (r int, exists bool) {
-	v := m.menu_order
+// MemoryTotal returns the value of the "memory_total" field in the mutation.
+func (m *GpuMutation) MemoryTotal() (r float64, exists bool) {
+	v := m.memory_total
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMenuOrder returns the old "menu_order" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldMemoryTotal returns the old "memory_total" field's value of the Gpu entity.
+// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldMenuOrder(ctx context.Context) (v int, err error) {
+func (m *GpuMutation) OldMemoryTotal(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMenuOrder is only allowed on UpdateOne operations")
+		return v, errors.New("OldMemoryTotal is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMenuOrder requires an ID field in the mutation")
+		return v, errors.New("OldMemoryTotal requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMenuOrder: %w", err)
+		return v, fmt.Errorf("querying old value for OldMemoryTotal: %w", err)
 	}
-	return oldValue.MenuOrder, nil
+	return oldValue.MemoryTotal, nil
 }
 
-// AddMenuOrder adds i to the "menu_order" field.
-func (m *MenuMutation) AddMenuOrder(i int) {
-	if m.addmenu_order != nil {
-		*m.addmenu_order += i
+// AddMemoryTotal adds f to the "memory_total" field.
+func (m *GpuMutation) AddMemoryTotal(f float64) {
+	if m.addmemory_total != nil {
+		*m.addmemory_total += f
 	} else {
-		m.addmenu_order = &i
+		m.addmemory_total = &f
 	}
 }
 
-// AddedMenuOrder returns the value that was added to the "menu_order" field in this mutation.
-func (m *MenuMutation) AddedMenuOrder() (r int, exists bool) {
-	v := m.addmenu_order
+// AddedMemoryTotal returns the value that was added to the "memory_total" field in this mutation.
+func (m *GpuMutation) AddedMemoryTotal() (r float64, exists bool) {
+	v := m.addmemory_total
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMenuOrder resets all changes to the "menu_order" field.
-func (m *MenuMutation) ResetMenuOrder() {
-	m.menu_order = nil
-	m.addmenu_order = nil
+// ResetMemoryTotal resets all changes to the "memory_total" field.
+func (m *GpuMutation) ResetMemoryTotal() {
+	m.memory_total = nil
+	m.addmemory_total = nil
 }
 
-// SetParentKey sets the "parent_key" field.
-func (m *MenuMutation) SetParentKey(s string) {
-	m.parent = &s
+// SetMemoryUsed sets the "memory_used" field.
+func (m *GpuMutation) SetMemoryUsed(f float64) {
+	m.memory_used = &f
+	m.addmemory_used = nil
 }
 
-// ParentKey returns the value of the "parent_key" field in the mutation.
-func (m *MenuMutation) ParentKey() (r string, exists bool) {
-	v := m.parent
+// MemoryUsed returns the value of the "memory_used" field in the mutation.
+func (m *GpuMutation) MemoryUsed() (r float64, exists bool) {
+	v := m.memory_used
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentKey returns the old "parent_key" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldMemoryUsed returns the old "memory_used" field's value of the Gpu entity.
+// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldParentKey(ctx context.Context) (v string, err error) {
+func (m *GpuMutation) OldMemoryUsed(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldMemoryUsed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentKey requires an ID field in the mutation")
+		return v, errors.New("OldMemoryUsed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldMemoryUsed: %w", err)
 	}
-	return oldValue.ParentKey, nil
+	return oldValue.MemoryUsed, nil
 }
 
-// ClearParentKey clears the value of the "parent_key" field.
-func (m *MenuMutation) ClearParentKey() {
-	m.parent = nil
-	m.clearedFields[menu.FieldParentKey] = struct{}{}
+// AddMemoryUsed adds f to the "memory_used" field.
+func (m *GpuMutation) AddMemoryUsed(f float64) {
+	if m.addmemory_used != nil {
+		*m.addmemory_used += f
+	} else {
+		m.addmemory_used = &f
+	}
 }
 
-// ParentKeyCleared returns if the "parent_key" field was cleared in this mutation.
-func (m *MenuMutation) ParentKeyCleared() bool {
-	_, ok := m.clearedFields[menu.FieldParentKey]
-	return ok
+// AddedMemoryUsed returns the value that was added to the "memory_used" field in this mutation.
+func (m *GpuMutation) AddedMemoryUsed() (r float64, exists bool) {
+	v := m.addmemory_used
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetParentKey resets all changes to the "parent_key" field.
-func (m *MenuMutation) ResetParentKey() {
-	m.parent = nil
-	delete(m.clearedFields, menu.FieldParentKey)
+// ResetMemoryUsed resets all changes to the "memory_used" field.
+func (m *GpuMutation) ResetMemoryUsed() {
+	m.memory_used = nil
+	m.addmemory_used = nil
 }
 
-// SetGroup sets the "group" field.
-func (m *MenuMutation) SetGroup(i int) {
-	m.group = &i
-	m.addgroup = nil
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (m *GpuMutation) SetUtilizationGpu(f float64) {
+	m.utilization_gpu = &f
+	m.addutilization_gpu = nil
 }
 
-// Group returns the value of the "group" field in the mutation.
-func (m *MenuMutation) Group() (r int, exists bool) {
-	v := m.group
+// UtilizationGpu returns the value of the "utilization_gpu" field in the mutation.
+func (m *GpuMutation) UtilizationGpu() (r float64, exists bool) {
+	v := m.utilization_gpu
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGroup returns the old "group" field's value of the Menu entity.
-// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// OldUtilizationGpu returns the old "utilization_gpu" field's value of the Gpu entity.
+// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MenuMutation) OldGroup(ctx context.Context) (v int, err error) {
+func (m *GpuMutation) OldUtilizationGpu(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGroup is only allowed on UpdateOne operations")
+		return v, errors.New("OldUtilizationGpu is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGroup requires an ID field in the mutation")
+		return v, errors.New("OldUtilizationGpu requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGroup: %w", err)
+		return v, fmt.Errorf("querying old value for OldUtilizationGpu: %w", err)
 	}
-	return oldValue.Group, nil
+	return oldValue.UtilizationGpu, nil
 }
 
-// AddGroup adds i to the "group" field.
-func (m *MenuMutation) AddGroup(i int) {
-	if m.addgroup != nil {
-		*m.addgroup += i
+// AddUtilizationGpu adds f to the "utilization_gpu" field.
+func (m *GpuMutation) AddUtilizationGpu(f float64) {
+	if m.addutilization_gpu != nil {
+		*m.addutilization_gpu += f
 	} else {
-		m.addgroup = &i
+		m.addutilization_gpu = &f
 	}
 }
 
-// AddedGroup returns the value that was added to the "group" field in this mutation.
-func (m *MenuMutation) AddedGroup() (r int, exists bool) {
-	v := m.addgroup
+// AddedUtilizationGpu returns the value that was added to the "utilization_gpu" field in this mutation.
+func (m *GpuMutation) AddedUtilizationGpu() (r float64, exists bool) {
+	v := m.addutilization_gpu
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetGroup resets all changes to the "group" field.
-func (m *MenuMutation) ResetGroup() {
-	m.group = nil
-	m.addgroup = nil
-}
-
-// SetParentID sets the "parent" edge to the Menu entity by id.
-func (m *MenuMutation) SetParentID(id string) {
-	m.parent = &id
-}
-
-// ClearParent clears the "parent" edge to the Menu entity.
-func (m *MenuMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[menu.FieldParentKey] = struct{}{}
+// ResetUtilizationGpu resets all changes to the "utilization_gpu" field.
+func (m *GpuMutation) ResetUtilizationGpu() {
+	m.utilization_gpu = nil
+	m.addutilization_gpu = nil
 }
 
-// ParentCleared reports if the "parent" edge to the Menu entity was cleared.
-func (m *MenuMutation) ParentCleared() bool {
-	return m.ParentKeyCleared() || m.clearedparent
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (m *GpuMutation) SetUtilizationMemory(f float64) {
+	m.utilization_memory = &f
+	m.addutilization_memory = nil
 }
 
-// ParentID returns the "parent" edge ID in the mutation.
-func (m *MenuMutation) ParentID() (id string, exists bool) {
-	if m.parent != nil {
-		return *m.parent, true
+// UtilizationMemory returns the value of the "utilization_memory" field in the mutation.
+func (m *GpuMutation) UtilizationMemory() (r float64, exists bool) {
+	v := m.utilization_memory
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *MenuMutation) ParentIDs() (ids []string) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
+// OldUtilizationMemory returns the old "utilization_memory" field's value of the Gpu entity.
+// If the Gpu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GpuMutation) OldUtilizationMemory(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUtilizationMemory is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetParent resets all changes to the "parent" edge.
-func (m *MenuMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUtilizationMemory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUtilizationMemory: %w", err)
+	}
+	return oldValue.UtilizationMemory, nil
 }
 
-// AddChildIDs adds the "children" edge to the Menu entity by ids.
-func (m *MenuMutation) AddChildIDs(ids ...string) {
-	if m.children == nil {
-		m.children = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+// AddUtilizationMemory adds f to the "utilization_memory" field.
+func (m *GpuMutation) AddUtilizationMemory(f float64) {
+	if m.addutilization_memory != nil {
+		*m.addutilization_memory += f
+	} else {
+		m.addutilization_memory = &f
 	}
 }
 
-// ClearChildren clears the "children" edge to the Menu entity.
-func (m *MenuMutation) ClearChildren() {
-	m.clearedchildren = true
+// AddedUtilizationMemory returns the value that was added to the "utilization_memory" field in this mutation.
+func (m *GpuMutation) AddedUtilizationMemory() (r float64, exists bool) {
+	v := m.addutilization_memory
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ChildrenCleared reports if the "children" edge to the Menu entity was cleared.
-func (m *MenuMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// ResetUtilizationMemory resets all changes to the "utilization_memory" field.
+func (m *GpuMutation) ResetUtilizationMemory() {
+	m.utilization_memory = nil
+	m.addutilization_memory = nil
 }
 
-// RemoveChildIDs removes the "children" edge to the Menu entity by IDs.
-func (m *MenuMutation) RemoveChildIDs(ids ...string) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
-	}
+// ClearDevice clears the "device" edge to the Device entity.
+func (m *GpuMutation) ClearDevice() {
+	m.cleareddevice = true
+	m.clearedFields[gpu.FieldDeviceID] = struct{}{}
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Menu entity.
-func (m *MenuMutation) RemovedChildrenIDs() (ids []string) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
-	}
-	return
+// DeviceCleared reports if the "device" edge to the Device entity was cleared.
+func (m *GpuMutation) DeviceCleared() bool {
+	return m.DeviceIDCleared() || m.cleareddevice
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *MenuMutation) ChildrenIDs() (ids []string) {
-	for id := range m.children {
-		ids = append(ids, id)
+// DeviceIDs returns the "device" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeviceID instead. It exists only for internal usage by the builders.
+func (m *GpuMutation) DeviceIDs() (ids []int) {
+	if id := m.device; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *MenuMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// ResetDevice resets all changes to the "device" edge.
+func (m *GpuMutation) ResetDevice() {
+	m.device = nil
+	m.cleareddevice = false
 }
 
-// Where appends a list predicates to the MenuMutation builder.
-func (m *MenuMutation) Where(ps ...predicate.Menu) {
+// Where appends a list predicates to the GpuMutation builder.
+func (m *GpuMutation) Where(ps ...predicate.Gpu) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MenuMutation builder. Using this method,
+// WhereP appends storage-level predicates to the GpuMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MenuMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Menu, len(ps))
+func (m *GpuMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Gpu, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6134,48 +7026,54 @@ func (m *MenuMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MenuMutation) Op() Op {
+func (m *GpuMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MenuMutation) SetOp(op Op) {
+func (m *GpuMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Menu).
-func (m *MenuMutation) Type() string {
+// Type returns the node type of this mutation (Gpu).
+func (m *GpuMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MenuMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.label != nil {
-		fields = append(fields, menu.FieldLabel)
+func (m *GpuMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.uuid != nil {
+		fields = append(fields, gpu.FieldUUID)
 	}
-	if m.icon != nil {
-		fields = append(fields, menu.FieldIcon)
+	if m.index != nil {
+		fields = append(fields, gpu.FieldIndex)
 	}
-	if m.url != nil {
-		fields = append(fields, menu.FieldURL)
+	if m.name != nil {
+		fields = append(fields, gpu.FieldName)
+	}
+	if m.state != nil {
+		fields = append(fields, gpu.FieldState)
 	}
 	if m.is_use != nil {
-		fields = append(fields, menu.FieldIsUse)
+		fields = append(fields, gpu.FieldIsUse)
 	}
-	if m.is_title != nil {
-		fields = append(fields, menu.FieldIsTitle)
+	if m.device != nil {
+		fields = append(fields, gpu.FieldDeviceID)
 	}
-	if m.menu_order != nil {
-		fields = append(fields, menu.FieldMenuOrder)
+	if m.memory_total != nil {
+		fields = append(fields, gpu.FieldMemoryTotal)
 	}
-	if m.parent != nil {
-		fields = append(fields, menu.FieldParentKey)
+	if m.memory_used != nil {
+		fields = append(fields, gpu.FieldMemoryUsed)
 	}
-	if m.group != nil {
-		fields = append(fields, menu.FieldGroup)
+	if m.utilization_gpu != nil {
+		fields = append(fields, gpu.FieldUtilizationGpu)
+	}
+	if m.utilization_memory != nil {
+		fields = append(fields, gpu.FieldUtilizationMemory)
 	}
 	return fields
 }
@@ -6183,24 +7081,28 @@ func (m *MenuMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MenuMutation) Field(name string) (ent.Value, bool) {
+func (m *GpuMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case menu.FieldLabel:
-		return m.Label()
-	case menu.FieldIcon:
-		return m.Icon()
-	case menu.FieldURL:
-		return m.URL()
-	case menu.FieldIsUse:
+	case gpu.FieldUUID:
+		return m.UUID()
+	case gpu.FieldIndex:
+		return m.Index()
+	case gpu.FieldName:
+		return m.Name()
+	case gpu.FieldState:
+		return m.State()
+	case gpu.FieldIsUse:
 		return m.IsUse()
-	case menu.FieldIsTitle:
-		return m.IsTitle()
-	case menu.FieldMenuOrder:
-		return m.MenuOrder()
-	case menu.FieldParentKey:
-		return m.ParentKey()
-	case menu.FieldGroup:
-		return m.Group()
+	case gpu.FieldDeviceID:
+		return m.DeviceID()
+	case gpu.FieldMemoryTotal:
+		return m.MemoryTotal()
+	case gpu.FieldMemoryUsed:
+		return m.MemoryUsed()
+	case gpu.FieldUtilizationGpu:
+		return m.UtilizationGpu()
+	case gpu.FieldUtilizationMemory:
+		return m.UtilizationMemory()
 	}
 	return nil, false
 }
@@ -6208,102 +7110,129 @@ func (m *MenuMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MenuMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GpuMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case menu.FieldLabel:
-		return m.OldLabel(ctx)
-	case menu.FieldIcon:
-		return m.OldIcon(ctx)
-	case menu.FieldURL:
-		return m.OldURL(ctx)
-	case menu.FieldIsUse:
+	case gpu.FieldUUID:
+		return m.OldUUID(ctx)
+	case gpu.FieldIndex:
+		return m.OldIndex(ctx)
+	case gpu.FieldName:
+		return m.OldName(ctx)
+	case gpu.FieldState:
+		return m.OldState(ctx)
+	case gpu.FieldIsUse:
 		return m.OldIsUse(ctx)
-	case menu.FieldIsTitle:
-		return m.OldIsTitle(ctx)
-	case menu.FieldMenuOrder:
-		return m.OldMenuOrder(ctx)
-	case menu.FieldParentKey:
-		return m.OldParentKey(ctx)
-	case menu.FieldGroup:
-		return m.OldGroup(ctx)
+	case gpu.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case gpu.FieldMemoryTotal:
+		return m.OldMemoryTotal(ctx)
+	case gpu.FieldMemoryUsed:
+		return m.OldMemoryUsed(ctx)
+	case gpu.FieldUtilizationGpu:
+		return m.OldUtilizationGpu(ctx)
+	case gpu.FieldUtilizationMemory:
+		return m.OldUtilizationMemory(ctx)
 	}
-	return nil, fmt.Errorf("unknown Menu field %s", name)
+	return nil, fmt.Errorf("unknown Gpu field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MenuMutation) SetField(name string, value ent.Value) error {
+func (m *GpuMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case menu.FieldLabel:
+	case gpu.FieldUUID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLabel(v)
+		m.SetUUID(v)
 		return nil
-	case menu.FieldIcon:
+	case gpu.FieldIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndex(v)
+		return nil
+	case gpu.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIcon(v)
+		m.SetName(v)
 		return nil
-	case menu.FieldURL:
+	case gpu.FieldState:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetURL(v)
+		m.SetState(v)
 		return nil
-	case menu.FieldIsUse:
+	case gpu.FieldIsUse:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetIsUse(v)
 		return nil
-	case menu.FieldIsTitle:
-		v, ok := value.(bool)
+	case gpu.FieldDeviceID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsTitle(v)
+		m.SetDeviceID(v)
 		return nil
-	case menu.FieldMenuOrder:
-		v, ok := value.(int)
+	case gpu.FieldMemoryTotal:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMenuOrder(v)
+		m.SetMemoryTotal(v)
 		return nil
-	case menu.FieldParentKey:
-		v, ok := value.(string)
+	case gpu.FieldMemoryUsed:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentKey(v)
+		m.SetMemoryUsed(v)
 		return nil
-	case menu.FieldGroup:
-		v, ok := value.(int)
+	case gpu.FieldUtilizationGpu:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGroup(v)
+		m.SetUtilizationGpu(v)
+		return nil
+	case gpu.FieldUtilizationMemory:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUtilizationMemory(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Menu field %s", name)
+	return fmt.Errorf("unknown Gpu field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MenuMutation) AddedFields() []string {
+func (m *GpuMutation) AddedFields() []string {
 	var fields []string
-	if m.addmenu_order != nil {
-		fields = append(fields, menu.FieldMenuOrder)
+	if m.addindex != nil {
+		fields = append(fields, gpu.FieldIndex)
 	}
-	if m.addgroup != nil {
-		fields = append(fields, menu.FieldGroup)
+	if m.addmemory_total != nil {
+		fields = append(fields, gpu.FieldMemoryTotal)
+	}
+	if m.addmemory_used != nil {
+		fields = append(fields, gpu.FieldMemoryUsed)
+	}
+	if m.addutilization_gpu != nil {
+		fields = append(fields, gpu.FieldUtilizationGpu)
+	}
+	if m.addutilization_memory != nil {
+		fields = append(fields, gpu.FieldUtilizationMemory)
 	}
 	return fields
 }
@@ -6311,12 +7240,18 @@ func (m *MenuMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MenuMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GpuMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case menu.FieldMenuOrder:
-		return m.AddedMenuOrder()
-	case menu.FieldGroup:
-		return m.AddedGroup()
+	case gpu.FieldIndex:
+		return m.AddedIndex()
+	case gpu.FieldMemoryTotal:
+		return m.AddedMemoryTotal()
+	case gpu.FieldMemoryUsed:
+		return m.AddedMemoryUsed()
+	case gpu.FieldUtilizationGpu:
+		return m.AddedUtilizationGpu()
+	case gpu.FieldUtilizationMemory:
+		return m.AddedUtilizationMemory()
 	}
 	return nil, false
 }
@@ -6324,261 +7259,232 @@ func (m *MenuMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MenuMutation) AddField(name string, value ent.Value) error {
+func (m *GpuMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case menu.FieldMenuOrder:
+	case gpu.FieldIndex:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMenuOrder(v)
+		m.AddIndex(v)
 		return nil
-	case menu.FieldGroup:
-		v, ok := value.(int)
+	case gpu.FieldMemoryTotal:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddGroup(v)
+		m.AddMemoryTotal(v)
+		return nil
+	case gpu.FieldMemoryUsed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMemoryUsed(v)
+		return nil
+	case gpu.FieldUtilizationGpu:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUtilizationGpu(v)
+		return nil
+	case gpu.FieldUtilizationMemory:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUtilizationMemory(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Menu numeric field %s", name)
+	return fmt.Errorf("unknown Gpu numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MenuMutation) ClearedFields() []string {
+func (m *GpuMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(menu.FieldURL) {
-		fields = append(fields, menu.FieldURL)
-	}
-	if m.FieldCleared(menu.FieldIsUse) {
-		fields = append(fields, menu.FieldIsUse)
-	}
-	if m.FieldCleared(menu.FieldIsTitle) {
-		fields = append(fields, menu.FieldIsTitle)
-	}
-	if m.FieldCleared(menu.FieldParentKey) {
-		fields = append(fields, menu.FieldParentKey)
+	if m.FieldCleared(gpu.FieldDeviceID) {
+		fields = append(fields, gpu.FieldDeviceID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MenuMutation) FieldCleared(name string) bool {
+func (m *GpuMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MenuMutation) ClearField(name string) error {
+func (m *GpuMutation) ClearField(name string) error {
 	switch name {
-	case menu.FieldURL:
-		m.ClearURL()
-		return nil
-	case menu.FieldIsUse:
-		m.ClearIsUse()
-		return nil
-	case menu.FieldIsTitle:
-		m.ClearIsTitle()
-		return nil
-	case menu.FieldParentKey:
-		m.ClearParentKey()
+	case gpu.FieldDeviceID:
+		m.ClearDeviceID()
 		return nil
 	}
-	return fmt.Errorf("unknown Menu nullable field %s", name)
+	return fmt.Errorf("unknown Gpu nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MenuMutation) ResetField(name string) error {
+func (m *GpuMutation) ResetField(name string) error {
 	switch name {
-	case menu.FieldLabel:
-		m.ResetLabel()
+	case gpu.FieldUUID:
+		m.ResetUUID()
 		return nil
-	case menu.FieldIcon:
-		m.ResetIcon()
+	case gpu.FieldIndex:
+		m.ResetIndex()
 		return nil
-	case menu.FieldURL:
-		m.ResetURL()
+	case gpu.FieldName:
+		m.ResetName()
 		return nil
-	case menu.FieldIsUse:
+	case gpu.FieldState:
+		m.ResetState()
+		return nil
+	case gpu.FieldIsUse:
 		m.ResetIsUse()
 		return nil
-	case menu.FieldIsTitle:
-		m.ResetIsTitle()
+	case gpu.FieldDeviceID:
+		m.ResetDeviceID()
 		return nil
-	case menu.FieldMenuOrder:
-		m.ResetMenuOrder()
+	case gpu.FieldMemoryTotal:
+		m.ResetMemoryTotal()
 		return nil
-	case menu.FieldParentKey:
-		m.ResetParentKey()
+	case gpu.FieldMemoryUsed:
+		m.ResetMemoryUsed()
 		return nil
-	case menu.FieldGroup:
-		m.ResetGroup()
+	case gpu.FieldUtilizationGpu:
+		m.ResetUtilizationGpu()
+		return nil
+	case gpu.FieldUtilizationMemory:
+		m.ResetUtilizationMemory()
 		return nil
 	}
-	return fmt.Errorf("unknown Menu field %s", name)
+	return fmt.Errorf("unknown Gpu field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MenuMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.parent != nil {
-		edges = append(edges, menu.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, menu.EdgeChildren)
+func (m *GpuMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.device != nil {
+		edges = append(edges, gpu.EdgeDevice)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MenuMutation) AddedIDs(name string) []ent.Value {
+func (m *GpuMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case menu.EdgeParent:
-		if id := m.parent; id != nil {
+	case gpu.EdgeDevice:
+		if id := m.device; id != nil {
 			return []ent.Value{*id}
 		}
-	case menu.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MenuMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedchildren != nil {
-		edges = append(edges, menu.EdgeChildren)
-	}
+func (m *GpuMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MenuMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case menu.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *GpuMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MenuMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedparent {
-		edges = append(edges, menu.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, menu.EdgeChildren)
+func (m *GpuMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddevice {
+		edges = append(edges, gpu.EdgeDevice)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MenuMutation) EdgeCleared(name string) bool {
+func (m *GpuMutation) EdgeCleared(name string) bool {
 	switch name {
-	case menu.EdgeParent:
-		return m.clearedparent
-	case menu.EdgeChildren:
-		return m.clearedchildren
+	case gpu.EdgeDevice:
+		return m.cleareddevice
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MenuMutation) ClearEdge(name string) error {
+func (m *GpuMutation) ClearEdge(name string) error {
 	switch name {
-	case menu.EdgeParent:
-		m.ClearParent()
+	case gpu.EdgeDevice:
+		m.ClearDevice()
 		return nil
 	}
-	return fmt.Errorf("unknown Menu unique edge %s", name)
+	return fmt.Errorf("unknown Gpu unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MenuMutation) ResetEdge(name string) error {
+func (m *GpuMutation) ResetEdge(name string) error {
 	switch name {
-	case menu.EdgeParent:
-		m.ResetParent()
-		return nil
-	case menu.EdgeChildren:
-		m.ResetChildren()
+	case gpu.EdgeDevice:
+		m.ResetDevice()
 		return nil
 	}
-	return fmt.Errorf("unknown Menu edge %s", name)
+	return fmt.Errorf("unknown Gpu edge %s", name)
 }
 
-// ModelingMutation represents an operation that mutates the Modeling nodes in the graph.
-type ModelingMutation struct {
+// GpuTelemetryMutation represents an operation that mutates the GpuTelemetry nodes in the graph.
+type GpuTelemetryMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	local_id                *int
-	addlocal_id             *int
-	parent_id               *int
-	addparent_id            *int
-	parent_local_id         *int
-	addparent_local_id      *int
-	dataset_id              *int
-	adddataset_id           *int
-	params                  *[]string
-	appendparams            []string
-	dataset_stat            *[]string
-	appenddataset_stat      []string
-	modeling_type           *string
-	modeling_step           *string
-	performance             *[]string
-	appendperformance       []string
-	progress                *float64
-	addprogress             *float64
-	created_at              *time.Time
-	updated_at              *time.Time
-	started_at              *time.Time
-	clearedFields           map[string]struct{}
-	task                    *int
-	clearedtask             bool
-	modeling_details        map[int]struct{}
-	removedmodeling_details map[int]struct{}
-	clearedmodeling_details bool
-	modeling_models         map[int]struct{}
-	removedmodeling_models  map[int]struct{}
-	clearedmodeling_models  bool
-	trials                  map[int]struct{}
-	removedtrials           map[int]struct{}
-	clearedtrials           bool
-	done                    bool
-	oldValue                func(context.Context) (*Modeling, error)
-	predicates              []predicate.Modeling
-}
-
-var _ ent.Mutation = (*ModelingMutation)(nil)
-
-// modelingOption allows management of the mutation configuration using functional options.
-type modelingOption func(*ModelingMutation)
-
-// newModelingMutation creates new mutation for the Modeling entity.
-func newModelingMutation(c config, op Op, opts ...modelingOption) *ModelingMutation {
-	m := &ModelingMutation{
+	op                    Op
+	typ                   string
+	id                    *int
+	gpu_id                *int
+	addgpu_id             *int
+	device_id             *int
+	adddevice_id          *int
+	resolution            *int
+	addresolution         *int
+	bucket_at             *time.Time
+	samples               *int
+	addsamples            *int
+	utilization_gpu       *float64
+	addutilization_gpu    *float64
+	utilization_memory    *float64
+	addutilization_memory *float64
+	memory_used           *float64
+	addmemory_used        *float64
+	memory_total          *float64
+	addmemory_total       *float64
+	temperature           *float64
+	addtemperature        *float64
+	power_draw            *float64
+	addpower_draw         *float64
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*GpuTelemetry, error)
+	predicates            []predicate.GpuTelemetry
+}
+
+var _ ent.Mutation = (*GpuTelemetryMutation)(nil)
+
+// gputelemetryOption allows management of the mutation configuration using functional options.
+type gputelemetryOption func(*GpuTelemetryMutation)
+
+// newGpuTelemetryMutation creates new mutation for the GpuTelemetry entity.
+func newGpuTelemetryMutation(c config, op Op, opts ...gputelemetryOption) *GpuTelemetryMutation {
+	m := &GpuTelemetryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeModeling,
+		typ:           TypeGpuTelemetry,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6587,20 +7493,20 @@ func newModelingMutation(c config, op Op, opts ...modelingOption) *ModelingMutat
 	return m
 }
 
-// withModelingID sets the ID field of the mutation.
-func withModelingID(id int) modelingOption {
-	return func(m *ModelingMutation) {
+// withGpuTelemetryID sets the ID field of the mutation.
+func withGpuTelemetryID(id int) gputelemetryOption {
+	return func(m *GpuTelemetryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Modeling
+			value *GpuTelemetry
 		)
-		m.oldValue = func(ctx context.Context) (*Modeling, error) {
+		m.oldValue = func(ctx context.Context) (*GpuTelemetry, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Modeling.Get(ctx, id)
+					value, err = m.Client().GpuTelemetry.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6609,10 +7515,10 @@ func withModelingID(id int) modelingOption {
 	}
 }
 
-// withModeling sets the old Modeling of the mutation.
-func withModeling(node *Modeling) modelingOption {
-	return func(m *ModelingMutation) {
-		m.oldValue = func(context.Context) (*Modeling, error) {
+// withGpuTelemetry sets the old GpuTelemetry of the mutation.
+func withGpuTelemetry(node *GpuTelemetry) gputelemetryOption {
+	return func(m *GpuTelemetryMutation) {
+		m.oldValue = func(context.Context) (*GpuTelemetry, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6621,7 +7527,7 @@ func withModeling(node *Modeling) modelingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ModelingMutation) Client() *Client {
+func (m GpuTelemetryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6629,7 +7535,7 @@ func (m ModelingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ModelingMutation) Tx() (*Tx, error) {
+func (m GpuTelemetryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6639,14 +7545,14 @@ func (m ModelingMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Modeling entities.
-func (m *ModelingMutation) SetID(id int) {
+// operation is only accepted on creation of GpuTelemetry entities.
+func (m *GpuTelemetryMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ModelingMutation) ID() (id int, exists bool) {
+func (m *GpuTelemetryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6657,7 +7563,7 @@ func (m *ModelingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ModelingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *GpuTelemetryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6666,2477 +7572,11538 @@ func (m *ModelingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Modeling.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().GpuTelemetry.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetLocalID sets the "local_id" field.
-func (m *ModelingMutation) SetLocalID(i int) {
-	m.local_id = &i
-	m.addlocal_id = nil
+// SetGpuID sets the "gpu_id" field.
+func (m *GpuTelemetryMutation) SetGpuID(i int) {
+	m.gpu_id = &i
+	m.addgpu_id = nil
 }
 
-// LocalID returns the value of the "local_id" field in the mutation.
-func (m *ModelingMutation) LocalID() (r int, exists bool) {
-	v := m.local_id
+// GpuID returns the value of the "gpu_id" field in the mutation.
+func (m *GpuTelemetryMutation) GpuID() (r int, exists bool) {
+	v := m.gpu_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLocalID returns the old "local_id" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// OldGpuID returns the old "gpu_id" field's value of the GpuTelemetry entity.
+// If the GpuTelemetry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldLocalID(ctx context.Context) (v int, err error) {
+func (m *GpuTelemetryMutation) OldGpuID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocalID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGpuID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocalID requires an ID field in the mutation")
+		return v, errors.New("OldGpuID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocalID: %w", err)
+		return v, fmt.Errorf("querying old value for OldGpuID: %w", err)
 	}
-	return oldValue.LocalID, nil
+	return oldValue.GpuID, nil
 }
 
-// AddLocalID adds i to the "local_id" field.
-func (m *ModelingMutation) AddLocalID(i int) {
-	if m.addlocal_id != nil {
-		*m.addlocal_id += i
+// AddGpuID adds i to the "gpu_id" field.
+func (m *GpuTelemetryMutation) AddGpuID(i int) {
+	if m.addgpu_id != nil {
+		*m.addgpu_id += i
 	} else {
-		m.addlocal_id = &i
+		m.addgpu_id = &i
 	}
 }
 
-// AddedLocalID returns the value that was added to the "local_id" field in this mutation.
-func (m *ModelingMutation) AddedLocalID() (r int, exists bool) {
-	v := m.addlocal_id
+// AddedGpuID returns the value that was added to the "gpu_id" field in this mutation.
+func (m *GpuTelemetryMutation) AddedGpuID() (r int, exists bool) {
+	v := m.addgpu_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetLocalID resets all changes to the "local_id" field.
-func (m *ModelingMutation) ResetLocalID() {
-	m.local_id = nil
-	m.addlocal_id = nil
+// ResetGpuID resets all changes to the "gpu_id" field.
+func (m *GpuTelemetryMutation) ResetGpuID() {
+	m.gpu_id = nil
+	m.addgpu_id = nil
 }
 
-// SetTaskID sets the "task_id" field.
-func (m *ModelingMutation) SetTaskID(i int) {
-	m.task = &i
+// SetDeviceID sets the "device_id" field.
+func (m *GpuTelemetryMutation) SetDeviceID(i int) {
+	m.device_id = &i
+	m.adddevice_id = nil
 }
 
-// TaskID returns the value of the "task_id" field in the mutation.
-func (m *ModelingMutation) TaskID() (r int, exists bool) {
-	v := m.task
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *GpuTelemetryMutation) DeviceID() (r int, exists bool) {
+	v := m.device_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTaskID returns the old "task_id" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// OldDeviceID returns the old "device_id" field's value of the GpuTelemetry entity.
+// If the GpuTelemetry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldTaskID(ctx context.Context) (v int, err error) {
+func (m *GpuTelemetryMutation) OldDeviceID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTaskID requires an ID field in the mutation")
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
 	}
-	return oldValue.TaskID, nil
+	return oldValue.DeviceID, nil
 }
 
-// ClearTaskID clears the value of the "task_id" field.
-func (m *ModelingMutation) ClearTaskID() {
-	m.task = nil
-	m.clearedFields[modeling.FieldTaskID] = struct{}{}
+// AddDeviceID adds i to the "device_id" field.
+func (m *GpuTelemetryMutation) AddDeviceID(i int) {
+	if m.adddevice_id != nil {
+		*m.adddevice_id += i
+	} else {
+		m.adddevice_id = &i
+	}
 }
 
-// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
-func (m *ModelingMutation) TaskIDCleared() bool {
-	_, ok := m.clearedFields[modeling.FieldTaskID]
-	return ok
+// AddedDeviceID returns the value that was added to the "device_id" field in this mutation.
+func (m *GpuTelemetryMutation) AddedDeviceID() (r int, exists bool) {
+	v := m.adddevice_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetTaskID resets all changes to the "task_id" field.
-func (m *ModelingMutation) ResetTaskID() {
-	m.task = nil
-	delete(m.clearedFields, modeling.FieldTaskID)
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *GpuTelemetryMutation) ResetDeviceID() {
+	m.device_id = nil
+	m.adddevice_id = nil
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *ModelingMutation) SetParentID(i int) {
-	m.parent_id = &i
-	m.addparent_id = nil
+// SetResolution sets the "resolution" field.
+func (m *GpuTelemetryMutation) SetResolution(i int) {
+	m.resolution = &i
+	m.addresolution = nil
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *ModelingMutation) ParentID() (r int, exists bool) {
-	v := m.parent_id
+// Resolution returns the value of the "resolution" field in the mutation.
+func (m *GpuTelemetryMutation) Resolution() (r int, exists bool) {
+	v := m.resolution
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentID returns the old "parent_id" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// OldResolution returns the old "resolution" field's value of the GpuTelemetry entity.
+// If the GpuTelemetry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldParentID(ctx context.Context) (v int, err error) {
+func (m *GpuTelemetryMutation) OldResolution(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldResolution is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
+		return v, errors.New("OldResolution requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldResolution: %w", err)
 	}
-	return oldValue.ParentID, nil
+	return oldValue.Resolution, nil
 }
 
-// AddParentID adds i to the "parent_id" field.
-func (m *ModelingMutation) AddParentID(i int) {
-	if m.addparent_id != nil {
-		*m.addparent_id += i
+// AddResolution adds i to the "resolution" field.
+func (m *GpuTelemetryMutation) AddResolution(i int) {
+	if m.addresolution != nil {
+		*m.addresolution += i
 	} else {
-		m.addparent_id = &i
+		m.addresolution = &i
 	}
 }
 
-// AddedParentID returns the value that was added to the "parent_id" field in this mutation.
-func (m *ModelingMutation) AddedParentID() (r int, exists bool) {
-	v := m.addparent_id
+// AddedResolution returns the value that was added to the "resolution" field in this mutation.
+func (m *GpuTelemetryMutation) AddedResolution() (r int, exists bool) {
+	v := m.addresolution
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearParentID clears the value of the "parent_id" field.
-func (m *ModelingMutation) ClearParentID() {
-	m.parent_id = nil
-	m.addparent_id = nil
-	m.clearedFields[modeling.FieldParentID] = struct{}{}
-}
-
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *ModelingMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[modeling.FieldParentID]
-	return ok
-}
-
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *ModelingMutation) ResetParentID() {
-	m.parent_id = nil
-	m.addparent_id = nil
-	delete(m.clearedFields, modeling.FieldParentID)
+// ResetResolution resets all changes to the "resolution" field.
+func (m *GpuTelemetryMutation) ResetResolution() {
+	m.resolution = nil
+	m.addresolution = nil
 }
 
-// SetParentLocalID sets the "parent_local_id" field.
-func (m *ModelingMutation) SetParentLocalID(i int) {
-	m.parent_local_id = &i
-	m.addparent_local_id = nil
+// SetBucketAt sets the "bucket_at" field.
+func (m *GpuTelemetryMutation) SetBucketAt(t time.Time) {
+	m.bucket_at = &t
 }
 
-// ParentLocalID returns the value of the "parent_local_id" field in the mutation.
-func (m *ModelingMutation) ParentLocalID() (r int, exists bool) {
-	v := m.parent_local_id
+// BucketAt returns the value of the "bucket_at" field in the mutation.
+func (m *GpuTelemetryMutation) BucketAt() (r time.Time, exists bool) {
+	v := m.bucket_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentLocalID returns the old "parent_local_id" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// OldBucketAt returns the old "bucket_at" field's value of the GpuTelemetry entity.
+// If the GpuTelemetry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldParentLocalID(ctx context.Context) (v int, err error) {
+func (m *GpuTelemetryMutation) OldBucketAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentLocalID is only allowed on UpdateOne operations")
+		return v, errors.New("OldBucketAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentLocalID requires an ID field in the mutation")
+		return v, errors.New("OldBucketAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentLocalID: %w", err)
+		return v, fmt.Errorf("querying old value for OldBucketAt: %w", err)
 	}
-	return oldValue.ParentLocalID, nil
+	return oldValue.BucketAt, nil
 }
 
-// AddParentLocalID adds i to the "parent_local_id" field.
-func (m *ModelingMutation) AddParentLocalID(i int) {
-	if m.addparent_local_id != nil {
-		*m.addparent_local_id += i
-	} else {
-		m.addparent_local_id = &i
-	}
+// ResetBucketAt resets all changes to the "bucket_at" field.
+func (m *GpuTelemetryMutation) ResetBucketAt() {
+	m.bucket_at = nil
 }
 
-// AddedParentLocalID returns the value that was added to the "parent_local_id" field in this mutation.
-func (m *ModelingMutation) AddedParentLocalID() (r int, exists bool) {
-	v := m.addparent_local_id
+// SetSamples sets the "samples" field.
+func (m *GpuTelemetryMutation) SetSamples(i int) {
+	m.samples = &i
+	m.addsamples = nil
+}
+
+// Samples returns the value of the "samples" field in the mutation.
+func (m *GpuTelemetryMutation) Samples() (r int, exists bool) {
+	v := m.samples
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearParentLocalID clears the value of the "parent_local_id" field.
-func (m *ModelingMutation) ClearParentLocalID() {
-	m.parent_local_id = nil
-	m.addparent_local_id = nil
-	m.clearedFields[modeling.FieldParentLocalID] = struct{}{}
+// OldSamples returns the old "samples" field's value of the GpuTelemetry entity.
+// If the GpuTelemetry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GpuTelemetryMutation) OldSamples(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSamples is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSamples requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSamples: %w", err)
+	}
+	return oldValue.Samples, nil
 }
 
-// ParentLocalIDCleared returns if the "parent_local_id" field was cleared in this mutation.
-func (m *ModelingMutation) ParentLocalIDCleared() bool {
-	_, ok := m.clearedFields[modeling.FieldParentLocalID]
-	return ok
+// AddSamples adds i to the "samples" field.
+func (m *GpuTelemetryMutation) AddSamples(i int) {
+	if m.addsamples != nil {
+		*m.addsamples += i
+	} else {
+		m.addsamples = &i
+	}
 }
 
-// ResetParentLocalID resets all changes to the "parent_local_id" field.
-func (m *ModelingMutation) ResetParentLocalID() {
-	m.parent_local_id = nil
-	m.addparent_local_id = nil
-	delete(m.clearedFields, modeling.FieldParentLocalID)
+// AddedSamples returns the value that was added to the "samples" field in this mutation.
+func (m *GpuTelemetryMutation) AddedSamples() (r int, exists bool) {
+	v := m.addsamples
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetDatasetID sets the "dataset_id" field.
-func (m *ModelingMutation) SetDatasetID(i int) {
-	m.dataset_id = &i
-	m.adddataset_id = nil
+// ResetSamples resets all changes to the "samples" field.
+func (m *GpuTelemetryMutation) ResetSamples() {
+	m.samples = nil
+	m.addsamples = nil
 }
 
-// DatasetID returns the value of the "dataset_id" field in the mutation.
-func (m *ModelingMutation) DatasetID() (r int, exists bool) {
-	v := m.dataset_id
+// SetUtilizationGpu sets the "utilization_gpu" field.
+func (m *GpuTelemetryMutation) SetUtilizationGpu(f float64) {
+	m.utilization_gpu = &f
+	m.addutilization_gpu = nil
+}
+
+// UtilizationGpu returns the value of the "utilization_gpu" field in the mutation.
+func (m *GpuTelemetryMutation) UtilizationGpu() (r float64, exists bool) {
+	v := m.utilization_gpu
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDatasetID returns the old "dataset_id" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// OldUtilizationGpu returns the old "utilization_gpu" field's value of the GpuTelemetry entity.
+// If the GpuTelemetry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldDatasetID(ctx context.Context) (v int, err error) {
+func (m *GpuTelemetryMutation) OldUtilizationGpu(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDatasetID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUtilizationGpu is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDatasetID requires an ID field in the mutation")
+		return v, errors.New("OldUtilizationGpu requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDatasetID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUtilizationGpu: %w", err)
 	}
-	return oldValue.DatasetID, nil
+	return oldValue.UtilizationGpu, nil
 }
 
-// AddDatasetID adds i to the "dataset_id" field.
-func (m *ModelingMutation) AddDatasetID(i int) {
-	if m.adddataset_id != nil {
-		*m.adddataset_id += i
+// AddUtilizationGpu adds f to the "utilization_gpu" field.
+func (m *GpuTelemetryMutation) AddUtilizationGpu(f float64) {
+	if m.addutilization_gpu != nil {
+		*m.addutilization_gpu += f
 	} else {
-		m.adddataset_id = &i
+		m.addutilization_gpu = &f
 	}
 }
 
-// AddedDatasetID returns the value that was added to the "dataset_id" field in this mutation.
-func (m *ModelingMutation) AddedDatasetID() (r int, exists bool) {
-	v := m.adddataset_id
+// AddedUtilizationGpu returns the value that was added to the "utilization_gpu" field in this mutation.
+func (m *GpuTelemetryMutation) AddedUtilizationGpu() (r float64, exists bool) {
+	v := m.addutilization_gpu
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearDatasetID clears the value of the "dataset_id" field.
-func (m *ModelingMutation) ClearDatasetID() {
-	m.dataset_id = nil
-	m.adddataset_id = nil
-	m.clearedFields[modeling.FieldDatasetID] = struct{}{}
-}
-
-// DatasetIDCleared returns if the "dataset_id" field was cleared in this mutation.
-func (m *ModelingMutation) DatasetIDCleared() bool {
-	_, ok := m.clearedFields[modeling.FieldDatasetID]
-	return ok
-}
-
-// ResetDatasetID resets all changes to the "dataset_id" field.
-func (m *ModelingMutation) ResetDatasetID() {
-	m.dataset_id = nil
-	m.adddataset_id = nil
-	delete(m.clearedFields, modeling.FieldDatasetID)
+// ResetUtilizationGpu resets all changes to the "utilization_gpu" field.
+func (m *GpuTelemetryMutation) ResetUtilizationGpu() {
+	m.utilization_gpu = nil
+	m.addutilization_gpu = nil
 }
 
-// SetParams sets the "params" field.
-func (m *ModelingMutation) SetParams(s []string) {
-	m.params = &s
-	m.appendparams = nil
+// SetUtilizationMemory sets the "utilization_memory" field.
+func (m *GpuTelemetryMutation) SetUtilizationMemory(f float64) {
+	m.utilization_memory = &f
+	m.addutilization_memory = nil
 }
 
-// Params returns the value of the "params" field in the mutation.
-func (m *ModelingMutation) Params() (r []string, exists bool) {
-	v := m.params
+// UtilizationMemory returns the value of the "utilization_memory" field in the mutation.
+func (m *GpuTelemetryMutation) UtilizationMemory() (r float64, exists bool) {
+	v := m.utilization_memory
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParams returns the old "params" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// OldUtilizationMemory returns the old "utilization_memory" field's value of the GpuTelemetry entity.
+// If the GpuTelemetry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldParams(ctx context.Context) (v []string, err error) {
+func (m *GpuTelemetryMutation) OldUtilizationMemory(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParams is only allowed on UpdateOne operations")
+		return v, errors.New("OldUtilizationMemory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParams requires an ID field in the mutation")
+		return v, errors.New("OldUtilizationMemory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParams: %w", err)
+		return v, fmt.Errorf("querying old value for OldUtilizationMemory: %w", err)
 	}
-	return oldValue.Params, nil
+	return oldValue.UtilizationMemory, nil
 }
 
-// AppendParams adds s to the "params" field.
-func (m *ModelingMutation) AppendParams(s []string) {
-	m.appendparams = append(m.appendparams, s...)
+// AddUtilizationMemory adds f to the "utilization_memory" field.
+func (m *GpuTelemetryMutation) AddUtilizationMemory(f float64) {
+	if m.addutilization_memory != nil {
+		*m.addutilization_memory += f
+	} else {
+		m.addutilization_memory = &f
+	}
 }
 
-// AppendedParams returns the list of values that were appended to the "params" field in this mutation.
-func (m *ModelingMutation) AppendedParams() ([]string, bool) {
-	if len(m.appendparams) == 0 {
-		return nil, false
+// AddedUtilizationMemory returns the value that was added to the "utilization_memory" field in this mutation.
+func (m *GpuTelemetryMutation) AddedUtilizationMemory() (r float64, exists bool) {
+	v := m.addutilization_memory
+	if v == nil {
+		return
 	}
-	return m.appendparams, true
+	return *v, true
 }
 
-// ResetParams resets all changes to the "params" field.
-func (m *ModelingMutation) ResetParams() {
-	m.params = nil
-	m.appendparams = nil
+// ResetUtilizationMemory resets all changes to the "utilization_memory" field.
+func (m *GpuTelemetryMutation) ResetUtilizationMemory() {
+	m.utilization_memory = nil
+	m.addutilization_memory = nil
 }
 
-// SetDatasetStat sets the "dataset_stat" field.
-func (m *ModelingMutation) SetDatasetStat(s []string) {
-	m.dataset_stat = &s
-	m.appenddataset_stat = nil
+// SetMemoryUsed sets the "memory_used" field.
+func (m *GpuTelemetryMutation) SetMemoryUsed(f float64) {
+	m.memory_used = &f
+	m.addmemory_used = nil
 }
 
-// DatasetStat returns the value of the "dataset_stat" field in the mutation.
-func (m *ModelingMutation) DatasetStat() (r []string, exists bool) {
-	v := m.dataset_stat
+// MemoryUsed returns the value of the "memory_used" field in the mutation.
+func (m *GpuTelemetryMutation) MemoryUsed() (r float64, exists bool) {
+	v := m.memory_used
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDatasetStat returns the old "dataset_stat" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// OldMemoryUsed returns the old "memory_used" field's value of the GpuTelemetry entity.
+// If the GpuTelemetry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldDatasetStat(ctx context.Context) (v []string, err error) {
+func (m *GpuTelemetryMutation) OldMemoryUsed(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDatasetStat is only allowed on UpdateOne operations")
+		return v, errors.New("OldMemoryUsed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDatasetStat requires an ID field in the mutation")
+		return v, errors.New("OldMemoryUsed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDatasetStat: %w", err)
+		return v, fmt.Errorf("querying old value for OldMemoryUsed: %w", err)
 	}
-	return oldValue.DatasetStat, nil
+	return oldValue.MemoryUsed, nil
 }
 
-// AppendDatasetStat adds s to the "dataset_stat" field.
-func (m *ModelingMutation) AppendDatasetStat(s []string) {
-	m.appenddataset_stat = append(m.appenddataset_stat, s...)
+// AddMemoryUsed adds f to the "memory_used" field.
+func (m *GpuTelemetryMutation) AddMemoryUsed(f float64) {
+	if m.addmemory_used != nil {
+		*m.addmemory_used += f
+	} else {
+		m.addmemory_used = &f
+	}
 }
 
-// AppendedDatasetStat returns the list of values that were appended to the "dataset_stat" field in this mutation.
-func (m *ModelingMutation) AppendedDatasetStat() ([]string, bool) {
-	if len(m.appenddataset_stat) == 0 {
-		return nil, false
+// AddedMemoryUsed returns the value that was added to the "memory_used" field in this mutation.
+func (m *GpuTelemetryMutation) AddedMemoryUsed() (r float64, exists bool) {
+	v := m.addmemory_used
+	if v == nil {
+		return
 	}
-	return m.appenddataset_stat, true
+	return *v, true
 }
 
-// ResetDatasetStat resets all changes to the "dataset_stat" field.
-func (m *ModelingMutation) ResetDatasetStat() {
-	m.dataset_stat = nil
-	m.appenddataset_stat = nil
+// ResetMemoryUsed resets all changes to the "memory_used" field.
+func (m *GpuTelemetryMutation) ResetMemoryUsed() {
+	m.memory_used = nil
+	m.addmemory_used = nil
 }
 
-// SetModelingType sets the "modeling_type" field.
-func (m *ModelingMutation) SetModelingType(s string) {
-	m.modeling_type = &s
+// SetMemoryTotal sets the "memory_total" field.
+func (m *GpuTelemetryMutation) SetMemoryTotal(f float64) {
+	m.memory_total = &f
+	m.addmemory_total = nil
 }
 
-// ModelingType returns the value of the "modeling_type" field in the mutation.
-func (m *ModelingMutation) ModelingType() (r string, exists bool) {
-	v := m.modeling_type
+// MemoryTotal returns the value of the "memory_total" field in the mutation.
+func (m *GpuTelemetryMutation) MemoryTotal() (r float64, exists bool) {
+	v := m.memory_total
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelingType returns the old "modeling_type" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// OldMemoryTotal returns the old "memory_total" field's value of the GpuTelemetry entity.
+// If the GpuTelemetry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldModelingType(ctx context.Context) (v string, err error) {
+func (m *GpuTelemetryMutation) OldMemoryTotal(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelingType is only allowed on UpdateOne operations")
+		return v, errors.New("OldMemoryTotal is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelingType requires an ID field in the mutation")
+		return v, errors.New("OldMemoryTotal requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelingType: %w", err)
+		return v, fmt.Errorf("querying old value for OldMemoryTotal: %w", err)
 	}
-	return oldValue.ModelingType, nil
+	return oldValue.MemoryTotal, nil
 }
 
-// ResetModelingType resets all changes to the "modeling_type" field.
-func (m *ModelingMutation) ResetModelingType() {
-	m.modeling_type = nil
-}
-
-// SetModelingStep sets the "modeling_step" field.
-func (m *ModelingMutation) SetModelingStep(s string) {
-	m.modeling_step = &s
+// AddMemoryTotal adds f to the "memory_total" field.
+func (m *GpuTelemetryMutation) AddMemoryTotal(f float64) {
+	if m.addmemory_total != nil {
+		*m.addmemory_total += f
+	} else {
+		m.addmemory_total = &f
+	}
 }
 
-// ModelingStep returns the value of the "modeling_step" field in the mutation.
-func (m *ModelingMutation) ModelingStep() (r string, exists bool) {
-	v := m.modeling_step
+// AddedMemoryTotal returns the value that was added to the "memory_total" field in this mutation.
+func (m *GpuTelemetryMutation) AddedMemoryTotal() (r float64, exists bool) {
+	v := m.addmemory_total
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelingStep returns the old "modeling_step" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldModelingStep(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelingStep is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelingStep requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelingStep: %w", err)
-	}
-	return oldValue.ModelingStep, nil
-}
-
-// ResetModelingStep resets all changes to the "modeling_step" field.
-func (m *ModelingMutation) ResetModelingStep() {
-	m.modeling_step = nil
+// ResetMemoryTotal resets all changes to the "memory_total" field.
+func (m *GpuTelemetryMutation) ResetMemoryTotal() {
+	m.memory_total = nil
+	m.addmemory_total = nil
 }
 
-// SetPerformance sets the "performance" field.
-func (m *ModelingMutation) SetPerformance(s []string) {
-	m.performance = &s
-	m.appendperformance = nil
+// SetTemperature sets the "temperature" field.
+func (m *GpuTelemetryMutation) SetTemperature(f float64) {
+	m.temperature = &f
+	m.addtemperature = nil
 }
 
-// Performance returns the value of the "performance" field in the mutation.
-func (m *ModelingMutation) Performance() (r []string, exists bool) {
-	v := m.performance
+// Temperature returns the value of the "temperature" field in the mutation.
+func (m *GpuTelemetryMutation) Temperature() (r float64, exists bool) {
+	v := m.temperature
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPerformance returns the old "performance" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// OldTemperature returns the old "temperature" field's value of the GpuTelemetry entity.
+// If the GpuTelemetry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldPerformance(ctx context.Context) (v []string, err error) {
+func (m *GpuTelemetryMutation) OldTemperature(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPerformance is only allowed on UpdateOne operations")
+		return v, errors.New("OldTemperature is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPerformance requires an ID field in the mutation")
+		return v, errors.New("OldTemperature requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPerformance: %w", err)
+		return v, fmt.Errorf("querying old value for OldTemperature: %w", err)
 	}
-	return oldValue.Performance, nil
+	return oldValue.Temperature, nil
 }
 
-// AppendPerformance adds s to the "performance" field.
-func (m *ModelingMutation) AppendPerformance(s []string) {
-	m.appendperformance = append(m.appendperformance, s...)
+// AddTemperature adds f to the "temperature" field.
+func (m *GpuTelemetryMutation) AddTemperature(f float64) {
+	if m.addtemperature != nil {
+		*m.addtemperature += f
+	} else {
+		m.addtemperature = &f
+	}
 }
 
-// AppendedPerformance returns the list of values that were appended to the "performance" field in this mutation.
-func (m *ModelingMutation) AppendedPerformance() ([]string, bool) {
-	if len(m.appendperformance) == 0 {
-		return nil, false
+// AddedTemperature returns the value that was added to the "temperature" field in this mutation.
+func (m *GpuTelemetryMutation) AddedTemperature() (r float64, exists bool) {
+	v := m.addtemperature
+	if v == nil {
+		return
 	}
-	return m.appendperformance, true
+	return *v, true
 }
 
-// ResetPerformance resets all changes to the "performance" field.
-func (m *ModelingMutation) ResetPerformance() {
-	m.performance = nil
-	m.appendperformance = nil
+// ResetTemperature resets all changes to the "temperature" field.
+func (m *GpuTelemetryMutation) ResetTemperature() {
+	m.temperature = nil
+	m.addtemperature = nil
 }
 
-// SetProgress sets the "progress" field.
-func (m *ModelingMutation) SetProgress(f float64) {
-	m.progress = &f
-	m.addprogress = nil
+// SetPowerDraw sets the "power_draw" field.
+func (m *GpuTelemetryMutation) SetPowerDraw(f float64) {
+	m.power_draw = &f
+	m.addpower_draw = nil
 }
 
-// Progress returns the value of the "progress" field in the mutation.
-func (m *ModelingMutation) Progress() (r float64, exists bool) {
-	v := m.progress
+// PowerDraw returns the value of the "power_draw" field in the mutation.
+func (m *GpuTelemetryMutation) PowerDraw() (r float64, exists bool) {
+	v := m.power_draw
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProgress returns the old "progress" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// OldPowerDraw returns the old "power_draw" field's value of the GpuTelemetry entity.
+// If the GpuTelemetry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldProgress(ctx context.Context) (v float64, err error) {
+func (m *GpuTelemetryMutation) OldPowerDraw(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
+		return v, errors.New("OldPowerDraw is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProgress requires an ID field in the mutation")
+		return v, errors.New("OldPowerDraw requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
+		return v, fmt.Errorf("querying old value for OldPowerDraw: %w", err)
 	}
-	return oldValue.Progress, nil
+	return oldValue.PowerDraw, nil
 }
 
-// AddProgress adds f to the "progress" field.
-func (m *ModelingMutation) AddProgress(f float64) {
-	if m.addprogress != nil {
-		*m.addprogress += f
+// AddPowerDraw adds f to the "power_draw" field.
+func (m *GpuTelemetryMutation) AddPowerDraw(f float64) {
+	if m.addpower_draw != nil {
+		*m.addpower_draw += f
 	} else {
-		m.addprogress = &f
+		m.addpower_draw = &f
 	}
 }
 
-// AddedProgress returns the value that was added to the "progress" field in this mutation.
-func (m *ModelingMutation) AddedProgress() (r float64, exists bool) {
-	v := m.addprogress
+// AddedPowerDraw returns the value that was added to the "power_draw" field in this mutation.
+func (m *GpuTelemetryMutation) AddedPowerDraw() (r float64, exists bool) {
+	v := m.addpower_draw
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetProgress resets all changes to the "progress" field.
-func (m *ModelingMutation) ResetProgress() {
-	m.progress = nil
-	m.addprogress = nil
+// ResetPowerDraw resets all changes to the "power_draw" field.
+func (m *GpuTelemetryMutation) ResetPowerDraw() {
+	m.power_draw = nil
+	m.addpower_draw = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ModelingMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GpuTelemetryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ModelingMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GpuTelemetryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the GpuTelemetry entity.
+// If the GpuTelemetry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GpuTelemetryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ModelingMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GpuTelemetryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ModelingMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// Where appends a list predicates to the GpuTelemetryMutation builder.
+func (m *GpuTelemetryMutation) Where(ps ...predicate.GpuTelemetry) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ModelingMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the GpuTelemetryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GpuTelemetryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GpuTelemetry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
+// Op returns the operation name.
+func (m *GpuTelemetryMutation) Op() Op {
+	return m.op
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ModelingMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// SetOp allows setting the mutation operation.
+func (m *GpuTelemetryMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *ModelingMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// Type returns the node type of this mutation (GpuTelemetry).
+func (m *GpuTelemetryMutation) Type() string {
+	return m.typ
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *ModelingMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GpuTelemetryMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.gpu_id != nil {
+		fields = append(fields, gputelemetry.FieldGpuID)
 	}
-	return *v, true
-}
-
-// OldStartedAt returns the old "started_at" field's value of the Modeling entity.
-// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	if m.device_id != nil {
+		fields = append(fields, gputelemetry.FieldDeviceID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	if m.resolution != nil {
+		fields = append(fields, gputelemetry.FieldResolution)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	if m.bucket_at != nil {
+		fields = append(fields, gputelemetry.FieldBucketAt)
 	}
-	return oldValue.StartedAt, nil
-}
-
-// ClearStartedAt clears the value of the "started_at" field.
-func (m *ModelingMutation) ClearStartedAt() {
-	m.started_at = nil
-	m.clearedFields[modeling.FieldStartedAt] = struct{}{}
-}
-
-// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
-func (m *ModelingMutation) StartedAtCleared() bool {
-	_, ok := m.clearedFields[modeling.FieldStartedAt]
-	return ok
-}
-
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *ModelingMutation) ResetStartedAt() {
-	m.started_at = nil
-	delete(m.clearedFields, modeling.FieldStartedAt)
-}
-
-// ClearTask clears the "task" edge to the Task entity.
-func (m *ModelingMutation) ClearTask() {
-	m.clearedtask = true
-	m.clearedFields[modeling.FieldTaskID] = struct{}{}
-}
-
-// TaskCleared reports if the "task" edge to the Task entity was cleared.
-func (m *ModelingMutation) TaskCleared() bool {
-	return m.TaskIDCleared() || m.clearedtask
-}
-
-// TaskIDs returns the "task" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TaskID instead. It exists only for internal usage by the builders.
-func (m *ModelingMutation) TaskIDs() (ids []int) {
-	if id := m.task; id != nil {
-		ids = append(ids, *id)
+	if m.samples != nil {
+		fields = append(fields, gputelemetry.FieldSamples)
 	}
-	return
-}
-
-// ResetTask resets all changes to the "task" edge.
-func (m *ModelingMutation) ResetTask() {
-	m.task = nil
-	m.clearedtask = false
-}
-
-// AddModelingDetailIDs adds the "modeling_details" edge to the ModelingDetails entity by ids.
-func (m *ModelingMutation) AddModelingDetailIDs(ids ...int) {
-	if m.modeling_details == nil {
-		m.modeling_details = make(map[int]struct{})
+	if m.utilization_gpu != nil {
+		fields = append(fields, gputelemetry.FieldUtilizationGpu)
 	}
-	for i := range ids {
-		m.modeling_details[ids[i]] = struct{}{}
+	if m.utilization_memory != nil {
+		fields = append(fields, gputelemetry.FieldUtilizationMemory)
 	}
-}
-
-// ClearModelingDetails clears the "modeling_details" edge to the ModelingDetails entity.
-func (m *ModelingMutation) ClearModelingDetails() {
-	m.clearedmodeling_details = true
-}
-
-// ModelingDetailsCleared reports if the "modeling_details" edge to the ModelingDetails entity was cleared.
-func (m *ModelingMutation) ModelingDetailsCleared() bool {
-	return m.clearedmodeling_details
-}
-
-// RemoveModelingDetailIDs removes the "modeling_details" edge to the ModelingDetails entity by IDs.
-func (m *ModelingMutation) RemoveModelingDetailIDs(ids ...int) {
-	if m.removedmodeling_details == nil {
-		m.removedmodeling_details = make(map[int]struct{})
+	if m.memory_used != nil {
+		fields = append(fields, gputelemetry.FieldMemoryUsed)
 	}
-	for i := range ids {
-		delete(m.modeling_details, ids[i])
-		m.removedmodeling_details[ids[i]] = struct{}{}
+	if m.memory_total != nil {
+		fields = append(fields, gputelemetry.FieldMemoryTotal)
+	}
+	if m.temperature != nil {
+		fields = append(fields, gputelemetry.FieldTemperature)
+	}
+	if m.power_draw != nil {
+		fields = append(fields, gputelemetry.FieldPowerDraw)
 	}
+	if m.updated_at != nil {
+		fields = append(fields, gputelemetry.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// RemovedModelingDetails returns the removed IDs of the "modeling_details" edge to the ModelingDetails entity.
-func (m *ModelingMutation) RemovedModelingDetailsIDs() (ids []int) {
-	for id := range m.removedmodeling_details {
-		ids = append(ids, id)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GpuTelemetryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case gputelemetry.FieldGpuID:
+		return m.GpuID()
+	case gputelemetry.FieldDeviceID:
+		return m.DeviceID()
+	case gputelemetry.FieldResolution:
+		return m.Resolution()
+	case gputelemetry.FieldBucketAt:
+		return m.BucketAt()
+	case gputelemetry.FieldSamples:
+		return m.Samples()
+	case gputelemetry.FieldUtilizationGpu:
+		return m.UtilizationGpu()
+	case gputelemetry.FieldUtilizationMemory:
+		return m.UtilizationMemory()
+	case gputelemetry.FieldMemoryUsed:
+		return m.MemoryUsed()
+	case gputelemetry.FieldMemoryTotal:
+		return m.MemoryTotal()
+	case gputelemetry.FieldTemperature:
+		return m.Temperature()
+	case gputelemetry.FieldPowerDraw:
+		return m.PowerDraw()
+	case gputelemetry.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return
+	return nil, false
 }
 
-// ModelingDetailsIDs returns the "modeling_details" edge IDs in the mutation.
-func (m *ModelingMutation) ModelingDetailsIDs() (ids []int) {
-	for id := range m.modeling_details {
-		ids = append(ids, id)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GpuTelemetryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case gputelemetry.FieldGpuID:
+		return m.OldGpuID(ctx)
+	case gputelemetry.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case gputelemetry.FieldResolution:
+		return m.OldResolution(ctx)
+	case gputelemetry.FieldBucketAt:
+		return m.OldBucketAt(ctx)
+	case gputelemetry.FieldSamples:
+		return m.OldSamples(ctx)
+	case gputelemetry.FieldUtilizationGpu:
+		return m.OldUtilizationGpu(ctx)
+	case gputelemetry.FieldUtilizationMemory:
+		return m.OldUtilizationMemory(ctx)
+	case gputelemetry.FieldMemoryUsed:
+		return m.OldMemoryUsed(ctx)
+	case gputelemetry.FieldMemoryTotal:
+		return m.OldMemoryTotal(ctx)
+	case gputelemetry.FieldTemperature:
+		return m.OldTemperature(ctx)
+	case gputelemetry.FieldPowerDraw:
+		return m.OldPowerDraw(ctx)
+	case gputelemetry.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return
+	return nil, fmt.Errorf("unknown GpuTelemetry field %s", name)
 }
 
-// ResetModelingDetails resets all changes to the "modeling_details" edge.
-func (m *ModelingMutation) ResetModelingDetails() {
-	m.modeling_details = nil
-	m.clearedmodeling_details = false
-	m.removedmodeling_details = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GpuTelemetryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case gputelemetry.FieldGpuID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGpuID(v)
+		return nil
+	case gputelemetry.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case gputelemetry.FieldResolution:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolution(v)
+		return nil
+	case gputelemetry.FieldBucketAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBucketAt(v)
+		return nil
+	case gputelemetry.FieldSamples:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSamples(v)
+		return nil
+	case gputelemetry.FieldUtilizationGpu:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUtilizationGpu(v)
+		return nil
+	case gputelemetry.FieldUtilizationMemory:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUtilizationMemory(v)
+		return nil
+	case gputelemetry.FieldMemoryUsed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemoryUsed(v)
+		return nil
+	case gputelemetry.FieldMemoryTotal:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemoryTotal(v)
+		return nil
+	case gputelemetry.FieldTemperature:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemperature(v)
+		return nil
+	case gputelemetry.FieldPowerDraw:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPowerDraw(v)
+		return nil
+	case gputelemetry.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GpuTelemetry field %s", name)
 }
 
-// AddModelingModelIDs adds the "modeling_models" edge to the ModelingModels entity by ids.
-func (m *ModelingMutation) AddModelingModelIDs(ids ...int) {
-	if m.modeling_models == nil {
-		m.modeling_models = make(map[int]struct{})
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GpuTelemetryMutation) AddedFields() []string {
+	var fields []string
+	if m.addgpu_id != nil {
+		fields = append(fields, gputelemetry.FieldGpuID)
 	}
-	for i := range ids {
-		m.modeling_models[ids[i]] = struct{}{}
+	if m.adddevice_id != nil {
+		fields = append(fields, gputelemetry.FieldDeviceID)
+	}
+	if m.addresolution != nil {
+		fields = append(fields, gputelemetry.FieldResolution)
 	}
+	if m.addsamples != nil {
+		fields = append(fields, gputelemetry.FieldSamples)
+	}
+	if m.addutilization_gpu != nil {
+		fields = append(fields, gputelemetry.FieldUtilizationGpu)
+	}
+	if m.addutilization_memory != nil {
+		fields = append(fields, gputelemetry.FieldUtilizationMemory)
+	}
+	if m.addmemory_used != nil {
+		fields = append(fields, gputelemetry.FieldMemoryUsed)
+	}
+	if m.addmemory_total != nil {
+		fields = append(fields, gputelemetry.FieldMemoryTotal)
+	}
+	if m.addtemperature != nil {
+		fields = append(fields, gputelemetry.FieldTemperature)
+	}
+	if m.addpower_draw != nil {
+		fields = append(fields, gputelemetry.FieldPowerDraw)
+	}
+	return fields
 }
 
-// ClearModelingModels clears the "modeling_models" edge to the ModelingModels entity.
-func (m *ModelingMutation) ClearModelingModels() {
-	m.clearedmodeling_models = true
-}
-
-// ModelingModelsCleared reports if the "modeling_models" edge to the ModelingModels entity was cleared.
-func (m *ModelingMutation) ModelingModelsCleared() bool {
-	return m.clearedmodeling_models
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GpuTelemetryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case gputelemetry.FieldGpuID:
+		return m.AddedGpuID()
+	case gputelemetry.FieldDeviceID:
+		return m.AddedDeviceID()
+	case gputelemetry.FieldResolution:
+		return m.AddedResolution()
+	case gputelemetry.FieldSamples:
+		return m.AddedSamples()
+	case gputelemetry.FieldUtilizationGpu:
+		return m.AddedUtilizationGpu()
+	case gputelemetry.FieldUtilizationMemory:
+		return m.AddedUtilizationMemory()
+	case gputelemetry.FieldMemoryUsed:
+		return m.AddedMemoryUsed()
+	case gputelemetry.FieldMemoryTotal:
+		return m.AddedMemoryTotal()
+	case gputelemetry.FieldTemperature:
+		return m.AddedTemperature()
+	case gputelemetry.FieldPowerDraw:
+		return m.AddedPowerDraw()
+	}
+	return nil, false
 }
 
-// RemoveModelingModelIDs removes the "modeling_models" edge to the ModelingModels entity by IDs.
-func (m *ModelingMutation) RemoveModelingModelIDs(ids ...int) {
-	if m.removedmodeling_models == nil {
-		m.removedmodeling_models = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.modeling_models, ids[i])
-		m.removedmodeling_models[ids[i]] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GpuTelemetryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case gputelemetry.FieldGpuID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGpuID(v)
+		return nil
+	case gputelemetry.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeviceID(v)
+		return nil
+	case gputelemetry.FieldResolution:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResolution(v)
+		return nil
+	case gputelemetry.FieldSamples:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSamples(v)
+		return nil
+	case gputelemetry.FieldUtilizationGpu:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUtilizationGpu(v)
+		return nil
+	case gputelemetry.FieldUtilizationMemory:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUtilizationMemory(v)
+		return nil
+	case gputelemetry.FieldMemoryUsed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMemoryUsed(v)
+		return nil
+	case gputelemetry.FieldMemoryTotal:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMemoryTotal(v)
+		return nil
+	case gputelemetry.FieldTemperature:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTemperature(v)
+		return nil
+	case gputelemetry.FieldPowerDraw:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPowerDraw(v)
+		return nil
 	}
+	return fmt.Errorf("unknown GpuTelemetry numeric field %s", name)
 }
 
-// RemovedModelingModels returns the removed IDs of the "modeling_models" edge to the ModelingModels entity.
-func (m *ModelingMutation) RemovedModelingModelsIDs() (ids []int) {
-	for id := range m.removedmodeling_models {
-		ids = append(ids, id)
-	}
-	return
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GpuTelemetryMutation) ClearedFields() []string {
+	return nil
 }
 
-// ModelingModelsIDs returns the "modeling_models" edge IDs in the mutation.
-func (m *ModelingMutation) ModelingModelsIDs() (ids []int) {
-	for id := range m.modeling_models {
-		ids = append(ids, id)
-	}
-	return
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GpuTelemetryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ResetModelingModels resets all changes to the "modeling_models" edge.
-func (m *ModelingMutation) ResetModelingModels() {
-	m.modeling_models = nil
-	m.clearedmodeling_models = false
-	m.removedmodeling_models = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GpuTelemetryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GpuTelemetry nullable field %s", name)
 }
 
-// AddTrialIDs adds the "trials" edge to the Trial entity by ids.
-func (m *ModelingMutation) AddTrialIDs(ids ...int) {
-	if m.trials == nil {
-		m.trials = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.trials[ids[i]] = struct{}{}
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GpuTelemetryMutation) ResetField(name string) error {
+	switch name {
+	case gputelemetry.FieldGpuID:
+		m.ResetGpuID()
+		return nil
+	case gputelemetry.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case gputelemetry.FieldResolution:
+		m.ResetResolution()
+		return nil
+	case gputelemetry.FieldBucketAt:
+		m.ResetBucketAt()
+		return nil
+	case gputelemetry.FieldSamples:
+		m.ResetSamples()
+		return nil
+	case gputelemetry.FieldUtilizationGpu:
+		m.ResetUtilizationGpu()
+		return nil
+	case gputelemetry.FieldUtilizationMemory:
+		m.ResetUtilizationMemory()
+		return nil
+	case gputelemetry.FieldMemoryUsed:
+		m.ResetMemoryUsed()
+		return nil
+	case gputelemetry.FieldMemoryTotal:
+		m.ResetMemoryTotal()
+		return nil
+	case gputelemetry.FieldTemperature:
+		m.ResetTemperature()
+		return nil
+	case gputelemetry.FieldPowerDraw:
+		m.ResetPowerDraw()
+		return nil
+	case gputelemetry.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
+	return fmt.Errorf("unknown GpuTelemetry field %s", name)
 }
 
-// ClearTrials clears the "trials" edge to the Trial entity.
-func (m *ModelingMutation) ClearTrials() {
-	m.clearedtrials = true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GpuTelemetryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// TrialsCleared reports if the "trials" edge to the Trial entity was cleared.
-func (m *ModelingMutation) TrialsCleared() bool {
-	return m.clearedtrials
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GpuTelemetryMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemoveTrialIDs removes the "trials" edge to the Trial entity by IDs.
-func (m *ModelingMutation) RemoveTrialIDs(ids ...int) {
-	if m.removedtrials == nil {
-		m.removedtrials = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.trials, ids[i])
-		m.removedtrials[ids[i]] = struct{}{}
-	}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GpuTelemetryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// RemovedTrials returns the removed IDs of the "trials" edge to the Trial entity.
-func (m *ModelingMutation) RemovedTrialsIDs() (ids []int) {
-	for id := range m.removedtrials {
-		ids = append(ids, id)
-	}
-	return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GpuTelemetryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// TrialsIDs returns the "trials" edge IDs in the mutation.
-func (m *ModelingMutation) TrialsIDs() (ids []int) {
-	for id := range m.trials {
-		ids = append(ids, id)
-	}
-	return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GpuTelemetryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetTrials resets all changes to the "trials" edge.
-func (m *ModelingMutation) ResetTrials() {
-	m.trials = nil
-	m.clearedtrials = false
-	m.removedtrials = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GpuTelemetryMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// Where appends a list predicates to the ModelingMutation builder.
-func (m *ModelingMutation) Where(ps ...predicate.Modeling) {
-	m.predicates = append(m.predicates, ps...)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GpuTelemetryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GpuTelemetry unique edge %s", name)
 }
 
-// WhereP appends storage-level predicates to the ModelingMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ModelingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Modeling, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GpuTelemetryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GpuTelemetry edge %s", name)
 }
 
-// Op returns the operation name.
-func (m *ModelingMutation) Op() Op {
-	return m.op
+// HyperParamsHistoryMutation represents an operation that mutates the HyperParamsHistory nodes in the graph.
+type HyperParamsHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	trial_id      *int
+	addtrial_id   *int
+	trial_uuid    *string
+	model         *string
+	model_num     *int
+	addmodel_num  *int
+	params        *string
+	score         *float64
+	addscore      *float64
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*HyperParamsHistory, error)
+	predicates    []predicate.HyperParamsHistory
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ModelingMutation) SetOp(op Op) {
-	m.op = op
-}
+var _ ent.Mutation = (*HyperParamsHistoryMutation)(nil)
 
-// Type returns the node type of this mutation (Modeling).
-func (m *ModelingMutation) Type() string {
-	return m.typ
-}
+// hyperparamshistoryOption allows management of the mutation configuration using functional options.
+type hyperparamshistoryOption func(*HyperParamsHistoryMutation)
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ModelingMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.local_id != nil {
-		fields = append(fields, modeling.FieldLocalID)
-	}
-	if m.task != nil {
-		fields = append(fields, modeling.FieldTaskID)
-	}
-	if m.parent_id != nil {
-		fields = append(fields, modeling.FieldParentID)
-	}
-	if m.parent_local_id != nil {
-		fields = append(fields, modeling.FieldParentLocalID)
-	}
-	if m.dataset_id != nil {
-		fields = append(fields, modeling.FieldDatasetID)
-	}
-	if m.params != nil {
-		fields = append(fields, modeling.FieldParams)
+// newHyperParamsHistoryMutation creates new mutation for the HyperParamsHistory entity.
+func newHyperParamsHistoryMutation(c config, op Op, opts ...hyperparamshistoryOption) *HyperParamsHistoryMutation {
+	m := &HyperParamsHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHyperParamsHistory,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.dataset_stat != nil {
-		fields = append(fields, modeling.FieldDatasetStat)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHyperParamsHistoryID sets the ID field of the mutation.
+func withHyperParamsHistoryID(id int) hyperparamshistoryOption {
+	return func(m *HyperParamsHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HyperParamsHistory
+		)
+		m.oldValue = func(ctx context.Context) (*HyperParamsHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HyperParamsHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHyperParamsHistory sets the old HyperParamsHistory of the mutation.
+func withHyperParamsHistory(node *HyperParamsHistory) hyperparamshistoryOption {
+	return func(m *HyperParamsHistoryMutation) {
+		m.oldValue = func(context.Context) (*HyperParamsHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HyperParamsHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HyperParamsHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of HyperParamsHistory entities.
+func (m *HyperParamsHistoryMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HyperParamsHistoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HyperParamsHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HyperParamsHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTrialID sets the "trial_id" field.
+func (m *HyperParamsHistoryMutation) SetTrialID(i int) {
+	m.trial_id = &i
+	m.addtrial_id = nil
+}
+
+// TrialID returns the value of the "trial_id" field in the mutation.
+func (m *HyperParamsHistoryMutation) TrialID() (r int, exists bool) {
+	v := m.trial_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrialID returns the old "trial_id" field's value of the HyperParamsHistory entity.
+// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HyperParamsHistoryMutation) OldTrialID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrialID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrialID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrialID: %w", err)
+	}
+	return oldValue.TrialID, nil
+}
+
+// AddTrialID adds i to the "trial_id" field.
+func (m *HyperParamsHistoryMutation) AddTrialID(i int) {
+	if m.addtrial_id != nil {
+		*m.addtrial_id += i
+	} else {
+		m.addtrial_id = &i
+	}
+}
+
+// AddedTrialID returns the value that was added to the "trial_id" field in this mutation.
+func (m *HyperParamsHistoryMutation) AddedTrialID() (r int, exists bool) {
+	v := m.addtrial_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTrialID resets all changes to the "trial_id" field.
+func (m *HyperParamsHistoryMutation) ResetTrialID() {
+	m.trial_id = nil
+	m.addtrial_id = nil
+}
+
+// SetTrialUUID sets the "trial_uuid" field.
+func (m *HyperParamsHistoryMutation) SetTrialUUID(s string) {
+	m.trial_uuid = &s
+}
+
+// TrialUUID returns the value of the "trial_uuid" field in the mutation.
+func (m *HyperParamsHistoryMutation) TrialUUID() (r string, exists bool) {
+	v := m.trial_uuid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrialUUID returns the old "trial_uuid" field's value of the HyperParamsHistory entity.
+// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HyperParamsHistoryMutation) OldTrialUUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrialUUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrialUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrialUUID: %w", err)
+	}
+	return oldValue.TrialUUID, nil
+}
+
+// ResetTrialUUID resets all changes to the "trial_uuid" field.
+func (m *HyperParamsHistoryMutation) ResetTrialUUID() {
+	m.trial_uuid = nil
+}
+
+// SetModel sets the "model" field.
+func (m *HyperParamsHistoryMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *HyperParamsHistoryMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the HyperParamsHistory entity.
+// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HyperParamsHistoryMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *HyperParamsHistoryMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetModelNum sets the "model_num" field.
+func (m *HyperParamsHistoryMutation) SetModelNum(i int) {
+	m.model_num = &i
+	m.addmodel_num = nil
+}
+
+// ModelNum returns the value of the "model_num" field in the mutation.
+func (m *HyperParamsHistoryMutation) ModelNum() (r int, exists bool) {
+	v := m.model_num
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelNum returns the old "model_num" field's value of the HyperParamsHistory entity.
+// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HyperParamsHistoryMutation) OldModelNum(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelNum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelNum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelNum: %w", err)
+	}
+	return oldValue.ModelNum, nil
+}
+
+// AddModelNum adds i to the "model_num" field.
+func (m *HyperParamsHistoryMutation) AddModelNum(i int) {
+	if m.addmodel_num != nil {
+		*m.addmodel_num += i
+	} else {
+		m.addmodel_num = &i
+	}
+}
+
+// AddedModelNum returns the value that was added to the "model_num" field in this mutation.
+func (m *HyperParamsHistoryMutation) AddedModelNum() (r int, exists bool) {
+	v := m.addmodel_num
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetModelNum resets all changes to the "model_num" field.
+func (m *HyperParamsHistoryMutation) ResetModelNum() {
+	m.model_num = nil
+	m.addmodel_num = nil
+}
+
+// SetParams sets the "params" field.
+func (m *HyperParamsHistoryMutation) SetParams(s string) {
+	m.params = &s
+}
+
+// Params returns the value of the "params" field in the mutation.
+func (m *HyperParamsHistoryMutation) Params() (r string, exists bool) {
+	v := m.params
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParams returns the old "params" field's value of the HyperParamsHistory entity.
+// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HyperParamsHistoryMutation) OldParams(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParams: %w", err)
+	}
+	return oldValue.Params, nil
+}
+
+// ResetParams resets all changes to the "params" field.
+func (m *HyperParamsHistoryMutation) ResetParams() {
+	m.params = nil
+}
+
+// SetScore sets the "score" field.
+func (m *HyperParamsHistoryMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *HyperParamsHistoryMutation) Score() (r float64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the HyperParamsHistory entity.
+// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HyperParamsHistoryMutation) OldScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds f to the "score" field.
+func (m *HyperParamsHistoryMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *HyperParamsHistoryMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearScore clears the value of the "score" field.
+func (m *HyperParamsHistoryMutation) ClearScore() {
+	m.score = nil
+	m.addscore = nil
+	m.clearedFields[hyperparamshistory.FieldScore] = struct{}{}
+}
+
+// ScoreCleared returns if the "score" field was cleared in this mutation.
+func (m *HyperParamsHistoryMutation) ScoreCleared() bool {
+	_, ok := m.clearedFields[hyperparamshistory.FieldScore]
+	return ok
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *HyperParamsHistoryMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+	delete(m.clearedFields, hyperparamshistory.FieldScore)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *HyperParamsHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HyperParamsHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the HyperParamsHistory entity.
+// If the HyperParamsHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HyperParamsHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HyperParamsHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the HyperParamsHistoryMutation builder.
+func (m *HyperParamsHistoryMutation) Where(ps ...predicate.HyperParamsHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HyperParamsHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HyperParamsHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HyperParamsHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HyperParamsHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HyperParamsHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HyperParamsHistory).
+func (m *HyperParamsHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HyperParamsHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.trial_id != nil {
+		fields = append(fields, hyperparamshistory.FieldTrialID)
+	}
+	if m.trial_uuid != nil {
+		fields = append(fields, hyperparamshistory.FieldTrialUUID)
+	}
+	if m.model != nil {
+		fields = append(fields, hyperparamshistory.FieldModel)
+	}
+	if m.model_num != nil {
+		fields = append(fields, hyperparamshistory.FieldModelNum)
+	}
+	if m.params != nil {
+		fields = append(fields, hyperparamshistory.FieldParams)
+	}
+	if m.score != nil {
+		fields = append(fields, hyperparamshistory.FieldScore)
+	}
+	if m.created_at != nil {
+		fields = append(fields, hyperparamshistory.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HyperParamsHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hyperparamshistory.FieldTrialID:
+		return m.TrialID()
+	case hyperparamshistory.FieldTrialUUID:
+		return m.TrialUUID()
+	case hyperparamshistory.FieldModel:
+		return m.Model()
+	case hyperparamshistory.FieldModelNum:
+		return m.ModelNum()
+	case hyperparamshistory.FieldParams:
+		return m.Params()
+	case hyperparamshistory.FieldScore:
+		return m.Score()
+	case hyperparamshistory.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HyperParamsHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hyperparamshistory.FieldTrialID:
+		return m.OldTrialID(ctx)
+	case hyperparamshistory.FieldTrialUUID:
+		return m.OldTrialUUID(ctx)
+	case hyperparamshistory.FieldModel:
+		return m.OldModel(ctx)
+	case hyperparamshistory.FieldModelNum:
+		return m.OldModelNum(ctx)
+	case hyperparamshistory.FieldParams:
+		return m.OldParams(ctx)
+	case hyperparamshistory.FieldScore:
+		return m.OldScore(ctx)
+	case hyperparamshistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown HyperParamsHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HyperParamsHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hyperparamshistory.FieldTrialID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrialID(v)
+		return nil
+	case hyperparamshistory.FieldTrialUUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrialUUID(v)
+		return nil
+	case hyperparamshistory.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case hyperparamshistory.FieldModelNum:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelNum(v)
+		return nil
+	case hyperparamshistory.FieldParams:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParams(v)
+		return nil
+	case hyperparamshistory.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case hyperparamshistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HyperParamsHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HyperParamsHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addtrial_id != nil {
+		fields = append(fields, hyperparamshistory.FieldTrialID)
+	}
+	if m.addmodel_num != nil {
+		fields = append(fields, hyperparamshistory.FieldModelNum)
+	}
+	if m.addscore != nil {
+		fields = append(fields, hyperparamshistory.FieldScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HyperParamsHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case hyperparamshistory.FieldTrialID:
+		return m.AddedTrialID()
+	case hyperparamshistory.FieldModelNum:
+		return m.AddedModelNum()
+	case hyperparamshistory.FieldScore:
+		return m.AddedScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HyperParamsHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case hyperparamshistory.FieldTrialID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTrialID(v)
+		return nil
+	case hyperparamshistory.FieldModelNum:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModelNum(v)
+		return nil
+	case hyperparamshistory.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HyperParamsHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HyperParamsHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(hyperparamshistory.FieldScore) {
+		fields = append(fields, hyperparamshistory.FieldScore)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HyperParamsHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HyperParamsHistoryMutation) ClearField(name string) error {
+	switch name {
+	case hyperparamshistory.FieldScore:
+		m.ClearScore()
+		return nil
+	}
+	return fmt.Errorf("unknown HyperParamsHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HyperParamsHistoryMutation) ResetField(name string) error {
+	switch name {
+	case hyperparamshistory.FieldTrialID:
+		m.ResetTrialID()
+		return nil
+	case hyperparamshistory.FieldTrialUUID:
+		m.ResetTrialUUID()
+		return nil
+	case hyperparamshistory.FieldModel:
+		m.ResetModel()
+		return nil
+	case hyperparamshistory.FieldModelNum:
+		m.ResetModelNum()
+		return nil
+	case hyperparamshistory.FieldParams:
+		m.ResetParams()
+		return nil
+	case hyperparamshistory.FieldScore:
+		m.ResetScore()
+		return nil
+	case hyperparamshistory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown HyperParamsHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HyperParamsHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HyperParamsHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HyperParamsHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HyperParamsHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HyperParamsHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HyperParamsHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HyperParamsHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown HyperParamsHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HyperParamsHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown HyperParamsHistory edge %s", name)
+}
+
+// MaintenanceWindowMutation represents an operation that mutates the MaintenanceWindow nodes in the graph.
+type MaintenanceWindowMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	device_id     *int
+	adddevice_id  *int
+	starts_at     *time.Time
+	ends_at       *time.Time
+	reason        *string
+	migrate       *bool
+	created_by    *string
+	created_at    *time.Time
+	started_at    *time.Time
+	ended_at      *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MaintenanceWindow, error)
+	predicates    []predicate.MaintenanceWindow
+}
+
+var _ ent.Mutation = (*MaintenanceWindowMutation)(nil)
+
+// maintenancewindowOption allows management of the mutation configuration using functional options.
+type maintenancewindowOption func(*MaintenanceWindowMutation)
+
+// newMaintenanceWindowMutation creates new mutation for the MaintenanceWindow entity.
+func newMaintenanceWindowMutation(c config, op Op, opts ...maintenancewindowOption) *MaintenanceWindowMutation {
+	m := &MaintenanceWindowMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMaintenanceWindow,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMaintenanceWindowID sets the ID field of the mutation.
+func withMaintenanceWindowID(id int) maintenancewindowOption {
+	return func(m *MaintenanceWindowMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MaintenanceWindow
+		)
+		m.oldValue = func(ctx context.Context) (*MaintenanceWindow, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MaintenanceWindow.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMaintenanceWindow sets the old MaintenanceWindow of the mutation.
+func withMaintenanceWindow(node *MaintenanceWindow) maintenancewindowOption {
+	return func(m *MaintenanceWindowMutation) {
+		m.oldValue = func(context.Context) (*MaintenanceWindow, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MaintenanceWindowMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MaintenanceWindowMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MaintenanceWindow entities.
+func (m *MaintenanceWindowMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MaintenanceWindowMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MaintenanceWindowMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MaintenanceWindow.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *MaintenanceWindowMutation) SetDeviceID(i int) {
+	m.device_id = &i
+	m.adddevice_id = nil
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *MaintenanceWindowMutation) DeviceID() (r int, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldDeviceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (m *MaintenanceWindowMutation) AddDeviceID(i int) {
+	if m.adddevice_id != nil {
+		*m.adddevice_id += i
+	} else {
+		m.adddevice_id = &i
+	}
+}
+
+// AddedDeviceID returns the value that was added to the "device_id" field in this mutation.
+func (m *MaintenanceWindowMutation) AddedDeviceID() (r int, exists bool) {
+	v := m.adddevice_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *MaintenanceWindowMutation) ResetDeviceID() {
+	m.device_id = nil
+	m.adddevice_id = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *MaintenanceWindowMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *MaintenanceWindowMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *MaintenanceWindowMutation) ResetStartsAt() {
+	m.starts_at = nil
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *MaintenanceWindowMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *MaintenanceWindowMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldEndsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *MaintenanceWindowMutation) ResetEndsAt() {
+	m.ends_at = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *MaintenanceWindowMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *MaintenanceWindowMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *MaintenanceWindowMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetMigrate sets the "migrate" field.
+func (m *MaintenanceWindowMutation) SetMigrate(b bool) {
+	m.migrate = &b
+}
+
+// Migrate returns the value of the "migrate" field in the mutation.
+func (m *MaintenanceWindowMutation) Migrate() (r bool, exists bool) {
+	v := m.migrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMigrate returns the old "migrate" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldMigrate(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMigrate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMigrate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMigrate: %w", err)
+	}
+	return oldValue.Migrate, nil
+}
+
+// ResetMigrate resets all changes to the "migrate" field.
+func (m *MaintenanceWindowMutation) ResetMigrate() {
+	m.migrate = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *MaintenanceWindowMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *MaintenanceWindowMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *MaintenanceWindowMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MaintenanceWindowMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MaintenanceWindowMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MaintenanceWindowMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *MaintenanceWindowMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *MaintenanceWindowMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *MaintenanceWindowMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[maintenancewindow.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *MaintenanceWindowMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[maintenancewindow.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *MaintenanceWindowMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, maintenancewindow.FieldStartedAt)
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *MaintenanceWindowMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *MaintenanceWindowMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *MaintenanceWindowMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[maintenancewindow.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *MaintenanceWindowMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[maintenancewindow.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *MaintenanceWindowMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, maintenancewindow.FieldEndedAt)
+}
+
+// Where appends a list predicates to the MaintenanceWindowMutation builder.
+func (m *MaintenanceWindowMutation) Where(ps ...predicate.MaintenanceWindow) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MaintenanceWindowMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MaintenanceWindowMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MaintenanceWindow, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MaintenanceWindowMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MaintenanceWindowMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MaintenanceWindow).
+func (m *MaintenanceWindowMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MaintenanceWindowMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.device_id != nil {
+		fields = append(fields, maintenancewindow.FieldDeviceID)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, maintenancewindow.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, maintenancewindow.FieldEndsAt)
+	}
+	if m.reason != nil {
+		fields = append(fields, maintenancewindow.FieldReason)
+	}
+	if m.migrate != nil {
+		fields = append(fields, maintenancewindow.FieldMigrate)
+	}
+	if m.created_by != nil {
+		fields = append(fields, maintenancewindow.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, maintenancewindow.FieldCreatedAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, maintenancewindow.FieldStartedAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, maintenancewindow.FieldEndedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MaintenanceWindowMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case maintenancewindow.FieldDeviceID:
+		return m.DeviceID()
+	case maintenancewindow.FieldStartsAt:
+		return m.StartsAt()
+	case maintenancewindow.FieldEndsAt:
+		return m.EndsAt()
+	case maintenancewindow.FieldReason:
+		return m.Reason()
+	case maintenancewindow.FieldMigrate:
+		return m.Migrate()
+	case maintenancewindow.FieldCreatedBy:
+		return m.CreatedBy()
+	case maintenancewindow.FieldCreatedAt:
+		return m.CreatedAt()
+	case maintenancewindow.FieldStartedAt:
+		return m.StartedAt()
+	case maintenancewindow.FieldEndedAt:
+		return m.EndedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MaintenanceWindowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case maintenancewindow.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case maintenancewindow.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case maintenancewindow.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case maintenancewindow.FieldReason:
+		return m.OldReason(ctx)
+	case maintenancewindow.FieldMigrate:
+		return m.OldMigrate(ctx)
+	case maintenancewindow.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case maintenancewindow.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case maintenancewindow.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case maintenancewindow.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceWindowMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case maintenancewindow.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case maintenancewindow.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case maintenancewindow.FieldEndsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
+		return nil
+	case maintenancewindow.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case maintenancewindow.FieldMigrate:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMigrate(v)
+		return nil
+	case maintenancewindow.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case maintenancewindow.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case maintenancewindow.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case maintenancewindow.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MaintenanceWindowMutation) AddedFields() []string {
+	var fields []string
+	if m.adddevice_id != nil {
+		fields = append(fields, maintenancewindow.FieldDeviceID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MaintenanceWindowMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case maintenancewindow.FieldDeviceID:
+		return m.AddedDeviceID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceWindowMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case maintenancewindow.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeviceID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MaintenanceWindowMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(maintenancewindow.FieldStartedAt) {
+		fields = append(fields, maintenancewindow.FieldStartedAt)
+	}
+	if m.FieldCleared(maintenancewindow.FieldEndedAt) {
+		fields = append(fields, maintenancewindow.FieldEndedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MaintenanceWindowMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MaintenanceWindowMutation) ClearField(name string) error {
+	switch name {
+	case maintenancewindow.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case maintenancewindow.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MaintenanceWindowMutation) ResetField(name string) error {
+	switch name {
+	case maintenancewindow.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case maintenancewindow.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case maintenancewindow.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case maintenancewindow.FieldReason:
+		m.ResetReason()
+		return nil
+	case maintenancewindow.FieldMigrate:
+		m.ResetMigrate()
+		return nil
+	case maintenancewindow.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case maintenancewindow.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case maintenancewindow.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case maintenancewindow.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MaintenanceWindowMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MaintenanceWindowMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MaintenanceWindowMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MaintenanceWindowMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MaintenanceWindowMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MaintenanceWindowMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MaintenanceWindowMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MaintenanceWindow unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MaintenanceWindowMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MaintenanceWindow edge %s", name)
+}
+
+// MenuMutation represents an operation that mutates the Menu nodes in the graph.
+type MenuMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	label           *string
+	icon            *string
+	url             *string
+	is_use          *bool
+	is_title        *bool
+	menu_order      *int
+	addmenu_order   *int
+	group           *int
+	addgroup        *int
+	clearedFields   map[string]struct{}
+	parent          *string
+	clearedparent   bool
+	children        map[string]struct{}
+	removedchildren map[string]struct{}
+	clearedchildren bool
+	done            bool
+	oldValue        func(context.Context) (*Menu, error)
+	predicates      []predicate.Menu
+}
+
+var _ ent.Mutation = (*MenuMutation)(nil)
+
+// menuOption allows management of the mutation configuration using functional options.
+type menuOption func(*MenuMutation)
+
+// newMenuMutation creates new mutation for the Menu entity.
+func newMenuMutation(c config, op Op, opts ...menuOption) *MenuMutation {
+	m := &MenuMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMenu,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMenuID sets the ID field of the mutation.
+func withMenuID(id string) menuOption {
+	return func(m *MenuMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Menu
+		)
+		m.oldValue = func(ctx context.Context) (*Menu, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Menu.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMenu sets the old Menu of the mutation.
+func withMenu(node *Menu) menuOption {
+	return func(m *MenuMutation) {
+		m.oldValue = func(context.Context) (*Menu, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MenuMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MenuMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Menu entities.
+func (m *MenuMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MenuMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MenuMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Menu.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLabel sets the "label" field.
+func (m *MenuMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *MenuMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *MenuMutation) ResetLabel() {
+	m.label = nil
+}
+
+// SetIcon sets the "icon" field.
+func (m *MenuMutation) SetIcon(s string) {
+	m.icon = &s
+}
+
+// Icon returns the value of the "icon" field in the mutation.
+func (m *MenuMutation) Icon() (r string, exists bool) {
+	v := m.icon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIcon returns the old "icon" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldIcon(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIcon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
+	}
+	return oldValue.Icon, nil
+}
+
+// ResetIcon resets all changes to the "icon" field.
+func (m *MenuMutation) ResetIcon() {
+	m.icon = nil
+}
+
+// SetURL sets the "url" field.
+func (m *MenuMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *MenuMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *MenuMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[menu.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *MenuMutation) URLCleared() bool {
+	_, ok := m.clearedFields[menu.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *MenuMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, menu.FieldURL)
+}
+
+// SetIsUse sets the "is_use" field.
+func (m *MenuMutation) SetIsUse(b bool) {
+	m.is_use = &b
+}
+
+// IsUse returns the value of the "is_use" field in the mutation.
+func (m *MenuMutation) IsUse() (r bool, exists bool) {
+	v := m.is_use
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsUse returns the old "is_use" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldIsUse(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsUse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsUse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsUse: %w", err)
+	}
+	return oldValue.IsUse, nil
+}
+
+// ClearIsUse clears the value of the "is_use" field.
+func (m *MenuMutation) ClearIsUse() {
+	m.is_use = nil
+	m.clearedFields[menu.FieldIsUse] = struct{}{}
+}
+
+// IsUseCleared returns if the "is_use" field was cleared in this mutation.
+func (m *MenuMutation) IsUseCleared() bool {
+	_, ok := m.clearedFields[menu.FieldIsUse]
+	return ok
+}
+
+// ResetIsUse resets all changes to the "is_use" field.
+func (m *MenuMutation) ResetIsUse() {
+	m.is_use = nil
+	delete(m.clearedFields, menu.FieldIsUse)
+}
+
+// SetIsTitle sets the "is_title" field.
+func (m *MenuMutation) SetIsTitle(b bool) {
+	m.is_title = &b
+}
+
+// IsTitle returns the value of the "is_title" field in the mutation.
+func (m *MenuMutation) IsTitle() (r bool, exists bool) {
+	v := m.is_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsTitle returns the old "is_title" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldIsTitle(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsTitle: %w", err)
+	}
+	return oldValue.IsTitle, nil
+}
+
+// ClearIsTitle clears the value of the "is_title" field.
+func (m *MenuMutation) ClearIsTitle() {
+	m.is_title = nil
+	m.clearedFields[menu.FieldIsTitle] = struct{}{}
+}
+
+// IsTitleCleared returns if the "is_title" field was cleared in this mutation.
+func (m *MenuMutation) IsTitleCleared() bool {
+	_, ok := m.clearedFields[menu.FieldIsTitle]
+	return ok
+}
+
+// ResetIsTitle resets all changes to the "is_title" field.
+func (m *MenuMutation) ResetIsTitle() {
+	m.is_title = nil
+	delete(m.clearedFields, menu.FieldIsTitle)
+}
+
+// SetMenuOrder sets the "menu_order" field.
+func (m *MenuMutation) SetMenuOrder(i int) {
+	m.menu_order = &i
+	m.addmenu_order = nil
+}
+
+// MenuOrder returns the value of the "menu_order" field in the mutation.
+func (m *MenuMutation) MenuOrder() (r int, exists bool) {
+	v := m.menu_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMenuOrder returns the old "menu_order" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldMenuOrder(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMenuOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMenuOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMenuOrder: %w", err)
+	}
+	return oldValue.MenuOrder, nil
+}
+
+// AddMenuOrder adds i to the "menu_order" field.
+func (m *MenuMutation) AddMenuOrder(i int) {
+	if m.addmenu_order != nil {
+		*m.addmenu_order += i
+	} else {
+		m.addmenu_order = &i
+	}
+}
+
+// AddedMenuOrder returns the value that was added to the "menu_order" field in this mutation.
+func (m *MenuMutation) AddedMenuOrder() (r int, exists bool) {
+	v := m.addmenu_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMenuOrder resets all changes to the "menu_order" field.
+func (m *MenuMutation) ResetMenuOrder() {
+	m.menu_order = nil
+	m.addmenu_order = nil
+}
+
+// SetParentKey sets the "parent_key" field.
+func (m *MenuMutation) SetParentKey(s string) {
+	m.parent = &s
+}
+
+// ParentKey returns the value of the "parent_key" field in the mutation.
+func (m *MenuMutation) ParentKey() (r string, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentKey returns the old "parent_key" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldParentKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentKey: %w", err)
+	}
+	return oldValue.ParentKey, nil
+}
+
+// ClearParentKey clears the value of the "parent_key" field.
+func (m *MenuMutation) ClearParentKey() {
+	m.parent = nil
+	m.clearedFields[menu.FieldParentKey] = struct{}{}
+}
+
+// ParentKeyCleared returns if the "parent_key" field was cleared in this mutation.
+func (m *MenuMutation) ParentKeyCleared() bool {
+	_, ok := m.clearedFields[menu.FieldParentKey]
+	return ok
+}
+
+// ResetParentKey resets all changes to the "parent_key" field.
+func (m *MenuMutation) ResetParentKey() {
+	m.parent = nil
+	delete(m.clearedFields, menu.FieldParentKey)
+}
+
+// SetGroup sets the "group" field.
+func (m *MenuMutation) SetGroup(i int) {
+	m.group = &i
+	m.addgroup = nil
+}
+
+// Group returns the value of the "group" field in the mutation.
+func (m *MenuMutation) Group() (r int, exists bool) {
+	v := m.group
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroup returns the old "group" field's value of the Menu entity.
+// If the Menu object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MenuMutation) OldGroup(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroup: %w", err)
+	}
+	return oldValue.Group, nil
+}
+
+// AddGroup adds i to the "group" field.
+func (m *MenuMutation) AddGroup(i int) {
+	if m.addgroup != nil {
+		*m.addgroup += i
+	} else {
+		m.addgroup = &i
+	}
+}
+
+// AddedGroup returns the value that was added to the "group" field in this mutation.
+func (m *MenuMutation) AddedGroup() (r int, exists bool) {
+	v := m.addgroup
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGroup resets all changes to the "group" field.
+func (m *MenuMutation) ResetGroup() {
+	m.group = nil
+	m.addgroup = nil
+}
+
+// SetParentID sets the "parent" edge to the Menu entity by id.
+func (m *MenuMutation) SetParentID(id string) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Menu entity.
+func (m *MenuMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[menu.FieldParentKey] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Menu entity was cleared.
+func (m *MenuMutation) ParentCleared() bool {
+	return m.ParentKeyCleared() || m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *MenuMutation) ParentID() (id string, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *MenuMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *MenuMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Menu entity by ids.
+func (m *MenuMutation) AddChildIDs(ids ...string) {
+	if m.children == nil {
+		m.children = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Menu entity.
+func (m *MenuMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Menu entity was cleared.
+func (m *MenuMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Menu entity by IDs.
+func (m *MenuMutation) RemoveChildIDs(ids ...string) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Menu entity.
+func (m *MenuMutation) RemovedChildrenIDs() (ids []string) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *MenuMutation) ChildrenIDs() (ids []string) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *MenuMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the MenuMutation builder.
+func (m *MenuMutation) Where(ps ...predicate.Menu) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MenuMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MenuMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Menu, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MenuMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MenuMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Menu).
+func (m *MenuMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MenuMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.label != nil {
+		fields = append(fields, menu.FieldLabel)
+	}
+	if m.icon != nil {
+		fields = append(fields, menu.FieldIcon)
+	}
+	if m.url != nil {
+		fields = append(fields, menu.FieldURL)
+	}
+	if m.is_use != nil {
+		fields = append(fields, menu.FieldIsUse)
+	}
+	if m.is_title != nil {
+		fields = append(fields, menu.FieldIsTitle)
+	}
+	if m.menu_order != nil {
+		fields = append(fields, menu.FieldMenuOrder)
+	}
+	if m.parent != nil {
+		fields = append(fields, menu.FieldParentKey)
+	}
+	if m.group != nil {
+		fields = append(fields, menu.FieldGroup)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MenuMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case menu.FieldLabel:
+		return m.Label()
+	case menu.FieldIcon:
+		return m.Icon()
+	case menu.FieldURL:
+		return m.URL()
+	case menu.FieldIsUse:
+		return m.IsUse()
+	case menu.FieldIsTitle:
+		return m.IsTitle()
+	case menu.FieldMenuOrder:
+		return m.MenuOrder()
+	case menu.FieldParentKey:
+		return m.ParentKey()
+	case menu.FieldGroup:
+		return m.Group()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MenuMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case menu.FieldLabel:
+		return m.OldLabel(ctx)
+	case menu.FieldIcon:
+		return m.OldIcon(ctx)
+	case menu.FieldURL:
+		return m.OldURL(ctx)
+	case menu.FieldIsUse:
+		return m.OldIsUse(ctx)
+	case menu.FieldIsTitle:
+		return m.OldIsTitle(ctx)
+	case menu.FieldMenuOrder:
+		return m.OldMenuOrder(ctx)
+	case menu.FieldParentKey:
+		return m.OldParentKey(ctx)
+	case menu.FieldGroup:
+		return m.OldGroup(ctx)
+	}
+	return nil, fmt.Errorf("unknown Menu field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MenuMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case menu.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case menu.FieldIcon:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIcon(v)
+		return nil
+	case menu.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case menu.FieldIsUse:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsUse(v)
+		return nil
+	case menu.FieldIsTitle:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsTitle(v)
+		return nil
+	case menu.FieldMenuOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMenuOrder(v)
+		return nil
+	case menu.FieldParentKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentKey(v)
+		return nil
+	case menu.FieldGroup:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroup(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Menu field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MenuMutation) AddedFields() []string {
+	var fields []string
+	if m.addmenu_order != nil {
+		fields = append(fields, menu.FieldMenuOrder)
+	}
+	if m.addgroup != nil {
+		fields = append(fields, menu.FieldGroup)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MenuMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case menu.FieldMenuOrder:
+		return m.AddedMenuOrder()
+	case menu.FieldGroup:
+		return m.AddedGroup()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MenuMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case menu.FieldMenuOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMenuOrder(v)
+		return nil
+	case menu.FieldGroup:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGroup(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Menu numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MenuMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(menu.FieldURL) {
+		fields = append(fields, menu.FieldURL)
+	}
+	if m.FieldCleared(menu.FieldIsUse) {
+		fields = append(fields, menu.FieldIsUse)
+	}
+	if m.FieldCleared(menu.FieldIsTitle) {
+		fields = append(fields, menu.FieldIsTitle)
+	}
+	if m.FieldCleared(menu.FieldParentKey) {
+		fields = append(fields, menu.FieldParentKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MenuMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MenuMutation) ClearField(name string) error {
+	switch name {
+	case menu.FieldURL:
+		m.ClearURL()
+		return nil
+	case menu.FieldIsUse:
+		m.ClearIsUse()
+		return nil
+	case menu.FieldIsTitle:
+		m.ClearIsTitle()
+		return nil
+	case menu.FieldParentKey:
+		m.ClearParentKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Menu nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MenuMutation) ResetField(name string) error {
+	switch name {
+	case menu.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case menu.FieldIcon:
+		m.ResetIcon()
+		return nil
+	case menu.FieldURL:
+		m.ResetURL()
+		return nil
+	case menu.FieldIsUse:
+		m.ResetIsUse()
+		return nil
+	case menu.FieldIsTitle:
+		m.ResetIsTitle()
+		return nil
+	case menu.FieldMenuOrder:
+		m.ResetMenuOrder()
+		return nil
+	case menu.FieldParentKey:
+		m.ResetParentKey()
+		return nil
+	case menu.FieldGroup:
+		m.ResetGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown Menu field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MenuMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.parent != nil {
+		edges = append(edges, menu.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, menu.EdgeChildren)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MenuMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case menu.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case menu.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MenuMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedchildren != nil {
+		edges = append(edges, menu.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MenuMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case menu.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MenuMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedparent {
+		edges = append(edges, menu.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, menu.EdgeChildren)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MenuMutation) EdgeCleared(name string) bool {
+	switch name {
+	case menu.EdgeParent:
+		return m.clearedparent
+	case menu.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MenuMutation) ClearEdge(name string) error {
+	switch name {
+	case menu.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Menu unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MenuMutation) ResetEdge(name string) error {
+	switch name {
+	case menu.EdgeParent:
+		m.ResetParent()
+		return nil
+	case menu.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Menu edge %s", name)
+}
+
+// ModelingMutation represents an operation that mutates the Modeling nodes in the graph.
+type ModelingMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	local_id                *int
+	addlocal_id             *int
+	parent_id               *int
+	addparent_id            *int
+	parent_local_id         *int
+	addparent_local_id      *int
+	dataset_id              *int
+	adddataset_id           *int
+	params                  *[]string
+	appendparams            []string
+	dataset_stat            *[]string
+	appenddataset_stat      []string
+	modeling_type           *string
+	modeling_step           *string
+	performance             *[]string
+	appendperformance       []string
+	progress                *float64
+	addprogress             *float64
+	priority                *int
+	addpriority             *int
+	username                *string
+	queued_at               *time.Time
+	queue_position          *int
+	addqueue_position       *int
+	executor                *string
+	job_id                  *string
+	job_type                *string
+	attempts                *int
+	addattempts             *int
+	next_attempt_at         *time.Time
+	fail_reason             *string
+	pipeline_stage          *int
+	addpipeline_stage       *int
+	search_trial            *int
+	addsearch_trial         *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	started_at              *time.Time
+	clearedFields           map[string]struct{}
+	task                    *int
+	clearedtask             bool
+	modeling_details        map[int]struct{}
+	removedmodeling_details map[int]struct{}
+	clearedmodeling_details bool
+	modeling_models         map[int]struct{}
+	removedmodeling_models  map[int]struct{}
+	clearedmodeling_models  bool
+	trials                  map[int]struct{}
+	removedtrials           map[int]struct{}
+	clearedtrials           bool
+	done                    bool
+	oldValue                func(context.Context) (*Modeling, error)
+	predicates              []predicate.Modeling
+}
+
+var _ ent.Mutation = (*ModelingMutation)(nil)
+
+// modelingOption allows management of the mutation configuration using functional options.
+type modelingOption func(*ModelingMutation)
+
+// newModelingMutation creates new mutation for the Modeling entity.
+func newModelingMutation(c config, op Op, opts ...modelingOption) *ModelingMutation {
+	m := &ModelingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModeling,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModelingID sets the ID field of the mutation.
+func withModelingID(id int) modelingOption {
+	return func(m *ModelingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Modeling
+		)
+		m.oldValue = func(ctx context.Context) (*Modeling, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Modeling.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModeling sets the old Modeling of the mutation.
+func withModeling(node *Modeling) modelingOption {
+	return func(m *ModelingMutation) {
+		m.oldValue = func(context.Context) (*Modeling, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModelingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModelingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Modeling entities.
+func (m *ModelingMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModelingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModelingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Modeling.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLocalID sets the "local_id" field.
+func (m *ModelingMutation) SetLocalID(i int) {
+	m.local_id = &i
+	m.addlocal_id = nil
+}
+
+// LocalID returns the value of the "local_id" field in the mutation.
+func (m *ModelingMutation) LocalID() (r int, exists bool) {
+	v := m.local_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocalID returns the old "local_id" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldLocalID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocalID: %w", err)
+	}
+	return oldValue.LocalID, nil
+}
+
+// AddLocalID adds i to the "local_id" field.
+func (m *ModelingMutation) AddLocalID(i int) {
+	if m.addlocal_id != nil {
+		*m.addlocal_id += i
+	} else {
+		m.addlocal_id = &i
+	}
+}
+
+// AddedLocalID returns the value that was added to the "local_id" field in this mutation.
+func (m *ModelingMutation) AddedLocalID() (r int, exists bool) {
+	v := m.addlocal_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLocalID resets all changes to the "local_id" field.
+func (m *ModelingMutation) ResetLocalID() {
+	m.local_id = nil
+	m.addlocal_id = nil
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *ModelingMutation) SetTaskID(i int) {
+	m.task = &i
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *ModelingMutation) TaskID() (r int, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldTaskID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (m *ModelingMutation) ClearTaskID() {
+	m.task = nil
+	m.clearedFields[modeling.FieldTaskID] = struct{}{}
+}
+
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *ModelingMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[modeling.FieldTaskID]
+	return ok
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *ModelingMutation) ResetTaskID() {
+	m.task = nil
+	delete(m.clearedFields, modeling.FieldTaskID)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *ModelingMutation) SetParentID(i int) {
+	m.parent_id = &i
+	m.addparent_id = nil
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *ModelingMutation) ParentID() (r int, exists bool) {
+	v := m.parent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldParentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// AddParentID adds i to the "parent_id" field.
+func (m *ModelingMutation) AddParentID(i int) {
+	if m.addparent_id != nil {
+		*m.addparent_id += i
+	} else {
+		m.addparent_id = &i
+	}
+}
+
+// AddedParentID returns the value that was added to the "parent_id" field in this mutation.
+func (m *ModelingMutation) AddedParentID() (r int, exists bool) {
+	v := m.addparent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *ModelingMutation) ClearParentID() {
+	m.parent_id = nil
+	m.addparent_id = nil
+	m.clearedFields[modeling.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *ModelingMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[modeling.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *ModelingMutation) ResetParentID() {
+	m.parent_id = nil
+	m.addparent_id = nil
+	delete(m.clearedFields, modeling.FieldParentID)
+}
+
+// SetParentLocalID sets the "parent_local_id" field.
+func (m *ModelingMutation) SetParentLocalID(i int) {
+	m.parent_local_id = &i
+	m.addparent_local_id = nil
+}
+
+// ParentLocalID returns the value of the "parent_local_id" field in the mutation.
+func (m *ModelingMutation) ParentLocalID() (r int, exists bool) {
+	v := m.parent_local_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentLocalID returns the old "parent_local_id" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldParentLocalID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentLocalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentLocalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentLocalID: %w", err)
+	}
+	return oldValue.ParentLocalID, nil
+}
+
+// AddParentLocalID adds i to the "parent_local_id" field.
+func (m *ModelingMutation) AddParentLocalID(i int) {
+	if m.addparent_local_id != nil {
+		*m.addparent_local_id += i
+	} else {
+		m.addparent_local_id = &i
+	}
+}
+
+// AddedParentLocalID returns the value that was added to the "parent_local_id" field in this mutation.
+func (m *ModelingMutation) AddedParentLocalID() (r int, exists bool) {
+	v := m.addparent_local_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearParentLocalID clears the value of the "parent_local_id" field.
+func (m *ModelingMutation) ClearParentLocalID() {
+	m.parent_local_id = nil
+	m.addparent_local_id = nil
+	m.clearedFields[modeling.FieldParentLocalID] = struct{}{}
+}
+
+// ParentLocalIDCleared returns if the "parent_local_id" field was cleared in this mutation.
+func (m *ModelingMutation) ParentLocalIDCleared() bool {
+	_, ok := m.clearedFields[modeling.FieldParentLocalID]
+	return ok
+}
+
+// ResetParentLocalID resets all changes to the "parent_local_id" field.
+func (m *ModelingMutation) ResetParentLocalID() {
+	m.parent_local_id = nil
+	m.addparent_local_id = nil
+	delete(m.clearedFields, modeling.FieldParentLocalID)
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (m *ModelingMutation) SetDatasetID(i int) {
+	m.dataset_id = &i
+	m.adddataset_id = nil
+}
+
+// DatasetID returns the value of the "dataset_id" field in the mutation.
+func (m *ModelingMutation) DatasetID() (r int, exists bool) {
+	v := m.dataset_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDatasetID returns the old "dataset_id" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldDatasetID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDatasetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDatasetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDatasetID: %w", err)
+	}
+	return oldValue.DatasetID, nil
+}
+
+// AddDatasetID adds i to the "dataset_id" field.
+func (m *ModelingMutation) AddDatasetID(i int) {
+	if m.adddataset_id != nil {
+		*m.adddataset_id += i
+	} else {
+		m.adddataset_id = &i
+	}
+}
+
+// AddedDatasetID returns the value that was added to the "dataset_id" field in this mutation.
+func (m *ModelingMutation) AddedDatasetID() (r int, exists bool) {
+	v := m.adddataset_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDatasetID clears the value of the "dataset_id" field.
+func (m *ModelingMutation) ClearDatasetID() {
+	m.dataset_id = nil
+	m.adddataset_id = nil
+	m.clearedFields[modeling.FieldDatasetID] = struct{}{}
+}
+
+// DatasetIDCleared returns if the "dataset_id" field was cleared in this mutation.
+func (m *ModelingMutation) DatasetIDCleared() bool {
+	_, ok := m.clearedFields[modeling.FieldDatasetID]
+	return ok
+}
+
+// ResetDatasetID resets all changes to the "dataset_id" field.
+func (m *ModelingMutation) ResetDatasetID() {
+	m.dataset_id = nil
+	m.adddataset_id = nil
+	delete(m.clearedFields, modeling.FieldDatasetID)
+}
+
+// SetParams sets the "params" field.
+func (m *ModelingMutation) SetParams(s []string) {
+	m.params = &s
+	m.appendparams = nil
+}
+
+// Params returns the value of the "params" field in the mutation.
+func (m *ModelingMutation) Params() (r []string, exists bool) {
+	v := m.params
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParams returns the old "params" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldParams(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParams: %w", err)
+	}
+	return oldValue.Params, nil
+}
+
+// AppendParams adds s to the "params" field.
+func (m *ModelingMutation) AppendParams(s []string) {
+	m.appendparams = append(m.appendparams, s...)
+}
+
+// AppendedParams returns the list of values that were appended to the "params" field in this mutation.
+func (m *ModelingMutation) AppendedParams() ([]string, bool) {
+	if len(m.appendparams) == 0 {
+		return nil, false
+	}
+	return m.appendparams, true
+}
+
+// ResetParams resets all changes to the "params" field.
+func (m *ModelingMutation) ResetParams() {
+	m.params = nil
+	m.appendparams = nil
+}
+
+// SetDatasetStat sets the "dataset_stat" field.
+func (m *ModelingMutation) SetDatasetStat(s []string) {
+	m.dataset_stat = &s
+	m.appenddataset_stat = nil
+}
+
+// DatasetStat returns the value of the "dataset_stat" field in the mutation.
+func (m *ModelingMutation) DatasetStat() (r []string, exists bool) {
+	v := m.dataset_stat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDatasetStat returns the old "dataset_stat" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldDatasetStat(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDatasetStat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDatasetStat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDatasetStat: %w", err)
+	}
+	return oldValue.DatasetStat, nil
+}
+
+// AppendDatasetStat adds s to the "dataset_stat" field.
+func (m *ModelingMutation) AppendDatasetStat(s []string) {
+	m.appenddataset_stat = append(m.appenddataset_stat, s...)
+}
+
+// AppendedDatasetStat returns the list of values that were appended to the "dataset_stat" field in this mutation.
+func (m *ModelingMutation) AppendedDatasetStat() ([]string, bool) {
+	if len(m.appenddataset_stat) == 0 {
+		return nil, false
+	}
+	return m.appenddataset_stat, true
+}
+
+// ResetDatasetStat resets all changes to the "dataset_stat" field.
+func (m *ModelingMutation) ResetDatasetStat() {
+	m.dataset_stat = nil
+	m.appenddataset_stat = nil
+}
+
+// SetModelingType sets the "modeling_type" field.
+func (m *ModelingMutation) SetModelingType(s string) {
+	m.modeling_type = &s
+}
+
+// ModelingType returns the value of the "modeling_type" field in the mutation.
+func (m *ModelingMutation) ModelingType() (r string, exists bool) {
+	v := m.modeling_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelingType returns the old "modeling_type" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldModelingType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelingType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelingType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelingType: %w", err)
+	}
+	return oldValue.ModelingType, nil
+}
+
+// ResetModelingType resets all changes to the "modeling_type" field.
+func (m *ModelingMutation) ResetModelingType() {
+	m.modeling_type = nil
+}
+
+// SetModelingStep sets the "modeling_step" field.
+func (m *ModelingMutation) SetModelingStep(s string) {
+	m.modeling_step = &s
+}
+
+// ModelingStep returns the value of the "modeling_step" field in the mutation.
+func (m *ModelingMutation) ModelingStep() (r string, exists bool) {
+	v := m.modeling_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelingStep returns the old "modeling_step" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldModelingStep(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelingStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelingStep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelingStep: %w", err)
+	}
+	return oldValue.ModelingStep, nil
+}
+
+// ResetModelingStep resets all changes to the "modeling_step" field.
+func (m *ModelingMutation) ResetModelingStep() {
+	m.modeling_step = nil
+}
+
+// SetPerformance sets the "performance" field.
+func (m *ModelingMutation) SetPerformance(s []string) {
+	m.performance = &s
+	m.appendperformance = nil
+}
+
+// Performance returns the value of the "performance" field in the mutation.
+func (m *ModelingMutation) Performance() (r []string, exists bool) {
+	v := m.performance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPerformance returns the old "performance" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldPerformance(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPerformance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPerformance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPerformance: %w", err)
+	}
+	return oldValue.Performance, nil
+}
+
+// AppendPerformance adds s to the "performance" field.
+func (m *ModelingMutation) AppendPerformance(s []string) {
+	m.appendperformance = append(m.appendperformance, s...)
+}
+
+// AppendedPerformance returns the list of values that were appended to the "performance" field in this mutation.
+func (m *ModelingMutation) AppendedPerformance() ([]string, bool) {
+	if len(m.appendperformance) == 0 {
+		return nil, false
+	}
+	return m.appendperformance, true
+}
+
+// ResetPerformance resets all changes to the "performance" field.
+func (m *ModelingMutation) ResetPerformance() {
+	m.performance = nil
+	m.appendperformance = nil
+}
+
+// SetProgress sets the "progress" field.
+func (m *ModelingMutation) SetProgress(f float64) {
+	m.progress = &f
+	m.addprogress = nil
+}
+
+// Progress returns the value of the "progress" field in the mutation.
+func (m *ModelingMutation) Progress() (r float64, exists bool) {
+	v := m.progress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProgress returns the old "progress" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldProgress(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProgress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
+	}
+	return oldValue.Progress, nil
+}
+
+// AddProgress adds f to the "progress" field.
+func (m *ModelingMutation) AddProgress(f float64) {
+	if m.addprogress != nil {
+		*m.addprogress += f
+	} else {
+		m.addprogress = &f
+	}
+}
+
+// AddedProgress returns the value that was added to the "progress" field in this mutation.
+func (m *ModelingMutation) AddedProgress() (r float64, exists bool) {
+	v := m.addprogress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProgress resets all changes to the "progress" field.
+func (m *ModelingMutation) ResetProgress() {
+	m.progress = nil
+	m.addprogress = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *ModelingMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *ModelingMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *ModelingMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *ModelingMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *ModelingMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *ModelingMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *ModelingMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *ModelingMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetQueuedAt sets the "queued_at" field.
+func (m *ModelingMutation) SetQueuedAt(t time.Time) {
+	m.queued_at = &t
+}
+
+// QueuedAt returns the value of the "queued_at" field in the mutation.
+func (m *ModelingMutation) QueuedAt() (r time.Time, exists bool) {
+	v := m.queued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQueuedAt returns the old "queued_at" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldQueuedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQueuedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQueuedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueuedAt: %w", err)
+	}
+	return oldValue.QueuedAt, nil
+}
+
+// ResetQueuedAt resets all changes to the "queued_at" field.
+func (m *ModelingMutation) ResetQueuedAt() {
+	m.queued_at = nil
+}
+
+// SetQueuePosition sets the "queue_position" field.
+func (m *ModelingMutation) SetQueuePosition(i int) {
+	m.queue_position = &i
+	m.addqueue_position = nil
+}
+
+// QueuePosition returns the value of the "queue_position" field in the mutation.
+func (m *ModelingMutation) QueuePosition() (r int, exists bool) {
+	v := m.queue_position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQueuePosition returns the old "queue_position" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldQueuePosition(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQueuePosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQueuePosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQueuePosition: %w", err)
+	}
+	return oldValue.QueuePosition, nil
+}
+
+// AddQueuePosition adds i to the "queue_position" field.
+func (m *ModelingMutation) AddQueuePosition(i int) {
+	if m.addqueue_position != nil {
+		*m.addqueue_position += i
+	} else {
+		m.addqueue_position = &i
+	}
+}
+
+// AddedQueuePosition returns the value that was added to the "queue_position" field in this mutation.
+func (m *ModelingMutation) AddedQueuePosition() (r int, exists bool) {
+	v := m.addqueue_position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQueuePosition clears the value of the "queue_position" field.
+func (m *ModelingMutation) ClearQueuePosition() {
+	m.queue_position = nil
+	m.addqueue_position = nil
+	m.clearedFields[modeling.FieldQueuePosition] = struct{}{}
+}
+
+// QueuePositionCleared returns if the "queue_position" field was cleared in this mutation.
+func (m *ModelingMutation) QueuePositionCleared() bool {
+	_, ok := m.clearedFields[modeling.FieldQueuePosition]
+	return ok
+}
+
+// ResetQueuePosition resets all changes to the "queue_position" field.
+func (m *ModelingMutation) ResetQueuePosition() {
+	m.queue_position = nil
+	m.addqueue_position = nil
+	delete(m.clearedFields, modeling.FieldQueuePosition)
+}
+
+// SetExecutor sets the "executor" field.
+func (m *ModelingMutation) SetExecutor(s string) {
+	m.executor = &s
+}
+
+// Executor returns the value of the "executor" field in the mutation.
+func (m *ModelingMutation) Executor() (r string, exists bool) {
+	v := m.executor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExecutor returns the old "executor" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldExecutor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExecutor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExecutor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExecutor: %w", err)
+	}
+	return oldValue.Executor, nil
+}
+
+// ResetExecutor resets all changes to the "executor" field.
+func (m *ModelingMutation) ResetExecutor() {
+	m.executor = nil
+}
+
+// SetJobID sets the "job_id" field.
+func (m *ModelingMutation) SetJobID(s string) {
+	m.job_id = &s
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *ModelingMutation) JobID() (r string, exists bool) {
+	v := m.job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldJobID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
+}
+
+// ResetJobID resets all changes to the "job_id" field.
+func (m *ModelingMutation) ResetJobID() {
+	m.job_id = nil
+}
+
+// SetJobType sets the "job_type" field.
+func (m *ModelingMutation) SetJobType(s string) {
+	m.job_type = &s
+}
+
+// JobType returns the value of the "job_type" field in the mutation.
+func (m *ModelingMutation) JobType() (r string, exists bool) {
+	v := m.job_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobType returns the old "job_type" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldJobType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobType: %w", err)
+	}
+	return oldValue.JobType, nil
+}
+
+// ResetJobType resets all changes to the "job_type" field.
+func (m *ModelingMutation) ResetJobType() {
+	m.job_type = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *ModelingMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *ModelingMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *ModelingMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *ModelingMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *ModelingMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *ModelingMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *ModelingMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *ModelingMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[modeling.FieldNextAttemptAt] = struct{}{}
+}
+
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *ModelingMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[modeling.FieldNextAttemptAt]
+	return ok
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *ModelingMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, modeling.FieldNextAttemptAt)
+}
+
+// SetFailReason sets the "fail_reason" field.
+func (m *ModelingMutation) SetFailReason(s string) {
+	m.fail_reason = &s
+}
+
+// FailReason returns the value of the "fail_reason" field in the mutation.
+func (m *ModelingMutation) FailReason() (r string, exists bool) {
+	v := m.fail_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailReason returns the old "fail_reason" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldFailReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailReason: %w", err)
+	}
+	return oldValue.FailReason, nil
+}
+
+// ResetFailReason resets all changes to the "fail_reason" field.
+func (m *ModelingMutation) ResetFailReason() {
+	m.fail_reason = nil
+}
+
+// SetPipelineStage sets the "pipeline_stage" field.
+func (m *ModelingMutation) SetPipelineStage(i int) {
+	m.pipeline_stage = &i
+	m.addpipeline_stage = nil
+}
+
+// PipelineStage returns the value of the "pipeline_stage" field in the mutation.
+func (m *ModelingMutation) PipelineStage() (r int, exists bool) {
+	v := m.pipeline_stage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPipelineStage returns the old "pipeline_stage" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldPipelineStage(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPipelineStage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPipelineStage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPipelineStage: %w", err)
+	}
+	return oldValue.PipelineStage, nil
+}
+
+// AddPipelineStage adds i to the "pipeline_stage" field.
+func (m *ModelingMutation) AddPipelineStage(i int) {
+	if m.addpipeline_stage != nil {
+		*m.addpipeline_stage += i
+	} else {
+		m.addpipeline_stage = &i
+	}
+}
+
+// AddedPipelineStage returns the value that was added to the "pipeline_stage" field in this mutation.
+func (m *ModelingMutation) AddedPipelineStage() (r int, exists bool) {
+	v := m.addpipeline_stage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPipelineStage resets all changes to the "pipeline_stage" field.
+func (m *ModelingMutation) ResetPipelineStage() {
+	m.pipeline_stage = nil
+	m.addpipeline_stage = nil
+}
+
+// SetSearchTrial sets the "search_trial" field.
+func (m *ModelingMutation) SetSearchTrial(i int) {
+	m.search_trial = &i
+	m.addsearch_trial = nil
+}
+
+// SearchTrial returns the value of the "search_trial" field in the mutation.
+func (m *ModelingMutation) SearchTrial() (r int, exists bool) {
+	v := m.search_trial
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchTrial returns the old "search_trial" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldSearchTrial(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchTrial is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchTrial requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchTrial: %w", err)
+	}
+	return oldValue.SearchTrial, nil
+}
+
+// AddSearchTrial adds i to the "search_trial" field.
+func (m *ModelingMutation) AddSearchTrial(i int) {
+	if m.addsearch_trial != nil {
+		*m.addsearch_trial += i
+	} else {
+		m.addsearch_trial = &i
+	}
+}
+
+// AddedSearchTrial returns the value that was added to the "search_trial" field in this mutation.
+func (m *ModelingMutation) AddedSearchTrial() (r int, exists bool) {
+	v := m.addsearch_trial
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSearchTrial resets all changes to the "search_trial" field.
+func (m *ModelingMutation) ResetSearchTrial() {
+	m.search_trial = nil
+	m.addsearch_trial = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModelingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModelingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModelingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ModelingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ModelingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ModelingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *ModelingMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *ModelingMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Modeling entity.
+// If the Modeling object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *ModelingMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[modeling.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *ModelingMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[modeling.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *ModelingMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, modeling.FieldStartedAt)
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *ModelingMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[modeling.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *ModelingMutation) TaskCleared() bool {
+	return m.TaskIDCleared() || m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *ModelingMutation) TaskIDs() (ids []int) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *ModelingMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// AddModelingDetailIDs adds the "modeling_details" edge to the ModelingDetails entity by ids.
+func (m *ModelingMutation) AddModelingDetailIDs(ids ...int) {
+	if m.modeling_details == nil {
+		m.modeling_details = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.modeling_details[ids[i]] = struct{}{}
+	}
+}
+
+// ClearModelingDetails clears the "modeling_details" edge to the ModelingDetails entity.
+func (m *ModelingMutation) ClearModelingDetails() {
+	m.clearedmodeling_details = true
+}
+
+// ModelingDetailsCleared reports if the "modeling_details" edge to the ModelingDetails entity was cleared.
+func (m *ModelingMutation) ModelingDetailsCleared() bool {
+	return m.clearedmodeling_details
+}
+
+// RemoveModelingDetailIDs removes the "modeling_details" edge to the ModelingDetails entity by IDs.
+func (m *ModelingMutation) RemoveModelingDetailIDs(ids ...int) {
+	if m.removedmodeling_details == nil {
+		m.removedmodeling_details = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.modeling_details, ids[i])
+		m.removedmodeling_details[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedModelingDetails returns the removed IDs of the "modeling_details" edge to the ModelingDetails entity.
+func (m *ModelingMutation) RemovedModelingDetailsIDs() (ids []int) {
+	for id := range m.removedmodeling_details {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ModelingDetailsIDs returns the "modeling_details" edge IDs in the mutation.
+func (m *ModelingMutation) ModelingDetailsIDs() (ids []int) {
+	for id := range m.modeling_details {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetModelingDetails resets all changes to the "modeling_details" edge.
+func (m *ModelingMutation) ResetModelingDetails() {
+	m.modeling_details = nil
+	m.clearedmodeling_details = false
+	m.removedmodeling_details = nil
+}
+
+// AddModelingModelIDs adds the "modeling_models" edge to the ModelingModels entity by ids.
+func (m *ModelingMutation) AddModelingModelIDs(ids ...int) {
+	if m.modeling_models == nil {
+		m.modeling_models = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.modeling_models[ids[i]] = struct{}{}
+	}
+}
+
+// ClearModelingModels clears the "modeling_models" edge to the ModelingModels entity.
+func (m *ModelingMutation) ClearModelingModels() {
+	m.clearedmodeling_models = true
+}
+
+// ModelingModelsCleared reports if the "modeling_models" edge to the ModelingModels entity was cleared.
+func (m *ModelingMutation) ModelingModelsCleared() bool {
+	return m.clearedmodeling_models
+}
+
+// RemoveModelingModelIDs removes the "modeling_models" edge to the ModelingModels entity by IDs.
+func (m *ModelingMutation) RemoveModelingModelIDs(ids ...int) {
+	if m.removedmodeling_models == nil {
+		m.removedmodeling_models = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.modeling_models, ids[i])
+		m.removedmodeling_models[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedModelingModels returns the removed IDs of the "modeling_models" edge to the ModelingModels entity.
+func (m *ModelingMutation) RemovedModelingModelsIDs() (ids []int) {
+	for id := range m.removedmodeling_models {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ModelingModelsIDs returns the "modeling_models" edge IDs in the mutation.
+func (m *ModelingMutation) ModelingModelsIDs() (ids []int) {
+	for id := range m.modeling_models {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetModelingModels resets all changes to the "modeling_models" edge.
+func (m *ModelingMutation) ResetModelingModels() {
+	m.modeling_models = nil
+	m.clearedmodeling_models = false
+	m.removedmodeling_models = nil
+}
+
+// AddTrialIDs adds the "trials" edge to the Trial entity by ids.
+func (m *ModelingMutation) AddTrialIDs(ids ...int) {
+	if m.trials == nil {
+		m.trials = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.trials[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTrials clears the "trials" edge to the Trial entity.
+func (m *ModelingMutation) ClearTrials() {
+	m.clearedtrials = true
+}
+
+// TrialsCleared reports if the "trials" edge to the Trial entity was cleared.
+func (m *ModelingMutation) TrialsCleared() bool {
+	return m.clearedtrials
+}
+
+// RemoveTrialIDs removes the "trials" edge to the Trial entity by IDs.
+func (m *ModelingMutation) RemoveTrialIDs(ids ...int) {
+	if m.removedtrials == nil {
+		m.removedtrials = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.trials, ids[i])
+		m.removedtrials[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTrials returns the removed IDs of the "trials" edge to the Trial entity.
+func (m *ModelingMutation) RemovedTrialsIDs() (ids []int) {
+	for id := range m.removedtrials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TrialsIDs returns the "trials" edge IDs in the mutation.
+func (m *ModelingMutation) TrialsIDs() (ids []int) {
+	for id := range m.trials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTrials resets all changes to the "trials" edge.
+func (m *ModelingMutation) ResetTrials() {
+	m.trials = nil
+	m.clearedtrials = false
+	m.removedtrials = nil
+}
+
+// Where appends a list predicates to the ModelingMutation builder.
+func (m *ModelingMutation) Where(ps ...predicate.Modeling) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Modeling, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Modeling).
+func (m *ModelingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelingMutation) Fields() []string {
+	fields := make([]string, 0, 26)
+	if m.local_id != nil {
+		fields = append(fields, modeling.FieldLocalID)
+	}
+	if m.task != nil {
+		fields = append(fields, modeling.FieldTaskID)
+	}
+	if m.parent_id != nil {
+		fields = append(fields, modeling.FieldParentID)
+	}
+	if m.parent_local_id != nil {
+		fields = append(fields, modeling.FieldParentLocalID)
+	}
+	if m.dataset_id != nil {
+		fields = append(fields, modeling.FieldDatasetID)
+	}
+	if m.params != nil {
+		fields = append(fields, modeling.FieldParams)
+	}
+	if m.dataset_stat != nil {
+		fields = append(fields, modeling.FieldDatasetStat)
 	}
 	if m.modeling_type != nil {
 		fields = append(fields, modeling.FieldModelingType)
 	}
-	if m.modeling_step != nil {
-		fields = append(fields, modeling.FieldModelingStep)
+	if m.modeling_step != nil {
+		fields = append(fields, modeling.FieldModelingStep)
+	}
+	if m.performance != nil {
+		fields = append(fields, modeling.FieldPerformance)
+	}
+	if m.progress != nil {
+		fields = append(fields, modeling.FieldProgress)
+	}
+	if m.priority != nil {
+		fields = append(fields, modeling.FieldPriority)
+	}
+	if m.username != nil {
+		fields = append(fields, modeling.FieldUsername)
+	}
+	if m.queued_at != nil {
+		fields = append(fields, modeling.FieldQueuedAt)
+	}
+	if m.queue_position != nil {
+		fields = append(fields, modeling.FieldQueuePosition)
+	}
+	if m.executor != nil {
+		fields = append(fields, modeling.FieldExecutor)
+	}
+	if m.job_id != nil {
+		fields = append(fields, modeling.FieldJobID)
+	}
+	if m.job_type != nil {
+		fields = append(fields, modeling.FieldJobType)
+	}
+	if m.attempts != nil {
+		fields = append(fields, modeling.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, modeling.FieldNextAttemptAt)
+	}
+	if m.fail_reason != nil {
+		fields = append(fields, modeling.FieldFailReason)
+	}
+	if m.pipeline_stage != nil {
+		fields = append(fields, modeling.FieldPipelineStage)
+	}
+	if m.search_trial != nil {
+		fields = append(fields, modeling.FieldSearchTrial)
+	}
+	if m.created_at != nil {
+		fields = append(fields, modeling.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, modeling.FieldUpdatedAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, modeling.FieldStartedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModelingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case modeling.FieldLocalID:
+		return m.LocalID()
+	case modeling.FieldTaskID:
+		return m.TaskID()
+	case modeling.FieldParentID:
+		return m.ParentID()
+	case modeling.FieldParentLocalID:
+		return m.ParentLocalID()
+	case modeling.FieldDatasetID:
+		return m.DatasetID()
+	case modeling.FieldParams:
+		return m.Params()
+	case modeling.FieldDatasetStat:
+		return m.DatasetStat()
+	case modeling.FieldModelingType:
+		return m.ModelingType()
+	case modeling.FieldModelingStep:
+		return m.ModelingStep()
+	case modeling.FieldPerformance:
+		return m.Performance()
+	case modeling.FieldProgress:
+		return m.Progress()
+	case modeling.FieldPriority:
+		return m.Priority()
+	case modeling.FieldUsername:
+		return m.Username()
+	case modeling.FieldQueuedAt:
+		return m.QueuedAt()
+	case modeling.FieldQueuePosition:
+		return m.QueuePosition()
+	case modeling.FieldExecutor:
+		return m.Executor()
+	case modeling.FieldJobID:
+		return m.JobID()
+	case modeling.FieldJobType:
+		return m.JobType()
+	case modeling.FieldAttempts:
+		return m.Attempts()
+	case modeling.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case modeling.FieldFailReason:
+		return m.FailReason()
+	case modeling.FieldPipelineStage:
+		return m.PipelineStage()
+	case modeling.FieldSearchTrial:
+		return m.SearchTrial()
+	case modeling.FieldCreatedAt:
+		return m.CreatedAt()
+	case modeling.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case modeling.FieldStartedAt:
+		return m.StartedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case modeling.FieldLocalID:
+		return m.OldLocalID(ctx)
+	case modeling.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case modeling.FieldParentID:
+		return m.OldParentID(ctx)
+	case modeling.FieldParentLocalID:
+		return m.OldParentLocalID(ctx)
+	case modeling.FieldDatasetID:
+		return m.OldDatasetID(ctx)
+	case modeling.FieldParams:
+		return m.OldParams(ctx)
+	case modeling.FieldDatasetStat:
+		return m.OldDatasetStat(ctx)
+	case modeling.FieldModelingType:
+		return m.OldModelingType(ctx)
+	case modeling.FieldModelingStep:
+		return m.OldModelingStep(ctx)
+	case modeling.FieldPerformance:
+		return m.OldPerformance(ctx)
+	case modeling.FieldProgress:
+		return m.OldProgress(ctx)
+	case modeling.FieldPriority:
+		return m.OldPriority(ctx)
+	case modeling.FieldUsername:
+		return m.OldUsername(ctx)
+	case modeling.FieldQueuedAt:
+		return m.OldQueuedAt(ctx)
+	case modeling.FieldQueuePosition:
+		return m.OldQueuePosition(ctx)
+	case modeling.FieldExecutor:
+		return m.OldExecutor(ctx)
+	case modeling.FieldJobID:
+		return m.OldJobID(ctx)
+	case modeling.FieldJobType:
+		return m.OldJobType(ctx)
+	case modeling.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case modeling.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case modeling.FieldFailReason:
+		return m.OldFailReason(ctx)
+	case modeling.FieldPipelineStage:
+		return m.OldPipelineStage(ctx)
+	case modeling.FieldSearchTrial:
+		return m.OldSearchTrial(ctx)
+	case modeling.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case modeling.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case modeling.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Modeling field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case modeling.FieldLocalID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocalID(v)
+		return nil
+	case modeling.FieldTaskID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case modeling.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case modeling.FieldParentLocalID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentLocalID(v)
+		return nil
+	case modeling.FieldDatasetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDatasetID(v)
+		return nil
+	case modeling.FieldParams:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParams(v)
+		return nil
+	case modeling.FieldDatasetStat:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDatasetStat(v)
+		return nil
+	case modeling.FieldModelingType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelingType(v)
+		return nil
+	case modeling.FieldModelingStep:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelingStep(v)
+		return nil
+	case modeling.FieldPerformance:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPerformance(v)
+		return nil
+	case modeling.FieldProgress:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgress(v)
+		return nil
+	case modeling.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case modeling.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case modeling.FieldQueuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueuedAt(v)
+		return nil
+	case modeling.FieldQueuePosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQueuePosition(v)
+		return nil
+	case modeling.FieldExecutor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExecutor(v)
+		return nil
+	case modeling.FieldJobID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case modeling.FieldJobType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobType(v)
+		return nil
+	case modeling.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case modeling.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case modeling.FieldFailReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailReason(v)
+		return nil
+	case modeling.FieldPipelineStage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPipelineStage(v)
+		return nil
+	case modeling.FieldSearchTrial:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchTrial(v)
+		return nil
+	case modeling.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case modeling.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case modeling.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Modeling field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelingMutation) AddedFields() []string {
+	var fields []string
+	if m.addlocal_id != nil {
+		fields = append(fields, modeling.FieldLocalID)
+	}
+	if m.addparent_id != nil {
+		fields = append(fields, modeling.FieldParentID)
+	}
+	if m.addparent_local_id != nil {
+		fields = append(fields, modeling.FieldParentLocalID)
+	}
+	if m.adddataset_id != nil {
+		fields = append(fields, modeling.FieldDatasetID)
+	}
+	if m.addprogress != nil {
+		fields = append(fields, modeling.FieldProgress)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, modeling.FieldPriority)
+	}
+	if m.addqueue_position != nil {
+		fields = append(fields, modeling.FieldQueuePosition)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, modeling.FieldAttempts)
+	}
+	if m.addpipeline_stage != nil {
+		fields = append(fields, modeling.FieldPipelineStage)
+	}
+	if m.addsearch_trial != nil {
+		fields = append(fields, modeling.FieldSearchTrial)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case modeling.FieldLocalID:
+		return m.AddedLocalID()
+	case modeling.FieldParentID:
+		return m.AddedParentID()
+	case modeling.FieldParentLocalID:
+		return m.AddedParentLocalID()
+	case modeling.FieldDatasetID:
+		return m.AddedDatasetID()
+	case modeling.FieldProgress:
+		return m.AddedProgress()
+	case modeling.FieldPriority:
+		return m.AddedPriority()
+	case modeling.FieldQueuePosition:
+		return m.AddedQueuePosition()
+	case modeling.FieldAttempts:
+		return m.AddedAttempts()
+	case modeling.FieldPipelineStage:
+		return m.AddedPipelineStage()
+	case modeling.FieldSearchTrial:
+		return m.AddedSearchTrial()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case modeling.FieldLocalID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLocalID(v)
+		return nil
+	case modeling.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParentID(v)
+		return nil
+	case modeling.FieldParentLocalID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParentLocalID(v)
+		return nil
+	case modeling.FieldDatasetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDatasetID(v)
+		return nil
+	case modeling.FieldProgress:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProgress(v)
+		return nil
+	case modeling.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case modeling.FieldQueuePosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQueuePosition(v)
+		return nil
+	case modeling.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case modeling.FieldPipelineStage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPipelineStage(v)
+		return nil
+	case modeling.FieldSearchTrial:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSearchTrial(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Modeling numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(modeling.FieldTaskID) {
+		fields = append(fields, modeling.FieldTaskID)
+	}
+	if m.FieldCleared(modeling.FieldParentID) {
+		fields = append(fields, modeling.FieldParentID)
+	}
+	if m.FieldCleared(modeling.FieldParentLocalID) {
+		fields = append(fields, modeling.FieldParentLocalID)
+	}
+	if m.FieldCleared(modeling.FieldDatasetID) {
+		fields = append(fields, modeling.FieldDatasetID)
+	}
+	if m.FieldCleared(modeling.FieldQueuePosition) {
+		fields = append(fields, modeling.FieldQueuePosition)
+	}
+	if m.FieldCleared(modeling.FieldNextAttemptAt) {
+		fields = append(fields, modeling.FieldNextAttemptAt)
+	}
+	if m.FieldCleared(modeling.FieldStartedAt) {
+		fields = append(fields, modeling.FieldStartedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelingMutation) ClearField(name string) error {
+	switch name {
+	case modeling.FieldTaskID:
+		m.ClearTaskID()
+		return nil
+	case modeling.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case modeling.FieldParentLocalID:
+		m.ClearParentLocalID()
+		return nil
+	case modeling.FieldDatasetID:
+		m.ClearDatasetID()
+		return nil
+	case modeling.FieldQueuePosition:
+		m.ClearQueuePosition()
+		return nil
+	case modeling.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
+		return nil
+	case modeling.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Modeling nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelingMutation) ResetField(name string) error {
+	switch name {
+	case modeling.FieldLocalID:
+		m.ResetLocalID()
+		return nil
+	case modeling.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case modeling.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case modeling.FieldParentLocalID:
+		m.ResetParentLocalID()
+		return nil
+	case modeling.FieldDatasetID:
+		m.ResetDatasetID()
+		return nil
+	case modeling.FieldParams:
+		m.ResetParams()
+		return nil
+	case modeling.FieldDatasetStat:
+		m.ResetDatasetStat()
+		return nil
+	case modeling.FieldModelingType:
+		m.ResetModelingType()
+		return nil
+	case modeling.FieldModelingStep:
+		m.ResetModelingStep()
+		return nil
+	case modeling.FieldPerformance:
+		m.ResetPerformance()
+		return nil
+	case modeling.FieldProgress:
+		m.ResetProgress()
+		return nil
+	case modeling.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case modeling.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case modeling.FieldQueuedAt:
+		m.ResetQueuedAt()
+		return nil
+	case modeling.FieldQueuePosition:
+		m.ResetQueuePosition()
+		return nil
+	case modeling.FieldExecutor:
+		m.ResetExecutor()
+		return nil
+	case modeling.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case modeling.FieldJobType:
+		m.ResetJobType()
+		return nil
+	case modeling.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case modeling.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case modeling.FieldFailReason:
+		m.ResetFailReason()
+		return nil
+	case modeling.FieldPipelineStage:
+		m.ResetPipelineStage()
+		return nil
+	case modeling.FieldSearchTrial:
+		m.ResetSearchTrial()
+		return nil
+	case modeling.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case modeling.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case modeling.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Modeling field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.task != nil {
+		edges = append(edges, modeling.EdgeTask)
+	}
+	if m.modeling_details != nil {
+		edges = append(edges, modeling.EdgeModelingDetails)
+	}
+	if m.modeling_models != nil {
+		edges = append(edges, modeling.EdgeModelingModels)
+	}
+	if m.trials != nil {
+		edges = append(edges, modeling.EdgeTrials)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case modeling.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case modeling.EdgeModelingDetails:
+		ids := make([]ent.Value, 0, len(m.modeling_details))
+		for id := range m.modeling_details {
+			ids = append(ids, id)
+		}
+		return ids
+	case modeling.EdgeModelingModels:
+		ids := make([]ent.Value, 0, len(m.modeling_models))
+		for id := range m.modeling_models {
+			ids = append(ids, id)
+		}
+		return ids
+	case modeling.EdgeTrials:
+		ids := make([]ent.Value, 0, len(m.trials))
+		for id := range m.trials {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedmodeling_details != nil {
+		edges = append(edges, modeling.EdgeModelingDetails)
+	}
+	if m.removedmodeling_models != nil {
+		edges = append(edges, modeling.EdgeModelingModels)
+	}
+	if m.removedtrials != nil {
+		edges = append(edges, modeling.EdgeTrials)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelingMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case modeling.EdgeModelingDetails:
+		ids := make([]ent.Value, 0, len(m.removedmodeling_details))
+		for id := range m.removedmodeling_details {
+			ids = append(ids, id)
+		}
+		return ids
+	case modeling.EdgeModelingModels:
+		ids := make([]ent.Value, 0, len(m.removedmodeling_models))
+		for id := range m.removedmodeling_models {
+			ids = append(ids, id)
+		}
+		return ids
+	case modeling.EdgeTrials:
+		ids := make([]ent.Value, 0, len(m.removedtrials))
+		for id := range m.removedtrials {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedtask {
+		edges = append(edges, modeling.EdgeTask)
+	}
+	if m.clearedmodeling_details {
+		edges = append(edges, modeling.EdgeModelingDetails)
+	}
+	if m.clearedmodeling_models {
+		edges = append(edges, modeling.EdgeModelingModels)
+	}
+	if m.clearedtrials {
+		edges = append(edges, modeling.EdgeTrials)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case modeling.EdgeTask:
+		return m.clearedtask
+	case modeling.EdgeModelingDetails:
+		return m.clearedmodeling_details
+	case modeling.EdgeModelingModels:
+		return m.clearedmodeling_models
+	case modeling.EdgeTrials:
+		return m.clearedtrials
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelingMutation) ClearEdge(name string) error {
+	switch name {
+	case modeling.EdgeTask:
+		m.ClearTask()
+		return nil
+	}
+	return fmt.Errorf("unknown Modeling unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelingMutation) ResetEdge(name string) error {
+	switch name {
+	case modeling.EdgeTask:
+		m.ResetTask()
+		return nil
+	case modeling.EdgeModelingDetails:
+		m.ResetModelingDetails()
+		return nil
+	case modeling.EdgeModelingModels:
+		m.ResetModelingModels()
+		return nil
+	case modeling.EdgeTrials:
+		m.ResetTrials()
+		return nil
+	}
+	return fmt.Errorf("unknown Modeling edge %s", name)
+}
+
+// ModelingDetailsMutation represents an operation that mutates the ModelingDetails nodes in the graph.
+type ModelingDetailsMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	model           *string
+	data_type       *string
+	data            *[]string
+	appenddata      []string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	modeling        *int
+	clearedmodeling bool
+	done            bool
+	oldValue        func(context.Context) (*ModelingDetails, error)
+	predicates      []predicate.ModelingDetails
+}
+
+var _ ent.Mutation = (*ModelingDetailsMutation)(nil)
+
+// modelingdetailsOption allows management of the mutation configuration using functional options.
+type modelingdetailsOption func(*ModelingDetailsMutation)
+
+// newModelingDetailsMutation creates new mutation for the ModelingDetails entity.
+func newModelingDetailsMutation(c config, op Op, opts ...modelingdetailsOption) *ModelingDetailsMutation {
+	m := &ModelingDetailsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModelingDetails,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModelingDetailsID sets the ID field of the mutation.
+func withModelingDetailsID(id int) modelingdetailsOption {
+	return func(m *ModelingDetailsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModelingDetails
+		)
+		m.oldValue = func(ctx context.Context) (*ModelingDetails, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModelingDetails.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModelingDetails sets the old ModelingDetails of the mutation.
+func withModelingDetails(node *ModelingDetails) modelingdetailsOption {
+	return func(m *ModelingDetailsMutation) {
+		m.oldValue = func(context.Context) (*ModelingDetails, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModelingDetailsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModelingDetailsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ModelingDetails entities.
+func (m *ModelingDetailsMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModelingDetailsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModelingDetailsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModelingDetails.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (m *ModelingDetailsMutation) SetModelingID(i int) {
+	m.modeling = &i
+}
+
+// ModelingID returns the value of the "modeling_id" field in the mutation.
+func (m *ModelingDetailsMutation) ModelingID() (r int, exists bool) {
+	v := m.modeling
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelingID returns the old "modeling_id" field's value of the ModelingDetails entity.
+// If the ModelingDetails object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingDetailsMutation) OldModelingID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelingID: %w", err)
+	}
+	return oldValue.ModelingID, nil
+}
+
+// ClearModelingID clears the value of the "modeling_id" field.
+func (m *ModelingDetailsMutation) ClearModelingID() {
+	m.modeling = nil
+	m.clearedFields[modelingdetails.FieldModelingID] = struct{}{}
+}
+
+// ModelingIDCleared returns if the "modeling_id" field was cleared in this mutation.
+func (m *ModelingDetailsMutation) ModelingIDCleared() bool {
+	_, ok := m.clearedFields[modelingdetails.FieldModelingID]
+	return ok
+}
+
+// ResetModelingID resets all changes to the "modeling_id" field.
+func (m *ModelingDetailsMutation) ResetModelingID() {
+	m.modeling = nil
+	delete(m.clearedFields, modelingdetails.FieldModelingID)
+}
+
+// SetModel sets the "model" field.
+func (m *ModelingDetailsMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *ModelingDetailsMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the ModelingDetails entity.
+// If the ModelingDetails object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingDetailsMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *ModelingDetailsMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetDataType sets the "data_type" field.
+func (m *ModelingDetailsMutation) SetDataType(s string) {
+	m.data_type = &s
+}
+
+// DataType returns the value of the "data_type" field in the mutation.
+func (m *ModelingDetailsMutation) DataType() (r string, exists bool) {
+	v := m.data_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDataType returns the old "data_type" field's value of the ModelingDetails entity.
+// If the ModelingDetails object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingDetailsMutation) OldDataType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDataType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDataType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataType: %w", err)
+	}
+	return oldValue.DataType, nil
+}
+
+// ResetDataType resets all changes to the "data_type" field.
+func (m *ModelingDetailsMutation) ResetDataType() {
+	m.data_type = nil
+}
+
+// SetData sets the "data" field.
+func (m *ModelingDetailsMutation) SetData(s []string) {
+	m.data = &s
+	m.appenddata = nil
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *ModelingDetailsMutation) Data() (r []string, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the ModelingDetails entity.
+// If the ModelingDetails object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingDetailsMutation) OldData(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// AppendData adds s to the "data" field.
+func (m *ModelingDetailsMutation) AppendData(s []string) {
+	m.appenddata = append(m.appenddata, s...)
+}
+
+// AppendedData returns the list of values that were appended to the "data" field in this mutation.
+func (m *ModelingDetailsMutation) AppendedData() ([]string, bool) {
+	if len(m.appenddata) == 0 {
+		return nil, false
+	}
+	return m.appenddata, true
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *ModelingDetailsMutation) ResetData() {
+	m.data = nil
+	m.appenddata = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModelingDetailsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModelingDetailsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ModelingDetails entity.
+// If the ModelingDetails object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingDetailsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModelingDetailsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearModeling clears the "modeling" edge to the Modeling entity.
+func (m *ModelingDetailsMutation) ClearModeling() {
+	m.clearedmodeling = true
+	m.clearedFields[modelingdetails.FieldModelingID] = struct{}{}
+}
+
+// ModelingCleared reports if the "modeling" edge to the Modeling entity was cleared.
+func (m *ModelingDetailsMutation) ModelingCleared() bool {
+	return m.ModelingIDCleared() || m.clearedmodeling
+}
+
+// ModelingIDs returns the "modeling" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModelingID instead. It exists only for internal usage by the builders.
+func (m *ModelingDetailsMutation) ModelingIDs() (ids []int) {
+	if id := m.modeling; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModeling resets all changes to the "modeling" edge.
+func (m *ModelingDetailsMutation) ResetModeling() {
+	m.modeling = nil
+	m.clearedmodeling = false
+}
+
+// Where appends a list predicates to the ModelingDetailsMutation builder.
+func (m *ModelingDetailsMutation) Where(ps ...predicate.ModelingDetails) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelingDetailsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelingDetailsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModelingDetails, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelingDetailsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelingDetailsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModelingDetails).
+func (m *ModelingDetailsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelingDetailsMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.modeling != nil {
+		fields = append(fields, modelingdetails.FieldModelingID)
+	}
+	if m.model != nil {
+		fields = append(fields, modelingdetails.FieldModel)
+	}
+	if m.data_type != nil {
+		fields = append(fields, modelingdetails.FieldDataType)
+	}
+	if m.data != nil {
+		fields = append(fields, modelingdetails.FieldData)
+	}
+	if m.created_at != nil {
+		fields = append(fields, modelingdetails.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModelingDetailsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case modelingdetails.FieldModelingID:
+		return m.ModelingID()
+	case modelingdetails.FieldModel:
+		return m.Model()
+	case modelingdetails.FieldDataType:
+		return m.DataType()
+	case modelingdetails.FieldData:
+		return m.Data()
+	case modelingdetails.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelingDetailsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case modelingdetails.FieldModelingID:
+		return m.OldModelingID(ctx)
+	case modelingdetails.FieldModel:
+		return m.OldModel(ctx)
+	case modelingdetails.FieldDataType:
+		return m.OldDataType(ctx)
+	case modelingdetails.FieldData:
+		return m.OldData(ctx)
+	case modelingdetails.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModelingDetails field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelingDetailsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case modelingdetails.FieldModelingID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelingID(v)
+		return nil
+	case modelingdetails.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case modelingdetails.FieldDataType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataType(v)
+		return nil
+	case modelingdetails.FieldData:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case modelingdetails.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModelingDetails field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelingDetailsMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelingDetailsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelingDetailsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ModelingDetails numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelingDetailsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(modelingdetails.FieldModelingID) {
+		fields = append(fields, modelingdetails.FieldModelingID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelingDetailsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelingDetailsMutation) ClearField(name string) error {
+	switch name {
+	case modelingdetails.FieldModelingID:
+		m.ClearModelingID()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelingDetails nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelingDetailsMutation) ResetField(name string) error {
+	switch name {
+	case modelingdetails.FieldModelingID:
+		m.ResetModelingID()
+		return nil
+	case modelingdetails.FieldModel:
+		m.ResetModel()
+		return nil
+	case modelingdetails.FieldDataType:
+		m.ResetDataType()
+		return nil
+	case modelingdetails.FieldData:
+		m.ResetData()
+		return nil
+	case modelingdetails.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelingDetails field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelingDetailsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.modeling != nil {
+		edges = append(edges, modelingdetails.EdgeModeling)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelingDetailsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case modelingdetails.EdgeModeling:
+		if id := m.modeling; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelingDetailsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelingDetailsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelingDetailsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmodeling {
+		edges = append(edges, modelingdetails.EdgeModeling)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelingDetailsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case modelingdetails.EdgeModeling:
+		return m.clearedmodeling
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelingDetailsMutation) ClearEdge(name string) error {
+	switch name {
+	case modelingdetails.EdgeModeling:
+		m.ClearModeling()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelingDetails unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelingDetailsMutation) ResetEdge(name string) error {
+	switch name {
+	case modelingdetails.EdgeModeling:
+		m.ResetModeling()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelingDetails edge %s", name)
+}
+
+// ModelingModelsMutation represents an operation that mutates the ModelingModels nodes in the graph.
+type ModelingModelsMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	data_type       *string
+	data            *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	modeling        *int
+	clearedmodeling bool
+	done            bool
+	oldValue        func(context.Context) (*ModelingModels, error)
+	predicates      []predicate.ModelingModels
+}
+
+var _ ent.Mutation = (*ModelingModelsMutation)(nil)
+
+// modelingmodelsOption allows management of the mutation configuration using functional options.
+type modelingmodelsOption func(*ModelingModelsMutation)
+
+// newModelingModelsMutation creates new mutation for the ModelingModels entity.
+func newModelingModelsMutation(c config, op Op, opts ...modelingmodelsOption) *ModelingModelsMutation {
+	m := &ModelingModelsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModelingModels,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModelingModelsID sets the ID field of the mutation.
+func withModelingModelsID(id int) modelingmodelsOption {
+	return func(m *ModelingModelsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModelingModels
+		)
+		m.oldValue = func(ctx context.Context) (*ModelingModels, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModelingModels.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModelingModels sets the old ModelingModels of the mutation.
+func withModelingModels(node *ModelingModels) modelingmodelsOption {
+	return func(m *ModelingModelsMutation) {
+		m.oldValue = func(context.Context) (*ModelingModels, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModelingModelsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModelingModelsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ModelingModels entities.
+func (m *ModelingModelsMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModelingModelsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModelingModelsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModelingModels.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (m *ModelingModelsMutation) SetModelingID(i int) {
+	m.modeling = &i
+}
+
+// ModelingID returns the value of the "modeling_id" field in the mutation.
+func (m *ModelingModelsMutation) ModelingID() (r int, exists bool) {
+	v := m.modeling
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelingID returns the old "modeling_id" field's value of the ModelingModels entity.
+// If the ModelingModels object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingModelsMutation) OldModelingID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelingID: %w", err)
+	}
+	return oldValue.ModelingID, nil
+}
+
+// ClearModelingID clears the value of the "modeling_id" field.
+func (m *ModelingModelsMutation) ClearModelingID() {
+	m.modeling = nil
+	m.clearedFields[modelingmodels.FieldModelingID] = struct{}{}
+}
+
+// ModelingIDCleared returns if the "modeling_id" field was cleared in this mutation.
+func (m *ModelingModelsMutation) ModelingIDCleared() bool {
+	_, ok := m.clearedFields[modelingmodels.FieldModelingID]
+	return ok
+}
+
+// ResetModelingID resets all changes to the "modeling_id" field.
+func (m *ModelingModelsMutation) ResetModelingID() {
+	m.modeling = nil
+	delete(m.clearedFields, modelingmodels.FieldModelingID)
+}
+
+// SetDataType sets the "data_type" field.
+func (m *ModelingModelsMutation) SetDataType(s string) {
+	m.data_type = &s
+}
+
+// DataType returns the value of the "data_type" field in the mutation.
+func (m *ModelingModelsMutation) DataType() (r string, exists bool) {
+	v := m.data_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDataType returns the old "data_type" field's value of the ModelingModels entity.
+// If the ModelingModels object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingModelsMutation) OldDataType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDataType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDataType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataType: %w", err)
+	}
+	return oldValue.DataType, nil
+}
+
+// ResetDataType resets all changes to the "data_type" field.
+func (m *ModelingModelsMutation) ResetDataType() {
+	m.data_type = nil
+}
+
+// SetData sets the "data" field.
+func (m *ModelingModelsMutation) SetData(s string) {
+	m.data = &s
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *ModelingModelsMutation) Data() (r string, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the ModelingModels entity.
+// If the ModelingModels object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingModelsMutation) OldData(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *ModelingModelsMutation) ResetData() {
+	m.data = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModelingModelsMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModelingModelsMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ModelingModels entity.
+// If the ModelingModels object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelingModelsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModelingModelsMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearModeling clears the "modeling" edge to the Modeling entity.
+func (m *ModelingModelsMutation) ClearModeling() {
+	m.clearedmodeling = true
+	m.clearedFields[modelingmodels.FieldModelingID] = struct{}{}
+}
+
+// ModelingCleared reports if the "modeling" edge to the Modeling entity was cleared.
+func (m *ModelingModelsMutation) ModelingCleared() bool {
+	return m.ModelingIDCleared() || m.clearedmodeling
+}
+
+// ModelingIDs returns the "modeling" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModelingID instead. It exists only for internal usage by the builders.
+func (m *ModelingModelsMutation) ModelingIDs() (ids []int) {
+	if id := m.modeling; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModeling resets all changes to the "modeling" edge.
+func (m *ModelingModelsMutation) ResetModeling() {
+	m.modeling = nil
+	m.clearedmodeling = false
+}
+
+// Where appends a list predicates to the ModelingModelsMutation builder.
+func (m *ModelingModelsMutation) Where(ps ...predicate.ModelingModels) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelingModelsMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelingModelsMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModelingModels, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelingModelsMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelingModelsMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModelingModels).
+func (m *ModelingModelsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelingModelsMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.modeling != nil {
+		fields = append(fields, modelingmodels.FieldModelingID)
+	}
+	if m.data_type != nil {
+		fields = append(fields, modelingmodels.FieldDataType)
+	}
+	if m.data != nil {
+		fields = append(fields, modelingmodels.FieldData)
+	}
+	if m.created_at != nil {
+		fields = append(fields, modelingmodels.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModelingModelsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case modelingmodels.FieldModelingID:
+		return m.ModelingID()
+	case modelingmodels.FieldDataType:
+		return m.DataType()
+	case modelingmodels.FieldData:
+		return m.Data()
+	case modelingmodels.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelingModelsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case modelingmodels.FieldModelingID:
+		return m.OldModelingID(ctx)
+	case modelingmodels.FieldDataType:
+		return m.OldDataType(ctx)
+	case modelingmodels.FieldData:
+		return m.OldData(ctx)
+	case modelingmodels.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModelingModels field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelingModelsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case modelingmodels.FieldModelingID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelingID(v)
+		return nil
+	case modelingmodels.FieldDataType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataType(v)
+		return nil
+	case modelingmodels.FieldData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case modelingmodels.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModelingModels field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelingModelsMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelingModelsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelingModelsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ModelingModels numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelingModelsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(modelingmodels.FieldModelingID) {
+		fields = append(fields, modelingmodels.FieldModelingID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelingModelsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelingModelsMutation) ClearField(name string) error {
+	switch name {
+	case modelingmodels.FieldModelingID:
+		m.ClearModelingID()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelingModels nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelingModelsMutation) ResetField(name string) error {
+	switch name {
+	case modelingmodels.FieldModelingID:
+		m.ResetModelingID()
+		return nil
+	case modelingmodels.FieldDataType:
+		m.ResetDataType()
+		return nil
+	case modelingmodels.FieldData:
+		m.ResetData()
+		return nil
+	case modelingmodels.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelingModels field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelingModelsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.modeling != nil {
+		edges = append(edges, modelingmodels.EdgeModeling)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelingModelsMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case modelingmodels.EdgeModeling:
+		if id := m.modeling; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelingModelsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelingModelsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelingModelsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmodeling {
+		edges = append(edges, modelingmodels.EdgeModeling)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelingModelsMutation) EdgeCleared(name string) bool {
+	switch name {
+	case modelingmodels.EdgeModeling:
+		return m.clearedmodeling
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelingModelsMutation) ClearEdge(name string) error {
+	switch name {
+	case modelingmodels.EdgeModeling:
+		m.ClearModeling()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelingModels unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelingModelsMutation) ResetEdge(name string) error {
+	switch name {
+	case modelingmodels.EdgeModeling:
+		m.ResetModeling()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelingModels edge %s", name)
+}
+
+// PreemptionMutation represents an operation that mutates the Preemption nodes in the graph.
+type PreemptionMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	modeling_id        *int
+	addmodeling_id     *int
+	priority           *int
+	addpriority        *int
+	username           *string
+	victim_id          *int
+	addvictim_id       *int
+	victim_priority    *int
+	addvictim_priority *int
+	victim_username    *string
+	gpu_ids            *[]int
+	appendgpu_ids      []int
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*Preemption, error)
+	predicates         []predicate.Preemption
+}
+
+var _ ent.Mutation = (*PreemptionMutation)(nil)
+
+// preemptionOption allows management of the mutation configuration using functional options.
+type preemptionOption func(*PreemptionMutation)
+
+// newPreemptionMutation creates new mutation for the Preemption entity.
+func newPreemptionMutation(c config, op Op, opts ...preemptionOption) *PreemptionMutation {
+	m := &PreemptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePreemption,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPreemptionID sets the ID field of the mutation.
+func withPreemptionID(id int) preemptionOption {
+	return func(m *PreemptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Preemption
+		)
+		m.oldValue = func(ctx context.Context) (*Preemption, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Preemption.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPreemption sets the old Preemption of the mutation.
+func withPreemption(node *Preemption) preemptionOption {
+	return func(m *PreemptionMutation) {
+		m.oldValue = func(context.Context) (*Preemption, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PreemptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PreemptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Preemption entities.
+func (m *PreemptionMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PreemptionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PreemptionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Preemption.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (m *PreemptionMutation) SetModelingID(i int) {
+	m.modeling_id = &i
+	m.addmodeling_id = nil
+}
+
+// ModelingID returns the value of the "modeling_id" field in the mutation.
+func (m *PreemptionMutation) ModelingID() (r int, exists bool) {
+	v := m.modeling_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelingID returns the old "modeling_id" field's value of the Preemption entity.
+// If the Preemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreemptionMutation) OldModelingID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelingID: %w", err)
+	}
+	return oldValue.ModelingID, nil
+}
+
+// AddModelingID adds i to the "modeling_id" field.
+func (m *PreemptionMutation) AddModelingID(i int) {
+	if m.addmodeling_id != nil {
+		*m.addmodeling_id += i
+	} else {
+		m.addmodeling_id = &i
+	}
+}
+
+// AddedModelingID returns the value that was added to the "modeling_id" field in this mutation.
+func (m *PreemptionMutation) AddedModelingID() (r int, exists bool) {
+	v := m.addmodeling_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetModelingID resets all changes to the "modeling_id" field.
+func (m *PreemptionMutation) ResetModelingID() {
+	m.modeling_id = nil
+	m.addmodeling_id = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *PreemptionMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *PreemptionMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the Preemption entity.
+// If the Preemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreemptionMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *PreemptionMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *PreemptionMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *PreemptionMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *PreemptionMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *PreemptionMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the Preemption entity.
+// If the Preemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreemptionMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *PreemptionMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetVictimID sets the "victim_id" field.
+func (m *PreemptionMutation) SetVictimID(i int) {
+	m.victim_id = &i
+	m.addvictim_id = nil
+}
+
+// VictimID returns the value of the "victim_id" field in the mutation.
+func (m *PreemptionMutation) VictimID() (r int, exists bool) {
+	v := m.victim_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVictimID returns the old "victim_id" field's value of the Preemption entity.
+// If the Preemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreemptionMutation) OldVictimID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVictimID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVictimID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVictimID: %w", err)
+	}
+	return oldValue.VictimID, nil
+}
+
+// AddVictimID adds i to the "victim_id" field.
+func (m *PreemptionMutation) AddVictimID(i int) {
+	if m.addvictim_id != nil {
+		*m.addvictim_id += i
+	} else {
+		m.addvictim_id = &i
+	}
+}
+
+// AddedVictimID returns the value that was added to the "victim_id" field in this mutation.
+func (m *PreemptionMutation) AddedVictimID() (r int, exists bool) {
+	v := m.addvictim_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVictimID resets all changes to the "victim_id" field.
+func (m *PreemptionMutation) ResetVictimID() {
+	m.victim_id = nil
+	m.addvictim_id = nil
+}
+
+// SetVictimPriority sets the "victim_priority" field.
+func (m *PreemptionMutation) SetVictimPriority(i int) {
+	m.victim_priority = &i
+	m.addvictim_priority = nil
+}
+
+// VictimPriority returns the value of the "victim_priority" field in the mutation.
+func (m *PreemptionMutation) VictimPriority() (r int, exists bool) {
+	v := m.victim_priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVictimPriority returns the old "victim_priority" field's value of the Preemption entity.
+// If the Preemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreemptionMutation) OldVictimPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVictimPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVictimPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVictimPriority: %w", err)
+	}
+	return oldValue.VictimPriority, nil
+}
+
+// AddVictimPriority adds i to the "victim_priority" field.
+func (m *PreemptionMutation) AddVictimPriority(i int) {
+	if m.addvictim_priority != nil {
+		*m.addvictim_priority += i
+	} else {
+		m.addvictim_priority = &i
+	}
+}
+
+// AddedVictimPriority returns the value that was added to the "victim_priority" field in this mutation.
+func (m *PreemptionMutation) AddedVictimPriority() (r int, exists bool) {
+	v := m.addvictim_priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVictimPriority resets all changes to the "victim_priority" field.
+func (m *PreemptionMutation) ResetVictimPriority() {
+	m.victim_priority = nil
+	m.addvictim_priority = nil
+}
+
+// SetVictimUsername sets the "victim_username" field.
+func (m *PreemptionMutation) SetVictimUsername(s string) {
+	m.victim_username = &s
+}
+
+// VictimUsername returns the value of the "victim_username" field in the mutation.
+func (m *PreemptionMutation) VictimUsername() (r string, exists bool) {
+	v := m.victim_username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVictimUsername returns the old "victim_username" field's value of the Preemption entity.
+// If the Preemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreemptionMutation) OldVictimUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVictimUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVictimUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVictimUsername: %w", err)
+	}
+	return oldValue.VictimUsername, nil
+}
+
+// ResetVictimUsername resets all changes to the "victim_username" field.
+func (m *PreemptionMutation) ResetVictimUsername() {
+	m.victim_username = nil
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (m *PreemptionMutation) SetGpuIds(i []int) {
+	m.gpu_ids = &i
+	m.appendgpu_ids = nil
+}
+
+// GpuIds returns the value of the "gpu_ids" field in the mutation.
+func (m *PreemptionMutation) GpuIds() (r []int, exists bool) {
+	v := m.gpu_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGpuIds returns the old "gpu_ids" field's value of the Preemption entity.
+// If the Preemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreemptionMutation) OldGpuIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGpuIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGpuIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGpuIds: %w", err)
+	}
+	return oldValue.GpuIds, nil
+}
+
+// AppendGpuIds adds i to the "gpu_ids" field.
+func (m *PreemptionMutation) AppendGpuIds(i []int) {
+	m.appendgpu_ids = append(m.appendgpu_ids, i...)
+}
+
+// AppendedGpuIds returns the list of values that were appended to the "gpu_ids" field in this mutation.
+func (m *PreemptionMutation) AppendedGpuIds() ([]int, bool) {
+	if len(m.appendgpu_ids) == 0 {
+		return nil, false
+	}
+	return m.appendgpu_ids, true
+}
+
+// ResetGpuIds resets all changes to the "gpu_ids" field.
+func (m *PreemptionMutation) ResetGpuIds() {
+	m.gpu_ids = nil
+	m.appendgpu_ids = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PreemptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PreemptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Preemption entity.
+// If the Preemption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PreemptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PreemptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the PreemptionMutation builder.
+func (m *PreemptionMutation) Where(ps ...predicate.Preemption) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PreemptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PreemptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Preemption, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PreemptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PreemptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Preemption).
+func (m *PreemptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PreemptionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.modeling_id != nil {
+		fields = append(fields, preemption.FieldModelingID)
+	}
+	if m.priority != nil {
+		fields = append(fields, preemption.FieldPriority)
+	}
+	if m.username != nil {
+		fields = append(fields, preemption.FieldUsername)
+	}
+	if m.victim_id != nil {
+		fields = append(fields, preemption.FieldVictimID)
+	}
+	if m.victim_priority != nil {
+		fields = append(fields, preemption.FieldVictimPriority)
+	}
+	if m.victim_username != nil {
+		fields = append(fields, preemption.FieldVictimUsername)
+	}
+	if m.gpu_ids != nil {
+		fields = append(fields, preemption.FieldGpuIds)
+	}
+	if m.created_at != nil {
+		fields = append(fields, preemption.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PreemptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case preemption.FieldModelingID:
+		return m.ModelingID()
+	case preemption.FieldPriority:
+		return m.Priority()
+	case preemption.FieldUsername:
+		return m.Username()
+	case preemption.FieldVictimID:
+		return m.VictimID()
+	case preemption.FieldVictimPriority:
+		return m.VictimPriority()
+	case preemption.FieldVictimUsername:
+		return m.VictimUsername()
+	case preemption.FieldGpuIds:
+		return m.GpuIds()
+	case preemption.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PreemptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case preemption.FieldModelingID:
+		return m.OldModelingID(ctx)
+	case preemption.FieldPriority:
+		return m.OldPriority(ctx)
+	case preemption.FieldUsername:
+		return m.OldUsername(ctx)
+	case preemption.FieldVictimID:
+		return m.OldVictimID(ctx)
+	case preemption.FieldVictimPriority:
+		return m.OldVictimPriority(ctx)
+	case preemption.FieldVictimUsername:
+		return m.OldVictimUsername(ctx)
+	case preemption.FieldGpuIds:
+		return m.OldGpuIds(ctx)
+	case preemption.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Preemption field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PreemptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case preemption.FieldModelingID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelingID(v)
+		return nil
+	case preemption.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case preemption.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case preemption.FieldVictimID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVictimID(v)
+		return nil
+	case preemption.FieldVictimPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVictimPriority(v)
+		return nil
+	case preemption.FieldVictimUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVictimUsername(v)
+		return nil
+	case preemption.FieldGpuIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGpuIds(v)
+		return nil
+	case preemption.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Preemption field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PreemptionMutation) AddedFields() []string {
+	var fields []string
+	if m.addmodeling_id != nil {
+		fields = append(fields, preemption.FieldModelingID)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, preemption.FieldPriority)
+	}
+	if m.addvictim_id != nil {
+		fields = append(fields, preemption.FieldVictimID)
+	}
+	if m.addvictim_priority != nil {
+		fields = append(fields, preemption.FieldVictimPriority)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PreemptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case preemption.FieldModelingID:
+		return m.AddedModelingID()
+	case preemption.FieldPriority:
+		return m.AddedPriority()
+	case preemption.FieldVictimID:
+		return m.AddedVictimID()
+	case preemption.FieldVictimPriority:
+		return m.AddedVictimPriority()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PreemptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case preemption.FieldModelingID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModelingID(v)
+		return nil
+	case preemption.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case preemption.FieldVictimID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVictimID(v)
+		return nil
+	case preemption.FieldVictimPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVictimPriority(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Preemption numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PreemptionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PreemptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PreemptionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Preemption nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PreemptionMutation) ResetField(name string) error {
+	switch name {
+	case preemption.FieldModelingID:
+		m.ResetModelingID()
+		return nil
+	case preemption.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case preemption.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case preemption.FieldVictimID:
+		m.ResetVictimID()
+		return nil
+	case preemption.FieldVictimPriority:
+		m.ResetVictimPriority()
+		return nil
+	case preemption.FieldVictimUsername:
+		m.ResetVictimUsername()
+		return nil
+	case preemption.FieldGpuIds:
+		m.ResetGpuIds()
+		return nil
+	case preemption.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Preemption field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PreemptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PreemptionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PreemptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PreemptionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PreemptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PreemptionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PreemptionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Preemption unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PreemptionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Preemption edge %s", name)
+}
+
+// ProjectMutation represents an operation that mutates the Project nodes in the graph.
+type ProjectMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	title               *string
+	description         *string
+	is_use              *bool
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	tasks               map[int]struct{}
+	removedtasks        map[int]struct{}
+	clearedtasks        bool
+	user_project        map[int]struct{}
+	removeduser_project map[int]struct{}
+	cleareduser_project bool
+	done                bool
+	oldValue            func(context.Context) (*Project, error)
+	predicates          []predicate.Project
+}
+
+var _ ent.Mutation = (*ProjectMutation)(nil)
+
+// projectOption allows management of the mutation configuration using functional options.
+type projectOption func(*ProjectMutation)
+
+// newProjectMutation creates new mutation for the Project entity.
+func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
+	m := &ProjectMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProject,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectID sets the ID field of the mutation.
+func withProjectID(id int) projectOption {
+	return func(m *ProjectMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Project
+		)
+		m.oldValue = func(ctx context.Context) (*Project, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Project.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProject sets the old Project of the mutation.
+func withProject(node *Project) projectOption {
+	return func(m *ProjectMutation) {
+		m.oldValue = func(context.Context) (*Project, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Project entities.
+func (m *ProjectMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *ProjectMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ProjectMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ProjectMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ProjectMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProjectMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ProjectMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[project.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProjectMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[project.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProjectMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, project.FieldDescription)
+}
+
+// SetIsUse sets the "is_use" field.
+func (m *ProjectMutation) SetIsUse(b bool) {
+	m.is_use = &b
+}
+
+// IsUse returns the value of the "is_use" field in the mutation.
+func (m *ProjectMutation) IsUse() (r bool, exists bool) {
+	v := m.is_use
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsUse returns the old "is_use" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldIsUse(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsUse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsUse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsUse: %w", err)
+	}
+	return oldValue.IsUse, nil
+}
+
+// ResetIsUse resets all changes to the "is_use" field.
+func (m *ProjectMutation) ResetIsUse() {
+	m.is_use = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProjectMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProjectMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProjectMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProjectMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProjectMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *ProjectMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[project.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *ProjectMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[project.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProjectMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, project.FieldUpdatedAt)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
+func (m *ProjectMutation) AddTaskIDs(ids ...int) {
+	if m.tasks == nil {
+		m.tasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTasks clears the "tasks" edge to the Task entity.
+func (m *ProjectMutation) ClearTasks() {
+	m.clearedtasks = true
+}
+
+// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
+func (m *ProjectMutation) TasksCleared() bool {
+	return m.clearedtasks
+}
+
+// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
+func (m *ProjectMutation) RemoveTaskIDs(ids ...int) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
+func (m *ProjectMutation) RemovedTasksIDs() (ids []int) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *ProjectMutation) TasksIDs() (ids []int) {
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *ProjectMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
+}
+
+// AddUserProjectIDs adds the "user_project" edge to the UserProject entity by ids.
+func (m *ProjectMutation) AddUserProjectIDs(ids ...int) {
+	if m.user_project == nil {
+		m.user_project = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.user_project[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserProject clears the "user_project" edge to the UserProject entity.
+func (m *ProjectMutation) ClearUserProject() {
+	m.cleareduser_project = true
+}
+
+// UserProjectCleared reports if the "user_project" edge to the UserProject entity was cleared.
+func (m *ProjectMutation) UserProjectCleared() bool {
+	return m.cleareduser_project
+}
+
+// RemoveUserProjectIDs removes the "user_project" edge to the UserProject entity by IDs.
+func (m *ProjectMutation) RemoveUserProjectIDs(ids ...int) {
+	if m.removeduser_project == nil {
+		m.removeduser_project = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.user_project, ids[i])
+		m.removeduser_project[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserProject returns the removed IDs of the "user_project" edge to the UserProject entity.
+func (m *ProjectMutation) RemovedUserProjectIDs() (ids []int) {
+	for id := range m.removeduser_project {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserProjectIDs returns the "user_project" edge IDs in the mutation.
+func (m *ProjectMutation) UserProjectIDs() (ids []int) {
+	for id := range m.user_project {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserProject resets all changes to the "user_project" edge.
+func (m *ProjectMutation) ResetUserProject() {
+	m.user_project = nil
+	m.cleareduser_project = false
+	m.removeduser_project = nil
+}
+
+// Where appends a list predicates to the ProjectMutation builder.
+func (m *ProjectMutation) Where(ps ...predicate.Project) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Project, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProjectMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProjectMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Project).
+func (m *ProjectMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.title != nil {
+		fields = append(fields, project.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, project.FieldDescription)
+	}
+	if m.is_use != nil {
+		fields = append(fields, project.FieldIsUse)
+	}
+	if m.created_at != nil {
+		fields = append(fields, project.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, project.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case project.FieldTitle:
+		return m.Title()
+	case project.FieldDescription:
+		return m.Description()
+	case project.FieldIsUse:
+		return m.IsUse()
+	case project.FieldCreatedAt:
+		return m.CreatedAt()
+	case project.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case project.FieldTitle:
+		return m.OldTitle(ctx)
+	case project.FieldDescription:
+		return m.OldDescription(ctx)
+	case project.FieldIsUse:
+		return m.OldIsUse(ctx)
+	case project.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case project.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Project field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case project.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case project.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case project.FieldIsUse:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsUse(v)
+		return nil
+	case project.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case project.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProjectMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Project numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProjectMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(project.FieldDescription) {
+		fields = append(fields, project.FieldDescription)
+	}
+	if m.FieldCleared(project.FieldUpdatedAt) {
+		fields = append(fields, project.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProjectMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectMutation) ClearField(name string) error {
+	switch name {
+	case project.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case project.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Project nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProjectMutation) ResetField(name string) error {
+	switch name {
+	case project.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case project.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case project.FieldIsUse:
+		m.ResetIsUse()
+		return nil
+	case project.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case project.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProjectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tasks != nil {
+		edges = append(edges, project.EdgeTasks)
+	}
+	if m.user_project != nil {
+		edges = append(edges, project.EdgeUserProject)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeUserProject:
+		ids := make([]ent.Value, 0, len(m.user_project))
+		for id := range m.user_project {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProjectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedtasks != nil {
+		edges = append(edges, project.EdgeTasks)
+	}
+	if m.removeduser_project != nil {
+		edges = append(edges, project.EdgeUserProject)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeUserProject:
+		ids := make([]ent.Value, 0, len(m.removeduser_project))
+		for id := range m.removeduser_project {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProjectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtasks {
+		edges = append(edges, project.EdgeTasks)
+	}
+	if m.cleareduser_project {
+		edges = append(edges, project.EdgeUserProject)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProjectMutation) EdgeCleared(name string) bool {
+	switch name {
+	case project.EdgeTasks:
+		return m.clearedtasks
+	case project.EdgeUserProject:
+		return m.cleareduser_project
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProjectMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Project unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProjectMutation) ResetEdge(name string) error {
+	switch name {
+	case project.EdgeTasks:
+		m.ResetTasks()
+		return nil
+	case project.EdgeUserProject:
+		m.ResetUserProject()
+		return nil
+	}
+	return fmt.Errorf("unknown Project edge %s", name)
+}
+
+// QuotaMutation represents an operation that mutates the Quota nodes in the graph.
+type QuotaMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	target_type   *string
+	target_id     *int
+	addtarget_id  *int
+	max_gpus      *int
+	addmax_gpus   *int
+	max_queued    *int
+	addmax_queued *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Quota, error)
+	predicates    []predicate.Quota
+}
+
+var _ ent.Mutation = (*QuotaMutation)(nil)
+
+// quotaOption allows management of the mutation configuration using functional options.
+type quotaOption func(*QuotaMutation)
+
+// newQuotaMutation creates new mutation for the Quota entity.
+func newQuotaMutation(c config, op Op, opts ...quotaOption) *QuotaMutation {
+	m := &QuotaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuota,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuotaID sets the ID field of the mutation.
+func withQuotaID(id int) quotaOption {
+	return func(m *QuotaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Quota
+		)
+		m.oldValue = func(ctx context.Context) (*Quota, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Quota.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuota sets the old Quota of the mutation.
+func withQuota(node *Quota) quotaOption {
+	return func(m *QuotaMutation) {
+		m.oldValue = func(context.Context) (*Quota, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuotaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuotaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Quota entities.
+func (m *QuotaMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuotaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuotaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Quota.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTargetType sets the "target_type" field.
+func (m *QuotaMutation) SetTargetType(s string) {
+	m.target_type = &s
+}
+
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *QuotaMutation) TargetType() (r string, exists bool) {
+	v := m.target_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetType returns the old "target_type" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldTargetType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
+	}
+	return oldValue.TargetType, nil
+}
+
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *QuotaMutation) ResetTargetType() {
+	m.target_type = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *QuotaMutation) SetTargetID(i int) {
+	m.target_id = &i
+	m.addtarget_id = nil
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *QuotaMutation) TargetID() (r int, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldTargetID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (m *QuotaMutation) AddTargetID(i int) {
+	if m.addtarget_id != nil {
+		*m.addtarget_id += i
+	} else {
+		m.addtarget_id = &i
+	}
+}
+
+// AddedTargetID returns the value that was added to the "target_id" field in this mutation.
+func (m *QuotaMutation) AddedTargetID() (r int, exists bool) {
+	v := m.addtarget_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *QuotaMutation) ResetTargetID() {
+	m.target_id = nil
+	m.addtarget_id = nil
+}
+
+// SetMaxGpus sets the "max_gpus" field.
+func (m *QuotaMutation) SetMaxGpus(i int) {
+	m.max_gpus = &i
+	m.addmax_gpus = nil
+}
+
+// MaxGpus returns the value of the "max_gpus" field in the mutation.
+func (m *QuotaMutation) MaxGpus() (r int, exists bool) {
+	v := m.max_gpus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxGpus returns the old "max_gpus" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldMaxGpus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxGpus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxGpus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxGpus: %w", err)
+	}
+	return oldValue.MaxGpus, nil
+}
+
+// AddMaxGpus adds i to the "max_gpus" field.
+func (m *QuotaMutation) AddMaxGpus(i int) {
+	if m.addmax_gpus != nil {
+		*m.addmax_gpus += i
+	} else {
+		m.addmax_gpus = &i
+	}
+}
+
+// AddedMaxGpus returns the value that was added to the "max_gpus" field in this mutation.
+func (m *QuotaMutation) AddedMaxGpus() (r int, exists bool) {
+	v := m.addmax_gpus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxGpus resets all changes to the "max_gpus" field.
+func (m *QuotaMutation) ResetMaxGpus() {
+	m.max_gpus = nil
+	m.addmax_gpus = nil
+}
+
+// SetMaxQueued sets the "max_queued" field.
+func (m *QuotaMutation) SetMaxQueued(i int) {
+	m.max_queued = &i
+	m.addmax_queued = nil
+}
+
+// MaxQueued returns the value of the "max_queued" field in the mutation.
+func (m *QuotaMutation) MaxQueued() (r int, exists bool) {
+	v := m.max_queued
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxQueued returns the old "max_queued" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldMaxQueued(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxQueued is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxQueued requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxQueued: %w", err)
+	}
+	return oldValue.MaxQueued, nil
+}
+
+// AddMaxQueued adds i to the "max_queued" field.
+func (m *QuotaMutation) AddMaxQueued(i int) {
+	if m.addmax_queued != nil {
+		*m.addmax_queued += i
+	} else {
+		m.addmax_queued = &i
+	}
+}
+
+// AddedMaxQueued returns the value that was added to the "max_queued" field in this mutation.
+func (m *QuotaMutation) AddedMaxQueued() (r int, exists bool) {
+	v := m.addmax_queued
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxQueued resets all changes to the "max_queued" field.
+func (m *QuotaMutation) ResetMaxQueued() {
+	m.max_queued = nil
+	m.addmax_queued = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuotaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuotaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuotaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *QuotaMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *QuotaMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Quota entity.
+// If the Quota object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuotaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *QuotaMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the QuotaMutation builder.
+func (m *QuotaMutation) Where(ps ...predicate.Quota) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuotaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuotaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Quota, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuotaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuotaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Quota).
+func (m *QuotaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuotaMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.target_type != nil {
+		fields = append(fields, quota.FieldTargetType)
+	}
+	if m.target_id != nil {
+		fields = append(fields, quota.FieldTargetID)
+	}
+	if m.max_gpus != nil {
+		fields = append(fields, quota.FieldMaxGpus)
+	}
+	if m.max_queued != nil {
+		fields = append(fields, quota.FieldMaxQueued)
+	}
+	if m.created_at != nil {
+		fields = append(fields, quota.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, quota.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuotaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case quota.FieldTargetType:
+		return m.TargetType()
+	case quota.FieldTargetID:
+		return m.TargetID()
+	case quota.FieldMaxGpus:
+		return m.MaxGpus()
+	case quota.FieldMaxQueued:
+		return m.MaxQueued()
+	case quota.FieldCreatedAt:
+		return m.CreatedAt()
+	case quota.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuotaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case quota.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case quota.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case quota.FieldMaxGpus:
+		return m.OldMaxGpus(ctx)
+	case quota.FieldMaxQueued:
+		return m.OldMaxQueued(ctx)
+	case quota.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case quota.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Quota field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuotaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case quota.FieldTargetType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetType(v)
+		return nil
+	case quota.FieldTargetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	case quota.FieldMaxGpus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxGpus(v)
+		return nil
+	case quota.FieldMaxQueued:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxQueued(v)
+		return nil
+	case quota.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case quota.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Quota field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuotaMutation) AddedFields() []string {
+	var fields []string
+	if m.addtarget_id != nil {
+		fields = append(fields, quota.FieldTargetID)
+	}
+	if m.addmax_gpus != nil {
+		fields = append(fields, quota.FieldMaxGpus)
+	}
+	if m.addmax_queued != nil {
+		fields = append(fields, quota.FieldMaxQueued)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuotaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case quota.FieldTargetID:
+		return m.AddedTargetID()
+	case quota.FieldMaxGpus:
+		return m.AddedMaxGpus()
+	case quota.FieldMaxQueued:
+		return m.AddedMaxQueued()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuotaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case quota.FieldTargetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetID(v)
+		return nil
+	case quota.FieldMaxGpus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxGpus(v)
+		return nil
+	case quota.FieldMaxQueued:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxQueued(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Quota numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuotaMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuotaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuotaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Quota nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuotaMutation) ResetField(name string) error {
+	switch name {
+	case quota.FieldTargetType:
+		m.ResetTargetType()
+		return nil
+	case quota.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case quota.FieldMaxGpus:
+		m.ResetMaxGpus()
+		return nil
+	case quota.FieldMaxQueued:
+		m.ResetMaxQueued()
+		return nil
+	case quota.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case quota.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Quota field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuotaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuotaMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuotaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuotaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuotaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuotaMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuotaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Quota unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuotaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Quota edge %s", name)
+}
+
+// ReservationMutation represents an operation that mutates the Reservation nodes in the graph.
+type ReservationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	title         *string
+	device_id     *int
+	adddevice_id  *int
+	gpu_ids       *[]int
+	appendgpu_ids []int
+	project_id    *int
+	addproject_id *int
+	username      *string
+	starts_at     *time.Time
+	ends_at       *time.Time
+	created_by    *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Reservation, error)
+	predicates    []predicate.Reservation
+}
+
+var _ ent.Mutation = (*ReservationMutation)(nil)
+
+// reservationOption allows management of the mutation configuration using functional options.
+type reservationOption func(*ReservationMutation)
+
+// newReservationMutation creates new mutation for the Reservation entity.
+func newReservationMutation(c config, op Op, opts ...reservationOption) *ReservationMutation {
+	m := &ReservationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReservation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReservationID sets the ID field of the mutation.
+func withReservationID(id int) reservationOption {
+	return func(m *ReservationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Reservation
+		)
+		m.oldValue = func(ctx context.Context) (*Reservation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Reservation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReservation sets the old Reservation of the mutation.
+func withReservation(node *Reservation) reservationOption {
+	return func(m *ReservationMutation) {
+		m.oldValue = func(context.Context) (*Reservation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReservationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReservationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Reservation entities.
+func (m *ReservationMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReservationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReservationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Reservation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *ReservationMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ReservationMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
 	}
-	if m.performance != nil {
-		fields = append(fields, modeling.FieldPerformance)
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
-	if m.progress != nil {
-		fields = append(fields, modeling.FieldProgress)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, modeling.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, modeling.FieldUpdatedAt)
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ReservationMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *ReservationMutation) SetDeviceID(i int) {
+	m.device_id = &i
+	m.adddevice_id = nil
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *ReservationMutation) DeviceID() (r int, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
 	}
-	if m.started_at != nil {
-		fields = append(fields, modeling.FieldStartedAt)
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldDeviceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ModelingMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case modeling.FieldLocalID:
-		return m.LocalID()
-	case modeling.FieldTaskID:
-		return m.TaskID()
-	case modeling.FieldParentID:
-		return m.ParentID()
-	case modeling.FieldParentLocalID:
-		return m.ParentLocalID()
-	case modeling.FieldDatasetID:
-		return m.DatasetID()
-	case modeling.FieldParams:
-		return m.Params()
-	case modeling.FieldDatasetStat:
-		return m.DatasetStat()
-	case modeling.FieldModelingType:
-		return m.ModelingType()
-	case modeling.FieldModelingStep:
-		return m.ModelingStep()
-	case modeling.FieldPerformance:
-		return m.Performance()
-	case modeling.FieldProgress:
-		return m.Progress()
-	case modeling.FieldCreatedAt:
-		return m.CreatedAt()
-	case modeling.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case modeling.FieldStartedAt:
-		return m.StartedAt()
+// AddDeviceID adds i to the "device_id" field.
+func (m *ReservationMutation) AddDeviceID(i int) {
+	if m.adddevice_id != nil {
+		*m.adddevice_id += i
+	} else {
+		m.adddevice_id = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ModelingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case modeling.FieldLocalID:
-		return m.OldLocalID(ctx)
-	case modeling.FieldTaskID:
-		return m.OldTaskID(ctx)
-	case modeling.FieldParentID:
-		return m.OldParentID(ctx)
-	case modeling.FieldParentLocalID:
-		return m.OldParentLocalID(ctx)
-	case modeling.FieldDatasetID:
-		return m.OldDatasetID(ctx)
-	case modeling.FieldParams:
-		return m.OldParams(ctx)
-	case modeling.FieldDatasetStat:
-		return m.OldDatasetStat(ctx)
-	case modeling.FieldModelingType:
-		return m.OldModelingType(ctx)
-	case modeling.FieldModelingStep:
-		return m.OldModelingStep(ctx)
-	case modeling.FieldPerformance:
-		return m.OldPerformance(ctx)
-	case modeling.FieldProgress:
-		return m.OldProgress(ctx)
-	case modeling.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case modeling.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case modeling.FieldStartedAt:
-		return m.OldStartedAt(ctx)
+// AddedDeviceID returns the value that was added to the "device_id" field in this mutation.
+func (m *ReservationMutation) AddedDeviceID() (r int, exists bool) {
+	v := m.adddevice_id
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Modeling field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModelingMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case modeling.FieldLocalID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLocalID(v)
-		return nil
-	case modeling.FieldTaskID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTaskID(v)
-		return nil
-	case modeling.FieldParentID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetParentID(v)
-		return nil
-	case modeling.FieldParentLocalID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetParentLocalID(v)
-		return nil
-	case modeling.FieldDatasetID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDatasetID(v)
-		return nil
-	case modeling.FieldParams:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetParams(v)
-		return nil
-	case modeling.FieldDatasetStat:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDatasetStat(v)
-		return nil
-	case modeling.FieldModelingType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetModelingType(v)
-		return nil
-	case modeling.FieldModelingStep:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetModelingStep(v)
-		return nil
-	case modeling.FieldPerformance:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPerformance(v)
-		return nil
-	case modeling.FieldProgress:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProgress(v)
-		return nil
-	case modeling.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case modeling.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case modeling.FieldStartedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartedAt(v)
-		return nil
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *ReservationMutation) ResetDeviceID() {
+	m.device_id = nil
+	m.adddevice_id = nil
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (m *ReservationMutation) SetGpuIds(i []int) {
+	m.gpu_ids = &i
+	m.appendgpu_ids = nil
+}
+
+// GpuIds returns the value of the "gpu_ids" field in the mutation.
+func (m *ReservationMutation) GpuIds() (r []int, exists bool) {
+	v := m.gpu_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGpuIds returns the old "gpu_ids" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldGpuIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGpuIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGpuIds requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown Modeling field %s", name)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGpuIds: %w", err)
+	}
+	return oldValue.GpuIds, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ModelingMutation) AddedFields() []string {
-	var fields []string
-	if m.addlocal_id != nil {
-		fields = append(fields, modeling.FieldLocalID)
+// AppendGpuIds adds i to the "gpu_ids" field.
+func (m *ReservationMutation) AppendGpuIds(i []int) {
+	m.appendgpu_ids = append(m.appendgpu_ids, i...)
+}
+
+// AppendedGpuIds returns the list of values that were appended to the "gpu_ids" field in this mutation.
+func (m *ReservationMutation) AppendedGpuIds() ([]int, bool) {
+	if len(m.appendgpu_ids) == 0 {
+		return nil, false
 	}
-	if m.addparent_id != nil {
-		fields = append(fields, modeling.FieldParentID)
+	return m.appendgpu_ids, true
+}
+
+// ClearGpuIds clears the value of the "gpu_ids" field.
+func (m *ReservationMutation) ClearGpuIds() {
+	m.gpu_ids = nil
+	m.appendgpu_ids = nil
+	m.clearedFields[reservation.FieldGpuIds] = struct{}{}
+}
+
+// GpuIdsCleared returns if the "gpu_ids" field was cleared in this mutation.
+func (m *ReservationMutation) GpuIdsCleared() bool {
+	_, ok := m.clearedFields[reservation.FieldGpuIds]
+	return ok
+}
+
+// ResetGpuIds resets all changes to the "gpu_ids" field.
+func (m *ReservationMutation) ResetGpuIds() {
+	m.gpu_ids = nil
+	m.appendgpu_ids = nil
+	delete(m.clearedFields, reservation.FieldGpuIds)
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *ReservationMutation) SetProjectID(i int) {
+	m.project_id = &i
+	m.addproject_id = nil
+}
+
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *ReservationMutation) ProjectID() (r int, exists bool) {
+	v := m.project_id
+	if v == nil {
+		return
 	}
-	if m.addparent_local_id != nil {
-		fields = append(fields, modeling.FieldParentLocalID)
+	return *v, true
+}
+
+// OldProjectID returns the old "project_id" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldProjectID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
 	}
-	if m.adddataset_id != nil {
-		fields = append(fields, modeling.FieldDatasetID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
 	}
-	if m.addprogress != nil {
-		fields = append(fields, modeling.FieldProgress)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
 	}
-	return fields
+	return oldValue.ProjectID, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ModelingMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case modeling.FieldLocalID:
-		return m.AddedLocalID()
-	case modeling.FieldParentID:
-		return m.AddedParentID()
-	case modeling.FieldParentLocalID:
-		return m.AddedParentLocalID()
-	case modeling.FieldDatasetID:
-		return m.AddedDatasetID()
-	case modeling.FieldProgress:
-		return m.AddedProgress()
+// AddProjectID adds i to the "project_id" field.
+func (m *ReservationMutation) AddProjectID(i int) {
+	if m.addproject_id != nil {
+		*m.addproject_id += i
+	} else {
+		m.addproject_id = &i
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModelingMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case modeling.FieldLocalID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLocalID(v)
-		return nil
-	case modeling.FieldParentID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddParentID(v)
-		return nil
-	case modeling.FieldParentLocalID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddParentLocalID(v)
-		return nil
-	case modeling.FieldDatasetID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDatasetID(v)
-		return nil
-	case modeling.FieldProgress:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProgress(v)
-		return nil
+// AddedProjectID returns the value that was added to the "project_id" field in this mutation.
+func (m *ReservationMutation) AddedProjectID() (r int, exists bool) {
+	v := m.addproject_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Modeling numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ModelingMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(modeling.FieldTaskID) {
-		fields = append(fields, modeling.FieldTaskID)
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *ReservationMutation) ResetProjectID() {
+	m.project_id = nil
+	m.addproject_id = nil
+}
+
+// SetUsername sets the "username" field.
+func (m *ReservationMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *ReservationMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(modeling.FieldParentID) {
-		fields = append(fields, modeling.FieldParentID)
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(modeling.FieldParentLocalID) {
-		fields = append(fields, modeling.FieldParentLocalID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
-	if m.FieldCleared(modeling.FieldDatasetID) {
-		fields = append(fields, modeling.FieldDatasetID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	if m.FieldCleared(modeling.FieldStartedAt) {
-		fields = append(fields, modeling.FieldStartedAt)
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *ReservationMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *ReservationMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *ReservationMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ModelingMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// OldStartsAt returns the old "starts_at" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ModelingMutation) ClearField(name string) error {
-	switch name {
-	case modeling.FieldTaskID:
-		m.ClearTaskID()
-		return nil
-	case modeling.FieldParentID:
-		m.ClearParentID()
-		return nil
-	case modeling.FieldParentLocalID:
-		m.ClearParentLocalID()
-		return nil
-	case modeling.FieldDatasetID:
-		m.ClearDatasetID()
-		return nil
-	case modeling.FieldStartedAt:
-		m.ClearStartedAt()
-		return nil
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *ReservationMutation) ResetStartsAt() {
+	m.starts_at = nil
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *ReservationMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *ReservationMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Modeling nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ModelingMutation) ResetField(name string) error {
-	switch name {
-	case modeling.FieldLocalID:
-		m.ResetLocalID()
-		return nil
-	case modeling.FieldTaskID:
-		m.ResetTaskID()
-		return nil
-	case modeling.FieldParentID:
-		m.ResetParentID()
-		return nil
-	case modeling.FieldParentLocalID:
-		m.ResetParentLocalID()
-		return nil
-	case modeling.FieldDatasetID:
-		m.ResetDatasetID()
-		return nil
-	case modeling.FieldParams:
-		m.ResetParams()
-		return nil
-	case modeling.FieldDatasetStat:
-		m.ResetDatasetStat()
-		return nil
-	case modeling.FieldModelingType:
-		m.ResetModelingType()
-		return nil
-	case modeling.FieldModelingStep:
-		m.ResetModelingStep()
-		return nil
-	case modeling.FieldPerformance:
-		m.ResetPerformance()
-		return nil
-	case modeling.FieldProgress:
-		m.ResetProgress()
-		return nil
-	case modeling.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case modeling.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case modeling.FieldStartedAt:
-		m.ResetStartedAt()
-		return nil
+// OldEndsAt returns the old "ends_at" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldEndsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *ReservationMutation) ResetEndsAt() {
+	m.ends_at = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *ReservationMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *ReservationMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Modeling field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ModelingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.task != nil {
-		edges = append(edges, modeling.EdgeTask)
+// OldCreatedBy returns the old "created_by" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
-	if m.modeling_details != nil {
-		edges = append(edges, modeling.EdgeModelingDetails)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
-	if m.modeling_models != nil {
-		edges = append(edges, modeling.EdgeModelingModels)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	if m.trials != nil {
-		edges = append(edges, modeling.EdgeTrials)
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *ReservationMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReservationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReservationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ModelingMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case modeling.EdgeTask:
-		if id := m.task; id != nil {
-			return []ent.Value{*id}
-		}
-	case modeling.EdgeModelingDetails:
-		ids := make([]ent.Value, 0, len(m.modeling_details))
-		for id := range m.modeling_details {
-			ids = append(ids, id)
-		}
-		return ids
-	case modeling.EdgeModelingModels:
-		ids := make([]ent.Value, 0, len(m.modeling_models))
-		for id := range m.modeling_models {
-			ids = append(ids, id)
-		}
-		return ids
-	case modeling.EdgeTrials:
-		ids := make([]ent.Value, 0, len(m.trials))
-		for id := range m.trials {
-			ids = append(ids, id)
-		}
-		return ids
+// OldCreatedAt returns the old "created_at" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ModelingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedmodeling_details != nil {
-		edges = append(edges, modeling.EdgeModelingDetails)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReservationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReservationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReservationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	if m.removedmodeling_models != nil {
-		edges = append(edges, modeling.EdgeModelingModels)
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Reservation entity.
+// If the Reservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReservationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.removedtrials != nil {
-		edges = append(edges, modeling.EdgeTrials)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ModelingMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case modeling.EdgeModelingDetails:
-		ids := make([]ent.Value, 0, len(m.removedmodeling_details))
-		for id := range m.removedmodeling_details {
-			ids = append(ids, id)
-		}
-		return ids
-	case modeling.EdgeModelingModels:
-		ids := make([]ent.Value, 0, len(m.removedmodeling_models))
-		for id := range m.removedmodeling_models {
-			ids = append(ids, id)
-		}
-		return ids
-	case modeling.EdgeTrials:
-		ids := make([]ent.Value, 0, len(m.removedtrials))
-		for id := range m.removedtrials {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReservationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ReservationMutation builder.
+func (m *ReservationMutation) Where(ps ...predicate.Reservation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReservationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReservationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Reservation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return nil
+	m.Where(p...)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ModelingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedtask {
-		edges = append(edges, modeling.EdgeTask)
+// Op returns the operation name.
+func (m *ReservationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReservationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Reservation).
+func (m *ReservationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReservationMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.title != nil {
+		fields = append(fields, reservation.FieldTitle)
 	}
-	if m.clearedmodeling_details {
-		edges = append(edges, modeling.EdgeModelingDetails)
+	if m.device_id != nil {
+		fields = append(fields, reservation.FieldDeviceID)
 	}
-	if m.clearedmodeling_models {
-		edges = append(edges, modeling.EdgeModelingModels)
+	if m.gpu_ids != nil {
+		fields = append(fields, reservation.FieldGpuIds)
 	}
-	if m.clearedtrials {
-		edges = append(edges, modeling.EdgeTrials)
+	if m.project_id != nil {
+		fields = append(fields, reservation.FieldProjectID)
 	}
-	return edges
+	if m.username != nil {
+		fields = append(fields, reservation.FieldUsername)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, reservation.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, reservation.FieldEndsAt)
+	}
+	if m.created_by != nil {
+		fields = append(fields, reservation.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, reservation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, reservation.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ModelingMutation) EdgeCleared(name string) bool {
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReservationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case modeling.EdgeTask:
-		return m.clearedtask
-	case modeling.EdgeModelingDetails:
-		return m.clearedmodeling_details
-	case modeling.EdgeModelingModels:
-		return m.clearedmodeling_models
-	case modeling.EdgeTrials:
-		return m.clearedtrials
+	case reservation.FieldTitle:
+		return m.Title()
+	case reservation.FieldDeviceID:
+		return m.DeviceID()
+	case reservation.FieldGpuIds:
+		return m.GpuIds()
+	case reservation.FieldProjectID:
+		return m.ProjectID()
+	case reservation.FieldUsername:
+		return m.Username()
+	case reservation.FieldStartsAt:
+		return m.StartsAt()
+	case reservation.FieldEndsAt:
+		return m.EndsAt()
+	case reservation.FieldCreatedBy:
+		return m.CreatedBy()
+	case reservation.FieldCreatedAt:
+		return m.CreatedAt()
+	case reservation.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return false
+	return nil, false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ModelingMutation) ClearEdge(name string) error {
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReservationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case modeling.EdgeTask:
-		m.ClearTask()
+	case reservation.FieldTitle:
+		return m.OldTitle(ctx)
+	case reservation.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case reservation.FieldGpuIds:
+		return m.OldGpuIds(ctx)
+	case reservation.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case reservation.FieldUsername:
+		return m.OldUsername(ctx)
+	case reservation.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case reservation.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case reservation.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case reservation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case reservation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Reservation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReservationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reservation.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case reservation.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case reservation.FieldGpuIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGpuIds(v)
+		return nil
+	case reservation.FieldProjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case reservation.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case reservation.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Modeling unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ModelingMutation) ResetEdge(name string) error {
-	switch name {
-	case modeling.EdgeTask:
-		m.ResetTask()
+	case reservation.FieldEndsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
 		return nil
-	case modeling.EdgeModelingDetails:
-		m.ResetModelingDetails()
+	case reservation.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
 		return nil
-	case modeling.EdgeModelingModels:
-		m.ResetModelingModels()
+	case reservation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
-	case modeling.EdgeTrials:
-		m.ResetTrials()
+	case reservation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Modeling edge %s", name)
-}
-
-// ModelingDetailsMutation represents an operation that mutates the ModelingDetails nodes in the graph.
-type ModelingDetailsMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *int
-	model           *string
-	data_type       *string
-	data            *[]string
-	appenddata      []string
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	modeling        *int
-	clearedmodeling bool
-	done            bool
-	oldValue        func(context.Context) (*ModelingDetails, error)
-	predicates      []predicate.ModelingDetails
+	return fmt.Errorf("unknown Reservation field %s", name)
 }
 
-var _ ent.Mutation = (*ModelingDetailsMutation)(nil)
-
-// modelingdetailsOption allows management of the mutation configuration using functional options.
-type modelingdetailsOption func(*ModelingDetailsMutation)
-
-// newModelingDetailsMutation creates new mutation for the ModelingDetails entity.
-func newModelingDetailsMutation(c config, op Op, opts ...modelingdetailsOption) *ModelingDetailsMutation {
-	m := &ModelingDetailsMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeModelingDetails,
-		clearedFields: make(map[string]struct{}),
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReservationMutation) AddedFields() []string {
+	var fields []string
+	if m.adddevice_id != nil {
+		fields = append(fields, reservation.FieldDeviceID)
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.addproject_id != nil {
+		fields = append(fields, reservation.FieldProjectID)
 	}
-	return m
+	return fields
 }
 
-// withModelingDetailsID sets the ID field of the mutation.
-func withModelingDetailsID(id int) modelingdetailsOption {
-	return func(m *ModelingDetailsMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ModelingDetails
-		)
-		m.oldValue = func(ctx context.Context) (*ModelingDetails, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ModelingDetails.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReservationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case reservation.FieldDeviceID:
+		return m.AddedDeviceID()
+	case reservation.FieldProjectID:
+		return m.AddedProjectID()
 	}
+	return nil, false
 }
 
-// withModelingDetails sets the old ModelingDetails of the mutation.
-func withModelingDetails(node *ModelingDetails) modelingdetailsOption {
-	return func(m *ModelingDetailsMutation) {
-		m.oldValue = func(context.Context) (*ModelingDetails, error) {
-			return node, nil
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReservationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case reservation.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.id = &node.ID
-	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ModelingDetailsMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ModelingDetailsMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ModelingDetails entities.
-func (m *ModelingDetailsMutation) SetID(id int) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ModelingDetailsMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+		m.AddDeviceID(v)
+		return nil
+	case reservation.FieldProjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProjectID(v)
+		return nil
 	}
-	return *m.id, true
+	return fmt.Errorf("unknown Reservation numeric field %s", name)
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ModelingDetailsMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ModelingDetails.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReservationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(reservation.FieldGpuIds) {
+		fields = append(fields, reservation.FieldGpuIds)
 	}
+	return fields
 }
 
-// SetModelingID sets the "modeling_id" field.
-func (m *ModelingDetailsMutation) SetModelingID(i int) {
-	m.modeling = &i
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReservationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ModelingID returns the value of the "modeling_id" field in the mutation.
-func (m *ModelingDetailsMutation) ModelingID() (r int, exists bool) {
-	v := m.modeling
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReservationMutation) ClearField(name string) error {
+	switch name {
+	case reservation.FieldGpuIds:
+		m.ClearGpuIds()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Reservation nullable field %s", name)
 }
 
-// OldModelingID returns the old "modeling_id" field's value of the ModelingDetails entity.
-// If the ModelingDetails object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingDetailsMutation) OldModelingID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelingID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelingID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelingID: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReservationMutation) ResetField(name string) error {
+	switch name {
+	case reservation.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case reservation.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case reservation.FieldGpuIds:
+		m.ResetGpuIds()
+		return nil
+	case reservation.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case reservation.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case reservation.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case reservation.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case reservation.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case reservation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case reservation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.ModelingID, nil
+	return fmt.Errorf("unknown Reservation field %s", name)
 }
 
-// ClearModelingID clears the value of the "modeling_id" field.
-func (m *ModelingDetailsMutation) ClearModelingID() {
-	m.modeling = nil
-	m.clearedFields[modelingdetails.FieldModelingID] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReservationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ModelingIDCleared returns if the "modeling_id" field was cleared in this mutation.
-func (m *ModelingDetailsMutation) ModelingIDCleared() bool {
-	_, ok := m.clearedFields[modelingdetails.FieldModelingID]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReservationMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetModelingID resets all changes to the "modeling_id" field.
-func (m *ModelingDetailsMutation) ResetModelingID() {
-	m.modeling = nil
-	delete(m.clearedFields, modelingdetails.FieldModelingID)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReservationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetModel sets the "model" field.
-func (m *ModelingDetailsMutation) SetModel(s string) {
-	m.model = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReservationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Model returns the value of the "model" field in the mutation.
-func (m *ModelingDetailsMutation) Model() (r string, exists bool) {
-	v := m.model
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReservationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldModel returns the old "model" field's value of the ModelingDetails entity.
-// If the ModelingDetails object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingDetailsMutation) OldModel(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModel is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModel requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModel: %w", err)
-	}
-	return oldValue.Model, nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReservationMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResetModel resets all changes to the "model" field.
-func (m *ModelingDetailsMutation) ResetModel() {
-	m.model = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReservationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Reservation unique edge %s", name)
 }
 
-// SetDataType sets the "data_type" field.
-func (m *ModelingDetailsMutation) SetDataType(s string) {
-	m.data_type = &s
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReservationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Reservation edge %s", name)
 }
 
-// DataType returns the value of the "data_type" field in the mutation.
-func (m *ModelingDetailsMutation) DataType() (r string, exists bool) {
-	v := m.data_type
-	if v == nil {
-		return
-	}
-	return *v, true
+// ScheduleMutation represents an operation that mutates the Schedule nodes in the graph.
+type ScheduleMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	parent_id           *int
+	addparent_id        *int
+	trigger             *string
+	cron                *string
+	dataset_id          *int
+	adddataset_id       *int
+	paused              *bool
+	priority            *int
+	addpriority         *int
+	username            *string
+	trained_fingerprint *string
+	next_run_at         *time.Time
+	last_run_at         *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	task                *int
+	clearedtask         bool
+	histories           map[int]struct{}
+	removedhistories    map[int]struct{}
+	clearedhistories    bool
+	done                bool
+	oldValue            func(context.Context) (*Schedule, error)
+	predicates          []predicate.Schedule
 }
 
-// OldDataType returns the old "data_type" field's value of the ModelingDetails entity.
-// If the ModelingDetails object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingDetailsMutation) OldDataType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDataType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDataType requires an ID field in the mutation")
+var _ ent.Mutation = (*ScheduleMutation)(nil)
+
+// scheduleOption allows management of the mutation configuration using functional options.
+type scheduleOption func(*ScheduleMutation)
+
+// newScheduleMutation creates new mutation for the Schedule entity.
+func newScheduleMutation(c config, op Op, opts ...scheduleOption) *ScheduleMutation {
+	m := &ScheduleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSchedule,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDataType: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.DataType, nil
+	return m
 }
 
-// ResetDataType resets all changes to the "data_type" field.
-func (m *ModelingDetailsMutation) ResetDataType() {
-	m.data_type = nil
+// withScheduleID sets the ID field of the mutation.
+func withScheduleID(id int) scheduleOption {
+	return func(m *ScheduleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Schedule
+		)
+		m.oldValue = func(ctx context.Context) (*Schedule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Schedule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetData sets the "data" field.
-func (m *ModelingDetailsMutation) SetData(s []string) {
-	m.data = &s
-	m.appenddata = nil
+// withSchedule sets the old Schedule of the mutation.
+func withSchedule(node *Schedule) scheduleOption {
+	return func(m *ScheduleMutation) {
+		m.oldValue = func(context.Context) (*Schedule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Data returns the value of the "data" field in the mutation.
-func (m *ModelingDetailsMutation) Data() (r []string, exists bool) {
-	v := m.data
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScheduleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldData returns the old "data" field's value of the ModelingDetails entity.
-// If the ModelingDetails object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingDetailsMutation) OldData(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldData is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldData requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldData: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScheduleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.Data, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AppendData adds s to the "data" field.
-func (m *ModelingDetailsMutation) AppendData(s []string) {
-	m.appenddata = append(m.appenddata, s...)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Schedule entities.
+func (m *ScheduleMutation) SetID(id int) {
+	m.id = &id
 }
 
-// AppendedData returns the list of values that were appended to the "data" field in this mutation.
-func (m *ModelingDetailsMutation) AppendedData() ([]string, bool) {
-	if len(m.appenddata) == 0 {
-		return nil, false
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScheduleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return m.appenddata, true
+	return *m.id, true
 }
 
-// ResetData resets all changes to the "data" field.
-func (m *ModelingDetailsMutation) ResetData() {
-	m.data = nil
-	m.appenddata = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScheduleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Schedule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ModelingDetailsMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetTaskID sets the "task_id" field.
+func (m *ScheduleMutation) SetTaskID(i int) {
+	m.task = &i
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ModelingDetailsMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *ScheduleMutation) TaskID() (r int, exists bool) {
+	v := m.task
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ModelingDetails entity.
-// If the ModelingDetails object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingDetailsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ScheduleMutation) OldTaskID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ModelingDetailsMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// ClearModeling clears the "modeling" edge to the Modeling entity.
-func (m *ModelingDetailsMutation) ClearModeling() {
-	m.clearedmodeling = true
-	m.clearedFields[modelingdetails.FieldModelingID] = struct{}{}
-}
-
-// ModelingCleared reports if the "modeling" edge to the Modeling entity was cleared.
-func (m *ModelingDetailsMutation) ModelingCleared() bool {
-	return m.ModelingIDCleared() || m.clearedmodeling
-}
-
-// ModelingIDs returns the "modeling" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ModelingID instead. It exists only for internal usage by the builders.
-func (m *ModelingDetailsMutation) ModelingIDs() (ids []int) {
-	if id := m.modeling; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return
-}
-
-// ResetModeling resets all changes to the "modeling" edge.
-func (m *ModelingDetailsMutation) ResetModeling() {
-	m.modeling = nil
-	m.clearedmodeling = false
-}
-
-// Where appends a list predicates to the ModelingDetailsMutation builder.
-func (m *ModelingDetailsMutation) Where(ps ...predicate.ModelingDetails) {
-	m.predicates = append(m.predicates, ps...)
+	return oldValue.TaskID, nil
 }
 
-// WhereP appends storage-level predicates to the ModelingDetailsMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ModelingDetailsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ModelingDetails, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearTaskID clears the value of the "task_id" field.
+func (m *ScheduleMutation) ClearTaskID() {
+	m.task = nil
+	m.clearedFields[schedule.FieldTaskID] = struct{}{}
 }
 
-// Op returns the operation name.
-func (m *ModelingDetailsMutation) Op() Op {
-	return m.op
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *ScheduleMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldTaskID]
+	return ok
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ModelingDetailsMutation) SetOp(op Op) {
-	m.op = op
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *ScheduleMutation) ResetTaskID() {
+	m.task = nil
+	delete(m.clearedFields, schedule.FieldTaskID)
 }
 
-// Type returns the node type of this mutation (ModelingDetails).
-func (m *ModelingDetailsMutation) Type() string {
-	return m.typ
+// SetParentID sets the "parent_id" field.
+func (m *ScheduleMutation) SetParentID(i int) {
+	m.parent_id = &i
+	m.addparent_id = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ModelingDetailsMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.modeling != nil {
-		fields = append(fields, modelingdetails.FieldModelingID)
-	}
-	if m.model != nil {
-		fields = append(fields, modelingdetails.FieldModel)
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *ScheduleMutation) ParentID() (r int, exists bool) {
+	v := m.parent_id
+	if v == nil {
+		return
 	}
-	if m.data_type != nil {
-		fields = append(fields, modelingdetails.FieldDataType)
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldParentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
-	if m.data != nil {
-		fields = append(fields, modelingdetails.FieldData)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, modelingdetails.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
 	}
-	return fields
+	return oldValue.ParentID, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ModelingDetailsMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case modelingdetails.FieldModelingID:
-		return m.ModelingID()
-	case modelingdetails.FieldModel:
-		return m.Model()
-	case modelingdetails.FieldDataType:
-		return m.DataType()
-	case modelingdetails.FieldData:
-		return m.Data()
-	case modelingdetails.FieldCreatedAt:
-		return m.CreatedAt()
+// AddParentID adds i to the "parent_id" field.
+func (m *ScheduleMutation) AddParentID(i int) {
+	if m.addparent_id != nil {
+		*m.addparent_id += i
+	} else {
+		m.addparent_id = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ModelingDetailsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case modelingdetails.FieldModelingID:
-		return m.OldModelingID(ctx)
-	case modelingdetails.FieldModel:
-		return m.OldModel(ctx)
-	case modelingdetails.FieldDataType:
-		return m.OldDataType(ctx)
-	case modelingdetails.FieldData:
-		return m.OldData(ctx)
-	case modelingdetails.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+// AddedParentID returns the value that was added to the "parent_id" field in this mutation.
+func (m *ScheduleMutation) AddedParentID() (r int, exists bool) {
+	v := m.addparent_id
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown ModelingDetails field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModelingDetailsMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case modelingdetails.FieldModelingID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetModelingID(v)
-		return nil
-	case modelingdetails.FieldModel:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetModel(v)
-		return nil
-	case modelingdetails.FieldDataType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDataType(v)
-		return nil
-	case modelingdetails.FieldData:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetData(v)
-		return nil
-	case modelingdetails.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown ModelingDetails field %s", name)
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *ScheduleMutation) ResetParentID() {
+	m.parent_id = nil
+	m.addparent_id = nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ModelingDetailsMutation) AddedFields() []string {
-	var fields []string
-	return fields
+// SetTrigger sets the "trigger" field.
+func (m *ScheduleMutation) SetTrigger(s string) {
+	m.trigger = &s
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ModelingDetailsMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *ScheduleMutation) Trigger() (r string, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModelingDetailsMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldTrigger returns the old "trigger" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldTrigger(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ModelingDetails numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ModelingDetailsMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(modelingdetails.FieldModelingID) {
-		fields = append(fields, modelingdetails.FieldModelingID)
-	}
-	return fields
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *ScheduleMutation) ResetTrigger() {
+	m.trigger = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ModelingDetailsMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetCron sets the "cron" field.
+func (m *ScheduleMutation) SetCron(s string) {
+	m.cron = &s
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ModelingDetailsMutation) ClearField(name string) error {
-	switch name {
-	case modelingdetails.FieldModelingID:
-		m.ClearModelingID()
-		return nil
+// Cron returns the value of the "cron" field in the mutation.
+func (m *ScheduleMutation) Cron() (r string, exists bool) {
+	v := m.cron
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ModelingDetails nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ModelingDetailsMutation) ResetField(name string) error {
-	switch name {
-	case modelingdetails.FieldModelingID:
-		m.ResetModelingID()
-		return nil
-	case modelingdetails.FieldModel:
-		m.ResetModel()
-		return nil
-	case modelingdetails.FieldDataType:
-		m.ResetDataType()
-		return nil
-	case modelingdetails.FieldData:
-		m.ResetData()
-		return nil
-	case modelingdetails.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// OldCron returns the old "cron" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldCron(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCron is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ModelingDetails field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCron requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCron: %w", err)
+	}
+	return oldValue.Cron, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ModelingDetailsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.modeling != nil {
-		edges = append(edges, modelingdetails.EdgeModeling)
-	}
-	return edges
+// ResetCron resets all changes to the "cron" field.
+func (m *ScheduleMutation) ResetCron() {
+	m.cron = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ModelingDetailsMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case modelingdetails.EdgeModeling:
-		if id := m.modeling; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// SetDatasetID sets the "dataset_id" field.
+func (m *ScheduleMutation) SetDatasetID(i int) {
+	m.dataset_id = &i
+	m.adddataset_id = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ModelingDetailsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// DatasetID returns the value of the "dataset_id" field in the mutation.
+func (m *ScheduleMutation) DatasetID() (r int, exists bool) {
+	v := m.dataset_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ModelingDetailsMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// OldDatasetID returns the old "dataset_id" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldDatasetID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDatasetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDatasetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDatasetID: %w", err)
+	}
+	return oldValue.DatasetID, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ModelingDetailsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmodeling {
-		edges = append(edges, modelingdetails.EdgeModeling)
+// AddDatasetID adds i to the "dataset_id" field.
+func (m *ScheduleMutation) AddDatasetID(i int) {
+	if m.adddataset_id != nil {
+		*m.adddataset_id += i
+	} else {
+		m.adddataset_id = &i
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ModelingDetailsMutation) EdgeCleared(name string) bool {
-	switch name {
-	case modelingdetails.EdgeModeling:
-		return m.clearedmodeling
+// AddedDatasetID returns the value that was added to the "dataset_id" field in this mutation.
+func (m *ScheduleMutation) AddedDatasetID() (r int, exists bool) {
+	v := m.adddataset_id
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
+}
+
+// ResetDatasetID resets all changes to the "dataset_id" field.
+func (m *ScheduleMutation) ResetDatasetID() {
+	m.dataset_id = nil
+	m.adddataset_id = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ModelingDetailsMutation) ClearEdge(name string) error {
-	switch name {
-	case modelingdetails.EdgeModeling:
-		m.ClearModeling()
-		return nil
+// SetPaused sets the "paused" field.
+func (m *ScheduleMutation) SetPaused(b bool) {
+	m.paused = &b
+}
+
+// Paused returns the value of the "paused" field in the mutation.
+func (m *ScheduleMutation) Paused() (r bool, exists bool) {
+	v := m.paused
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ModelingDetails unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ModelingDetailsMutation) ResetEdge(name string) error {
-	switch name {
-	case modelingdetails.EdgeModeling:
-		m.ResetModeling()
-		return nil
+// OldPaused returns the old "paused" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldPaused(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaused is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ModelingDetails edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaused requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaused: %w", err)
+	}
+	return oldValue.Paused, nil
 }
 
-// ModelingModelsMutation represents an operation that mutates the ModelingModels nodes in the graph.
-type ModelingModelsMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *int
-	data_type       *string
-	data            *string
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	modeling        *int
-	clearedmodeling bool
-	done            bool
-	oldValue        func(context.Context) (*ModelingModels, error)
-	predicates      []predicate.ModelingModels
+// ResetPaused resets all changes to the "paused" field.
+func (m *ScheduleMutation) ResetPaused() {
+	m.paused = nil
 }
 
-var _ ent.Mutation = (*ModelingModelsMutation)(nil)
+// SetPriority sets the "priority" field.
+func (m *ScheduleMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
 
-// modelingmodelsOption allows management of the mutation configuration using functional options.
-type modelingmodelsOption func(*ModelingModelsMutation)
+// Priority returns the value of the "priority" field in the mutation.
+func (m *ScheduleMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newModelingModelsMutation creates new mutation for the ModelingModels entity.
-func newModelingModelsMutation(c config, op Op, opts ...modelingmodelsOption) *ModelingModelsMutation {
-	m := &ModelingModelsMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeModelingModels,
-		clearedFields: make(map[string]struct{}),
+// OldPriority returns the old "priority" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
 }
 
-// withModelingModelsID sets the ID field of the mutation.
-func withModelingModelsID(id int) modelingmodelsOption {
-	return func(m *ModelingModelsMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ModelingModels
-		)
-		m.oldValue = func(ctx context.Context) (*ModelingModels, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ModelingModels.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddPriority adds i to the "priority" field.
+func (m *ScheduleMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
 	}
 }
 
-// withModelingModels sets the old ModelingModels of the mutation.
-func withModelingModels(node *ModelingModels) modelingmodelsOption {
-	return func(m *ModelingModelsMutation) {
-		m.oldValue = func(context.Context) (*ModelingModels, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *ScheduleMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ModelingModelsMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetPriority resets all changes to the "priority" field.
+func (m *ScheduleMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ModelingModelsMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// SetUsername sets the "username" field.
+func (m *ScheduleMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *ScheduleMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ModelingModels entities.
-func (m *ModelingModelsMutation) SetID(id int) {
-	m.id = &id
+// OldUsername returns the old "username" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ModelingModelsMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// ResetUsername resets all changes to the "username" field.
+func (m *ScheduleMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetTrainedFingerprint sets the "trained_fingerprint" field.
+func (m *ScheduleMutation) SetTrainedFingerprint(s string) {
+	m.trained_fingerprint = &s
+}
+
+// TrainedFingerprint returns the value of the "trained_fingerprint" field in the mutation.
+func (m *ScheduleMutation) TrainedFingerprint() (r string, exists bool) {
+	v := m.trained_fingerprint
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ModelingModelsMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ModelingModels.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldTrainedFingerprint returns the old "trained_fingerprint" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleMutation) OldTrainedFingerprint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrainedFingerprint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrainedFingerprint requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrainedFingerprint: %w", err)
+	}
+	return oldValue.TrainedFingerprint, nil
 }
 
-// SetModelingID sets the "modeling_id" field.
-func (m *ModelingModelsMutation) SetModelingID(i int) {
-	m.modeling = &i
+// ResetTrainedFingerprint resets all changes to the "trained_fingerprint" field.
+func (m *ScheduleMutation) ResetTrainedFingerprint() {
+	m.trained_fingerprint = nil
 }
 
-// ModelingID returns the value of the "modeling_id" field in the mutation.
-func (m *ModelingModelsMutation) ModelingID() (r int, exists bool) {
-	v := m.modeling
+// SetNextRunAt sets the "next_run_at" field.
+func (m *ScheduleMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
+}
+
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *ScheduleMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelingID returns the old "modeling_id" field's value of the ModelingModels entity.
-// If the ModelingModels object wasn't provided to the builder, the object is fetched from the database.
+// OldNextRunAt returns the old "next_run_at" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingModelsMutation) OldModelingID(ctx context.Context) (v int, err error) {
+func (m *ScheduleMutation) OldNextRunAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelingID is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelingID requires an ID field in the mutation")
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelingID: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
 	}
-	return oldValue.ModelingID, nil
+	return oldValue.NextRunAt, nil
 }
 
-// ClearModelingID clears the value of the "modeling_id" field.
-func (m *ModelingModelsMutation) ClearModelingID() {
-	m.modeling = nil
-	m.clearedFields[modelingmodels.FieldModelingID] = struct{}{}
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (m *ScheduleMutation) ClearNextRunAt() {
+	m.next_run_at = nil
+	m.clearedFields[schedule.FieldNextRunAt] = struct{}{}
 }
 
-// ModelingIDCleared returns if the "modeling_id" field was cleared in this mutation.
-func (m *ModelingModelsMutation) ModelingIDCleared() bool {
-	_, ok := m.clearedFields[modelingmodels.FieldModelingID]
+// NextRunAtCleared returns if the "next_run_at" field was cleared in this mutation.
+func (m *ScheduleMutation) NextRunAtCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldNextRunAt]
 	return ok
 }
 
-// ResetModelingID resets all changes to the "modeling_id" field.
-func (m *ModelingModelsMutation) ResetModelingID() {
-	m.modeling = nil
-	delete(m.clearedFields, modelingmodels.FieldModelingID)
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *ScheduleMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+	delete(m.clearedFields, schedule.FieldNextRunAt)
 }
 
-// SetDataType sets the "data_type" field.
-func (m *ModelingModelsMutation) SetDataType(s string) {
-	m.data_type = &s
+// SetLastRunAt sets the "last_run_at" field.
+func (m *ScheduleMutation) SetLastRunAt(t time.Time) {
+	m.last_run_at = &t
 }
 
-// DataType returns the value of the "data_type" field in the mutation.
-func (m *ModelingModelsMutation) DataType() (r string, exists bool) {
-	v := m.data_type
+// LastRunAt returns the value of the "last_run_at" field in the mutation.
+func (m *ScheduleMutation) LastRunAt() (r time.Time, exists bool) {
+	v := m.last_run_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDataType returns the old "data_type" field's value of the ModelingModels entity.
-// If the ModelingModels object wasn't provided to the builder, the object is fetched from the database.
+// OldLastRunAt returns the old "last_run_at" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingModelsMutation) OldDataType(ctx context.Context) (v string, err error) {
+func (m *ScheduleMutation) OldLastRunAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDataType is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDataType requires an ID field in the mutation")
+		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDataType: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
 	}
-	return oldValue.DataType, nil
+	return oldValue.LastRunAt, nil
 }
 
-// ResetDataType resets all changes to the "data_type" field.
-func (m *ModelingModelsMutation) ResetDataType() {
-	m.data_type = nil
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (m *ScheduleMutation) ClearLastRunAt() {
+	m.last_run_at = nil
+	m.clearedFields[schedule.FieldLastRunAt] = struct{}{}
 }
 
-// SetData sets the "data" field.
-func (m *ModelingModelsMutation) SetData(s string) {
-	m.data = &s
+// LastRunAtCleared returns if the "last_run_at" field was cleared in this mutation.
+func (m *ScheduleMutation) LastRunAtCleared() bool {
+	_, ok := m.clearedFields[schedule.FieldLastRunAt]
+	return ok
 }
 
-// Data returns the value of the "data" field in the mutation.
-func (m *ModelingModelsMutation) Data() (r string, exists bool) {
-	v := m.data
+// ResetLastRunAt resets all changes to the "last_run_at" field.
+func (m *ScheduleMutation) ResetLastRunAt() {
+	m.last_run_at = nil
+	delete(m.clearedFields, schedule.FieldLastRunAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ScheduleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ScheduleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldData returns the old "data" field's value of the ModelingModels entity.
-// If the ModelingModels object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingModelsMutation) OldData(ctx context.Context) (v string, err error) {
+func (m *ScheduleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldData is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldData requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldData: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Data, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetData resets all changes to the "data" field.
-func (m *ModelingModelsMutation) ResetData() {
-	m.data = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ScheduleMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ModelingModelsMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ScheduleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ModelingModelsMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ScheduleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ModelingModels entity.
-// If the ModelingModels object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Schedule entity.
+// If the Schedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelingModelsMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ScheduleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ModelingModelsMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ScheduleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ClearModeling clears the "modeling" edge to the Modeling entity.
-func (m *ModelingModelsMutation) ClearModeling() {
-	m.clearedmodeling = true
-	m.clearedFields[modelingmodels.FieldModelingID] = struct{}{}
+// ClearTask clears the "task" edge to the Task entity.
+func (m *ScheduleMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[schedule.FieldTaskID] = struct{}{}
 }
 
-// ModelingCleared reports if the "modeling" edge to the Modeling entity was cleared.
-func (m *ModelingModelsMutation) ModelingCleared() bool {
-	return m.ModelingIDCleared() || m.clearedmodeling
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *ScheduleMutation) TaskCleared() bool {
+	return m.TaskIDCleared() || m.clearedtask
 }
 
-// ModelingIDs returns the "modeling" edge IDs in the mutation.
+// TaskIDs returns the "task" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ModelingID instead. It exists only for internal usage by the builders.
-func (m *ModelingModelsMutation) ModelingIDs() (ids []int) {
-	if id := m.modeling; id != nil {
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *ScheduleMutation) TaskIDs() (ids []int) {
+	if id := m.task; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetModeling resets all changes to the "modeling" edge.
-func (m *ModelingModelsMutation) ResetModeling() {
-	m.modeling = nil
-	m.clearedmodeling = false
+// ResetTask resets all changes to the "task" edge.
+func (m *ScheduleMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
 }
 
-// Where appends a list predicates to the ModelingModelsMutation builder.
-func (m *ModelingModelsMutation) Where(ps ...predicate.ModelingModels) {
+// AddHistoryIDs adds the "histories" edge to the ScheduleHistory entity by ids.
+func (m *ScheduleMutation) AddHistoryIDs(ids ...int) {
+	if m.histories == nil {
+		m.histories = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.histories[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHistories clears the "histories" edge to the ScheduleHistory entity.
+func (m *ScheduleMutation) ClearHistories() {
+	m.clearedhistories = true
+}
+
+// HistoriesCleared reports if the "histories" edge to the ScheduleHistory entity was cleared.
+func (m *ScheduleMutation) HistoriesCleared() bool {
+	return m.clearedhistories
+}
+
+// RemoveHistoryIDs removes the "histories" edge to the ScheduleHistory entity by IDs.
+func (m *ScheduleMutation) RemoveHistoryIDs(ids ...int) {
+	if m.removedhistories == nil {
+		m.removedhistories = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.histories, ids[i])
+		m.removedhistories[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHistories returns the removed IDs of the "histories" edge to the ScheduleHistory entity.
+func (m *ScheduleMutation) RemovedHistoriesIDs() (ids []int) {
+	for id := range m.removedhistories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HistoriesIDs returns the "histories" edge IDs in the mutation.
+func (m *ScheduleMutation) HistoriesIDs() (ids []int) {
+	for id := range m.histories {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHistories resets all changes to the "histories" edge.
+func (m *ScheduleMutation) ResetHistories() {
+	m.histories = nil
+	m.clearedhistories = false
+	m.removedhistories = nil
+}
+
+// Where appends a list predicates to the ScheduleMutation builder.
+func (m *ScheduleMutation) Where(ps ...predicate.Schedule) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ModelingModelsMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ScheduleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ModelingModelsMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ModelingModels, len(ps))
+func (m *ScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Schedule, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9144,36 +19111,63 @@ func (m *ModelingModelsMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ModelingModelsMutation) Op() Op {
+func (m *ScheduleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ModelingModelsMutation) SetOp(op Op) {
+func (m *ScheduleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ModelingModels).
-func (m *ModelingModelsMutation) Type() string {
+// Type returns the node type of this mutation (Schedule).
+func (m *ScheduleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ModelingModelsMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.modeling != nil {
-		fields = append(fields, modelingmodels.FieldModelingID)
+func (m *ScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.task != nil {
+		fields = append(fields, schedule.FieldTaskID)
 	}
-	if m.data_type != nil {
-		fields = append(fields, modelingmodels.FieldDataType)
+	if m.parent_id != nil {
+		fields = append(fields, schedule.FieldParentID)
 	}
-	if m.data != nil {
-		fields = append(fields, modelingmodels.FieldData)
+	if m.trigger != nil {
+		fields = append(fields, schedule.FieldTrigger)
+	}
+	if m.cron != nil {
+		fields = append(fields, schedule.FieldCron)
+	}
+	if m.dataset_id != nil {
+		fields = append(fields, schedule.FieldDatasetID)
+	}
+	if m.paused != nil {
+		fields = append(fields, schedule.FieldPaused)
+	}
+	if m.priority != nil {
+		fields = append(fields, schedule.FieldPriority)
+	}
+	if m.username != nil {
+		fields = append(fields, schedule.FieldUsername)
+	}
+	if m.trained_fingerprint != nil {
+		fields = append(fields, schedule.FieldTrainedFingerprint)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, schedule.FieldNextRunAt)
+	}
+	if m.last_run_at != nil {
+		fields = append(fields, schedule.FieldLastRunAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, modelingmodels.FieldCreatedAt)
+		fields = append(fields, schedule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, schedule.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -9181,16 +19175,34 @@ func (m *ModelingModelsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ModelingModelsMutation) Field(name string) (ent.Value, bool) {
+func (m *ScheduleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case modelingmodels.FieldModelingID:
-		return m.ModelingID()
-	case modelingmodels.FieldDataType:
-		return m.DataType()
-	case modelingmodels.FieldData:
-		return m.Data()
-	case modelingmodels.FieldCreatedAt:
+	case schedule.FieldTaskID:
+		return m.TaskID()
+	case schedule.FieldParentID:
+		return m.ParentID()
+	case schedule.FieldTrigger:
+		return m.Trigger()
+	case schedule.FieldCron:
+		return m.Cron()
+	case schedule.FieldDatasetID:
+		return m.DatasetID()
+	case schedule.FieldPaused:
+		return m.Paused()
+	case schedule.FieldPriority:
+		return m.Priority()
+	case schedule.FieldUsername:
+		return m.Username()
+	case schedule.FieldTrainedFingerprint:
+		return m.TrainedFingerprint()
+	case schedule.FieldNextRunAt:
+		return m.NextRunAt()
+	case schedule.FieldLastRunAt:
+		return m.LastRunAt()
+	case schedule.FieldCreatedAt:
 		return m.CreatedAt()
+	case schedule.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -9198,69 +19210,165 @@ func (m *ModelingModelsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ModelingModelsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case modelingmodels.FieldModelingID:
-		return m.OldModelingID(ctx)
-	case modelingmodels.FieldDataType:
-		return m.OldDataType(ctx)
-	case modelingmodels.FieldData:
-		return m.OldData(ctx)
-	case modelingmodels.FieldCreatedAt:
+	case schedule.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case schedule.FieldParentID:
+		return m.OldParentID(ctx)
+	case schedule.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case schedule.FieldCron:
+		return m.OldCron(ctx)
+	case schedule.FieldDatasetID:
+		return m.OldDatasetID(ctx)
+	case schedule.FieldPaused:
+		return m.OldPaused(ctx)
+	case schedule.FieldPriority:
+		return m.OldPriority(ctx)
+	case schedule.FieldUsername:
+		return m.OldUsername(ctx)
+	case schedule.FieldTrainedFingerprint:
+		return m.OldTrainedFingerprint(ctx)
+	case schedule.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
+	case schedule.FieldLastRunAt:
+		return m.OldLastRunAt(ctx)
+	case schedule.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case schedule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ModelingModels field %s", name)
+	return nil, fmt.Errorf("unknown Schedule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ModelingModelsMutation) SetField(name string, value ent.Value) error {
+func (m *ScheduleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case modelingmodels.FieldModelingID:
+	case schedule.FieldTaskID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelingID(v)
+		m.SetTaskID(v)
 		return nil
-	case modelingmodels.FieldDataType:
+	case schedule.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case schedule.FieldTrigger:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDataType(v)
+		m.SetTrigger(v)
 		return nil
-	case modelingmodels.FieldData:
+	case schedule.FieldCron:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetData(v)
+		m.SetCron(v)
 		return nil
-	case modelingmodels.FieldCreatedAt:
+	case schedule.FieldDatasetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDatasetID(v)
+		return nil
+	case schedule.FieldPaused:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaused(v)
+		return nil
+	case schedule.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case schedule.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case schedule.FieldTrainedFingerprint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrainedFingerprint(v)
+		return nil
+	case schedule.FieldNextRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunAt(v)
+		return nil
+	case schedule.FieldLastRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunAt(v)
+		return nil
+	case schedule.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case schedule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ModelingModels field %s", name)
+	return fmt.Errorf("unknown Schedule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ModelingModelsMutation) AddedFields() []string {
+func (m *ScheduleMutation) AddedFields() []string {
 	var fields []string
+	if m.addparent_id != nil {
+		fields = append(fields, schedule.FieldParentID)
+	}
+	if m.adddataset_id != nil {
+		fields = append(fields, schedule.FieldDatasetID)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, schedule.FieldPriority)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ModelingModelsMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ScheduleMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case schedule.FieldParentID:
+		return m.AddedParentID()
+	case schedule.FieldDatasetID:
+		return m.AddedDatasetID()
+	case schedule.FieldPriority:
+		return m.AddedPriority()
 	}
 	return nil, false
 }
@@ -9268,168 +19376,253 @@ func (m *ModelingModelsMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ModelingModelsMutation) AddField(name string, value ent.Value) error {
+func (m *ScheduleMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case schedule.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParentID(v)
+		return nil
+	case schedule.FieldDatasetID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDatasetID(v)
+		return nil
+	case schedule.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ModelingModels numeric field %s", name)
+	return fmt.Errorf("unknown Schedule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ModelingModelsMutation) ClearedFields() []string {
+func (m *ScheduleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(modelingmodels.FieldModelingID) {
-		fields = append(fields, modelingmodels.FieldModelingID)
+	if m.FieldCleared(schedule.FieldTaskID) {
+		fields = append(fields, schedule.FieldTaskID)
+	}
+	if m.FieldCleared(schedule.FieldNextRunAt) {
+		fields = append(fields, schedule.FieldNextRunAt)
+	}
+	if m.FieldCleared(schedule.FieldLastRunAt) {
+		fields = append(fields, schedule.FieldLastRunAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ModelingModelsMutation) FieldCleared(name string) bool {
+func (m *ScheduleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ModelingModelsMutation) ClearField(name string) error {
+func (m *ScheduleMutation) ClearField(name string) error {
 	switch name {
-	case modelingmodels.FieldModelingID:
-		m.ClearModelingID()
+	case schedule.FieldTaskID:
+		m.ClearTaskID()
+		return nil
+	case schedule.FieldNextRunAt:
+		m.ClearNextRunAt()
+		return nil
+	case schedule.FieldLastRunAt:
+		m.ClearLastRunAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ModelingModels nullable field %s", name)
+	return fmt.Errorf("unknown Schedule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ModelingModelsMutation) ResetField(name string) error {
+func (m *ScheduleMutation) ResetField(name string) error {
 	switch name {
-	case modelingmodels.FieldModelingID:
-		m.ResetModelingID()
+	case schedule.FieldTaskID:
+		m.ResetTaskID()
 		return nil
-	case modelingmodels.FieldDataType:
-		m.ResetDataType()
+	case schedule.FieldParentID:
+		m.ResetParentID()
 		return nil
-	case modelingmodels.FieldData:
-		m.ResetData()
+	case schedule.FieldTrigger:
+		m.ResetTrigger()
 		return nil
-	case modelingmodels.FieldCreatedAt:
+	case schedule.FieldCron:
+		m.ResetCron()
+		return nil
+	case schedule.FieldDatasetID:
+		m.ResetDatasetID()
+		return nil
+	case schedule.FieldPaused:
+		m.ResetPaused()
+		return nil
+	case schedule.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case schedule.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case schedule.FieldTrainedFingerprint:
+		m.ResetTrainedFingerprint()
+		return nil
+	case schedule.FieldNextRunAt:
+		m.ResetNextRunAt()
+		return nil
+	case schedule.FieldLastRunAt:
+		m.ResetLastRunAt()
+		return nil
+	case schedule.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case schedule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown ModelingModels field %s", name)
+	return fmt.Errorf("unknown Schedule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ModelingModelsMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.modeling != nil {
-		edges = append(edges, modelingmodels.EdgeModeling)
+func (m *ScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.task != nil {
+		edges = append(edges, schedule.EdgeTask)
+	}
+	if m.histories != nil {
+		edges = append(edges, schedule.EdgeHistories)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ModelingModelsMutation) AddedIDs(name string) []ent.Value {
+func (m *ScheduleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case modelingmodels.EdgeModeling:
-		if id := m.modeling; id != nil {
+	case schedule.EdgeTask:
+		if id := m.task; id != nil {
 			return []ent.Value{*id}
 		}
+	case schedule.EdgeHistories:
+		ids := make([]ent.Value, 0, len(m.histories))
+		for id := range m.histories {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ModelingModelsMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedhistories != nil {
+		edges = append(edges, schedule.EdgeHistories)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ModelingModelsMutation) RemovedIDs(name string) []ent.Value {
+func (m *ScheduleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case schedule.EdgeHistories:
+		ids := make([]ent.Value, 0, len(m.removedhistories))
+		for id := range m.removedhistories {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ModelingModelsMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmodeling {
-		edges = append(edges, modelingmodels.EdgeModeling)
+func (m *ScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtask {
+		edges = append(edges, schedule.EdgeTask)
+	}
+	if m.clearedhistories {
+		edges = append(edges, schedule.EdgeHistories)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ModelingModelsMutation) EdgeCleared(name string) bool {
+func (m *ScheduleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case modelingmodels.EdgeModeling:
-		return m.clearedmodeling
+	case schedule.EdgeTask:
+		return m.clearedtask
+	case schedule.EdgeHistories:
+		return m.clearedhistories
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ModelingModelsMutation) ClearEdge(name string) error {
+func (m *ScheduleMutation) ClearEdge(name string) error {
 	switch name {
-	case modelingmodels.EdgeModeling:
-		m.ClearModeling()
+	case schedule.EdgeTask:
+		m.ClearTask()
 		return nil
 	}
-	return fmt.Errorf("unknown ModelingModels unique edge %s", name)
+	return fmt.Errorf("unknown Schedule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ModelingModelsMutation) ResetEdge(name string) error {
+func (m *ScheduleMutation) ResetEdge(name string) error {
 	switch name {
-	case modelingmodels.EdgeModeling:
-		m.ResetModeling()
+	case schedule.EdgeTask:
+		m.ResetTask()
+		return nil
+	case schedule.EdgeHistories:
+		m.ResetHistories()
 		return nil
 	}
-	return fmt.Errorf("unknown ModelingModels edge %s", name)
+	return fmt.Errorf("unknown Schedule edge %s", name)
 }
 
-// ProjectMutation represents an operation that mutates the Project nodes in the graph.
-type ProjectMutation struct {
+// ScheduleHistoryMutation represents an operation that mutates the ScheduleHistory nodes in the graph.
+type ScheduleHistoryMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	title               *string
-	description         *string
-	is_use              *bool
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	tasks               map[int]struct{}
-	removedtasks        map[int]struct{}
-	clearedtasks        bool
-	user_project        map[int]struct{}
-	removeduser_project map[int]struct{}
-	cleareduser_project bool
-	done                bool
-	oldValue            func(context.Context) (*Project, error)
-	predicates          []predicate.Project
+	op              Op
+	typ             string
+	id              *int
+	modeling_id     *int
+	addmodeling_id  *int
+	trigger         *string
+	status          *string
+	message         *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	schedule        *int
+	clearedschedule bool
+	done            bool
+	oldValue        func(context.Context) (*ScheduleHistory, error)
+	predicates      []predicate.ScheduleHistory
 }
 
-var _ ent.Mutation = (*ProjectMutation)(nil)
+var _ ent.Mutation = (*ScheduleHistoryMutation)(nil)
 
-// projectOption allows management of the mutation configuration using functional options.
-type projectOption func(*ProjectMutation)
+// schedulehistoryOption allows management of the mutation configuration using functional options.
+type schedulehistoryOption func(*ScheduleHistoryMutation)
 
-// newProjectMutation creates new mutation for the Project entity.
-func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
-	m := &ProjectMutation{
+// newScheduleHistoryMutation creates new mutation for the ScheduleHistory entity.
+func newScheduleHistoryMutation(c config, op Op, opts ...schedulehistoryOption) *ScheduleHistoryMutation {
+	m := &ScheduleHistoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProject,
+		typ:           TypeScheduleHistory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9438,20 +19631,20 @@ func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation
 	return m
 }
 
-// withProjectID sets the ID field of the mutation.
-func withProjectID(id int) projectOption {
-	return func(m *ProjectMutation) {
+// withScheduleHistoryID sets the ID field of the mutation.
+func withScheduleHistoryID(id int) schedulehistoryOption {
+	return func(m *ScheduleHistoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Project
+			value *ScheduleHistory
 		)
-		m.oldValue = func(ctx context.Context) (*Project, error) {
+		m.oldValue = func(ctx context.Context) (*ScheduleHistory, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Project.Get(ctx, id)
+					value, err = m.Client().ScheduleHistory.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9460,10 +19653,10 @@ func withProjectID(id int) projectOption {
 	}
 }
 
-// withProject sets the old Project of the mutation.
-func withProject(node *Project) projectOption {
-	return func(m *ProjectMutation) {
-		m.oldValue = func(context.Context) (*Project, error) {
+// withScheduleHistory sets the old ScheduleHistory of the mutation.
+func withScheduleHistory(node *ScheduleHistory) schedulehistoryOption {
+	return func(m *ScheduleHistoryMutation) {
+		m.oldValue = func(context.Context) (*ScheduleHistory, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9472,7 +19665,7 @@ func withProject(node *Project) projectOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProjectMutation) Client() *Client {
+func (m ScheduleHistoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9480,7 +19673,7 @@ func (m ProjectMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProjectMutation) Tx() (*Tx, error) {
+func (m ScheduleHistoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -9490,14 +19683,14 @@ func (m ProjectMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Project entities.
-func (m *ProjectMutation) SetID(id int) {
+// operation is only accepted on creation of ScheduleHistory entities.
+func (m *ScheduleHistoryMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProjectMutation) ID() (id int, exists bool) {
+func (m *ScheduleHistoryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9508,7 +19701,7 @@ func (m *ProjectMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ScheduleHistoryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -9517,335 +19710,297 @@ func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ScheduleHistory.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *ProjectMutation) SetTitle(s string) {
-	m.title = &s
+// SetScheduleID sets the "schedule_id" field.
+func (m *ScheduleHistoryMutation) SetScheduleID(i int) {
+	m.schedule = &i
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ProjectMutation) Title() (r string, exists bool) {
-	v := m.title
+// ScheduleID returns the value of the "schedule_id" field in the mutation.
+func (m *ScheduleHistoryMutation) ScheduleID() (r int, exists bool) {
+	v := m.schedule
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduleID returns the old "schedule_id" field's value of the ScheduleHistory entity.
+// If the ScheduleHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *ScheduleHistoryMutation) OldScheduleID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldScheduleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduleID: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.ScheduleID, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ProjectMutation) ResetTitle() {
-	m.title = nil
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (m *ScheduleHistoryMutation) ClearScheduleID() {
+	m.schedule = nil
+	m.clearedFields[schedulehistory.FieldScheduleID] = struct{}{}
 }
 
-// SetDescription sets the "description" field.
-func (m *ProjectMutation) SetDescription(s string) {
-	m.description = &s
+// ScheduleIDCleared returns if the "schedule_id" field was cleared in this mutation.
+func (m *ScheduleHistoryMutation) ScheduleIDCleared() bool {
+	_, ok := m.clearedFields[schedulehistory.FieldScheduleID]
+	return ok
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ProjectMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetScheduleID resets all changes to the "schedule_id" field.
+func (m *ScheduleHistoryMutation) ResetScheduleID() {
+	m.schedule = nil
+	delete(m.clearedFields, schedulehistory.FieldScheduleID)
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (m *ScheduleHistoryMutation) SetModelingID(i int) {
+	m.modeling_id = &i
+	m.addmodeling_id = nil
+}
+
+// ModelingID returns the value of the "modeling_id" field in the mutation.
+func (m *ScheduleHistoryMutation) ModelingID() (r int, exists bool) {
+	v := m.modeling_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldModelingID returns the old "modeling_id" field's value of the ScheduleHistory entity.
+// If the ScheduleHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *ScheduleHistoryMutation) OldModelingID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldModelingID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldModelingID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldModelingID: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ProjectMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[project.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ProjectMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[project.FieldDescription]
-	return ok
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *ProjectMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, project.FieldDescription)
+	return oldValue.ModelingID, nil
 }
 
-// SetIsUse sets the "is_use" field.
-func (m *ProjectMutation) SetIsUse(b bool) {
-	m.is_use = &b
+// AddModelingID adds i to the "modeling_id" field.
+func (m *ScheduleHistoryMutation) AddModelingID(i int) {
+	if m.addmodeling_id != nil {
+		*m.addmodeling_id += i
+	} else {
+		m.addmodeling_id = &i
+	}
 }
 
-// IsUse returns the value of the "is_use" field in the mutation.
-func (m *ProjectMutation) IsUse() (r bool, exists bool) {
-	v := m.is_use
+// AddedModelingID returns the value that was added to the "modeling_id" field in this mutation.
+func (m *ScheduleHistoryMutation) AddedModelingID() (r int, exists bool) {
+	v := m.addmodeling_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsUse returns the old "is_use" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldIsUse(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsUse is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsUse requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsUse: %w", err)
-	}
-	return oldValue.IsUse, nil
-}
-
-// ResetIsUse resets all changes to the "is_use" field.
-func (m *ProjectMutation) ResetIsUse() {
-	m.is_use = nil
+// ResetModelingID resets all changes to the "modeling_id" field.
+func (m *ScheduleHistoryMutation) ResetModelingID() {
+	m.modeling_id = nil
+	m.addmodeling_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ProjectMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetTrigger sets the "trigger" field.
+func (m *ScheduleHistoryMutation) SetTrigger(s string) {
+	m.trigger = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProjectMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *ScheduleHistoryMutation) Trigger() (r string, exists bool) {
+	v := m.trigger
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldTrigger returns the old "trigger" field's value of the ScheduleHistory entity.
+// If the ScheduleHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ScheduleHistoryMutation) OldTrigger(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Trigger, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProjectMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *ScheduleHistoryMutation) ResetTrigger() {
+	m.trigger = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ProjectMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetStatus sets the "status" field.
+func (m *ScheduleHistoryMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProjectMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Status returns the value of the "status" field in the mutation.
+func (m *ScheduleHistoryMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the ScheduleHistory entity.
+// If the ScheduleHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ScheduleHistoryMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *ProjectMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[project.FieldUpdatedAt] = struct{}{}
+	return oldValue.Status, nil
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *ProjectMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[project.FieldUpdatedAt]
-	return ok
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProjectMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, project.FieldUpdatedAt)
-}
-
-// AddTaskIDs adds the "tasks" edge to the Task entity by ids.
-func (m *ProjectMutation) AddTaskIDs(ids ...int) {
-	if m.tasks == nil {
-		m.tasks = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.tasks[ids[i]] = struct{}{}
-	}
+// ResetStatus resets all changes to the "status" field.
+func (m *ScheduleHistoryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// ClearTasks clears the "tasks" edge to the Task entity.
-func (m *ProjectMutation) ClearTasks() {
-	m.clearedtasks = true
+// SetMessage sets the "message" field.
+func (m *ScheduleHistoryMutation) SetMessage(s string) {
+	m.message = &s
 }
 
-// TasksCleared reports if the "tasks" edge to the Task entity was cleared.
-func (m *ProjectMutation) TasksCleared() bool {
-	return m.clearedtasks
+// Message returns the value of the "message" field in the mutation.
+func (m *ScheduleHistoryMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveTaskIDs removes the "tasks" edge to the Task entity by IDs.
-func (m *ProjectMutation) RemoveTaskIDs(ids ...int) {
-	if m.removedtasks == nil {
-		m.removedtasks = make(map[int]struct{})
+// OldMessage returns the old "message" field's value of the ScheduleHistory entity.
+// If the ScheduleHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleHistoryMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.tasks, ids[i])
-		m.removedtasks[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
-}
-
-// RemovedTasks returns the removed IDs of the "tasks" edge to the Task entity.
-func (m *ProjectMutation) RemovedTasksIDs() (ids []int) {
-	for id := range m.removedtasks {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return
+	return oldValue.Message, nil
 }
 
-// TasksIDs returns the "tasks" edge IDs in the mutation.
-func (m *ProjectMutation) TasksIDs() (ids []int) {
-	for id := range m.tasks {
-		ids = append(ids, id)
-	}
-	return
+// ResetMessage resets all changes to the "message" field.
+func (m *ScheduleHistoryMutation) ResetMessage() {
+	m.message = nil
 }
 
-// ResetTasks resets all changes to the "tasks" edge.
-func (m *ProjectMutation) ResetTasks() {
-	m.tasks = nil
-	m.clearedtasks = false
-	m.removedtasks = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *ScheduleHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// AddUserProjectIDs adds the "user_project" edge to the UserProject entity by ids.
-func (m *ProjectMutation) AddUserProjectIDs(ids ...int) {
-	if m.user_project == nil {
-		m.user_project = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.user_project[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ScheduleHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearUserProject clears the "user_project" edge to the UserProject entity.
-func (m *ProjectMutation) ClearUserProject() {
-	m.cleareduser_project = true
+// OldCreatedAt returns the old "created_at" field's value of the ScheduleHistory entity.
+// If the ScheduleHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduleHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// UserProjectCleared reports if the "user_project" edge to the UserProject entity was cleared.
-func (m *ProjectMutation) UserProjectCleared() bool {
-	return m.cleareduser_project
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ScheduleHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// RemoveUserProjectIDs removes the "user_project" edge to the UserProject entity by IDs.
-func (m *ProjectMutation) RemoveUserProjectIDs(ids ...int) {
-	if m.removeduser_project == nil {
-		m.removeduser_project = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.user_project, ids[i])
-		m.removeduser_project[ids[i]] = struct{}{}
-	}
+// ClearSchedule clears the "schedule" edge to the Schedule entity.
+func (m *ScheduleHistoryMutation) ClearSchedule() {
+	m.clearedschedule = true
+	m.clearedFields[schedulehistory.FieldScheduleID] = struct{}{}
 }
 
-// RemovedUserProject returns the removed IDs of the "user_project" edge to the UserProject entity.
-func (m *ProjectMutation) RemovedUserProjectIDs() (ids []int) {
-	for id := range m.removeduser_project {
-		ids = append(ids, id)
-	}
-	return
+// ScheduleCleared reports if the "schedule" edge to the Schedule entity was cleared.
+func (m *ScheduleHistoryMutation) ScheduleCleared() bool {
+	return m.ScheduleIDCleared() || m.clearedschedule
 }
 
-// UserProjectIDs returns the "user_project" edge IDs in the mutation.
-func (m *ProjectMutation) UserProjectIDs() (ids []int) {
-	for id := range m.user_project {
-		ids = append(ids, id)
+// ScheduleIDs returns the "schedule" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ScheduleID instead. It exists only for internal usage by the builders.
+func (m *ScheduleHistoryMutation) ScheduleIDs() (ids []int) {
+	if id := m.schedule; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUserProject resets all changes to the "user_project" edge.
-func (m *ProjectMutation) ResetUserProject() {
-	m.user_project = nil
-	m.cleareduser_project = false
-	m.removeduser_project = nil
+// ResetSchedule resets all changes to the "schedule" edge.
+func (m *ScheduleHistoryMutation) ResetSchedule() {
+	m.schedule = nil
+	m.clearedschedule = false
 }
 
-// Where appends a list predicates to the ProjectMutation builder.
-func (m *ProjectMutation) Where(ps ...predicate.Project) {
+// Where appends a list predicates to the ScheduleHistoryMutation builder.
+func (m *ScheduleHistoryMutation) Where(ps ...predicate.ScheduleHistory) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ScheduleHistoryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Project, len(ps))
+func (m *ScheduleHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScheduleHistory, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9853,39 +20008,42 @@ func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProjectMutation) Op() Op {
+func (m *ScheduleHistoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProjectMutation) SetOp(op Op) {
+func (m *ScheduleHistoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Project).
-func (m *ProjectMutation) Type() string {
+// Type returns the node type of this mutation (ScheduleHistory).
+func (m *ScheduleHistoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProjectMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.title != nil {
-		fields = append(fields, project.FieldTitle)
+func (m *ScheduleHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.schedule != nil {
+		fields = append(fields, schedulehistory.FieldScheduleID)
 	}
-	if m.description != nil {
-		fields = append(fields, project.FieldDescription)
+	if m.modeling_id != nil {
+		fields = append(fields, schedulehistory.FieldModelingID)
 	}
-	if m.is_use != nil {
-		fields = append(fields, project.FieldIsUse)
+	if m.trigger != nil {
+		fields = append(fields, schedulehistory.FieldTrigger)
 	}
-	if m.created_at != nil {
-		fields = append(fields, project.FieldCreatedAt)
+	if m.status != nil {
+		fields = append(fields, schedulehistory.FieldStatus)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, project.FieldUpdatedAt)
+	if m.message != nil {
+		fields = append(fields, schedulehistory.FieldMessage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, schedulehistory.FieldCreatedAt)
 	}
 	return fields
 }
@@ -9893,18 +20051,20 @@ func (m *ProjectMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
+func (m *ScheduleHistoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case project.FieldTitle:
-		return m.Title()
-	case project.FieldDescription:
-		return m.Description()
-	case project.FieldIsUse:
-		return m.IsUse()
-	case project.FieldCreatedAt:
+	case schedulehistory.FieldScheduleID:
+		return m.ScheduleID()
+	case schedulehistory.FieldModelingID:
+		return m.ModelingID()
+	case schedulehistory.FieldTrigger:
+		return m.Trigger()
+	case schedulehistory.FieldStatus:
+		return m.Status()
+	case schedulehistory.FieldMessage:
+		return m.Message()
+	case schedulehistory.FieldCreatedAt:
 		return m.CreatedAt()
-	case project.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -9912,253 +20072,238 @@ func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ScheduleHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case project.FieldTitle:
-		return m.OldTitle(ctx)
-	case project.FieldDescription:
-		return m.OldDescription(ctx)
-	case project.FieldIsUse:
-		return m.OldIsUse(ctx)
-	case project.FieldCreatedAt:
+	case schedulehistory.FieldScheduleID:
+		return m.OldScheduleID(ctx)
+	case schedulehistory.FieldModelingID:
+		return m.OldModelingID(ctx)
+	case schedulehistory.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case schedulehistory.FieldStatus:
+		return m.OldStatus(ctx)
+	case schedulehistory.FieldMessage:
+		return m.OldMessage(ctx)
+	case schedulehistory.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case project.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Project field %s", name)
+	return nil, fmt.Errorf("unknown ScheduleHistory field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectMutation) SetField(name string, value ent.Value) error {
+func (m *ScheduleHistoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case project.FieldTitle:
-		v, ok := value.(string)
+	case schedulehistory.FieldScheduleID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetScheduleID(v)
 		return nil
-	case project.FieldDescription:
+	case schedulehistory.FieldModelingID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelingID(v)
+		return nil
+	case schedulehistory.FieldTrigger:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetTrigger(v)
 		return nil
-	case project.FieldIsUse:
-		v, ok := value.(bool)
+	case schedulehistory.FieldStatus:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsUse(v)
+		m.SetStatus(v)
 		return nil
-	case project.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case schedulehistory.FieldMessage:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetMessage(v)
 		return nil
-	case project.FieldUpdatedAt:
+	case schedulehistory.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Project field %s", name)
+	return fmt.Errorf("unknown ScheduleHistory field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProjectMutation) AddedFields() []string {
-	return nil
+func (m *ScheduleHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addmodeling_id != nil {
+		fields = append(fields, schedulehistory.FieldModelingID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ScheduleHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case schedulehistory.FieldModelingID:
+		return m.AddedModelingID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectMutation) AddField(name string, value ent.Value) error {
+func (m *ScheduleHistoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case schedulehistory.FieldModelingID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModelingID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Project numeric field %s", name)
+	return fmt.Errorf("unknown ScheduleHistory numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProjectMutation) ClearedFields() []string {
+func (m *ScheduleHistoryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(project.FieldDescription) {
-		fields = append(fields, project.FieldDescription)
-	}
-	if m.FieldCleared(project.FieldUpdatedAt) {
-		fields = append(fields, project.FieldUpdatedAt)
+	if m.FieldCleared(schedulehistory.FieldScheduleID) {
+		fields = append(fields, schedulehistory.FieldScheduleID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProjectMutation) FieldCleared(name string) bool {
+func (m *ScheduleHistoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProjectMutation) ClearField(name string) error {
+func (m *ScheduleHistoryMutation) ClearField(name string) error {
 	switch name {
-	case project.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case project.FieldUpdatedAt:
-		m.ClearUpdatedAt()
+	case schedulehistory.FieldScheduleID:
+		m.ClearScheduleID()
 		return nil
 	}
-	return fmt.Errorf("unknown Project nullable field %s", name)
+	return fmt.Errorf("unknown ScheduleHistory nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProjectMutation) ResetField(name string) error {
+func (m *ScheduleHistoryMutation) ResetField(name string) error {
 	switch name {
-	case project.FieldTitle:
-		m.ResetTitle()
+	case schedulehistory.FieldScheduleID:
+		m.ResetScheduleID()
 		return nil
-	case project.FieldDescription:
-		m.ResetDescription()
+	case schedulehistory.FieldModelingID:
+		m.ResetModelingID()
 		return nil
-	case project.FieldIsUse:
-		m.ResetIsUse()
+	case schedulehistory.FieldTrigger:
+		m.ResetTrigger()
 		return nil
-	case project.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case schedulehistory.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case project.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case schedulehistory.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case schedulehistory.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Project field %s", name)
+	return fmt.Errorf("unknown ScheduleHistory field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProjectMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.tasks != nil {
-		edges = append(edges, project.EdgeTasks)
-	}
-	if m.user_project != nil {
-		edges = append(edges, project.EdgeUserProject)
+func (m *ScheduleHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.schedule != nil {
+		edges = append(edges, schedulehistory.EdgeSchedule)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
+func (m *ScheduleHistoryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case project.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.tasks))
-		for id := range m.tasks {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeUserProject:
-		ids := make([]ent.Value, 0, len(m.user_project))
-		for id := range m.user_project {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProjectMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedtasks != nil {
-		edges = append(edges, project.EdgeTasks)
-	}
-	if m.removeduser_project != nil {
-		edges = append(edges, project.EdgeUserProject)
+	case schedulehistory.EdgeSchedule:
+		if id := m.schedule; id != nil {
+			return []ent.Value{*id}
+		}
 	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScheduleHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case project.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.removedtasks))
-		for id := range m.removedtasks {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeUserProject:
-		ids := make([]ent.Value, 0, len(m.removeduser_project))
-		for id := range m.removeduser_project {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ScheduleHistoryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProjectMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedtasks {
-		edges = append(edges, project.EdgeTasks)
-	}
-	if m.cleareduser_project {
-		edges = append(edges, project.EdgeUserProject)
+func (m *ScheduleHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedschedule {
+		edges = append(edges, schedulehistory.EdgeSchedule)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProjectMutation) EdgeCleared(name string) bool {
+func (m *ScheduleHistoryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case project.EdgeTasks:
-		return m.clearedtasks
-	case project.EdgeUserProject:
-		return m.cleareduser_project
+	case schedulehistory.EdgeSchedule:
+		return m.clearedschedule
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProjectMutation) ClearEdge(name string) error {
+func (m *ScheduleHistoryMutation) ClearEdge(name string) error {
 	switch name {
+	case schedulehistory.EdgeSchedule:
+		m.ClearSchedule()
+		return nil
 	}
-	return fmt.Errorf("unknown Project unique edge %s", name)
+	return fmt.Errorf("unknown ScheduleHistory unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProjectMutation) ResetEdge(name string) error {
+func (m *ScheduleHistoryMutation) ResetEdge(name string) error {
 	switch name {
-	case project.EdgeTasks:
-		m.ResetTasks()
-		return nil
-	case project.EdgeUserProject:
-		m.ResetUserProject()
+	case schedulehistory.EdgeSchedule:
+		m.ResetSchedule()
 		return nil
 	}
-	return fmt.Errorf("unknown Project edge %s", name)
+	return fmt.Errorf("unknown ScheduleHistory edge %s", name)
 }
 
 // TaskMutation represents an operation that mutates the Task nodes in the graph.
@@ -10175,6 +20320,13 @@ type TaskMutation struct {
 	target_metric    *string
 	params           *[]string
 	appendparams     []string
+	pipeline         *[]schema.PipelineStage
+	appendpipeline   []schema.PipelineStage
+	pipeline_status  *string
+	search           **schema.HPSearch
+	search_status    *string
+	early_stop       *[]schema.EarlyStopPolicy
+	appendearly_stop []schema.EarlyStopPolicy
 	created_at       *time.Time
 	updated_at       *time.Time
 	clearedFields    map[string]struct{}
@@ -10183,6 +20335,9 @@ type TaskMutation struct {
 	modelings        map[int]struct{}
 	removedmodelings map[int]struct{}
 	clearedmodelings bool
+	schedules        map[int]struct{}
+	removedschedules map[int]struct{}
+	clearedschedules bool
 	done             bool
 	oldValue         func(context.Context) (*Task, error)
 	predicates       []predicate.Task
@@ -10592,6 +20747,257 @@ func (m *TaskMutation) ResetParams() {
 	m.appendparams = nil
 }
 
+// SetPipeline sets the "pipeline" field.
+func (m *TaskMutation) SetPipeline(ss []schema.PipelineStage) {
+	m.pipeline = &ss
+	m.appendpipeline = nil
+}
+
+// Pipeline returns the value of the "pipeline" field in the mutation.
+func (m *TaskMutation) Pipeline() (r []schema.PipelineStage, exists bool) {
+	v := m.pipeline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPipeline returns the old "pipeline" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPipeline(ctx context.Context) (v []schema.PipelineStage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPipeline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPipeline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPipeline: %w", err)
+	}
+	return oldValue.Pipeline, nil
+}
+
+// AppendPipeline adds ss to the "pipeline" field.
+func (m *TaskMutation) AppendPipeline(ss []schema.PipelineStage) {
+	m.appendpipeline = append(m.appendpipeline, ss...)
+}
+
+// AppendedPipeline returns the list of values that were appended to the "pipeline" field in this mutation.
+func (m *TaskMutation) AppendedPipeline() ([]schema.PipelineStage, bool) {
+	if len(m.appendpipeline) == 0 {
+		return nil, false
+	}
+	return m.appendpipeline, true
+}
+
+// ClearPipeline clears the value of the "pipeline" field.
+func (m *TaskMutation) ClearPipeline() {
+	m.pipeline = nil
+	m.appendpipeline = nil
+	m.clearedFields[task.FieldPipeline] = struct{}{}
+}
+
+// PipelineCleared returns if the "pipeline" field was cleared in this mutation.
+func (m *TaskMutation) PipelineCleared() bool {
+	_, ok := m.clearedFields[task.FieldPipeline]
+	return ok
+}
+
+// ResetPipeline resets all changes to the "pipeline" field.
+func (m *TaskMutation) ResetPipeline() {
+	m.pipeline = nil
+	m.appendpipeline = nil
+	delete(m.clearedFields, task.FieldPipeline)
+}
+
+// SetPipelineStatus sets the "pipeline_status" field.
+func (m *TaskMutation) SetPipelineStatus(s string) {
+	m.pipeline_status = &s
+}
+
+// PipelineStatus returns the value of the "pipeline_status" field in the mutation.
+func (m *TaskMutation) PipelineStatus() (r string, exists bool) {
+	v := m.pipeline_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPipelineStatus returns the old "pipeline_status" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPipelineStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPipelineStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPipelineStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPipelineStatus: %w", err)
+	}
+	return oldValue.PipelineStatus, nil
+}
+
+// ResetPipelineStatus resets all changes to the "pipeline_status" field.
+func (m *TaskMutation) ResetPipelineStatus() {
+	m.pipeline_status = nil
+}
+
+// SetSearch sets the "search" field.
+func (m *TaskMutation) SetSearch(ss *schema.HPSearch) {
+	m.search = &ss
+}
+
+// Search returns the value of the "search" field in the mutation.
+func (m *TaskMutation) Search() (r *schema.HPSearch, exists bool) {
+	v := m.search
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearch returns the old "search" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldSearch(ctx context.Context) (v *schema.HPSearch, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearch: %w", err)
+	}
+	return oldValue.Search, nil
+}
+
+// ClearSearch clears the value of the "search" field.
+func (m *TaskMutation) ClearSearch() {
+	m.search = nil
+	m.clearedFields[task.FieldSearch] = struct{}{}
+}
+
+// SearchCleared returns if the "search" field was cleared in this mutation.
+func (m *TaskMutation) SearchCleared() bool {
+	_, ok := m.clearedFields[task.FieldSearch]
+	return ok
+}
+
+// ResetSearch resets all changes to the "search" field.
+func (m *TaskMutation) ResetSearch() {
+	m.search = nil
+	delete(m.clearedFields, task.FieldSearch)
+}
+
+// SetSearchStatus sets the "search_status" field.
+func (m *TaskMutation) SetSearchStatus(s string) {
+	m.search_status = &s
+}
+
+// SearchStatus returns the value of the "search_status" field in the mutation.
+func (m *TaskMutation) SearchStatus() (r string, exists bool) {
+	v := m.search_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchStatus returns the old "search_status" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldSearchStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchStatus: %w", err)
+	}
+	return oldValue.SearchStatus, nil
+}
+
+// ResetSearchStatus resets all changes to the "search_status" field.
+func (m *TaskMutation) ResetSearchStatus() {
+	m.search_status = nil
+}
+
+// SetEarlyStop sets the "early_stop" field.
+func (m *TaskMutation) SetEarlyStop(ssp []schema.EarlyStopPolicy) {
+	m.early_stop = &ssp
+	m.appendearly_stop = nil
+}
+
+// EarlyStop returns the value of the "early_stop" field in the mutation.
+func (m *TaskMutation) EarlyStop() (r []schema.EarlyStopPolicy, exists bool) {
+	v := m.early_stop
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEarlyStop returns the old "early_stop" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldEarlyStop(ctx context.Context) (v []schema.EarlyStopPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEarlyStop is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEarlyStop requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEarlyStop: %w", err)
+	}
+	return oldValue.EarlyStop, nil
+}
+
+// AppendEarlyStop adds ssp to the "early_stop" field.
+func (m *TaskMutation) AppendEarlyStop(ssp []schema.EarlyStopPolicy) {
+	m.appendearly_stop = append(m.appendearly_stop, ssp...)
+}
+
+// AppendedEarlyStop returns the list of values that were appended to the "early_stop" field in this mutation.
+func (m *TaskMutation) AppendedEarlyStop() ([]schema.EarlyStopPolicy, bool) {
+	if len(m.appendearly_stop) == 0 {
+		return nil, false
+	}
+	return m.appendearly_stop, true
+}
+
+// ClearEarlyStop clears the value of the "early_stop" field.
+func (m *TaskMutation) ClearEarlyStop() {
+	m.early_stop = nil
+	m.appendearly_stop = nil
+	m.clearedFields[task.FieldEarlyStop] = struct{}{}
+}
+
+// EarlyStopCleared returns if the "early_stop" field was cleared in this mutation.
+func (m *TaskMutation) EarlyStopCleared() bool {
+	_, ok := m.clearedFields[task.FieldEarlyStop]
+	return ok
+}
+
+// ResetEarlyStop resets all changes to the "early_stop" field.
+func (m *TaskMutation) ResetEarlyStop() {
+	m.early_stop = nil
+	m.appendearly_stop = nil
+	delete(m.clearedFields, task.FieldEarlyStop)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *TaskMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -10745,6 +21151,60 @@ func (m *TaskMutation) ResetModelings() {
 	m.removedmodelings = nil
 }
 
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by ids.
+func (m *TaskMutation) AddScheduleIDs(ids ...int) {
+	if m.schedules == nil {
+		m.schedules = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.schedules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSchedules clears the "schedules" edge to the Schedule entity.
+func (m *TaskMutation) ClearSchedules() {
+	m.clearedschedules = true
+}
+
+// SchedulesCleared reports if the "schedules" edge to the Schedule entity was cleared.
+func (m *TaskMutation) SchedulesCleared() bool {
+	return m.clearedschedules
+}
+
+// RemoveScheduleIDs removes the "schedules" edge to the Schedule entity by IDs.
+func (m *TaskMutation) RemoveScheduleIDs(ids ...int) {
+	if m.removedschedules == nil {
+		m.removedschedules = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.schedules, ids[i])
+		m.removedschedules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSchedules returns the removed IDs of the "schedules" edge to the Schedule entity.
+func (m *TaskMutation) RemovedSchedulesIDs() (ids []int) {
+	for id := range m.removedschedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SchedulesIDs returns the "schedules" edge IDs in the mutation.
+func (m *TaskMutation) SchedulesIDs() (ids []int) {
+	for id := range m.schedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSchedules resets all changes to the "schedules" edge.
+func (m *TaskMutation) ResetSchedules() {
+	m.schedules = nil
+	m.clearedschedules = false
+	m.removedschedules = nil
+}
+
 // Where appends a list predicates to the TaskMutation builder.
 func (m *TaskMutation) Where(ps ...predicate.Task) {
 	m.predicates = append(m.predicates, ps...)
@@ -10779,7 +21239,7 @@ func (m *TaskMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TaskMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 14)
 	if m.project != nil {
 		fields = append(fields, task.FieldProjectID)
 	}
@@ -10801,6 +21261,21 @@ func (m *TaskMutation) Fields() []string {
 	if m.params != nil {
 		fields = append(fields, task.FieldParams)
 	}
+	if m.pipeline != nil {
+		fields = append(fields, task.FieldPipeline)
+	}
+	if m.pipeline_status != nil {
+		fields = append(fields, task.FieldPipelineStatus)
+	}
+	if m.search != nil {
+		fields = append(fields, task.FieldSearch)
+	}
+	if m.search_status != nil {
+		fields = append(fields, task.FieldSearchStatus)
+	}
+	if m.early_stop != nil {
+		fields = append(fields, task.FieldEarlyStop)
+	}
 	if m.created_at != nil {
 		fields = append(fields, task.FieldCreatedAt)
 	}
@@ -10829,6 +21304,16 @@ func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 		return m.TargetMetric()
 	case task.FieldParams:
 		return m.Params()
+	case task.FieldPipeline:
+		return m.Pipeline()
+	case task.FieldPipelineStatus:
+		return m.PipelineStatus()
+	case task.FieldSearch:
+		return m.Search()
+	case task.FieldSearchStatus:
+		return m.SearchStatus()
+	case task.FieldEarlyStop:
+		return m.EarlyStop()
 	case task.FieldCreatedAt:
 		return m.CreatedAt()
 	case task.FieldUpdatedAt:
@@ -10856,6 +21341,16 @@ func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldTargetMetric(ctx)
 	case task.FieldParams:
 		return m.OldParams(ctx)
+	case task.FieldPipeline:
+		return m.OldPipeline(ctx)
+	case task.FieldPipelineStatus:
+		return m.OldPipelineStatus(ctx)
+	case task.FieldSearch:
+		return m.OldSearch(ctx)
+	case task.FieldSearchStatus:
+		return m.OldSearchStatus(ctx)
+	case task.FieldEarlyStop:
+		return m.OldEarlyStop(ctx)
 	case task.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case task.FieldUpdatedAt:
@@ -10918,6 +21413,41 @@ func (m *TaskMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetParams(v)
 		return nil
+	case task.FieldPipeline:
+		v, ok := value.([]schema.PipelineStage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPipeline(v)
+		return nil
+	case task.FieldPipelineStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPipelineStatus(v)
+		return nil
+	case task.FieldSearch:
+		v, ok := value.(*schema.HPSearch)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearch(v)
+		return nil
+	case task.FieldSearchStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchStatus(v)
+		return nil
+	case task.FieldEarlyStop:
+		v, ok := value.([]schema.EarlyStopPolicy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEarlyStop(v)
+		return nil
 	case task.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -10980,6 +21510,15 @@ func (m *TaskMutation) ClearedFields() []string {
 	if m.FieldCleared(task.FieldProjectID) {
 		fields = append(fields, task.FieldProjectID)
 	}
+	if m.FieldCleared(task.FieldPipeline) {
+		fields = append(fields, task.FieldPipeline)
+	}
+	if m.FieldCleared(task.FieldSearch) {
+		fields = append(fields, task.FieldSearch)
+	}
+	if m.FieldCleared(task.FieldEarlyStop) {
+		fields = append(fields, task.FieldEarlyStop)
+	}
 	return fields
 }
 
@@ -10997,6 +21536,15 @@ func (m *TaskMutation) ClearField(name string) error {
 	case task.FieldProjectID:
 		m.ClearProjectID()
 		return nil
+	case task.FieldPipeline:
+		m.ClearPipeline()
+		return nil
+	case task.FieldSearch:
+		m.ClearSearch()
+		return nil
+	case task.FieldEarlyStop:
+		m.ClearEarlyStop()
+		return nil
 	}
 	return fmt.Errorf("unknown Task nullable field %s", name)
 }
@@ -11026,6 +21574,21 @@ func (m *TaskMutation) ResetField(name string) error {
 	case task.FieldParams:
 		m.ResetParams()
 		return nil
+	case task.FieldPipeline:
+		m.ResetPipeline()
+		return nil
+	case task.FieldPipelineStatus:
+		m.ResetPipelineStatus()
+		return nil
+	case task.FieldSearch:
+		m.ResetSearch()
+		return nil
+	case task.FieldSearchStatus:
+		m.ResetSearchStatus()
+		return nil
+	case task.FieldEarlyStop:
+		m.ResetEarlyStop()
+		return nil
 	case task.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -11038,13 +21601,16 @@ func (m *TaskMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *TaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.project != nil {
 		edges = append(edges, task.EdgeProject)
 	}
 	if m.modelings != nil {
 		edges = append(edges, task.EdgeModelings)
 	}
+	if m.schedules != nil {
+		edges = append(edges, task.EdgeSchedules)
+	}
 	return edges
 }
 
@@ -11062,16 +21628,25 @@ func (m *TaskMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case task.EdgeSchedules:
+		ids := make([]ent.Value, 0, len(m.schedules))
+		for id := range m.schedules {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *TaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.removedmodelings != nil {
 		edges = append(edges, task.EdgeModelings)
 	}
+	if m.removedschedules != nil {
+		edges = append(edges, task.EdgeSchedules)
+	}
 	return edges
 }
 
@@ -11085,19 +21660,28 @@ func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case task.EdgeSchedules:
+		ids := make([]ent.Value, 0, len(m.removedschedules))
+		for id := range m.removedschedules {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *TaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.clearedproject {
 		edges = append(edges, task.EdgeProject)
 	}
 	if m.clearedmodelings {
 		edges = append(edges, task.EdgeModelings)
 	}
+	if m.clearedschedules {
+		edges = append(edges, task.EdgeSchedules)
+	}
 	return edges
 }
 
@@ -11109,6 +21693,8 @@ func (m *TaskMutation) EdgeCleared(name string) bool {
 		return m.clearedproject
 	case task.EdgeModelings:
 		return m.clearedmodelings
+	case task.EdgeSchedules:
+		return m.clearedschedules
 	}
 	return false
 }
@@ -11134,6 +21720,9 @@ func (m *TaskMutation) ResetEdge(name string) error {
 	case task.EdgeModelings:
 		m.ResetModelings()
 		return nil
+	case task.EdgeSchedules:
+		m.ResetSchedules()
+		return nil
 	}
 	return fmt.Errorf("unknown Task edge %s", name)
 }
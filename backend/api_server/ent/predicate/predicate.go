@@ -18,15 +18,27 @@ type DatasetRoot func(*sql.Selector)
 // Device is the predicate function for device builders.
 type Device func(*sql.Selector)
 
+// DeviceToken is the predicate function for devicetoken builders.
+type DeviceToken func(*sql.Selector)
+
+// EarlyStop is the predicate function for earlystop builders.
+type EarlyStop func(*sql.Selector)
+
 // EngineLog is the predicate function for enginelog builders.
 type EngineLog func(*sql.Selector)
 
 // Gpu is the predicate function for gpu builders.
 type Gpu func(*sql.Selector)
 
+// GpuTelemetry is the predicate function for gputelemetry builders.
+type GpuTelemetry func(*sql.Selector)
+
 // HyperParamsHistory is the predicate function for hyperparamshistory builders.
 type HyperParamsHistory func(*sql.Selector)
 
+// MaintenanceWindow is the predicate function for maintenancewindow builders.
+type MaintenanceWindow func(*sql.Selector)
+
 // Menu is the predicate function for menu builders.
 type Menu func(*sql.Selector)
 
@@ -39,9 +51,24 @@ type ModelingDetails func(*sql.Selector)
 // ModelingModels is the predicate function for modelingmodels builders.
 type ModelingModels func(*sql.Selector)
 
+// Preemption is the predicate function for preemption builders.
+type Preemption func(*sql.Selector)
+
 // Project is the predicate function for project builders.
 type Project func(*sql.Selector)
 
+// Quota is the predicate function for quota builders.
+type Quota func(*sql.Selector)
+
+// Reservation is the predicate function for reservation builders.
+type Reservation func(*sql.Selector)
+
+// Schedule is the predicate function for schedule builders.
+type Schedule func(*sql.Selector)
+
+// ScheduleHistory is the predicate function for schedulehistory builders.
+type ScheduleHistory func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/preemption"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 높은 우선순위 modeling이 실행 중인 modeling을 중단시킨 기록
+type Preemption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Preempting modeling ID
+	ModelingID int `json:"modeling_id,omitempty"`
+	// Priority of the preempting modeling
+	Priority int `json:"priority,omitempty"`
+	// User who queued the preempting modeling
+	Username string `json:"username,omitempty"`
+	// Preempted modeling ID
+	VictimID int `json:"victim_id,omitempty"`
+	// Priority of the preempted modeling
+	VictimPriority int `json:"victim_priority,omitempty"`
+	// User who queued the preempted modeling
+	VictimUsername string `json:"victim_username,omitempty"`
+	// GPUs released by the preempted modeling
+	GpuIds []int `json:"gpu_ids,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Preemption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case preemption.FieldGpuIds:
+			values[i] = new([]byte)
+		case preemption.FieldID, preemption.FieldModelingID, preemption.FieldPriority, preemption.FieldVictimID, preemption.FieldVictimPriority:
+			values[i] = new(sql.NullInt64)
+		case preemption.FieldUsername, preemption.FieldVictimUsername:
+			values[i] = new(sql.NullString)
+		case preemption.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Preemption fields.
+func (pr *Preemption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case preemption.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case preemption.FieldModelingID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field modeling_id", values[i])
+			} else if value.Valid {
+				pr.ModelingID = int(value.Int64)
+			}
+		case preemption.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				pr.Priority = int(value.Int64)
+			}
+		case preemption.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				pr.Username = value.String
+			}
+		case preemption.FieldVictimID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field victim_id", values[i])
+			} else if value.Valid {
+				pr.VictimID = int(value.Int64)
+			}
+		case preemption.FieldVictimPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field victim_priority", values[i])
+			} else if value.Valid {
+				pr.VictimPriority = int(value.Int64)
+			}
+		case preemption.FieldVictimUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field victim_username", values[i])
+			} else if value.Valid {
+				pr.VictimUsername = value.String
+			}
+		case preemption.FieldGpuIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field gpu_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.GpuIds); err != nil {
+					return fmt.Errorf("unmarshal field gpu_ids: %w", err)
+				}
+			}
+		case preemption.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Preemption.
+// This includes values selected through modifiers, order, etc.
+func (pr *Preemption) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Preemption.
+// Note that you need to call Preemption.Unwrap() before calling this method if this Preemption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Preemption) Update() *PreemptionUpdateOne {
+	return NewPreemptionClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Preemption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Preemption) Unwrap() *Preemption {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Preemption is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Preemption) String() string {
+	var builder strings.Builder
+	builder.WriteString("Preemption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("modeling_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ModelingID))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(pr.Username)
+	builder.WriteString(", ")
+	builder.WriteString("victim_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.VictimID))
+	builder.WriteString(", ")
+	builder.WriteString("victim_priority=")
+	builder.WriteString(fmt.Sprintf("%v", pr.VictimPriority))
+	builder.WriteString(", ")
+	builder.WriteString("victim_username=")
+	builder.WriteString(pr.VictimUsername)
+	builder.WriteString(", ")
+	builder.WriteString("gpu_ids=")
+	builder.WriteString(fmt.Sprintf("%v", pr.GpuIds))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Preemptions is a parsable slice of Preemption.
+type Preemptions []*Preemption
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package preemption
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the preemption type in the database.
+	Label = "preemption"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldModelingID holds the string denoting the modeling_id field in the database.
+	FieldModelingID = "modeling_id"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldVictimID holds the string denoting the victim_id field in the database.
+	FieldVictimID = "victim_id"
+	// FieldVictimPriority holds the string denoting the victim_priority field in the database.
+	FieldVictimPriority = "victim_priority"
+	// FieldVictimUsername holds the string denoting the victim_username field in the database.
+	FieldVictimUsername = "victim_username"
+	// FieldGpuIds holds the string denoting the gpu_ids field in the database.
+	FieldGpuIds = "gpu_ids"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the preemption in the database.
+	Table = "preemption"
+)
+
+// Columns holds all SQL columns for preemption fields.
+var Columns = []string{
+	FieldID,
+	FieldModelingID,
+	FieldPriority,
+	FieldUsername,
+	FieldVictimID,
+	FieldVictimPriority,
+	FieldVictimUsername,
+	FieldGpuIds,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultUsername holds the default value on creation for the "username" field.
+	DefaultUsername string
+	// DefaultVictimPriority holds the default value on creation for the "victim_priority" field.
+	DefaultVictimPriority int
+	// DefaultVictimUsername holds the default value on creation for the "victim_username" field.
+	DefaultVictimUsername string
+	// DefaultGpuIds holds the default value on creation for the "gpu_ids" field.
+	DefaultGpuIds []int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Preemption queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByModelingID orders the results by the modeling_id field.
+func ByModelingID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModelingID, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByVictimID orders the results by the victim_id field.
+func ByVictimID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVictimID, opts...).ToFunc()
+}
+
+// ByVictimPriority orders the results by the victim_priority field.
+func ByVictimPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVictimPriority, opts...).ToFunc()
+}
+
+// ByVictimUsername orders the results by the victim_username field.
+func ByVictimUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVictimUsername, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package preemption
+
+import (
+	"api_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLTE(FieldID, id))
+}
+
+// ModelingID applies equality check predicate on the "modeling_id" field. It's identical to ModelingIDEQ.
+func ModelingID(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldModelingID, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldPriority, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldUsername, v))
+}
+
+// VictimID applies equality check predicate on the "victim_id" field. It's identical to VictimIDEQ.
+func VictimID(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldVictimID, v))
+}
+
+// VictimPriority applies equality check predicate on the "victim_priority" field. It's identical to VictimPriorityEQ.
+func VictimPriority(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldVictimPriority, v))
+}
+
+// VictimUsername applies equality check predicate on the "victim_username" field. It's identical to VictimUsernameEQ.
+func VictimUsername(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldVictimUsername, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ModelingIDEQ applies the EQ predicate on the "modeling_id" field.
+func ModelingIDEQ(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldModelingID, v))
+}
+
+// ModelingIDNEQ applies the NEQ predicate on the "modeling_id" field.
+func ModelingIDNEQ(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNEQ(FieldModelingID, v))
+}
+
+// ModelingIDIn applies the In predicate on the "modeling_id" field.
+func ModelingIDIn(vs ...int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldIn(FieldModelingID, vs...))
+}
+
+// ModelingIDNotIn applies the NotIn predicate on the "modeling_id" field.
+func ModelingIDNotIn(vs ...int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNotIn(FieldModelingID, vs...))
+}
+
+// ModelingIDGT applies the GT predicate on the "modeling_id" field.
+func ModelingIDGT(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGT(FieldModelingID, v))
+}
+
+// ModelingIDGTE applies the GTE predicate on the "modeling_id" field.
+func ModelingIDGTE(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGTE(FieldModelingID, v))
+}
+
+// ModelingIDLT applies the LT predicate on the "modeling_id" field.
+func ModelingIDLT(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLT(FieldModelingID, v))
+}
+
+// ModelingIDLTE applies the LTE predicate on the "modeling_id" field.
+func ModelingIDLTE(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLTE(FieldModelingID, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLTE(FieldPriority, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// VictimIDEQ applies the EQ predicate on the "victim_id" field.
+func VictimIDEQ(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldVictimID, v))
+}
+
+// VictimIDNEQ applies the NEQ predicate on the "victim_id" field.
+func VictimIDNEQ(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNEQ(FieldVictimID, v))
+}
+
+// VictimIDIn applies the In predicate on the "victim_id" field.
+func VictimIDIn(vs ...int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldIn(FieldVictimID, vs...))
+}
+
+// VictimIDNotIn applies the NotIn predicate on the "victim_id" field.
+func VictimIDNotIn(vs ...int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNotIn(FieldVictimID, vs...))
+}
+
+// VictimIDGT applies the GT predicate on the "victim_id" field.
+func VictimIDGT(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGT(FieldVictimID, v))
+}
+
+// VictimIDGTE applies the GTE predicate on the "victim_id" field.
+func VictimIDGTE(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGTE(FieldVictimID, v))
+}
+
+// VictimIDLT applies the LT predicate on the "victim_id" field.
+func VictimIDLT(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLT(FieldVictimID, v))
+}
+
+// VictimIDLTE applies the LTE predicate on the "victim_id" field.
+func VictimIDLTE(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLTE(FieldVictimID, v))
+}
+
+// VictimPriorityEQ applies the EQ predicate on the "victim_priority" field.
+func VictimPriorityEQ(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldVictimPriority, v))
+}
+
+// VictimPriorityNEQ applies the NEQ predicate on the "victim_priority" field.
+func VictimPriorityNEQ(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNEQ(FieldVictimPriority, v))
+}
+
+// VictimPriorityIn applies the In predicate on the "victim_priority" field.
+func VictimPriorityIn(vs ...int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldIn(FieldVictimPriority, vs...))
+}
+
+// VictimPriorityNotIn applies the NotIn predicate on the "victim_priority" field.
+func VictimPriorityNotIn(vs ...int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNotIn(FieldVictimPriority, vs...))
+}
+
+// VictimPriorityGT applies the GT predicate on the "victim_priority" field.
+func VictimPriorityGT(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGT(FieldVictimPriority, v))
+}
+
+// VictimPriorityGTE applies the GTE predicate on the "victim_priority" field.
+func VictimPriorityGTE(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGTE(FieldVictimPriority, v))
+}
+
+// VictimPriorityLT applies the LT predicate on the "victim_priority" field.
+func VictimPriorityLT(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLT(FieldVictimPriority, v))
+}
+
+// VictimPriorityLTE applies the LTE predicate on the "victim_priority" field.
+func VictimPriorityLTE(v int) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLTE(FieldVictimPriority, v))
+}
+
+// VictimUsernameEQ applies the EQ predicate on the "victim_username" field.
+func VictimUsernameEQ(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldVictimUsername, v))
+}
+
+// VictimUsernameNEQ applies the NEQ predicate on the "victim_username" field.
+func VictimUsernameNEQ(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNEQ(FieldVictimUsername, v))
+}
+
+// VictimUsernameIn applies the In predicate on the "victim_username" field.
+func VictimUsernameIn(vs ...string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldIn(FieldVictimUsername, vs...))
+}
+
+// VictimUsernameNotIn applies the NotIn predicate on the "victim_username" field.
+func VictimUsernameNotIn(vs ...string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNotIn(FieldVictimUsername, vs...))
+}
+
+// VictimUsernameGT applies the GT predicate on the "victim_username" field.
+func VictimUsernameGT(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGT(FieldVictimUsername, v))
+}
+
+// VictimUsernameGTE applies the GTE predicate on the "victim_username" field.
+func VictimUsernameGTE(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGTE(FieldVictimUsername, v))
+}
+
+// VictimUsernameLT applies the LT predicate on the "victim_username" field.
+func VictimUsernameLT(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLT(FieldVictimUsername, v))
+}
+
+// VictimUsernameLTE applies the LTE predicate on the "victim_username" field.
+func VictimUsernameLTE(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLTE(FieldVictimUsername, v))
+}
+
+// VictimUsernameContains applies the Contains predicate on the "victim_username" field.
+func VictimUsernameContains(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldContains(FieldVictimUsername, v))
+}
+
+// VictimUsernameHasPrefix applies the HasPrefix predicate on the "victim_username" field.
+func VictimUsernameHasPrefix(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldHasPrefix(FieldVictimUsername, v))
+}
+
+// VictimUsernameHasSuffix applies the HasSuffix predicate on the "victim_username" field.
+func VictimUsernameHasSuffix(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldHasSuffix(FieldVictimUsername, v))
+}
+
+// VictimUsernameEqualFold applies the EqualFold predicate on the "victim_username" field.
+func VictimUsernameEqualFold(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEqualFold(FieldVictimUsername, v))
+}
+
+// VictimUsernameContainsFold applies the ContainsFold predicate on the "victim_username" field.
+func VictimUsernameContainsFold(v string) predicate.Preemption {
+	return predicate.Preemption(sql.FieldContainsFold(FieldVictimUsername, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Preemption {
+	return predicate.Preemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Preemption {
+	return predicate.Preemption(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Preemption {
+	return predicate.Preemption(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Preemption {
+	return predicate.Preemption(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Preemption {
+	return predicate.Preemption(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Preemption) predicate.Preemption {
+	return predicate.Preemption(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Preemption) predicate.Preemption {
+	return predicate.Preemption(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Preemption) predicate.Preemption {
+	return predicate.Preemption(sql.NotPredicates(p))
+}
@@ -0,0 +1,972 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/preemption"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PreemptionCreate is the builder for creating a Preemption entity.
+type PreemptionCreate struct {
+	config
+	mutation *PreemptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (pc *PreemptionCreate) SetModelingID(i int) *PreemptionCreate {
+	pc.mutation.SetModelingID(i)
+	return pc
+}
+
+// SetPriority sets the "priority" field.
+func (pc *PreemptionCreate) SetPriority(i int) *PreemptionCreate {
+	pc.mutation.SetPriority(i)
+	return pc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (pc *PreemptionCreate) SetNillablePriority(i *int) *PreemptionCreate {
+	if i != nil {
+		pc.SetPriority(*i)
+	}
+	return pc
+}
+
+// SetUsername sets the "username" field.
+func (pc *PreemptionCreate) SetUsername(s string) *PreemptionCreate {
+	pc.mutation.SetUsername(s)
+	return pc
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (pc *PreemptionCreate) SetNillableUsername(s *string) *PreemptionCreate {
+	if s != nil {
+		pc.SetUsername(*s)
+	}
+	return pc
+}
+
+// SetVictimID sets the "victim_id" field.
+func (pc *PreemptionCreate) SetVictimID(i int) *PreemptionCreate {
+	pc.mutation.SetVictimID(i)
+	return pc
+}
+
+// SetVictimPriority sets the "victim_priority" field.
+func (pc *PreemptionCreate) SetVictimPriority(i int) *PreemptionCreate {
+	pc.mutation.SetVictimPriority(i)
+	return pc
+}
+
+// SetNillableVictimPriority sets the "victim_priority" field if the given value is not nil.
+func (pc *PreemptionCreate) SetNillableVictimPriority(i *int) *PreemptionCreate {
+	if i != nil {
+		pc.SetVictimPriority(*i)
+	}
+	return pc
+}
+
+// SetVictimUsername sets the "victim_username" field.
+func (pc *PreemptionCreate) SetVictimUsername(s string) *PreemptionCreate {
+	pc.mutation.SetVictimUsername(s)
+	return pc
+}
+
+// SetNillableVictimUsername sets the "victim_username" field if the given value is not nil.
+func (pc *PreemptionCreate) SetNillableVictimUsername(s *string) *PreemptionCreate {
+	if s != nil {
+		pc.SetVictimUsername(*s)
+	}
+	return pc
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (pc *PreemptionCreate) SetGpuIds(i []int) *PreemptionCreate {
+	pc.mutation.SetGpuIds(i)
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PreemptionCreate) SetCreatedAt(t time.Time) *PreemptionCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PreemptionCreate) SetNillableCreatedAt(t *time.Time) *PreemptionCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *PreemptionCreate) SetID(i int) *PreemptionCreate {
+	pc.mutation.SetID(i)
+	return pc
+}
+
+// Mutation returns the PreemptionMutation object of the builder.
+func (pc *PreemptionCreate) Mutation() *PreemptionMutation {
+	return pc.mutation
+}
+
+// Save creates the Preemption in the database.
+func (pc *PreemptionCreate) Save(ctx context.Context) (*Preemption, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PreemptionCreate) SaveX(ctx context.Context) *Preemption {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PreemptionCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PreemptionCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PreemptionCreate) defaults() {
+	if _, ok := pc.mutation.Priority(); !ok {
+		v := preemption.DefaultPriority
+		pc.mutation.SetPriority(v)
+	}
+	if _, ok := pc.mutation.Username(); !ok {
+		v := preemption.DefaultUsername
+		pc.mutation.SetUsername(v)
+	}
+	if _, ok := pc.mutation.VictimPriority(); !ok {
+		v := preemption.DefaultVictimPriority
+		pc.mutation.SetVictimPriority(v)
+	}
+	if _, ok := pc.mutation.VictimUsername(); !ok {
+		v := preemption.DefaultVictimUsername
+		pc.mutation.SetVictimUsername(v)
+	}
+	if _, ok := pc.mutation.GpuIds(); !ok {
+		v := preemption.DefaultGpuIds
+		pc.mutation.SetGpuIds(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := preemption.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PreemptionCreate) check() error {
+	if _, ok := pc.mutation.ModelingID(); !ok {
+		return &ValidationError{Name: "modeling_id", err: errors.New(`ent: missing required field "Preemption.modeling_id"`)}
+	}
+	if _, ok := pc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Preemption.priority"`)}
+	}
+	if _, ok := pc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "Preemption.username"`)}
+	}
+	if _, ok := pc.mutation.VictimID(); !ok {
+		return &ValidationError{Name: "victim_id", err: errors.New(`ent: missing required field "Preemption.victim_id"`)}
+	}
+	if _, ok := pc.mutation.VictimPriority(); !ok {
+		return &ValidationError{Name: "victim_priority", err: errors.New(`ent: missing required field "Preemption.victim_priority"`)}
+	}
+	if _, ok := pc.mutation.VictimUsername(); !ok {
+		return &ValidationError{Name: "victim_username", err: errors.New(`ent: missing required field "Preemption.victim_username"`)}
+	}
+	if _, ok := pc.mutation.GpuIds(); !ok {
+		return &ValidationError{Name: "gpu_ids", err: errors.New(`ent: missing required field "Preemption.gpu_ids"`)}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Preemption.created_at"`)}
+	}
+	return nil
+}
+
+func (pc *PreemptionCreate) sqlSave(ctx context.Context) (*Preemption, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PreemptionCreate) createSpec() (*Preemption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Preemption{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(preemption.Table, sqlgraph.NewFieldSpec(preemption.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = pc.conflict
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pc.mutation.ModelingID(); ok {
+		_spec.SetField(preemption.FieldModelingID, field.TypeInt, value)
+		_node.ModelingID = value
+	}
+	if value, ok := pc.mutation.Priority(); ok {
+		_spec.SetField(preemption.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := pc.mutation.Username(); ok {
+		_spec.SetField(preemption.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := pc.mutation.VictimID(); ok {
+		_spec.SetField(preemption.FieldVictimID, field.TypeInt, value)
+		_node.VictimID = value
+	}
+	if value, ok := pc.mutation.VictimPriority(); ok {
+		_spec.SetField(preemption.FieldVictimPriority, field.TypeInt, value)
+		_node.VictimPriority = value
+	}
+	if value, ok := pc.mutation.VictimUsername(); ok {
+		_spec.SetField(preemption.FieldVictimUsername, field.TypeString, value)
+		_node.VictimUsername = value
+	}
+	if value, ok := pc.mutation.GpuIds(); ok {
+		_spec.SetField(preemption.FieldGpuIds, field.TypeJSON, value)
+		_node.GpuIds = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(preemption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Preemption.Create().
+//		SetModelingID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PreemptionUpsert) {
+//			SetModelingID(v+v).
+//		}).
+//		Exec(ctx)
+func (pc *PreemptionCreate) OnConflict(opts ...sql.ConflictOption) *PreemptionUpsertOne {
+	pc.conflict = opts
+	return &PreemptionUpsertOne{
+		create: pc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Preemption.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pc *PreemptionCreate) OnConflictColumns(columns ...string) *PreemptionUpsertOne {
+	pc.conflict = append(pc.conflict, sql.ConflictColumns(columns...))
+	return &PreemptionUpsertOne{
+		create: pc,
+	}
+}
+
+type (
+	// PreemptionUpsertOne is the builder for "upsert"-ing
+	//  one Preemption node.
+	PreemptionUpsertOne struct {
+		create *PreemptionCreate
+	}
+
+	// PreemptionUpsert is the "OnConflict" setter.
+	PreemptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetModelingID sets the "modeling_id" field.
+func (u *PreemptionUpsert) SetModelingID(v int) *PreemptionUpsert {
+	u.Set(preemption.FieldModelingID, v)
+	return u
+}
+
+// UpdateModelingID sets the "modeling_id" field to the value that was provided on create.
+func (u *PreemptionUpsert) UpdateModelingID() *PreemptionUpsert {
+	u.SetExcluded(preemption.FieldModelingID)
+	return u
+}
+
+// AddModelingID adds v to the "modeling_id" field.
+func (u *PreemptionUpsert) AddModelingID(v int) *PreemptionUpsert {
+	u.Add(preemption.FieldModelingID, v)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *PreemptionUpsert) SetPriority(v int) *PreemptionUpsert {
+	u.Set(preemption.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *PreemptionUpsert) UpdatePriority() *PreemptionUpsert {
+	u.SetExcluded(preemption.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *PreemptionUpsert) AddPriority(v int) *PreemptionUpsert {
+	u.Add(preemption.FieldPriority, v)
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *PreemptionUpsert) SetUsername(v string) *PreemptionUpsert {
+	u.Set(preemption.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *PreemptionUpsert) UpdateUsername() *PreemptionUpsert {
+	u.SetExcluded(preemption.FieldUsername)
+	return u
+}
+
+// SetVictimID sets the "victim_id" field.
+func (u *PreemptionUpsert) SetVictimID(v int) *PreemptionUpsert {
+	u.Set(preemption.FieldVictimID, v)
+	return u
+}
+
+// UpdateVictimID sets the "victim_id" field to the value that was provided on create.
+func (u *PreemptionUpsert) UpdateVictimID() *PreemptionUpsert {
+	u.SetExcluded(preemption.FieldVictimID)
+	return u
+}
+
+// AddVictimID adds v to the "victim_id" field.
+func (u *PreemptionUpsert) AddVictimID(v int) *PreemptionUpsert {
+	u.Add(preemption.FieldVictimID, v)
+	return u
+}
+
+// SetVictimPriority sets the "victim_priority" field.
+func (u *PreemptionUpsert) SetVictimPriority(v int) *PreemptionUpsert {
+	u.Set(preemption.FieldVictimPriority, v)
+	return u
+}
+
+// UpdateVictimPriority sets the "victim_priority" field to the value that was provided on create.
+func (u *PreemptionUpsert) UpdateVictimPriority() *PreemptionUpsert {
+	u.SetExcluded(preemption.FieldVictimPriority)
+	return u
+}
+
+// AddVictimPriority adds v to the "victim_priority" field.
+func (u *PreemptionUpsert) AddVictimPriority(v int) *PreemptionUpsert {
+	u.Add(preemption.FieldVictimPriority, v)
+	return u
+}
+
+// SetVictimUsername sets the "victim_username" field.
+func (u *PreemptionUpsert) SetVictimUsername(v string) *PreemptionUpsert {
+	u.Set(preemption.FieldVictimUsername, v)
+	return u
+}
+
+// UpdateVictimUsername sets the "victim_username" field to the value that was provided on create.
+func (u *PreemptionUpsert) UpdateVictimUsername() *PreemptionUpsert {
+	u.SetExcluded(preemption.FieldVictimUsername)
+	return u
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (u *PreemptionUpsert) SetGpuIds(v []int) *PreemptionUpsert {
+	u.Set(preemption.FieldGpuIds, v)
+	return u
+}
+
+// UpdateGpuIds sets the "gpu_ids" field to the value that was provided on create.
+func (u *PreemptionUpsert) UpdateGpuIds() *PreemptionUpsert {
+	u.SetExcluded(preemption.FieldGpuIds)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Preemption.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(preemption.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PreemptionUpsertOne) UpdateNewValues() *PreemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(preemption.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(preemption.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Preemption.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PreemptionUpsertOne) Ignore() *PreemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PreemptionUpsertOne) DoNothing() *PreemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PreemptionCreate.OnConflict
+// documentation for more info.
+func (u *PreemptionUpsertOne) Update(set func(*PreemptionUpsert)) *PreemptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PreemptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (u *PreemptionUpsertOne) SetModelingID(v int) *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetModelingID(v)
+	})
+}
+
+// AddModelingID adds v to the "modeling_id" field.
+func (u *PreemptionUpsertOne) AddModelingID(v int) *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.AddModelingID(v)
+	})
+}
+
+// UpdateModelingID sets the "modeling_id" field to the value that was provided on create.
+func (u *PreemptionUpsertOne) UpdateModelingID() *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdateModelingID()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *PreemptionUpsertOne) SetPriority(v int) *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *PreemptionUpsertOne) AddPriority(v int) *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *PreemptionUpsertOne) UpdatePriority() *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *PreemptionUpsertOne) SetUsername(v string) *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *PreemptionUpsertOne) UpdateUsername() *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetVictimID sets the "victim_id" field.
+func (u *PreemptionUpsertOne) SetVictimID(v int) *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetVictimID(v)
+	})
+}
+
+// AddVictimID adds v to the "victim_id" field.
+func (u *PreemptionUpsertOne) AddVictimID(v int) *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.AddVictimID(v)
+	})
+}
+
+// UpdateVictimID sets the "victim_id" field to the value that was provided on create.
+func (u *PreemptionUpsertOne) UpdateVictimID() *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdateVictimID()
+	})
+}
+
+// SetVictimPriority sets the "victim_priority" field.
+func (u *PreemptionUpsertOne) SetVictimPriority(v int) *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetVictimPriority(v)
+	})
+}
+
+// AddVictimPriority adds v to the "victim_priority" field.
+func (u *PreemptionUpsertOne) AddVictimPriority(v int) *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.AddVictimPriority(v)
+	})
+}
+
+// UpdateVictimPriority sets the "victim_priority" field to the value that was provided on create.
+func (u *PreemptionUpsertOne) UpdateVictimPriority() *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdateVictimPriority()
+	})
+}
+
+// SetVictimUsername sets the "victim_username" field.
+func (u *PreemptionUpsertOne) SetVictimUsername(v string) *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetVictimUsername(v)
+	})
+}
+
+// UpdateVictimUsername sets the "victim_username" field to the value that was provided on create.
+func (u *PreemptionUpsertOne) UpdateVictimUsername() *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdateVictimUsername()
+	})
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (u *PreemptionUpsertOne) SetGpuIds(v []int) *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetGpuIds(v)
+	})
+}
+
+// UpdateGpuIds sets the "gpu_ids" field to the value that was provided on create.
+func (u *PreemptionUpsertOne) UpdateGpuIds() *PreemptionUpsertOne {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdateGpuIds()
+	})
+}
+
+// Exec executes the query.
+func (u *PreemptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PreemptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PreemptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PreemptionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PreemptionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PreemptionCreateBulk is the builder for creating many Preemption entities in bulk.
+type PreemptionCreateBulk struct {
+	config
+	err      error
+	builders []*PreemptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Preemption entities in the database.
+func (pcb *PreemptionCreateBulk) Save(ctx context.Context) ([]*Preemption, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Preemption, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PreemptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PreemptionCreateBulk) SaveX(ctx context.Context) []*Preemption {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PreemptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PreemptionCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Preemption.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PreemptionUpsert) {
+//			SetModelingID(v+v).
+//		}).
+//		Exec(ctx)
+func (pcb *PreemptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PreemptionUpsertBulk {
+	pcb.conflict = opts
+	return &PreemptionUpsertBulk{
+		create: pcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Preemption.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pcb *PreemptionCreateBulk) OnConflictColumns(columns ...string) *PreemptionUpsertBulk {
+	pcb.conflict = append(pcb.conflict, sql.ConflictColumns(columns...))
+	return &PreemptionUpsertBulk{
+		create: pcb,
+	}
+}
+
+// PreemptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of Preemption nodes.
+type PreemptionUpsertBulk struct {
+	create *PreemptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Preemption.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(preemption.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PreemptionUpsertBulk) UpdateNewValues() *PreemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(preemption.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(preemption.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Preemption.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PreemptionUpsertBulk) Ignore() *PreemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PreemptionUpsertBulk) DoNothing() *PreemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PreemptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PreemptionUpsertBulk) Update(set func(*PreemptionUpsert)) *PreemptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PreemptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (u *PreemptionUpsertBulk) SetModelingID(v int) *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetModelingID(v)
+	})
+}
+
+// AddModelingID adds v to the "modeling_id" field.
+func (u *PreemptionUpsertBulk) AddModelingID(v int) *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.AddModelingID(v)
+	})
+}
+
+// UpdateModelingID sets the "modeling_id" field to the value that was provided on create.
+func (u *PreemptionUpsertBulk) UpdateModelingID() *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdateModelingID()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *PreemptionUpsertBulk) SetPriority(v int) *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *PreemptionUpsertBulk) AddPriority(v int) *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *PreemptionUpsertBulk) UpdatePriority() *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *PreemptionUpsertBulk) SetUsername(v string) *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *PreemptionUpsertBulk) UpdateUsername() *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetVictimID sets the "victim_id" field.
+func (u *PreemptionUpsertBulk) SetVictimID(v int) *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetVictimID(v)
+	})
+}
+
+// AddVictimID adds v to the "victim_id" field.
+func (u *PreemptionUpsertBulk) AddVictimID(v int) *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.AddVictimID(v)
+	})
+}
+
+// UpdateVictimID sets the "victim_id" field to the value that was provided on create.
+func (u *PreemptionUpsertBulk) UpdateVictimID() *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdateVictimID()
+	})
+}
+
+// SetVictimPriority sets the "victim_priority" field.
+func (u *PreemptionUpsertBulk) SetVictimPriority(v int) *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetVictimPriority(v)
+	})
+}
+
+// AddVictimPriority adds v to the "victim_priority" field.
+func (u *PreemptionUpsertBulk) AddVictimPriority(v int) *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.AddVictimPriority(v)
+	})
+}
+
+// UpdateVictimPriority sets the "victim_priority" field to the value that was provided on create.
+func (u *PreemptionUpsertBulk) UpdateVictimPriority() *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdateVictimPriority()
+	})
+}
+
+// SetVictimUsername sets the "victim_username" field.
+func (u *PreemptionUpsertBulk) SetVictimUsername(v string) *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetVictimUsername(v)
+	})
+}
+
+// UpdateVictimUsername sets the "victim_username" field to the value that was provided on create.
+func (u *PreemptionUpsertBulk) UpdateVictimUsername() *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdateVictimUsername()
+	})
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (u *PreemptionUpsertBulk) SetGpuIds(v []int) *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.SetGpuIds(v)
+	})
+}
+
+// UpdateGpuIds sets the "gpu_ids" field to the value that was provided on create.
+func (u *PreemptionUpsertBulk) UpdateGpuIds() *PreemptionUpsertBulk {
+	return u.Update(func(s *PreemptionUpsert) {
+		s.UpdateGpuIds()
+	})
+}
+
+// Exec executes the query.
+func (u *PreemptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PreemptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PreemptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PreemptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
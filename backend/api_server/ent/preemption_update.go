@@ -0,0 +1,516 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/predicate"
+	"api_server/ent/preemption"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// PreemptionUpdate is the builder for updating Preemption entities.
+type PreemptionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PreemptionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PreemptionUpdate builder.
+func (pu *PreemptionUpdate) Where(ps ...predicate.Preemption) *PreemptionUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (pu *PreemptionUpdate) SetModelingID(i int) *PreemptionUpdate {
+	pu.mutation.ResetModelingID()
+	pu.mutation.SetModelingID(i)
+	return pu
+}
+
+// SetNillableModelingID sets the "modeling_id" field if the given value is not nil.
+func (pu *PreemptionUpdate) SetNillableModelingID(i *int) *PreemptionUpdate {
+	if i != nil {
+		pu.SetModelingID(*i)
+	}
+	return pu
+}
+
+// AddModelingID adds i to the "modeling_id" field.
+func (pu *PreemptionUpdate) AddModelingID(i int) *PreemptionUpdate {
+	pu.mutation.AddModelingID(i)
+	return pu
+}
+
+// SetPriority sets the "priority" field.
+func (pu *PreemptionUpdate) SetPriority(i int) *PreemptionUpdate {
+	pu.mutation.ResetPriority()
+	pu.mutation.SetPriority(i)
+	return pu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (pu *PreemptionUpdate) SetNillablePriority(i *int) *PreemptionUpdate {
+	if i != nil {
+		pu.SetPriority(*i)
+	}
+	return pu
+}
+
+// AddPriority adds i to the "priority" field.
+func (pu *PreemptionUpdate) AddPriority(i int) *PreemptionUpdate {
+	pu.mutation.AddPriority(i)
+	return pu
+}
+
+// SetUsername sets the "username" field.
+func (pu *PreemptionUpdate) SetUsername(s string) *PreemptionUpdate {
+	pu.mutation.SetUsername(s)
+	return pu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (pu *PreemptionUpdate) SetNillableUsername(s *string) *PreemptionUpdate {
+	if s != nil {
+		pu.SetUsername(*s)
+	}
+	return pu
+}
+
+// SetVictimID sets the "victim_id" field.
+func (pu *PreemptionUpdate) SetVictimID(i int) *PreemptionUpdate {
+	pu.mutation.ResetVictimID()
+	pu.mutation.SetVictimID(i)
+	return pu
+}
+
+// SetNillableVictimID sets the "victim_id" field if the given value is not nil.
+func (pu *PreemptionUpdate) SetNillableVictimID(i *int) *PreemptionUpdate {
+	if i != nil {
+		pu.SetVictimID(*i)
+	}
+	return pu
+}
+
+// AddVictimID adds i to the "victim_id" field.
+func (pu *PreemptionUpdate) AddVictimID(i int) *PreemptionUpdate {
+	pu.mutation.AddVictimID(i)
+	return pu
+}
+
+// SetVictimPriority sets the "victim_priority" field.
+func (pu *PreemptionUpdate) SetVictimPriority(i int) *PreemptionUpdate {
+	pu.mutation.ResetVictimPriority()
+	pu.mutation.SetVictimPriority(i)
+	return pu
+}
+
+// SetNillableVictimPriority sets the "victim_priority" field if the given value is not nil.
+func (pu *PreemptionUpdate) SetNillableVictimPriority(i *int) *PreemptionUpdate {
+	if i != nil {
+		pu.SetVictimPriority(*i)
+	}
+	return pu
+}
+
+// AddVictimPriority adds i to the "victim_priority" field.
+func (pu *PreemptionUpdate) AddVictimPriority(i int) *PreemptionUpdate {
+	pu.mutation.AddVictimPriority(i)
+	return pu
+}
+
+// SetVictimUsername sets the "victim_username" field.
+func (pu *PreemptionUpdate) SetVictimUsername(s string) *PreemptionUpdate {
+	pu.mutation.SetVictimUsername(s)
+	return pu
+}
+
+// SetNillableVictimUsername sets the "victim_username" field if the given value is not nil.
+func (pu *PreemptionUpdate) SetNillableVictimUsername(s *string) *PreemptionUpdate {
+	if s != nil {
+		pu.SetVictimUsername(*s)
+	}
+	return pu
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (pu *PreemptionUpdate) SetGpuIds(i []int) *PreemptionUpdate {
+	pu.mutation.SetGpuIds(i)
+	return pu
+}
+
+// AppendGpuIds appends i to the "gpu_ids" field.
+func (pu *PreemptionUpdate) AppendGpuIds(i []int) *PreemptionUpdate {
+	pu.mutation.AppendGpuIds(i)
+	return pu
+}
+
+// Mutation returns the PreemptionMutation object of the builder.
+func (pu *PreemptionUpdate) Mutation() *PreemptionMutation {
+	return pu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PreemptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PreemptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PreemptionUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PreemptionUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pu *PreemptionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PreemptionUpdate {
+	pu.modifiers = append(pu.modifiers, modifiers...)
+	return pu
+}
+
+func (pu *PreemptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(preemption.Table, preemption.Columns, sqlgraph.NewFieldSpec(preemption.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.ModelingID(); ok {
+		_spec.SetField(preemption.FieldModelingID, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedModelingID(); ok {
+		_spec.AddField(preemption.FieldModelingID, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.Priority(); ok {
+		_spec.SetField(preemption.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedPriority(); ok {
+		_spec.AddField(preemption.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.Username(); ok {
+		_spec.SetField(preemption.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.VictimID(); ok {
+		_spec.SetField(preemption.FieldVictimID, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedVictimID(); ok {
+		_spec.AddField(preemption.FieldVictimID, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.VictimPriority(); ok {
+		_spec.SetField(preemption.FieldVictimPriority, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedVictimPriority(); ok {
+		_spec.AddField(preemption.FieldVictimPriority, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.VictimUsername(); ok {
+		_spec.SetField(preemption.FieldVictimUsername, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.GpuIds(); ok {
+		_spec.SetField(preemption.FieldGpuIds, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedGpuIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, preemption.FieldGpuIds, value)
+		})
+	}
+	_spec.AddModifiers(pu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{preemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PreemptionUpdateOne is the builder for updating a single Preemption entity.
+type PreemptionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PreemptionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (puo *PreemptionUpdateOne) SetModelingID(i int) *PreemptionUpdateOne {
+	puo.mutation.ResetModelingID()
+	puo.mutation.SetModelingID(i)
+	return puo
+}
+
+// SetNillableModelingID sets the "modeling_id" field if the given value is not nil.
+func (puo *PreemptionUpdateOne) SetNillableModelingID(i *int) *PreemptionUpdateOne {
+	if i != nil {
+		puo.SetModelingID(*i)
+	}
+	return puo
+}
+
+// AddModelingID adds i to the "modeling_id" field.
+func (puo *PreemptionUpdateOne) AddModelingID(i int) *PreemptionUpdateOne {
+	puo.mutation.AddModelingID(i)
+	return puo
+}
+
+// SetPriority sets the "priority" field.
+func (puo *PreemptionUpdateOne) SetPriority(i int) *PreemptionUpdateOne {
+	puo.mutation.ResetPriority()
+	puo.mutation.SetPriority(i)
+	return puo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (puo *PreemptionUpdateOne) SetNillablePriority(i *int) *PreemptionUpdateOne {
+	if i != nil {
+		puo.SetPriority(*i)
+	}
+	return puo
+}
+
+// AddPriority adds i to the "priority" field.
+func (puo *PreemptionUpdateOne) AddPriority(i int) *PreemptionUpdateOne {
+	puo.mutation.AddPriority(i)
+	return puo
+}
+
+// SetUsername sets the "username" field.
+func (puo *PreemptionUpdateOne) SetUsername(s string) *PreemptionUpdateOne {
+	puo.mutation.SetUsername(s)
+	return puo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (puo *PreemptionUpdateOne) SetNillableUsername(s *string) *PreemptionUpdateOne {
+	if s != nil {
+		puo.SetUsername(*s)
+	}
+	return puo
+}
+
+// SetVictimID sets the "victim_id" field.
+func (puo *PreemptionUpdateOne) SetVictimID(i int) *PreemptionUpdateOne {
+	puo.mutation.ResetVictimID()
+	puo.mutation.SetVictimID(i)
+	return puo
+}
+
+// SetNillableVictimID sets the "victim_id" field if the given value is not nil.
+func (puo *PreemptionUpdateOne) SetNillableVictimID(i *int) *PreemptionUpdateOne {
+	if i != nil {
+		puo.SetVictimID(*i)
+	}
+	return puo
+}
+
+// AddVictimID adds i to the "victim_id" field.
+func (puo *PreemptionUpdateOne) AddVictimID(i int) *PreemptionUpdateOne {
+	puo.mutation.AddVictimID(i)
+	return puo
+}
+
+// SetVictimPriority sets the "victim_priority" field.
+func (puo *PreemptionUpdateOne) SetVictimPriority(i int) *PreemptionUpdateOne {
+	puo.mutation.ResetVictimPriority()
+	puo.mutation.SetVictimPriority(i)
+	return puo
+}
+
+// SetNillableVictimPriority sets the "victim_priority" field if the given value is not nil.
+func (puo *PreemptionUpdateOne) SetNillableVictimPriority(i *int) *PreemptionUpdateOne {
+	if i != nil {
+		puo.SetVictimPriority(*i)
+	}
+	return puo
+}
+
+// AddVictimPriority adds i to the "victim_priority" field.
+func (puo *PreemptionUpdateOne) AddVictimPriority(i int) *PreemptionUpdateOne {
+	puo.mutation.AddVictimPriority(i)
+	return puo
+}
+
+// SetVictimUsername sets the "victim_username" field.
+func (puo *PreemptionUpdateOne) SetVictimUsername(s string) *PreemptionUpdateOne {
+	puo.mutation.SetVictimUsername(s)
+	return puo
+}
+
+// SetNillableVictimUsername sets the "victim_username" field if the given value is not nil.
+func (puo *PreemptionUpdateOne) SetNillableVictimUsername(s *string) *PreemptionUpdateOne {
+	if s != nil {
+		puo.SetVictimUsername(*s)
+	}
+	return puo
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (puo *PreemptionUpdateOne) SetGpuIds(i []int) *PreemptionUpdateOne {
+	puo.mutation.SetGpuIds(i)
+	return puo
+}
+
+// AppendGpuIds appends i to the "gpu_ids" field.
+func (puo *PreemptionUpdateOne) AppendGpuIds(i []int) *PreemptionUpdateOne {
+	puo.mutation.AppendGpuIds(i)
+	return puo
+}
+
+// Mutation returns the PreemptionMutation object of the builder.
+func (puo *PreemptionUpdateOne) Mutation() *PreemptionMutation {
+	return puo.mutation
+}
+
+// Where appends a list predicates to the PreemptionUpdate builder.
+func (puo *PreemptionUpdateOne) Where(ps ...predicate.Preemption) *PreemptionUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PreemptionUpdateOne) Select(field string, fields ...string) *PreemptionUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Preemption entity.
+func (puo *PreemptionUpdateOne) Save(ctx context.Context) (*Preemption, error) {
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PreemptionUpdateOne) SaveX(ctx context.Context) *Preemption {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PreemptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PreemptionUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (puo *PreemptionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PreemptionUpdateOne {
+	puo.modifiers = append(puo.modifiers, modifiers...)
+	return puo
+}
+
+func (puo *PreemptionUpdateOne) sqlSave(ctx context.Context) (_node *Preemption, err error) {
+	_spec := sqlgraph.NewUpdateSpec(preemption.Table, preemption.Columns, sqlgraph.NewFieldSpec(preemption.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Preemption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, preemption.FieldID)
+		for _, f := range fields {
+			if !preemption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != preemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.ModelingID(); ok {
+		_spec.SetField(preemption.FieldModelingID, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedModelingID(); ok {
+		_spec.AddField(preemption.FieldModelingID, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.Priority(); ok {
+		_spec.SetField(preemption.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedPriority(); ok {
+		_spec.AddField(preemption.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.Username(); ok {
+		_spec.SetField(preemption.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.VictimID(); ok {
+		_spec.SetField(preemption.FieldVictimID, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedVictimID(); ok {
+		_spec.AddField(preemption.FieldVictimID, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.VictimPriority(); ok {
+		_spec.SetField(preemption.FieldVictimPriority, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedVictimPriority(); ok {
+		_spec.AddField(preemption.FieldVictimPriority, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.VictimUsername(); ok {
+		_spec.SetField(preemption.FieldVictimUsername, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.GpuIds(); ok {
+		_spec.SetField(preemption.FieldGpuIds, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedGpuIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, preemption.FieldGpuIds, value)
+		})
+	}
+	_spec.AddModifiers(puo.modifiers...)
+	_node = &Preemption{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{preemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
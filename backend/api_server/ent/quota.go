@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/quota"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// project, user group 별 GPU 사용 제한
+type Quota struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// project | user_group
+	TargetType string `json:"target_type,omitempty"`
+	// Project ID or user group level
+	TargetID int `json:"target_id,omitempty"`
+	// Max concurrent GPUs, 0 is unlimited
+	MaxGpus int `json:"max_gpus,omitempty"`
+	// Max queued modelings, 0 is unlimited
+	MaxQueued int `json:"max_queued,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Quota) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quota.FieldID, quota.FieldTargetID, quota.FieldMaxGpus, quota.FieldMaxQueued:
+			values[i] = new(sql.NullInt64)
+		case quota.FieldTargetType:
+			values[i] = new(sql.NullString)
+		case quota.FieldCreatedAt, quota.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Quota fields.
+func (q *Quota) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quota.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			q.ID = int(value.Int64)
+		case quota.FieldTargetType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_type", values[i])
+			} else if value.Valid {
+				q.TargetType = value.String
+			}
+		case quota.FieldTargetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				q.TargetID = int(value.Int64)
+			}
+		case quota.FieldMaxGpus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_gpus", values[i])
+			} else if value.Valid {
+				q.MaxGpus = int(value.Int64)
+			}
+		case quota.FieldMaxQueued:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_queued", values[i])
+			} else if value.Valid {
+				q.MaxQueued = int(value.Int64)
+			}
+		case quota.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				q.CreatedAt = value.Time
+			}
+		case quota.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				q.UpdatedAt = value.Time
+			}
+		default:
+			q.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Quota.
+// This includes values selected through modifiers, order, etc.
+func (q *Quota) Value(name string) (ent.Value, error) {
+	return q.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Quota.
+// Note that you need to call Quota.Unwrap() before calling this method if this Quota
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (q *Quota) Update() *QuotaUpdateOne {
+	return NewQuotaClient(q.config).UpdateOne(q)
+}
+
+// Unwrap unwraps the Quota entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (q *Quota) Unwrap() *Quota {
+	_tx, ok := q.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Quota is not a transactional entity")
+	}
+	q.config.driver = _tx.drv
+	return q
+}
+
+// String implements the fmt.Stringer.
+func (q *Quota) String() string {
+	var builder strings.Builder
+	builder.WriteString("Quota(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", q.ID))
+	builder.WriteString("target_type=")
+	builder.WriteString(q.TargetType)
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(fmt.Sprintf("%v", q.TargetID))
+	builder.WriteString(", ")
+	builder.WriteString("max_gpus=")
+	builder.WriteString(fmt.Sprintf("%v", q.MaxGpus))
+	builder.WriteString(", ")
+	builder.WriteString("max_queued=")
+	builder.WriteString(fmt.Sprintf("%v", q.MaxQueued))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(q.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(q.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuotaSlice is a parsable slice of Quota.
+type QuotaSlice []*Quota
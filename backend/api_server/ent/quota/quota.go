@@ -0,0 +1,100 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quota
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the quota type in the database.
+	Label = "quota"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTargetType holds the string denoting the target_type field in the database.
+	FieldTargetType = "target_type"
+	// FieldTargetID holds the string denoting the target_id field in the database.
+	FieldTargetID = "target_id"
+	// FieldMaxGpus holds the string denoting the max_gpus field in the database.
+	FieldMaxGpus = "max_gpus"
+	// FieldMaxQueued holds the string denoting the max_queued field in the database.
+	FieldMaxQueued = "max_queued"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the quota in the database.
+	Table = "quota"
+)
+
+// Columns holds all SQL columns for quota fields.
+var Columns = []string{
+	FieldID,
+	FieldTargetType,
+	FieldTargetID,
+	FieldMaxGpus,
+	FieldMaxQueued,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMaxGpus holds the default value on creation for the "max_gpus" field.
+	DefaultMaxGpus int
+	// DefaultMaxQueued holds the default value on creation for the "max_queued" field.
+	DefaultMaxQueued int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Quota queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTargetType orders the results by the target_type field.
+func ByTargetType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetType, opts...).ToFunc()
+}
+
+// ByTargetID orders the results by the target_id field.
+func ByTargetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetID, opts...).ToFunc()
+}
+
+// ByMaxGpus orders the results by the max_gpus field.
+func ByMaxGpus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxGpus, opts...).ToFunc()
+}
+
+// ByMaxQueued orders the results by the max_queued field.
+func ByMaxQueued(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxQueued, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quota
+
+import (
+	"api_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldID, id))
+}
+
+// TargetType applies equality check predicate on the "target_type" field. It's identical to TargetTypeEQ.
+func TargetType(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldTargetType, v))
+}
+
+// TargetID applies equality check predicate on the "target_id" field. It's identical to TargetIDEQ.
+func TargetID(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldTargetID, v))
+}
+
+// MaxGpus applies equality check predicate on the "max_gpus" field. It's identical to MaxGpusEQ.
+func MaxGpus(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldMaxGpus, v))
+}
+
+// MaxQueued applies equality check predicate on the "max_queued" field. It's identical to MaxQueuedEQ.
+func MaxQueued(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldMaxQueued, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TargetTypeEQ applies the EQ predicate on the "target_type" field.
+func TargetTypeEQ(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldTargetType, v))
+}
+
+// TargetTypeNEQ applies the NEQ predicate on the "target_type" field.
+func TargetTypeNEQ(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldTargetType, v))
+}
+
+// TargetTypeIn applies the In predicate on the "target_type" field.
+func TargetTypeIn(vs ...string) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldTargetType, vs...))
+}
+
+// TargetTypeNotIn applies the NotIn predicate on the "target_type" field.
+func TargetTypeNotIn(vs ...string) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldTargetType, vs...))
+}
+
+// TargetTypeGT applies the GT predicate on the "target_type" field.
+func TargetTypeGT(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldTargetType, v))
+}
+
+// TargetTypeGTE applies the GTE predicate on the "target_type" field.
+func TargetTypeGTE(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldTargetType, v))
+}
+
+// TargetTypeLT applies the LT predicate on the "target_type" field.
+func TargetTypeLT(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldTargetType, v))
+}
+
+// TargetTypeLTE applies the LTE predicate on the "target_type" field.
+func TargetTypeLTE(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldTargetType, v))
+}
+
+// TargetTypeContains applies the Contains predicate on the "target_type" field.
+func TargetTypeContains(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldContains(FieldTargetType, v))
+}
+
+// TargetTypeHasPrefix applies the HasPrefix predicate on the "target_type" field.
+func TargetTypeHasPrefix(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldHasPrefix(FieldTargetType, v))
+}
+
+// TargetTypeHasSuffix applies the HasSuffix predicate on the "target_type" field.
+func TargetTypeHasSuffix(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldHasSuffix(FieldTargetType, v))
+}
+
+// TargetTypeEqualFold applies the EqualFold predicate on the "target_type" field.
+func TargetTypeEqualFold(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldEqualFold(FieldTargetType, v))
+}
+
+// TargetTypeContainsFold applies the ContainsFold predicate on the "target_type" field.
+func TargetTypeContainsFold(v string) predicate.Quota {
+	return predicate.Quota(sql.FieldContainsFold(FieldTargetType, v))
+}
+
+// TargetIDEQ applies the EQ predicate on the "target_id" field.
+func TargetIDEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldTargetID, v))
+}
+
+// TargetIDNEQ applies the NEQ predicate on the "target_id" field.
+func TargetIDNEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldTargetID, v))
+}
+
+// TargetIDIn applies the In predicate on the "target_id" field.
+func TargetIDIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldTargetID, vs...))
+}
+
+// TargetIDNotIn applies the NotIn predicate on the "target_id" field.
+func TargetIDNotIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldTargetID, vs...))
+}
+
+// TargetIDGT applies the GT predicate on the "target_id" field.
+func TargetIDGT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldTargetID, v))
+}
+
+// TargetIDGTE applies the GTE predicate on the "target_id" field.
+func TargetIDGTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldTargetID, v))
+}
+
+// TargetIDLT applies the LT predicate on the "target_id" field.
+func TargetIDLT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldTargetID, v))
+}
+
+// TargetIDLTE applies the LTE predicate on the "target_id" field.
+func TargetIDLTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldTargetID, v))
+}
+
+// MaxGpusEQ applies the EQ predicate on the "max_gpus" field.
+func MaxGpusEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldMaxGpus, v))
+}
+
+// MaxGpusNEQ applies the NEQ predicate on the "max_gpus" field.
+func MaxGpusNEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldMaxGpus, v))
+}
+
+// MaxGpusIn applies the In predicate on the "max_gpus" field.
+func MaxGpusIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldMaxGpus, vs...))
+}
+
+// MaxGpusNotIn applies the NotIn predicate on the "max_gpus" field.
+func MaxGpusNotIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldMaxGpus, vs...))
+}
+
+// MaxGpusGT applies the GT predicate on the "max_gpus" field.
+func MaxGpusGT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldMaxGpus, v))
+}
+
+// MaxGpusGTE applies the GTE predicate on the "max_gpus" field.
+func MaxGpusGTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldMaxGpus, v))
+}
+
+// MaxGpusLT applies the LT predicate on the "max_gpus" field.
+func MaxGpusLT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldMaxGpus, v))
+}
+
+// MaxGpusLTE applies the LTE predicate on the "max_gpus" field.
+func MaxGpusLTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldMaxGpus, v))
+}
+
+// MaxQueuedEQ applies the EQ predicate on the "max_queued" field.
+func MaxQueuedEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldMaxQueued, v))
+}
+
+// MaxQueuedNEQ applies the NEQ predicate on the "max_queued" field.
+func MaxQueuedNEQ(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldMaxQueued, v))
+}
+
+// MaxQueuedIn applies the In predicate on the "max_queued" field.
+func MaxQueuedIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldMaxQueued, vs...))
+}
+
+// MaxQueuedNotIn applies the NotIn predicate on the "max_queued" field.
+func MaxQueuedNotIn(vs ...int) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldMaxQueued, vs...))
+}
+
+// MaxQueuedGT applies the GT predicate on the "max_queued" field.
+func MaxQueuedGT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldMaxQueued, v))
+}
+
+// MaxQueuedGTE applies the GTE predicate on the "max_queued" field.
+func MaxQueuedGTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldMaxQueued, v))
+}
+
+// MaxQueuedLT applies the LT predicate on the "max_queued" field.
+func MaxQueuedLT(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldMaxQueued, v))
+}
+
+// MaxQueuedLTE applies the LTE predicate on the "max_queued" field.
+func MaxQueuedLTE(v int) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldMaxQueued, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Quota {
+	return predicate.Quota(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Quota) predicate.Quota {
+	return predicate.Quota(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Quota) predicate.Quota {
+	return predicate.Quota(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Quota) predicate.Quota {
+	return predicate.Quota(sql.NotPredicates(p))
+}
@@ -0,0 +1,830 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/quota"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuotaCreate is the builder for creating a Quota entity.
+type QuotaCreate struct {
+	config
+	mutation *QuotaMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTargetType sets the "target_type" field.
+func (qc *QuotaCreate) SetTargetType(s string) *QuotaCreate {
+	qc.mutation.SetTargetType(s)
+	return qc
+}
+
+// SetTargetID sets the "target_id" field.
+func (qc *QuotaCreate) SetTargetID(i int) *QuotaCreate {
+	qc.mutation.SetTargetID(i)
+	return qc
+}
+
+// SetMaxGpus sets the "max_gpus" field.
+func (qc *QuotaCreate) SetMaxGpus(i int) *QuotaCreate {
+	qc.mutation.SetMaxGpus(i)
+	return qc
+}
+
+// SetNillableMaxGpus sets the "max_gpus" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableMaxGpus(i *int) *QuotaCreate {
+	if i != nil {
+		qc.SetMaxGpus(*i)
+	}
+	return qc
+}
+
+// SetMaxQueued sets the "max_queued" field.
+func (qc *QuotaCreate) SetMaxQueued(i int) *QuotaCreate {
+	qc.mutation.SetMaxQueued(i)
+	return qc
+}
+
+// SetNillableMaxQueued sets the "max_queued" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableMaxQueued(i *int) *QuotaCreate {
+	if i != nil {
+		qc.SetMaxQueued(*i)
+	}
+	return qc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (qc *QuotaCreate) SetCreatedAt(t time.Time) *QuotaCreate {
+	qc.mutation.SetCreatedAt(t)
+	return qc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableCreatedAt(t *time.Time) *QuotaCreate {
+	if t != nil {
+		qc.SetCreatedAt(*t)
+	}
+	return qc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qc *QuotaCreate) SetUpdatedAt(t time.Time) *QuotaCreate {
+	qc.mutation.SetUpdatedAt(t)
+	return qc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qc *QuotaCreate) SetNillableUpdatedAt(t *time.Time) *QuotaCreate {
+	if t != nil {
+		qc.SetUpdatedAt(*t)
+	}
+	return qc
+}
+
+// SetID sets the "id" field.
+func (qc *QuotaCreate) SetID(i int) *QuotaCreate {
+	qc.mutation.SetID(i)
+	return qc
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (qc *QuotaCreate) Mutation() *QuotaMutation {
+	return qc.mutation
+}
+
+// Save creates the Quota in the database.
+func (qc *QuotaCreate) Save(ctx context.Context) (*Quota, error) {
+	qc.defaults()
+	return withHooks(ctx, qc.sqlSave, qc.mutation, qc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (qc *QuotaCreate) SaveX(ctx context.Context) *Quota {
+	v, err := qc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qc *QuotaCreate) Exec(ctx context.Context) error {
+	_, err := qc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qc *QuotaCreate) ExecX(ctx context.Context) {
+	if err := qc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (qc *QuotaCreate) defaults() {
+	if _, ok := qc.mutation.MaxGpus(); !ok {
+		v := quota.DefaultMaxGpus
+		qc.mutation.SetMaxGpus(v)
+	}
+	if _, ok := qc.mutation.MaxQueued(); !ok {
+		v := quota.DefaultMaxQueued
+		qc.mutation.SetMaxQueued(v)
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		v := quota.DefaultCreatedAt()
+		qc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		v := quota.DefaultUpdatedAt()
+		qc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (qc *QuotaCreate) check() error {
+	if _, ok := qc.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "Quota.target_type"`)}
+	}
+	if _, ok := qc.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "Quota.target_id"`)}
+	}
+	if _, ok := qc.mutation.MaxGpus(); !ok {
+		return &ValidationError{Name: "max_gpus", err: errors.New(`ent: missing required field "Quota.max_gpus"`)}
+	}
+	if _, ok := qc.mutation.MaxQueued(); !ok {
+		return &ValidationError{Name: "max_queued", err: errors.New(`ent: missing required field "Quota.max_queued"`)}
+	}
+	if _, ok := qc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Quota.created_at"`)}
+	}
+	if _, ok := qc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Quota.updated_at"`)}
+	}
+	return nil
+}
+
+func (qc *QuotaCreate) sqlSave(ctx context.Context) (*Quota, error) {
+	if err := qc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := qc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, qc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	qc.mutation.id = &_node.ID
+	qc.mutation.done = true
+	return _node, nil
+}
+
+func (qc *QuotaCreate) createSpec() (*Quota, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Quota{config: qc.config}
+		_spec = sqlgraph.NewCreateSpec(quota.Table, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = qc.conflict
+	if id, ok := qc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := qc.mutation.TargetType(); ok {
+		_spec.SetField(quota.FieldTargetType, field.TypeString, value)
+		_node.TargetType = value
+	}
+	if value, ok := qc.mutation.TargetID(); ok {
+		_spec.SetField(quota.FieldTargetID, field.TypeInt, value)
+		_node.TargetID = value
+	}
+	if value, ok := qc.mutation.MaxGpus(); ok {
+		_spec.SetField(quota.FieldMaxGpus, field.TypeInt, value)
+		_node.MaxGpus = value
+	}
+	if value, ok := qc.mutation.MaxQueued(); ok {
+		_spec.SetField(quota.FieldMaxQueued, field.TypeInt, value)
+		_node.MaxQueued = value
+	}
+	if value, ok := qc.mutation.CreatedAt(); ok {
+		_spec.SetField(quota.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := qc.mutation.UpdatedAt(); ok {
+		_spec.SetField(quota.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Quota.Create().
+//		SetTargetType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuotaUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (qc *QuotaCreate) OnConflict(opts ...sql.ConflictOption) *QuotaUpsertOne {
+	qc.conflict = opts
+	return &QuotaUpsertOne{
+		create: qc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qc *QuotaCreate) OnConflictColumns(columns ...string) *QuotaUpsertOne {
+	qc.conflict = append(qc.conflict, sql.ConflictColumns(columns...))
+	return &QuotaUpsertOne{
+		create: qc,
+	}
+}
+
+type (
+	// QuotaUpsertOne is the builder for "upsert"-ing
+	//  one Quota node.
+	QuotaUpsertOne struct {
+		create *QuotaCreate
+	}
+
+	// QuotaUpsert is the "OnConflict" setter.
+	QuotaUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTargetType sets the "target_type" field.
+func (u *QuotaUpsert) SetTargetType(v string) *QuotaUpsert {
+	u.Set(quota.FieldTargetType, v)
+	return u
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateTargetType() *QuotaUpsert {
+	u.SetExcluded(quota.FieldTargetType)
+	return u
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *QuotaUpsert) SetTargetID(v int) *QuotaUpsert {
+	u.Set(quota.FieldTargetID, v)
+	return u
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateTargetID() *QuotaUpsert {
+	u.SetExcluded(quota.FieldTargetID)
+	return u
+}
+
+// AddTargetID adds v to the "target_id" field.
+func (u *QuotaUpsert) AddTargetID(v int) *QuotaUpsert {
+	u.Add(quota.FieldTargetID, v)
+	return u
+}
+
+// SetMaxGpus sets the "max_gpus" field.
+func (u *QuotaUpsert) SetMaxGpus(v int) *QuotaUpsert {
+	u.Set(quota.FieldMaxGpus, v)
+	return u
+}
+
+// UpdateMaxGpus sets the "max_gpus" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateMaxGpus() *QuotaUpsert {
+	u.SetExcluded(quota.FieldMaxGpus)
+	return u
+}
+
+// AddMaxGpus adds v to the "max_gpus" field.
+func (u *QuotaUpsert) AddMaxGpus(v int) *QuotaUpsert {
+	u.Add(quota.FieldMaxGpus, v)
+	return u
+}
+
+// SetMaxQueued sets the "max_queued" field.
+func (u *QuotaUpsert) SetMaxQueued(v int) *QuotaUpsert {
+	u.Set(quota.FieldMaxQueued, v)
+	return u
+}
+
+// UpdateMaxQueued sets the "max_queued" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateMaxQueued() *QuotaUpsert {
+	u.SetExcluded(quota.FieldMaxQueued)
+	return u
+}
+
+// AddMaxQueued adds v to the "max_queued" field.
+func (u *QuotaUpsert) AddMaxQueued(v int) *QuotaUpsert {
+	u.Add(quota.FieldMaxQueued, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuotaUpsert) SetUpdatedAt(v time.Time) *QuotaUpsert {
+	u.Set(quota.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuotaUpsert) UpdateUpdatedAt() *QuotaUpsert {
+	u.SetExcluded(quota.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(quota.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuotaUpsertOne) UpdateNewValues() *QuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(quota.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(quota.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuotaUpsertOne) Ignore() *QuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuotaUpsertOne) DoNothing() *QuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuotaCreate.OnConflict
+// documentation for more info.
+func (u *QuotaUpsertOne) Update(set func(*QuotaUpsert)) *QuotaUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuotaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *QuotaUpsertOne) SetTargetType(v string) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateTargetType() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *QuotaUpsertOne) SetTargetID(v int) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// AddTargetID adds v to the "target_id" field.
+func (u *QuotaUpsertOne) AddTargetID(v int) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateTargetID() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetMaxGpus sets the "max_gpus" field.
+func (u *QuotaUpsertOne) SetMaxGpus(v int) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetMaxGpus(v)
+	})
+}
+
+// AddMaxGpus adds v to the "max_gpus" field.
+func (u *QuotaUpsertOne) AddMaxGpus(v int) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddMaxGpus(v)
+	})
+}
+
+// UpdateMaxGpus sets the "max_gpus" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateMaxGpus() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateMaxGpus()
+	})
+}
+
+// SetMaxQueued sets the "max_queued" field.
+func (u *QuotaUpsertOne) SetMaxQueued(v int) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetMaxQueued(v)
+	})
+}
+
+// AddMaxQueued adds v to the "max_queued" field.
+func (u *QuotaUpsertOne) AddMaxQueued(v int) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddMaxQueued(v)
+	})
+}
+
+// UpdateMaxQueued sets the "max_queued" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateMaxQueued() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateMaxQueued()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuotaUpsertOne) SetUpdatedAt(v time.Time) *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuotaUpsertOne) UpdateUpdatedAt() *QuotaUpsertOne {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuotaUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuotaCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuotaUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuotaUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuotaUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// QuotaCreateBulk is the builder for creating many Quota entities in bulk.
+type QuotaCreateBulk struct {
+	config
+	err      error
+	builders []*QuotaCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Quota entities in the database.
+func (qcb *QuotaCreateBulk) Save(ctx context.Context) ([]*Quota, error) {
+	if qcb.err != nil {
+		return nil, qcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(qcb.builders))
+	nodes := make([]*Quota, len(qcb.builders))
+	mutators := make([]Mutator, len(qcb.builders))
+	for i := range qcb.builders {
+		func(i int, root context.Context) {
+			builder := qcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuotaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, qcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = qcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, qcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, qcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qcb *QuotaCreateBulk) SaveX(ctx context.Context) []*Quota {
+	v, err := qcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (qcb *QuotaCreateBulk) Exec(ctx context.Context) error {
+	_, err := qcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qcb *QuotaCreateBulk) ExecX(ctx context.Context) {
+	if err := qcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Quota.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuotaUpsert) {
+//			SetTargetType(v+v).
+//		}).
+//		Exec(ctx)
+func (qcb *QuotaCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuotaUpsertBulk {
+	qcb.conflict = opts
+	return &QuotaUpsertBulk{
+		create: qcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (qcb *QuotaCreateBulk) OnConflictColumns(columns ...string) *QuotaUpsertBulk {
+	qcb.conflict = append(qcb.conflict, sql.ConflictColumns(columns...))
+	return &QuotaUpsertBulk{
+		create: qcb,
+	}
+}
+
+// QuotaUpsertBulk is the builder for "upsert"-ing
+// a bulk of Quota nodes.
+type QuotaUpsertBulk struct {
+	create *QuotaCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(quota.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuotaUpsertBulk) UpdateNewValues() *QuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(quota.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(quota.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Quota.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuotaUpsertBulk) Ignore() *QuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuotaUpsertBulk) DoNothing() *QuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuotaCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuotaUpsertBulk) Update(set func(*QuotaUpsert)) *QuotaUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuotaUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTargetType sets the "target_type" field.
+func (u *QuotaUpsertBulk) SetTargetType(v string) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetTargetType(v)
+	})
+}
+
+// UpdateTargetType sets the "target_type" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateTargetType() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateTargetType()
+	})
+}
+
+// SetTargetID sets the "target_id" field.
+func (u *QuotaUpsertBulk) SetTargetID(v int) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetTargetID(v)
+	})
+}
+
+// AddTargetID adds v to the "target_id" field.
+func (u *QuotaUpsertBulk) AddTargetID(v int) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddTargetID(v)
+	})
+}
+
+// UpdateTargetID sets the "target_id" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateTargetID() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateTargetID()
+	})
+}
+
+// SetMaxGpus sets the "max_gpus" field.
+func (u *QuotaUpsertBulk) SetMaxGpus(v int) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetMaxGpus(v)
+	})
+}
+
+// AddMaxGpus adds v to the "max_gpus" field.
+func (u *QuotaUpsertBulk) AddMaxGpus(v int) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddMaxGpus(v)
+	})
+}
+
+// UpdateMaxGpus sets the "max_gpus" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateMaxGpus() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateMaxGpus()
+	})
+}
+
+// SetMaxQueued sets the "max_queued" field.
+func (u *QuotaUpsertBulk) SetMaxQueued(v int) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetMaxQueued(v)
+	})
+}
+
+// AddMaxQueued adds v to the "max_queued" field.
+func (u *QuotaUpsertBulk) AddMaxQueued(v int) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.AddMaxQueued(v)
+	})
+}
+
+// UpdateMaxQueued sets the "max_queued" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateMaxQueued() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateMaxQueued()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuotaUpsertBulk) SetUpdatedAt(v time.Time) *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuotaUpsertBulk) UpdateUpdatedAt() *QuotaUpsertBulk {
+	return u.Update(func(s *QuotaUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuotaUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QuotaCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuotaCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuotaUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/predicate"
+	"api_server/ent/quota"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuotaQuery is the builder for querying Quota entities.
+type QuotaQuery struct {
+	config
+	ctx        *QueryContext
+	order      []quota.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Quota
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QuotaQuery builder.
+func (qq *QuotaQuery) Where(ps ...predicate.Quota) *QuotaQuery {
+	qq.predicates = append(qq.predicates, ps...)
+	return qq
+}
+
+// Limit the number of records to be returned by this query.
+func (qq *QuotaQuery) Limit(limit int) *QuotaQuery {
+	qq.ctx.Limit = &limit
+	return qq
+}
+
+// Offset to start from.
+func (qq *QuotaQuery) Offset(offset int) *QuotaQuery {
+	qq.ctx.Offset = &offset
+	return qq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (qq *QuotaQuery) Unique(unique bool) *QuotaQuery {
+	qq.ctx.Unique = &unique
+	return qq
+}
+
+// Order specifies how the records should be ordered.
+func (qq *QuotaQuery) Order(o ...quota.OrderOption) *QuotaQuery {
+	qq.order = append(qq.order, o...)
+	return qq
+}
+
+// First returns the first Quota entity from the query.
+// Returns a *NotFoundError when no Quota was found.
+func (qq *QuotaQuery) First(ctx context.Context) (*Quota, error) {
+	nodes, err := qq.Limit(1).All(setContextOp(ctx, qq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{quota.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (qq *QuotaQuery) FirstX(ctx context.Context) *Quota {
+	node, err := qq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Quota ID from the query.
+// Returns a *NotFoundError when no Quota ID was found.
+func (qq *QuotaQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qq.Limit(1).IDs(setContextOp(ctx, qq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{quota.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (qq *QuotaQuery) FirstIDX(ctx context.Context) int {
+	id, err := qq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Quota entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Quota entity is found.
+// Returns a *NotFoundError when no Quota entities are found.
+func (qq *QuotaQuery) Only(ctx context.Context) (*Quota, error) {
+	nodes, err := qq.Limit(2).All(setContextOp(ctx, qq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{quota.Label}
+	default:
+		return nil, &NotSingularError{quota.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (qq *QuotaQuery) OnlyX(ctx context.Context) *Quota {
+	node, err := qq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Quota ID in the query.
+// Returns a *NotSingularError when more than one Quota ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (qq *QuotaQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = qq.Limit(2).IDs(setContextOp(ctx, qq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{quota.Label}
+	default:
+		err = &NotSingularError{quota.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (qq *QuotaQuery) OnlyIDX(ctx context.Context) int {
+	id, err := qq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QuotaSlice.
+func (qq *QuotaQuery) All(ctx context.Context) ([]*Quota, error) {
+	ctx = setContextOp(ctx, qq.ctx, ent.OpQueryAll)
+	if err := qq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Quota, *QuotaQuery]()
+	return withInterceptors[[]*Quota](ctx, qq, qr, qq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (qq *QuotaQuery) AllX(ctx context.Context) []*Quota {
+	nodes, err := qq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Quota IDs.
+func (qq *QuotaQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if qq.ctx.Unique == nil && qq.path != nil {
+		qq.Unique(true)
+	}
+	ctx = setContextOp(ctx, qq.ctx, ent.OpQueryIDs)
+	if err = qq.Select(quota.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (qq *QuotaQuery) IDsX(ctx context.Context) []int {
+	ids, err := qq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (qq *QuotaQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, qq.ctx, ent.OpQueryCount)
+	if err := qq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, qq, querierCount[*QuotaQuery](), qq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (qq *QuotaQuery) CountX(ctx context.Context) int {
+	count, err := qq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (qq *QuotaQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, qq.ctx, ent.OpQueryExist)
+	switch _, err := qq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (qq *QuotaQuery) ExistX(ctx context.Context) bool {
+	exist, err := qq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QuotaQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (qq *QuotaQuery) Clone() *QuotaQuery {
+	if qq == nil {
+		return nil
+	}
+	return &QuotaQuery{
+		config:     qq.config,
+		ctx:        qq.ctx.Clone(),
+		order:      append([]quota.OrderOption{}, qq.order...),
+		inters:     append([]Interceptor{}, qq.inters...),
+		predicates: append([]predicate.Quota{}, qq.predicates...),
+		// clone intermediate query.
+		sql:       qq.sql.Clone(),
+		path:      qq.path,
+		modifiers: append([]func(*sql.Selector){}, qq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TargetType string `json:"target_type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Quota.Query().
+//		GroupBy(quota.FieldTargetType).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (qq *QuotaQuery) GroupBy(field string, fields ...string) *QuotaGroupBy {
+	qq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QuotaGroupBy{build: qq}
+	grbuild.flds = &qq.ctx.Fields
+	grbuild.label = quota.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TargetType string `json:"target_type,omitempty"`
+//	}
+//
+//	client.Quota.Query().
+//		Select(quota.FieldTargetType).
+//		Scan(ctx, &v)
+func (qq *QuotaQuery) Select(fields ...string) *QuotaSelect {
+	qq.ctx.Fields = append(qq.ctx.Fields, fields...)
+	sbuild := &QuotaSelect{QuotaQuery: qq}
+	sbuild.label = quota.Label
+	sbuild.flds, sbuild.scan = &qq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QuotaSelect configured with the given aggregations.
+func (qq *QuotaQuery) Aggregate(fns ...AggregateFunc) *QuotaSelect {
+	return qq.Select().Aggregate(fns...)
+}
+
+func (qq *QuotaQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range qq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, qq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range qq.ctx.Fields {
+		if !quota.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if qq.path != nil {
+		prev, err := qq.path(ctx)
+		if err != nil {
+			return err
+		}
+		qq.sql = prev
+	}
+	return nil
+}
+
+func (qq *QuotaQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Quota, error) {
+	var (
+		nodes = []*Quota{}
+		_spec = qq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Quota).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Quota{config: qq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(qq.modifiers) > 0 {
+		_spec.Modifiers = qq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, qq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (qq *QuotaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := qq.querySpec()
+	if len(qq.modifiers) > 0 {
+		_spec.Modifiers = qq.modifiers
+	}
+	_spec.Node.Columns = qq.ctx.Fields
+	if len(qq.ctx.Fields) > 0 {
+		_spec.Unique = qq.ctx.Unique != nil && *qq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, qq.driver, _spec)
+}
+
+func (qq *QuotaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(quota.Table, quota.Columns, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeInt))
+	_spec.From = qq.sql
+	if unique := qq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if qq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := qq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quota.FieldID)
+		for i := range fields {
+			if fields[i] != quota.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := qq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := qq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := qq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := qq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (qq *QuotaQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(qq.driver.Dialect())
+	t1 := builder.Table(quota.Table)
+	columns := qq.ctx.Fields
+	if len(columns) == 0 {
+		columns = quota.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if qq.sql != nil {
+		selector = qq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if qq.ctx.Unique != nil && *qq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range qq.modifiers {
+		m(selector)
+	}
+	for _, p := range qq.predicates {
+		p(selector)
+	}
+	for _, p := range qq.order {
+		p(selector)
+	}
+	if offset := qq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := qq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (qq *QuotaQuery) Modify(modifiers ...func(s *sql.Selector)) *QuotaSelect {
+	qq.modifiers = append(qq.modifiers, modifiers...)
+	return qq.Select()
+}
+
+// QuotaGroupBy is the group-by builder for Quota entities.
+type QuotaGroupBy struct {
+	selector
+	build *QuotaQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (qgb *QuotaGroupBy) Aggregate(fns ...AggregateFunc) *QuotaGroupBy {
+	qgb.fns = append(qgb.fns, fns...)
+	return qgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qgb *QuotaGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qgb.build.ctx, ent.OpQueryGroupBy)
+	if err := qgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuotaQuery, *QuotaGroupBy](ctx, qgb.build, qgb, qgb.build.inters, v)
+}
+
+func (qgb *QuotaGroupBy) sqlScan(ctx context.Context, root *QuotaQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(qgb.fns))
+	for _, fn := range qgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*qgb.flds)+len(qgb.fns))
+		for _, f := range *qgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*qgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QuotaSelect is the builder for selecting fields of Quota entities.
+type QuotaSelect struct {
+	*QuotaQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (qs *QuotaSelect) Aggregate(fns ...AggregateFunc) *QuotaSelect {
+	qs.fns = append(qs.fns, fns...)
+	return qs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (qs *QuotaSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, qs.ctx, ent.OpQuerySelect)
+	if err := qs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuotaQuery, *QuotaSelect](ctx, qs.QuotaQuery, qs, qs.inters, v)
+}
+
+func (qs *QuotaSelect) sqlScan(ctx context.Context, root *QuotaQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(qs.fns))
+	for _, fn := range qs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*qs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := qs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (qs *QuotaSelect) Modify(modifiers ...func(s *sql.Selector)) *QuotaSelect {
+	qs.modifiers = append(qs.modifiers, modifiers...)
+	return qs
+}
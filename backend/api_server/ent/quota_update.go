@@ -0,0 +1,422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/predicate"
+	"api_server/ent/quota"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuotaUpdate is the builder for updating Quota entities.
+type QuotaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *QuotaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the QuotaUpdate builder.
+func (qu *QuotaUpdate) Where(ps ...predicate.Quota) *QuotaUpdate {
+	qu.mutation.Where(ps...)
+	return qu
+}
+
+// SetTargetType sets the "target_type" field.
+func (qu *QuotaUpdate) SetTargetType(s string) *QuotaUpdate {
+	qu.mutation.SetTargetType(s)
+	return qu
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableTargetType(s *string) *QuotaUpdate {
+	if s != nil {
+		qu.SetTargetType(*s)
+	}
+	return qu
+}
+
+// SetTargetID sets the "target_id" field.
+func (qu *QuotaUpdate) SetTargetID(i int) *QuotaUpdate {
+	qu.mutation.ResetTargetID()
+	qu.mutation.SetTargetID(i)
+	return qu
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableTargetID(i *int) *QuotaUpdate {
+	if i != nil {
+		qu.SetTargetID(*i)
+	}
+	return qu
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (qu *QuotaUpdate) AddTargetID(i int) *QuotaUpdate {
+	qu.mutation.AddTargetID(i)
+	return qu
+}
+
+// SetMaxGpus sets the "max_gpus" field.
+func (qu *QuotaUpdate) SetMaxGpus(i int) *QuotaUpdate {
+	qu.mutation.ResetMaxGpus()
+	qu.mutation.SetMaxGpus(i)
+	return qu
+}
+
+// SetNillableMaxGpus sets the "max_gpus" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableMaxGpus(i *int) *QuotaUpdate {
+	if i != nil {
+		qu.SetMaxGpus(*i)
+	}
+	return qu
+}
+
+// AddMaxGpus adds i to the "max_gpus" field.
+func (qu *QuotaUpdate) AddMaxGpus(i int) *QuotaUpdate {
+	qu.mutation.AddMaxGpus(i)
+	return qu
+}
+
+// SetMaxQueued sets the "max_queued" field.
+func (qu *QuotaUpdate) SetMaxQueued(i int) *QuotaUpdate {
+	qu.mutation.ResetMaxQueued()
+	qu.mutation.SetMaxQueued(i)
+	return qu
+}
+
+// SetNillableMaxQueued sets the "max_queued" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableMaxQueued(i *int) *QuotaUpdate {
+	if i != nil {
+		qu.SetMaxQueued(*i)
+	}
+	return qu
+}
+
+// AddMaxQueued adds i to the "max_queued" field.
+func (qu *QuotaUpdate) AddMaxQueued(i int) *QuotaUpdate {
+	qu.mutation.AddMaxQueued(i)
+	return qu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (qu *QuotaUpdate) SetUpdatedAt(t time.Time) *QuotaUpdate {
+	qu.mutation.SetUpdatedAt(t)
+	return qu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (qu *QuotaUpdate) SetNillableUpdatedAt(t *time.Time) *QuotaUpdate {
+	if t != nil {
+		qu.SetUpdatedAt(*t)
+	}
+	return qu
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (qu *QuotaUpdate) Mutation() *QuotaMutation {
+	return qu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (qu *QuotaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, qu.sqlSave, qu.mutation, qu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (qu *QuotaUpdate) SaveX(ctx context.Context) int {
+	affected, err := qu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (qu *QuotaUpdate) Exec(ctx context.Context) error {
+	_, err := qu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (qu *QuotaUpdate) ExecX(ctx context.Context) {
+	if err := qu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (qu *QuotaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuotaUpdate {
+	qu.modifiers = append(qu.modifiers, modifiers...)
+	return qu
+}
+
+func (qu *QuotaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(quota.Table, quota.Columns, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeInt))
+	if ps := qu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := qu.mutation.TargetType(); ok {
+		_spec.SetField(quota.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.TargetID(); ok {
+		_spec.SetField(quota.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedTargetID(); ok {
+		_spec.AddField(quota.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.MaxGpus(); ok {
+		_spec.SetField(quota.FieldMaxGpus, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedMaxGpus(); ok {
+		_spec.AddField(quota.FieldMaxGpus, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.MaxQueued(); ok {
+		_spec.SetField(quota.FieldMaxQueued, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedMaxQueued(); ok {
+		_spec.AddField(quota.FieldMaxQueued, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.UpdatedAt(); ok {
+		_spec.SetField(quota.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(qu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, qu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	qu.mutation.done = true
+	return n, nil
+}
+
+// QuotaUpdateOne is the builder for updating a single Quota entity.
+type QuotaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *QuotaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTargetType sets the "target_type" field.
+func (quo *QuotaUpdateOne) SetTargetType(s string) *QuotaUpdateOne {
+	quo.mutation.SetTargetType(s)
+	return quo
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableTargetType(s *string) *QuotaUpdateOne {
+	if s != nil {
+		quo.SetTargetType(*s)
+	}
+	return quo
+}
+
+// SetTargetID sets the "target_id" field.
+func (quo *QuotaUpdateOne) SetTargetID(i int) *QuotaUpdateOne {
+	quo.mutation.ResetTargetID()
+	quo.mutation.SetTargetID(i)
+	return quo
+}
+
+// SetNillableTargetID sets the "target_id" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableTargetID(i *int) *QuotaUpdateOne {
+	if i != nil {
+		quo.SetTargetID(*i)
+	}
+	return quo
+}
+
+// AddTargetID adds i to the "target_id" field.
+func (quo *QuotaUpdateOne) AddTargetID(i int) *QuotaUpdateOne {
+	quo.mutation.AddTargetID(i)
+	return quo
+}
+
+// SetMaxGpus sets the "max_gpus" field.
+func (quo *QuotaUpdateOne) SetMaxGpus(i int) *QuotaUpdateOne {
+	quo.mutation.ResetMaxGpus()
+	quo.mutation.SetMaxGpus(i)
+	return quo
+}
+
+// SetNillableMaxGpus sets the "max_gpus" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableMaxGpus(i *int) *QuotaUpdateOne {
+	if i != nil {
+		quo.SetMaxGpus(*i)
+	}
+	return quo
+}
+
+// AddMaxGpus adds i to the "max_gpus" field.
+func (quo *QuotaUpdateOne) AddMaxGpus(i int) *QuotaUpdateOne {
+	quo.mutation.AddMaxGpus(i)
+	return quo
+}
+
+// SetMaxQueued sets the "max_queued" field.
+func (quo *QuotaUpdateOne) SetMaxQueued(i int) *QuotaUpdateOne {
+	quo.mutation.ResetMaxQueued()
+	quo.mutation.SetMaxQueued(i)
+	return quo
+}
+
+// SetNillableMaxQueued sets the "max_queued" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableMaxQueued(i *int) *QuotaUpdateOne {
+	if i != nil {
+		quo.SetMaxQueued(*i)
+	}
+	return quo
+}
+
+// AddMaxQueued adds i to the "max_queued" field.
+func (quo *QuotaUpdateOne) AddMaxQueued(i int) *QuotaUpdateOne {
+	quo.mutation.AddMaxQueued(i)
+	return quo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (quo *QuotaUpdateOne) SetUpdatedAt(t time.Time) *QuotaUpdateOne {
+	quo.mutation.SetUpdatedAt(t)
+	return quo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (quo *QuotaUpdateOne) SetNillableUpdatedAt(t *time.Time) *QuotaUpdateOne {
+	if t != nil {
+		quo.SetUpdatedAt(*t)
+	}
+	return quo
+}
+
+// Mutation returns the QuotaMutation object of the builder.
+func (quo *QuotaUpdateOne) Mutation() *QuotaMutation {
+	return quo.mutation
+}
+
+// Where appends a list predicates to the QuotaUpdate builder.
+func (quo *QuotaUpdateOne) Where(ps ...predicate.Quota) *QuotaUpdateOne {
+	quo.mutation.Where(ps...)
+	return quo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (quo *QuotaUpdateOne) Select(field string, fields ...string) *QuotaUpdateOne {
+	quo.fields = append([]string{field}, fields...)
+	return quo
+}
+
+// Save executes the query and returns the updated Quota entity.
+func (quo *QuotaUpdateOne) Save(ctx context.Context) (*Quota, error) {
+	return withHooks(ctx, quo.sqlSave, quo.mutation, quo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (quo *QuotaUpdateOne) SaveX(ctx context.Context) *Quota {
+	node, err := quo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (quo *QuotaUpdateOne) Exec(ctx context.Context) error {
+	_, err := quo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (quo *QuotaUpdateOne) ExecX(ctx context.Context) {
+	if err := quo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (quo *QuotaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuotaUpdateOne {
+	quo.modifiers = append(quo.modifiers, modifiers...)
+	return quo
+}
+
+func (quo *QuotaUpdateOne) sqlSave(ctx context.Context) (_node *Quota, err error) {
+	_spec := sqlgraph.NewUpdateSpec(quota.Table, quota.Columns, sqlgraph.NewFieldSpec(quota.FieldID, field.TypeInt))
+	id, ok := quo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Quota.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := quo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quota.FieldID)
+		for _, f := range fields {
+			if !quota.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quota.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := quo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := quo.mutation.TargetType(); ok {
+		_spec.SetField(quota.FieldTargetType, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.TargetID(); ok {
+		_spec.SetField(quota.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedTargetID(); ok {
+		_spec.AddField(quota.FieldTargetID, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.MaxGpus(); ok {
+		_spec.SetField(quota.FieldMaxGpus, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedMaxGpus(); ok {
+		_spec.AddField(quota.FieldMaxGpus, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.MaxQueued(); ok {
+		_spec.SetField(quota.FieldMaxQueued, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedMaxQueued(); ok {
+		_spec.AddField(quota.FieldMaxQueued, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.UpdatedAt(); ok {
+		_spec.SetField(quota.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(quo.modifiers...)
+	_node = &Quota{config: quo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, quo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	quo.mutation.done = true
+	return _node, nil
+}
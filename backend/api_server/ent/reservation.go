@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/reservation"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// project 또는 user가 starts_at부터 ends_at까지 사용할 device, GPU 예약
+type Reservation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Reserved device
+	DeviceID int `json:"device_id,omitempty"`
+	// Reserved GPUs of the device, empty reserves every GPU
+	GpuIds []int `json:"gpu_ids,omitempty"`
+	// Project that owns the reservation, 0 if reserved for a user
+	ProjectID int `json:"project_id,omitempty"`
+	// User that owns the reservation, empty if reserved for a project
+	Username string `json:"username,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reservation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reservation.FieldGpuIds:
+			values[i] = new([]byte)
+		case reservation.FieldID, reservation.FieldDeviceID, reservation.FieldProjectID:
+			values[i] = new(sql.NullInt64)
+		case reservation.FieldTitle, reservation.FieldUsername, reservation.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case reservation.FieldStartsAt, reservation.FieldEndsAt, reservation.FieldCreatedAt, reservation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reservation fields.
+func (r *Reservation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reservation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case reservation.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				r.Title = value.String
+			}
+		case reservation.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				r.DeviceID = int(value.Int64)
+			}
+		case reservation.FieldGpuIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field gpu_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.GpuIds); err != nil {
+					return fmt.Errorf("unmarshal field gpu_ids: %w", err)
+				}
+			}
+		case reservation.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				r.ProjectID = int(value.Int64)
+			}
+		case reservation.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				r.Username = value.String
+			}
+		case reservation.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				r.StartsAt = value.Time
+			}
+		case reservation.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				r.EndsAt = value.Time
+			}
+		case reservation.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				r.CreatedBy = value.String
+			}
+		case reservation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case reservation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				r.UpdatedAt = value.Time
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Reservation.
+// This includes values selected through modifiers, order, etc.
+func (r *Reservation) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Reservation.
+// Note that you need to call Reservation.Unwrap() before calling this method if this Reservation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Reservation) Update() *ReservationUpdateOne {
+	return NewReservationClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Reservation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Reservation) Unwrap() *Reservation {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reservation is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Reservation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reservation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("title=")
+	builder.WriteString(r.Title)
+	builder.WriteString(", ")
+	builder.WriteString("device_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.DeviceID))
+	builder.WriteString(", ")
+	builder.WriteString("gpu_ids=")
+	builder.WriteString(fmt.Sprintf("%v", r.GpuIds))
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(r.Username)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(r.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(r.EndsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(r.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(r.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reservations is a parsable slice of Reservation.
+type Reservations []*Reservation
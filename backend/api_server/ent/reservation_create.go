@@ -0,0 +1,1063 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/reservation"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReservationCreate is the builder for creating a Reservation entity.
+type ReservationCreate struct {
+	config
+	mutation *ReservationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTitle sets the "title" field.
+func (rc *ReservationCreate) SetTitle(s string) *ReservationCreate {
+	rc.mutation.SetTitle(s)
+	return rc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableTitle(s *string) *ReservationCreate {
+	if s != nil {
+		rc.SetTitle(*s)
+	}
+	return rc
+}
+
+// SetDeviceID sets the "device_id" field.
+func (rc *ReservationCreate) SetDeviceID(i int) *ReservationCreate {
+	rc.mutation.SetDeviceID(i)
+	return rc
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (rc *ReservationCreate) SetGpuIds(i []int) *ReservationCreate {
+	rc.mutation.SetGpuIds(i)
+	return rc
+}
+
+// SetProjectID sets the "project_id" field.
+func (rc *ReservationCreate) SetProjectID(i int) *ReservationCreate {
+	rc.mutation.SetProjectID(i)
+	return rc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableProjectID(i *int) *ReservationCreate {
+	if i != nil {
+		rc.SetProjectID(*i)
+	}
+	return rc
+}
+
+// SetUsername sets the "username" field.
+func (rc *ReservationCreate) SetUsername(s string) *ReservationCreate {
+	rc.mutation.SetUsername(s)
+	return rc
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableUsername(s *string) *ReservationCreate {
+	if s != nil {
+		rc.SetUsername(*s)
+	}
+	return rc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (rc *ReservationCreate) SetStartsAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetStartsAt(t)
+	return rc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (rc *ReservationCreate) SetEndsAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetEndsAt(t)
+	return rc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rc *ReservationCreate) SetCreatedBy(s string) *ReservationCreate {
+	rc.mutation.SetCreatedBy(s)
+	return rc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableCreatedBy(s *string) *ReservationCreate {
+	if s != nil {
+		rc.SetCreatedBy(*s)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReservationCreate) SetCreatedAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableCreatedAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *ReservationCreate) SetUpdatedAt(t time.Time) *ReservationCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *ReservationCreate) SetNillableUpdatedAt(t *time.Time) *ReservationCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReservationCreate) SetID(i int) *ReservationCreate {
+	rc.mutation.SetID(i)
+	return rc
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (rc *ReservationCreate) Mutation() *ReservationMutation {
+	return rc.mutation
+}
+
+// Save creates the Reservation in the database.
+func (rc *ReservationCreate) Save(ctx context.Context) (*Reservation, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReservationCreate) SaveX(ctx context.Context) *Reservation {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReservationCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReservationCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReservationCreate) defaults() {
+	if _, ok := rc.mutation.Title(); !ok {
+		v := reservation.DefaultTitle
+		rc.mutation.SetTitle(v)
+	}
+	if _, ok := rc.mutation.ProjectID(); !ok {
+		v := reservation.DefaultProjectID
+		rc.mutation.SetProjectID(v)
+	}
+	if _, ok := rc.mutation.Username(); !ok {
+		v := reservation.DefaultUsername
+		rc.mutation.SetUsername(v)
+	}
+	if _, ok := rc.mutation.CreatedBy(); !ok {
+		v := reservation.DefaultCreatedBy
+		rc.mutation.SetCreatedBy(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := reservation.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := reservation.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReservationCreate) check() error {
+	if _, ok := rc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Reservation.title"`)}
+	}
+	if _, ok := rc.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "Reservation.device_id"`)}
+	}
+	if _, ok := rc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "Reservation.project_id"`)}
+	}
+	if _, ok := rc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "Reservation.username"`)}
+	}
+	if _, ok := rc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "Reservation.starts_at"`)}
+	}
+	if _, ok := rc.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`ent: missing required field "Reservation.ends_at"`)}
+	}
+	if _, ok := rc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Reservation.created_by"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Reservation.created_at"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Reservation.updated_at"`)}
+	}
+	return nil
+}
+
+func (rc *ReservationCreate) sqlSave(ctx context.Context) (*Reservation, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReservationCreate) createSpec() (*Reservation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reservation{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(reservation.Table, sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = rc.conflict
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.Title(); ok {
+		_spec.SetField(reservation.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := rc.mutation.DeviceID(); ok {
+		_spec.SetField(reservation.FieldDeviceID, field.TypeInt, value)
+		_node.DeviceID = value
+	}
+	if value, ok := rc.mutation.GpuIds(); ok {
+		_spec.SetField(reservation.FieldGpuIds, field.TypeJSON, value)
+		_node.GpuIds = value
+	}
+	if value, ok := rc.mutation.ProjectID(); ok {
+		_spec.SetField(reservation.FieldProjectID, field.TypeInt, value)
+		_node.ProjectID = value
+	}
+	if value, ok := rc.mutation.Username(); ok {
+		_spec.SetField(reservation.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := rc.mutation.StartsAt(); ok {
+		_spec.SetField(reservation.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := rc.mutation.EndsAt(); ok {
+		_spec.SetField(reservation.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if value, ok := rc.mutation.CreatedBy(); ok {
+		_spec.SetField(reservation.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(reservation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.SetField(reservation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Reservation.Create().
+//		SetTitle(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReservationUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (rc *ReservationCreate) OnConflict(opts ...sql.ConflictOption) *ReservationUpsertOne {
+	rc.conflict = opts
+	return &ReservationUpsertOne{
+		create: rc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Reservation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rc *ReservationCreate) OnConflictColumns(columns ...string) *ReservationUpsertOne {
+	rc.conflict = append(rc.conflict, sql.ConflictColumns(columns...))
+	return &ReservationUpsertOne{
+		create: rc,
+	}
+}
+
+type (
+	// ReservationUpsertOne is the builder for "upsert"-ing
+	//  one Reservation node.
+	ReservationUpsertOne struct {
+		create *ReservationCreate
+	}
+
+	// ReservationUpsert is the "OnConflict" setter.
+	ReservationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTitle sets the "title" field.
+func (u *ReservationUpsert) SetTitle(v string) *ReservationUpsert {
+	u.Set(reservation.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateTitle() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldTitle)
+	return u
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *ReservationUpsert) SetDeviceID(v int) *ReservationUpsert {
+	u.Set(reservation.FieldDeviceID, v)
+	return u
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateDeviceID() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldDeviceID)
+	return u
+}
+
+// AddDeviceID adds v to the "device_id" field.
+func (u *ReservationUpsert) AddDeviceID(v int) *ReservationUpsert {
+	u.Add(reservation.FieldDeviceID, v)
+	return u
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (u *ReservationUpsert) SetGpuIds(v []int) *ReservationUpsert {
+	u.Set(reservation.FieldGpuIds, v)
+	return u
+}
+
+// UpdateGpuIds sets the "gpu_ids" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateGpuIds() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldGpuIds)
+	return u
+}
+
+// ClearGpuIds clears the value of the "gpu_ids" field.
+func (u *ReservationUpsert) ClearGpuIds() *ReservationUpsert {
+	u.SetNull(reservation.FieldGpuIds)
+	return u
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *ReservationUpsert) SetProjectID(v int) *ReservationUpsert {
+	u.Set(reservation.FieldProjectID, v)
+	return u
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateProjectID() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldProjectID)
+	return u
+}
+
+// AddProjectID adds v to the "project_id" field.
+func (u *ReservationUpsert) AddProjectID(v int) *ReservationUpsert {
+	u.Add(reservation.FieldProjectID, v)
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *ReservationUpsert) SetUsername(v string) *ReservationUpsert {
+	u.Set(reservation.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateUsername() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldUsername)
+	return u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *ReservationUpsert) SetStartsAt(v time.Time) *ReservationUpsert {
+	u.Set(reservation.FieldStartsAt, v)
+	return u
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateStartsAt() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldStartsAt)
+	return u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *ReservationUpsert) SetEndsAt(v time.Time) *ReservationUpsert {
+	u.Set(reservation.FieldEndsAt, v)
+	return u
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateEndsAt() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldEndsAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ReservationUpsert) SetCreatedBy(v string) *ReservationUpsert {
+	u.Set(reservation.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateCreatedBy() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReservationUpsert) SetUpdatedAt(v time.Time) *ReservationUpsert {
+	u.Set(reservation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReservationUpsert) UpdateUpdatedAt() *ReservationUpsert {
+	u.SetExcluded(reservation.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Reservation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reservation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReservationUpsertOne) UpdateNewValues() *ReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reservation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reservation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Reservation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReservationUpsertOne) Ignore() *ReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReservationUpsertOne) DoNothing() *ReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReservationCreate.OnConflict
+// documentation for more info.
+func (u *ReservationUpsertOne) Update(set func(*ReservationUpsert)) *ReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReservationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ReservationUpsertOne) SetTitle(v string) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateTitle() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *ReservationUpsertOne) SetDeviceID(v int) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetDeviceID(v)
+	})
+}
+
+// AddDeviceID adds v to the "device_id" field.
+func (u *ReservationUpsertOne) AddDeviceID(v int) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.AddDeviceID(v)
+	})
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateDeviceID() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateDeviceID()
+	})
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (u *ReservationUpsertOne) SetGpuIds(v []int) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetGpuIds(v)
+	})
+}
+
+// UpdateGpuIds sets the "gpu_ids" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateGpuIds() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateGpuIds()
+	})
+}
+
+// ClearGpuIds clears the value of the "gpu_ids" field.
+func (u *ReservationUpsertOne) ClearGpuIds() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.ClearGpuIds()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *ReservationUpsertOne) SetProjectID(v int) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// AddProjectID adds v to the "project_id" field.
+func (u *ReservationUpsertOne) AddProjectID(v int) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.AddProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateProjectID() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *ReservationUpsertOne) SetUsername(v string) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateUsername() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *ReservationUpsertOne) SetStartsAt(v time.Time) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateStartsAt() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *ReservationUpsertOne) SetEndsAt(v time.Time) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateEndsAt() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ReservationUpsertOne) SetCreatedBy(v string) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateCreatedBy() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReservationUpsertOne) SetUpdatedAt(v time.Time) *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReservationUpsertOne) UpdateUpdatedAt() *ReservationUpsertOne {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReservationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReservationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReservationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReservationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReservationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReservationCreateBulk is the builder for creating many Reservation entities in bulk.
+type ReservationCreateBulk struct {
+	config
+	err      error
+	builders []*ReservationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Reservation entities in the database.
+func (rcb *ReservationCreateBulk) Save(ctx context.Context) ([]*Reservation, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Reservation, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReservationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReservationCreateBulk) SaveX(ctx context.Context) []*Reservation {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReservationCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReservationCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Reservation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReservationUpsert) {
+//			SetTitle(v+v).
+//		}).
+//		Exec(ctx)
+func (rcb *ReservationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReservationUpsertBulk {
+	rcb.conflict = opts
+	return &ReservationUpsertBulk{
+		create: rcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Reservation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcb *ReservationCreateBulk) OnConflictColumns(columns ...string) *ReservationUpsertBulk {
+	rcb.conflict = append(rcb.conflict, sql.ConflictColumns(columns...))
+	return &ReservationUpsertBulk{
+		create: rcb,
+	}
+}
+
+// ReservationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Reservation nodes.
+type ReservationUpsertBulk struct {
+	create *ReservationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Reservation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reservation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReservationUpsertBulk) UpdateNewValues() *ReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reservation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reservation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Reservation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReservationUpsertBulk) Ignore() *ReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReservationUpsertBulk) DoNothing() *ReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReservationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReservationUpsertBulk) Update(set func(*ReservationUpsert)) *ReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReservationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ReservationUpsertBulk) SetTitle(v string) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateTitle() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDeviceID sets the "device_id" field.
+func (u *ReservationUpsertBulk) SetDeviceID(v int) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetDeviceID(v)
+	})
+}
+
+// AddDeviceID adds v to the "device_id" field.
+func (u *ReservationUpsertBulk) AddDeviceID(v int) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.AddDeviceID(v)
+	})
+}
+
+// UpdateDeviceID sets the "device_id" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateDeviceID() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateDeviceID()
+	})
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (u *ReservationUpsertBulk) SetGpuIds(v []int) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetGpuIds(v)
+	})
+}
+
+// UpdateGpuIds sets the "gpu_ids" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateGpuIds() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateGpuIds()
+	})
+}
+
+// ClearGpuIds clears the value of the "gpu_ids" field.
+func (u *ReservationUpsertBulk) ClearGpuIds() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.ClearGpuIds()
+	})
+}
+
+// SetProjectID sets the "project_id" field.
+func (u *ReservationUpsertBulk) SetProjectID(v int) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetProjectID(v)
+	})
+}
+
+// AddProjectID adds v to the "project_id" field.
+func (u *ReservationUpsertBulk) AddProjectID(v int) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.AddProjectID(v)
+	})
+}
+
+// UpdateProjectID sets the "project_id" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateProjectID() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateProjectID()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *ReservationUpsertBulk) SetUsername(v string) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateUsername() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *ReservationUpsertBulk) SetStartsAt(v time.Time) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateStartsAt() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *ReservationUpsertBulk) SetEndsAt(v time.Time) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateEndsAt() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ReservationUpsertBulk) SetCreatedBy(v string) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateCreatedBy() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReservationUpsertBulk) SetUpdatedAt(v time.Time) *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReservationUpsertBulk) UpdateUpdatedAt() *ReservationUpsertBulk {
+	return u.Update(func(s *ReservationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReservationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReservationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReservationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReservationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/predicate"
+	"api_server/ent/reservation"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ReservationUpdate is the builder for updating Reservation entities.
+type ReservationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ReservationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ReservationUpdate builder.
+func (ru *ReservationUpdate) Where(ps ...predicate.Reservation) *ReservationUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetTitle sets the "title" field.
+func (ru *ReservationUpdate) SetTitle(s string) *ReservationUpdate {
+	ru.mutation.SetTitle(s)
+	return ru
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableTitle(s *string) *ReservationUpdate {
+	if s != nil {
+		ru.SetTitle(*s)
+	}
+	return ru
+}
+
+// SetDeviceID sets the "device_id" field.
+func (ru *ReservationUpdate) SetDeviceID(i int) *ReservationUpdate {
+	ru.mutation.ResetDeviceID()
+	ru.mutation.SetDeviceID(i)
+	return ru
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableDeviceID(i *int) *ReservationUpdate {
+	if i != nil {
+		ru.SetDeviceID(*i)
+	}
+	return ru
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (ru *ReservationUpdate) AddDeviceID(i int) *ReservationUpdate {
+	ru.mutation.AddDeviceID(i)
+	return ru
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (ru *ReservationUpdate) SetGpuIds(i []int) *ReservationUpdate {
+	ru.mutation.SetGpuIds(i)
+	return ru
+}
+
+// AppendGpuIds appends i to the "gpu_ids" field.
+func (ru *ReservationUpdate) AppendGpuIds(i []int) *ReservationUpdate {
+	ru.mutation.AppendGpuIds(i)
+	return ru
+}
+
+// ClearGpuIds clears the value of the "gpu_ids" field.
+func (ru *ReservationUpdate) ClearGpuIds() *ReservationUpdate {
+	ru.mutation.ClearGpuIds()
+	return ru
+}
+
+// SetProjectID sets the "project_id" field.
+func (ru *ReservationUpdate) SetProjectID(i int) *ReservationUpdate {
+	ru.mutation.ResetProjectID()
+	ru.mutation.SetProjectID(i)
+	return ru
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableProjectID(i *int) *ReservationUpdate {
+	if i != nil {
+		ru.SetProjectID(*i)
+	}
+	return ru
+}
+
+// AddProjectID adds i to the "project_id" field.
+func (ru *ReservationUpdate) AddProjectID(i int) *ReservationUpdate {
+	ru.mutation.AddProjectID(i)
+	return ru
+}
+
+// SetUsername sets the "username" field.
+func (ru *ReservationUpdate) SetUsername(s string) *ReservationUpdate {
+	ru.mutation.SetUsername(s)
+	return ru
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableUsername(s *string) *ReservationUpdate {
+	if s != nil {
+		ru.SetUsername(*s)
+	}
+	return ru
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (ru *ReservationUpdate) SetStartsAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetStartsAt(t)
+	return ru
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableStartsAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetStartsAt(*t)
+	}
+	return ru
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (ru *ReservationUpdate) SetEndsAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetEndsAt(t)
+	return ru
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableEndsAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetEndsAt(*t)
+	}
+	return ru
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ru *ReservationUpdate) SetCreatedBy(s string) *ReservationUpdate {
+	ru.mutation.SetCreatedBy(s)
+	return ru
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableCreatedBy(s *string) *ReservationUpdate {
+	if s != nil {
+		ru.SetCreatedBy(*s)
+	}
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *ReservationUpdate) SetUpdatedAt(t time.Time) *ReservationUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ru *ReservationUpdate) SetNillableUpdatedAt(t *time.Time) *ReservationUpdate {
+	if t != nil {
+		ru.SetUpdatedAt(*t)
+	}
+	return ru
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (ru *ReservationUpdate) Mutation() *ReservationMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReservationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReservationUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReservationUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReservationUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ru *ReservationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReservationUpdate {
+	ru.modifiers = append(ru.modifiers, modifiers...)
+	return ru
+}
+
+func (ru *ReservationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(reservation.Table, reservation.Columns, sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Title(); ok {
+		_spec.SetField(reservation.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.DeviceID(); ok {
+		_spec.SetField(reservation.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedDeviceID(); ok {
+		_spec.AddField(reservation.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.GpuIds(); ok {
+		_spec.SetField(reservation.FieldGpuIds, field.TypeJSON, value)
+	}
+	if value, ok := ru.mutation.AppendedGpuIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reservation.FieldGpuIds, value)
+		})
+	}
+	if ru.mutation.GpuIdsCleared() {
+		_spec.ClearField(reservation.FieldGpuIds, field.TypeJSON)
+	}
+	if value, ok := ru.mutation.ProjectID(); ok {
+		_spec.SetField(reservation.FieldProjectID, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.AddedProjectID(); ok {
+		_spec.AddField(reservation.FieldProjectID, field.TypeInt, value)
+	}
+	if value, ok := ru.mutation.Username(); ok {
+		_spec.SetField(reservation.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.StartsAt(); ok {
+		_spec.SetField(reservation.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.EndsAt(); ok {
+		_spec.SetField(reservation.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.CreatedBy(); ok {
+		_spec.SetField(reservation.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(reservation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(ru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReservationUpdateOne is the builder for updating a single Reservation entity.
+type ReservationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ReservationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTitle sets the "title" field.
+func (ruo *ReservationUpdateOne) SetTitle(s string) *ReservationUpdateOne {
+	ruo.mutation.SetTitle(s)
+	return ruo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableTitle(s *string) *ReservationUpdateOne {
+	if s != nil {
+		ruo.SetTitle(*s)
+	}
+	return ruo
+}
+
+// SetDeviceID sets the "device_id" field.
+func (ruo *ReservationUpdateOne) SetDeviceID(i int) *ReservationUpdateOne {
+	ruo.mutation.ResetDeviceID()
+	ruo.mutation.SetDeviceID(i)
+	return ruo
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableDeviceID(i *int) *ReservationUpdateOne {
+	if i != nil {
+		ruo.SetDeviceID(*i)
+	}
+	return ruo
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (ruo *ReservationUpdateOne) AddDeviceID(i int) *ReservationUpdateOne {
+	ruo.mutation.AddDeviceID(i)
+	return ruo
+}
+
+// SetGpuIds sets the "gpu_ids" field.
+func (ruo *ReservationUpdateOne) SetGpuIds(i []int) *ReservationUpdateOne {
+	ruo.mutation.SetGpuIds(i)
+	return ruo
+}
+
+// AppendGpuIds appends i to the "gpu_ids" field.
+func (ruo *ReservationUpdateOne) AppendGpuIds(i []int) *ReservationUpdateOne {
+	ruo.mutation.AppendGpuIds(i)
+	return ruo
+}
+
+// ClearGpuIds clears the value of the "gpu_ids" field.
+func (ruo *ReservationUpdateOne) ClearGpuIds() *ReservationUpdateOne {
+	ruo.mutation.ClearGpuIds()
+	return ruo
+}
+
+// SetProjectID sets the "project_id" field.
+func (ruo *ReservationUpdateOne) SetProjectID(i int) *ReservationUpdateOne {
+	ruo.mutation.ResetProjectID()
+	ruo.mutation.SetProjectID(i)
+	return ruo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableProjectID(i *int) *ReservationUpdateOne {
+	if i != nil {
+		ruo.SetProjectID(*i)
+	}
+	return ruo
+}
+
+// AddProjectID adds i to the "project_id" field.
+func (ruo *ReservationUpdateOne) AddProjectID(i int) *ReservationUpdateOne {
+	ruo.mutation.AddProjectID(i)
+	return ruo
+}
+
+// SetUsername sets the "username" field.
+func (ruo *ReservationUpdateOne) SetUsername(s string) *ReservationUpdateOne {
+	ruo.mutation.SetUsername(s)
+	return ruo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableUsername(s *string) *ReservationUpdateOne {
+	if s != nil {
+		ruo.SetUsername(*s)
+	}
+	return ruo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (ruo *ReservationUpdateOne) SetStartsAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetStartsAt(t)
+	return ruo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableStartsAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetStartsAt(*t)
+	}
+	return ruo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (ruo *ReservationUpdateOne) SetEndsAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetEndsAt(t)
+	return ruo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableEndsAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetEndsAt(*t)
+	}
+	return ruo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ruo *ReservationUpdateOne) SetCreatedBy(s string) *ReservationUpdateOne {
+	ruo.mutation.SetCreatedBy(s)
+	return ruo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableCreatedBy(s *string) *ReservationUpdateOne {
+	if s != nil {
+		ruo.SetCreatedBy(*s)
+	}
+	return ruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *ReservationUpdateOne) SetUpdatedAt(t time.Time) *ReservationUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ruo *ReservationUpdateOne) SetNillableUpdatedAt(t *time.Time) *ReservationUpdateOne {
+	if t != nil {
+		ruo.SetUpdatedAt(*t)
+	}
+	return ruo
+}
+
+// Mutation returns the ReservationMutation object of the builder.
+func (ruo *ReservationUpdateOne) Mutation() *ReservationMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ReservationUpdate builder.
+func (ruo *ReservationUpdateOne) Where(ps ...predicate.Reservation) *ReservationUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReservationUpdateOne) Select(field string, fields ...string) *ReservationUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reservation entity.
+func (ruo *ReservationUpdateOne) Save(ctx context.Context) (*Reservation, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReservationUpdateOne) SaveX(ctx context.Context) *Reservation {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReservationUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReservationUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ruo *ReservationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ReservationUpdateOne {
+	ruo.modifiers = append(ruo.modifiers, modifiers...)
+	return ruo
+}
+
+func (ruo *ReservationUpdateOne) sqlSave(ctx context.Context) (_node *Reservation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(reservation.Table, reservation.Columns, sqlgraph.NewFieldSpec(reservation.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reservation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reservation.FieldID)
+		for _, f := range fields {
+			if !reservation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reservation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Title(); ok {
+		_spec.SetField(reservation.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.DeviceID(); ok {
+		_spec.SetField(reservation.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedDeviceID(); ok {
+		_spec.AddField(reservation.FieldDeviceID, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.GpuIds(); ok {
+		_spec.SetField(reservation.FieldGpuIds, field.TypeJSON, value)
+	}
+	if value, ok := ruo.mutation.AppendedGpuIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reservation.FieldGpuIds, value)
+		})
+	}
+	if ruo.mutation.GpuIdsCleared() {
+		_spec.ClearField(reservation.FieldGpuIds, field.TypeJSON)
+	}
+	if value, ok := ruo.mutation.ProjectID(); ok {
+		_spec.SetField(reservation.FieldProjectID, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.AddedProjectID(); ok {
+		_spec.AddField(reservation.FieldProjectID, field.TypeInt, value)
+	}
+	if value, ok := ruo.mutation.Username(); ok {
+		_spec.SetField(reservation.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.StartsAt(); ok {
+		_spec.SetField(reservation.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.EndsAt(); ok {
+		_spec.SetField(reservation.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.CreatedBy(); ok {
+		_spec.SetField(reservation.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(reservation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(ruo.modifiers...)
+	_node = &Reservation{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
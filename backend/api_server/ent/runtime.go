@@ -7,14 +7,23 @@ import (
 	"api_server/ent/dataset"
 	"api_server/ent/datasetroot"
 	"api_server/ent/device"
+	"api_server/ent/devicetoken"
+	"api_server/ent/earlystop"
 	"api_server/ent/enginelog"
 	"api_server/ent/gpu"
+	"api_server/ent/gputelemetry"
 	"api_server/ent/hyperparamshistory"
+	"api_server/ent/maintenancewindow"
 	"api_server/ent/menu"
 	"api_server/ent/modeling"
 	"api_server/ent/modelingdetails"
 	"api_server/ent/modelingmodels"
+	"api_server/ent/preemption"
 	"api_server/ent/project"
+	"api_server/ent/quota"
+	"api_server/ent/reservation"
+	"api_server/ent/schedule"
+	"api_server/ent/schedulehistory"
 	"api_server/ent/schema"
 	"api_server/ent/task"
 	"api_server/ent/trial"
@@ -82,6 +91,10 @@ func init() {
 	datasetDescDrID := datasetFields[17].Descriptor()
 	// dataset.DefaultDrID holds the default value on creation for the dr_id field.
 	dataset.DefaultDrID = datasetDescDrID.Default.(int)
+	// datasetDescFingerprint is the schema descriptor for fingerprint field.
+	datasetDescFingerprint := datasetFields[18].Descriptor()
+	// dataset.DefaultFingerprint holds the default value on creation for the fingerprint field.
+	dataset.DefaultFingerprint = datasetDescFingerprint.Default.(string)
 	datasetrootFields := schema.DatasetRoot{}.Fields()
 	_ = datasetrootFields
 	// datasetrootDescName is the schema descriptor for name field.
@@ -114,6 +127,62 @@ func init() {
 	deviceDescAvailable := deviceFields[7].Descriptor()
 	// device.DefaultAvailable holds the default value on creation for the available field.
 	device.DefaultAvailable = deviceDescAvailable.Default.(string)
+	// deviceDescDrain is the schema descriptor for drain field.
+	deviceDescDrain := deviceFields[10].Descriptor()
+	// device.DefaultDrain holds the default value on creation for the drain field.
+	device.DefaultDrain = deviceDescDrain.Default.(bool)
+	// deviceDescDrainReason is the schema descriptor for drain_reason field.
+	deviceDescDrainReason := deviceFields[11].Descriptor()
+	// device.DefaultDrainReason holds the default value on creation for the drain_reason field.
+	device.DefaultDrainReason = deviceDescDrainReason.Default.(string)
+	// deviceDescDrainWindow is the schema descriptor for drain_window field.
+	deviceDescDrainWindow := deviceFields[13].Descriptor()
+	// device.DefaultDrainWindow holds the default value on creation for the drain_window field.
+	device.DefaultDrainWindow = deviceDescDrainWindow.Default.(int)
+	devicetokenFields := schema.DeviceToken{}.Fields()
+	_ = devicetokenFields
+	// devicetokenDescName is the schema descriptor for name field.
+	devicetokenDescName := devicetokenFields[1].Descriptor()
+	// devicetoken.DefaultName holds the default value on creation for the name field.
+	devicetoken.DefaultName = devicetokenDescName.Default.(string)
+	// devicetokenDescDeviceID is the schema descriptor for device_id field.
+	devicetokenDescDeviceID := devicetokenFields[3].Descriptor()
+	// devicetoken.DefaultDeviceID holds the default value on creation for the device_id field.
+	devicetoken.DefaultDeviceID = devicetokenDescDeviceID.Default.(int)
+	// devicetokenDescRevoked is the schema descriptor for revoked field.
+	devicetokenDescRevoked := devicetokenFields[4].Descriptor()
+	// devicetoken.DefaultRevoked holds the default value on creation for the revoked field.
+	devicetoken.DefaultRevoked = devicetokenDescRevoked.Default.(bool)
+	// devicetokenDescCreatedBy is the schema descriptor for created_by field.
+	devicetokenDescCreatedBy := devicetokenFields[5].Descriptor()
+	// devicetoken.DefaultCreatedBy holds the default value on creation for the created_by field.
+	devicetoken.DefaultCreatedBy = devicetokenDescCreatedBy.Default.(string)
+	// devicetokenDescCreatedAt is the schema descriptor for created_at field.
+	devicetokenDescCreatedAt := devicetokenFields[6].Descriptor()
+	// devicetoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	devicetoken.DefaultCreatedAt = devicetokenDescCreatedAt.Default.(func() time.Time)
+	earlystopFields := schema.EarlyStop{}.Fields()
+	_ = earlystopFields
+	// earlystopDescMetric is the schema descriptor for metric field.
+	earlystopDescMetric := earlystopFields[5].Descriptor()
+	// earlystop.DefaultMetric holds the default value on creation for the metric field.
+	earlystop.DefaultMetric = earlystopDescMetric.Default.(string)
+	// earlystopDescEpoch is the schema descriptor for epoch field.
+	earlystopDescEpoch := earlystopFields[6].Descriptor()
+	// earlystop.DefaultEpoch holds the default value on creation for the epoch field.
+	earlystop.DefaultEpoch = earlystopDescEpoch.Default.(int)
+	// earlystopDescValue is the schema descriptor for value field.
+	earlystopDescValue := earlystopFields[7].Descriptor()
+	// earlystop.DefaultValue holds the default value on creation for the value field.
+	earlystop.DefaultValue = earlystopDescValue.Default.(float64)
+	// earlystopDescReason is the schema descriptor for reason field.
+	earlystopDescReason := earlystopFields[8].Descriptor()
+	// earlystop.DefaultReason holds the default value on creation for the reason field.
+	earlystop.DefaultReason = earlystopDescReason.Default.(string)
+	// earlystopDescCreatedAt is the schema descriptor for created_at field.
+	earlystopDescCreatedAt := earlystopFields[9].Descriptor()
+	// earlystop.DefaultCreatedAt holds the default value on creation for the created_at field.
+	earlystop.DefaultCreatedAt = earlystopDescCreatedAt.Default.(func() time.Time)
 	enginelogFields := schema.EngineLog{}.Fields()
 	_ = enginelogFields
 	// enginelogDescModelingID is the schema descriptor for modeling_id field.
@@ -142,6 +211,58 @@ func init() {
 	gpuDescDeviceID := gpuFields[5].Descriptor()
 	// gpu.DefaultDeviceID holds the default value on creation for the device_id field.
 	gpu.DefaultDeviceID = gpuDescDeviceID.Default.(int)
+	// gpuDescMemoryTotal is the schema descriptor for memory_total field.
+	gpuDescMemoryTotal := gpuFields[6].Descriptor()
+	// gpu.DefaultMemoryTotal holds the default value on creation for the memory_total field.
+	gpu.DefaultMemoryTotal = gpuDescMemoryTotal.Default.(float64)
+	// gpuDescMemoryUsed is the schema descriptor for memory_used field.
+	gpuDescMemoryUsed := gpuFields[7].Descriptor()
+	// gpu.DefaultMemoryUsed holds the default value on creation for the memory_used field.
+	gpu.DefaultMemoryUsed = gpuDescMemoryUsed.Default.(float64)
+	// gpuDescUtilizationGpu is the schema descriptor for utilization_gpu field.
+	gpuDescUtilizationGpu := gpuFields[8].Descriptor()
+	// gpu.DefaultUtilizationGpu holds the default value on creation for the utilization_gpu field.
+	gpu.DefaultUtilizationGpu = gpuDescUtilizationGpu.Default.(float64)
+	// gpuDescUtilizationMemory is the schema descriptor for utilization_memory field.
+	gpuDescUtilizationMemory := gpuFields[9].Descriptor()
+	// gpu.DefaultUtilizationMemory holds the default value on creation for the utilization_memory field.
+	gpu.DefaultUtilizationMemory = gpuDescUtilizationMemory.Default.(float64)
+	gputelemetryFields := schema.GpuTelemetry{}.Fields()
+	_ = gputelemetryFields
+	// gputelemetryDescSamples is the schema descriptor for samples field.
+	gputelemetryDescSamples := gputelemetryFields[5].Descriptor()
+	// gputelemetry.DefaultSamples holds the default value on creation for the samples field.
+	gputelemetry.DefaultSamples = gputelemetryDescSamples.Default.(int)
+	// gputelemetryDescUtilizationGpu is the schema descriptor for utilization_gpu field.
+	gputelemetryDescUtilizationGpu := gputelemetryFields[6].Descriptor()
+	// gputelemetry.DefaultUtilizationGpu holds the default value on creation for the utilization_gpu field.
+	gputelemetry.DefaultUtilizationGpu = gputelemetryDescUtilizationGpu.Default.(float64)
+	// gputelemetryDescUtilizationMemory is the schema descriptor for utilization_memory field.
+	gputelemetryDescUtilizationMemory := gputelemetryFields[7].Descriptor()
+	// gputelemetry.DefaultUtilizationMemory holds the default value on creation for the utilization_memory field.
+	gputelemetry.DefaultUtilizationMemory = gputelemetryDescUtilizationMemory.Default.(float64)
+	// gputelemetryDescMemoryUsed is the schema descriptor for memory_used field.
+	gputelemetryDescMemoryUsed := gputelemetryFields[8].Descriptor()
+	// gputelemetry.DefaultMemoryUsed holds the default value on creation for the memory_used field.
+	gputelemetry.DefaultMemoryUsed = gputelemetryDescMemoryUsed.Default.(float64)
+	// gputelemetryDescMemoryTotal is the schema descriptor for memory_total field.
+	gputelemetryDescMemoryTotal := gputelemetryFields[9].Descriptor()
+	// gputelemetry.DefaultMemoryTotal holds the default value on creation for the memory_total field.
+	gputelemetry.DefaultMemoryTotal = gputelemetryDescMemoryTotal.Default.(float64)
+	// gputelemetryDescTemperature is the schema descriptor for temperature field.
+	gputelemetryDescTemperature := gputelemetryFields[10].Descriptor()
+	// gputelemetry.DefaultTemperature holds the default value on creation for the temperature field.
+	gputelemetry.DefaultTemperature = gputelemetryDescTemperature.Default.(float64)
+	// gputelemetryDescPowerDraw is the schema descriptor for power_draw field.
+	gputelemetryDescPowerDraw := gputelemetryFields[11].Descriptor()
+	// gputelemetry.DefaultPowerDraw holds the default value on creation for the power_draw field.
+	gputelemetry.DefaultPowerDraw = gputelemetryDescPowerDraw.Default.(float64)
+	// gputelemetryDescUpdatedAt is the schema descriptor for updated_at field.
+	gputelemetryDescUpdatedAt := gputelemetryFields[12].Descriptor()
+	// gputelemetry.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	gputelemetry.DefaultUpdatedAt = gputelemetryDescUpdatedAt.Default.(func() time.Time)
+	// gputelemetry.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	gputelemetry.UpdateDefaultUpdatedAt = gputelemetryDescUpdatedAt.UpdateDefault.(func() time.Time)
 	hyperparamshistoryFields := schema.HyperParamsHistory{}.Fields()
 	_ = hyperparamshistoryFields
 	// hyperparamshistoryDescTrialID is the schema descriptor for trial_id field.
@@ -165,9 +286,27 @@ func init() {
 	// hyperparamshistory.DefaultParams holds the default value on creation for the params field.
 	hyperparamshistory.DefaultParams = hyperparamshistoryDescParams.Default.(string)
 	// hyperparamshistoryDescCreatedAt is the schema descriptor for created_at field.
-	hyperparamshistoryDescCreatedAt := hyperparamshistoryFields[6].Descriptor()
+	hyperparamshistoryDescCreatedAt := hyperparamshistoryFields[7].Descriptor()
 	// hyperparamshistory.DefaultCreatedAt holds the default value on creation for the created_at field.
 	hyperparamshistory.DefaultCreatedAt = hyperparamshistoryDescCreatedAt.Default.(func() time.Time)
+	maintenancewindowFields := schema.MaintenanceWindow{}.Fields()
+	_ = maintenancewindowFields
+	// maintenancewindowDescReason is the schema descriptor for reason field.
+	maintenancewindowDescReason := maintenancewindowFields[4].Descriptor()
+	// maintenancewindow.DefaultReason holds the default value on creation for the reason field.
+	maintenancewindow.DefaultReason = maintenancewindowDescReason.Default.(string)
+	// maintenancewindowDescMigrate is the schema descriptor for migrate field.
+	maintenancewindowDescMigrate := maintenancewindowFields[5].Descriptor()
+	// maintenancewindow.DefaultMigrate holds the default value on creation for the migrate field.
+	maintenancewindow.DefaultMigrate = maintenancewindowDescMigrate.Default.(bool)
+	// maintenancewindowDescCreatedBy is the schema descriptor for created_by field.
+	maintenancewindowDescCreatedBy := maintenancewindowFields[6].Descriptor()
+	// maintenancewindow.DefaultCreatedBy holds the default value on creation for the created_by field.
+	maintenancewindow.DefaultCreatedBy = maintenancewindowDescCreatedBy.Default.(string)
+	// maintenancewindowDescCreatedAt is the schema descriptor for created_at field.
+	maintenancewindowDescCreatedAt := maintenancewindowFields[7].Descriptor()
+	// maintenancewindow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	maintenancewindow.DefaultCreatedAt = maintenancewindowDescCreatedAt.Default.(func() time.Time)
 	menuFields := schema.Menu{}.Fields()
 	_ = menuFields
 	// menuDescIsUse is the schema descriptor for is_use field.
@@ -224,12 +363,52 @@ func init() {
 	modelingDescPerformance := modelingFields[10].Descriptor()
 	// modeling.DefaultPerformance holds the default value on creation for the performance field.
 	modeling.DefaultPerformance = modelingDescPerformance.Default.([]string)
+	// modelingDescPriority is the schema descriptor for priority field.
+	modelingDescPriority := modelingFields[12].Descriptor()
+	// modeling.DefaultPriority holds the default value on creation for the priority field.
+	modeling.DefaultPriority = modelingDescPriority.Default.(int)
+	// modelingDescUsername is the schema descriptor for username field.
+	modelingDescUsername := modelingFields[13].Descriptor()
+	// modeling.DefaultUsername holds the default value on creation for the username field.
+	modeling.DefaultUsername = modelingDescUsername.Default.(string)
+	// modelingDescQueuedAt is the schema descriptor for queued_at field.
+	modelingDescQueuedAt := modelingFields[14].Descriptor()
+	// modeling.DefaultQueuedAt holds the default value on creation for the queued_at field.
+	modeling.DefaultQueuedAt = modelingDescQueuedAt.Default.(func() time.Time)
+	// modelingDescExecutor is the schema descriptor for executor field.
+	modelingDescExecutor := modelingFields[16].Descriptor()
+	// modeling.DefaultExecutor holds the default value on creation for the executor field.
+	modeling.DefaultExecutor = modelingDescExecutor.Default.(string)
+	// modelingDescJobID is the schema descriptor for job_id field.
+	modelingDescJobID := modelingFields[17].Descriptor()
+	// modeling.DefaultJobID holds the default value on creation for the job_id field.
+	modeling.DefaultJobID = modelingDescJobID.Default.(string)
+	// modelingDescJobType is the schema descriptor for job_type field.
+	modelingDescJobType := modelingFields[18].Descriptor()
+	// modeling.DefaultJobType holds the default value on creation for the job_type field.
+	modeling.DefaultJobType = modelingDescJobType.Default.(string)
+	// modelingDescAttempts is the schema descriptor for attempts field.
+	modelingDescAttempts := modelingFields[19].Descriptor()
+	// modeling.DefaultAttempts holds the default value on creation for the attempts field.
+	modeling.DefaultAttempts = modelingDescAttempts.Default.(int)
+	// modelingDescFailReason is the schema descriptor for fail_reason field.
+	modelingDescFailReason := modelingFields[21].Descriptor()
+	// modeling.DefaultFailReason holds the default value on creation for the fail_reason field.
+	modeling.DefaultFailReason = modelingDescFailReason.Default.(string)
+	// modelingDescPipelineStage is the schema descriptor for pipeline_stage field.
+	modelingDescPipelineStage := modelingFields[22].Descriptor()
+	// modeling.DefaultPipelineStage holds the default value on creation for the pipeline_stage field.
+	modeling.DefaultPipelineStage = modelingDescPipelineStage.Default.(int)
+	// modelingDescSearchTrial is the schema descriptor for search_trial field.
+	modelingDescSearchTrial := modelingFields[23].Descriptor()
+	// modeling.DefaultSearchTrial holds the default value on creation for the search_trial field.
+	modeling.DefaultSearchTrial = modelingDescSearchTrial.Default.(int)
 	// modelingDescCreatedAt is the schema descriptor for created_at field.
-	modelingDescCreatedAt := modelingFields[12].Descriptor()
+	modelingDescCreatedAt := modelingFields[24].Descriptor()
 	// modeling.DefaultCreatedAt holds the default value on creation for the created_at field.
 	modeling.DefaultCreatedAt = modelingDescCreatedAt.Default.(func() time.Time)
 	// modelingDescUpdatedAt is the schema descriptor for updated_at field.
-	modelingDescUpdatedAt := modelingFields[13].Descriptor()
+	modelingDescUpdatedAt := modelingFields[25].Descriptor()
 	// modeling.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	modeling.DefaultUpdatedAt = modelingDescUpdatedAt.Default.(func() time.Time)
 	modelingdetailsFields := schema.ModelingDetails{}.Fields()
@@ -272,6 +451,32 @@ func init() {
 	modelingmodelsDescCreatedAt := modelingmodelsFields[4].Descriptor()
 	// modelingmodels.DefaultCreatedAt holds the default value on creation for the created_at field.
 	modelingmodels.DefaultCreatedAt = modelingmodelsDescCreatedAt.Default.(func() time.Time)
+	preemptionFields := schema.Preemption{}.Fields()
+	_ = preemptionFields
+	// preemptionDescPriority is the schema descriptor for priority field.
+	preemptionDescPriority := preemptionFields[2].Descriptor()
+	// preemption.DefaultPriority holds the default value on creation for the priority field.
+	preemption.DefaultPriority = preemptionDescPriority.Default.(int)
+	// preemptionDescUsername is the schema descriptor for username field.
+	preemptionDescUsername := preemptionFields[3].Descriptor()
+	// preemption.DefaultUsername holds the default value on creation for the username field.
+	preemption.DefaultUsername = preemptionDescUsername.Default.(string)
+	// preemptionDescVictimPriority is the schema descriptor for victim_priority field.
+	preemptionDescVictimPriority := preemptionFields[5].Descriptor()
+	// preemption.DefaultVictimPriority holds the default value on creation for the victim_priority field.
+	preemption.DefaultVictimPriority = preemptionDescVictimPriority.Default.(int)
+	// preemptionDescVictimUsername is the schema descriptor for victim_username field.
+	preemptionDescVictimUsername := preemptionFields[6].Descriptor()
+	// preemption.DefaultVictimUsername holds the default value on creation for the victim_username field.
+	preemption.DefaultVictimUsername = preemptionDescVictimUsername.Default.(string)
+	// preemptionDescGpuIds is the schema descriptor for gpu_ids field.
+	preemptionDescGpuIds := preemptionFields[7].Descriptor()
+	// preemption.DefaultGpuIds holds the default value on creation for the gpu_ids field.
+	preemption.DefaultGpuIds = preemptionDescGpuIds.Default.([]int)
+	// preemptionDescCreatedAt is the schema descriptor for created_at field.
+	preemptionDescCreatedAt := preemptionFields[8].Descriptor()
+	// preemption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	preemption.DefaultCreatedAt = preemptionDescCreatedAt.Default.(func() time.Time)
 	projectFields := schema.Project{}.Fields()
 	_ = projectFields
 	// projectDescIsUse is the schema descriptor for is_use field.
@@ -286,6 +491,122 @@ func init() {
 	projectDescUpdatedAt := projectFields[5].Descriptor()
 	// project.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	project.DefaultUpdatedAt = projectDescUpdatedAt.Default.(func() time.Time)
+	quotaFields := schema.Quota{}.Fields()
+	_ = quotaFields
+	// quotaDescMaxGpus is the schema descriptor for max_gpus field.
+	quotaDescMaxGpus := quotaFields[3].Descriptor()
+	// quota.DefaultMaxGpus holds the default value on creation for the max_gpus field.
+	quota.DefaultMaxGpus = quotaDescMaxGpus.Default.(int)
+	// quotaDescMaxQueued is the schema descriptor for max_queued field.
+	quotaDescMaxQueued := quotaFields[4].Descriptor()
+	// quota.DefaultMaxQueued holds the default value on creation for the max_queued field.
+	quota.DefaultMaxQueued = quotaDescMaxQueued.Default.(int)
+	// quotaDescCreatedAt is the schema descriptor for created_at field.
+	quotaDescCreatedAt := quotaFields[5].Descriptor()
+	// quota.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quota.DefaultCreatedAt = quotaDescCreatedAt.Default.(func() time.Time)
+	// quotaDescUpdatedAt is the schema descriptor for updated_at field.
+	quotaDescUpdatedAt := quotaFields[6].Descriptor()
+	// quota.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	quota.DefaultUpdatedAt = quotaDescUpdatedAt.Default.(func() time.Time)
+	reservationFields := schema.Reservation{}.Fields()
+	_ = reservationFields
+	// reservationDescTitle is the schema descriptor for title field.
+	reservationDescTitle := reservationFields[1].Descriptor()
+	// reservation.DefaultTitle holds the default value on creation for the title field.
+	reservation.DefaultTitle = reservationDescTitle.Default.(string)
+	// reservationDescProjectID is the schema descriptor for project_id field.
+	reservationDescProjectID := reservationFields[4].Descriptor()
+	// reservation.DefaultProjectID holds the default value on creation for the project_id field.
+	reservation.DefaultProjectID = reservationDescProjectID.Default.(int)
+	// reservationDescUsername is the schema descriptor for username field.
+	reservationDescUsername := reservationFields[5].Descriptor()
+	// reservation.DefaultUsername holds the default value on creation for the username field.
+	reservation.DefaultUsername = reservationDescUsername.Default.(string)
+	// reservationDescCreatedBy is the schema descriptor for created_by field.
+	reservationDescCreatedBy := reservationFields[8].Descriptor()
+	// reservation.DefaultCreatedBy holds the default value on creation for the created_by field.
+	reservation.DefaultCreatedBy = reservationDescCreatedBy.Default.(string)
+	// reservationDescCreatedAt is the schema descriptor for created_at field.
+	reservationDescCreatedAt := reservationFields[9].Descriptor()
+	// reservation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reservation.DefaultCreatedAt = reservationDescCreatedAt.Default.(func() time.Time)
+	// reservationDescUpdatedAt is the schema descriptor for updated_at field.
+	reservationDescUpdatedAt := reservationFields[10].Descriptor()
+	// reservation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reservation.DefaultUpdatedAt = reservationDescUpdatedAt.Default.(func() time.Time)
+	scheduleFields := schema.Schedule{}.Fields()
+	_ = scheduleFields
+	// scheduleDescTaskID is the schema descriptor for task_id field.
+	scheduleDescTaskID := scheduleFields[1].Descriptor()
+	// schedule.DefaultTaskID holds the default value on creation for the task_id field.
+	schedule.DefaultTaskID = scheduleDescTaskID.Default.(int)
+	// scheduleDescParentID is the schema descriptor for parent_id field.
+	scheduleDescParentID := scheduleFields[2].Descriptor()
+	// schedule.DefaultParentID holds the default value on creation for the parent_id field.
+	schedule.DefaultParentID = scheduleDescParentID.Default.(int)
+	// scheduleDescTrigger is the schema descriptor for trigger field.
+	scheduleDescTrigger := scheduleFields[3].Descriptor()
+	// schedule.DefaultTrigger holds the default value on creation for the trigger field.
+	schedule.DefaultTrigger = scheduleDescTrigger.Default.(string)
+	// scheduleDescCron is the schema descriptor for cron field.
+	scheduleDescCron := scheduleFields[4].Descriptor()
+	// schedule.DefaultCron holds the default value on creation for the cron field.
+	schedule.DefaultCron = scheduleDescCron.Default.(string)
+	// scheduleDescDatasetID is the schema descriptor for dataset_id field.
+	scheduleDescDatasetID := scheduleFields[5].Descriptor()
+	// schedule.DefaultDatasetID holds the default value on creation for the dataset_id field.
+	schedule.DefaultDatasetID = scheduleDescDatasetID.Default.(int)
+	// scheduleDescPaused is the schema descriptor for paused field.
+	scheduleDescPaused := scheduleFields[6].Descriptor()
+	// schedule.DefaultPaused holds the default value on creation for the paused field.
+	schedule.DefaultPaused = scheduleDescPaused.Default.(bool)
+	// scheduleDescPriority is the schema descriptor for priority field.
+	scheduleDescPriority := scheduleFields[7].Descriptor()
+	// schedule.DefaultPriority holds the default value on creation for the priority field.
+	schedule.DefaultPriority = scheduleDescPriority.Default.(int)
+	// scheduleDescUsername is the schema descriptor for username field.
+	scheduleDescUsername := scheduleFields[8].Descriptor()
+	// schedule.DefaultUsername holds the default value on creation for the username field.
+	schedule.DefaultUsername = scheduleDescUsername.Default.(string)
+	// scheduleDescTrainedFingerprint is the schema descriptor for trained_fingerprint field.
+	scheduleDescTrainedFingerprint := scheduleFields[9].Descriptor()
+	// schedule.DefaultTrainedFingerprint holds the default value on creation for the trained_fingerprint field.
+	schedule.DefaultTrainedFingerprint = scheduleDescTrainedFingerprint.Default.(string)
+	// scheduleDescCreatedAt is the schema descriptor for created_at field.
+	scheduleDescCreatedAt := scheduleFields[12].Descriptor()
+	// schedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	schedule.DefaultCreatedAt = scheduleDescCreatedAt.Default.(func() time.Time)
+	// scheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduleDescUpdatedAt := scheduleFields[13].Descriptor()
+	// schedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	schedule.DefaultUpdatedAt = scheduleDescUpdatedAt.Default.(func() time.Time)
+	schedulehistoryFields := schema.ScheduleHistory{}.Fields()
+	_ = schedulehistoryFields
+	// schedulehistoryDescScheduleID is the schema descriptor for schedule_id field.
+	schedulehistoryDescScheduleID := schedulehistoryFields[1].Descriptor()
+	// schedulehistory.DefaultScheduleID holds the default value on creation for the schedule_id field.
+	schedulehistory.DefaultScheduleID = schedulehistoryDescScheduleID.Default.(int)
+	// schedulehistoryDescModelingID is the schema descriptor for modeling_id field.
+	schedulehistoryDescModelingID := schedulehistoryFields[2].Descriptor()
+	// schedulehistory.DefaultModelingID holds the default value on creation for the modeling_id field.
+	schedulehistory.DefaultModelingID = schedulehistoryDescModelingID.Default.(int)
+	// schedulehistoryDescTrigger is the schema descriptor for trigger field.
+	schedulehistoryDescTrigger := schedulehistoryFields[3].Descriptor()
+	// schedulehistory.DefaultTrigger holds the default value on creation for the trigger field.
+	schedulehistory.DefaultTrigger = schedulehistoryDescTrigger.Default.(string)
+	// schedulehistoryDescStatus is the schema descriptor for status field.
+	schedulehistoryDescStatus := schedulehistoryFields[4].Descriptor()
+	// schedulehistory.DefaultStatus holds the default value on creation for the status field.
+	schedulehistory.DefaultStatus = schedulehistoryDescStatus.Default.(string)
+	// schedulehistoryDescMessage is the schema descriptor for message field.
+	schedulehistoryDescMessage := schedulehistoryFields[5].Descriptor()
+	// schedulehistory.DefaultMessage holds the default value on creation for the message field.
+	schedulehistory.DefaultMessage = schedulehistoryDescMessage.Default.(string)
+	// schedulehistoryDescCreatedAt is the schema descriptor for created_at field.
+	schedulehistoryDescCreatedAt := schedulehistoryFields[6].Descriptor()
+	// schedulehistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	schedulehistory.DefaultCreatedAt = schedulehistoryDescCreatedAt.Default.(func() time.Time)
 	taskFields := schema.Task{}.Fields()
 	_ = taskFields
 	// taskDescProjectID is the schema descriptor for project_id field.
@@ -308,12 +629,20 @@ func init() {
 	taskDescParams := taskFields[7].Descriptor()
 	// task.DefaultParams holds the default value on creation for the params field.
 	task.DefaultParams = taskDescParams.Default.([]string)
+	// taskDescPipelineStatus is the schema descriptor for pipeline_status field.
+	taskDescPipelineStatus := taskFields[9].Descriptor()
+	// task.DefaultPipelineStatus holds the default value on creation for the pipeline_status field.
+	task.DefaultPipelineStatus = taskDescPipelineStatus.Default.(string)
+	// taskDescSearchStatus is the schema descriptor for search_status field.
+	taskDescSearchStatus := taskFields[11].Descriptor()
+	// task.DefaultSearchStatus holds the default value on creation for the search_status field.
+	task.DefaultSearchStatus = taskDescSearchStatus.Default.(string)
 	// taskDescCreatedAt is the schema descriptor for created_at field.
-	taskDescCreatedAt := taskFields[8].Descriptor()
+	taskDescCreatedAt := taskFields[13].Descriptor()
 	// task.DefaultCreatedAt holds the default value on creation for the created_at field.
 	task.DefaultCreatedAt = taskDescCreatedAt.Default.(func() time.Time)
 	// taskDescUpdatedAt is the schema descriptor for updated_at field.
-	taskDescUpdatedAt := taskFields[9].Descriptor()
+	taskDescUpdatedAt := taskFields[14].Descriptor()
 	// task.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	task.DefaultUpdatedAt = taskDescUpdatedAt.Default.(func() time.Time)
 	trialFields := schema.Trial{}.Fields()
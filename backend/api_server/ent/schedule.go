@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/schedule"
+	"api_server/ent/task"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// task 재학습 일정: cron | dataset 변경
+type Schedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Parent task ID
+	TaskID int `json:"task_id,omitempty"`
+	// Modeling whose params are used for retraining
+	ParentID int `json:"parent_id,omitempty"`
+	// cron | dataset
+	Trigger string `json:"trigger,omitempty"`
+	// minute hour day month weekday
+	Cron string `json:"cron,omitempty"`
+	// Dataset watched by dataset trigger
+	DatasetID int `json:"dataset_id,omitempty"`
+	// Paused holds the value of the "paused" field.
+	Paused bool `json:"paused,omitempty"`
+	// Queue priority of created modelings
+	Priority int `json:"priority,omitempty"`
+	// User who created the schedule
+	Username string `json:"username,omitempty"`
+	// Dataset fingerprint when the dataset trigger last created a modeling
+	TrainedFingerprint string `json:"trained_fingerprint,omitempty"`
+	// Next cron run
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	// LastRunAt holds the value of the "last_run_at" field.
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduleQuery when eager-loading is set.
+	Edges        ScheduleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScheduleEdges holds the relations/edges for other nodes in the graph.
+type ScheduleEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// Histories holds the value of the histories edge.
+	Histories []*ScheduleHistory `json:"histories,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduleEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// HistoriesOrErr returns the Histories value or an error if the edge
+// was not loaded in eager-loading.
+func (e ScheduleEdges) HistoriesOrErr() ([]*ScheduleHistory, error) {
+	if e.loadedTypes[1] {
+		return e.Histories, nil
+	}
+	return nil, &NotLoadedError{edge: "histories"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Schedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schedule.FieldPaused:
+			values[i] = new(sql.NullBool)
+		case schedule.FieldID, schedule.FieldTaskID, schedule.FieldParentID, schedule.FieldDatasetID, schedule.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case schedule.FieldTrigger, schedule.FieldCron, schedule.FieldUsername, schedule.FieldTrainedFingerprint:
+			values[i] = new(sql.NullString)
+		case schedule.FieldNextRunAt, schedule.FieldLastRunAt, schedule.FieldCreatedAt, schedule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Schedule fields.
+func (s *Schedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schedule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case schedule.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				s.TaskID = int(value.Int64)
+			}
+		case schedule.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				s.ParentID = int(value.Int64)
+			}
+		case schedule.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				s.Trigger = value.String
+			}
+		case schedule.FieldCron:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron", values[i])
+			} else if value.Valid {
+				s.Cron = value.String
+			}
+		case schedule.FieldDatasetID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field dataset_id", values[i])
+			} else if value.Valid {
+				s.DatasetID = int(value.Int64)
+			}
+		case schedule.FieldPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paused", values[i])
+			} else if value.Valid {
+				s.Paused = value.Bool
+			}
+		case schedule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				s.Priority = int(value.Int64)
+			}
+		case schedule.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				s.Username = value.String
+			}
+		case schedule.FieldTrainedFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trained_fingerprint", values[i])
+			} else if value.Valid {
+				s.TrainedFingerprint = value.String
+			}
+		case schedule.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				s.NextRunAt = new(time.Time)
+				*s.NextRunAt = value.Time
+			}
+		case schedule.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				s.LastRunAt = new(time.Time)
+				*s.LastRunAt = value.Time
+			}
+		case schedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case schedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Schedule.
+// This includes values selected through modifiers, order, etc.
+func (s *Schedule) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the Schedule entity.
+func (s *Schedule) QueryTask() *TaskQuery {
+	return NewScheduleClient(s.config).QueryTask(s)
+}
+
+// QueryHistories queries the "histories" edge of the Schedule entity.
+func (s *Schedule) QueryHistories() *ScheduleHistoryQuery {
+	return NewScheduleClient(s.config).QueryHistories(s)
+}
+
+// Update returns a builder for updating this Schedule.
+// Note that you need to call Schedule.Unwrap() before calling this method if this Schedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Schedule) Update() *ScheduleUpdateOne {
+	return NewScheduleClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Schedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Schedule) Unwrap() *Schedule {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Schedule is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Schedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("Schedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("parent_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.ParentID))
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(s.Trigger)
+	builder.WriteString(", ")
+	builder.WriteString("cron=")
+	builder.WriteString(s.Cron)
+	builder.WriteString(", ")
+	builder.WriteString("dataset_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.DatasetID))
+	builder.WriteString(", ")
+	builder.WriteString("paused=")
+	builder.WriteString(fmt.Sprintf("%v", s.Paused))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", s.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(s.Username)
+	builder.WriteString(", ")
+	builder.WriteString("trained_fingerprint=")
+	builder.WriteString(s.TrainedFingerprint)
+	builder.WriteString(", ")
+	if v := s.NextRunAt; v != nil {
+		builder.WriteString("next_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Schedules is a parsable slice of Schedule.
+type Schedules []*Schedule
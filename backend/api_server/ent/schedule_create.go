@@ -0,0 +1,1403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/schedule"
+	"api_server/ent/schedulehistory"
+	"api_server/ent/task"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleCreate is the builder for creating a Schedule entity.
+type ScheduleCreate struct {
+	config
+	mutation *ScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTaskID sets the "task_id" field.
+func (sc *ScheduleCreate) SetTaskID(i int) *ScheduleCreate {
+	sc.mutation.SetTaskID(i)
+	return sc
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableTaskID(i *int) *ScheduleCreate {
+	if i != nil {
+		sc.SetTaskID(*i)
+	}
+	return sc
+}
+
+// SetParentID sets the "parent_id" field.
+func (sc *ScheduleCreate) SetParentID(i int) *ScheduleCreate {
+	sc.mutation.SetParentID(i)
+	return sc
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableParentID(i *int) *ScheduleCreate {
+	if i != nil {
+		sc.SetParentID(*i)
+	}
+	return sc
+}
+
+// SetTrigger sets the "trigger" field.
+func (sc *ScheduleCreate) SetTrigger(s string) *ScheduleCreate {
+	sc.mutation.SetTrigger(s)
+	return sc
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableTrigger(s *string) *ScheduleCreate {
+	if s != nil {
+		sc.SetTrigger(*s)
+	}
+	return sc
+}
+
+// SetCron sets the "cron" field.
+func (sc *ScheduleCreate) SetCron(s string) *ScheduleCreate {
+	sc.mutation.SetCron(s)
+	return sc
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableCron(s *string) *ScheduleCreate {
+	if s != nil {
+		sc.SetCron(*s)
+	}
+	return sc
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (sc *ScheduleCreate) SetDatasetID(i int) *ScheduleCreate {
+	sc.mutation.SetDatasetID(i)
+	return sc
+}
+
+// SetNillableDatasetID sets the "dataset_id" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableDatasetID(i *int) *ScheduleCreate {
+	if i != nil {
+		sc.SetDatasetID(*i)
+	}
+	return sc
+}
+
+// SetPaused sets the "paused" field.
+func (sc *ScheduleCreate) SetPaused(b bool) *ScheduleCreate {
+	sc.mutation.SetPaused(b)
+	return sc
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillablePaused(b *bool) *ScheduleCreate {
+	if b != nil {
+		sc.SetPaused(*b)
+	}
+	return sc
+}
+
+// SetPriority sets the "priority" field.
+func (sc *ScheduleCreate) SetPriority(i int) *ScheduleCreate {
+	sc.mutation.SetPriority(i)
+	return sc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillablePriority(i *int) *ScheduleCreate {
+	if i != nil {
+		sc.SetPriority(*i)
+	}
+	return sc
+}
+
+// SetUsername sets the "username" field.
+func (sc *ScheduleCreate) SetUsername(s string) *ScheduleCreate {
+	sc.mutation.SetUsername(s)
+	return sc
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableUsername(s *string) *ScheduleCreate {
+	if s != nil {
+		sc.SetUsername(*s)
+	}
+	return sc
+}
+
+// SetTrainedFingerprint sets the "trained_fingerprint" field.
+func (sc *ScheduleCreate) SetTrainedFingerprint(s string) *ScheduleCreate {
+	sc.mutation.SetTrainedFingerprint(s)
+	return sc
+}
+
+// SetNillableTrainedFingerprint sets the "trained_fingerprint" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableTrainedFingerprint(s *string) *ScheduleCreate {
+	if s != nil {
+		sc.SetTrainedFingerprint(*s)
+	}
+	return sc
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (sc *ScheduleCreate) SetNextRunAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetNextRunAt(t)
+	return sc
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableNextRunAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetNextRunAt(*t)
+	}
+	return sc
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (sc *ScheduleCreate) SetLastRunAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetLastRunAt(t)
+	return sc
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableLastRunAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetLastRunAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ScheduleCreate) SetCreatedAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableCreatedAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *ScheduleCreate) SetUpdatedAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableUpdatedAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *ScheduleCreate) SetID(i int) *ScheduleCreate {
+	sc.mutation.SetID(i)
+	return sc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (sc *ScheduleCreate) SetTask(t *Task) *ScheduleCreate {
+	return sc.SetTaskID(t.ID)
+}
+
+// AddHistoryIDs adds the "histories" edge to the ScheduleHistory entity by IDs.
+func (sc *ScheduleCreate) AddHistoryIDs(ids ...int) *ScheduleCreate {
+	sc.mutation.AddHistoryIDs(ids...)
+	return sc
+}
+
+// AddHistories adds the "histories" edges to the ScheduleHistory entity.
+func (sc *ScheduleCreate) AddHistories(s ...*ScheduleHistory) *ScheduleCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddHistoryIDs(ids...)
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (sc *ScheduleCreate) Mutation() *ScheduleMutation {
+	return sc.mutation
+}
+
+// Save creates the Schedule in the database.
+func (sc *ScheduleCreate) Save(ctx context.Context) (*Schedule, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ScheduleCreate) SaveX(ctx context.Context) *Schedule {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ScheduleCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ScheduleCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ScheduleCreate) defaults() {
+	if _, ok := sc.mutation.TaskID(); !ok {
+		v := schedule.DefaultTaskID
+		sc.mutation.SetTaskID(v)
+	}
+	if _, ok := sc.mutation.ParentID(); !ok {
+		v := schedule.DefaultParentID
+		sc.mutation.SetParentID(v)
+	}
+	if _, ok := sc.mutation.Trigger(); !ok {
+		v := schedule.DefaultTrigger
+		sc.mutation.SetTrigger(v)
+	}
+	if _, ok := sc.mutation.Cron(); !ok {
+		v := schedule.DefaultCron
+		sc.mutation.SetCron(v)
+	}
+	if _, ok := sc.mutation.DatasetID(); !ok {
+		v := schedule.DefaultDatasetID
+		sc.mutation.SetDatasetID(v)
+	}
+	if _, ok := sc.mutation.Paused(); !ok {
+		v := schedule.DefaultPaused
+		sc.mutation.SetPaused(v)
+	}
+	if _, ok := sc.mutation.Priority(); !ok {
+		v := schedule.DefaultPriority
+		sc.mutation.SetPriority(v)
+	}
+	if _, ok := sc.mutation.Username(); !ok {
+		v := schedule.DefaultUsername
+		sc.mutation.SetUsername(v)
+	}
+	if _, ok := sc.mutation.TrainedFingerprint(); !ok {
+		v := schedule.DefaultTrainedFingerprint
+		sc.mutation.SetTrainedFingerprint(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := schedule.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := schedule.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ScheduleCreate) check() error {
+	if _, ok := sc.mutation.ParentID(); !ok {
+		return &ValidationError{Name: "parent_id", err: errors.New(`ent: missing required field "Schedule.parent_id"`)}
+	}
+	if _, ok := sc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "Schedule.trigger"`)}
+	}
+	if _, ok := sc.mutation.Cron(); !ok {
+		return &ValidationError{Name: "cron", err: errors.New(`ent: missing required field "Schedule.cron"`)}
+	}
+	if _, ok := sc.mutation.DatasetID(); !ok {
+		return &ValidationError{Name: "dataset_id", err: errors.New(`ent: missing required field "Schedule.dataset_id"`)}
+	}
+	if _, ok := sc.mutation.Paused(); !ok {
+		return &ValidationError{Name: "paused", err: errors.New(`ent: missing required field "Schedule.paused"`)}
+	}
+	if _, ok := sc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Schedule.priority"`)}
+	}
+	if _, ok := sc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "Schedule.username"`)}
+	}
+	if _, ok := sc.mutation.TrainedFingerprint(); !ok {
+		return &ValidationError{Name: "trained_fingerprint", err: errors.New(`ent: missing required field "Schedule.trained_fingerprint"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Schedule.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Schedule.updated_at"`)}
+	}
+	return nil
+}
+
+func (sc *ScheduleCreate) sqlSave(ctx context.Context) (*Schedule, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *ScheduleCreate) createSpec() (*Schedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Schedule{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(schedule.Table, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = sc.conflict
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.ParentID(); ok {
+		_spec.SetField(schedule.FieldParentID, field.TypeInt, value)
+		_node.ParentID = value
+	}
+	if value, ok := sc.mutation.Trigger(); ok {
+		_spec.SetField(schedule.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := sc.mutation.Cron(); ok {
+		_spec.SetField(schedule.FieldCron, field.TypeString, value)
+		_node.Cron = value
+	}
+	if value, ok := sc.mutation.DatasetID(); ok {
+		_spec.SetField(schedule.FieldDatasetID, field.TypeInt, value)
+		_node.DatasetID = value
+	}
+	if value, ok := sc.mutation.Paused(); ok {
+		_spec.SetField(schedule.FieldPaused, field.TypeBool, value)
+		_node.Paused = value
+	}
+	if value, ok := sc.mutation.Priority(); ok {
+		_spec.SetField(schedule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := sc.mutation.Username(); ok {
+		_spec.SetField(schedule.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := sc.mutation.TrainedFingerprint(); ok {
+		_spec.SetField(schedule.FieldTrainedFingerprint, field.TypeString, value)
+		_node.TrainedFingerprint = value
+	}
+	if value, ok := sc.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = &value
+	}
+	if value, ok := sc.mutation.LastRunAt(); ok {
+		_spec.SetField(schedule.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(schedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := sc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schedule.TaskTable,
+			Columns: []string{schedule.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.HistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.HistoriesTable,
+			Columns: []string{schedule.HistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Schedule.Create().
+//		SetTaskID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (sc *ScheduleCreate) OnConflict(opts ...sql.ConflictOption) *ScheduleUpsertOne {
+	sc.conflict = opts
+	return &ScheduleUpsertOne{
+		create: sc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sc *ScheduleCreate) OnConflictColumns(columns ...string) *ScheduleUpsertOne {
+	sc.conflict = append(sc.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleUpsertOne{
+		create: sc,
+	}
+}
+
+type (
+	// ScheduleUpsertOne is the builder for "upsert"-ing
+	//  one Schedule node.
+	ScheduleUpsertOne struct {
+		create *ScheduleCreate
+	}
+
+	// ScheduleUpsert is the "OnConflict" setter.
+	ScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTaskID sets the "task_id" field.
+func (u *ScheduleUpsert) SetTaskID(v int) *ScheduleUpsert {
+	u.Set(schedule.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateTaskID() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldTaskID)
+	return u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *ScheduleUpsert) ClearTaskID() *ScheduleUpsert {
+	u.SetNull(schedule.FieldTaskID)
+	return u
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *ScheduleUpsert) SetParentID(v int) *ScheduleUpsert {
+	u.Set(schedule.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateParentID() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldParentID)
+	return u
+}
+
+// AddParentID adds v to the "parent_id" field.
+func (u *ScheduleUpsert) AddParentID(v int) *ScheduleUpsert {
+	u.Add(schedule.FieldParentID, v)
+	return u
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *ScheduleUpsert) SetTrigger(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldTrigger, v)
+	return u
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateTrigger() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldTrigger)
+	return u
+}
+
+// SetCron sets the "cron" field.
+func (u *ScheduleUpsert) SetCron(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldCron, v)
+	return u
+}
+
+// UpdateCron sets the "cron" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateCron() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldCron)
+	return u
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (u *ScheduleUpsert) SetDatasetID(v int) *ScheduleUpsert {
+	u.Set(schedule.FieldDatasetID, v)
+	return u
+}
+
+// UpdateDatasetID sets the "dataset_id" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateDatasetID() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldDatasetID)
+	return u
+}
+
+// AddDatasetID adds v to the "dataset_id" field.
+func (u *ScheduleUpsert) AddDatasetID(v int) *ScheduleUpsert {
+	u.Add(schedule.FieldDatasetID, v)
+	return u
+}
+
+// SetPaused sets the "paused" field.
+func (u *ScheduleUpsert) SetPaused(v bool) *ScheduleUpsert {
+	u.Set(schedule.FieldPaused, v)
+	return u
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdatePaused() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldPaused)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *ScheduleUpsert) SetPriority(v int) *ScheduleUpsert {
+	u.Set(schedule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdatePriority() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *ScheduleUpsert) AddPriority(v int) *ScheduleUpsert {
+	u.Add(schedule.FieldPriority, v)
+	return u
+}
+
+// SetUsername sets the "username" field.
+func (u *ScheduleUpsert) SetUsername(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldUsername, v)
+	return u
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateUsername() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldUsername)
+	return u
+}
+
+// SetTrainedFingerprint sets the "trained_fingerprint" field.
+func (u *ScheduleUpsert) SetTrainedFingerprint(v string) *ScheduleUpsert {
+	u.Set(schedule.FieldTrainedFingerprint, v)
+	return u
+}
+
+// UpdateTrainedFingerprint sets the "trained_fingerprint" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateTrainedFingerprint() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldTrainedFingerprint)
+	return u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduleUpsert) SetNextRunAt(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldNextRunAt, v)
+	return u
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateNextRunAt() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldNextRunAt)
+	return u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduleUpsert) ClearNextRunAt() *ScheduleUpsert {
+	u.SetNull(schedule.FieldNextRunAt)
+	return u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScheduleUpsert) SetLastRunAt(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldLastRunAt, v)
+	return u
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateLastRunAt() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldLastRunAt)
+	return u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScheduleUpsert) ClearLastRunAt() *ScheduleUpsert {
+	u.SetNull(schedule.FieldLastRunAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduleUpsert) SetUpdatedAt(v time.Time) *ScheduleUpsert {
+	u.Set(schedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduleUpsert) UpdateUpdatedAt() *ScheduleUpsert {
+	u.SetExcluded(schedule.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(schedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduleUpsertOne) UpdateNewValues() *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(schedule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(schedule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScheduleUpsertOne) Ignore() *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleUpsertOne) DoNothing() *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleCreate.OnConflict
+// documentation for more info.
+func (u *ScheduleUpsertOne) Update(set func(*ScheduleUpsert)) *ScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *ScheduleUpsertOne) SetTaskID(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateTaskID() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *ScheduleUpsertOne) ClearTaskID() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *ScheduleUpsertOne) SetParentID(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// AddParentID adds v to the "parent_id" field.
+func (u *ScheduleUpsertOne) AddParentID(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateParentID() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *ScheduleUpsertOne) SetTrigger(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateTrigger() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetCron sets the "cron" field.
+func (u *ScheduleUpsertOne) SetCron(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetCron(v)
+	})
+}
+
+// UpdateCron sets the "cron" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateCron() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateCron()
+	})
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (u *ScheduleUpsertOne) SetDatasetID(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetDatasetID(v)
+	})
+}
+
+// AddDatasetID adds v to the "dataset_id" field.
+func (u *ScheduleUpsertOne) AddDatasetID(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddDatasetID(v)
+	})
+}
+
+// UpdateDatasetID sets the "dataset_id" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateDatasetID() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateDatasetID()
+	})
+}
+
+// SetPaused sets the "paused" field.
+func (u *ScheduleUpsertOne) SetPaused(v bool) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdatePaused() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdatePaused()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *ScheduleUpsertOne) SetPriority(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *ScheduleUpsertOne) AddPriority(v int) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdatePriority() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *ScheduleUpsertOne) SetUsername(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateUsername() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetTrainedFingerprint sets the "trained_fingerprint" field.
+func (u *ScheduleUpsertOne) SetTrainedFingerprint(v string) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetTrainedFingerprint(v)
+	})
+}
+
+// UpdateTrainedFingerprint sets the "trained_fingerprint" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateTrainedFingerprint() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateTrainedFingerprint()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduleUpsertOne) SetNextRunAt(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateNextRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduleUpsertOne) ClearNextRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScheduleUpsertOne) SetLastRunAt(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateLastRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScheduleUpsertOne) ClearLastRunAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduleUpsertOne) SetUpdatedAt(v time.Time) *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduleUpsertOne) UpdateUpdatedAt() *ScheduleUpsertOne {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScheduleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScheduleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScheduleCreateBulk is the builder for creating many Schedule entities in bulk.
+type ScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Schedule entities in the database.
+func (scb *ScheduleCreateBulk) Save(ctx context.Context) ([]*Schedule, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Schedule, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = scb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ScheduleCreateBulk) SaveX(ctx context.Context) []*Schedule {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Schedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (scb *ScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScheduleUpsertBulk {
+	scb.conflict = opts
+	return &ScheduleUpsertBulk{
+		create: scb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scb *ScheduleCreateBulk) OnConflictColumns(columns ...string) *ScheduleUpsertBulk {
+	scb.conflict = append(scb.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleUpsertBulk{
+		create: scb,
+	}
+}
+
+// ScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of Schedule nodes.
+type ScheduleUpsertBulk struct {
+	create *ScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(schedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduleUpsertBulk) UpdateNewValues() *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(schedule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(schedule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Schedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScheduleUpsertBulk) Ignore() *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleUpsertBulk) DoNothing() *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScheduleUpsertBulk) Update(set func(*ScheduleUpsert)) *ScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *ScheduleUpsertBulk) SetTaskID(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateTaskID() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *ScheduleUpsertBulk) ClearTaskID() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *ScheduleUpsertBulk) SetParentID(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// AddParentID adds v to the "parent_id" field.
+func (u *ScheduleUpsertBulk) AddParentID(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateParentID() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *ScheduleUpsertBulk) SetTrigger(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateTrigger() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetCron sets the "cron" field.
+func (u *ScheduleUpsertBulk) SetCron(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetCron(v)
+	})
+}
+
+// UpdateCron sets the "cron" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateCron() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateCron()
+	})
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (u *ScheduleUpsertBulk) SetDatasetID(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetDatasetID(v)
+	})
+}
+
+// AddDatasetID adds v to the "dataset_id" field.
+func (u *ScheduleUpsertBulk) AddDatasetID(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddDatasetID(v)
+	})
+}
+
+// UpdateDatasetID sets the "dataset_id" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateDatasetID() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateDatasetID()
+	})
+}
+
+// SetPaused sets the "paused" field.
+func (u *ScheduleUpsertBulk) SetPaused(v bool) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdatePaused() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdatePaused()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *ScheduleUpsertBulk) SetPriority(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *ScheduleUpsertBulk) AddPriority(v int) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdatePriority() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetUsername sets the "username" field.
+func (u *ScheduleUpsertBulk) SetUsername(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetUsername(v)
+	})
+}
+
+// UpdateUsername sets the "username" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateUsername() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateUsername()
+	})
+}
+
+// SetTrainedFingerprint sets the "trained_fingerprint" field.
+func (u *ScheduleUpsertBulk) SetTrainedFingerprint(v string) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetTrainedFingerprint(v)
+	})
+}
+
+// UpdateTrainedFingerprint sets the "trained_fingerprint" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateTrainedFingerprint() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateTrainedFingerprint()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ScheduleUpsertBulk) SetNextRunAt(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateNextRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ScheduleUpsertBulk) ClearNextRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *ScheduleUpsertBulk) SetLastRunAt(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateLastRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *ScheduleUpsertBulk) ClearLastRunAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ScheduleUpsertBulk) SetUpdatedAt(v time.Time) *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ScheduleUpsertBulk) UpdateUpdatedAt() *ScheduleUpsertBulk {
+	return u.Update(func(s *ScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
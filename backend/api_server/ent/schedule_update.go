@@ -0,0 +1,946 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/predicate"
+	"api_server/ent/schedule"
+	"api_server/ent/schedulehistory"
+	"api_server/ent/task"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleUpdate is the builder for updating Schedule entities.
+type ScheduleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScheduleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScheduleUpdate builder.
+func (su *ScheduleUpdate) Where(ps ...predicate.Schedule) *ScheduleUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetTaskID sets the "task_id" field.
+func (su *ScheduleUpdate) SetTaskID(i int) *ScheduleUpdate {
+	su.mutation.SetTaskID(i)
+	return su
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableTaskID(i *int) *ScheduleUpdate {
+	if i != nil {
+		su.SetTaskID(*i)
+	}
+	return su
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (su *ScheduleUpdate) ClearTaskID() *ScheduleUpdate {
+	su.mutation.ClearTaskID()
+	return su
+}
+
+// SetParentID sets the "parent_id" field.
+func (su *ScheduleUpdate) SetParentID(i int) *ScheduleUpdate {
+	su.mutation.ResetParentID()
+	su.mutation.SetParentID(i)
+	return su
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableParentID(i *int) *ScheduleUpdate {
+	if i != nil {
+		su.SetParentID(*i)
+	}
+	return su
+}
+
+// AddParentID adds i to the "parent_id" field.
+func (su *ScheduleUpdate) AddParentID(i int) *ScheduleUpdate {
+	su.mutation.AddParentID(i)
+	return su
+}
+
+// SetTrigger sets the "trigger" field.
+func (su *ScheduleUpdate) SetTrigger(s string) *ScheduleUpdate {
+	su.mutation.SetTrigger(s)
+	return su
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableTrigger(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetTrigger(*s)
+	}
+	return su
+}
+
+// SetCron sets the "cron" field.
+func (su *ScheduleUpdate) SetCron(s string) *ScheduleUpdate {
+	su.mutation.SetCron(s)
+	return su
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableCron(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetCron(*s)
+	}
+	return su
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (su *ScheduleUpdate) SetDatasetID(i int) *ScheduleUpdate {
+	su.mutation.ResetDatasetID()
+	su.mutation.SetDatasetID(i)
+	return su
+}
+
+// SetNillableDatasetID sets the "dataset_id" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableDatasetID(i *int) *ScheduleUpdate {
+	if i != nil {
+		su.SetDatasetID(*i)
+	}
+	return su
+}
+
+// AddDatasetID adds i to the "dataset_id" field.
+func (su *ScheduleUpdate) AddDatasetID(i int) *ScheduleUpdate {
+	su.mutation.AddDatasetID(i)
+	return su
+}
+
+// SetPaused sets the "paused" field.
+func (su *ScheduleUpdate) SetPaused(b bool) *ScheduleUpdate {
+	su.mutation.SetPaused(b)
+	return su
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillablePaused(b *bool) *ScheduleUpdate {
+	if b != nil {
+		su.SetPaused(*b)
+	}
+	return su
+}
+
+// SetPriority sets the "priority" field.
+func (su *ScheduleUpdate) SetPriority(i int) *ScheduleUpdate {
+	su.mutation.ResetPriority()
+	su.mutation.SetPriority(i)
+	return su
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillablePriority(i *int) *ScheduleUpdate {
+	if i != nil {
+		su.SetPriority(*i)
+	}
+	return su
+}
+
+// AddPriority adds i to the "priority" field.
+func (su *ScheduleUpdate) AddPriority(i int) *ScheduleUpdate {
+	su.mutation.AddPriority(i)
+	return su
+}
+
+// SetUsername sets the "username" field.
+func (su *ScheduleUpdate) SetUsername(s string) *ScheduleUpdate {
+	su.mutation.SetUsername(s)
+	return su
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableUsername(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetUsername(*s)
+	}
+	return su
+}
+
+// SetTrainedFingerprint sets the "trained_fingerprint" field.
+func (su *ScheduleUpdate) SetTrainedFingerprint(s string) *ScheduleUpdate {
+	su.mutation.SetTrainedFingerprint(s)
+	return su
+}
+
+// SetNillableTrainedFingerprint sets the "trained_fingerprint" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableTrainedFingerprint(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetTrainedFingerprint(*s)
+	}
+	return su
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (su *ScheduleUpdate) SetNextRunAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetNextRunAt(t)
+	return su
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableNextRunAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetNextRunAt(*t)
+	}
+	return su
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (su *ScheduleUpdate) ClearNextRunAt() *ScheduleUpdate {
+	su.mutation.ClearNextRunAt()
+	return su
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (su *ScheduleUpdate) SetLastRunAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetLastRunAt(t)
+	return su
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableLastRunAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetLastRunAt(*t)
+	}
+	return su
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (su *ScheduleUpdate) ClearLastRunAt() *ScheduleUpdate {
+	su.mutation.ClearLastRunAt()
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *ScheduleUpdate) SetUpdatedAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableUpdatedAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetUpdatedAt(*t)
+	}
+	return su
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (su *ScheduleUpdate) SetTask(t *Task) *ScheduleUpdate {
+	return su.SetTaskID(t.ID)
+}
+
+// AddHistoryIDs adds the "histories" edge to the ScheduleHistory entity by IDs.
+func (su *ScheduleUpdate) AddHistoryIDs(ids ...int) *ScheduleUpdate {
+	su.mutation.AddHistoryIDs(ids...)
+	return su
+}
+
+// AddHistories adds the "histories" edges to the ScheduleHistory entity.
+func (su *ScheduleUpdate) AddHistories(s ...*ScheduleHistory) *ScheduleUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddHistoryIDs(ids...)
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (su *ScheduleUpdate) Mutation() *ScheduleMutation {
+	return su.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (su *ScheduleUpdate) ClearTask() *ScheduleUpdate {
+	su.mutation.ClearTask()
+	return su
+}
+
+// ClearHistories clears all "histories" edges to the ScheduleHistory entity.
+func (su *ScheduleUpdate) ClearHistories() *ScheduleUpdate {
+	su.mutation.ClearHistories()
+	return su
+}
+
+// RemoveHistoryIDs removes the "histories" edge to ScheduleHistory entities by IDs.
+func (su *ScheduleUpdate) RemoveHistoryIDs(ids ...int) *ScheduleUpdate {
+	su.mutation.RemoveHistoryIDs(ids...)
+	return su
+}
+
+// RemoveHistories removes "histories" edges to ScheduleHistory entities.
+func (su *ScheduleUpdate) RemoveHistories(s ...*ScheduleHistory) *ScheduleUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveHistoryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ScheduleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ScheduleUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (su *ScheduleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduleUpdate {
+	su.modifiers = append(su.modifiers, modifiers...)
+	return su
+}
+
+func (su *ScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(schedule.Table, schedule.Columns, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.ParentID(); ok {
+		_spec.SetField(schedule.FieldParentID, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedParentID(); ok {
+		_spec.AddField(schedule.FieldParentID, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Trigger(); ok {
+		_spec.SetField(schedule.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Cron(); ok {
+		_spec.SetField(schedule.FieldCron, field.TypeString, value)
+	}
+	if value, ok := su.mutation.DatasetID(); ok {
+		_spec.SetField(schedule.FieldDatasetID, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedDatasetID(); ok {
+		_spec.AddField(schedule.FieldDatasetID, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Paused(); ok {
+		_spec.SetField(schedule.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.Priority(); ok {
+		_spec.SetField(schedule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedPriority(); ok {
+		_spec.AddField(schedule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Username(); ok {
+		_spec.SetField(schedule.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := su.mutation.TrainedFingerprint(); ok {
+		_spec.SetField(schedule.FieldTrainedFingerprint, field.TypeString, value)
+	}
+	if value, ok := su.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if su.mutation.NextRunAtCleared() {
+		_spec.ClearField(schedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.LastRunAt(); ok {
+		_spec.SetField(schedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if su.mutation.LastRunAtCleared() {
+		_spec.ClearField(schedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if su.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schedule.TaskTable,
+			Columns: []string{schedule.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schedule.TaskTable,
+			Columns: []string{schedule.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.HistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.HistoriesTable,
+			Columns: []string{schedule.HistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulehistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedHistoriesIDs(); len(nodes) > 0 && !su.mutation.HistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.HistoriesTable,
+			Columns: []string{schedule.HistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.HistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.HistoriesTable,
+			Columns: []string{schedule.HistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(su.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// ScheduleUpdateOne is the builder for updating a single Schedule entity.
+type ScheduleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScheduleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTaskID sets the "task_id" field.
+func (suo *ScheduleUpdateOne) SetTaskID(i int) *ScheduleUpdateOne {
+	suo.mutation.SetTaskID(i)
+	return suo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableTaskID(i *int) *ScheduleUpdateOne {
+	if i != nil {
+		suo.SetTaskID(*i)
+	}
+	return suo
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (suo *ScheduleUpdateOne) ClearTaskID() *ScheduleUpdateOne {
+	suo.mutation.ClearTaskID()
+	return suo
+}
+
+// SetParentID sets the "parent_id" field.
+func (suo *ScheduleUpdateOne) SetParentID(i int) *ScheduleUpdateOne {
+	suo.mutation.ResetParentID()
+	suo.mutation.SetParentID(i)
+	return suo
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableParentID(i *int) *ScheduleUpdateOne {
+	if i != nil {
+		suo.SetParentID(*i)
+	}
+	return suo
+}
+
+// AddParentID adds i to the "parent_id" field.
+func (suo *ScheduleUpdateOne) AddParentID(i int) *ScheduleUpdateOne {
+	suo.mutation.AddParentID(i)
+	return suo
+}
+
+// SetTrigger sets the "trigger" field.
+func (suo *ScheduleUpdateOne) SetTrigger(s string) *ScheduleUpdateOne {
+	suo.mutation.SetTrigger(s)
+	return suo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableTrigger(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetTrigger(*s)
+	}
+	return suo
+}
+
+// SetCron sets the "cron" field.
+func (suo *ScheduleUpdateOne) SetCron(s string) *ScheduleUpdateOne {
+	suo.mutation.SetCron(s)
+	return suo
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableCron(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetCron(*s)
+	}
+	return suo
+}
+
+// SetDatasetID sets the "dataset_id" field.
+func (suo *ScheduleUpdateOne) SetDatasetID(i int) *ScheduleUpdateOne {
+	suo.mutation.ResetDatasetID()
+	suo.mutation.SetDatasetID(i)
+	return suo
+}
+
+// SetNillableDatasetID sets the "dataset_id" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableDatasetID(i *int) *ScheduleUpdateOne {
+	if i != nil {
+		suo.SetDatasetID(*i)
+	}
+	return suo
+}
+
+// AddDatasetID adds i to the "dataset_id" field.
+func (suo *ScheduleUpdateOne) AddDatasetID(i int) *ScheduleUpdateOne {
+	suo.mutation.AddDatasetID(i)
+	return suo
+}
+
+// SetPaused sets the "paused" field.
+func (suo *ScheduleUpdateOne) SetPaused(b bool) *ScheduleUpdateOne {
+	suo.mutation.SetPaused(b)
+	return suo
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillablePaused(b *bool) *ScheduleUpdateOne {
+	if b != nil {
+		suo.SetPaused(*b)
+	}
+	return suo
+}
+
+// SetPriority sets the "priority" field.
+func (suo *ScheduleUpdateOne) SetPriority(i int) *ScheduleUpdateOne {
+	suo.mutation.ResetPriority()
+	suo.mutation.SetPriority(i)
+	return suo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillablePriority(i *int) *ScheduleUpdateOne {
+	if i != nil {
+		suo.SetPriority(*i)
+	}
+	return suo
+}
+
+// AddPriority adds i to the "priority" field.
+func (suo *ScheduleUpdateOne) AddPriority(i int) *ScheduleUpdateOne {
+	suo.mutation.AddPriority(i)
+	return suo
+}
+
+// SetUsername sets the "username" field.
+func (suo *ScheduleUpdateOne) SetUsername(s string) *ScheduleUpdateOne {
+	suo.mutation.SetUsername(s)
+	return suo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableUsername(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetUsername(*s)
+	}
+	return suo
+}
+
+// SetTrainedFingerprint sets the "trained_fingerprint" field.
+func (suo *ScheduleUpdateOne) SetTrainedFingerprint(s string) *ScheduleUpdateOne {
+	suo.mutation.SetTrainedFingerprint(s)
+	return suo
+}
+
+// SetNillableTrainedFingerprint sets the "trained_fingerprint" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableTrainedFingerprint(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetTrainedFingerprint(*s)
+	}
+	return suo
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (suo *ScheduleUpdateOne) SetNextRunAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetNextRunAt(t)
+	return suo
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableNextRunAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetNextRunAt(*t)
+	}
+	return suo
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (suo *ScheduleUpdateOne) ClearNextRunAt() *ScheduleUpdateOne {
+	suo.mutation.ClearNextRunAt()
+	return suo
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (suo *ScheduleUpdateOne) SetLastRunAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetLastRunAt(t)
+	return suo
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableLastRunAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetLastRunAt(*t)
+	}
+	return suo
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (suo *ScheduleUpdateOne) ClearLastRunAt() *ScheduleUpdateOne {
+	suo.mutation.ClearLastRunAt()
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *ScheduleUpdateOne) SetUpdatedAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableUpdatedAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetUpdatedAt(*t)
+	}
+	return suo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (suo *ScheduleUpdateOne) SetTask(t *Task) *ScheduleUpdateOne {
+	return suo.SetTaskID(t.ID)
+}
+
+// AddHistoryIDs adds the "histories" edge to the ScheduleHistory entity by IDs.
+func (suo *ScheduleUpdateOne) AddHistoryIDs(ids ...int) *ScheduleUpdateOne {
+	suo.mutation.AddHistoryIDs(ids...)
+	return suo
+}
+
+// AddHistories adds the "histories" edges to the ScheduleHistory entity.
+func (suo *ScheduleUpdateOne) AddHistories(s ...*ScheduleHistory) *ScheduleUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddHistoryIDs(ids...)
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (suo *ScheduleUpdateOne) Mutation() *ScheduleMutation {
+	return suo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (suo *ScheduleUpdateOne) ClearTask() *ScheduleUpdateOne {
+	suo.mutation.ClearTask()
+	return suo
+}
+
+// ClearHistories clears all "histories" edges to the ScheduleHistory entity.
+func (suo *ScheduleUpdateOne) ClearHistories() *ScheduleUpdateOne {
+	suo.mutation.ClearHistories()
+	return suo
+}
+
+// RemoveHistoryIDs removes the "histories" edge to ScheduleHistory entities by IDs.
+func (suo *ScheduleUpdateOne) RemoveHistoryIDs(ids ...int) *ScheduleUpdateOne {
+	suo.mutation.RemoveHistoryIDs(ids...)
+	return suo
+}
+
+// RemoveHistories removes "histories" edges to ScheduleHistory entities.
+func (suo *ScheduleUpdateOne) RemoveHistories(s ...*ScheduleHistory) *ScheduleUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveHistoryIDs(ids...)
+}
+
+// Where appends a list predicates to the ScheduleUpdate builder.
+func (suo *ScheduleUpdateOne) Where(ps ...predicate.Schedule) *ScheduleUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ScheduleUpdateOne) Select(field string, fields ...string) *ScheduleUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Schedule entity.
+func (suo *ScheduleUpdateOne) Save(ctx context.Context) (*Schedule, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ScheduleUpdateOne) SaveX(ctx context.Context) *Schedule {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (suo *ScheduleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduleUpdateOne {
+	suo.modifiers = append(suo.modifiers, modifiers...)
+	return suo
+}
+
+func (suo *ScheduleUpdateOne) sqlSave(ctx context.Context) (_node *Schedule, err error) {
+	_spec := sqlgraph.NewUpdateSpec(schedule.Table, schedule.Columns, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Schedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedule.FieldID)
+		for _, f := range fields {
+			if !schedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.ParentID(); ok {
+		_spec.SetField(schedule.FieldParentID, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedParentID(); ok {
+		_spec.AddField(schedule.FieldParentID, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.Trigger(); ok {
+		_spec.SetField(schedule.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Cron(); ok {
+		_spec.SetField(schedule.FieldCron, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.DatasetID(); ok {
+		_spec.SetField(schedule.FieldDatasetID, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedDatasetID(); ok {
+		_spec.AddField(schedule.FieldDatasetID, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.Paused(); ok {
+		_spec.SetField(schedule.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.Priority(); ok {
+		_spec.SetField(schedule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedPriority(); ok {
+		_spec.AddField(schedule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.Username(); ok {
+		_spec.SetField(schedule.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.TrainedFingerprint(); ok {
+		_spec.SetField(schedule.FieldTrainedFingerprint, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if suo.mutation.NextRunAtCleared() {
+		_spec.ClearField(schedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.LastRunAt(); ok {
+		_spec.SetField(schedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if suo.mutation.LastRunAtCleared() {
+		_spec.ClearField(schedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schedule.TaskTable,
+			Columns: []string{schedule.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schedule.TaskTable,
+			Columns: []string{schedule.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.HistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.HistoriesTable,
+			Columns: []string{schedule.HistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulehistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedHistoriesIDs(); len(nodes) > 0 && !suo.mutation.HistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.HistoriesTable,
+			Columns: []string{schedule.HistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.HistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.HistoriesTable,
+			Columns: []string{schedule.HistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedulehistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(suo.modifiers...)
+	_node = &Schedule{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
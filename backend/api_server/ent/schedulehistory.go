@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/schedule"
+	"api_server/ent/schedulehistory"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// schedule이 실행된 기록
+type ScheduleHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Parent schedule ID
+	ScheduleID int `json:"schedule_id,omitempty"`
+	// Created modeling ID, 0 if not created
+	ModelingID int `json:"modeling_id,omitempty"`
+	// cron | dataset
+	Trigger string `json:"trigger,omitempty"`
+	// created | skipped | failed
+	Status string `json:"status,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduleHistoryQuery when eager-loading is set.
+	Edges        ScheduleHistoryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScheduleHistoryEdges holds the relations/edges for other nodes in the graph.
+type ScheduleHistoryEdges struct {
+	// Schedule holds the value of the schedule edge.
+	Schedule *Schedule `json:"schedule,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ScheduleOrErr returns the Schedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScheduleHistoryEdges) ScheduleOrErr() (*Schedule, error) {
+	if e.Schedule != nil {
+		return e.Schedule, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: schedule.Label}
+	}
+	return nil, &NotLoadedError{edge: "schedule"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduleHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schedulehistory.FieldID, schedulehistory.FieldScheduleID, schedulehistory.FieldModelingID:
+			values[i] = new(sql.NullInt64)
+		case schedulehistory.FieldTrigger, schedulehistory.FieldStatus, schedulehistory.FieldMessage:
+			values[i] = new(sql.NullString)
+		case schedulehistory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduleHistory fields.
+func (sh *ScheduleHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schedulehistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sh.ID = int(value.Int64)
+		case schedulehistory.FieldScheduleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value.Valid {
+				sh.ScheduleID = int(value.Int64)
+			}
+		case schedulehistory.FieldModelingID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field modeling_id", values[i])
+			} else if value.Valid {
+				sh.ModelingID = int(value.Int64)
+			}
+		case schedulehistory.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				sh.Trigger = value.String
+			}
+		case schedulehistory.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sh.Status = value.String
+			}
+		case schedulehistory.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				sh.Message = value.String
+			}
+		case schedulehistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sh.CreatedAt = value.Time
+			}
+		default:
+			sh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScheduleHistory.
+// This includes values selected through modifiers, order, etc.
+func (sh *ScheduleHistory) Value(name string) (ent.Value, error) {
+	return sh.selectValues.Get(name)
+}
+
+// QuerySchedule queries the "schedule" edge of the ScheduleHistory entity.
+func (sh *ScheduleHistory) QuerySchedule() *ScheduleQuery {
+	return NewScheduleHistoryClient(sh.config).QuerySchedule(sh)
+}
+
+// Update returns a builder for updating this ScheduleHistory.
+// Note that you need to call ScheduleHistory.Unwrap() before calling this method if this ScheduleHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sh *ScheduleHistory) Update() *ScheduleHistoryUpdateOne {
+	return NewScheduleHistoryClient(sh.config).UpdateOne(sh)
+}
+
+// Unwrap unwraps the ScheduleHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sh *ScheduleHistory) Unwrap() *ScheduleHistory {
+	_tx, ok := sh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScheduleHistory is not a transactional entity")
+	}
+	sh.config.driver = _tx.drv
+	return sh
+}
+
+// String implements the fmt.Stringer.
+func (sh *ScheduleHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduleHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sh.ID))
+	builder.WriteString("schedule_id=")
+	builder.WriteString(fmt.Sprintf("%v", sh.ScheduleID))
+	builder.WriteString(", ")
+	builder.WriteString("modeling_id=")
+	builder.WriteString(fmt.Sprintf("%v", sh.ModelingID))
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(sh.Trigger)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(sh.Status)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(sh.Message)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sh.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduleHistories is a parsable slice of ScheduleHistory.
+type ScheduleHistories []*ScheduleHistory
@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schedulehistory
+
+import (
+	"api_server/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldLTE(FieldID, id))
+}
+
+// ScheduleID applies equality check predicate on the "schedule_id" field. It's identical to ScheduleIDEQ.
+func ScheduleID(v int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// ModelingID applies equality check predicate on the "modeling_id" field. It's identical to ModelingIDEQ.
+func ModelingID(v int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldModelingID, v))
+}
+
+// Trigger applies equality check predicate on the "trigger" field. It's identical to TriggerEQ.
+func Trigger(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldTrigger, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldStatus, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ScheduleIDEQ applies the EQ predicate on the "schedule_id" field.
+func ScheduleIDEQ(v int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDNEQ applies the NEQ predicate on the "schedule_id" field.
+func ScheduleIDNEQ(v int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDIn applies the In predicate on the "schedule_id" field.
+func ScheduleIDIn(vs ...int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDNotIn applies the NotIn predicate on the "schedule_id" field.
+func ScheduleIDNotIn(vs ...int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNotIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDIsNil applies the IsNil predicate on the "schedule_id" field.
+func ScheduleIDIsNil() predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldIsNull(FieldScheduleID))
+}
+
+// ScheduleIDNotNil applies the NotNil predicate on the "schedule_id" field.
+func ScheduleIDNotNil() predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNotNull(FieldScheduleID))
+}
+
+// ModelingIDEQ applies the EQ predicate on the "modeling_id" field.
+func ModelingIDEQ(v int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldModelingID, v))
+}
+
+// ModelingIDNEQ applies the NEQ predicate on the "modeling_id" field.
+func ModelingIDNEQ(v int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNEQ(FieldModelingID, v))
+}
+
+// ModelingIDIn applies the In predicate on the "modeling_id" field.
+func ModelingIDIn(vs ...int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldIn(FieldModelingID, vs...))
+}
+
+// ModelingIDNotIn applies the NotIn predicate on the "modeling_id" field.
+func ModelingIDNotIn(vs ...int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNotIn(FieldModelingID, vs...))
+}
+
+// ModelingIDGT applies the GT predicate on the "modeling_id" field.
+func ModelingIDGT(v int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldGT(FieldModelingID, v))
+}
+
+// ModelingIDGTE applies the GTE predicate on the "modeling_id" field.
+func ModelingIDGTE(v int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldGTE(FieldModelingID, v))
+}
+
+// ModelingIDLT applies the LT predicate on the "modeling_id" field.
+func ModelingIDLT(v int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldLT(FieldModelingID, v))
+}
+
+// ModelingIDLTE applies the LTE predicate on the "modeling_id" field.
+func ModelingIDLTE(v int) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldLTE(FieldModelingID, v))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// TriggerGT applies the GT predicate on the "trigger" field.
+func TriggerGT(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldGT(FieldTrigger, v))
+}
+
+// TriggerGTE applies the GTE predicate on the "trigger" field.
+func TriggerGTE(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldGTE(FieldTrigger, v))
+}
+
+// TriggerLT applies the LT predicate on the "trigger" field.
+func TriggerLT(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldLT(FieldTrigger, v))
+}
+
+// TriggerLTE applies the LTE predicate on the "trigger" field.
+func TriggerLTE(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldLTE(FieldTrigger, v))
+}
+
+// TriggerContains applies the Contains predicate on the "trigger" field.
+func TriggerContains(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldContains(FieldTrigger, v))
+}
+
+// TriggerHasPrefix applies the HasPrefix predicate on the "trigger" field.
+func TriggerHasPrefix(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldHasPrefix(FieldTrigger, v))
+}
+
+// TriggerHasSuffix applies the HasSuffix predicate on the "trigger" field.
+func TriggerHasSuffix(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldHasSuffix(FieldTrigger, v))
+}
+
+// TriggerEqualFold applies the EqualFold predicate on the "trigger" field.
+func TriggerEqualFold(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEqualFold(FieldTrigger, v))
+}
+
+// TriggerContainsFold applies the ContainsFold predicate on the "trigger" field.
+func TriggerContainsFold(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldContainsFold(FieldTrigger, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasSchedule applies the HasEdge predicate on the "schedule" edge.
+func HasSchedule() predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ScheduleTable, ScheduleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduleWith applies the HasEdge predicate on the "schedule" edge with a given conditions (other predicates).
+func HasScheduleWith(preds ...predicate.Schedule) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(func(s *sql.Selector) {
+		step := newScheduleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduleHistory) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduleHistory) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduleHistory) predicate.ScheduleHistory {
+	return predicate.ScheduleHistory(sql.NotPredicates(p))
+}
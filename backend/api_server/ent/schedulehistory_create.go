@@ -0,0 +1,850 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/schedule"
+	"api_server/ent/schedulehistory"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleHistoryCreate is the builder for creating a ScheduleHistory entity.
+type ScheduleHistoryCreate struct {
+	config
+	mutation *ScheduleHistoryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (shc *ScheduleHistoryCreate) SetScheduleID(i int) *ScheduleHistoryCreate {
+	shc.mutation.SetScheduleID(i)
+	return shc
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (shc *ScheduleHistoryCreate) SetNillableScheduleID(i *int) *ScheduleHistoryCreate {
+	if i != nil {
+		shc.SetScheduleID(*i)
+	}
+	return shc
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (shc *ScheduleHistoryCreate) SetModelingID(i int) *ScheduleHistoryCreate {
+	shc.mutation.SetModelingID(i)
+	return shc
+}
+
+// SetNillableModelingID sets the "modeling_id" field if the given value is not nil.
+func (shc *ScheduleHistoryCreate) SetNillableModelingID(i *int) *ScheduleHistoryCreate {
+	if i != nil {
+		shc.SetModelingID(*i)
+	}
+	return shc
+}
+
+// SetTrigger sets the "trigger" field.
+func (shc *ScheduleHistoryCreate) SetTrigger(s string) *ScheduleHistoryCreate {
+	shc.mutation.SetTrigger(s)
+	return shc
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (shc *ScheduleHistoryCreate) SetNillableTrigger(s *string) *ScheduleHistoryCreate {
+	if s != nil {
+		shc.SetTrigger(*s)
+	}
+	return shc
+}
+
+// SetStatus sets the "status" field.
+func (shc *ScheduleHistoryCreate) SetStatus(s string) *ScheduleHistoryCreate {
+	shc.mutation.SetStatus(s)
+	return shc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (shc *ScheduleHistoryCreate) SetNillableStatus(s *string) *ScheduleHistoryCreate {
+	if s != nil {
+		shc.SetStatus(*s)
+	}
+	return shc
+}
+
+// SetMessage sets the "message" field.
+func (shc *ScheduleHistoryCreate) SetMessage(s string) *ScheduleHistoryCreate {
+	shc.mutation.SetMessage(s)
+	return shc
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (shc *ScheduleHistoryCreate) SetNillableMessage(s *string) *ScheduleHistoryCreate {
+	if s != nil {
+		shc.SetMessage(*s)
+	}
+	return shc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (shc *ScheduleHistoryCreate) SetCreatedAt(t time.Time) *ScheduleHistoryCreate {
+	shc.mutation.SetCreatedAt(t)
+	return shc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (shc *ScheduleHistoryCreate) SetNillableCreatedAt(t *time.Time) *ScheduleHistoryCreate {
+	if t != nil {
+		shc.SetCreatedAt(*t)
+	}
+	return shc
+}
+
+// SetID sets the "id" field.
+func (shc *ScheduleHistoryCreate) SetID(i int) *ScheduleHistoryCreate {
+	shc.mutation.SetID(i)
+	return shc
+}
+
+// SetSchedule sets the "schedule" edge to the Schedule entity.
+func (shc *ScheduleHistoryCreate) SetSchedule(s *Schedule) *ScheduleHistoryCreate {
+	return shc.SetScheduleID(s.ID)
+}
+
+// Mutation returns the ScheduleHistoryMutation object of the builder.
+func (shc *ScheduleHistoryCreate) Mutation() *ScheduleHistoryMutation {
+	return shc.mutation
+}
+
+// Save creates the ScheduleHistory in the database.
+func (shc *ScheduleHistoryCreate) Save(ctx context.Context) (*ScheduleHistory, error) {
+	shc.defaults()
+	return withHooks(ctx, shc.sqlSave, shc.mutation, shc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (shc *ScheduleHistoryCreate) SaveX(ctx context.Context) *ScheduleHistory {
+	v, err := shc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (shc *ScheduleHistoryCreate) Exec(ctx context.Context) error {
+	_, err := shc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shc *ScheduleHistoryCreate) ExecX(ctx context.Context) {
+	if err := shc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (shc *ScheduleHistoryCreate) defaults() {
+	if _, ok := shc.mutation.ScheduleID(); !ok {
+		v := schedulehistory.DefaultScheduleID
+		shc.mutation.SetScheduleID(v)
+	}
+	if _, ok := shc.mutation.ModelingID(); !ok {
+		v := schedulehistory.DefaultModelingID
+		shc.mutation.SetModelingID(v)
+	}
+	if _, ok := shc.mutation.Trigger(); !ok {
+		v := schedulehistory.DefaultTrigger
+		shc.mutation.SetTrigger(v)
+	}
+	if _, ok := shc.mutation.Status(); !ok {
+		v := schedulehistory.DefaultStatus
+		shc.mutation.SetStatus(v)
+	}
+	if _, ok := shc.mutation.Message(); !ok {
+		v := schedulehistory.DefaultMessage
+		shc.mutation.SetMessage(v)
+	}
+	if _, ok := shc.mutation.CreatedAt(); !ok {
+		v := schedulehistory.DefaultCreatedAt()
+		shc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (shc *ScheduleHistoryCreate) check() error {
+	if _, ok := shc.mutation.ModelingID(); !ok {
+		return &ValidationError{Name: "modeling_id", err: errors.New(`ent: missing required field "ScheduleHistory.modeling_id"`)}
+	}
+	if _, ok := shc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "ScheduleHistory.trigger"`)}
+	}
+	if _, ok := shc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ScheduleHistory.status"`)}
+	}
+	if _, ok := shc.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "ScheduleHistory.message"`)}
+	}
+	if _, ok := shc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ScheduleHistory.created_at"`)}
+	}
+	return nil
+}
+
+func (shc *ScheduleHistoryCreate) sqlSave(ctx context.Context) (*ScheduleHistory, error) {
+	if err := shc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := shc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, shc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	shc.mutation.id = &_node.ID
+	shc.mutation.done = true
+	return _node, nil
+}
+
+func (shc *ScheduleHistoryCreate) createSpec() (*ScheduleHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduleHistory{config: shc.config}
+		_spec = sqlgraph.NewCreateSpec(schedulehistory.Table, sqlgraph.NewFieldSpec(schedulehistory.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = shc.conflict
+	if id, ok := shc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := shc.mutation.ModelingID(); ok {
+		_spec.SetField(schedulehistory.FieldModelingID, field.TypeInt, value)
+		_node.ModelingID = value
+	}
+	if value, ok := shc.mutation.Trigger(); ok {
+		_spec.SetField(schedulehistory.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := shc.mutation.Status(); ok {
+		_spec.SetField(schedulehistory.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := shc.mutation.Message(); ok {
+		_spec.SetField(schedulehistory.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := shc.mutation.CreatedAt(); ok {
+		_spec.SetField(schedulehistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := shc.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schedulehistory.ScheduleTable,
+			Columns: []string{schedulehistory.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ScheduleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduleHistory.Create().
+//		SetScheduleID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleHistoryUpsert) {
+//			SetScheduleID(v+v).
+//		}).
+//		Exec(ctx)
+func (shc *ScheduleHistoryCreate) OnConflict(opts ...sql.ConflictOption) *ScheduleHistoryUpsertOne {
+	shc.conflict = opts
+	return &ScheduleHistoryUpsertOne{
+		create: shc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduleHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (shc *ScheduleHistoryCreate) OnConflictColumns(columns ...string) *ScheduleHistoryUpsertOne {
+	shc.conflict = append(shc.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleHistoryUpsertOne{
+		create: shc,
+	}
+}
+
+type (
+	// ScheduleHistoryUpsertOne is the builder for "upsert"-ing
+	//  one ScheduleHistory node.
+	ScheduleHistoryUpsertOne struct {
+		create *ScheduleHistoryCreate
+	}
+
+	// ScheduleHistoryUpsert is the "OnConflict" setter.
+	ScheduleHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *ScheduleHistoryUpsert) SetScheduleID(v int) *ScheduleHistoryUpsert {
+	u.Set(schedulehistory.FieldScheduleID, v)
+	return u
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsert) UpdateScheduleID() *ScheduleHistoryUpsert {
+	u.SetExcluded(schedulehistory.FieldScheduleID)
+	return u
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (u *ScheduleHistoryUpsert) ClearScheduleID() *ScheduleHistoryUpsert {
+	u.SetNull(schedulehistory.FieldScheduleID)
+	return u
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (u *ScheduleHistoryUpsert) SetModelingID(v int) *ScheduleHistoryUpsert {
+	u.Set(schedulehistory.FieldModelingID, v)
+	return u
+}
+
+// UpdateModelingID sets the "modeling_id" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsert) UpdateModelingID() *ScheduleHistoryUpsert {
+	u.SetExcluded(schedulehistory.FieldModelingID)
+	return u
+}
+
+// AddModelingID adds v to the "modeling_id" field.
+func (u *ScheduleHistoryUpsert) AddModelingID(v int) *ScheduleHistoryUpsert {
+	u.Add(schedulehistory.FieldModelingID, v)
+	return u
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *ScheduleHistoryUpsert) SetTrigger(v string) *ScheduleHistoryUpsert {
+	u.Set(schedulehistory.FieldTrigger, v)
+	return u
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsert) UpdateTrigger() *ScheduleHistoryUpsert {
+	u.SetExcluded(schedulehistory.FieldTrigger)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduleHistoryUpsert) SetStatus(v string) *ScheduleHistoryUpsert {
+	u.Set(schedulehistory.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsert) UpdateStatus() *ScheduleHistoryUpsert {
+	u.SetExcluded(schedulehistory.FieldStatus)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *ScheduleHistoryUpsert) SetMessage(v string) *ScheduleHistoryUpsert {
+	u.Set(schedulehistory.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsert) UpdateMessage() *ScheduleHistoryUpsert {
+	u.SetExcluded(schedulehistory.FieldMessage)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ScheduleHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(schedulehistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduleHistoryUpsertOne) UpdateNewValues() *ScheduleHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(schedulehistory.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(schedulehistory.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduleHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScheduleHistoryUpsertOne) Ignore() *ScheduleHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleHistoryUpsertOne) DoNothing() *ScheduleHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleHistoryCreate.OnConflict
+// documentation for more info.
+func (u *ScheduleHistoryUpsertOne) Update(set func(*ScheduleHistoryUpsert)) *ScheduleHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *ScheduleHistoryUpsertOne) SetScheduleID(v int) *ScheduleHistoryUpsertOne {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.SetScheduleID(v)
+	})
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsertOne) UpdateScheduleID() *ScheduleHistoryUpsertOne {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.UpdateScheduleID()
+	})
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (u *ScheduleHistoryUpsertOne) ClearScheduleID() *ScheduleHistoryUpsertOne {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.ClearScheduleID()
+	})
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (u *ScheduleHistoryUpsertOne) SetModelingID(v int) *ScheduleHistoryUpsertOne {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.SetModelingID(v)
+	})
+}
+
+// AddModelingID adds v to the "modeling_id" field.
+func (u *ScheduleHistoryUpsertOne) AddModelingID(v int) *ScheduleHistoryUpsertOne {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.AddModelingID(v)
+	})
+}
+
+// UpdateModelingID sets the "modeling_id" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsertOne) UpdateModelingID() *ScheduleHistoryUpsertOne {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.UpdateModelingID()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *ScheduleHistoryUpsertOne) SetTrigger(v string) *ScheduleHistoryUpsertOne {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsertOne) UpdateTrigger() *ScheduleHistoryUpsertOne {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduleHistoryUpsertOne) SetStatus(v string) *ScheduleHistoryUpsertOne {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsertOne) UpdateStatus() *ScheduleHistoryUpsertOne {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ScheduleHistoryUpsertOne) SetMessage(v string) *ScheduleHistoryUpsertOne {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsertOne) UpdateMessage() *ScheduleHistoryUpsertOne {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduleHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScheduleHistoryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScheduleHistoryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScheduleHistoryCreateBulk is the builder for creating many ScheduleHistory entities in bulk.
+type ScheduleHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduleHistoryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScheduleHistory entities in the database.
+func (shcb *ScheduleHistoryCreateBulk) Save(ctx context.Context) ([]*ScheduleHistory, error) {
+	if shcb.err != nil {
+		return nil, shcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(shcb.builders))
+	nodes := make([]*ScheduleHistory, len(shcb.builders))
+	mutators := make([]Mutator, len(shcb.builders))
+	for i := range shcb.builders {
+		func(i int, root context.Context) {
+			builder := shcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduleHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, shcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = shcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, shcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, shcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (shcb *ScheduleHistoryCreateBulk) SaveX(ctx context.Context) []*ScheduleHistory {
+	v, err := shcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (shcb *ScheduleHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := shcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shcb *ScheduleHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := shcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduleHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduleHistoryUpsert) {
+//			SetScheduleID(v+v).
+//		}).
+//		Exec(ctx)
+func (shcb *ScheduleHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScheduleHistoryUpsertBulk {
+	shcb.conflict = opts
+	return &ScheduleHistoryUpsertBulk{
+		create: shcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduleHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (shcb *ScheduleHistoryCreateBulk) OnConflictColumns(columns ...string) *ScheduleHistoryUpsertBulk {
+	shcb.conflict = append(shcb.conflict, sql.ConflictColumns(columns...))
+	return &ScheduleHistoryUpsertBulk{
+		create: shcb,
+	}
+}
+
+// ScheduleHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScheduleHistory nodes.
+type ScheduleHistoryUpsertBulk struct {
+	create *ScheduleHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScheduleHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(schedulehistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduleHistoryUpsertBulk) UpdateNewValues() *ScheduleHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(schedulehistory.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(schedulehistory.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduleHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScheduleHistoryUpsertBulk) Ignore() *ScheduleHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduleHistoryUpsertBulk) DoNothing() *ScheduleHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduleHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScheduleHistoryUpsertBulk) Update(set func(*ScheduleHistoryUpsert)) *ScheduleHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduleHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *ScheduleHistoryUpsertBulk) SetScheduleID(v int) *ScheduleHistoryUpsertBulk {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.SetScheduleID(v)
+	})
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsertBulk) UpdateScheduleID() *ScheduleHistoryUpsertBulk {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.UpdateScheduleID()
+	})
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (u *ScheduleHistoryUpsertBulk) ClearScheduleID() *ScheduleHistoryUpsertBulk {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.ClearScheduleID()
+	})
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (u *ScheduleHistoryUpsertBulk) SetModelingID(v int) *ScheduleHistoryUpsertBulk {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.SetModelingID(v)
+	})
+}
+
+// AddModelingID adds v to the "modeling_id" field.
+func (u *ScheduleHistoryUpsertBulk) AddModelingID(v int) *ScheduleHistoryUpsertBulk {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.AddModelingID(v)
+	})
+}
+
+// UpdateModelingID sets the "modeling_id" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsertBulk) UpdateModelingID() *ScheduleHistoryUpsertBulk {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.UpdateModelingID()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *ScheduleHistoryUpsertBulk) SetTrigger(v string) *ScheduleHistoryUpsertBulk {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsertBulk) UpdateTrigger() *ScheduleHistoryUpsertBulk {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ScheduleHistoryUpsertBulk) SetStatus(v string) *ScheduleHistoryUpsertBulk {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsertBulk) UpdateStatus() *ScheduleHistoryUpsertBulk {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ScheduleHistoryUpsertBulk) SetMessage(v string) *ScheduleHistoryUpsertBulk {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ScheduleHistoryUpsertBulk) UpdateMessage() *ScheduleHistoryUpsertBulk {
+	return u.Update(func(s *ScheduleHistoryUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// Exec executes the query.
+func (u *ScheduleHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ScheduleHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ScheduleHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduleHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/predicate"
+	"api_server/ent/schedulehistory"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleHistoryDelete is the builder for deleting a ScheduleHistory entity.
+type ScheduleHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScheduleHistoryMutation
+}
+
+// Where appends a list predicates to the ScheduleHistoryDelete builder.
+func (shd *ScheduleHistoryDelete) Where(ps ...predicate.ScheduleHistory) *ScheduleHistoryDelete {
+	shd.mutation.Where(ps...)
+	return shd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (shd *ScheduleHistoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, shd.sqlExec, shd.mutation, shd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shd *ScheduleHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := shd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (shd *ScheduleHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(schedulehistory.Table, sqlgraph.NewFieldSpec(schedulehistory.FieldID, field.TypeInt))
+	if ps := shd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, shd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	shd.mutation.done = true
+	return affected, err
+}
+
+// ScheduleHistoryDeleteOne is the builder for deleting a single ScheduleHistory entity.
+type ScheduleHistoryDeleteOne struct {
+	shd *ScheduleHistoryDelete
+}
+
+// Where appends a list predicates to the ScheduleHistoryDelete builder.
+func (shdo *ScheduleHistoryDeleteOne) Where(ps ...predicate.ScheduleHistory) *ScheduleHistoryDeleteOne {
+	shdo.shd.mutation.Where(ps...)
+	return shdo
+}
+
+// Exec executes the deletion query.
+func (shdo *ScheduleHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := shdo.shd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{schedulehistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shdo *ScheduleHistoryDeleteOne) ExecX(ctx context.Context) {
+	if err := shdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/predicate"
+	"api_server/ent/schedule"
+	"api_server/ent/schedulehistory"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleHistoryQuery is the builder for querying ScheduleHistory entities.
+type ScheduleHistoryQuery struct {
+	config
+	ctx          *QueryContext
+	order        []schedulehistory.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ScheduleHistory
+	withSchedule *ScheduleQuery
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScheduleHistoryQuery builder.
+func (shq *ScheduleHistoryQuery) Where(ps ...predicate.ScheduleHistory) *ScheduleHistoryQuery {
+	shq.predicates = append(shq.predicates, ps...)
+	return shq
+}
+
+// Limit the number of records to be returned by this query.
+func (shq *ScheduleHistoryQuery) Limit(limit int) *ScheduleHistoryQuery {
+	shq.ctx.Limit = &limit
+	return shq
+}
+
+// Offset to start from.
+func (shq *ScheduleHistoryQuery) Offset(offset int) *ScheduleHistoryQuery {
+	shq.ctx.Offset = &offset
+	return shq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (shq *ScheduleHistoryQuery) Unique(unique bool) *ScheduleHistoryQuery {
+	shq.ctx.Unique = &unique
+	return shq
+}
+
+// Order specifies how the records should be ordered.
+func (shq *ScheduleHistoryQuery) Order(o ...schedulehistory.OrderOption) *ScheduleHistoryQuery {
+	shq.order = append(shq.order, o...)
+	return shq
+}
+
+// QuerySchedule chains the current query on the "schedule" edge.
+func (shq *ScheduleHistoryQuery) QuerySchedule() *ScheduleQuery {
+	query := (&ScheduleClient{config: shq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := shq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := shq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(schedulehistory.Table, schedulehistory.FieldID, selector),
+			sqlgraph.To(schedule.Table, schedule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, schedulehistory.ScheduleTable, schedulehistory.ScheduleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(shq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ScheduleHistory entity from the query.
+// Returns a *NotFoundError when no ScheduleHistory was found.
+func (shq *ScheduleHistoryQuery) First(ctx context.Context) (*ScheduleHistory, error) {
+	nodes, err := shq.Limit(1).All(setContextOp(ctx, shq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{schedulehistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (shq *ScheduleHistoryQuery) FirstX(ctx context.Context) *ScheduleHistory {
+	node, err := shq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScheduleHistory ID from the query.
+// Returns a *NotFoundError when no ScheduleHistory ID was found.
+func (shq *ScheduleHistoryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = shq.Limit(1).IDs(setContextOp(ctx, shq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{schedulehistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (shq *ScheduleHistoryQuery) FirstIDX(ctx context.Context) int {
+	id, err := shq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScheduleHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ScheduleHistory entity is found.
+// Returns a *NotFoundError when no ScheduleHistory entities are found.
+func (shq *ScheduleHistoryQuery) Only(ctx context.Context) (*ScheduleHistory, error) {
+	nodes, err := shq.Limit(2).All(setContextOp(ctx, shq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{schedulehistory.Label}
+	default:
+		return nil, &NotSingularError{schedulehistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (shq *ScheduleHistoryQuery) OnlyX(ctx context.Context) *ScheduleHistory {
+	node, err := shq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScheduleHistory ID in the query.
+// Returns a *NotSingularError when more than one ScheduleHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (shq *ScheduleHistoryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = shq.Limit(2).IDs(setContextOp(ctx, shq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{schedulehistory.Label}
+	default:
+		err = &NotSingularError{schedulehistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (shq *ScheduleHistoryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := shq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScheduleHistories.
+func (shq *ScheduleHistoryQuery) All(ctx context.Context) ([]*ScheduleHistory, error) {
+	ctx = setContextOp(ctx, shq.ctx, ent.OpQueryAll)
+	if err := shq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ScheduleHistory, *ScheduleHistoryQuery]()
+	return withInterceptors[[]*ScheduleHistory](ctx, shq, qr, shq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (shq *ScheduleHistoryQuery) AllX(ctx context.Context) []*ScheduleHistory {
+	nodes, err := shq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScheduleHistory IDs.
+func (shq *ScheduleHistoryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if shq.ctx.Unique == nil && shq.path != nil {
+		shq.Unique(true)
+	}
+	ctx = setContextOp(ctx, shq.ctx, ent.OpQueryIDs)
+	if err = shq.Select(schedulehistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (shq *ScheduleHistoryQuery) IDsX(ctx context.Context) []int {
+	ids, err := shq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (shq *ScheduleHistoryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, shq.ctx, ent.OpQueryCount)
+	if err := shq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, shq, querierCount[*ScheduleHistoryQuery](), shq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (shq *ScheduleHistoryQuery) CountX(ctx context.Context) int {
+	count, err := shq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (shq *ScheduleHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, shq.ctx, ent.OpQueryExist)
+	switch _, err := shq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (shq *ScheduleHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := shq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScheduleHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (shq *ScheduleHistoryQuery) Clone() *ScheduleHistoryQuery {
+	if shq == nil {
+		return nil
+	}
+	return &ScheduleHistoryQuery{
+		config:       shq.config,
+		ctx:          shq.ctx.Clone(),
+		order:        append([]schedulehistory.OrderOption{}, shq.order...),
+		inters:       append([]Interceptor{}, shq.inters...),
+		predicates:   append([]predicate.ScheduleHistory{}, shq.predicates...),
+		withSchedule: shq.withSchedule.Clone(),
+		// clone intermediate query.
+		sql:       shq.sql.Clone(),
+		path:      shq.path,
+		modifiers: append([]func(*sql.Selector){}, shq.modifiers...),
+	}
+}
+
+// WithSchedule tells the query-builder to eager-load the nodes that are connected to
+// the "schedule" edge. The optional arguments are used to configure the query builder of the edge.
+func (shq *ScheduleHistoryQuery) WithSchedule(opts ...func(*ScheduleQuery)) *ScheduleHistoryQuery {
+	query := (&ScheduleClient{config: shq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	shq.withSchedule = query
+	return shq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ScheduleID int `json:"schedule_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScheduleHistory.Query().
+//		GroupBy(schedulehistory.FieldScheduleID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (shq *ScheduleHistoryQuery) GroupBy(field string, fields ...string) *ScheduleHistoryGroupBy {
+	shq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ScheduleHistoryGroupBy{build: shq}
+	grbuild.flds = &shq.ctx.Fields
+	grbuild.label = schedulehistory.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ScheduleID int `json:"schedule_id,omitempty"`
+//	}
+//
+//	client.ScheduleHistory.Query().
+//		Select(schedulehistory.FieldScheduleID).
+//		Scan(ctx, &v)
+func (shq *ScheduleHistoryQuery) Select(fields ...string) *ScheduleHistorySelect {
+	shq.ctx.Fields = append(shq.ctx.Fields, fields...)
+	sbuild := &ScheduleHistorySelect{ScheduleHistoryQuery: shq}
+	sbuild.label = schedulehistory.Label
+	sbuild.flds, sbuild.scan = &shq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ScheduleHistorySelect configured with the given aggregations.
+func (shq *ScheduleHistoryQuery) Aggregate(fns ...AggregateFunc) *ScheduleHistorySelect {
+	return shq.Select().Aggregate(fns...)
+}
+
+func (shq *ScheduleHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range shq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, shq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range shq.ctx.Fields {
+		if !schedulehistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if shq.path != nil {
+		prev, err := shq.path(ctx)
+		if err != nil {
+			return err
+		}
+		shq.sql = prev
+	}
+	return nil
+}
+
+func (shq *ScheduleHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ScheduleHistory, error) {
+	var (
+		nodes       = []*ScheduleHistory{}
+		_spec       = shq.querySpec()
+		loadedTypes = [1]bool{
+			shq.withSchedule != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ScheduleHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ScheduleHistory{config: shq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(shq.modifiers) > 0 {
+		_spec.Modifiers = shq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, shq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := shq.withSchedule; query != nil {
+		if err := shq.loadSchedule(ctx, query, nodes, nil,
+			func(n *ScheduleHistory, e *Schedule) { n.Edges.Schedule = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (shq *ScheduleHistoryQuery) loadSchedule(ctx context.Context, query *ScheduleQuery, nodes []*ScheduleHistory, init func(*ScheduleHistory), assign func(*ScheduleHistory, *Schedule)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ScheduleHistory)
+	for i := range nodes {
+		fk := nodes[i].ScheduleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(schedule.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "schedule_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (shq *ScheduleHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := shq.querySpec()
+	if len(shq.modifiers) > 0 {
+		_spec.Modifiers = shq.modifiers
+	}
+	_spec.Node.Columns = shq.ctx.Fields
+	if len(shq.ctx.Fields) > 0 {
+		_spec.Unique = shq.ctx.Unique != nil && *shq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, shq.driver, _spec)
+}
+
+func (shq *ScheduleHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(schedulehistory.Table, schedulehistory.Columns, sqlgraph.NewFieldSpec(schedulehistory.FieldID, field.TypeInt))
+	_spec.From = shq.sql
+	if unique := shq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if shq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := shq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedulehistory.FieldID)
+		for i := range fields {
+			if fields[i] != schedulehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if shq.withSchedule != nil {
+			_spec.Node.AddColumnOnce(schedulehistory.FieldScheduleID)
+		}
+	}
+	if ps := shq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := shq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := shq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := shq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (shq *ScheduleHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(shq.driver.Dialect())
+	t1 := builder.Table(schedulehistory.Table)
+	columns := shq.ctx.Fields
+	if len(columns) == 0 {
+		columns = schedulehistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if shq.sql != nil {
+		selector = shq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if shq.ctx.Unique != nil && *shq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range shq.modifiers {
+		m(selector)
+	}
+	for _, p := range shq.predicates {
+		p(selector)
+	}
+	for _, p := range shq.order {
+		p(selector)
+	}
+	if offset := shq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := shq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (shq *ScheduleHistoryQuery) Modify(modifiers ...func(s *sql.Selector)) *ScheduleHistorySelect {
+	shq.modifiers = append(shq.modifiers, modifiers...)
+	return shq.Select()
+}
+
+// ScheduleHistoryGroupBy is the group-by builder for ScheduleHistory entities.
+type ScheduleHistoryGroupBy struct {
+	selector
+	build *ScheduleHistoryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (shgb *ScheduleHistoryGroupBy) Aggregate(fns ...AggregateFunc) *ScheduleHistoryGroupBy {
+	shgb.fns = append(shgb.fns, fns...)
+	return shgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (shgb *ScheduleHistoryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, shgb.build.ctx, ent.OpQueryGroupBy)
+	if err := shgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScheduleHistoryQuery, *ScheduleHistoryGroupBy](ctx, shgb.build, shgb, shgb.build.inters, v)
+}
+
+func (shgb *ScheduleHistoryGroupBy) sqlScan(ctx context.Context, root *ScheduleHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(shgb.fns))
+	for _, fn := range shgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*shgb.flds)+len(shgb.fns))
+		for _, f := range *shgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*shgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := shgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ScheduleHistorySelect is the builder for selecting fields of ScheduleHistory entities.
+type ScheduleHistorySelect struct {
+	*ScheduleHistoryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (shs *ScheduleHistorySelect) Aggregate(fns ...AggregateFunc) *ScheduleHistorySelect {
+	shs.fns = append(shs.fns, fns...)
+	return shs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (shs *ScheduleHistorySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, shs.ctx, ent.OpQuerySelect)
+	if err := shs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScheduleHistoryQuery, *ScheduleHistorySelect](ctx, shs.ScheduleHistoryQuery, shs, shs.inters, v)
+}
+
+func (shs *ScheduleHistorySelect) sqlScan(ctx context.Context, root *ScheduleHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(shs.fns))
+	for _, fn := range shs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*shs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := shs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (shs *ScheduleHistorySelect) Modify(modifiers ...func(s *sql.Selector)) *ScheduleHistorySelect {
+	shs.modifiers = append(shs.modifiers, modifiers...)
+	return shs
+}
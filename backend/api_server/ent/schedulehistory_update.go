@@ -0,0 +1,468 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"api_server/ent/predicate"
+	"api_server/ent/schedule"
+	"api_server/ent/schedulehistory"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleHistoryUpdate is the builder for updating ScheduleHistory entities.
+type ScheduleHistoryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScheduleHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScheduleHistoryUpdate builder.
+func (shu *ScheduleHistoryUpdate) Where(ps ...predicate.ScheduleHistory) *ScheduleHistoryUpdate {
+	shu.mutation.Where(ps...)
+	return shu
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (shu *ScheduleHistoryUpdate) SetScheduleID(i int) *ScheduleHistoryUpdate {
+	shu.mutation.SetScheduleID(i)
+	return shu
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (shu *ScheduleHistoryUpdate) SetNillableScheduleID(i *int) *ScheduleHistoryUpdate {
+	if i != nil {
+		shu.SetScheduleID(*i)
+	}
+	return shu
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (shu *ScheduleHistoryUpdate) ClearScheduleID() *ScheduleHistoryUpdate {
+	shu.mutation.ClearScheduleID()
+	return shu
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (shu *ScheduleHistoryUpdate) SetModelingID(i int) *ScheduleHistoryUpdate {
+	shu.mutation.ResetModelingID()
+	shu.mutation.SetModelingID(i)
+	return shu
+}
+
+// SetNillableModelingID sets the "modeling_id" field if the given value is not nil.
+func (shu *ScheduleHistoryUpdate) SetNillableModelingID(i *int) *ScheduleHistoryUpdate {
+	if i != nil {
+		shu.SetModelingID(*i)
+	}
+	return shu
+}
+
+// AddModelingID adds i to the "modeling_id" field.
+func (shu *ScheduleHistoryUpdate) AddModelingID(i int) *ScheduleHistoryUpdate {
+	shu.mutation.AddModelingID(i)
+	return shu
+}
+
+// SetTrigger sets the "trigger" field.
+func (shu *ScheduleHistoryUpdate) SetTrigger(s string) *ScheduleHistoryUpdate {
+	shu.mutation.SetTrigger(s)
+	return shu
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (shu *ScheduleHistoryUpdate) SetNillableTrigger(s *string) *ScheduleHistoryUpdate {
+	if s != nil {
+		shu.SetTrigger(*s)
+	}
+	return shu
+}
+
+// SetStatus sets the "status" field.
+func (shu *ScheduleHistoryUpdate) SetStatus(s string) *ScheduleHistoryUpdate {
+	shu.mutation.SetStatus(s)
+	return shu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (shu *ScheduleHistoryUpdate) SetNillableStatus(s *string) *ScheduleHistoryUpdate {
+	if s != nil {
+		shu.SetStatus(*s)
+	}
+	return shu
+}
+
+// SetMessage sets the "message" field.
+func (shu *ScheduleHistoryUpdate) SetMessage(s string) *ScheduleHistoryUpdate {
+	shu.mutation.SetMessage(s)
+	return shu
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (shu *ScheduleHistoryUpdate) SetNillableMessage(s *string) *ScheduleHistoryUpdate {
+	if s != nil {
+		shu.SetMessage(*s)
+	}
+	return shu
+}
+
+// SetSchedule sets the "schedule" edge to the Schedule entity.
+func (shu *ScheduleHistoryUpdate) SetSchedule(s *Schedule) *ScheduleHistoryUpdate {
+	return shu.SetScheduleID(s.ID)
+}
+
+// Mutation returns the ScheduleHistoryMutation object of the builder.
+func (shu *ScheduleHistoryUpdate) Mutation() *ScheduleHistoryMutation {
+	return shu.mutation
+}
+
+// ClearSchedule clears the "schedule" edge to the Schedule entity.
+func (shu *ScheduleHistoryUpdate) ClearSchedule() *ScheduleHistoryUpdate {
+	shu.mutation.ClearSchedule()
+	return shu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (shu *ScheduleHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, shu.sqlSave, shu.mutation, shu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (shu *ScheduleHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := shu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (shu *ScheduleHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := shu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shu *ScheduleHistoryUpdate) ExecX(ctx context.Context) {
+	if err := shu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (shu *ScheduleHistoryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduleHistoryUpdate {
+	shu.modifiers = append(shu.modifiers, modifiers...)
+	return shu
+}
+
+func (shu *ScheduleHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(schedulehistory.Table, schedulehistory.Columns, sqlgraph.NewFieldSpec(schedulehistory.FieldID, field.TypeInt))
+	if ps := shu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := shu.mutation.ModelingID(); ok {
+		_spec.SetField(schedulehistory.FieldModelingID, field.TypeInt, value)
+	}
+	if value, ok := shu.mutation.AddedModelingID(); ok {
+		_spec.AddField(schedulehistory.FieldModelingID, field.TypeInt, value)
+	}
+	if value, ok := shu.mutation.Trigger(); ok {
+		_spec.SetField(schedulehistory.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := shu.mutation.Status(); ok {
+		_spec.SetField(schedulehistory.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := shu.mutation.Message(); ok {
+		_spec.SetField(schedulehistory.FieldMessage, field.TypeString, value)
+	}
+	if shu.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schedulehistory.ScheduleTable,
+			Columns: []string{schedulehistory.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := shu.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schedulehistory.ScheduleTable,
+			Columns: []string{schedulehistory.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(shu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, shu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedulehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	shu.mutation.done = true
+	return n, nil
+}
+
+// ScheduleHistoryUpdateOne is the builder for updating a single ScheduleHistory entity.
+type ScheduleHistoryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScheduleHistoryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (shuo *ScheduleHistoryUpdateOne) SetScheduleID(i int) *ScheduleHistoryUpdateOne {
+	shuo.mutation.SetScheduleID(i)
+	return shuo
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (shuo *ScheduleHistoryUpdateOne) SetNillableScheduleID(i *int) *ScheduleHistoryUpdateOne {
+	if i != nil {
+		shuo.SetScheduleID(*i)
+	}
+	return shuo
+}
+
+// ClearScheduleID clears the value of the "schedule_id" field.
+func (shuo *ScheduleHistoryUpdateOne) ClearScheduleID() *ScheduleHistoryUpdateOne {
+	shuo.mutation.ClearScheduleID()
+	return shuo
+}
+
+// SetModelingID sets the "modeling_id" field.
+func (shuo *ScheduleHistoryUpdateOne) SetModelingID(i int) *ScheduleHistoryUpdateOne {
+	shuo.mutation.ResetModelingID()
+	shuo.mutation.SetModelingID(i)
+	return shuo
+}
+
+// SetNillableModelingID sets the "modeling_id" field if the given value is not nil.
+func (shuo *ScheduleHistoryUpdateOne) SetNillableModelingID(i *int) *ScheduleHistoryUpdateOne {
+	if i != nil {
+		shuo.SetModelingID(*i)
+	}
+	return shuo
+}
+
+// AddModelingID adds i to the "modeling_id" field.
+func (shuo *ScheduleHistoryUpdateOne) AddModelingID(i int) *ScheduleHistoryUpdateOne {
+	shuo.mutation.AddModelingID(i)
+	return shuo
+}
+
+// SetTrigger sets the "trigger" field.
+func (shuo *ScheduleHistoryUpdateOne) SetTrigger(s string) *ScheduleHistoryUpdateOne {
+	shuo.mutation.SetTrigger(s)
+	return shuo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (shuo *ScheduleHistoryUpdateOne) SetNillableTrigger(s *string) *ScheduleHistoryUpdateOne {
+	if s != nil {
+		shuo.SetTrigger(*s)
+	}
+	return shuo
+}
+
+// SetStatus sets the "status" field.
+func (shuo *ScheduleHistoryUpdateOne) SetStatus(s string) *ScheduleHistoryUpdateOne {
+	shuo.mutation.SetStatus(s)
+	return shuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (shuo *ScheduleHistoryUpdateOne) SetNillableStatus(s *string) *ScheduleHistoryUpdateOne {
+	if s != nil {
+		shuo.SetStatus(*s)
+	}
+	return shuo
+}
+
+// SetMessage sets the "message" field.
+func (shuo *ScheduleHistoryUpdateOne) SetMessage(s string) *ScheduleHistoryUpdateOne {
+	shuo.mutation.SetMessage(s)
+	return shuo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (shuo *ScheduleHistoryUpdateOne) SetNillableMessage(s *string) *ScheduleHistoryUpdateOne {
+	if s != nil {
+		shuo.SetMessage(*s)
+	}
+	return shuo
+}
+
+// SetSchedule sets the "schedule" edge to the Schedule entity.
+func (shuo *ScheduleHistoryUpdateOne) SetSchedule(s *Schedule) *ScheduleHistoryUpdateOne {
+	return shuo.SetScheduleID(s.ID)
+}
+
+// Mutation returns the ScheduleHistoryMutation object of the builder.
+func (shuo *ScheduleHistoryUpdateOne) Mutation() *ScheduleHistoryMutation {
+	return shuo.mutation
+}
+
+// ClearSchedule clears the "schedule" edge to the Schedule entity.
+func (shuo *ScheduleHistoryUpdateOne) ClearSchedule() *ScheduleHistoryUpdateOne {
+	shuo.mutation.ClearSchedule()
+	return shuo
+}
+
+// Where appends a list predicates to the ScheduleHistoryUpdate builder.
+func (shuo *ScheduleHistoryUpdateOne) Where(ps ...predicate.ScheduleHistory) *ScheduleHistoryUpdateOne {
+	shuo.mutation.Where(ps...)
+	return shuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (shuo *ScheduleHistoryUpdateOne) Select(field string, fields ...string) *ScheduleHistoryUpdateOne {
+	shuo.fields = append([]string{field}, fields...)
+	return shuo
+}
+
+// Save executes the query and returns the updated ScheduleHistory entity.
+func (shuo *ScheduleHistoryUpdateOne) Save(ctx context.Context) (*ScheduleHistory, error) {
+	return withHooks(ctx, shuo.sqlSave, shuo.mutation, shuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (shuo *ScheduleHistoryUpdateOne) SaveX(ctx context.Context) *ScheduleHistory {
+	node, err := shuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (shuo *ScheduleHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := shuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shuo *ScheduleHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := shuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (shuo *ScheduleHistoryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScheduleHistoryUpdateOne {
+	shuo.modifiers = append(shuo.modifiers, modifiers...)
+	return shuo
+}
+
+func (shuo *ScheduleHistoryUpdateOne) sqlSave(ctx context.Context) (_node *ScheduleHistory, err error) {
+	_spec := sqlgraph.NewUpdateSpec(schedulehistory.Table, schedulehistory.Columns, sqlgraph.NewFieldSpec(schedulehistory.FieldID, field.TypeInt))
+	id, ok := shuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScheduleHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := shuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedulehistory.FieldID)
+		for _, f := range fields {
+			if !schedulehistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schedulehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := shuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := shuo.mutation.ModelingID(); ok {
+		_spec.SetField(schedulehistory.FieldModelingID, field.TypeInt, value)
+	}
+	if value, ok := shuo.mutation.AddedModelingID(); ok {
+		_spec.AddField(schedulehistory.FieldModelingID, field.TypeInt, value)
+	}
+	if value, ok := shuo.mutation.Trigger(); ok {
+		_spec.SetField(schedulehistory.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := shuo.mutation.Status(); ok {
+		_spec.SetField(schedulehistory.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := shuo.mutation.Message(); ok {
+		_spec.SetField(schedulehistory.FieldMessage, field.TypeString, value)
+	}
+	if shuo.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schedulehistory.ScheduleTable,
+			Columns: []string{schedulehistory.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := shuo.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   schedulehistory.ScheduleTable,
+			Columns: []string{schedulehistory.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(shuo.modifiers...)
+	_node = &ScheduleHistory{config: shuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, shuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedulehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	shuo.mutation.done = true
+	return _node, nil
+}
@@ -42,6 +42,8 @@ func (Dataset) Fields() []ent.Field {
 		field.Time("updated_at").Default(time.Now),
 		field.Time("deleted_at").Default(time.Now),
 		field.Int("dr_id").Optional().Default(0),
+		field.String("fingerprint").Default(""),            // 파일 수, 크기, 수정 시각으로 만든 변경 감지 값
+		field.Time("fingerprint_at").Optional().Nillable(), // fingerprint가 마지막으로 바뀐 시각
 	}
 }
 
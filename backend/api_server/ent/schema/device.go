@@ -30,6 +30,12 @@ func (Device) Fields() []ent.Field {
 		field.String("type").Default("engine"),
 		field.String("connection").Default("rest"),
 		field.String("available").Default("false"),
+		field.Time("heartbeat_at").Optional().Nillable(),             // 스스로 등록한 engine의 마지막 heartbeat, nil이면 KAI.S가 /api/sys로 확인
+		field.JSON("capabilities", &DeviceCapabilities{}).Optional(), // engine이 보고한 engine type, version
+		field.Bool("drain").Default(false),                           // true이면 새 작업을 배치하지 않음, 실행 중인 modeling은 끝까지 실행
+		field.String("drain_reason").Default(""),
+		field.Time("drain_at").Optional().Nillable(), // drain을 시작한 시각
+		field.Int("drain_window").Default(0),         // drain을 시작한 maintenance window ID, 0이면 관리자가 직접 시작
 	}
 }
 
@@ -0,0 +1,7 @@
+package schema
+
+// DeviceCapabilities는 engine이 보고한 실행 가능 범위입니다.
+type DeviceCapabilities struct {
+	EngineTypes   []string `json:"engine_types,omitempty"`   // 실행할 수 있는 engine type (vcls-sl, tcls, vad, ...)
+	EngineVersion string   `json:"engine_version,omitempty"` // engine build version
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// DeviceToken holds the schema definition for the DeviceToken entity.
+type DeviceToken struct {
+	ent.Schema
+}
+
+func (DeviceToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "device_token"},
+		entsql.WithComments(true),
+		schema.Comment("engine이 스스로 등록하고 heartbeat를 보낼 때 사용하는 device별 enrollment token"),
+	}
+}
+
+// Fields of the DeviceToken.
+func (DeviceToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.String("name").Default("").Comment("Label for admins"),
+		field.String("token_hash").Unique().Sensitive().Comment("SHA-256 of the token, the token itself is shown only once"),
+		field.Int("device_id").Default(0).Comment("Device registered with the token, 0 until the first registration"),
+		field.Bool("revoked").Default(false),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("used_at").Optional().Nillable().Comment("Last registration or heartbeat"),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the DeviceToken.
+func (DeviceToken) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,14 @@
+package schema
+
+// EarlyStopPolicy는 task의 trial을 epoch metric으로 일찍 중단시키는 정책입니다.
+// 여러 정책을 지정하면 하나라도 중단을 결정한 trial을 중단합니다.
+type EarlyStopPolicy struct {
+	Type            string  `json:"type"`                       // median | patience | halving
+	Metric          string  `json:"metric,omitempty"`           // trial_status metric, 기본값 patience는 valid_loss, 나머지는 valid_score
+	Mode            string  `json:"mode,omitempty"`             // min | max, 기본값은 loss metric이면 min
+	MinEpochs       int     `json:"min_epochs,omitempty"`       // 이 epoch 전에는 중단하지 않음, halving은 첫 rung
+	Patience        int     `json:"patience,omitempty"`         // patience: 개선이 없어도 기다릴 epoch 수, 기본값 5
+	MinDelta        float64 `json:"min_delta,omitempty"`        // patience: 개선으로 인정할 최소 변화량
+	MinTrials       int     `json:"min_trials,omitempty"`       // median: 비교에 필요한 다른 trial 수, 기본값 3
+	ReductionFactor int     `json:"reduction_factor,omitempty"` // halving: rung마다 남길 비율의 역수, 기본값 3
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EarlyStop holds the schema definition for the EarlyStop entity.
+type EarlyStop struct {
+	ent.Schema
+}
+
+func (EarlyStop) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "early_stop"},
+		entsql.WithComments(true),
+		schema.Comment("early stopping policy가 trial을 중단시킨 기록"),
+	}
+}
+
+func (EarlyStop) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id"),
+		index.Fields("trial_uuid"),
+	}
+}
+
+// Fields of the EarlyStop.
+func (EarlyStop) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.Int("task_id").Comment("Task ID"),
+		field.Int("modeling_id").Comment("Modeling ID of the stopped trial"),
+		field.String("trial_uuid").Comment("Stopped trial UUID"),
+		field.String("policy").Comment("median | patience | halving"),
+		field.String("metric").Default("").Comment("Metric the policy evaluated"),
+		field.Int("epoch").Default(0).Comment("Epoch at the decision"),
+		field.Float("value").Default(0).Comment("Metric value of the trial at the decision"),
+		field.String("reason").Default("").Comment("Why the trial was stopped"),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the EarlyStop.
+func (EarlyStop) Edges() []ent.Edge {
+	return nil
+}
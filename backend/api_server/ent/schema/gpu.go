@@ -28,6 +28,10 @@ func (Gpu) Fields() []ent.Field {
 		field.String("state").Default("idle"),        // idle | train | test | load
 		field.Bool("is_use").Default(true),           // usable
 		field.Int("device_id").Optional().Default(0), // GPU machine ID
+		field.Float("memory_total").Default(0),       // MiB, engine /api/sys 보고값
+		field.Float("memory_used").Default(0),        // MiB
+		field.Float("utilization_gpu").Default(0),    // %
+		field.Float("utilization_memory").Default(0), // %
 	}
 }
 
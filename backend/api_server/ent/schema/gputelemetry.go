@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GpuTelemetry holds the schema definition for the GpuTelemetry entity.
+type GpuTelemetry struct {
+	ent.Schema
+}
+
+func (GpuTelemetry) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "gpu_telemetry"},
+		entsql.WithComments(true),
+		schema.Comment("engine /api/sys로 수집한 GPU 상태를 resolution 단위 구간의 평균으로 저장한 시계열"),
+	}
+}
+
+func (GpuTelemetry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("gpu_id", "resolution", "bucket_at").Unique(),
+		index.Fields("device_id", "resolution", "bucket_at"),
+	}
+}
+
+// Fields of the GpuTelemetry.
+func (GpuTelemetry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.Int("gpu_id").Comment("GPU ID"),
+		field.Int("device_id").Comment("Device ID of the GPU"),
+		field.Int("resolution").Comment("Bucket size in seconds"),
+		field.Time("bucket_at").Comment("Start of the bucket"),
+		field.Int("samples").Default(0).Comment("Number of samples averaged in the bucket"),
+		field.Float("utilization_gpu").Default(0).Comment("%"),
+		field.Float("utilization_memory").Default(0).Comment("%"),
+		field.Float("memory_used").Default(0).Comment("MiB"),
+		field.Float("memory_total").Default(0).Comment("MiB"),
+		field.Float("temperature").Default(0).Comment("Celsius"),
+		field.Float("power_draw").Default(0).Comment("Watt"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the GpuTelemetry.
+func (GpuTelemetry) Edges() []ent.Edge {
+	return nil
+}
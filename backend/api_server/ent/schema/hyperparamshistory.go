@@ -31,6 +31,7 @@ func (HyperParamsHistory) Fields() []ent.Field {
 		field.String("model").Default(""),
 		field.Int("model_num").Default(0),
 		field.String("params").Default(""),
+		field.Float("score").Optional().Nillable().Comment("search metric of the trial"),
 		field.Time("created_at").Immutable().Default(time.Now),
 	}
 }
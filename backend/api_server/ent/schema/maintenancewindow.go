@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MaintenanceWindow holds the schema definition for the MaintenanceWindow entity.
+type MaintenanceWindow struct {
+	ent.Schema
+}
+
+func (MaintenanceWindow) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "maintenance_window"},
+		entsql.WithComments(true),
+		schema.Comment("starts_at부터 ends_at까지 device를 drain하는 예약된 점검 시간"),
+	}
+}
+
+func (MaintenanceWindow) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("device_id", "starts_at"),
+	}
+}
+
+// Fields of the MaintenanceWindow.
+func (MaintenanceWindow) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.Int("device_id").Comment("Device ID to drain"),
+		field.Time("starts_at").Comment("Drain starts"),
+		field.Time("ends_at").Comment("Drain ends and the device is scheduled again"),
+		field.String("reason").Default(""),
+		field.Bool("migrate").Default(false).Comment("Move loaded inference models to other GPUs instead of unloading them"),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("started_at").Optional().Nillable().Comment("When the drain was applied"),
+		field.Time("ended_at").Optional().Nillable().Comment("When the drain was released"),
+	}
+}
+
+// Edges of the MaintenanceWindow.
+func (MaintenanceWindow) Edges() []ent.Edge {
+	return nil
+}
@@ -39,6 +39,18 @@ func (Modeling) Fields() []ent.Field {
 		field.String("modeling_step").Default("idle").Comment("current task step"),
 		field.JSON("performance", []string{}).Default(defaultValue).Comment("Performance"),
 		field.Float("progress").Comment("task progress"),
+		field.Int("priority").Default(0).Comment("Queue priority, higher runs first"),
+		field.String("username").Default("").Comment("User who queued the modeling"),
+		field.Time("queued_at").Default(time.Now).Comment("Time the modeling entered the queue"),
+		field.Int("queue_position").Optional().Nillable().Comment("Admin-set order within the same priority, lower runs first"),
+		field.String("executor").Default("rest").Comment("rest | slurm"),
+		field.String("job_id").Default("").Comment("Executor job ID (slurm job id)"),
+		field.String("job_type").Default("").Comment("train | evaluation | blind | finish"),
+		field.Int("attempts").Default(0).Comment("Failed dispatch attempts"),
+		field.Time("next_attempt_at").Optional().Nillable().Comment("Do not dispatch before this time"),
+		field.String("fail_reason").Default("").Comment("Last dispatch error or failure reason"),
+		field.Int("pipeline_stage").Default(0).Comment("0: not in pipeline, 1: training, n: task.pipeline[n-2]"),
+		field.Int("search_trial").Default(0).Comment("0: not in hyperparameter search, n: n-th trial"),
 		field.Time("created_at").Immutable().Default(time.Now),
 		field.Time("updated_at").Default(time.Now),
 		field.Time("started_at").Optional().Nillable(),
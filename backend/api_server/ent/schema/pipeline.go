@@ -0,0 +1,8 @@
+package schema
+
+// PipelineStage는 task pipeline에서 학습이 끝난 뒤 이어서 실행할 단계입니다.
+type PipelineStage struct {
+	Type       string                 `json:"type"`                  // evaluation | blind
+	DatasetID  int                    `json:"dataset_id"`            // 평가/blind test에 사용할 dataset
+	UserParams map[string]interface{} `json:"user_params,omitempty"` // 단계별 engine params
+}
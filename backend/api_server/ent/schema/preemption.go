@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Preemption holds the schema definition for the Preemption entity.
+type Preemption struct {
+	ent.Schema
+}
+
+func (Preemption) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "preemption"},
+		entsql.WithComments(true),
+		schema.Comment("높은 우선순위 modeling이 실행 중인 modeling을 중단시킨 기록"),
+	}
+}
+
+func (Preemption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("modeling_id"),
+		index.Fields("victim_id"),
+	}
+}
+
+// Fields of the Preemption.
+func (Preemption) Fields() []ent.Field {
+	defaultValue := []int{}
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.Int("modeling_id").Comment("Preempting modeling ID"),
+		field.Int("priority").Default(0).Comment("Priority of the preempting modeling"),
+		field.String("username").Default("").Comment("User who queued the preempting modeling"),
+		field.Int("victim_id").Comment("Preempted modeling ID"),
+		field.Int("victim_priority").Default(0).Comment("Priority of the preempted modeling"),
+		field.String("victim_username").Default("").Comment("User who queued the preempted modeling"),
+		field.JSON("gpu_ids", []int{}).Default(defaultValue).Comment("GPUs released by the preempted modeling"),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the Preemption.
+func (Preemption) Edges() []ent.Edge {
+	return nil
+}
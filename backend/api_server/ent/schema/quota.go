@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Quota holds the schema definition for the Quota entity.
+type Quota struct {
+	ent.Schema
+}
+
+func (Quota) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "quota"},
+		entsql.WithComments(true),
+		schema.Comment("project, user group 별 GPU 사용 제한"),
+	}
+}
+
+func (Quota) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("target_type", "target_id").Unique(),
+	}
+}
+
+// Fields of the Quota.
+func (Quota) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.String("target_type").Comment("project | user_group"),
+		field.Int("target_id").Comment("Project ID or user group level"),
+		field.Int("max_gpus").Default(0).Comment("Max concurrent GPUs, 0 is unlimited"),
+		field.Int("max_queued").Default(0).Comment("Max queued modelings, 0 is unlimited"),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("updated_at").Default(time.Now),
+	}
+}
+
+// Edges of the Quota.
+func (Quota) Edges() []ent.Edge {
+	return nil
+}
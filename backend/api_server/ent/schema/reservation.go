@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Reservation holds the schema definition for the Reservation entity.
+type Reservation struct {
+	ent.Schema
+}
+
+func (Reservation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "reservation"},
+		entsql.WithComments(true),
+		schema.Comment("project 또는 user가 starts_at부터 ends_at까지 사용할 device, GPU 예약"),
+	}
+}
+
+func (Reservation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("device_id", "starts_at"),
+	}
+}
+
+// Fields of the Reservation.
+func (Reservation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.String("title").Default(""),
+		field.Int("device_id").Comment("Reserved device"),
+		field.Ints("gpu_ids").Optional().Comment("Reserved GPUs of the device, empty reserves every GPU"),
+		field.Int("project_id").Default(0).Comment("Project that owns the reservation, 0 if reserved for a user"),
+		field.String("username").Default("").Comment("User that owns the reservation, empty if reserved for a project"),
+		field.Time("starts_at"),
+		field.Time("ends_at"),
+		field.String("created_by").Default(""),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("updated_at").Default(time.Now),
+	}
+}
+
+// Edges of the Reservation.
+func (Reservation) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Schedule holds the schema definition for the Schedule entity.
+type Schedule struct {
+	ent.Schema
+}
+
+func (Schedule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "schedule"},
+		entsql.WithComments(true),
+		schema.Comment("task 재학습 일정: cron | dataset 변경"),
+	}
+}
+
+// Fields of the Schedule.
+func (Schedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.Int("task_id").Optional().Default(0).Comment("Parent task ID"),
+		field.Int("parent_id").Default(0).Comment("Modeling whose params are used for retraining"),
+		field.String("trigger").Default("cron").Comment("cron | dataset"),
+		field.String("cron").Default("").Comment("minute hour day month weekday"),
+		field.Int("dataset_id").Default(0).Comment("Dataset watched by dataset trigger"),
+		field.Bool("paused").Default(false),
+		field.Int("priority").Default(0).Comment("Queue priority of created modelings"),
+		field.String("username").Default("").Comment("User who created the schedule"),
+		field.String("trained_fingerprint").Default("").Comment("Dataset fingerprint when the dataset trigger last created a modeling"),
+		field.Time("next_run_at").Optional().Nillable().Comment("Next cron run"),
+		field.Time("last_run_at").Optional().Nillable(),
+		field.Time("created_at").Immutable().Default(time.Now),
+		field.Time("updated_at").Default(time.Now),
+	}
+}
+
+// Edges of the Schedule.
+func (Schedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("schedules").
+			Unique().
+			Field("task_id"),
+		edge.To("histories", ScheduleHistory.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
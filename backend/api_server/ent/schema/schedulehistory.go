@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ScheduleHistory holds the schema definition for the ScheduleHistory entity.
+type ScheduleHistory struct {
+	ent.Schema
+}
+
+func (ScheduleHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "schedule_history"},
+		entsql.WithComments(true),
+		schema.Comment("schedule이 실행된 기록"),
+	}
+}
+
+// Fields of the ScheduleHistory.
+func (ScheduleHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").Unique().Immutable(),
+		field.Int("schedule_id").Optional().Default(0).Comment("Parent schedule ID"),
+		field.Int("modeling_id").Default(0).Comment("Created modeling ID, 0 if not created"),
+		field.String("trigger").Default("cron").Comment("cron | dataset"),
+		field.String("status").Default("created").Comment("created | skipped | failed"),
+		field.String("message").Default(""),
+		field.Time("created_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the ScheduleHistory.
+func (ScheduleHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("schedule", Schedule.Type).
+			Ref("histories").
+			Unique().
+			Field("schedule_id"),
+	}
+}
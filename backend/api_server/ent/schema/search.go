@@ -0,0 +1,24 @@
+package schema
+
+// HPSearch는 task에 선언하는 hyperparameter 탐색 설정입니다.
+// KAI.S가 trial 설정을 제안하고 trial마다 modeling을 하나씩 만듭니다.
+type HPSearch struct {
+	Strategy    string    `json:"strategy"`               // grid | random | tpe
+	Space       []HPParam `json:"space"`                  // 탐색 공간
+	Metric      string    `json:"metric,omitempty"`       // 비교할 testset score, 비어 있으면 task.target_metric
+	Direction   string    `json:"direction,omitempty"`    // max | min, 기본값 max
+	MaxTrials   int       `json:"max_trials,omitempty"`   // 최대 trial 수, grid는 0이면 모든 조합
+	MaxDuration int       `json:"max_duration,omitempty"` // task 생성 후 탐색을 끝낼 시간(초), 0이면 제한 없음
+	Parallel    int       `json:"parallel,omitempty"`     // 동시에 대기열에 올릴 trial 수, 기본값 1
+	Seed        int64     `json:"seed,omitempty"`         // random/tpe 재현용 seed
+}
+
+// HPParam은 탐색할 engine param 하나입니다. Name은 engine에 전달되는 params의 key입니다.
+type HPParam struct {
+	Name    string        `json:"name"`
+	Type    string        `json:"type"`              // int | float | log | choice
+	Min     float64       `json:"min,omitempty"`     // int/float/log 범위
+	Max     float64       `json:"max,omitempty"`     // int/float/log 범위
+	Step    float64       `json:"step,omitempty"`    // grid 간격(log는 log10 간격), 0이면 int는 1, float/log는 5개 값
+	Choices []interface{} `json:"choices,omitempty"` // choice 후보
+}
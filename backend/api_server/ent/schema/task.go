@@ -35,6 +35,11 @@ func (Task) Fields() []ent.Field {
 		field.String("engine_type").Comment("engine type"),
 		field.String("target_metric").Default("wa").Comment(""),
 		field.JSON("params", []string{}).Default(defaultValue).Comment("params by engine type"),
+		field.JSON("pipeline", []PipelineStage{}).Optional().Comment("stages to run after training"),
+		field.String("pipeline_status").Default("").Comment("'' | run | complete | fail | cancel"),
+		field.JSON("search", &HPSearch{}).Optional().Comment("hyperparameter search space and budget"),
+		field.String("search_status").Default("").Comment("'' | run | complete | fail | cancel"),
+		field.JSON("early_stop", []EarlyStopPolicy{}).Optional().Comment("early stopping policies for trials"),
 		field.Time("created_at").Immutable().Default(time.Now),
 		field.Time("updated_at").Default(time.Now),
 	}
@@ -49,5 +54,7 @@ func (Task) Edges() []ent.Edge {
 			Field("project_id"),
 		edge.To("modelings", Modeling.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("schedules", Schedule.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
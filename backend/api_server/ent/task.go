@@ -4,6 +4,7 @@ package ent
 
 import (
 	"api_server/ent/project"
+	"api_server/ent/schema"
 	"api_server/ent/task"
 	"encoding/json"
 	"fmt"
@@ -33,6 +34,16 @@ type Task struct {
 	TargetMetric string `json:"target_metric,omitempty"`
 	// params by engine type
 	Params []string `json:"params,omitempty"`
+	// stages to run after training
+	Pipeline []schema.PipelineStage `json:"pipeline,omitempty"`
+	// '' | run | complete | fail | cancel
+	PipelineStatus string `json:"pipeline_status,omitempty"`
+	// hyperparameter search space and budget
+	Search *schema.HPSearch `json:"search,omitempty"`
+	// '' | run | complete | fail | cancel
+	SearchStatus string `json:"search_status,omitempty"`
+	// early stopping policies for trials
+	EarlyStop []schema.EarlyStopPolicy `json:"early_stop,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -49,9 +60,11 @@ type TaskEdges struct {
 	Project *Project `json:"project,omitempty"`
 	// Modelings holds the value of the modelings edge.
 	Modelings []*Modeling `json:"modelings,omitempty"`
+	// Schedules holds the value of the schedules edge.
+	Schedules []*Schedule `json:"schedules,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // ProjectOrErr returns the Project value or an error if the edge
@@ -74,16 +87,25 @@ func (e TaskEdges) ModelingsOrErr() ([]*Modeling, error) {
 	return nil, &NotLoadedError{edge: "modelings"}
 }
 
+// SchedulesOrErr returns the Schedules value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) SchedulesOrErr() ([]*Schedule, error) {
+	if e.loadedTypes[2] {
+		return e.Schedules, nil
+	}
+	return nil, &NotLoadedError{edge: "schedules"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Task) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case task.FieldParams:
+		case task.FieldParams, task.FieldPipeline, task.FieldSearch, task.FieldEarlyStop:
 			values[i] = new([]byte)
 		case task.FieldID, task.FieldProjectID, task.FieldDatasetID:
 			values[i] = new(sql.NullInt64)
-		case task.FieldTitle, task.FieldDescription, task.FieldEngineType, task.FieldTargetMetric:
+		case task.FieldTitle, task.FieldDescription, task.FieldEngineType, task.FieldTargetMetric, task.FieldPipelineStatus, task.FieldSearchStatus:
 			values[i] = new(sql.NullString)
 		case task.FieldCreatedAt, task.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -152,6 +174,42 @@ func (t *Task) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field params: %w", err)
 				}
 			}
+		case task.FieldPipeline:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Pipeline); err != nil {
+					return fmt.Errorf("unmarshal field pipeline: %w", err)
+				}
+			}
+		case task.FieldPipelineStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline_status", values[i])
+			} else if value.Valid {
+				t.PipelineStatus = value.String
+			}
+		case task.FieldSearch:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field search", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Search); err != nil {
+					return fmt.Errorf("unmarshal field search: %w", err)
+				}
+			}
+		case task.FieldSearchStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_status", values[i])
+			} else if value.Valid {
+				t.SearchStatus = value.String
+			}
+		case task.FieldEarlyStop:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field early_stop", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.EarlyStop); err != nil {
+					return fmt.Errorf("unmarshal field early_stop: %w", err)
+				}
+			}
 		case task.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -187,6 +245,11 @@ func (t *Task) QueryModelings() *ModelingQuery {
 	return NewTaskClient(t.config).QueryModelings(t)
 }
 
+// QuerySchedules queries the "schedules" edge of the Task entity.
+func (t *Task) QuerySchedules() *ScheduleQuery {
+	return NewTaskClient(t.config).QuerySchedules(t)
+}
+
 // Update returns a builder for updating this Task.
 // Note that you need to call Task.Unwrap() before calling this method if this Task
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -231,6 +294,21 @@ func (t *Task) String() string {
 	builder.WriteString("params=")
 	builder.WriteString(fmt.Sprintf("%v", t.Params))
 	builder.WriteString(", ")
+	builder.WriteString("pipeline=")
+	builder.WriteString(fmt.Sprintf("%v", t.Pipeline))
+	builder.WriteString(", ")
+	builder.WriteString("pipeline_status=")
+	builder.WriteString(t.PipelineStatus)
+	builder.WriteString(", ")
+	builder.WriteString("search=")
+	builder.WriteString(fmt.Sprintf("%v", t.Search))
+	builder.WriteString(", ")
+	builder.WriteString("search_status=")
+	builder.WriteString(t.SearchStatus)
+	builder.WriteString(", ")
+	builder.WriteString("early_stop=")
+	builder.WriteString(fmt.Sprintf("%v", t.EarlyStop))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
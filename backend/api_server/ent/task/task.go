@@ -28,6 +28,16 @@ const (
 	FieldTargetMetric = "target_metric"
 	// FieldParams holds the string denoting the params field in the database.
 	FieldParams = "params"
+	// FieldPipeline holds the string denoting the pipeline field in the database.
+	FieldPipeline = "pipeline"
+	// FieldPipelineStatus holds the string denoting the pipeline_status field in the database.
+	FieldPipelineStatus = "pipeline_status"
+	// FieldSearch holds the string denoting the search field in the database.
+	FieldSearch = "search"
+	// FieldSearchStatus holds the string denoting the search_status field in the database.
+	FieldSearchStatus = "search_status"
+	// FieldEarlyStop holds the string denoting the early_stop field in the database.
+	FieldEarlyStop = "early_stop"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -36,6 +46,8 @@ const (
 	EdgeProject = "project"
 	// EdgeModelings holds the string denoting the modelings edge name in mutations.
 	EdgeModelings = "modelings"
+	// EdgeSchedules holds the string denoting the schedules edge name in mutations.
+	EdgeSchedules = "schedules"
 	// Table holds the table name of the task in the database.
 	Table = "task"
 	// ProjectTable is the table that holds the project relation/edge.
@@ -52,6 +64,13 @@ const (
 	ModelingsInverseTable = "modeling"
 	// ModelingsColumn is the table column denoting the modelings relation/edge.
 	ModelingsColumn = "task_id"
+	// SchedulesTable is the table that holds the schedules relation/edge.
+	SchedulesTable = "schedule"
+	// SchedulesInverseTable is the table name for the Schedule entity.
+	// It exists in this package in order to avoid circular dependency with the "schedule" package.
+	SchedulesInverseTable = "schedule"
+	// SchedulesColumn is the table column denoting the schedules relation/edge.
+	SchedulesColumn = "task_id"
 )
 
 // Columns holds all SQL columns for task fields.
@@ -64,6 +83,11 @@ var Columns = []string{
 	FieldEngineType,
 	FieldTargetMetric,
 	FieldParams,
+	FieldPipeline,
+	FieldPipelineStatus,
+	FieldSearch,
+	FieldSearchStatus,
+	FieldEarlyStop,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -89,6 +113,10 @@ var (
 	DefaultTargetMetric string
 	// DefaultParams holds the default value on creation for the "params" field.
 	DefaultParams []string
+	// DefaultPipelineStatus holds the default value on creation for the "pipeline_status" field.
+	DefaultPipelineStatus string
+	// DefaultSearchStatus holds the default value on creation for the "search_status" field.
+	DefaultSearchStatus string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -133,6 +161,16 @@ func ByTargetMetric(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTargetMetric, opts...).ToFunc()
 }
 
+// ByPipelineStatus orders the results by the pipeline_status field.
+func ByPipelineStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPipelineStatus, opts...).ToFunc()
+}
+
+// BySearchStatus orders the results by the search_status field.
+func BySearchStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchStatus, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -163,6 +201,20 @@ func ByModelings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newModelingsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySchedulesCount orders the results by schedules count.
+func BySchedulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSchedulesStep(), opts...)
+	}
+}
+
+// BySchedules orders the results by schedules terms.
+func BySchedules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSchedulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProjectStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -177,3 +229,10 @@ func newModelingsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ModelingsTable, ModelingsColumn),
 	)
 }
+func newSchedulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SchedulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SchedulesTable, SchedulesColumn),
+	)
+}
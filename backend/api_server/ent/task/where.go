@@ -85,6 +85,16 @@ func TargetMetric(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldTargetMetric, v))
 }
 
+// PipelineStatus applies equality check predicate on the "pipeline_status" field. It's identical to PipelineStatusEQ.
+func PipelineStatus(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPipelineStatus, v))
+}
+
+// SearchStatus applies equality check predicate on the "search_status" field. It's identical to SearchStatusEQ.
+func SearchStatus(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldSearchStatus, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreatedAt, v))
@@ -425,6 +435,166 @@ func TargetMetricContainsFold(v string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldTargetMetric, v))
 }
 
+// PipelineIsNil applies the IsNil predicate on the "pipeline" field.
+func PipelineIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldPipeline))
+}
+
+// PipelineNotNil applies the NotNil predicate on the "pipeline" field.
+func PipelineNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldPipeline))
+}
+
+// PipelineStatusEQ applies the EQ predicate on the "pipeline_status" field.
+func PipelineStatusEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPipelineStatus, v))
+}
+
+// PipelineStatusNEQ applies the NEQ predicate on the "pipeline_status" field.
+func PipelineStatusNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldPipelineStatus, v))
+}
+
+// PipelineStatusIn applies the In predicate on the "pipeline_status" field.
+func PipelineStatusIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldPipelineStatus, vs...))
+}
+
+// PipelineStatusNotIn applies the NotIn predicate on the "pipeline_status" field.
+func PipelineStatusNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldPipelineStatus, vs...))
+}
+
+// PipelineStatusGT applies the GT predicate on the "pipeline_status" field.
+func PipelineStatusGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldPipelineStatus, v))
+}
+
+// PipelineStatusGTE applies the GTE predicate on the "pipeline_status" field.
+func PipelineStatusGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldPipelineStatus, v))
+}
+
+// PipelineStatusLT applies the LT predicate on the "pipeline_status" field.
+func PipelineStatusLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldPipelineStatus, v))
+}
+
+// PipelineStatusLTE applies the LTE predicate on the "pipeline_status" field.
+func PipelineStatusLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldPipelineStatus, v))
+}
+
+// PipelineStatusContains applies the Contains predicate on the "pipeline_status" field.
+func PipelineStatusContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldPipelineStatus, v))
+}
+
+// PipelineStatusHasPrefix applies the HasPrefix predicate on the "pipeline_status" field.
+func PipelineStatusHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldPipelineStatus, v))
+}
+
+// PipelineStatusHasSuffix applies the HasSuffix predicate on the "pipeline_status" field.
+func PipelineStatusHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldPipelineStatus, v))
+}
+
+// PipelineStatusEqualFold applies the EqualFold predicate on the "pipeline_status" field.
+func PipelineStatusEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldPipelineStatus, v))
+}
+
+// PipelineStatusContainsFold applies the ContainsFold predicate on the "pipeline_status" field.
+func PipelineStatusContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldPipelineStatus, v))
+}
+
+// SearchIsNil applies the IsNil predicate on the "search" field.
+func SearchIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldSearch))
+}
+
+// SearchNotNil applies the NotNil predicate on the "search" field.
+func SearchNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldSearch))
+}
+
+// SearchStatusEQ applies the EQ predicate on the "search_status" field.
+func SearchStatusEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldSearchStatus, v))
+}
+
+// SearchStatusNEQ applies the NEQ predicate on the "search_status" field.
+func SearchStatusNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldSearchStatus, v))
+}
+
+// SearchStatusIn applies the In predicate on the "search_status" field.
+func SearchStatusIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldSearchStatus, vs...))
+}
+
+// SearchStatusNotIn applies the NotIn predicate on the "search_status" field.
+func SearchStatusNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldSearchStatus, vs...))
+}
+
+// SearchStatusGT applies the GT predicate on the "search_status" field.
+func SearchStatusGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldSearchStatus, v))
+}
+
+// SearchStatusGTE applies the GTE predicate on the "search_status" field.
+func SearchStatusGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldSearchStatus, v))
+}
+
+// SearchStatusLT applies the LT predicate on the "search_status" field.
+func SearchStatusLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldSearchStatus, v))
+}
+
+// SearchStatusLTE applies the LTE predicate on the "search_status" field.
+func SearchStatusLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldSearchStatus, v))
+}
+
+// SearchStatusContains applies the Contains predicate on the "search_status" field.
+func SearchStatusContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldSearchStatus, v))
+}
+
+// SearchStatusHasPrefix applies the HasPrefix predicate on the "search_status" field.
+func SearchStatusHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldSearchStatus, v))
+}
+
+// SearchStatusHasSuffix applies the HasSuffix predicate on the "search_status" field.
+func SearchStatusHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldSearchStatus, v))
+}
+
+// SearchStatusEqualFold applies the EqualFold predicate on the "search_status" field.
+func SearchStatusEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldSearchStatus, v))
+}
+
+// SearchStatusContainsFold applies the ContainsFold predicate on the "search_status" field.
+func SearchStatusContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldSearchStatus, v))
+}
+
+// EarlyStopIsNil applies the IsNil predicate on the "early_stop" field.
+func EarlyStopIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldEarlyStop))
+}
+
+// EarlyStopNotNil applies the NotNil predicate on the "early_stop" field.
+func EarlyStopNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldEarlyStop))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreatedAt, v))
@@ -551,6 +721,29 @@ func HasModelingsWith(preds ...predicate.Modeling) predicate.Task {
 	})
 }
 
+// HasSchedules applies the HasEdge predicate on the "schedules" edge.
+func HasSchedules() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SchedulesTable, SchedulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSchedulesWith applies the HasEdge predicate on the "schedules" edge with a given conditions (other predicates).
+func HasSchedulesWith(preds ...predicate.Schedule) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newSchedulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Task) predicate.Task {
 	return predicate.Task(sql.AndPredicates(predicates...))
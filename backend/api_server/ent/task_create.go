@@ -5,6 +5,8 @@ package ent
 import (
 	"api_server/ent/modeling"
 	"api_server/ent/project"
+	"api_server/ent/schedule"
+	"api_server/ent/schema"
 	"api_server/ent/task"
 	"context"
 	"errors"
@@ -98,6 +100,52 @@ func (tc *TaskCreate) SetParams(s []string) *TaskCreate {
 	return tc
 }
 
+// SetPipeline sets the "pipeline" field.
+func (tc *TaskCreate) SetPipeline(ss []schema.PipelineStage) *TaskCreate {
+	tc.mutation.SetPipeline(ss)
+	return tc
+}
+
+// SetPipelineStatus sets the "pipeline_status" field.
+func (tc *TaskCreate) SetPipelineStatus(s string) *TaskCreate {
+	tc.mutation.SetPipelineStatus(s)
+	return tc
+}
+
+// SetNillablePipelineStatus sets the "pipeline_status" field if the given value is not nil.
+func (tc *TaskCreate) SetNillablePipelineStatus(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetPipelineStatus(*s)
+	}
+	return tc
+}
+
+// SetSearch sets the "search" field.
+func (tc *TaskCreate) SetSearch(ss *schema.HPSearch) *TaskCreate {
+	tc.mutation.SetSearch(ss)
+	return tc
+}
+
+// SetSearchStatus sets the "search_status" field.
+func (tc *TaskCreate) SetSearchStatus(s string) *TaskCreate {
+	tc.mutation.SetSearchStatus(s)
+	return tc
+}
+
+// SetNillableSearchStatus sets the "search_status" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableSearchStatus(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetSearchStatus(*s)
+	}
+	return tc
+}
+
+// SetEarlyStop sets the "early_stop" field.
+func (tc *TaskCreate) SetEarlyStop(ssp []schema.EarlyStopPolicy) *TaskCreate {
+	tc.mutation.SetEarlyStop(ssp)
+	return tc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (tc *TaskCreate) SetCreatedAt(t time.Time) *TaskCreate {
 	tc.mutation.SetCreatedAt(t)
@@ -152,6 +200,21 @@ func (tc *TaskCreate) AddModelings(m ...*Modeling) *TaskCreate {
 	return tc.AddModelingIDs(ids...)
 }
 
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by IDs.
+func (tc *TaskCreate) AddScheduleIDs(ids ...int) *TaskCreate {
+	tc.mutation.AddScheduleIDs(ids...)
+	return tc
+}
+
+// AddSchedules adds the "schedules" edges to the Schedule entity.
+func (tc *TaskCreate) AddSchedules(s ...*Schedule) *TaskCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tc.AddScheduleIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tc *TaskCreate) Mutation() *TaskMutation {
 	return tc.mutation
@@ -207,6 +270,14 @@ func (tc *TaskCreate) defaults() {
 		v := task.DefaultParams
 		tc.mutation.SetParams(v)
 	}
+	if _, ok := tc.mutation.PipelineStatus(); !ok {
+		v := task.DefaultPipelineStatus
+		tc.mutation.SetPipelineStatus(v)
+	}
+	if _, ok := tc.mutation.SearchStatus(); !ok {
+		v := task.DefaultSearchStatus
+		tc.mutation.SetSearchStatus(v)
+	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
 		v := task.DefaultCreatedAt()
 		tc.mutation.SetCreatedAt(v)
@@ -237,6 +308,12 @@ func (tc *TaskCreate) check() error {
 	if _, ok := tc.mutation.Params(); !ok {
 		return &ValidationError{Name: "params", err: errors.New(`ent: missing required field "Task.params"`)}
 	}
+	if _, ok := tc.mutation.PipelineStatus(); !ok {
+		return &ValidationError{Name: "pipeline_status", err: errors.New(`ent: missing required field "Task.pipeline_status"`)}
+	}
+	if _, ok := tc.mutation.SearchStatus(); !ok {
+		return &ValidationError{Name: "search_status", err: errors.New(`ent: missing required field "Task.search_status"`)}
+	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Task.created_at"`)}
 	}
@@ -300,6 +377,26 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldParams, field.TypeJSON, value)
 		_node.Params = value
 	}
+	if value, ok := tc.mutation.Pipeline(); ok {
+		_spec.SetField(task.FieldPipeline, field.TypeJSON, value)
+		_node.Pipeline = value
+	}
+	if value, ok := tc.mutation.PipelineStatus(); ok {
+		_spec.SetField(task.FieldPipelineStatus, field.TypeString, value)
+		_node.PipelineStatus = value
+	}
+	if value, ok := tc.mutation.Search(); ok {
+		_spec.SetField(task.FieldSearch, field.TypeJSON, value)
+		_node.Search = value
+	}
+	if value, ok := tc.mutation.SearchStatus(); ok {
+		_spec.SetField(task.FieldSearchStatus, field.TypeString, value)
+		_node.SearchStatus = value
+	}
+	if value, ok := tc.mutation.EarlyStop(); ok {
+		_spec.SetField(task.FieldEarlyStop, field.TypeJSON, value)
+		_node.EarlyStop = value
+	}
 	if value, ok := tc.mutation.CreatedAt(); ok {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -341,6 +438,22 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.SchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.SchedulesTable,
+			Columns: []string{task.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -489,6 +602,84 @@ func (u *TaskUpsert) UpdateParams() *TaskUpsert {
 	return u
 }
 
+// SetPipeline sets the "pipeline" field.
+func (u *TaskUpsert) SetPipeline(v []schema.PipelineStage) *TaskUpsert {
+	u.Set(task.FieldPipeline, v)
+	return u
+}
+
+// UpdatePipeline sets the "pipeline" field to the value that was provided on create.
+func (u *TaskUpsert) UpdatePipeline() *TaskUpsert {
+	u.SetExcluded(task.FieldPipeline)
+	return u
+}
+
+// ClearPipeline clears the value of the "pipeline" field.
+func (u *TaskUpsert) ClearPipeline() *TaskUpsert {
+	u.SetNull(task.FieldPipeline)
+	return u
+}
+
+// SetPipelineStatus sets the "pipeline_status" field.
+func (u *TaskUpsert) SetPipelineStatus(v string) *TaskUpsert {
+	u.Set(task.FieldPipelineStatus, v)
+	return u
+}
+
+// UpdatePipelineStatus sets the "pipeline_status" field to the value that was provided on create.
+func (u *TaskUpsert) UpdatePipelineStatus() *TaskUpsert {
+	u.SetExcluded(task.FieldPipelineStatus)
+	return u
+}
+
+// SetSearch sets the "search" field.
+func (u *TaskUpsert) SetSearch(v *schema.HPSearch) *TaskUpsert {
+	u.Set(task.FieldSearch, v)
+	return u
+}
+
+// UpdateSearch sets the "search" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateSearch() *TaskUpsert {
+	u.SetExcluded(task.FieldSearch)
+	return u
+}
+
+// ClearSearch clears the value of the "search" field.
+func (u *TaskUpsert) ClearSearch() *TaskUpsert {
+	u.SetNull(task.FieldSearch)
+	return u
+}
+
+// SetSearchStatus sets the "search_status" field.
+func (u *TaskUpsert) SetSearchStatus(v string) *TaskUpsert {
+	u.Set(task.FieldSearchStatus, v)
+	return u
+}
+
+// UpdateSearchStatus sets the "search_status" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateSearchStatus() *TaskUpsert {
+	u.SetExcluded(task.FieldSearchStatus)
+	return u
+}
+
+// SetEarlyStop sets the "early_stop" field.
+func (u *TaskUpsert) SetEarlyStop(v []schema.EarlyStopPolicy) *TaskUpsert {
+	u.Set(task.FieldEarlyStop, v)
+	return u
+}
+
+// UpdateEarlyStop sets the "early_stop" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateEarlyStop() *TaskUpsert {
+	u.SetExcluded(task.FieldEarlyStop)
+	return u
+}
+
+// ClearEarlyStop clears the value of the "early_stop" field.
+func (u *TaskUpsert) ClearEarlyStop() *TaskUpsert {
+	u.SetNull(task.FieldEarlyStop)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *TaskUpsert) SetUpdatedAt(v time.Time) *TaskUpsert {
 	u.Set(task.FieldUpdatedAt, v)
@@ -664,6 +855,97 @@ func (u *TaskUpsertOne) UpdateParams() *TaskUpsertOne {
 	})
 }
 
+// SetPipeline sets the "pipeline" field.
+func (u *TaskUpsertOne) SetPipeline(v []schema.PipelineStage) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetPipeline(v)
+	})
+}
+
+// UpdatePipeline sets the "pipeline" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdatePipeline() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdatePipeline()
+	})
+}
+
+// ClearPipeline clears the value of the "pipeline" field.
+func (u *TaskUpsertOne) ClearPipeline() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearPipeline()
+	})
+}
+
+// SetPipelineStatus sets the "pipeline_status" field.
+func (u *TaskUpsertOne) SetPipelineStatus(v string) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetPipelineStatus(v)
+	})
+}
+
+// UpdatePipelineStatus sets the "pipeline_status" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdatePipelineStatus() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdatePipelineStatus()
+	})
+}
+
+// SetSearch sets the "search" field.
+func (u *TaskUpsertOne) SetSearch(v *schema.HPSearch) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetSearch(v)
+	})
+}
+
+// UpdateSearch sets the "search" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateSearch() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateSearch()
+	})
+}
+
+// ClearSearch clears the value of the "search" field.
+func (u *TaskUpsertOne) ClearSearch() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearSearch()
+	})
+}
+
+// SetSearchStatus sets the "search_status" field.
+func (u *TaskUpsertOne) SetSearchStatus(v string) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetSearchStatus(v)
+	})
+}
+
+// UpdateSearchStatus sets the "search_status" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateSearchStatus() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateSearchStatus()
+	})
+}
+
+// SetEarlyStop sets the "early_stop" field.
+func (u *TaskUpsertOne) SetEarlyStop(v []schema.EarlyStopPolicy) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetEarlyStop(v)
+	})
+}
+
+// UpdateEarlyStop sets the "early_stop" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateEarlyStop() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateEarlyStop()
+	})
+}
+
+// ClearEarlyStop clears the value of the "early_stop" field.
+func (u *TaskUpsertOne) ClearEarlyStop() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearEarlyStop()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *TaskUpsertOne) SetUpdatedAt(v time.Time) *TaskUpsertOne {
 	return u.Update(func(s *TaskUpsert) {
@@ -1007,6 +1289,97 @@ func (u *TaskUpsertBulk) UpdateParams() *TaskUpsertBulk {
 	})
 }
 
+// SetPipeline sets the "pipeline" field.
+func (u *TaskUpsertBulk) SetPipeline(v []schema.PipelineStage) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetPipeline(v)
+	})
+}
+
+// UpdatePipeline sets the "pipeline" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdatePipeline() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdatePipeline()
+	})
+}
+
+// ClearPipeline clears the value of the "pipeline" field.
+func (u *TaskUpsertBulk) ClearPipeline() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearPipeline()
+	})
+}
+
+// SetPipelineStatus sets the "pipeline_status" field.
+func (u *TaskUpsertBulk) SetPipelineStatus(v string) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetPipelineStatus(v)
+	})
+}
+
+// UpdatePipelineStatus sets the "pipeline_status" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdatePipelineStatus() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdatePipelineStatus()
+	})
+}
+
+// SetSearch sets the "search" field.
+func (u *TaskUpsertBulk) SetSearch(v *schema.HPSearch) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetSearch(v)
+	})
+}
+
+// UpdateSearch sets the "search" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateSearch() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateSearch()
+	})
+}
+
+// ClearSearch clears the value of the "search" field.
+func (u *TaskUpsertBulk) ClearSearch() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearSearch()
+	})
+}
+
+// SetSearchStatus sets the "search_status" field.
+func (u *TaskUpsertBulk) SetSearchStatus(v string) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetSearchStatus(v)
+	})
+}
+
+// UpdateSearchStatus sets the "search_status" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateSearchStatus() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateSearchStatus()
+	})
+}
+
+// SetEarlyStop sets the "early_stop" field.
+func (u *TaskUpsertBulk) SetEarlyStop(v []schema.EarlyStopPolicy) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetEarlyStop(v)
+	})
+}
+
+// UpdateEarlyStop sets the "early_stop" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateEarlyStop() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateEarlyStop()
+	})
+}
+
+// ClearEarlyStop clears the value of the "early_stop" field.
+func (u *TaskUpsertBulk) ClearEarlyStop() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearEarlyStop()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *TaskUpsertBulk) SetUpdatedAt(v time.Time) *TaskUpsertBulk {
 	return u.Update(func(s *TaskUpsert) {
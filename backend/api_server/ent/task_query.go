@@ -6,6 +6,7 @@ import (
 	"api_server/ent/modeling"
 	"api_server/ent/predicate"
 	"api_server/ent/project"
+	"api_server/ent/schedule"
 	"api_server/ent/task"
 	"context"
 	"database/sql/driver"
@@ -27,6 +28,7 @@ type TaskQuery struct {
 	predicates    []predicate.Task
 	withProject   *ProjectQuery
 	withModelings *ModelingQuery
+	withSchedules *ScheduleQuery
 	modifiers     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -108,6 +110,28 @@ func (tq *TaskQuery) QueryModelings() *ModelingQuery {
 	return query
 }
 
+// QuerySchedules chains the current query on the "schedules" edge.
+func (tq *TaskQuery) QuerySchedules() *ScheduleQuery {
+	query := (&ScheduleClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(schedule.Table, schedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, task.SchedulesTable, task.SchedulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Task entity from the query.
 // Returns a *NotFoundError when no Task was found.
 func (tq *TaskQuery) First(ctx context.Context) (*Task, error) {
@@ -302,6 +326,7 @@ func (tq *TaskQuery) Clone() *TaskQuery {
 		predicates:    append([]predicate.Task{}, tq.predicates...),
 		withProject:   tq.withProject.Clone(),
 		withModelings: tq.withModelings.Clone(),
+		withSchedules: tq.withSchedules.Clone(),
 		// clone intermediate query.
 		sql:       tq.sql.Clone(),
 		path:      tq.path,
@@ -331,6 +356,17 @@ func (tq *TaskQuery) WithModelings(opts ...func(*ModelingQuery)) *TaskQuery {
 	return tq
 }
 
+// WithSchedules tells the query-builder to eager-load the nodes that are connected to
+// the "schedules" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithSchedules(opts ...func(*ScheduleQuery)) *TaskQuery {
+	query := (&ScheduleClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withSchedules = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +445,10 @@ func (tq *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 	var (
 		nodes       = []*Task{}
 		_spec       = tq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			tq.withProject != nil,
 			tq.withModelings != nil,
+			tq.withSchedules != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -448,6 +485,13 @@ func (tq *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 			return nil, err
 		}
 	}
+	if query := tq.withSchedules; query != nil {
+		if err := tq.loadSchedules(ctx, query, nodes,
+			func(n *Task) { n.Edges.Schedules = []*Schedule{} },
+			func(n *Task, e *Schedule) { n.Edges.Schedules = append(n.Edges.Schedules, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -510,6 +554,36 @@ func (tq *TaskQuery) loadModelings(ctx context.Context, query *ModelingQuery, no
 	}
 	return nil
 }
+func (tq *TaskQuery) loadSchedules(ctx context.Context, query *ScheduleQuery, nodes []*Task, init func(*Task), assign func(*Task, *Schedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(schedule.FieldTaskID)
+	}
+	query.Where(predicate.Schedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.SchedulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (tq *TaskQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
@@ -6,6 +6,8 @@ import (
 	"api_server/ent/modeling"
 	"api_server/ent/predicate"
 	"api_server/ent/project"
+	"api_server/ent/schedule"
+	"api_server/ent/schema"
 	"api_server/ent/task"
 	"context"
 	"errors"
@@ -141,6 +143,82 @@ func (tu *TaskUpdate) AppendParams(s []string) *TaskUpdate {
 	return tu
 }
 
+// SetPipeline sets the "pipeline" field.
+func (tu *TaskUpdate) SetPipeline(ss []schema.PipelineStage) *TaskUpdate {
+	tu.mutation.SetPipeline(ss)
+	return tu
+}
+
+// AppendPipeline appends ss to the "pipeline" field.
+func (tu *TaskUpdate) AppendPipeline(ss []schema.PipelineStage) *TaskUpdate {
+	tu.mutation.AppendPipeline(ss)
+	return tu
+}
+
+// ClearPipeline clears the value of the "pipeline" field.
+func (tu *TaskUpdate) ClearPipeline() *TaskUpdate {
+	tu.mutation.ClearPipeline()
+	return tu
+}
+
+// SetPipelineStatus sets the "pipeline_status" field.
+func (tu *TaskUpdate) SetPipelineStatus(s string) *TaskUpdate {
+	tu.mutation.SetPipelineStatus(s)
+	return tu
+}
+
+// SetNillablePipelineStatus sets the "pipeline_status" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillablePipelineStatus(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetPipelineStatus(*s)
+	}
+	return tu
+}
+
+// SetSearch sets the "search" field.
+func (tu *TaskUpdate) SetSearch(ss *schema.HPSearch) *TaskUpdate {
+	tu.mutation.SetSearch(ss)
+	return tu
+}
+
+// ClearSearch clears the value of the "search" field.
+func (tu *TaskUpdate) ClearSearch() *TaskUpdate {
+	tu.mutation.ClearSearch()
+	return tu
+}
+
+// SetSearchStatus sets the "search_status" field.
+func (tu *TaskUpdate) SetSearchStatus(s string) *TaskUpdate {
+	tu.mutation.SetSearchStatus(s)
+	return tu
+}
+
+// SetNillableSearchStatus sets the "search_status" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableSearchStatus(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetSearchStatus(*s)
+	}
+	return tu
+}
+
+// SetEarlyStop sets the "early_stop" field.
+func (tu *TaskUpdate) SetEarlyStop(ssp []schema.EarlyStopPolicy) *TaskUpdate {
+	tu.mutation.SetEarlyStop(ssp)
+	return tu
+}
+
+// AppendEarlyStop appends ssp to the "early_stop" field.
+func (tu *TaskUpdate) AppendEarlyStop(ssp []schema.EarlyStopPolicy) *TaskUpdate {
+	tu.mutation.AppendEarlyStop(ssp)
+	return tu
+}
+
+// ClearEarlyStop clears the value of the "early_stop" field.
+func (tu *TaskUpdate) ClearEarlyStop() *TaskUpdate {
+	tu.mutation.ClearEarlyStop()
+	return tu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (tu *TaskUpdate) SetUpdatedAt(t time.Time) *TaskUpdate {
 	tu.mutation.SetUpdatedAt(t)
@@ -175,6 +253,21 @@ func (tu *TaskUpdate) AddModelings(m ...*Modeling) *TaskUpdate {
 	return tu.AddModelingIDs(ids...)
 }
 
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by IDs.
+func (tu *TaskUpdate) AddScheduleIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddScheduleIDs(ids...)
+	return tu
+}
+
+// AddSchedules adds the "schedules" edges to the Schedule entity.
+func (tu *TaskUpdate) AddSchedules(s ...*Schedule) *TaskUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.AddScheduleIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tu *TaskUpdate) Mutation() *TaskMutation {
 	return tu.mutation
@@ -207,6 +300,27 @@ func (tu *TaskUpdate) RemoveModelings(m ...*Modeling) *TaskUpdate {
 	return tu.RemoveModelingIDs(ids...)
 }
 
+// ClearSchedules clears all "schedules" edges to the Schedule entity.
+func (tu *TaskUpdate) ClearSchedules() *TaskUpdate {
+	tu.mutation.ClearSchedules()
+	return tu
+}
+
+// RemoveScheduleIDs removes the "schedules" edge to Schedule entities by IDs.
+func (tu *TaskUpdate) RemoveScheduleIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveScheduleIDs(ids...)
+	return tu
+}
+
+// RemoveSchedules removes "schedules" edges to Schedule entities.
+func (tu *TaskUpdate) RemoveSchedules(s ...*Schedule) *TaskUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tu.RemoveScheduleIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
@@ -275,6 +389,40 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			sqljson.Append(u, task.FieldParams, value)
 		})
 	}
+	if value, ok := tu.mutation.Pipeline(); ok {
+		_spec.SetField(task.FieldPipeline, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedPipeline(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldPipeline, value)
+		})
+	}
+	if tu.mutation.PipelineCleared() {
+		_spec.ClearField(task.FieldPipeline, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.PipelineStatus(); ok {
+		_spec.SetField(task.FieldPipelineStatus, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Search(); ok {
+		_spec.SetField(task.FieldSearch, field.TypeJSON, value)
+	}
+	if tu.mutation.SearchCleared() {
+		_spec.ClearField(task.FieldSearch, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.SearchStatus(); ok {
+		_spec.SetField(task.FieldSearchStatus, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.EarlyStop(); ok {
+		_spec.SetField(task.FieldEarlyStop, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedEarlyStop(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldEarlyStop, value)
+		})
+	}
+	if tu.mutation.EarlyStopCleared() {
+		_spec.ClearField(task.FieldEarlyStop, field.TypeJSON)
+	}
 	if value, ok := tu.mutation.UpdatedAt(); ok {
 		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -352,6 +500,51 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.SchedulesTable,
+			Columns: []string{task.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedSchedulesIDs(); len(nodes) > 0 && !tu.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.SchedulesTable,
+			Columns: []string{task.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.SchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.SchedulesTable,
+			Columns: []string{task.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(tu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -483,6 +676,82 @@ func (tuo *TaskUpdateOne) AppendParams(s []string) *TaskUpdateOne {
 	return tuo
 }
 
+// SetPipeline sets the "pipeline" field.
+func (tuo *TaskUpdateOne) SetPipeline(ss []schema.PipelineStage) *TaskUpdateOne {
+	tuo.mutation.SetPipeline(ss)
+	return tuo
+}
+
+// AppendPipeline appends ss to the "pipeline" field.
+func (tuo *TaskUpdateOne) AppendPipeline(ss []schema.PipelineStage) *TaskUpdateOne {
+	tuo.mutation.AppendPipeline(ss)
+	return tuo
+}
+
+// ClearPipeline clears the value of the "pipeline" field.
+func (tuo *TaskUpdateOne) ClearPipeline() *TaskUpdateOne {
+	tuo.mutation.ClearPipeline()
+	return tuo
+}
+
+// SetPipelineStatus sets the "pipeline_status" field.
+func (tuo *TaskUpdateOne) SetPipelineStatus(s string) *TaskUpdateOne {
+	tuo.mutation.SetPipelineStatus(s)
+	return tuo
+}
+
+// SetNillablePipelineStatus sets the "pipeline_status" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillablePipelineStatus(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetPipelineStatus(*s)
+	}
+	return tuo
+}
+
+// SetSearch sets the "search" field.
+func (tuo *TaskUpdateOne) SetSearch(ss *schema.HPSearch) *TaskUpdateOne {
+	tuo.mutation.SetSearch(ss)
+	return tuo
+}
+
+// ClearSearch clears the value of the "search" field.
+func (tuo *TaskUpdateOne) ClearSearch() *TaskUpdateOne {
+	tuo.mutation.ClearSearch()
+	return tuo
+}
+
+// SetSearchStatus sets the "search_status" field.
+func (tuo *TaskUpdateOne) SetSearchStatus(s string) *TaskUpdateOne {
+	tuo.mutation.SetSearchStatus(s)
+	return tuo
+}
+
+// SetNillableSearchStatus sets the "search_status" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableSearchStatus(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetSearchStatus(*s)
+	}
+	return tuo
+}
+
+// SetEarlyStop sets the "early_stop" field.
+func (tuo *TaskUpdateOne) SetEarlyStop(ssp []schema.EarlyStopPolicy) *TaskUpdateOne {
+	tuo.mutation.SetEarlyStop(ssp)
+	return tuo
+}
+
+// AppendEarlyStop appends ssp to the "early_stop" field.
+func (tuo *TaskUpdateOne) AppendEarlyStop(ssp []schema.EarlyStopPolicy) *TaskUpdateOne {
+	tuo.mutation.AppendEarlyStop(ssp)
+	return tuo
+}
+
+// ClearEarlyStop clears the value of the "early_stop" field.
+func (tuo *TaskUpdateOne) ClearEarlyStop() *TaskUpdateOne {
+	tuo.mutation.ClearEarlyStop()
+	return tuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (tuo *TaskUpdateOne) SetUpdatedAt(t time.Time) *TaskUpdateOne {
 	tuo.mutation.SetUpdatedAt(t)
@@ -517,6 +786,21 @@ func (tuo *TaskUpdateOne) AddModelings(m ...*Modeling) *TaskUpdateOne {
 	return tuo.AddModelingIDs(ids...)
 }
 
+// AddScheduleIDs adds the "schedules" edge to the Schedule entity by IDs.
+func (tuo *TaskUpdateOne) AddScheduleIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddScheduleIDs(ids...)
+	return tuo
+}
+
+// AddSchedules adds the "schedules" edges to the Schedule entity.
+func (tuo *TaskUpdateOne) AddSchedules(s ...*Schedule) *TaskUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.AddScheduleIDs(ids...)
+}
+
 // Mutation returns the TaskMutation object of the builder.
 func (tuo *TaskUpdateOne) Mutation() *TaskMutation {
 	return tuo.mutation
@@ -549,6 +833,27 @@ func (tuo *TaskUpdateOne) RemoveModelings(m ...*Modeling) *TaskUpdateOne {
 	return tuo.RemoveModelingIDs(ids...)
 }
 
+// ClearSchedules clears all "schedules" edges to the Schedule entity.
+func (tuo *TaskUpdateOne) ClearSchedules() *TaskUpdateOne {
+	tuo.mutation.ClearSchedules()
+	return tuo
+}
+
+// RemoveScheduleIDs removes the "schedules" edge to Schedule entities by IDs.
+func (tuo *TaskUpdateOne) RemoveScheduleIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveScheduleIDs(ids...)
+	return tuo
+}
+
+// RemoveSchedules removes "schedules" edges to Schedule entities.
+func (tuo *TaskUpdateOne) RemoveSchedules(s ...*Schedule) *TaskUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return tuo.RemoveScheduleIDs(ids...)
+}
+
 // Where appends a list predicates to the TaskUpdate builder.
 func (tuo *TaskUpdateOne) Where(ps ...predicate.Task) *TaskUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -647,6 +952,40 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 			sqljson.Append(u, task.FieldParams, value)
 		})
 	}
+	if value, ok := tuo.mutation.Pipeline(); ok {
+		_spec.SetField(task.FieldPipeline, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedPipeline(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldPipeline, value)
+		})
+	}
+	if tuo.mutation.PipelineCleared() {
+		_spec.ClearField(task.FieldPipeline, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.PipelineStatus(); ok {
+		_spec.SetField(task.FieldPipelineStatus, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Search(); ok {
+		_spec.SetField(task.FieldSearch, field.TypeJSON, value)
+	}
+	if tuo.mutation.SearchCleared() {
+		_spec.ClearField(task.FieldSearch, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.SearchStatus(); ok {
+		_spec.SetField(task.FieldSearchStatus, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.EarlyStop(); ok {
+		_spec.SetField(task.FieldEarlyStop, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedEarlyStop(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldEarlyStop, value)
+		})
+	}
+	if tuo.mutation.EarlyStopCleared() {
+		_spec.ClearField(task.FieldEarlyStop, field.TypeJSON)
+	}
 	if value, ok := tuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -724,6 +1063,51 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.SchedulesTable,
+			Columns: []string{task.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedSchedulesIDs(); len(nodes) > 0 && !tuo.mutation.SchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.SchedulesTable,
+			Columns: []string{task.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.SchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.SchedulesTable,
+			Columns: []string{task.SchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(tuo.modifiers...)
 	_node = &Task{config: tuo.config}
 	_spec.Assign = _node.assignValues
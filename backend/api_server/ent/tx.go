@@ -22,12 +22,20 @@ type Tx struct {
 	DatasetRoot *DatasetRootClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// DeviceToken is the client for interacting with the DeviceToken builders.
+	DeviceToken *DeviceTokenClient
+	// EarlyStop is the client for interacting with the EarlyStop builders.
+	EarlyStop *EarlyStopClient
 	// EngineLog is the client for interacting with the EngineLog builders.
 	EngineLog *EngineLogClient
 	// Gpu is the client for interacting with the Gpu builders.
 	Gpu *GpuClient
+	// GpuTelemetry is the client for interacting with the GpuTelemetry builders.
+	GpuTelemetry *GpuTelemetryClient
 	// HyperParamsHistory is the client for interacting with the HyperParamsHistory builders.
 	HyperParamsHistory *HyperParamsHistoryClient
+	// MaintenanceWindow is the client for interacting with the MaintenanceWindow builders.
+	MaintenanceWindow *MaintenanceWindowClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
 	// Modeling is the client for interacting with the Modeling builders.
@@ -36,8 +44,18 @@ type Tx struct {
 	ModelingDetails *ModelingDetailsClient
 	// ModelingModels is the client for interacting with the ModelingModels builders.
 	ModelingModels *ModelingModelsClient
+	// Preemption is the client for interacting with the Preemption builders.
+	Preemption *PreemptionClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
+	// Quota is the client for interacting with the Quota builders.
+	Quota *QuotaClient
+	// Reservation is the client for interacting with the Reservation builders.
+	Reservation *ReservationClient
+	// Schedule is the client for interacting with the Schedule builders.
+	Schedule *ScheduleClient
+	// ScheduleHistory is the client for interacting with the ScheduleHistory builders.
+	ScheduleHistory *ScheduleHistoryClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// Trial is the client for interacting with the Trial builders.
@@ -187,14 +205,23 @@ func (tx *Tx) init() {
 	tx.Dataset = NewDatasetClient(tx.config)
 	tx.DatasetRoot = NewDatasetRootClient(tx.config)
 	tx.Device = NewDeviceClient(tx.config)
+	tx.DeviceToken = NewDeviceTokenClient(tx.config)
+	tx.EarlyStop = NewEarlyStopClient(tx.config)
 	tx.EngineLog = NewEngineLogClient(tx.config)
 	tx.Gpu = NewGpuClient(tx.config)
+	tx.GpuTelemetry = NewGpuTelemetryClient(tx.config)
 	tx.HyperParamsHistory = NewHyperParamsHistoryClient(tx.config)
+	tx.MaintenanceWindow = NewMaintenanceWindowClient(tx.config)
 	tx.Menu = NewMenuClient(tx.config)
 	tx.Modeling = NewModelingClient(tx.config)
 	tx.ModelingDetails = NewModelingDetailsClient(tx.config)
 	tx.ModelingModels = NewModelingModelsClient(tx.config)
+	tx.Preemption = NewPreemptionClient(tx.config)
 	tx.Project = NewProjectClient(tx.config)
+	tx.Quota = NewQuotaClient(tx.config)
+	tx.Reservation = NewReservationClient(tx.config)
+	tx.Schedule = NewScheduleClient(tx.config)
+	tx.ScheduleHistory = NewScheduleHistoryClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
 	tx.Trial = NewTrialClient(tx.config)
 	tx.TrialDetails = NewTrialDetailsClient(tx.config)
@@ -7,6 +7,7 @@ toolchain go1.23.6
 require (
 	github.com/gin-gonic/gin v1.9.1
 	github.com/lib/pq v1.10.9
+	github.com/mattn/go-sqlite3 v1.14.16
 )
 
 require (
@@ -39,7 +40,7 @@ require (
 	golang.org/x/mod v0.24.0 // indirect
 	golang.org/x/net v0.40.0 // indirect
 	golang.org/x/sys v0.33.0 // indirect
-	google.golang.org/protobuf v1.34.0 // indirect
+	google.golang.org/protobuf v1.34.2
 )
 
 require (
@@ -60,6 +61,7 @@ require (
 	github.com/xuri/excelize/v2 v2.8.0
 	golang.org/x/image v0.22.0
 	golang.org/x/text v0.25.0
+	google.golang.org/grpc v1.67.3
 	gopkg.in/natefinch/lumberjack.v2 v2.2.1
 	gopkg.in/yaml.v3 v3.0.1
 )
@@ -89,4 +91,5 @@ require (
 	golang.org/x/arch v0.7.0 // indirect
 	golang.org/x/sync v0.14.0 // indirect
 	golang.org/x/tools v0.33.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240814211410-ddb44dafa142 // indirect
 )
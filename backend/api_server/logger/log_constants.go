@@ -25,15 +25,19 @@ var (
 	CODE_LOGOUT_FAILED = State{Code: "AU0003", Message: "Failed to logout"}
 	CODE_TOKEN_EXPIRED = State{Code: "AU0004", Message: "Expired login"}
 
+	CODE_DEVICE_TOKEN_INVALID = State{Code: "AU0005", Message: "Invalid device token"}
+
 	CODE_REMOTE_SELECT          = State{Code: "RM0001", Message: "Failed to call engine"}
 	CODE_REMOTE_CREATE_REQ      = State{Code: "RM0002", Message: "Can't create request"}
 	CODE_REMOTE_REQUEST         = State{Code: "RM0003", Message: "Can't connect to server"}
 	CODE_REMOTE_RESPONSE        = State{Code: "RM0004", Message: "Can't parse response"}
 	CODE_REMOTE_NOT_FOUND_MODEL = State{Code: "RM0005", Message: "Not found model"}
+	CODE_ENGINE_INCOMPATIBLE    = State{Code: "RM0006", Message: "Incompatible engine"}
 
 	CODE_FILE_NOT_EXIST   = State{Code: "FL0001", Message: "File Not Exist"}
 	CODE_FILE_OPEN        = State{Code: "FL0002", Message: "File Open Error"}
 	CODE_FILE_READ        = State{Code: "FL0003", Message: "File Read Error"}
+	CODE_FILE_WRITE       = State{Code: "FL0004", Message: "File Write Error"}
 	CODE_DIR_NOT_EXIST    = State{Code: "FL0005", Message: "Directory Not Exist"}
 	CODE_TESTID_NOT_EXIST = State{Code: "FL0005", Message: "Test ID Not Exist"}
 	CODE_INVALID_METRIC   = State{Code: "FL0006", Message: "Invalid Metric"}
@@ -51,6 +55,16 @@ var (
 
 	CODE_MODELING_IN_PROGRESS      = State{Code: "MR001", Message: "There's something in progress"}
 	CODE_MODELING_DEVICE_NOT_EXIST = State{Code: "MR002", Message: "There's nothing usable devices"}
+	CODE_MODELING_NOT_QUEUED       = State{Code: "MR003", Message: "Modeling is not waiting in queue"}
+	CODE_PIPELINE_INVALID          = State{Code: "MR004", Message: "Invalid pipeline stage"}
+	CODE_SCHEDULE_INVALID          = State{Code: "MR005", Message: "Invalid schedule"}
+	CODE_QUOTA_INVALID             = State{Code: "MR006", Message: "Invalid quota"}
+	CODE_QUOTA_EXCEEDED            = State{Code: "MR007", Message: "Quota exceeded"}
+	CODE_SEARCH_INVALID            = State{Code: "MR008", Message: "Invalid hyperparameter search"}
+	CODE_EARLY_STOP_INVALID        = State{Code: "MR009", Message: "Invalid early stopping policy"}
+	CODE_MAINTENANCE_INVALID       = State{Code: "MR010", Message: "Invalid maintenance window"}
+	CODE_RESERVATION_INVALID       = State{Code: "MR011", Message: "Invalid reservation"}
+	CODE_RESERVATION_CONFLICT      = State{Code: "MR012", Message: "Reservation conflicts"}
 
 	ERROR_CODE_SSH_ERROR = State{Code: "7001", Message: "Failed to find gpu"}
 	CODE_TAPI_SUCCESS    = State{Code: "0000", Message: "Success"}
@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"bytes"
 	"compress/gzip"
 	"fmt"
 	"io"
@@ -133,6 +134,27 @@ func ApiResponseWithZipFile(c *gin.Context, filepath string) {
 	)
 }
 
+// ApiResponseWithCsv 함수는 메모리에서 만든 CSV 데이터를 첨부 파일로 클라이언트에게 반환합니다.
+//
+// 파라미터:
+//   - c: *gin.Context - Gin의 컨텍스트 객체
+//   - filename: string - 다운로드될 파일 이름
+//   - data: []byte - CSV 데이터
+func ApiResponseWithCsv(c *gin.Context, filename string, data []byte) {
+	extraHeaders := map[string]string{
+		"Content-Disposition": fmt.Sprintf("attachment; filename=%s", filename),
+	}
+
+	Info(fmt.Sprintf(`[%s] RES (%s:%s)`, requestid.Get(c), c.Request.Method, c.Request.URL.Path))
+	c.DataFromReader(
+		http.StatusOK,
+		int64(len(data)),
+		"text/csv",
+		bytes.NewReader(data),
+		extraHeaders,
+	)
+}
+
 // ApiResponseWithJsonFile 함수는 JSON 파일을 읽어 클라이언트에게 반환하는 API 응답을 처리합니다.
 // 클라이언트가 gzip 압축을 지원하면 gzip으로 압축하여 전달하며, 그렇지 않으면 원본 파일을 그대로 전송합니다.
 //
@@ -23,6 +23,8 @@ import (
 	download_router "api_server/download/router"
 	menu_router "api_server/menu/router"
 	project_router "api_server/project/router"
+	quota_router "api_server/quota/router"
+	reservation_router "api_server/reservation/router"
 	tapi_router "api_server/tapi/router"
 	task_router "api_server/task/router"
 	user_router "api_server/user/router"
@@ -34,8 +36,10 @@ import (
 	config_service "api_server/configuration/service"
 	dataset_module "api_server/dataset/modules"
 	dataset_repo "api_server/dataset/repository"
+	device_repo "api_server/device/repository"
 	device_service "api_server/device/service"
 	"api_server/logger"
+	task_repo "api_server/task/repository"
 	task_service "api_server/task/service"
 	"api_server/utils"
 )
@@ -65,11 +69,21 @@ func main() {
 	go datasetWatcher.WatchDataset()
 	// Check GPU Nodes
 	device_service.GatherDevicesInfo()
+	go device_service.NewHealthChecker(device_repo.New(), device_repo.NewGPUDAO()).WatchDevices()
+	go device_service.NewTelemetryService(device_repo.NewTelemetryDAO(), device_repo.NewGPUDAO()).WatchRetention()
+	// Drain devices in scheduled maintenance windows
+	go device_service.NewDrainService(device_repo.New(), device_repo.NewGPUDAO(), device_repo.NewMaintenanceDAO()).WatchMaintenance()
 	// change trial state to cancel
 	// trial_service.InitializeTrials()
 	// Watch Task Queue
 	scheduler := task_service.NewTaskScheduler()
 	go scheduler.WatchTasks()
+	// Watch retraining schedules
+	go task_service.NewScheduleService(task_repo.NewScheduleDAO(), task_repo.NewModelingDAO()).WatchSchedules()
+	// Stop hopeless trials by early stopping policies
+	go task_service.NewEarlyStopService(task_repo.NewEarlyStopDAO(), task_repo.NewTaskDAO(), task_repo.NewModelingDAO()).WatchTrials()
+	// Receive training progress and logs from gRPC engines
+	go task_service.NewEngineEventReceiver(task_repo.NewEngineEventDAO(), device_repo.New()).WatchEngines()
 
 	gomode := os.Args[0]
 	if strings.Contains(gomode, "Temp\\go-build") || strings.Contains(gomode, "tmp/go-build") {
@@ -105,6 +119,8 @@ func main() {
 	ws_router.InitWebSocketRouter(r)
 	user_router.InitRouter(r)
 	tapi_router.InitRouter(r)
+	quota_router.InitRouter(r)
+	reservation_router.InitRouter(r)
 	menu_router.InitRouter(r)
 
 	r.NoRoute(func(c *gin.Context) {
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"api_server/ent"
+	"api_server/ent/quota"
+	"api_server/logger"
+	"api_server/utils"
+)
+
+type IQuotaDAO interface {
+	InsertOne(ctx context.Context, req QuotaDTO) (*ent.Quota, error)
+	SelectAll(ctx context.Context) ([]*ent.Quota, error)
+	UpdateOne(ctx context.Context, req QuotaDTO) (*ent.Quota, error)
+	DeleteOne(ctx context.Context, id int) error
+}
+
+type QuotaDAO struct {
+	dbms *ent.Client
+}
+
+var onceQuota sync.Once
+var instanceQuota *QuotaDAO
+
+func NewQuotaDAO() *QuotaDAO {
+	onceQuota.Do(func() {
+		logger.Debug("Quota DAO instance")
+		instanceQuota = &QuotaDAO{
+			dbms: utils.GetEntClient(),
+		}
+	})
+
+	return instanceQuota
+}
+
+func (dao *QuotaDAO) InsertOne(ctx context.Context, req QuotaDTO) (*ent.Quota, error) {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	return dao.dbms.Quota.Create().
+		SetTargetType(req.TargetType).
+		SetTargetID(req.TargetID).
+		SetMaxGpus(req.MaxGPUs).
+		SetMaxQueued(req.MaxQueued).
+		SetCreatedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+}
+
+func (dao *QuotaDAO) SelectAll(ctx context.Context) ([]*ent.Quota, error) {
+	return dao.dbms.Quota.Query().
+		Order(quota.ByTargetType(), quota.ByTargetID()).
+		All(ctx)
+}
+
+func (dao *QuotaDAO) UpdateOne(ctx context.Context, req QuotaDTO) (*ent.Quota, error) {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	return dao.dbms.Quota.UpdateOneID(req.ID).
+		SetMaxGpus(req.MaxGPUs).
+		SetMaxQueued(req.MaxQueued).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+}
+
+func (dao *QuotaDAO) DeleteOne(ctx context.Context, id int) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	return dao.dbms.Quota.DeleteOneID(id).Exec(ctx)
+}
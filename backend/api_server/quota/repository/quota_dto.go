@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"api_server/ent"
+	"time"
+)
+
+type QuotaDTO struct {
+	ID         int       `json:"id"`
+	TargetType string    `json:"target_type"` // project | user_group
+	TargetID   int       `json:"target_id"`   // project ID 또는 user group level
+	MaxGPUs    int       `json:"max_gpus"`    // 동시에 사용할 수 있는 GPU 개수, 0이면 제한 없음
+	MaxQueued  int       `json:"max_queued"`  // 대기열에 넣을 수 있는 modeling 개수, 0이면 제한 없음
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// QuotaUsageDTO는 quota 제한과 현재 사용량입니다.
+type QuotaUsageDTO struct {
+	QuotaDTO
+	UsedGPUs int `json:"used_gpus"`
+	Queued   int `json:"queued"`
+}
+
+func ConvertQuotaEntToDTO(entity *ent.Quota) *QuotaDTO {
+	return &QuotaDTO{
+		ID:         entity.ID,
+		TargetType: entity.TargetType,
+		TargetID:   entity.TargetID,
+		MaxGPUs:    entity.MaxGpus,
+		MaxQueued:  entity.MaxQueued,
+		CreatedAt:  entity.CreatedAt,
+		UpdatedAt:  entity.UpdatedAt,
+	}
+}
+
+func ConvertQuotaEntsToDTOs(ents []*ent.Quota) []*QuotaDTO {
+	dtos := []*QuotaDTO{}
+
+	for _, entity := range ents {
+		dtos = append(dtos, ConvertQuotaEntToDTO(entity))
+	}
+
+	return dtos
+}
@@ -0,0 +1,82 @@
+package router
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"api_server/logger"
+	repo "api_server/quota/repository"
+	"api_server/quota/service"
+)
+
+type QuotaController struct {
+	svc service.IQuotaService
+}
+
+var onceQuota sync.Once
+var instanceQuota *QuotaController
+
+func New(svc service.IQuotaService) *QuotaController {
+	onceQuota.Do(func() {
+		logger.Debug("Quota Controller instance")
+		instanceQuota = &QuotaController{
+			svc: svc,
+		}
+	})
+
+	return instanceQuota
+}
+
+func (ctlr *QuotaController) CreateOne(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	reqDTO := repo.QuotaDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.Create(reqDTO)
+		logger.ApiResponse(c, report, data)
+	}
+}
+
+func (ctlr *QuotaController) GetAll(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	data, report := ctlr.svc.ReadAll()
+	logger.ApiResponse(c, report, data)
+}
+
+func (ctlr *QuotaController) GetUsage(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	data, report := ctlr.svc.ReadUsage()
+	logger.ApiResponse(c, report, data)
+}
+
+func (ctlr *QuotaController) UpdateById(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	reqDTO := repo.QuotaDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.Edit(reqDTO)
+		logger.ApiResponse(c, report, data)
+	}
+}
+
+func (ctlr *QuotaController) DeleteById(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		report := ctlr.svc.DeleteOne(id)
+		logger.ApiResponse(c, report, nil)
+	}
+}
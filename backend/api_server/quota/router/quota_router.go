@@ -0,0 +1,27 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"api_server/quota/repository"
+	"api_server/quota/service"
+	repo_task "api_server/task/repository"
+	repo_user "api_server/user/repository"
+	"api_server/utils"
+)
+
+func InitRouter(r *gin.Engine) {
+	quota_dao := repository.NewQuotaDAO()
+	svc := service.NewQuotaService(quota_dao, repo_user.NewUserDAO(), repo_task.NewTaskDAO(), repo_task.NewModelingDAO())
+	controller := New(svc)
+
+	apiRouter := r.Group(utils.API_BASE_URL_V1 + "/quota")
+	{
+		apiRouter.Use(utils.JWTAuthMiddleware(), utils.GroupMiddleware(0, 1))
+		apiRouter.POST("", controller.CreateOne)
+		apiRouter.GET("", controller.GetAll)
+		apiRouter.GET("/usage", controller.GetUsage)
+		apiRouter.PUT("", controller.UpdateById)
+		apiRouter.DELETE("/:id", controller.DeleteById)
+	}
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"fmt"
+
+	"api_server/ent"
+	repo "api_server/quota/repository"
+	task_repo "api_server/task/repository"
+	"api_server/utils"
+)
+
+// target은 quota가 적용되는 project 또는 user group 입니다.
+type target struct {
+	kind string
+	id   int
+}
+
+// Limits는 quota 제한과 현재 사용량입니다.
+// 스케줄러는 한 주기 동안 Limits를 사용하며, 배치한 modeling을 Charge로 사용량에 더합니다.
+type Limits struct {
+	quotas map[target]*ent.Quota
+	groups map[string]int // username -> user group level
+	gpus   map[target]int
+	queued map[target]int
+}
+
+func newLimits(quotas []*ent.Quota, users []*ent.User) *Limits {
+	l := &Limits{
+		quotas: make(map[target]*ent.Quota),
+		groups: make(map[string]int),
+		gpus:   make(map[target]int),
+		queued: make(map[target]int),
+	}
+	for _, q := range quotas {
+		l.quotas[target{q.TargetType, q.TargetID}] = q
+	}
+	for _, u := range users {
+		l.groups[u.Username] = u.Group
+	}
+
+	return l
+}
+
+// targets는 modeling의 project와 사용자의 user group 중 quota가 있는 것들입니다.
+func (l *Limits) targets(projectID int, username string) []target {
+	targets := []target{}
+	if _, ok := l.quotas[target{utils.QUOTA_TARGET_PROJECT, projectID}]; ok {
+		targets = append(targets, target{utils.QUOTA_TARGET_PROJECT, projectID})
+	}
+	if group, ok := l.groups[username]; ok {
+		if _, ok := l.quotas[target{utils.QUOTA_TARGET_USER_GROUP, group}]; ok {
+			targets = append(targets, target{utils.QUOTA_TARGET_USER_GROUP, group})
+		}
+	}
+
+	return targets
+}
+
+// Charge는 실행 중이거나 이번 주기에 배치한 modeling의 GPU 사용량을 더합니다.
+func (l *Limits) Charge(m *ent.Modeling) {
+	for _, t := range l.targets(modelingProject(m), m.Username) {
+		l.gpus[t] += task_repo.RequestedGPUCount(m)
+	}
+}
+
+// Queue는 대기 중인 modeling 개수를 더합니다.
+func (l *Limits) Queue(m *ent.Modeling) {
+	for _, t := range l.targets(modelingProject(m), m.Username) {
+		l.queued[t]++
+	}
+}
+
+// CheckGPUs는 modeling을 실행했을 때 GPU quota를 넘는지 확인합니다.
+func (l *Limits) CheckGPUs(m *ent.Modeling) error {
+	gpus := task_repo.RequestedGPUCount(m)
+	for _, t := range l.targets(modelingProject(m), m.Username) {
+		if limit := l.quotas[t].MaxGpus; limit > 0 && l.gpus[t]+gpus > limit {
+			return fmt.Errorf("%s %d uses %d of %d gpus, requested %d", t.kind, t.id, l.gpus[t], limit, gpus)
+		}
+	}
+
+	return nil
+}
+
+// CheckQueue는 modeling을 하나 더 대기열에 넣었을 때 대기열 quota를 넘는지 확인합니다.
+func (l *Limits) CheckQueue(projectID int, username string) error {
+	for _, t := range l.targets(projectID, username) {
+		if limit := l.quotas[t].MaxQueued; limit > 0 && l.queued[t]+1 > limit {
+			return fmt.Errorf("%s %d has %d of %d queued modelings", t.kind, t.id, l.queued[t], limit)
+		}
+	}
+
+	return nil
+}
+
+// Usage는 quota 별 제한과 현재 사용량입니다.
+func (l *Limits) Usage(quotas []*ent.Quota) []*repo.QuotaUsageDTO {
+	usage := []*repo.QuotaUsageDTO{}
+	for _, q := range quotas {
+		t := target{q.TargetType, q.TargetID}
+		usage = append(usage, &repo.QuotaUsageDTO{
+			QuotaDTO: *repo.ConvertQuotaEntToDTO(q),
+			UsedGPUs: l.gpus[t],
+			Queued:   l.queued[t],
+		})
+	}
+
+	return usage
+}
+
+func modelingProject(m *ent.Modeling) int {
+	if m.Edges.Task != nil {
+		return m.Edges.Task.ProjectID
+	}
+
+	return 0
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"testing"
+
+	"api_server/ent"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LimitsTestSuite struct {
+	suite.Suite
+	limits *Limits
+}
+
+func modelingIn(project int, username string, params string) *ent.Modeling {
+	m := &ent.Modeling{Username: username, Params: []string{params}}
+	m.Edges.Task = &ent.Task{ProjectID: project}
+	return m
+}
+
+func (suite *LimitsTestSuite) SetupTest() {
+	suite.limits = newLimits(
+		[]*ent.Quota{
+			{TargetType: utils.QUOTA_TARGET_PROJECT, TargetID: 1, MaxGpus: 2},
+			{TargetType: utils.QUOTA_TARGET_USER_GROUP, TargetID: 2, MaxQueued: 1},
+		},
+		[]*ent.User{{Username: "alice", Group: 2}, {Username: "bob", Group: 1}},
+	)
+}
+
+func (suite *LimitsTestSuite) TestGPUs() {
+	suite.limits.Charge(modelingIn(1, "bob", `{"device_ids":[1]}`))
+
+	suite.NoError(suite.limits.CheckGPUs(modelingIn(1, "bob", `{"gpu_auto":true,"gpu_count":1}`)))
+	suite.Error(suite.limits.CheckGPUs(modelingIn(1, "bob", `{"gpu_auto":true,"gpu_count":2}`)))
+	// quota가 없는 project는 제한하지 않는다.
+	suite.NoError(suite.limits.CheckGPUs(modelingIn(3, "bob", `{"gpu_auto":true,"gpu_count":8}`)))
+}
+
+func (suite *LimitsTestSuite) TestQueue() {
+	suite.NoError(suite.limits.CheckQueue(3, "alice"))
+
+	suite.limits.Queue(modelingIn(3, "alice", `{}`))
+	suite.Error(suite.limits.CheckQueue(3, "alice"))
+	suite.NoError(suite.limits.CheckQueue(3, "bob"))
+
+	usage := suite.limits.Usage([]*ent.Quota{{TargetType: utils.QUOTA_TARGET_USER_GROUP, TargetID: 2, MaxQueued: 1}})
+	suite.Equal(1, usage[0].Queued)
+}
+
+func TestLimitsTestSuite(t *testing.T) {
+	suite.Run(t, new(LimitsTestSuite))
+}
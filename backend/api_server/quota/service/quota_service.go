@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"api_server/ent"
+	"api_server/logger"
+	repo "api_server/quota/repository"
+	task_repo "api_server/task/repository"
+	user_repo "api_server/user/repository"
+	"api_server/utils"
+)
+
+type IQuotaService interface {
+	// Create 함수는 project 또는 user group에 quota를 추가합니다.
+	//
+	// 매개변수:
+	//   - req: quota 정보. target_type이 user_group이면 target_id는 user group level 입니다.
+	//
+	// 반환값:
+	//   - *repo.QuotaDTO: 추가된 quota
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Create(req repo.QuotaDTO) (*repo.QuotaDTO, *logger.Report)
+
+	// ReadAll 함수는 전체 quota 목록을 조회합니다.
+	//
+	// 반환값:
+	//   - []*repo.QuotaDTO: quota 목록
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadAll() ([]*repo.QuotaDTO, *logger.Report)
+
+	// Edit 함수는 quota의 제한 값을 변경합니다.
+	//
+	// 매개변수:
+	//   - req: 변경할 quota 정보
+	//
+	// 반환값:
+	//   - *repo.QuotaDTO: 변경된 quota
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Edit(req repo.QuotaDTO) (*repo.QuotaDTO, *logger.Report)
+
+	// DeleteOne 함수는 quota를 삭제합니다.
+	//
+	// 매개변수:
+	//   - id: quota ID
+	//
+	// 반환값:
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	DeleteOne(id int) *logger.Report
+
+	// ReadUsage 함수는 quota 별 제한과 현재 GPU 사용량, 대기 중인 modeling 개수를 조회합니다.
+	//
+	// 반환값:
+	//   - []*repo.QuotaUsageDTO: quota 별 사용량
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadUsage() ([]*repo.QuotaUsageDTO, *logger.Report)
+
+	// Load 함수는 스케줄링에 사용할 quota 제한과 현재 사용량을 읽습니다.
+	//
+	// 반환값:
+	//   - *Limits: quota 제한과 사용량
+	//   - error: 조회 중 발생한 오류
+	Load() (*Limits, error)
+
+	// CheckQueue 함수는 modeling을 대기열에 추가할 수 있는지 확인합니다.
+	//
+	// 매개변수:
+	//   - project_id: modeling이 속한 project ID
+	//   - username: modeling을 등록하는 사용자
+	//
+	// 반환값:
+	//   - *logger.Report: quota를 넘으면 CODE_QUOTA_EXCEEDED 리포트 객체
+	CheckQueue(project_id int, username string) *logger.Report
+
+	// CheckTaskQueue 함수는 task에 modeling을 추가할 수 있는지 확인합니다.
+	//
+	// 매개변수:
+	//   - task_id: modeling이 속한 task ID
+	//   - username: modeling을 등록하는 사용자
+	//
+	// 반환값:
+	//   - *logger.Report: quota를 넘으면 CODE_QUOTA_EXCEEDED 리포트 객체
+	CheckTaskQueue(task_id int, username string) *logger.Report
+}
+
+type QuotaService struct {
+	ctx          context.Context
+	dao          repo.IQuotaDAO
+	dao_user     user_repo.IUserDAO
+	dao_task     task_repo.ITaskDAO
+	dao_modeling task_repo.IModelingDAO
+}
+
+var onceQuota sync.Once
+var instanceQuota *QuotaService
+
+func NewQuotaService(dao repo.IQuotaDAO,
+	dao_user user_repo.IUserDAO,
+	dao_task task_repo.ITaskDAO,
+	dao_modeling task_repo.IModelingDAO,
+) *QuotaService {
+	onceQuota.Do(func() {
+		logger.Debug("Quota service instance")
+		instanceQuota = &QuotaService{
+			ctx:          context.Background(),
+			dao:          dao,
+			dao_user:     dao_user,
+			dao_task:     dao_task,
+			dao_modeling: dao_modeling,
+		}
+	})
+
+	return instanceQuota
+}
+
+func validateQuota(req repo.QuotaDTO) error {
+	if req.TargetType != utils.QUOTA_TARGET_PROJECT && req.TargetType != utils.QUOTA_TARGET_USER_GROUP {
+		return errors.New("invalid target_type: " + req.TargetType)
+	}
+	if req.TargetType == utils.QUOTA_TARGET_PROJECT && req.TargetID < 1 {
+		return errors.New("project quota requires target_id")
+	}
+	if req.TargetID < 0 || req.MaxGPUs < 0 || req.MaxQueued < 0 {
+		return fmt.Errorf("negative quota: %+v", req)
+	}
+
+	return nil
+}
+
+func (svc *QuotaService) Create(req repo.QuotaDTO) (*repo.QuotaDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	if err := validateQuota(req); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_QUOTA_INVALID, err)
+	}
+
+	if inserted, err := svc.dao.InsertOne(svc.ctx, req); ent.IsConstraintError(err) {
+		return nil, logger.CreateReport(&logger.CODE_DB_DUPLICATE, err)
+	} else if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_INSERT, err)
+	} else {
+		return repo.ConvertQuotaEntToDTO(inserted), nil
+	}
+}
+
+func (svc *QuotaService) ReadAll() ([]*repo.QuotaDTO, *logger.Report) {
+	if quotas, err := svc.dao.SelectAll(svc.ctx); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	} else {
+		return repo.ConvertQuotaEntsToDTOs(quotas), nil
+	}
+}
+
+func (svc *QuotaService) Edit(req repo.QuotaDTO) (*repo.QuotaDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	if req.MaxGPUs < 0 || req.MaxQueued < 0 {
+		return nil, logger.CreateReport(&logger.CODE_QUOTA_INVALID, fmt.Errorf("negative quota: %+v", req))
+	}
+
+	if updated, err := svc.dao.UpdateOne(svc.ctx, req); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	} else {
+		return repo.ConvertQuotaEntToDTO(updated), nil
+	}
+}
+
+func (svc *QuotaService) DeleteOne(id int) *logger.Report {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	if err := svc.dao.DeleteOne(svc.ctx, id); err != nil {
+		return logger.CreateReport(&logger.CODE_DB_DELETE, err)
+	}
+
+	return nil
+}
+
+func (svc *QuotaService) ReadUsage() ([]*repo.QuotaUsageDTO, *logger.Report) {
+	quotas, err := svc.dao.SelectAll(svc.ctx)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	limits, err := svc.load(quotas)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	return limits.Usage(quotas), nil
+}
+
+func (svc *QuotaService) Load() (*Limits, error) {
+	quotas, err := svc.dao.SelectAll(svc.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.load(quotas)
+}
+
+func (svc *QuotaService) load(quotas []*ent.Quota) (*Limits, error) {
+	users, err := svc.dao_user.SelectAll(svc.ctx)
+	if err != nil {
+		return nil, err
+	}
+	limits := newLimits(quotas, users)
+	if len(quotas) < 1 {
+		return limits, nil
+	}
+
+	running, err := svc.dao_modeling.SelectManyRunning(svc.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range running {
+		limits.Charge(m)
+	}
+
+	idle, err := svc.dao_modeling.SelectManyIdle(svc.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range idle {
+		limits.Queue(m)
+	}
+
+	return limits, nil
+}
+
+func (svc *QuotaService) CheckQueue(project_id int, username string) *logger.Report {
+	limits, err := svc.Load()
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	if err := limits.CheckQueue(project_id, username); err != nil {
+		return logger.CreateReport(&logger.CODE_QUOTA_EXCEEDED, err)
+	}
+
+	return nil
+}
+
+func (svc *QuotaService) CheckTaskQueue(task_id int, username string) *logger.Report {
+	task, err := svc.dao_task.SelectOne(svc.ctx, task_id)
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	return svc.CheckQueue(task.ProjectID, username)
+}
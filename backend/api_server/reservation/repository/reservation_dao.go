@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"api_server/ent"
+	"api_server/ent/reservation"
+	"api_server/logger"
+	"api_server/utils"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type IReservationDAO interface {
+	InsertOne(ctx context.Context, req ReservationDTO) (*ent.Reservation, error)
+
+	// SelectAll은 예약을 시작 시각 순으로 조회합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - device_id: 조회할 Device ID, 0이면 모든 Device
+	//
+	// 반환 값:
+	//   - []*ent.Reservation: 조회된 예약 리스트
+	//   - error: 오류 정보
+	SelectAll(ctx context.Context, device_id int) ([]*ent.Reservation, error)
+	SelectOne(ctx context.Context, id int) (*ent.Reservation, error)
+
+	// SelectRange는 from부터 to 사이에 걸쳐 있는 예약을 시작 시각 순으로 조회합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - device_id: 조회할 Device ID, 0이면 모든 Device
+	//   - from: 조회 시작 시각
+	//   - to: 조회 종료 시각
+	//
+	// 반환 값:
+	//   - []*ent.Reservation: 조회된 예약 리스트
+	//   - error: 오류 정보
+	SelectRange(ctx context.Context, device_id int, from time.Time, to time.Time) ([]*ent.Reservation, error)
+
+	// SelectNotEnded는 종료 시각이 지나지 않은 예약을 조회합니다. scheduler가 배치할 GPU를 고를 때 사용합니다.
+	//
+	// 매개변수:
+	//   - ctx: context.Context
+	//   - now: 기준 시각
+	//
+	// 반환 값:
+	//   - []*ent.Reservation: 조회된 예약 리스트
+	//   - error: 오류 정보
+	SelectNotEnded(ctx context.Context, now time.Time) ([]*ent.Reservation, error)
+	UpdateOne(ctx context.Context, req ReservationDTO) (*ent.Reservation, error)
+	DeleteOne(ctx context.Context, id int) error
+}
+
+type ReservationDAO struct {
+	dbms *ent.Client
+}
+
+var onceReservation sync.Once
+var instanceReservation *ReservationDAO
+
+func NewReservationDAO() *ReservationDAO {
+	onceReservation.Do(func() {
+		logger.Debug("Reservation DAO instance")
+		instanceReservation = &ReservationDAO{
+			dbms: utils.GetEntClient(),
+		}
+	})
+
+	return instanceReservation
+}
+
+func (dao *ReservationDAO) InsertOne(ctx context.Context, req ReservationDTO) (*ent.Reservation, error) {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	return dao.dbms.Reservation.Create().
+		SetTitle(req.Title).
+		SetDeviceID(req.DeviceID).
+		SetGpuIds(req.GPUIDs).
+		SetProjectID(req.ProjectID).
+		SetUsername(req.Username).
+		SetStartsAt(req.StartsAt).
+		SetEndsAt(req.EndsAt).
+		SetCreatedBy(req.CreatedBy).
+		SetCreatedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+}
+
+func (dao *ReservationDAO) SelectAll(ctx context.Context, device_id int) ([]*ent.Reservation, error) {
+	query := dao.dbms.Reservation.Query()
+	if device_id > 0 {
+		query.Where(reservation.DeviceID(device_id))
+	}
+	return query.
+		Order(reservation.ByStartsAt(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (dao *ReservationDAO) SelectOne(ctx context.Context, id int) (*ent.Reservation, error) {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	return dao.dbms.Reservation.Get(ctx, id)
+}
+
+func (dao *ReservationDAO) SelectRange(ctx context.Context, device_id int, from time.Time, to time.Time) ([]*ent.Reservation, error) {
+	query := dao.dbms.Reservation.Query().
+		Where(
+			reservation.StartsAtLT(to),
+			reservation.EndsAtGT(from),
+		)
+	if device_id > 0 {
+		query.Where(reservation.DeviceID(device_id))
+	}
+	return query.
+		Order(reservation.ByStartsAt(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (dao *ReservationDAO) SelectNotEnded(ctx context.Context, now time.Time) ([]*ent.Reservation, error) {
+	return dao.dbms.Reservation.Query().
+		Where(reservation.EndsAtGT(now)).
+		Order(reservation.ByStartsAt(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (dao *ReservationDAO) UpdateOne(ctx context.Context, req ReservationDTO) (*ent.Reservation, error) {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	return dao.dbms.Reservation.UpdateOneID(req.ID).
+		SetTitle(req.Title).
+		SetDeviceID(req.DeviceID).
+		SetGpuIds(req.GPUIDs).
+		SetProjectID(req.ProjectID).
+		SetUsername(req.Username).
+		SetStartsAt(req.StartsAt).
+		SetEndsAt(req.EndsAt).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+}
+
+func (dao *ReservationDAO) DeleteOne(ctx context.Context, id int) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	return dao.dbms.Reservation.DeleteOneID(id).Exec(ctx)
+}
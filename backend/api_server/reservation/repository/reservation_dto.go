@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"time"
+
+	device_repo "api_server/device/repository"
+	"api_server/ent"
+)
+
+// ReservationDTO는 device 또는 GPU 예약입니다. ProjectID와 Username 중 하나만 지정합니다.
+type ReservationDTO struct {
+	ID        int       `json:"id"`
+	Title     string    `json:"title"`
+	DeviceID  int       `json:"device_id"`
+	GPUIDs    []int     `json:"gpu_ids"`    // 비어 있으면 device의 모든 GPU
+	ProjectID int       `json:"project_id"` // 예약한 project, user 예약이면 0
+	Username  string    `json:"username"`   // 예약한 user, project 예약이면 ""
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ConflictDTO는 예약하려는 시간에 같은 GPU를 쓰는 다른 예약과 device의 maintenance window 입니다.
+type ConflictDTO struct {
+	Conflict           bool                                `json:"conflict"`
+	Reservations       []*ReservationDTO                   `json:"reservations"`
+	MaintenanceWindows []*device_repo.MaintenanceWindowDTO `json:"maintenance_windows"`
+}
+
+// CalendarDTO는 device 하나의 기간 내 예약과 maintenance window를 시작 시각 순으로 나열한 일정입니다.
+type CalendarDTO struct {
+	DeviceID   int                 `json:"device_id"`
+	DeviceName string              `json:"device_name"`
+	Entries    []*CalendarEntryDTO `json:"entries"`
+}
+
+type CalendarEntryDTO struct {
+	Type      string    `json:"type"` // reservation | maintenance
+	ID        int       `json:"id"`
+	Title     string    `json:"title"`
+	GPUIDs    []int     `json:"gpu_ids"` // 비어 있으면 device의 모든 GPU
+	ProjectID int       `json:"project_id,omitempty"`
+	Username  string    `json:"username,omitempty"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+}
+
+func ConvertReservationEntToDTO(entity *ent.Reservation) *ReservationDTO {
+	return &ReservationDTO{
+		ID:        entity.ID,
+		Title:     entity.Title,
+		DeviceID:  entity.DeviceID,
+		GPUIDs:    entity.GpuIds,
+		ProjectID: entity.ProjectID,
+		Username:  entity.Username,
+		StartsAt:  entity.StartsAt,
+		EndsAt:    entity.EndsAt,
+		CreatedBy: entity.CreatedBy,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
+
+func ConvertReservationEntsToDTOs(ents []*ent.Reservation) []*ReservationDTO {
+	dtos := []*ReservationDTO{}
+
+	for _, entity := range ents {
+		dtos = append(dtos, ConvertReservationEntToDTO(entity))
+	}
+
+	return dtos
+}
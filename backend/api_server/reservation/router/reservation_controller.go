@@ -0,0 +1,146 @@
+package router
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"api_server/logger"
+	repo "api_server/reservation/repository"
+	"api_server/reservation/service"
+	"api_server/utils"
+)
+
+type ReservationController struct {
+	svc service.IReservationService
+}
+
+var onceReservation sync.Once
+var instanceReservation *ReservationController
+
+func New(svc service.IReservationService) *ReservationController {
+	onceReservation.Do(func() {
+		logger.Debug("Reservation Controller instance")
+		instanceReservation = &ReservationController{
+			svc: svc,
+		}
+	})
+
+	return instanceReservation
+}
+
+// queryDeviceID는 device_id query의 값입니다. 없으면 0 입니다.
+func queryDeviceID(c *gin.Context) (int, error) {
+	if param := c.Query("device_id"); param != "" {
+		return strconv.Atoi(param)
+	}
+
+	return 0, nil
+}
+
+// queryTime은 RFC3339 형식의 query 값입니다. 없으면 zero time 입니다.
+func queryTime(c *gin.Context, key string) (time.Time, error) {
+	if param := c.Query(key); param != "" {
+		return time.Parse(time.RFC3339, param)
+	}
+
+	return time.Time{}, nil
+}
+
+func (ctlr *ReservationController) CreateOne(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	ctxData, err := utils.GetDataFromToken(c)
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+	reqDTO := repo.ReservationDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+
+	data, report := ctlr.svc.Create(reqDTO, ctxData.Username)
+	logger.ApiResponse(c, report, data)
+}
+
+func (ctlr *ReservationController) GetAll(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if device_id, err := queryDeviceID(c); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.ReadAll(device_id)
+		logger.ApiResponse(c, report, data)
+	}
+}
+
+func (ctlr *ReservationController) UpdateById(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	reqDTO := repo.ReservationDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.Edit(reqDTO)
+		logger.ApiResponse(c, report, data)
+	}
+}
+
+func (ctlr *ReservationController) DeleteById(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		report := ctlr.svc.DeleteOne(id)
+		logger.ApiResponse(c, report, nil)
+	}
+}
+
+func (ctlr *ReservationController) CheckConflicts(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	reqDTO := repo.ReservationDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.CheckConflicts(reqDTO)
+		logger.ApiResponse(c, report, data)
+	}
+}
+
+func (ctlr *ReservationController) GetCalendar(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	device_id, err := queryDeviceID(c)
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+	from, err := queryTime(c, "from")
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+	to, err := queryTime(c, "to")
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+
+	data, report := ctlr.svc.ReadCalendar(device_id, from, to)
+	logger.ApiResponse(c, report, data)
+}
@@ -0,0 +1,27 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+
+	device_repo "api_server/device/repository"
+	"api_server/reservation/repository"
+	"api_server/reservation/service"
+	"api_server/utils"
+)
+
+func InitRouter(r *gin.Engine) {
+	svc := service.NewReservationService(repository.NewReservationDAO(), device_repo.New(), device_repo.NewGPUDAO(), device_repo.NewMaintenanceDAO())
+	controller := New(svc)
+
+	apiRouter := r.Group(utils.API_BASE_URL_V1 + "/reservation")
+	{
+		apiRouter.Use(utils.JWTAuthMiddleware())
+		apiRouter.GET("", controller.GetAll)
+		apiRouter.GET("/calendar", controller.GetCalendar)
+		apiRouter.POST("/conflicts", controller.CheckConflicts)
+		// 관리자
+		apiRouter.POST("", utils.GroupMiddleware(0, 1), controller.CreateOne)
+		apiRouter.PUT("", utils.GroupMiddleware(0, 1), controller.UpdateById)
+		apiRouter.DELETE("/:id", utils.GroupMiddleware(0, 1), controller.DeleteById)
+	}
+}
@@ -0,0 +1,404 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	device_repo "api_server/device/repository"
+	"api_server/ent"
+	"api_server/logger"
+	repo "api_server/reservation/repository"
+	"api_server/utils"
+)
+
+type IReservationService interface {
+	// Create 함수는 project 또는 user에게 device나 GPU를 예약합니다.
+	// 같은 GPU의 다른 예약이나 device의 maintenance window와 시간이 겹치면 등록하지 않습니다.
+	//
+	// 매개변수:
+	//   - req: 예약 정보. gpu_ids가 비어 있으면 device의 모든 GPU를 예약합니다.
+	//   - username: 등록한 관리자
+	//
+	// 반환값:
+	//   - *repo.ReservationDTO: 등록된 예약
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Create(req repo.ReservationDTO, username string) (*repo.ReservationDTO, *logger.Report)
+
+	// ReadAll 함수는 예약 목록을 조회합니다. device_id가 0이면 모든 device의 목록입니다.
+	ReadAll(device_id int) ([]*repo.ReservationDTO, *logger.Report)
+
+	// Edit 함수는 예약을 변경합니다. 변경한 시간과 GPU로 Create와 같이 충돌을 확인합니다.
+	//
+	// 매개변수:
+	//   - req: 변경할 예약 정보
+	//
+	// 반환값:
+	//   - *repo.ReservationDTO: 변경된 예약
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Edit(req repo.ReservationDTO) (*repo.ReservationDTO, *logger.Report)
+
+	// DeleteOne 함수는 예약을 삭제합니다.
+	//
+	// 매개변수:
+	//   - id: 예약 ID
+	//
+	// 반환값:
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	DeleteOne(id int) *logger.Report
+
+	// CheckConflicts 함수는 예약을 등록하지 않고 같은 시간, 같은 GPU의 다른 예약과 maintenance window를 조회합니다.
+	// req.ID가 있으면 그 예약은 제외합니다.
+	//
+	// 매개변수:
+	//   - req: 확인할 예약 정보
+	//
+	// 반환값:
+	//   - *repo.ConflictDTO: 충돌하는 예약과 maintenance window
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	CheckConflicts(req repo.ReservationDTO) (*repo.ConflictDTO, *logger.Report)
+
+	// ReadCalendar 함수는 device 별로 from부터 to 사이의 예약과 maintenance window를 시작 시각 순으로 조회합니다.
+	//
+	// 매개변수:
+	//   - device_id: 조회할 Device ID, 0이면 모든 device
+	//   - from: 조회 시작 시각, 비어 있으면 현재
+	//   - to: 조회 종료 시각, 비어 있으면 from부터 7일
+	//
+	// 반환값:
+	//   - []*repo.CalendarDTO: device 별 일정
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadCalendar(device_id int, from time.Time, to time.Time) ([]*repo.CalendarDTO, *logger.Report)
+
+	// Load 함수는 스케줄링에 사용할 끝나지 않은 예약을 읽습니다. device 예약은 device의 GPU로 풀어 둡니다.
+	//
+	// 매개변수:
+	//   - now: 기준 시각
+	//
+	// 반환값:
+	//   - *Reservations: 끝나지 않은 예약
+	//   - error: 조회 중 발생한 오류
+	Load(now time.Time) (*Reservations, error)
+}
+
+type ReservationService struct {
+	ctx             context.Context
+	dao             repo.IReservationDAO
+	dao_device      device_repo.IDeviceDAO
+	dao_gpu         device_repo.IGPUDAO
+	dao_maintenance device_repo.IMaintenanceDAO
+}
+
+var onceReservation sync.Once
+var instanceReservation *ReservationService
+
+func NewReservationService(dao repo.IReservationDAO,
+	dao_device device_repo.IDeviceDAO,
+	dao_gpu device_repo.IGPUDAO,
+	dao_maintenance device_repo.IMaintenanceDAO,
+) *ReservationService {
+	onceReservation.Do(func() {
+		logger.Debug("Reservation service instance")
+		instanceReservation = &ReservationService{
+			ctx:             context.Background(),
+			dao:             dao,
+			dao_device:      dao_device,
+			dao_gpu:         dao_gpu,
+			dao_maintenance: dao_maintenance,
+		}
+	})
+
+	return instanceReservation
+}
+
+func validateReservation(req repo.ReservationDTO, now time.Time) error {
+	if req.DeviceID < 1 {
+		return errors.New("device_id is required")
+	}
+	if req.ProjectID < 0 {
+		return fmt.Errorf("invalid project_id: %d", req.ProjectID)
+	}
+	if (req.ProjectID > 0) == (req.Username != "") {
+		return errors.New("either project_id or username is required")
+	}
+	if !req.EndsAt.After(req.StartsAt) {
+		return errors.New("ends_at must be after starts_at")
+	}
+	if !req.EndsAt.After(now) {
+		return errors.New("ends_at has already passed")
+	}
+
+	return nil
+}
+
+func (svc *ReservationService) Create(req repo.ReservationDTO, username string) (*repo.ReservationDTO, *logger.Report) {
+	req.ID = 0
+	if r := svc.check(&req); r != nil {
+		return nil, r
+	}
+
+	req.CreatedBy = username
+	reservation, err := svc.dao.InsertOne(svc.ctx, req)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_INSERT, err)
+	}
+	utils.NewNotifier().Publish(utils.EVENT_GPU_RESERVED, reservation.DeviceID)
+
+	return repo.ConvertReservationEntToDTO(reservation), nil
+}
+
+func (svc *ReservationService) ReadAll(device_id int) ([]*repo.ReservationDTO, *logger.Report) {
+	reservations, err := svc.dao.SelectAll(svc.ctx, device_id)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	return repo.ConvertReservationEntsToDTOs(reservations), nil
+}
+
+func (svc *ReservationService) Edit(req repo.ReservationDTO) (*repo.ReservationDTO, *logger.Report) {
+	if _, err := svc.dao.SelectOne(svc.ctx, req.ID); ent.IsNotFound(err) {
+		return nil, logger.CreateReport(&logger.CODE_REQUEST, fmt.Errorf("reservation %d not found", req.ID))
+	} else if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	if r := svc.check(&req); r != nil {
+		return nil, r
+	}
+
+	reservation, err := svc.dao.UpdateOne(svc.ctx, req)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+	utils.NewNotifier().Publish(utils.EVENT_GPU_RESERVED, reservation.DeviceID)
+
+	return repo.ConvertReservationEntToDTO(reservation), nil
+}
+
+func (svc *ReservationService) DeleteOne(id int) *logger.Report {
+	reservation, err := svc.dao.SelectOne(svc.ctx, id)
+	if ent.IsNotFound(err) {
+		return logger.CreateReport(&logger.CODE_REQUEST, fmt.Errorf("reservation %d not found", id))
+	} else if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	if err := svc.dao.DeleteOne(svc.ctx, id); err != nil {
+		return logger.CreateReport(&logger.CODE_DB_DELETE, err)
+	}
+	// 예약이 풀린 GPU에 대기 중인 modeling을 바로 배치한다.
+	utils.NewNotifier().Publish(utils.EVENT_GPU_RESERVED, reservation.DeviceID)
+
+	return nil
+}
+
+func (svc *ReservationService) CheckConflicts(req repo.ReservationDTO) (*repo.ConflictDTO, *logger.Report) {
+	if req.DeviceID < 1 || !req.EndsAt.After(req.StartsAt) {
+		return nil, logger.CreateReport(&logger.CODE_RESERVATION_INVALID, errors.New("device_id, starts_at and ends_at are required"))
+	}
+
+	conflict, err := svc.conflicts(req)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	return conflict, nil
+}
+
+func (svc *ReservationService) ReadCalendar(device_id int, from time.Time, to time.Time) ([]*repo.CalendarDTO, *logger.Report) {
+	if from.IsZero() {
+		from = time.Now()
+	}
+	if to.IsZero() {
+		to = from.AddDate(0, 0, 7)
+	}
+	if !to.After(from) {
+		return nil, logger.CreateReport(&logger.CODE_REQUEST, errors.New("to must be after from"))
+	}
+
+	var devices []*ent.Device
+	if device_id > 0 {
+		device, err := svc.dao_device.SelectOne(svc.ctx, device_id)
+		if ent.IsNotFound(err) {
+			return nil, logger.CreateReport(&logger.CODE_REQUEST, fmt.Errorf("device %d not found", device_id))
+		} else if err != nil {
+			return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		}
+		devices = []*ent.Device{device}
+	} else {
+		all, err := svc.dao_device.SelectAll(svc.ctx)
+		if err != nil {
+			return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		}
+		devices = all
+	}
+
+	reservations, err := svc.dao.SelectRange(svc.ctx, device_id, from, to)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	windows, err := svc.dao_maintenance.SelectAll(svc.ctx, device_id)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	entries := make(map[int][]*repo.CalendarEntryDTO)
+	for _, r := range reservations {
+		entries[r.DeviceID] = append(entries[r.DeviceID], &repo.CalendarEntryDTO{
+			Type:      utils.CALENDAR_ENTRY_RESERVATION,
+			ID:        r.ID,
+			Title:     r.Title,
+			GPUIDs:    r.GpuIds,
+			ProjectID: r.ProjectID,
+			Username:  r.Username,
+			StartsAt:  r.StartsAt,
+			EndsAt:    r.EndsAt,
+		})
+	}
+	for _, w := range windows {
+		if !w.StartsAt.Before(to) || !w.EndsAt.After(from) {
+			continue
+		}
+		entries[w.DeviceID] = append(entries[w.DeviceID], &repo.CalendarEntryDTO{
+			Type:     utils.CALENDAR_ENTRY_MAINTENANCE,
+			ID:       w.ID,
+			Title:    w.Reason,
+			StartsAt: w.StartsAt,
+			EndsAt:   w.EndsAt,
+		})
+	}
+
+	calendars := []*repo.CalendarDTO{}
+	for _, device := range devices {
+		items := entries[device.ID]
+		if items == nil {
+			items = []*repo.CalendarEntryDTO{}
+		}
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].StartsAt.Before(items[j].StartsAt)
+		})
+		calendars = append(calendars, &repo.CalendarDTO{
+			DeviceID:   device.ID,
+			DeviceName: device.Name,
+			Entries:    items,
+		})
+	}
+
+	return calendars, nil
+}
+
+func (svc *ReservationService) Load(now time.Time) (*Reservations, error) {
+	reservations, err := svc.dao.SelectNotEnded(svc.ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	deviceGPUs := make(map[int][]int)
+	for _, r := range reservations {
+		if len(r.GpuIds) > 0 {
+			continue
+		}
+		if _, ok := deviceGPUs[r.DeviceID]; ok {
+			continue
+		}
+		gpus, err := svc.dao_gpu.SelectManyByDeviceID(svc.ctx, r.DeviceID)
+		if err != nil {
+			return nil, err
+		}
+		deviceGPUs[r.DeviceID] = []int{}
+		for _, gpu := range gpus {
+			deviceGPUs[r.DeviceID] = append(deviceGPUs[r.DeviceID], gpu.ID)
+		}
+	}
+
+	return newReservations(reservations, deviceGPUs), nil
+}
+
+// check는 등록, 변경할 예약의 값과 GPU를 확인하고 다른 예약이나 maintenance window와 겹치는지 확인합니다.
+func (svc *ReservationService) check(req *repo.ReservationDTO) *logger.Report {
+	if err := validateReservation(*req, time.Now()); err != nil {
+		return logger.CreateReport(&logger.CODE_RESERVATION_INVALID, err)
+	}
+
+	gpus, err := svc.dao_gpu.SelectManyByDeviceID(svc.ctx, req.DeviceID)
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	if len(gpus) < 1 {
+		return logger.CreateReport(&logger.CODE_RESERVATION_INVALID, fmt.Errorf("device %d has no gpus", req.DeviceID))
+	}
+	owned := make(map[int]bool)
+	for _, gpu := range gpus {
+		owned[gpu.ID] = true
+	}
+	ids := []int{}
+	seen := make(map[int]bool)
+	for _, id := range req.GPUIDs {
+		if !owned[id] {
+			return logger.CreateReport(&logger.CODE_RESERVATION_INVALID, fmt.Errorf("gpu %d is not on device %d", id, req.DeviceID))
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	req.GPUIDs = ids
+
+	conflict, err := svc.conflicts(*req)
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	if conflict.Conflict {
+		return logger.CreateReport(&logger.CODE_RESERVATION_CONFLICT, conflictError(conflict))
+	}
+
+	return nil
+}
+
+// conflicts는 req와 같은 시간에 같은 GPU를 예약한 다른 예약과, 시간이 겹치는 device의 끝나지 않은 maintenance window를 찾습니다.
+func (svc *ReservationService) conflicts(req repo.ReservationDTO) (*repo.ConflictDTO, error) {
+	conflict := &repo.ConflictDTO{
+		Reservations:       []*repo.ReservationDTO{},
+		MaintenanceWindows: []*device_repo.MaintenanceWindowDTO{},
+	}
+
+	reservations, err := svc.dao.SelectRange(svc.ctx, req.DeviceID, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range reservations {
+		if r.ID != req.ID && sharesGPU(r.GpuIds, req.GPUIDs) {
+			conflict.Reservations = append(conflict.Reservations, repo.ConvertReservationEntToDTO(r))
+		}
+	}
+
+	windows, err := svc.dao_maintenance.SelectAll(svc.ctx, req.DeviceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range windows {
+		if w.EndedAt == nil && w.StartsAt.Before(req.EndsAt) && w.EndsAt.After(req.StartsAt) {
+			conflict.MaintenanceWindows = append(conflict.MaintenanceWindows, device_repo.ConvertMaintenanceEntToDTO(w))
+		}
+	}
+	conflict.Conflict = len(conflict.Reservations) > 0 || len(conflict.MaintenanceWindows) > 0
+
+	return conflict, nil
+}
+
+func conflictError(conflict *repo.ConflictDTO) error {
+	items := []string{}
+	for _, r := range conflict.Reservations {
+		items = append(items, fmt.Sprintf("reservation %d (%s ~ %s)", r.ID, r.StartsAt.Format(time.RFC3339), r.EndsAt.Format(time.RFC3339)))
+	}
+	for _, w := range conflict.MaintenanceWindows {
+		items = append(items, fmt.Sprintf("maintenance window %d (%s ~ %s)", w.ID, w.StartsAt.Format(time.RFC3339), w.EndsAt.Format(time.RFC3339)))
+	}
+
+	return fmt.Errorf("overlaps %s", strings.Join(items, ", "))
+}
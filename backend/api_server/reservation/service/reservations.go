@@ -0,0 +1,90 @@
+package service
+
+import (
+	"time"
+
+	"api_server/ent"
+)
+
+// Reservations는 스케줄링 시점에 끝나지 않은 예약과 예약된 GPU 입니다.
+type Reservations struct {
+	items []reserved
+}
+
+type reserved struct {
+	entity *ent.Reservation
+	gpus   []int // device 예약이면 device의 모든 GPU
+}
+
+func newReservations(ents []*ent.Reservation, deviceGPUs map[int][]int) *Reservations {
+	r := &Reservations{}
+	for _, entity := range ents {
+		gpus := entity.GpuIds
+		if len(gpus) < 1 {
+			gpus = deviceGPUs[entity.DeviceID]
+		}
+		r.items = append(r.items, reserved{entity: entity, gpus: gpus})
+	}
+
+	return r
+}
+
+// Empty는 끝나지 않은 예약이 없으면 true 입니다.
+func (r *Reservations) Empty() bool {
+	return r == nil || len(r.items) < 1
+}
+
+// Blocked는 from부터 to 사이에 m이 속하지 않은 project나 user의 예약이 잡혀 있는 GPU ID 입니다.
+func (r *Reservations) Blocked(m *ent.Modeling, from time.Time, to time.Time) map[int]bool {
+	blocked := make(map[int]bool)
+	if r == nil {
+		return blocked
+	}
+
+	for _, item := range r.items {
+		if !item.entity.StartsAt.Before(to) || !item.entity.EndsAt.After(from) || owns(item.entity, m) {
+			continue
+		}
+		for _, id := range item.gpus {
+			blocked[id] = true
+		}
+	}
+
+	return blocked
+}
+
+// owns는 modeling이 예약한 project에 속하거나 예약한 user가 등록한 modeling이면 true 입니다.
+func owns(entity *ent.Reservation, m *ent.Modeling) bool {
+	if entity.ProjectID > 0 {
+		return modelingProject(m) == entity.ProjectID
+	}
+
+	return entity.Username != "" && m.Username == entity.Username
+}
+
+func modelingProject(m *ent.Modeling) int {
+	if m.Edges.Task != nil {
+		return m.Edges.Task.ProjectID
+	}
+
+	return 0
+}
+
+// sharesGPU는 같은 device의 두 GPU 목록이 겹치면 true 입니다. 비어 있는 목록은 device의 모든 GPU 입니다.
+func sharesGPU(a []int, b []int) bool {
+	if len(a) < 1 || len(b) < 1 {
+		return true
+	}
+
+	set := make(map[int]bool)
+	for _, id := range a {
+		set[id] = true
+	}
+	for _, id := range b {
+		if set[id] {
+			return true
+		}
+	}
+
+	return false
+}
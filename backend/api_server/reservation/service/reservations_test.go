@@ -0,0 +1,74 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"api_server/ent"
+	repo "api_server/reservation/repository"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ReservationsTestSuite struct {
+	suite.Suite
+	now          time.Time
+	reservations *Reservations
+}
+
+func modelingOf(project int, username string) *ent.Modeling {
+	m := &ent.Modeling{Username: username}
+	m.Edges.Task = &ent.Task{ProjectID: project}
+	return m
+}
+
+func (suite *ReservationsTestSuite) SetupTest() {
+	suite.now = time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	suite.reservations = newReservations(
+		[]*ent.Reservation{
+			// 2시간 뒤부터 project 1이 device 1의 GPU 1을 사용
+			{ID: 1, DeviceID: 1, GpuIds: []int{1}, ProjectID: 1, StartsAt: suite.now.Add(2 * time.Hour), EndsAt: suite.now.Add(4 * time.Hour)},
+			// 지금부터 alice가 device 2 전체를 사용
+			{ID: 2, DeviceID: 2, Username: "alice", StartsAt: suite.now.Add(-time.Hour), EndsAt: suite.now.Add(time.Hour)},
+		},
+		map[int][]int{2: {3, 4}},
+	)
+}
+
+func (suite *ReservationsTestSuite) TestBlocked() {
+	// 1시간 안에 끝나는 modeling은 2시간 뒤 예약과 겹치지 않는다.
+	blocked := suite.reservations.Blocked(modelingOf(2, "bob"), suite.now, suite.now.Add(time.Hour))
+	suite.Equal(map[int]bool{3: true, 4: true}, blocked)
+
+	blocked = suite.reservations.Blocked(modelingOf(2, "bob"), suite.now, suite.now.Add(3*time.Hour))
+	suite.Equal(map[int]bool{1: true, 3: true, 4: true}, blocked)
+
+	// 예약한 project와 user의 modeling은 예약된 GPU를 사용할 수 있다.
+	suite.Empty(suite.reservations.Blocked(modelingOf(1, "alice"), suite.now, suite.now.Add(3*time.Hour)))
+}
+
+func (suite *ReservationsTestSuite) TestSharesGPU() {
+	suite.True(sharesGPU([]int{1, 2}, []int{2}))
+	suite.False(sharesGPU([]int{1}, []int{2}))
+	// device 예약은 device의 모든 GPU와 겹친다.
+	suite.True(sharesGPU(nil, []int{2}))
+}
+
+func (suite *ReservationsTestSuite) TestValidate() {
+	req := repo.ReservationDTO{DeviceID: 1, ProjectID: 1, StartsAt: suite.now, EndsAt: suite.now.Add(time.Hour)}
+	suite.NoError(validateReservation(req, suite.now))
+
+	both := req
+	both.Username = "alice"
+	suite.Error(validateReservation(both, suite.now))
+
+	none := req
+	none.ProjectID = 0
+	suite.Error(validateReservation(none, suite.now))
+
+	suite.Error(validateReservation(req, suite.now.Add(2*time.Hour)))
+}
+
+func TestReservationsTestSuite(t *testing.T) {
+	suite.Run(t, new(ReservationsTestSuite))
+}
@@ -33,6 +33,7 @@ type Modeling struct {
 	Performance   *map[string]any `json:"performance,omitempty"`    //modeling.performance -> 이 컬럼 제거 예정입니다.
 	InferenceTime *float64        `json:"inference_time,omitempty"` //??
 	TargetMetric  string          `json:"target_metric"`            //task.target_metric
+	FailReason    string          `json:"fail_reason,omitempty"`    //modeling.fail_reason
 	CreatedAt     time.Time       `json:"created_at"`
 	UpdatedAt     time.Time       `json:"updated_at"`
 }
@@ -61,8 +62,9 @@ type ModelingDetail struct {
 	Name  string `json:"name"`  //task.title
 	State string `json:"state"` //??
 	//GPU           string           `json:"gpu"`            //??
-	Dataset      string           `json:"dataset"`       //task.datasetId 로 검색 dataset.name
-	TargetMetric string           `json:"target_metric"` //task.target_metric
+	Dataset      string           `json:"dataset"`               //task.datasetId 로 검색 dataset.name
+	TargetMetric string           `json:"target_metric"`         //task.target_metric
+	FailReason   string           `json:"fail_reason,omitempty"` //modeling.fail_reason
 	CreatedAt    time.Time        `json:"created_at"`
 	UpdatedAt    time.Time        `json:"updated_at"`
 	ImageSize    *int             `json:"img_size,omitempty"` //task.params.image_resoultion
@@ -93,6 +95,11 @@ type TapiLoaddedModel struct {
 	GPUIndex  int    `json:"gpu_index"`
 	GPUID     int    `json:"gpu_id"`
 	GPUUUID   string `json:"gpu_uuid"`
+	DeviceID  int    `json:"device_id"`
+
+	// device drain 시 다른 GPU에 다시 올리기 위한 정보
+	ModelPath string `json:"-"`
+	ModelType string `json:"-"`
 }
 
 type Device struct {
@@ -131,7 +138,8 @@ type SystemInformation struct {
 type TestDTO struct {
 	ModelingID int    `json:"modeling_id"`
 	ModelName  string `json:"model_name"`
-	GpuId      int    `json:"gpu_id"`
+	GpuId      int    `json:"gpu_id"`    // device 안의 GPU index
+	DeviceID   int    `json:"device_id"` // GPU가 설치된 device, GPU index가 여러 device에 있으면 필요
 }
 
 type InferenceRequest struct {
@@ -173,6 +173,7 @@ func (ctrl *TapiController) PostLoadModel(c *gin.Context) {
 			ModelingID: tapiReq.ModelingID,
 			ModelName:  tapiReq.ModelName,
 			GpuId:      tapiReq.GpuId,
+			DeviceID:   tapiReq.DeviceID,
 		}
 
 		_, err := ctrl.svc.LoadModel(reqDTO, engineType)
@@ -4,21 +4,21 @@ import (
 	modules_dataset "api_server/dataset/modules"
 	repo_dataset "api_server/dataset/repository"
 	service_dataset "api_server/dataset/service"
+	repo_device "api_server/device/repository"
 	service_device "api_server/device/service"
+	engine_client "api_server/engine/client"
 	"api_server/logger"
 	repo "api_server/tapi/repository"
 	repo_task "api_server/task/repository"
 	service_task "api_server/task/service"
 
 	"api_server/utils"
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
-	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -104,7 +104,8 @@ type TapiService struct {
 
 	//dao               repo_task.ITaskDAO
 	dao_modeling      repo_task.IModelingDAO
-	tapiLoaddedModels []repo.TapiLoaddedModel
+	tapiLoaddedModels []repo.TapiLoaddedModel // HTTP handler와 device drain이 함께 사용하므로 mu로 보호
+	mu                sync.Mutex
 	tapi_devices      []repo.Device
 }
 
@@ -128,6 +129,7 @@ func New(dv_svc service_device.IDeviceService, g_svc service_device.IGPUService,
 			dataset_watcher: ds_watcher,
 			dao_modeling:    dao_modeling,
 		}
+		service_device.RegisterModelReleaser(instance)
 	})
 
 	return instance
@@ -230,6 +232,7 @@ func (svc *TapiService) ReadModelingList(engineType string) ([]*repo.Modeling, *
 					State:        m.ModelingStep,
 					Name:         task.Title,
 					TargetMetric: task.TargetMetric,
+					FailReason:   m.FailReason,
 					CreatedAt:    m.CreatedAt,
 					UpdatedAt:    m.UpdatedAt,
 					Dataset:      datasetName,
@@ -374,6 +377,7 @@ func (svc *TapiService) ReadModelingDetail(modelingID int, thresholdKey string)
 		ImageSize:    &imageSize,
 		ID:           modeling.ID,
 		State:        modeling.ModelingStep,
+		FailReason:   modeling.FailReason,
 		CreatedAt:    modeling.CreatedAt,
 		UpdatedAt:    modeling.UpdatedAt,
 		Models:       []*repo.ModelingModel{},
@@ -578,7 +582,7 @@ func (svc *TapiService) LoadedModelsGroupedByDevice() ([]repo.DeviceModelGroup,
 	deviceToModels := make(map[int][]repo.TapiLoaddedModel)
 	deviceToName := make(map[int]string)
 
-	for _, model := range svc.tapiLoaddedModels {
+	for _, model := range svc.loadedModels() {
 		cleanUUID := strings.TrimSpace(model.GPUUUID)
 		if devInfo, ok := uuidToDevice[cleanUUID]; ok {
 			deviceToModels[devInfo.ID] = append(deviceToModels[devInfo.ID], model)
@@ -647,76 +651,48 @@ func (svc *TapiService) LoadModel(reqDTO repo.TestDTO, engineType string) (*repo
 		}
 	}
 
-	// API 서버 요청 준비
-	url := "http://localhost:5000/api/load"
-
-	stringGpuId := strconv.Itoa(reqDTO.GpuId)
-
-	// 요청 파라미터 구성
-	reqData := map[string]interface{}{
-		"device_id":  stringGpuId,
-		"model_name": reqDTO.ModelName,
-		"model_path": modelPath,
-		"model_type": engineType,
-	}
-
-	jsonData, err := json.Marshal(reqData)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_JSON_MARSHAL, err)
+	gpu, r := svc.gpu_svc.ViewGpu(reqDTO.DeviceID, reqDTO.GpuId)
+	if r != nil {
+		return nil, r
 	}
-
-	// HTTP 요청 생성
-	req, err := http.NewRequestWithContext(svc.ctx, "POST", url, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_CREATE_REQ, err)
+	if r := svc.checkEngine(gpu.DeviceID, engineType); r != nil {
+		return nil, r
 	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	// HTTP 요청 실행
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_REQUEST, err)
+	engine, r := service_device.DeviceEngine(gpu.DeviceID)
+	if r != nil {
+		return nil, r
 	}
-	defer resp.Body.Close()
 
-	// 응답 처리
-	body, err := io.ReadAll(resp.Body)
+	resp, err := engine.Load(svc.ctx, engine_client.LoadRequest{
+		DeviceID:  strconv.Itoa(gpu.Index),
+		ModelName: reqDTO.ModelName,
+		ModelPath: modelPath,
+		ModelType: engineType,
+	})
 	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
-	}
-
-	// 응답 데이터 파싱
-	var apiResponse struct {
-		Name   string `json:"name"`
-		Models []struct {
-			ModelNum  int    `json:"model_num"`
-			ModelFile string `json:"model_file"`
-			Engine    string `json:"engine"`
-		} `json:"models"`
+		return nil, engine_client.Report(err)
 	}
-
-	if err := json.Unmarshal(body, &apiResponse); err != nil {
-		return nil, logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, err)
+	if len(resp.Models) < 1 {
+		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, fmt.Errorf("engine loaded no model for %s", reqDTO.ModelName))
 	}
 
 	loadded := repo.TapiLoaddedModel{
-		TestId:    len(svc.tapiLoaddedModels),
 		ModelName: reqDTO.ModelName,
-		ModelNum:  apiResponse.Models[0].ModelNum,
-		GPUIndex:  reqDTO.GpuId,
-	}
-	// gpuService := svc.gpu_svc.NewStatic()
-	gpuService := svc.gpu_svc
-	gpu, r := gpuService.ViewGpuByIndex(stringGpuId)
-	if r != nil {
-		logger.Error(fmt.Errorf("failed to get GPU info [gpu_index: %d]: %w", reqDTO.GpuId, err))
-	} else {
-		loadded.GPUID = gpu.ID
-		loadded.GPUUUID = gpu.UUID
-		svc.tapiLoaddedModels = append(svc.tapiLoaddedModels, loadded)
-	}
+		ModelNum:  resp.Models[0].ModelNum,
+		GPUIndex:  gpu.Index,
+		GPUID:     gpu.ID,
+		GPUUUID:   gpu.UUID,
+		DeviceID:  gpu.DeviceID,
+		ModelPath: modelPath,
+		ModelType: engineType,
+	}
+	svc.mu.Lock()
+	// 내린 모델의 test_id를 다시 쓰지 않도록 마지막 test_id 다음 번호를 사용한다.
+	if n := len(svc.tapiLoaddedModels); n > 0 {
+		loadded.TestId = svc.tapiLoaddedModels[n-1].TestId + 1
+	}
+	svc.tapiLoaddedModels = append(svc.tapiLoaddedModels, loadded)
+	svc.mu.Unlock()
 
 	// 성공 응답 반환
 	return &loadded, nil
@@ -725,74 +701,216 @@ func (svc *TapiService) LoadModel(reqDTO repo.TestDTO, engineType string) (*repo
 func (s *TapiService) UnloadModel(testId int, gpuId int) *logger.Report {
 	logger.Debug("TAPI Unload model: ", testId)
 
-	url := "http://localhost:5000/api/model"
+	loadded, ok := s.findModel(testId)
+	if !ok {
+		// 모델을 찾지 못한 경우
+		return logger.CreateReport(&logger.CODE_REMOTE_NOT_FOUND_MODEL, fmt.Errorf("model with test ID %d not found", testId))
+	}
+
+	engine, r := service_device.DeviceEngine(loadded.DeviceID)
+	if r != nil {
+		return r
+	}
+	err := engine.Unload(s.ctx, engine_client.UnloadRequest{
+		DeviceID:  strconv.Itoa(loadded.GPUIndex),
+		ModelName: loadded.ModelName,
+		ModelNum:  loadded.ModelNum,
+	})
+	if err != nil {
+		return engine_client.Report(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	filtered := make([]repo.TapiLoaddedModel, 0, len(s.tapiLoaddedModels))
+	for _, m := range s.tapiLoaddedModels {
+		if m.TestId != testId {
+			filtered = append(filtered, m)
+		}
+	}
+	s.tapiLoaddedModels = filtered
+
+	return nil
+}
+
+// loadedModels는 올라가 있는 추론 모델 목록의 복사본입니다.
+func (s *TapiService) loadedModels() []repo.TapiLoaddedModel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]repo.TapiLoaddedModel{}, s.tapiLoaddedModels...)
+}
+
+// findModel은 testId로 올라가 있는 추론 모델을 찾습니다.
+func (s *TapiService) findModel(testId int) (repo.TapiLoaddedModel, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
 	for _, loadded := range s.tapiLoaddedModels {
 		if loadded.TestId == testId {
+			return loadded, true
+		}
+	}
 
-			reqData := map[string]interface{}{
-				"device_id":  strconv.Itoa(gpuId),
-				"model_name": loadded.ModelName,
-				"model_num":  loadded.ModelNum,
-			}
+	return repo.TapiLoaddedModel{}, false
+}
 
-			jsonData, err := json.Marshal(reqData)
-			if err != nil {
-				return logger.CreateReport(&logger.CODE_JSON_MARSHAL, err)
-			}
+// CountModels는 gpu_ids에 올라가 있는 추론 모델 수를 반환합니다.
+func (s *TapiService) CountModels(gpu_ids []int) int {
+	targets := make(map[int]bool)
+	for _, id := range gpu_ids {
+		targets[id] = true
+	}
 
-			// HTTP 요청 생성
-			req, err := http.NewRequestWithContext(s.ctx, "DELETE", url, bytes.NewBuffer(jsonData))
-			if err != nil {
-				return logger.CreateReport(&logger.CODE_REMOTE_CREATE_REQ, err)
-			}
+	count := 0
+	for _, loadded := range s.loadedModels() {
+		if targets[loadded.GPUID] {
+			count++
+		}
+	}
+	return count
+}
 
-			req.Header.Set("Content-Type", "application/json")
+// ReleaseModels는 gpu_ids에 올라가 있는 추론 모델을 내립니다.
+// migrate이면 drain 중이 아니고 모델의 engine type을 실행할 수 있는 device의 idle GPU에 같은 모델을 먼저 올리며, test_id는 그대로 유지해 추론 요청을 계속 받을 수 있습니다.
+func (s *TapiService) ReleaseModels(gpu_ids []int, migrate bool) *logger.Report {
+	targets := make(map[int]bool)
+	for _, id := range gpu_ids {
+		targets[id] = true
+	}
 
-			// HTTP 요청 실행
-			client := &http.Client{}
-			resp, err := client.Do(req)
-			if err != nil {
-				return logger.CreateReport(&logger.CODE_REMOTE_REQUEST, err)
+	var idle []*repo_device.GPUDTO
+	if migrate {
+		gpus, r := s.gpu_svc.ReadIdle()
+		if r != nil {
+			return r
+		}
+		for _, gpu := range gpus {
+			if !targets[gpu.ID] {
+				idle = append(idle, gpu)
 			}
-			defer resp.Body.Close()
+		}
+		if len(idle) < 1 {
+			return logger.CreateReport(&logger.CODE_MODELING_DEVICE_NOT_EXIST, errors.New("no idle gpu to migrate inference models"))
+		}
+	}
 
-			// 응답 처리
-			body, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
+	var report *logger.Report
+	moved := 0
+	for _, loadded := range s.loadedModels() {
+		if !targets[loadded.GPUID] {
+			continue
+		}
+		if !migrate {
+			if r := s.UnloadModel(loadded.TestId, loadded.GPUIndex); r != nil {
+				report = r
 			}
+			continue
+		}
 
-			bodyStr := string(body)
-			logger.Debug(bodyStr)
+		// 여러 모델을 모델의 engine type을 실행할 수 있는 idle GPU에 번갈아 올린다.
+		compatible := s.compatibleGPUs(idle, loadded.ModelType)
+		if len(compatible) < 1 {
+			report = logger.CreateReport(&logger.CODE_ENGINE_INCOMPATIBLE,
+				fmt.Errorf("no idle gpu can run %s model %s (test_id %d)", loadded.ModelType, loadded.ModelName, loadded.TestId))
+			continue
+		}
+		target := compatible[moved%len(compatible)]
+		moved++
+		if r := s.migrateModel(loadded, target); r != nil {
+			report = r
+		}
+	}
 
-			// "Successfully" 포함 시 성공 처리
-			if strings.Contains(bodyStr, "Successfully") {
-				targetModelName := loadded.ModelName
-				filtered := make([]repo.TapiLoaddedModel, 0)
-				for _, m := range s.tapiLoaddedModels {
-					if m.ModelName != targetModelName {
-						filtered = append(filtered, m)
-					}
-				}
-				s.tapiLoaddedModels = filtered
+	return report
+}
 
-				return nil
-			}
+// checkEngine은 device의 engine이 engineType 모델을 올릴 수 있는지 확인합니다.
+func (s *TapiService) checkEngine(device_id int, engineType string) *logger.Report {
+	device, r := s.device_svc.ReadOne(device_id)
+	if r != nil {
+		return r
+	}
+	if err := service_device.CheckEngine(device.Capabilities, engineType); err != nil {
+		return logger.CreateReport(&logger.CODE_ENGINE_INCOMPATIBLE, fmt.Errorf("device %d (%s): %w", device.ID, device.Name, err))
+	}
+
+	return nil
+}
 
-			return logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, fmt.Errorf("unload failed: %s", bodyStr))
+// compatibleGPUs는 gpus 중 engineType 모델을 올릴 수 있는 device의 GPU입니다.
+func (s *TapiService) compatibleGPUs(gpus []*repo_device.GPUDTO, engineType string) []*repo_device.GPUDTO {
+	checked := make(map[int]bool)
+	compatible := []*repo_device.GPUDTO{}
+	for _, gpu := range gpus {
+		ok, found := checked[gpu.DeviceID]
+		if !found {
+			ok = s.checkEngine(gpu.DeviceID, engineType) == nil
+			checked[gpu.DeviceID] = ok
+		}
+		if ok {
+			compatible = append(compatible, gpu)
 		}
 	}
 
-	// 모델을 찾지 못한 경우
-	return logger.CreateReport(&logger.CODE_REMOTE_NOT_FOUND_MODEL, fmt.Errorf("model with test ID %d not found", testId))
+	return compatible
+}
+
+// migrateModel은 모델을 target GPU가 설치된 device의 engine에 올린 뒤 원래 device의 engine에서 내립니다.
+func (s *TapiService) migrateModel(loadded repo.TapiLoaddedModel, target *repo_device.GPUDTO) *logger.Report {
+	engine, r := service_device.DeviceEngine(target.DeviceID)
+	if r != nil {
+		return r
+	}
+	resp, err := engine.Load(s.ctx, engine_client.LoadRequest{
+		DeviceID:  strconv.Itoa(target.Index),
+		ModelName: loadded.ModelName,
+		ModelPath: loadded.ModelPath,
+		ModelType: loadded.ModelType,
+	})
+	if err != nil {
+		return engine_client.Report(err)
+	}
+	if len(resp.Models) < 1 {
+		return logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, fmt.Errorf("engine loaded no model for %s", loadded.ModelName))
+	}
+
+	s.mu.Lock()
+	for i := range s.tapiLoaddedModels {
+		if s.tapiLoaddedModels[i].TestId == loadded.TestId {
+			s.tapiLoaddedModels[i].ModelNum = resp.Models[0].ModelNum
+			s.tapiLoaddedModels[i].GPUIndex = target.Index
+			s.tapiLoaddedModels[i].GPUID = target.ID
+			s.tapiLoaddedModels[i].GPUUUID = target.UUID
+			s.tapiLoaddedModels[i].DeviceID = target.DeviceID
+		}
+	}
+	s.mu.Unlock()
+	logger.Info(fmt.Sprintf("model %s (test_id %d) migrated from gpu %d to gpu %d", loadded.ModelName, loadded.TestId, loadded.GPUID, target.ID))
+
+	source, r := service_device.DeviceEngine(loadded.DeviceID)
+	if r == nil {
+		err = source.Unload(s.ctx, engine_client.UnloadRequest{
+			DeviceID:  strconv.Itoa(loadded.GPUIndex),
+			ModelName: loadded.ModelName,
+			ModelNum:  loadded.ModelNum,
+		})
+	} else {
+		err = r.Error
+	}
+	if err != nil {
+		logger.Warn(fmt.Sprintf("model %s (test_id %d) is loaded on gpu %d but failed to unload from gpu %d: %v",
+			loadded.ModelName, loadded.TestId, target.ID, loadded.GPUID, err))
+	}
+
+	return nil
 }
 
 func (s *TapiService) UnloadByGPUAndModelName(gpuID int, modelName string) *logger.Report {
 	var report *logger.Report
 	found := false
 
-	for _, loadded := range s.tapiLoaddedModels {
+	for _, loadded := range s.loadedModels() {
 		if loadded.GPUID == gpuID && loadded.ModelName == modelName {
 			found = true
 			report = s.UnloadModel(loadded.TestId, loadded.GPUID)
@@ -810,7 +928,7 @@ func (s *TapiService) GetTestIdByGPUAndModelName(gpuID int, modelName string) (i
 	testId := -1
 	found := false
 
-	for _, loadded := range s.tapiLoaddedModels {
+	for _, loadded := range s.loadedModels() {
 		if loadded.GPUID == gpuID && loadded.ModelName == modelName {
 			found = true
 			testId = loadded.TestId
@@ -828,93 +946,32 @@ func (s *TapiService) GetTestIdByGPUAndModelName(gpuID int, modelName string) (i
 func (s *TapiService) InferenceVCLS(testId int, filename string, image multipart.File, heatmap string) (map[string]interface{}, *logger.Report) {
 	logger.Debug("Inference VCLS for test ID: ", testId)
 	// 로드된 모델 찾기
-	var model *repo.TapiLoaddedModel
-	for _, loadded := range s.tapiLoaddedModels {
-		if loadded.TestId == testId {
-			model = &loadded
-			break
-		}
-	}
-
-	if model == nil {
+	model, ok := s.findModel(testId)
+	if !ok {
 		return nil, logger.CreateReport(&logger.CODE_REMOTE_NOT_FOUND_MODEL, fmt.Errorf("model with test ID %d not found", testId))
 	}
 
-	// multipart/form-data 준비
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
-
-	// 이미지 파일 추가
-	fw, err := w.CreateFormFile("file", filename)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
-	}
-
 	// 파일 위치를 처음으로 되돌리기 (이미 읽혔을 수 있음)
 	if seeker, ok := image.(io.Seeker); ok {
-		_, err = seeker.Seek(0, io.SeekStart)
-		if err != nil {
-			return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
-		}
-	}
-
-	if _, err = io.Copy(fw, image); err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
-	}
-
-	// 추가 파라미터 설정
-	params := map[string]string{
-		"device_id":  "0",
-		"model_name": model.ModelName,
-		"model_num":  fmt.Sprintf("%d", model.ModelNum),
-		"heatmap":    heatmap,
-	}
-
-	for key, value := range params {
-		if err = w.WriteField(key, value); err != nil {
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
 			return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
 		}
 	}
 
-	// multipart writer 닫기
-	if err = w.Close(); err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
-	}
-
-	// HTTP 요청 준비
-	url := "http://localhost:5000/api/vcls"
-	req, err := http.NewRequestWithContext(s.ctx, "POST", url, &b)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_CREATE_REQ, err)
-	}
-
-	// Content-Type 헤더 설정
-	req.Header.Set("Content-Type", w.FormDataContentType())
-
-	// HTTP 요청 실행
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_REQUEST, err)
+	engine, r := service_device.DeviceEngine(model.DeviceID)
+	if r != nil {
+		return nil, r
 	}
-	defer resp.Body.Close()
-
-	// 응답 본문 읽기
-	body, err := io.ReadAll(resp.Body)
+	result, err := engine.InferVCLS(s.ctx, engine_client.VCLSRequest{
+		DeviceID:  strconv.Itoa(model.GPUIndex),
+		ModelName: model.ModelName,
+		ModelNum:  model.ModelNum,
+		Heatmap:   heatmap,
+		Filename:  filename,
+		Image:     image,
+	})
 	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
-	}
-
-	// 응답 상태 코드 확인
-	if resp.StatusCode != http.StatusOK {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE,
-			fmt.Errorf("server returned non-OK status: %d, body: %s", resp.StatusCode, string(body)))
-	}
-
-	// 응답 파싱
-	var result map[string]interface{}
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, err)
+		return nil, engine_client.Report(err)
 	}
 
 	var data map[string]interface{}
@@ -928,15 +985,8 @@ func (s *TapiService) InferenceTabular(testId int, xFeatures []map[string]interf
 	logger.Debug("Inference TABULAR for test ID: ", testId)
 
 	// 로드된 모델 찾기
-	var model *repo.TapiLoaddedModel
-	for _, loaded := range s.tapiLoaddedModels {
-		if loaded.TestId == testId {
-			model = &loaded
-			break
-		}
-	}
-
-	if model == nil {
+	model, ok := s.findModel(testId)
+	if !ok {
 		return nil, logger.CreateReport(&logger.CODE_REMOTE_NOT_FOUND_MODEL, fmt.Errorf("model with test ID %d not found", testId))
 	}
 
@@ -946,68 +996,18 @@ func (s *TapiService) InferenceTabular(testId int, xFeatures []map[string]interf
 		return nil, logger.CreateReport(&logger.CODE_JSON_MARSHAL, err)
 	}
 
-	// multipart/form-data 준비
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
-
-	// 파라미터 설정
-	params := map[string]string{
-		"device_id":  "0",
-		"model_name": model.ModelName,
-		"model_num":  fmt.Sprintf("%d", model.ModelNum),
-		"x_input":    string(xInputJSON),
-	}
-
-	for key, value := range params {
-		if err := w.WriteField(key, value); err != nil {
-			return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
-		}
-	}
-
-	// multipart writer 닫기
-	if err := w.Close(); err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
-	}
-
-	// HTTP 요청 준비
-	url := "http://localhost:5000/api/tabular"
-	req, err := http.NewRequestWithContext(s.ctx, "POST", url, &b)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_CREATE_REQ, err)
-	}
-
-	// Content-Type 헤더 설정
-	req.Header.Set("Content-Type", w.FormDataContentType())
-
-	// HTTP 요청 실행
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_REQUEST, err)
+	engine, r := service_device.DeviceEngine(model.DeviceID)
+	if r != nil {
+		return nil, r
 	}
-	defer resp.Body.Close()
-
-	// 응답 본문 읽기
-	body, err := io.ReadAll(resp.Body)
+	result, err := engine.InferTabular(s.ctx, engine_client.TabularRequest{
+		DeviceID:  strconv.Itoa(model.GPUIndex),
+		ModelName: model.ModelName,
+		ModelNum:  model.ModelNum,
+		XInput:    string(xInputJSON),
+	})
 	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
-	}
-
-	// 응답 상태 코드 확인
-	if resp.StatusCode != http.StatusOK {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE,
-			fmt.Errorf("server returned non-OK status: %d, body: %s", resp.StatusCode, string(body)))
-	}
-
-	// JSON 형식이 아닐 경우 대비
-	if !json.Valid(body) {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, fmt.Errorf("response is not valid JSON: %s", string(body)))
-	}
-
-	// 응답 파싱
-	var result map[string]interface{}
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, err)
+		return nil, engine_client.Report(err)
 	}
 
 	// 성공 응답 반환
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	device_repo "api_server/device/repository"
+	service_device "api_server/device/service"
+	engine_client "api_server/engine/client"
+	"api_server/engine/fake"
+	"api_server/ent"
+	"api_server/logger"
+	repo "api_server/tapi/repository"
+	"api_server/utils"
+	"api_server/utils/dbtest"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TapiServiceTestSuite struct {
+	suite.Suite
+	client *ent.Client
+	svc    *TapiService
+}
+
+func (suite *TapiServiceTestSuite) SetupTest() {
+	logger.InitLogger("", "/workspace/log/test.log")
+	suite.client = dbtest.Open(suite.T())
+	suite.svc = &TapiService{
+		ctx:        context.Background(),
+		device_svc: service_device.New(device_repo.New()),
+		gpu_svc:    service_device.NewGPUService(device_repo.NewGPUDAO()),
+	}
+}
+
+// addDevice는 ip:port의 engine을 사용 중인 device로 등록하고 idle GPU 하나를 붙입니다.
+func (suite *TapiServiceTestSuite) addDevice(ip string, port int, connection string) *ent.Gpu {
+	ctx := context.Background()
+	device := suite.client.Device.Create().
+		SetName(fmt.Sprintf("%s:%d", ip, port)).
+		SetIP(ip).
+		SetPort(port).
+		SetConnection(connection).
+		SetAvailable("true").
+		SaveX(ctx)
+
+	return suite.client.Gpu.Create().
+		SetUUID(fmt.Sprintf("GPU-%d-0", device.ID)).
+		SetIndex(0).
+		SetName("Test GPU").
+		SetDeviceID(device.ID).
+		SetIsUse(true).
+		SetState(utils.GPU_STATE_IDLE).
+		SaveX(ctx)
+}
+
+// load는 gpu가 설치된 device의 engine에 모델을 올리고 올라간 모델로 기록합니다.
+func (suite *TapiServiceTestSuite) load(gpu *ent.Gpu, modelName string) repo.TapiLoaddedModel {
+	engine, r := service_device.DeviceEngine(gpu.DeviceID)
+	suite.Require().Nil(r)
+	resp, err := engine.Load(suite.svc.ctx, engine_client.LoadRequest{DeviceID: "0", ModelName: modelName, ModelType: utils.JOB_TYPE_TABLE_CLS})
+	suite.Require().NoError(err)
+
+	loadded := repo.TapiLoaddedModel{
+		TestId:    len(suite.svc.tapiLoaddedModels),
+		ModelName: modelName,
+		ModelNum:  resp.Models[0].ModelNum,
+		GPUIndex:  gpu.Index,
+		GPUID:     gpu.ID,
+		GPUUUID:   gpu.UUID,
+		DeviceID:  gpu.DeviceID,
+		ModelType: utils.JOB_TYPE_TABLE_CLS,
+	}
+	suite.svc.tapiLoaddedModels = append(suite.svc.tapiLoaddedModels, loadded)
+
+	return loadded
+}
+
+// drain하는 device의 모델은 다른 device의 engine에 올린 뒤 원래 engine에서 내린다.
+func (suite *TapiServiceTestSuite) TestMigrateToOtherDevice() {
+	source := fake.New(1)
+	defer source.Close()
+	target := fake.NewGRPC(1)
+	defer target.Close()
+	sourceGPU := suite.addDevice(source.IP(), source.Port(), utils.DEVICE_CONNECTION_REST)
+	targetGPU := suite.addDevice(target.IP(), target.Port(), utils.DEVICE_CONNECTION_GRPC)
+
+	loadded := suite.load(sourceGPU, "xgboost_1")
+	suite.Equal([]string{"xgboost_1"}, source.Loaded())
+
+	suite.Nil(suite.svc.ReleaseModels([]int{sourceGPU.ID}, true))
+	suite.Empty(source.Loaded())
+	suite.Equal([]string{"xgboost_1"}, target.Loaded())
+
+	moved, ok := suite.svc.findModel(loadded.TestId)
+	suite.Require().True(ok)
+	suite.Equal(targetGPU.ID, moved.GPUID)
+	suite.Equal(targetGPU.DeviceID, moved.DeviceID)
+
+	// 옮긴 뒤의 추론과 해제는 target device의 engine으로 보낸다.
+	_, r := suite.svc.InferenceTabular(loadded.TestId, []map[string]interface{}{{"x1": 1}})
+	suite.Nil(r)
+	suite.Empty(source.Requests("/api/tabular"))
+	suite.Nil(suite.svc.UnloadModel(loadded.TestId, targetGPU.ID))
+	suite.Empty(target.Loaded())
+	suite.Equal(0, suite.svc.CountModels([]int{sourceGPU.ID, targetGPU.ID}))
+}
+
+// 같은 이름의 모델이 여러 GPU에 있어도 test_id의 모델만 내린다.
+func (suite *TapiServiceTestSuite) TestUnloadByTestId() {
+	engine := fake.New(1)
+	defer engine.Close()
+	first := suite.addDevice(engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_REST)
+	other := fake.New(1)
+	defer other.Close()
+	second := suite.addDevice(other.IP(), other.Port(), utils.DEVICE_CONNECTION_REST)
+
+	a := suite.load(first, "xgboost_1")
+	b := suite.load(second, "xgboost_1")
+
+	suite.Nil(suite.svc.UnloadModel(a.TestId, first.ID))
+	suite.Empty(engine.Loaded())
+	suite.Equal([]string{"xgboost_1"}, other.Loaded())
+	_, ok := suite.svc.findModel(b.TestId)
+	suite.True(ok)
+}
+
+func TestTapiServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TapiServiceTestSuite))
+}
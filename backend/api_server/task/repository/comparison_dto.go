@@ -0,0 +1,43 @@
+package repository
+
+// ComparisonDTO는 여러 modeling을 나란히 비교한 결과입니다.
+// Params와 Metrics의 Values는 Modelings와 같은 순서로 정렬되어 있습니다.
+type ComparisonDTO struct {
+	Modelings []*ComparedModelingDTO `json:"modelings"`
+	Params    []*ParamRowDTO         `json:"params"`  // modeling 사이에 값이 다른 params만 포함
+	Metrics   []*MetricRowDTO        `json:"metrics"` // best model의 testset score
+	Curves    []*CompareCurveDTO     `json:"curves"`
+}
+
+// ComparedModelingDTO는 비교 대상 modeling 하나의 요약입니다.
+type ComparedModelingDTO struct {
+	ID           int     `json:"id"`
+	LocalID      int     `json:"local_id"`
+	TaskID       int     `json:"task_id"`
+	DatasetID    int     `json:"dataset_id"`
+	EngineType   string  `json:"engine_type"`
+	ModelingType string  `json:"modeling_type"`
+	ModelingStep string  `json:"modeling_step"`
+	BestModel    string  `json:"best_model"`
+	InfTime      float64 `json:"inf_time"`
+}
+
+// ParamRowDTO는 params key 하나의 modeling별 값입니다. 값이 없는 modeling은 nil입니다.
+type ParamRowDTO struct {
+	Key    string        `json:"key"`
+	Values []interface{} `json:"values"`
+}
+
+// MetricRowDTO는 metric 하나의 modeling별 값입니다. 값이 없는 modeling은 nil입니다.
+type MetricRowDTO struct {
+	Metric string     `json:"metric"`
+	Values []*float64 `json:"values"`
+}
+
+// CompareCurveDTO는 modeling 하나의 학습 곡선입니다.
+// tabular engine은 Tabular, 그 외 engine은 Loss가 채워집니다.
+type CompareCurveDTO struct {
+	ModelingID int                    `json:"modeling_id"`
+	Loss       *LossPerfChartResponse `json:"loss,omitempty"`
+	Tabular    *TabularChartResponse  `json:"tabular,omitempty"`
+}
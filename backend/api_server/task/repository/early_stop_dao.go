@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"api_server/ent"
+	"api_server/ent/earlystop"
+	"api_server/logger"
+	"api_server/utils"
+
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+type IEarlyStopDAO interface {
+	// InsertOne는 trial 중단 기록을 추가하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - req: 중단한 trial과 정책, 사유
+	//
+	// 반환 값:
+	//   - error: 추가 중 발생한 오류
+	InsertOne(ctx context.Context, req EarlyStopDTO) error
+
+	// SelectByTask는 task의 trial 중단 기록을 최신 순으로 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - task_id: Task ID
+	//
+	// 반환 값:
+	//   - []*ent.EarlyStop: 중단 기록 목록
+	//   - error: 조회 중 발생한 오류
+	SelectByTask(ctx context.Context, task_id int) ([]*ent.EarlyStop, error)
+
+	// SelectTrialCurves는 task에 속한 모든 trial의 epoch별 metric을 trial_status에서 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - task_id: Task ID
+	//
+	// 반환 값:
+	//   - []*TrialCurveDTO: trial ID 순으로 정렬된 trial 목록
+	//   - error: 조회 중 발생한 오류
+	SelectTrialCurves(ctx context.Context, task_id int) ([]*TrialCurveDTO, error)
+}
+
+type EarlyStopDAO struct {
+	dbms *ent.Client
+}
+
+var onceEarlyStop sync.Once
+var instanceEarlyStop *EarlyStopDAO
+
+func NewEarlyStopDAO() *EarlyStopDAO {
+	onceEarlyStop.Do(func() {
+		logger.Debug("EarlyStop DAO instance")
+		instanceEarlyStop = &EarlyStopDAO{
+			dbms: utils.GetEntClient(),
+		}
+	})
+
+	return instanceEarlyStop
+}
+
+func (dao *EarlyStopDAO) InsertOne(ctx context.Context, req EarlyStopDTO) error {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	return dao.dbms.EarlyStop.Create().
+		SetTaskID(req.TaskID).
+		SetModelingID(req.ModelingID).
+		SetTrialUUID(req.TrialUUID).
+		SetPolicy(req.Policy).
+		SetMetric(req.Metric).
+		SetEpoch(req.Epoch).
+		SetValue(req.Value).
+		SetReason(req.Reason).
+		SetCreatedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (dao *EarlyStopDAO) SelectByTask(ctx context.Context, task_id int) ([]*ent.EarlyStop, error) {
+	logger.Debug(fmt.Sprintf(`{"task_id": %d}`, task_id))
+	return dao.dbms.EarlyStop.Query().
+		Where(earlystop.TaskID(task_id)).
+		Order(earlystop.ByID(entsql.OrderDesc())).
+		All(ctx)
+}
+
+func (dao *EarlyStopDAO) SelectTrialCurves(ctx context.Context, task_id int) ([]*TrialCurveDTO, error) {
+	rows, err := dao.dbms.QueryContext(
+		ctx,
+		fmt.Sprintf(`SELECT M.id, M.modeling_step, M.executor, TR.uuid, TR.state, TS.status_json
+		FROM trial_status TS
+			JOIN trial TR ON TR.uuid = TS.trial_uuid
+			JOIN modeling M ON M.id = TR.modeling_id
+		WHERE M.task_id = %d
+		ORDER BY TR.id ASC, TS.id ASC;
+		`,
+			task_id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	curves := []*TrialCurveDTO{}
+	var curve *TrialCurveDTO
+	for rows.Next() {
+		row := TrialCurveDTO{}
+		statusJson := sql.NullString{}
+		if err := rows.Scan(&row.ModelingID, &row.ModelingStep, &row.Executor, &row.TrialUUID, &row.TrialState, &statusJson); err != nil {
+			logger.Debug(err)
+			continue
+		}
+		if curve == nil || curve.TrialUUID != row.TrialUUID {
+			curve = &row
+			curves = append(curves, curve)
+		}
+
+		epoch, metrics, ok := parseTrialStatus(statusJson)
+		if !ok {
+			continue
+		}
+		curve.Epochs = append(curve.Epochs, epoch)
+		curve.Metrics = append(curve.Metrics, metrics)
+	}
+
+	return curves, rows.Err()
+}
+
+// parseTrialStatus는 trial_status.status_json에서 epoch와 숫자 metric들을 꺼냅니다.
+func parseTrialStatus(statusJson sql.NullString) (int, map[string]float64, bool) {
+	if !statusJson.Valid {
+		return 0, nil, false
+	}
+
+	status := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(statusJson.String), &status); err != nil {
+		return 0, nil, false
+	}
+	epoch, ok := status["epoch"].(float64)
+	if !ok {
+		return 0, nil, false
+	}
+
+	metrics := make(map[string]float64)
+	for key, value := range status {
+		if v, ok := value.(float64); ok && key != "epoch" && key != "log_time" {
+			metrics[key] = v
+		}
+	}
+
+	return int(epoch), metrics, true
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"api_server/ent"
+	"api_server/ent/schema"
+	"time"
+)
+
+// EarlyStopDTO는 early stopping policy가 trial을 중단시킨 기록입니다.
+type EarlyStopDTO struct {
+	ID         int       `json:"id"`
+	TaskID     int       `json:"task_id"`
+	ModelingID int       `json:"modeling_id"`
+	TrialUUID  string    `json:"trial_uuid"`
+	Policy     string    `json:"policy"`
+	Metric     string    `json:"metric"`
+	Epoch      int       `json:"epoch"`
+	Value      float64   `json:"value"`
+	Reason     string    `json:"reason"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func ConvertEarlyStopEntToDTO(entity *ent.EarlyStop) *EarlyStopDTO {
+	return &EarlyStopDTO{
+		ID:         entity.ID,
+		TaskID:     entity.TaskID,
+		ModelingID: entity.ModelingID,
+		TrialUUID:  entity.TrialUUID,
+		Policy:     entity.Policy,
+		Metric:     entity.Metric,
+		Epoch:      entity.Epoch,
+		Value:      entity.Value,
+		Reason:     entity.Reason,
+		CreatedAt:  entity.CreatedAt,
+	}
+}
+
+func ConvertEarlyStopEntsToDTOs(ents []*ent.EarlyStop) []*EarlyStopDTO {
+	dtos := []*EarlyStopDTO{}
+
+	for _, entity := range ents {
+		dtos = append(dtos, ConvertEarlyStopEntToDTO(entity))
+	}
+
+	return dtos
+}
+
+// EarlyStopStatusDTO는 task의 early stopping policy와 중단 기록입니다.
+type EarlyStopStatusDTO struct {
+	TaskID   int                      `json:"task_id"`
+	Policies []schema.EarlyStopPolicy `json:"policies"`
+	Stops    []*EarlyStopDTO          `json:"stops"`
+}
+
+// TrialCurveDTO는 trial_status에 기록된 trial 하나의 epoch별 metric입니다.
+type TrialCurveDTO struct {
+	ModelingID   int
+	ModelingStep string
+	Executor     string
+	TrialUUID    string
+	TrialState   string
+	Epochs       []int
+	Metrics      []map[string]float64 // Epochs와 같은 순서
+}
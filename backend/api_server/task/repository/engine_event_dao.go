@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	engine_repo "api_server/engine/repository"
+	"api_server/ent"
+	"api_server/ent/gpu"
+	"api_server/ent/modeling"
+	"api_server/ent/trial"
+	"api_server/logger"
+	"api_server/utils"
+)
+
+type IEngineEventDAO interface {
+	// UpdateModelingProgress는 modeling의 진행률과 단계를 기록합니다. ModelingStep이 비어 있으면 단계는 바꾸지 않습니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - req: engine이 보낸 modeling 진행 상태
+	//
+	// 반환 값:
+	//   - error: 기록 중 발생한 오류
+	UpdateModelingProgress(ctx context.Context, req ModelingProgressDTO) error
+
+	// UpsertTrial은 trial 상태를 기록합니다. 같은 UUID의 trial이 없으면 새로 만들고,
+	// StatusJson이 있으면 trial_status에 epoch 결과를 추가합니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - req: engine이 보낸 trial 진행 상태
+	//
+	// 반환 값:
+	//   - error: 기록 중 발생한 오류
+	UpsertTrial(ctx context.Context, req TrialProgressDTO) error
+
+	// InsertLog는 engine log 하나를 enginelog에 추가합니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - req: engine이 보낸 log
+	//
+	// 반환 값:
+	//   - error: 추가 중 발생한 오류
+	InsertLog(ctx context.Context, req engine_repo.EngineLog) error
+
+	// IsPlacedOn은 modeling이 device의 GPU에 배치되어 있는지 확인합니다. modeling이 없으면 false입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - modeling_id: event의 modeling ID
+	//   - device_id: event를 보낸 device ID
+	//
+	// 반환 값:
+	//   - bool: modeling params의 device_ids 중 device의 GPU가 있으면 true
+	//   - error: 조회 중 발생한 오류
+	IsPlacedOn(ctx context.Context, modeling_id int, device_id int) (bool, error)
+}
+
+type EngineEventDAO struct {
+	dbms *ent.Client
+}
+
+var onceEngineEvent sync.Once
+var instanceEngineEvent *EngineEventDAO
+
+func NewEngineEventDAO() *EngineEventDAO {
+	onceEngineEvent.Do(func() {
+		logger.Debug("EngineEvent DAO instance")
+		instanceEngineEvent = &EngineEventDAO{
+			dbms: utils.GetEntClient(),
+		}
+	})
+
+	return instanceEngineEvent
+}
+
+func (dao *EngineEventDAO) UpdateModelingProgress(ctx context.Context, req ModelingProgressDTO) error {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	update := dao.dbms.Modeling.Update().
+		Where(modeling.ID(req.ModelingID)).
+		SetProgress(req.Progress).
+		SetUpdatedAt(req.At)
+	if req.ModelingStep != "" {
+		update.SetModelingStep(req.ModelingStep)
+	}
+
+	return update.Exec(ctx)
+}
+
+func (dao *EngineEventDAO) UpsertTrial(ctx context.Context, req TrialProgressDTO) error {
+	logger.Debug(fmt.Sprintf(`{"modeling_id": %d, "trial_uuid": %s, "state": %s}`, req.ModelingID, req.TrialUUID, req.State))
+	updated, err := dao.dbms.Trial.Update().
+		Where(trial.UUID(req.TrialUUID)).
+		SetState(req.State).
+		SetProgress(req.Progress).
+		SetUpdatedAt(req.At).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		create := dao.dbms.Trial.Create().
+			SetModelingID(req.ModelingID).
+			SetUUID(req.TrialUUID).
+			SetState(req.State).
+			SetProgress(req.Progress).
+			SetSavePath(req.SavePath).
+			SetCreatedAt(req.At).
+			SetUpdatedAt(req.At)
+		if req.TargetMetric != "" {
+			create.SetTargetMetric(req.TargetMetric)
+		}
+		if err := create.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if req.StatusJson == "" {
+		return nil
+	}
+
+	// status_json은 engine이 만든 JSON 객체를 그대로 저장한다. ent field 타입([]string)으로는 객체를 쓸 수 없다.
+	_, err = dao.dbms.ExecContext(
+		ctx,
+		`INSERT INTO trial_status (trial_uuid, status_json, created_at) VALUES ($1, $2, $3);`,
+		req.TrialUUID, req.StatusJson, req.At,
+	)
+
+	return err
+}
+
+func (dao *EngineEventDAO) InsertLog(ctx context.Context, req engine_repo.EngineLog) error {
+	return dao.dbms.EngineLog.Create().
+		SetModelingID(req.ModelingID).
+		SetLevel(req.Level).
+		SetFilename(req.Filename).
+		SetLine(req.Line).
+		SetMessage(req.Message).
+		SetCreatedAt(req.CreatedAt).
+		Exec(ctx)
+}
+
+func (dao *EngineEventDAO) IsPlacedOn(ctx context.Context, modeling_id int, device_id int) (bool, error) {
+	m, err := dao.dbms.Modeling.Query().Where(modeling.ID(modeling_id)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if len(m.Params) < 1 {
+		return false, nil
+	}
+
+	engineParams := EngineParams{}
+	if err := json.Unmarshal([]byte(m.Params[0]), &engineParams); err != nil {
+		return false, err
+	}
+	if len(engineParams.DeviceIDs) < 1 {
+		return false, nil
+	}
+
+	return dao.dbms.Gpu.Query().
+		Where(gpu.IDIn(engineParams.DeviceIDs...), gpu.DeviceID(device_id)).
+		Exist(ctx)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import "time"
+
+// ModelingProgressDTO는 gRPC engine이 보낸 modeling의 단계와 진행률입니다.
+type ModelingProgressDTO struct {
+	ModelingID   int       `json:"modeling_id"`
+	ModelingStep string    `json:"modeling_step"` // 비어 있으면 단계는 바뀌지 않은 것
+	Progress     float64   `json:"progress"`
+	At           time.Time `json:"at"`
+}
+
+// TrialProgressDTO는 gRPC engine이 보낸 trial 하나의 상태와 epoch 하나의 결과입니다.
+type TrialProgressDTO struct {
+	ModelingID   int       `json:"modeling_id"`
+	TrialUUID    string    `json:"trial_uuid"`
+	State        string    `json:"state"`
+	Progress     float64   `json:"progress"`
+	SavePath     string    `json:"save_path"`
+	TargetMetric string    `json:"target_metric"`
+	StatusJson   string    `json:"status_json"` // trial_status.status_json, 비어 있으면 기록하지 않음
+	At           time.Time `json:"at"`
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"api_server/ent"
+	"api_server/ent/hyperparamshistory"
+	"api_server/logger"
+	"api_server/utils"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type IHyperParamsDAO interface {
+	// InsertOne는 KAI.S가 제안한 trial 설정을 기록하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - req: trial modeling ID, trial 번호와 설정
+	//
+	// 반환 값:
+	//   - error: 추가 중 발생한 오류
+	InsertOne(ctx context.Context, req HyperParamsHistoryDTO) error
+
+	// SelectManySearch는 modeling들에 대해 KAI.S가 기록한 trial을 조회하는 함수입니다.
+	// engine이 기록한 hyper_params_history는 제외합니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - trial_ids: trial modeling ID 목록
+	//
+	// 반환 값:
+	//   - []*ent.HyperParamsHistory: trial 번호 순으로 정렬된 기록
+	//   - error: 조회 중 발생한 오류
+	SelectManySearch(ctx context.Context, trial_ids []int) ([]*ent.HyperParamsHistory, error)
+
+	// UpdateScore는 끝난 trial의 best model과 탐색 metric을 기록하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - id: hyper_params_history ID
+	//   - model: trial의 best model
+	//   - score: 탐색 metric 값
+	//
+	// 반환 값:
+	//   - error: 변경 중 발생한 오류
+	UpdateScore(ctx context.Context, id int, model string, score float64) error
+}
+
+type HyperParamsDAO struct {
+	dbms *ent.Client
+}
+
+var onceHyperParams sync.Once
+var instanceHyperParams *HyperParamsDAO
+
+func NewHyperParamsDAO() *HyperParamsDAO {
+	onceHyperParams.Do(func() {
+		logger.Debug("HyperParams DAO instance")
+		instanceHyperParams = &HyperParamsDAO{
+			dbms: utils.GetEntClient(),
+		}
+	})
+
+	return instanceHyperParams
+}
+
+func (dao *HyperParamsDAO) InsertOne(ctx context.Context, req HyperParamsHistoryDTO) error {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	return dao.dbms.HyperParamsHistory.Create().
+		SetTrialID(req.TrialID).
+		SetTrialUUID(req.TrialUUID).
+		SetModel(req.Model).
+		SetModelNum(req.ModelNum).
+		SetParams(req.Params).
+		SetCreatedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (dao *HyperParamsDAO) SelectManySearch(ctx context.Context, trial_ids []int) ([]*ent.HyperParamsHistory, error) {
+	logger.Debug(fmt.Sprintf(`{"trial_ids": %v}`, trial_ids))
+	return dao.dbms.HyperParamsHistory.Query().
+		Where(
+			hyperparamshistory.TrialIDIn(trial_ids...),
+			hyperparamshistory.TrialUUIDHasPrefix(utils.SEARCH_TRIAL_UUID_PREFIX),
+		).
+		Order(hyperparamshistory.ByModelNum(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (dao *HyperParamsDAO) UpdateScore(ctx context.Context, id int, model string, score float64) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d, "model": %s, "score": %f}`, id, model, score))
+	return dao.dbms.HyperParamsHistory.UpdateOneID(id).
+		SetModel(model).
+		SetScore(score).
+		Exec(ctx)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import "time"
+
+// LineageDTO는 modeling 하나의 조상과 자손 modeling입니다.
+type LineageDTO struct {
+	ModelingID int               `json:"modeling_id"`
+	TaskID     int               `json:"task_id"`
+	Ancestors  []*LineageNodeDTO `json:"ancestors"` // 최초 modeling부터 부모까지, children은 비어 있음
+	Tree       *LineageNodeDTO   `json:"tree"`      // 조회한 modeling과 자손
+}
+
+// LineageNodeDTO는 lineage의 modeling 하나입니다. 부모가 있으면 params를 부모와 비교한 차이를 포함합니다.
+type LineageNodeDTO struct {
+	ID            int                `json:"id"`
+	LocalID       int                `json:"local_id"`
+	ParentID      int                `json:"parent_id"`
+	ParentLocalID int                `json:"parent_local_id"`
+	ModelingType  string             `json:"modeling_type"`
+	ModelingStep  string             `json:"modeling_step"`
+	DatasetID     int                `json:"dataset_id"`
+	ParamsDiff    []*ParamDiffDTO    `json:"params_diff"`
+	Scores        map[string]float64 `json:"scores"`
+	InfTime       float64            `json:"inf_time"`
+	CreatedAt     time.Time          `json:"created_at"`
+	Children      []*LineageNodeDTO  `json:"children"`
+}
+
+// ParamDiffDTO는 두 modeling params의 값이 다른 key 하나입니다. 한쪽에 없는 key는 nil입니다.
+type ParamDiffDTO struct {
+	Key    string      `json:"key"`
+	Parent interface{} `json:"parent"`
+	Value  interface{} `json:"value"`
+}
@@ -10,12 +10,18 @@ import (
 	"time"
 
 	"api_server/ent"
+	"api_server/ent/enginelog"
 	"api_server/ent/modeling"
 	"api_server/ent/modelingmodels"
+	"api_server/ent/task"
+	"api_server/ent/trial"
+	"api_server/ent/trialstatus"
 	"api_server/logger"
 	"api_server/utils"
 
-	"entgo.io/ent/dialect/sql"
+	"database/sql"
+
+	entsql "entgo.io/ent/dialect/sql"
 )
 
 type IModelingDAO interface {
@@ -87,6 +93,134 @@ type IModelingDAO interface {
 	//   - error: 삽입 중 발생한 오류
 	InsertModelingModels(ctx context.Context, req ModelingModels) (*ent.ModelingModels, error)
 	SelectModelingModelsByTypeAndModelingID(ctx context.Context, modeling_id int, data_type string) (*ent.ModelingModels, error)
+
+	// SelectManyRunning는 엔진에 요청되었거나 실행 중인 Modeling 목록을 조회하는 함수입니다.
+	// fair-share 계산을 위해 상위 Task의 project_id를 함께 조회합니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//
+	// 반환 값:
+	//   - []*ent.Modeling: request, run 상태의 Modeling 목록
+	//   - error: 조회 중 발생한 오류
+	SelectManyRunning(ctx context.Context) ([]*ent.Modeling, error)
+	// UpdatePriority는 대기 중인 Modeling의 우선순위를 변경하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - modeling_id: 변경할 Modeling의 ID
+	//   - priority: 새 우선순위 (값이 클수록 먼저 실행)
+	//
+	// 반환 값:
+	//   - error: 변경 중 발생한 오류
+	UpdatePriority(ctx context.Context, modeling_id int, priority int) error
+	// UpdateQueuePositions는 대기 중인 Modeling들의 대기열 순서를 modeling_ids 순서대로 지정하는 함수입니다.
+	// 목록에 없는 대기 중인 Modeling의 순서는 지웁니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - modeling_ids: 먼저 실행할 순서의 Modeling ID 목록
+	//
+	// 반환 값:
+	//   - error: 변경 중 발생한 오류
+	UpdateQueuePositions(ctx context.Context, modeling_ids []int) error
+	// UpdateJob는 Modeling을 실행한 executor와 job 정보를 기록하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - modeling_id: 변경할 Modeling의 ID
+	//   - executor: executor 이름 (rest, slurm)
+	//   - job_id: executor의 job ID (REST executor는 빈 문자열)
+	//   - job_type: 제출한 job 종류 (train, evaluation, blind, finish)
+	//
+	// 반환 값:
+	//   - error: 변경 중 발생한 오류
+	UpdateJob(ctx context.Context, modeling_id int, executor string, job_id string, job_type string) error
+	// SelectManyByExecutor는 주어진 executor에서 주어진 단계에 있는 Modeling 목록을 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - executor: executor 이름
+	//   - steps: 조회할 modeling_step 목록
+	//
+	// 반환 값:
+	//   - []*ent.Modeling: 조회된 Modeling 목록
+	//   - error: 조회 중 발생한 오류
+	SelectManyByExecutor(ctx context.Context, executor string, steps ...string) ([]*ent.Modeling, error)
+	// UpdateAttempt는 실행 요청에 실패한 Modeling의 시도 횟수와 다음 시도 시각을 기록하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - modeling_id: 변경할 Modeling의 ID
+	//   - attempts: 지금까지 실패한 시도 횟수
+	//   - next_attempt_at: 다음 시도 가능 시각
+	//   - reason: 마지막 실패 사유
+	//
+	// 반환 값:
+	//   - error: 변경 중 발생한 오류
+	UpdateAttempt(ctx context.Context, modeling_id int, attempts int, next_attempt_at time.Time, reason string) error
+	// ResetAttempt는 실행 요청에 성공한 Modeling의 시도 횟수, 다음 시도 시각, 실패 사유를 지우는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - modeling_id: 변경할 Modeling의 ID
+	//
+	// 반환 값:
+	//   - error: 변경 중 발생한 오류
+	ResetAttempt(ctx context.Context, modeling_id int) error
+	// UpdateFail는 Modeling을 실패 상태로 바꾸고 실패 사유를 기록하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - modeling_id: 변경할 Modeling의 ID
+	//   - reason: 실패 사유
+	//
+	// 반환 값:
+	//   - error: 변경 중 발생한 오류
+	UpdateFail(ctx context.Context, modeling_id int, reason string) error
+	// UpdateStateFrom는 Modeling의 단계가 from 중 하나일 때만 state로 바꾸는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - modeling_id: 변경할 Modeling의 ID
+	//   - state: 변경할 단계
+	//   - from: 변경을 허용하는 현재 단계
+	//
+	// 반환 값:
+	//   - bool: 단계를 바꿨는지 여부
+	//   - error: 변경 중 발생한 오류
+	UpdateStateFrom(ctx context.Context, modeling_id int, state string, from ...string) (bool, error)
+	// SelectLatestTrained는 Task에서 가장 최근에 학습을 마친 initial/update Modeling을 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - task_id: Task ID
+	//
+	// 반환 값:
+	//   - *ent.Modeling: 조회된 Modeling
+	//   - error: 조회 중 발생한 오류, 학습을 마친 Modeling이 없으면 NotFound
+	SelectLatestTrained(ctx context.Context, task_id int) (*ent.Modeling, error)
+	// SelectHeartbeat는 Modeling의 마지막 활동 시각을 조회하는 함수입니다.
+	// Modeling의 updated_at, 최근 enginelog, 최근 trial_status 중 가장 늦은 시각입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - modeling_id: Modeling ID
+	//
+	// 반환 값:
+	//   - time.Time: 마지막 활동 시각
+	//   - error: 조회 중 발생한 오류
+	SelectHeartbeat(ctx context.Context, modeling_id int) (time.Time, error)
+	// SelectDurations는 완료된 Modeling의 평균 실행 시간을 engine type 별로 조회하는 함수입니다.
+	// 실행 시간은 engine에 실행을 요청한 started_at부터 마지막으로 갱신된 updated_at까지입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//
+	// 반환 값:
+	//   - map[string]time.Duration: engine type 별 평균 실행 시간
+	//   - error: 조회 중 발생한 오류
+	SelectDurations(ctx context.Context) (map[string]time.Duration, error)
 }
 
 type ModelingDAO struct {
@@ -134,24 +268,74 @@ func (dao *ModelingDAO) InsertOne(ctx context.Context, req ModelingDTO) (*ent.Mo
 			Where(modeling.TaskID(req.TaskID)).
 			CountX(ctx) + 1).
 		SetParentLocalID(parent.LocalID).
+		SetPriority(req.Priority).
+		SetUsername(req.Username).
+		SetQueuedAt(time.Now()).
+		SetPipelineStage(req.PipelineStage).
+		SetSearchTrial(req.SearchTrial).
 		Save(ctx)
 }
 
 func (dao *ModelingDAO) SelectManyIdle(ctx context.Context) ([]*ent.Modeling, error) {
 	// logger.Debug("Select idle modelings")
 	return dao.dbms.Modeling.Query().
-		Select(modeling.FieldID, modeling.FieldParams, modeling.FieldModelingType).
+		Select(
+			modeling.FieldID,
+			modeling.FieldTaskID,
+			modeling.FieldParams,
+			modeling.FieldModelingType,
+			modeling.FieldPriority,
+			modeling.FieldUsername,
+			modeling.FieldQueuedAt,
+			modeling.FieldQueuePosition,
+			modeling.FieldAttempts,
+			modeling.FieldNextAttemptAt,
+			modeling.FieldFailReason,
+		).
 		Where(modeling.ModelingStep(utils.MODELING_STEP_IDLE)).
-		Order(modeling.ByCreatedAt(sql.OrderAsc())).
+		WithTask(func(q *ent.TaskQuery) {
+			q.Select(task.FieldID, task.FieldProjectID, task.FieldEngineType)
+		}).
+		Order(modeling.ByPriority(entsql.OrderDesc()), modeling.ByQueuedAt(entsql.OrderAsc())).
 		All(ctx)
 }
 
 func (dao *ModelingDAO) SelectManyFinish(ctx context.Context) ([]*ent.Modeling, error) {
 	// logger.Debug("Select finish modelings")
 	return dao.dbms.Modeling.Query().
-		Select(modeling.FieldID, modeling.FieldParams, modeling.FieldModelingType).
+		Select(
+			modeling.FieldID,
+			modeling.FieldParams,
+			modeling.FieldModelingType,
+			modeling.FieldExecutor,
+			modeling.FieldJobID,
+			modeling.FieldJobType,
+		).
 		Where(modeling.ModelingStep(utils.MODELING_STEP_FINISH)).
-		Order(modeling.ByUpdatedAt(sql.OrderAsc())).
+		Order(modeling.ByUpdatedAt(entsql.OrderAsc())).
+		All(ctx)
+}
+
+func (dao *ModelingDAO) SelectManyRunning(ctx context.Context) ([]*ent.Modeling, error) {
+	return dao.dbms.Modeling.Query().
+		Select(
+			modeling.FieldID,
+			modeling.FieldTaskID,
+			modeling.FieldParams,
+			modeling.FieldModelingType,
+			modeling.FieldModelingStep,
+			modeling.FieldPriority,
+			modeling.FieldUsername,
+			modeling.FieldExecutor,
+			modeling.FieldJobID,
+			modeling.FieldJobType,
+			modeling.FieldUpdatedAt,
+			modeling.FieldStartedAt,
+		).
+		Where(modeling.ModelingStepIn(utils.MODELING_STEP_REQUEST, utils.MODELING_STEP_RUN)).
+		WithTask(func(q *ent.TaskQuery) {
+			q.Select(task.FieldID, task.FieldProjectID, task.FieldEngineType)
+		}).
 		All(ctx)
 }
 
@@ -163,7 +347,7 @@ func (dao *ModelingDAO) SelectByTask(ctx context.Context, task_id int) ([]*Model
 			`SELECT id, local_id, task_id, parent_id, parent_local_id, dataset_id
 				, modeling_type, modeling_step, params
 				, dataset_stat, performance, progress
-				, created_at, updated_at
+				, created_at, updated_at, priority, username, fail_reason, pipeline_stage
 			FROM modeling
 			WHERE task_id = %d
 			ORDER BY id DESC
@@ -183,7 +367,7 @@ func (dao *ModelingDAO) SelectByTask(ctx context.Context, task_id int) ([]*Model
 			&result.ID, &result.LocalID, &result.TaskID, &result.ParentID, &result.ParentLocalID, &result.DatasetID,
 			&result.ModelingType, &result.ModelingStep, &result.Params,
 			&result.DatasetStat, &result.Performance, &result.Progress,
-			&result.CreatedAt, &result.UpdatedAt,
+			&result.CreatedAt, &result.UpdatedAt, &result.Priority, &result.Username, &result.FailReason, &result.PipelineStage,
 		); err != nil {
 			fmt.Println(err)
 			continue
@@ -249,6 +433,15 @@ func (dao *ModelingDAO) SelectOne(ctx context.Context, id int) (*ent.Modeling, e
 			modeling.FieldParentID,
 			modeling.FieldParentLocalID,
 			modeling.FieldDatasetID,
+			modeling.FieldPriority,
+			modeling.FieldExecutor,
+			modeling.FieldJobID,
+			modeling.FieldJobType,
+			modeling.FieldAttempts,
+			modeling.FieldFailReason,
+			modeling.FieldPipelineStage,
+			modeling.FieldCreatedAt,
+			modeling.FieldUpdatedAt,
 		).
 		Where(modeling.ID(id)).
 		Only(ctx)
@@ -262,7 +455,7 @@ func (dao *ModelingDAO) SelectFull(ctx context.Context, id int) (*ModelingDB, er
 			`SELECT id, local_id, task_id, parent_id, parent_local_id, dataset_id
 				, modeling_type, modeling_step, params
 				, dataset_stat, performance, progress
-				, created_at, updated_at, started_at
+				, created_at, updated_at, started_at, fail_reason
 			FROM modeling
 			WHERE id = %d
 			`,
@@ -277,19 +470,25 @@ func (dao *ModelingDAO) SelectFull(ctx context.Context, id int) (*ModelingDB, er
 	results := []*ModelingDB{}
 	for rows.Next() {
 		result := ModelingDB{}
+		startedAt := sql.NullTime{}
 		if err := rows.Scan(
 			&result.ID, &result.LocalID, &result.TaskID, &result.ParentID, &result.ParentLocalID, &result.DatasetID,
 			&result.ModelingType, &result.ModelingStep, &result.Params,
 			&result.DatasetStat, &result.Performance, &result.Progress,
-			&result.CreatedAt, &result.UpdatedAt,
+			&result.CreatedAt, &result.UpdatedAt, &startedAt, &result.FailReason,
 		); err != nil {
 			fmt.Println(err)
 			continue
 		}
+		result.StartedAt = startedAt.Time
 
 		results = append(results, &result)
 	}
 
+	if len(results) < 1 {
+		return nil, fmt.Errorf("modeling %d not found", id)
+	}
+
 	return results[0], nil
 }
 
@@ -304,14 +503,209 @@ func (dao *ModelingDAO) UpdateCancel(ctx context.Context) error {
 
 func (dao *ModelingDAO) UpdateState(ctx context.Context, modeling_id int, state string) error {
 	logger.Debug(fmt.Sprintf(`{"id": %d, "state": %s}`, modeling_id, state))
+	update := dao.dbms.Modeling.Update().
+		Where(modeling.ID(modeling_id)).
+		SetModelingStep(state).
+		SetUpdatedAt(time.Now())
+	// started_at은 engine에 실행을 요청한 시각이며, 실행 시간 추정에 사용한다.
+	if state == utils.MODELING_STEP_REQUEST {
+		update.SetStartedAt(time.Now())
+	}
+
+	return update.Exec(ctx)
+}
+
+func (dao *ModelingDAO) UpdatePriority(ctx context.Context, modeling_id int, priority int) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d, "priority": %d}`, modeling_id, priority))
+	return dao.dbms.Modeling.Update().
+		Where(modeling.ID(modeling_id), modeling.ModelingStep(utils.MODELING_STEP_IDLE)).
+		SetPriority(priority).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (dao *ModelingDAO) UpdateQueuePositions(ctx context.Context, modeling_ids []int) error {
+	logger.Debug(fmt.Sprintf(`{"ids": %+v}`, modeling_ids))
+	tx, err := dao.dbms.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Modeling.Update().
+		Where(modeling.ModelingStep(utils.MODELING_STEP_IDLE), modeling.QueuePositionNotNil()).
+		ClearQueuePosition().
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	for i, id := range modeling_ids {
+		if err := tx.Modeling.Update().
+			Where(modeling.ID(id), modeling.ModelingStep(utils.MODELING_STEP_IDLE)).
+			SetQueuePosition(i + 1).
+			Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (dao *ModelingDAO) UpdateJob(ctx context.Context, modeling_id int, executor string, job_id string, job_type string) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d, "executor": %s, "job_id": %s, "job_type": %s}`, modeling_id, executor, job_id, job_type))
+	return dao.dbms.Modeling.Update().
+		Where(modeling.ID(modeling_id)).
+		SetExecutor(executor).
+		SetJobID(job_id).
+		SetJobType(job_type).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (dao *ModelingDAO) SelectManyByExecutor(ctx context.Context, executor string, steps ...string) ([]*ent.Modeling, error) {
+	return dao.dbms.Modeling.Query().
+		Select(
+			modeling.FieldID,
+			modeling.FieldParams,
+			modeling.FieldModelingType,
+			modeling.FieldModelingStep,
+			modeling.FieldExecutor,
+			modeling.FieldJobID,
+			modeling.FieldJobType,
+		).
+		Where(modeling.Executor(executor), modeling.ModelingStepIn(steps...)).
+		All(ctx)
+}
+
+func (dao *ModelingDAO) UpdateAttempt(ctx context.Context, modeling_id int, attempts int, next_attempt_at time.Time, reason string) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d, "attempts": %d, "next_attempt_at": %s}`, modeling_id, attempts, next_attempt_at))
 	return dao.dbms.Modeling.Update().
 		Where(modeling.ID(modeling_id)).
+		SetAttempts(attempts).
+		SetNextAttemptAt(next_attempt_at).
+		SetFailReason(reason).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (dao *ModelingDAO) ResetAttempt(ctx context.Context, modeling_id int) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, modeling_id))
+	return dao.dbms.Modeling.Update().
+		Where(modeling.ID(modeling_id)).
+		SetAttempts(0).
+		ClearNextAttemptAt().
+		SetFailReason("").
+		Exec(ctx)
+}
+
+func (dao *ModelingDAO) UpdateStateFrom(ctx context.Context, modeling_id int, state string, from ...string) (bool, error) {
+	logger.Debug(fmt.Sprintf(`{"id": %d, "state": %s, "from": %+v}`, modeling_id, state, from))
+	updated, err := dao.dbms.Modeling.Update().
+		Where(
+			modeling.ID(modeling_id),
+			modeling.ModelingStepIn(from...),
+		).
 		SetModelingStep(state).
 		SetUpdatedAt(time.Now()).
-		SetStartedAt(time.Now()).
+		Save(ctx)
+
+	return updated > 0, err
+}
+
+func (dao *ModelingDAO) UpdateFail(ctx context.Context, modeling_id int, reason string) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d, "reason": %s}`, modeling_id, reason))
+	return dao.dbms.Modeling.Update().
+		Where(modeling.ID(modeling_id)).
+		SetModelingStep(utils.MODELING_STEP_FAIL).
+		SetFailReason(reason).
+		ClearNextAttemptAt().
+		SetUpdatedAt(time.Now()).
 		Exec(ctx)
 }
 
+func (dao *ModelingDAO) SelectLatestTrained(ctx context.Context, task_id int) (*ent.Modeling, error) {
+	logger.Debug(fmt.Sprintf(`{"task_id": %d}`, task_id))
+	return dao.dbms.Modeling.Query().
+		Where(
+			modeling.TaskID(task_id),
+			modeling.ModelingTypeIn(utils.MODELING_TYPE_INITIAL, utils.MODELING_TYPE_UPDATE),
+			modeling.ModelingStep(utils.MODELING_STEP_COMPLETE),
+		).
+		Order(modeling.ByID(entsql.OrderDesc())).
+		First(ctx)
+}
+
+func (dao *ModelingDAO) SelectHeartbeat(ctx context.Context, modeling_id int) (time.Time, error) {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, modeling_id))
+	m, err := dao.dbms.Modeling.Query().
+		Select(modeling.FieldUpdatedAt).
+		Where(modeling.ID(modeling_id)).
+		Only(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	heartbeat := m.UpdatedAt
+
+	log, err := dao.dbms.EngineLog.Query().
+		Where(enginelog.ModelingID(modeling_id)).
+		Order(enginelog.ByCreatedAt(entsql.OrderDesc())).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return heartbeat, err
+	} else if log != nil && log.CreatedAt.After(heartbeat) {
+		heartbeat = log.CreatedAt
+	}
+
+	uuids, err := dao.dbms.Trial.Query().
+		Where(trial.ModelingID(modeling_id)).
+		Select(trial.FieldUUID).
+		Strings(ctx)
+	if err != nil || len(uuids) < 1 {
+		return heartbeat, err
+	}
+	status, err := dao.dbms.TrialStatus.Query().
+		Where(trialstatus.TrialUUIDIn(uuids...)).
+		Order(trialstatus.ByCreatedAt(entsql.OrderDesc())).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return heartbeat, err
+	} else if status != nil && status.CreatedAt.After(heartbeat) {
+		heartbeat = status.CreatedAt
+	}
+
+	return heartbeat, nil
+}
+
+func (dao *ModelingDAO) SelectDurations(ctx context.Context) (map[string]time.Duration, error) {
+	rows, err := dao.dbms.QueryContext(
+		ctx,
+		`SELECT t.engine_type, AVG(EXTRACT(EPOCH FROM (m.updated_at - m.started_at)))
+			FROM modeling m
+			JOIN task t ON t.id = m.task_id
+			WHERE m.modeling_step = $1
+				AND m.started_at IS NOT NULL
+				AND m.updated_at > m.started_at
+			GROUP BY t.engine_type`,
+		utils.MODELING_STEP_COMPLETE,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	durations := make(map[string]time.Duration)
+	for rows.Next() {
+		var engineType string
+		var seconds float64
+		if err := rows.Scan(&engineType, &seconds); err != nil {
+			return nil, err
+		}
+		durations[engineType] = time.Duration(seconds * float64(time.Second))
+	}
+
+	return durations, rows.Err()
+}
+
 func (dao *ModelingDAO) UpdateParams(ctx context.Context, modeling_id int, params []string) error {
 	return dao.dbms.Modeling.Update().
 		Where(modeling.ID(modeling_id)).
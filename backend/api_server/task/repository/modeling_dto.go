@@ -3,6 +3,7 @@ package repository
 import (
 	"api_server/ent"
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
@@ -22,6 +23,15 @@ type ModelingDTO struct {
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
 	StartedAt     time.Time `json:"started_at"`
+	Priority      int       `json:"priority"`
+	Username      string    `json:"username"`
+	QueuedAt      time.Time `json:"queued_at"`
+	Executor      string    `json:"executor"`
+	JobID         string    `json:"job_id"`
+	Attempts      int       `json:"attempts"`
+	FailReason    string    `json:"fail_reason"`
+	PipelineStage int       `json:"pipeline_stage"`
+	SearchTrial   int       `json:"search_trial"`
 
 	ModelingModels []*ModelingModels  `json:"modeling_models"`
 	Scores         map[string]float64 `json:"scores"`
@@ -52,6 +62,10 @@ type ModelingDB struct {
 	CreatedAt     time.Time          `json:"created_at"`
 	UpdatedAt     time.Time          `json:"updated_at"`
 	StartedAt     time.Time          `json:"started_at"`
+	Priority      int                `json:"priority"`
+	Username      string             `json:"username"`
+	FailReason    string             `json:"fail_reason"`
+	PipelineStage int                `json:"pipeline_stage"`
 	Scores        map[string]float64 `json:"scores"`
 	InfTime       float64            `json:"inf_time"`
 }
@@ -72,6 +86,15 @@ func ConvertModelingEntToDTO(entity *ent.Modeling) *ModelingDTO {
 		Progress:       entity.Progress,
 		CreatedAt:      entity.CreatedAt,
 		UpdatedAt:      entity.UpdatedAt,
+		Priority:       entity.Priority,
+		Username:       entity.Username,
+		QueuedAt:       entity.QueuedAt,
+		Executor:       entity.Executor,
+		JobID:          entity.JobID,
+		Attempts:       entity.Attempts,
+		FailReason:     entity.FailReason,
+		PipelineStage:  entity.PipelineStage,
+		SearchTrial:    entity.SearchTrial,
 		ModelingModels: ConvertModelingModelsEntsToDTOs(entity.Edges.ModelingModels),
 	}
 }
@@ -117,6 +140,10 @@ type EngineParams struct {
 	TargetMetric  string   `json:"target_metric"`
 	DeviceIDs     []int    `json:"device_ids"`
 	GPUAuto       bool     `json:"gpu_auto"`
+	GPUCount      int      `json:"gpu_count,omitempty"`
+	MinFreeMemory float64  `json:"min_free_memory,omitempty"` // GPU 당 필요한 여유 메모리(MiB)
+	SameDevice    bool     `json:"same_device,omitempty"`     // 한 device의 GPU만 사용
+	Executor      string   `json:"executor,omitempty"`
 	EngineType    string   `json:"engine_type"`
 	OriginID      int      `json:"origin_id"`
 	OriginPath    string   `json:"origin_path"`
@@ -126,6 +153,27 @@ type EngineParams struct {
 	Mode          string   `json:"mode"`
 }
 
+// RequestedGPUCount는 modeling params에 지정된 GPU 개수를 반환합니다.
+// 배치 전 자동 배치 modeling은 gpu_count, 지정되지 않았으면 1개로 봅니다.
+func RequestedGPUCount(m *ent.Modeling) int {
+	if len(m.Params) < 1 {
+		return 1
+	}
+
+	engineParams := EngineParams{}
+	if err := json.Unmarshal([]byte(m.Params[0]), &engineParams); err != nil {
+		return 1
+	}
+	if len(engineParams.DeviceIDs) > 0 {
+		return len(engineParams.DeviceIDs)
+	}
+	if engineParams.GPUAuto && engineParams.GPUCount > 0 {
+		return engineParams.GPUCount
+	}
+
+	return 1
+}
+
 type EvaluationDTO struct {
 	TaskID       int                    `json:"task_id,omitempty"`
 	ParentID     int                    `json:"parent_id,omitempty"`
@@ -134,4 +182,58 @@ type EvaluationDTO struct {
 	TargetMetric string                 `json:"target_metric,omitempty"`
 	IsPath       bool                   `json:"is_path,omitempty"` // is true, blind test
 	UserParams   map[string]interface{} `json:"user_params,omitempty"`
+	Priority     int                    `json:"priority,omitempty"`
+	Username     string                 `json:"username,omitempty"`
+
+	PipelineStage int `json:"-"` // pipeline이 만든 단계일 때 단계 번호
+}
+
+// PriorityDTO는 대기 중인 modeling의 우선순위 변경 요청입니다.
+type PriorityDTO struct {
+	ModelingID int `json:"modeling_id"`
+	Priority   int `json:"priority"`
+}
+
+// QueueOrderDTO는 대기 중인 modeling들의 실행 순서 변경 요청입니다.
+// ModelingIDs는 같은 우선순위의 다른 modeling보다 먼저, 목록 순서대로 실행됩니다.
+// 우선순위가 높은 modeling이 낮은 modeling 뒤에 오면 거부됩니다.
+type QueueOrderDTO struct {
+	ModelingIDs []int `json:"modeling_ids"`
+}
+
+// QueueItemDTO는 대기 중인 modeling의 대기열 순서와 실행되지 않은 이유입니다.
+type QueueItemDTO struct {
+	ModelingID       int        `json:"modeling_id"`
+	TaskID           int        `json:"task_id"`
+	ProjectID        int        `json:"project_id"`
+	EngineType       string     `json:"engine_type"`
+	ModelingType     string     `json:"modeling_type"`
+	Priority         int        `json:"priority"`
+	Username         string     `json:"username"`
+	QueuedAt         time.Time  `json:"queued_at"`
+	Position         int        `json:"position"`              // 1부터 시작하는 대기 순서
+	GPUCount         int        `json:"gpu_count"`             // 기다리는 GPU 개수
+	DeviceIDs        []int      `json:"device_ids,omitempty"`  // 사용자가 지정한 GPU ID
+	WaitReason       string     `json:"wait_reason,omitempty"` // no idle gpus | quota | device offline | higher priority | retry
+	WaitDetail       string     `json:"wait_detail,omitempty"`
+	EstimatedStartAt *time.Time `json:"estimated_start_at"` // 추정할 수 없으면 null
+}
+
+// ReconcileReportDTO는 engine이 실제로 실행 중인 modeling과 DB 상태를 맞춘 결과입니다.
+type ReconcileReportDTO struct {
+	StartedAt          time.Time `json:"started_at"`
+	FinishedAt         time.Time `json:"finished_at"`
+	Devices            []int     `json:"devices"`             // 응답한 device ID
+	UnreachableDevices []int     `json:"unreachable_devices"` // 응답하지 않은 device ID
+	Running            []int     `json:"running"`             // engine에서 실행 중인 modeling ID
+	Requeued           []int     `json:"requeued"`            // request -> idle 로 되돌린 modeling ID
+	Failed             []int     `json:"failed"`              // run -> fail 로 바꾼 modeling ID
+	Skipped            []int     `json:"skipped"`             // device가 응답하지 않아 그대로 둔 modeling ID
+	ReleasedGPUs       []int     `json:"released_gpus"`       // idle 로 되돌린 GPU ID
+}
+
+// ModelingNotifyDTO는 engine이 modeling 단계 변경을 알릴 때 보내는 요청입니다.
+type ModelingNotifyDTO struct {
+	ModelingID   int    `json:"modeling_id"`
+	ModelingStep string `json:"modeling_step"`
 }
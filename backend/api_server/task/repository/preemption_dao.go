@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"api_server/ent"
+	"api_server/ent/preemption"
+	"api_server/logger"
+	"api_server/utils"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type IPreemptionDAO interface {
+	// InsertOne는 preemption 기록을 추가하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - req: 중단시킨 modeling과 중단된 modeling 정보
+	//
+	// 반환 값:
+	//   - error: 추가 중 발생한 오류
+	InsertOne(ctx context.Context, req PreemptionDTO) error
+
+	// SelectMany는 preemption 기록을 최신 순으로 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - modeling_id: 중단시켰거나 중단된 modeling ID, 0이면 전체
+	//
+	// 반환 값:
+	//   - []*ent.Preemption: 최근 100개의 기록
+	//   - error: 조회 중 발생한 오류
+	SelectMany(ctx context.Context, modeling_id int) ([]*ent.Preemption, error)
+}
+
+type PreemptionDAO struct {
+	dbms *ent.Client
+}
+
+var oncePreemption sync.Once
+var instancePreemption *PreemptionDAO
+
+func NewPreemptionDAO() *PreemptionDAO {
+	oncePreemption.Do(func() {
+		logger.Debug("Preemption DAO instance")
+		instancePreemption = &PreemptionDAO{
+			dbms: utils.GetEntClient(),
+		}
+	})
+
+	return instancePreemption
+}
+
+func (dao *PreemptionDAO) InsertOne(ctx context.Context, req PreemptionDTO) error {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	return dao.dbms.Preemption.Create().
+		SetModelingID(req.ModelingID).
+		SetPriority(req.Priority).
+		SetUsername(req.Username).
+		SetVictimID(req.VictimID).
+		SetVictimPriority(req.VictimPriority).
+		SetVictimUsername(req.VictimUsername).
+		SetGpuIds(req.GPUIDs).
+		SetCreatedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (dao *PreemptionDAO) SelectMany(ctx context.Context, modeling_id int) ([]*ent.Preemption, error) {
+	logger.Debug(fmt.Sprintf(`{"modeling_id": %d}`, modeling_id))
+	query := dao.dbms.Preemption.Query()
+	if modeling_id > 0 {
+		query = query.Where(preemption.Or(
+			preemption.ModelingID(modeling_id),
+			preemption.VictimID(modeling_id),
+		))
+	}
+
+	return query.
+		Order(preemption.ByID(sql.OrderDesc())).
+		Limit(100).
+		All(ctx)
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"api_server/ent"
+	"time"
+)
+
+// PreemptionDTO는 높은 우선순위 modeling(ModelingID)이 실행 중인 modeling(VictimID)을 중단시킨 기록입니다.
+type PreemptionDTO struct {
+	ID             int       `json:"id"`
+	ModelingID     int       `json:"modeling_id"`
+	Priority       int       `json:"priority"`
+	Username       string    `json:"username"`
+	VictimID       int       `json:"victim_id"`
+	VictimPriority int       `json:"victim_priority"`
+	VictimUsername string    `json:"victim_username"`
+	GPUIDs         []int     `json:"gpu_ids"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func ConvertPreemptionEntToDTO(entity *ent.Preemption) *PreemptionDTO {
+	return &PreemptionDTO{
+		ID:             entity.ID,
+		ModelingID:     entity.ModelingID,
+		Priority:       entity.Priority,
+		Username:       entity.Username,
+		VictimID:       entity.VictimID,
+		VictimPriority: entity.VictimPriority,
+		VictimUsername: entity.VictimUsername,
+		GPUIDs:         entity.GpuIds,
+		CreatedAt:      entity.CreatedAt,
+	}
+}
+
+func ConvertPreemptionEntsToDTOs(ents []*ent.Preemption) []*PreemptionDTO {
+	dtos := []*PreemptionDTO{}
+
+	for _, entity := range ents {
+		dtos = append(dtos, ConvertPreemptionEntToDTO(entity))
+	}
+
+	return dtos
+}
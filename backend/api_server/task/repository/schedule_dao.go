@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"api_server/ent"
+	"api_server/ent/schedule"
+	"api_server/ent/schedulehistory"
+	"api_server/logger"
+	"api_server/utils"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+type IScheduleDAO interface {
+	InsertOne(ctx context.Context, req ScheduleDTO) (*ent.Schedule, error)
+	SelectOne(ctx context.Context, id int) (*ent.Schedule, error)
+	SelectByTask(ctx context.Context, task_id int) ([]*ent.Schedule, error)
+	UpdateOne(ctx context.Context, req ScheduleDTO) (*ent.Schedule, error)
+	DeleteOne(ctx context.Context, id int) error
+
+	// SelectDue는 실행 시각이 된 cron schedule들을 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - now: 기준 시각
+	//
+	// 반환 값:
+	//   - []*ent.Schedule: 일시 정지되지 않았고 next_run_at이 now 이전인 schedule 목록
+	//   - error: 조회 중 발생한 오류
+	SelectDue(ctx context.Context, now time.Time) ([]*ent.Schedule, error)
+
+	// SelectDatasetTriggers는 dataset 변경으로 실행되는 schedule들을 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//
+	// 반환 값:
+	//   - []*ent.Schedule: 일시 정지되지 않은 dataset trigger schedule 목록
+	//   - error: 조회 중 발생한 오류
+	SelectDatasetTriggers(ctx context.Context) ([]*ent.Schedule, error)
+
+	// UpdateTrainedFingerprint는 schedule이 마지막으로 학습한 dataset fingerprint를 기록하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - id: schedule ID
+	//   - fingerprint: dataset fingerprint, 빈 문자열이면 다음 확인 때 현재 fingerprint를 기준으로 삼음
+	//
+	// 반환 값:
+	//   - error: 변경 중 발생한 오류
+	UpdateTrainedFingerprint(ctx context.Context, id int, fingerprint string) error
+
+	// UpdatePaused는 schedule을 일시 정지하거나 다시 시작하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - id: schedule ID
+	//   - paused: 일시 정지 여부
+	//   - next_run_at: 다음 실행 시각, nil이면 지움
+	//
+	// 반환 값:
+	//   - error: 변경 중 발생한 오류
+	UpdatePaused(ctx context.Context, id int, paused bool, next_run_at *time.Time) error
+
+	// UpdateRun는 schedule이 실행된 시각과 다음 실행 시각을 기록하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - id: schedule ID
+	//   - last_run_at: 실행 시각
+	//   - next_run_at: 다음 실행 시각, nil이면 지움
+	//
+	// 반환 값:
+	//   - error: 변경 중 발생한 오류
+	UpdateRun(ctx context.Context, id int, last_run_at time.Time, next_run_at *time.Time) error
+
+	// InsertHistory는 schedule 실행 기록을 추가하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - req: 실행 기록
+	//
+	// 반환 값:
+	//   - error: 추가 중 발생한 오류
+	InsertHistory(ctx context.Context, req ScheduleHistoryDTO) error
+
+	// SelectHistory는 schedule 실행 기록을 최신 순으로 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - schedule_id: schedule ID
+	//
+	// 반환 값:
+	//   - []*ent.ScheduleHistory: 실행 기록 목록
+	//   - error: 조회 중 발생한 오류
+	SelectHistory(ctx context.Context, schedule_id int) ([]*ent.ScheduleHistory, error)
+}
+
+type ScheduleDAO struct {
+	dbms *ent.Client
+}
+
+var onceSchedule sync.Once
+var instanceSchedule *ScheduleDAO
+
+func NewScheduleDAO() *ScheduleDAO {
+	onceSchedule.Do(func() {
+		logger.Debug("Schedule DAO instance")
+		instanceSchedule = &ScheduleDAO{
+			dbms: utils.GetEntClient(),
+		}
+	})
+
+	return instanceSchedule
+}
+
+func (dao *ScheduleDAO) InsertOne(ctx context.Context, req ScheduleDTO) (*ent.Schedule, error) {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	return dao.dbms.Schedule.Create().
+		SetTaskID(req.TaskID).
+		SetParentID(req.ParentID).
+		SetTrigger(req.Trigger).
+		SetCron(req.Cron).
+		SetDatasetID(req.DatasetID).
+		SetPaused(req.Paused).
+		SetPriority(req.Priority).
+		SetUsername(req.Username).
+		SetNillableNextRunAt(req.NextRunAt).
+		SetCreatedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+}
+
+func (dao *ScheduleDAO) SelectOne(ctx context.Context, id int) (*ent.Schedule, error) {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	return dao.dbms.Schedule.Query().
+		Where(schedule.ID(id)).
+		Only(ctx)
+}
+
+func (dao *ScheduleDAO) SelectByTask(ctx context.Context, task_id int) ([]*ent.Schedule, error) {
+	logger.Debug(fmt.Sprintf(`{"task_id": %d}`, task_id))
+	return dao.dbms.Schedule.Query().
+		Where(schedule.TaskID(task_id)).
+		Order(schedule.ByID(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (dao *ScheduleDAO) UpdateOne(ctx context.Context, req ScheduleDTO) (*ent.Schedule, error) {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	update := dao.dbms.Schedule.UpdateOneID(req.ID).
+		SetParentID(req.ParentID).
+		SetTrigger(req.Trigger).
+		SetCron(req.Cron).
+		SetDatasetID(req.DatasetID).
+		SetPriority(req.Priority).
+		SetUpdatedAt(time.Now())
+	if req.NextRunAt != nil {
+		update.SetNextRunAt(*req.NextRunAt)
+	} else {
+		update.ClearNextRunAt()
+	}
+
+	return update.Save(ctx)
+}
+
+func (dao *ScheduleDAO) DeleteOne(ctx context.Context, id int) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	return dao.dbms.Schedule.DeleteOneID(id).Exec(ctx)
+}
+
+func (dao *ScheduleDAO) SelectDue(ctx context.Context, now time.Time) ([]*ent.Schedule, error) {
+	return dao.dbms.Schedule.Query().
+		Where(
+			schedule.Trigger(utils.SCHEDULE_TRIGGER_CRON),
+			schedule.Paused(false),
+			schedule.NextRunAtLTE(now),
+		).
+		All(ctx)
+}
+
+func (dao *ScheduleDAO) SelectDatasetTriggers(ctx context.Context) ([]*ent.Schedule, error) {
+	return dao.dbms.Schedule.Query().
+		Where(
+			schedule.Trigger(utils.SCHEDULE_TRIGGER_DATASET),
+			schedule.Paused(false),
+		).
+		All(ctx)
+}
+
+func (dao *ScheduleDAO) UpdateTrainedFingerprint(ctx context.Context, id int, fingerprint string) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d, "fingerprint": %q}`, id, fingerprint))
+	return dao.dbms.Schedule.UpdateOneID(id).
+		SetTrainedFingerprint(fingerprint).
+		Exec(ctx)
+}
+
+func (dao *ScheduleDAO) UpdatePaused(ctx context.Context, id int, paused bool, next_run_at *time.Time) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d, "paused": %t}`, id, paused))
+	update := dao.dbms.Schedule.UpdateOneID(id).
+		SetPaused(paused).
+		SetUpdatedAt(time.Now())
+	if next_run_at != nil {
+		update.SetNextRunAt(*next_run_at)
+	} else {
+		update.ClearNextRunAt()
+	}
+
+	return update.Exec(ctx)
+}
+
+func (dao *ScheduleDAO) UpdateRun(ctx context.Context, id int, last_run_at time.Time, next_run_at *time.Time) error {
+	update := dao.dbms.Schedule.UpdateOneID(id).
+		SetLastRunAt(last_run_at)
+	if next_run_at != nil {
+		update.SetNextRunAt(*next_run_at)
+	} else {
+		update.ClearNextRunAt()
+	}
+
+	return update.Exec(ctx)
+}
+
+func (dao *ScheduleDAO) InsertHistory(ctx context.Context, req ScheduleHistoryDTO) error {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	return dao.dbms.ScheduleHistory.Create().
+		SetScheduleID(req.ScheduleID).
+		SetModelingID(req.ModelingID).
+		SetTrigger(req.Trigger).
+		SetStatus(req.Status).
+		SetMessage(req.Message).
+		SetCreatedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (dao *ScheduleDAO) SelectHistory(ctx context.Context, schedule_id int) ([]*ent.ScheduleHistory, error) {
+	logger.Debug(fmt.Sprintf(`{"schedule_id": %d}`, schedule_id))
+	return dao.dbms.ScheduleHistory.Query().
+		Where(schedulehistory.ScheduleID(schedule_id)).
+		Order(schedulehistory.ByID(sql.OrderDesc())).
+		Limit(100).
+		All(ctx)
+}
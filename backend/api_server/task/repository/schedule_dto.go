@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"api_server/ent"
+	"time"
+)
+
+type ScheduleDTO struct {
+	ID        int        `json:"id"`
+	TaskID    int        `json:"task_id"`
+	ParentID  int        `json:"parent_id"` // 0이면 실행 시점의 최신 학습 modeling
+	Trigger   string     `json:"trigger"`   // cron | dataset
+	Cron      string     `json:"cron"`
+	DatasetID int        `json:"dataset_id"`
+	Paused    bool       `json:"paused"`
+	Priority  int        `json:"priority"`
+	Username  string     `json:"username"`
+	NextRunAt *time.Time `json:"next_run_at"`
+	LastRunAt *time.Time `json:"last_run_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type ScheduleHistoryDTO struct {
+	ID         int       `json:"id"`
+	ScheduleID int       `json:"schedule_id"`
+	ModelingID int       `json:"modeling_id"`
+	Trigger    string    `json:"trigger"`
+	Status     string    `json:"status"` // created | skipped | failed
+	Message    string    `json:"message"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func ConvertScheduleEntToDTO(entity *ent.Schedule) *ScheduleDTO {
+	return &ScheduleDTO{
+		ID:        entity.ID,
+		TaskID:    entity.TaskID,
+		ParentID:  entity.ParentID,
+		Trigger:   entity.Trigger,
+		Cron:      entity.Cron,
+		DatasetID: entity.DatasetID,
+		Paused:    entity.Paused,
+		Priority:  entity.Priority,
+		Username:  entity.Username,
+		NextRunAt: entity.NextRunAt,
+		LastRunAt: entity.LastRunAt,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
+
+func ConvertScheduleEntsToDTOs(ents []*ent.Schedule) []*ScheduleDTO {
+	dtos := []*ScheduleDTO{}
+
+	for _, entity := range ents {
+		dtos = append(dtos, ConvertScheduleEntToDTO(entity))
+	}
+
+	return dtos
+}
+
+func ConvertScheduleHistoryEntsToDTOs(ents []*ent.ScheduleHistory) []*ScheduleHistoryDTO {
+	dtos := []*ScheduleHistoryDTO{}
+
+	for _, entity := range ents {
+		dtos = append(dtos, &ScheduleHistoryDTO{
+			ID:         entity.ID,
+			ScheduleID: entity.ScheduleID,
+			ModelingID: entity.ModelingID,
+			Trigger:    entity.Trigger,
+			Status:     entity.Status,
+			Message:    entity.Message,
+			CreatedAt:  entity.CreatedAt,
+		})
+	}
+
+	return dtos
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"api_server/ent"
+	"time"
+)
+
+// HyperParamsHistoryDTO는 hyperparameter 탐색 trial 하나의 설정과 결과입니다.
+type HyperParamsHistoryDTO struct {
+	ID        int       `json:"id"`
+	TrialID   int       `json:"trial_id"` // modeling ID
+	TrialUUID string    `json:"trial_uuid"`
+	Model     string    `json:"model"`     // trial의 best model
+	ModelNum  int       `json:"model_num"` // trial 번호
+	Params    string    `json:"params"`    // 제안한 설정(JSON)
+	Score     *float64  `json:"score"`     // 탐색 metric, 끝나지 않았거나 실패하면 nil
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ConvertHyperParamsHistoryEntToDTO(entity *ent.HyperParamsHistory) *HyperParamsHistoryDTO {
+	return &HyperParamsHistoryDTO{
+		ID:        entity.ID,
+		TrialID:   entity.TrialID,
+		TrialUUID: entity.TrialUUID,
+		Model:     entity.Model,
+		ModelNum:  entity.ModelNum,
+		Params:    entity.Params,
+		Score:     entity.Score,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+// SearchStatusDTO는 task hyperparameter 탐색 전체와 trial별 진행 상태입니다.
+type SearchStatusDTO struct {
+	TaskID    int               `json:"task_id"`
+	Status    string            `json:"status"`
+	Strategy  string            `json:"strategy"`
+	Metric    string            `json:"metric"`
+	Direction string            `json:"direction"`
+	MaxTrials int               `json:"max_trials"`
+	BestTrial int               `json:"best_trial,omitempty"`
+	BestScore *float64          `json:"best_score,omitempty"`
+	Trials    []*SearchTrialDTO `json:"trials"`
+}
+
+// SearchTrialDTO는 탐색 trial 하나의 상태입니다.
+type SearchTrialDTO struct {
+	Trial        int                    `json:"trial"`
+	ModelingID   int                    `json:"modeling_id"`
+	ModelingStep string                 `json:"modeling_step"`
+	Params       map[string]interface{} `json:"params"`
+	Model        string                 `json:"model,omitempty"`
+	Score        *float64               `json:"score"`
+	FailReason   string                 `json:"fail_reason,omitempty"`
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"api_server/ent"
+	"api_server/ent/modeling"
+	"api_server/ent/schema"
 	"api_server/ent/task"
 	"api_server/logger"
 	"api_server/utils"
@@ -47,6 +49,92 @@ type ITaskDAO interface {
 	SelectIDsByProjectIDs(ctx context.Context, projectIDs []int) ([]int, error)
 
 	SelectByEngineType(ctx context.Context, engine_type string) ([]*ent.Task, error)
+
+	// SelectManyPipelineRunning는 pipeline이 진행 중인 Task와 pipeline에 속한 modeling들을 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//
+	// 반환 값:
+	//   - []*ent.Task: modeling edge가 pipeline_stage 순서로 채워진 Task 목록
+	//   - error: 조회 중 발생한 오류
+	SelectManyPipelineRunning(ctx context.Context) ([]*ent.Task, error)
+
+	// SelectPipeline는 Task 하나와 pipeline에 속한 modeling들을 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - id: Task ID
+	//
+	// 반환 값:
+	//   - *ent.Task: modeling edge가 pipeline_stage 순서로 채워진 Task
+	//   - error: 조회 중 발생한 오류
+	SelectPipeline(ctx context.Context, id int) (*ent.Task, error)
+
+	// UpdatePipelineStatus는 Task의 pipeline 전체 상태를 변경하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - id: Task ID
+	//   - status: utils.PIPELINE_STATUS_* 값
+	//
+	// 반환 값:
+	//   - error: 변경 중 발생한 오류
+	UpdatePipelineStatus(ctx context.Context, id int, status string) error
+
+	// SelectManySearchRunning는 hyperparameter 탐색이 진행 중인 Task와 trial modeling들을 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//
+	// 반환 값:
+	//   - []*ent.Task: modeling edge가 search_trial 순서로 채워진 Task 목록
+	//   - error: 조회 중 발생한 오류
+	SelectManySearchRunning(ctx context.Context) ([]*ent.Task, error)
+
+	// SelectSearch는 Task 하나와 hyperparameter 탐색 trial modeling들을 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - id: Task ID
+	//
+	// 반환 값:
+	//   - *ent.Task: modeling edge가 search_trial 순서로 채워진 Task
+	//   - error: 조회 중 발생한 오류
+	SelectSearch(ctx context.Context, id int) (*ent.Task, error)
+
+	// UpdateSearchStatus는 Task의 hyperparameter 탐색 상태를 변경하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - id: Task ID
+	//   - status: utils.SEARCH_STATUS_* 값
+	//
+	// 반환 값:
+	//   - error: 변경 중 발생한 오류
+	UpdateSearchStatus(ctx context.Context, id int, status string) error
+
+	// SelectManyEarlyStop는 early stopping policy가 있고 실행 중인 modeling이 있는 Task를 조회하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//
+	// 반환 값:
+	//   - []*ent.Task: Task 목록
+	//   - error: 조회 중 발생한 오류
+	SelectManyEarlyStop(ctx context.Context) ([]*ent.Task, error)
+
+	// UpdateEarlyStop는 Task의 early stopping policy를 변경하는 함수입니다.
+	//
+	// 매개변수:
+	//   - ctx: 실행 컨텍스트
+	//   - id: Task ID
+	//   - policies: 적용할 policy 목록, 비어 있으면 early stopping을 끈다
+	//
+	// 반환 값:
+	//   - *ent.Task: 변경된 Task
+	//   - error: 변경 중 발생한 오류
+	UpdateEarlyStop(ctx context.Context, id int, policies []schema.EarlyStopPolicy) (*ent.Task, error)
 }
 
 type TaskDAO struct {
@@ -69,7 +157,7 @@ func NewTaskDAO() *TaskDAO {
 
 func (dao *TaskDAO) InsertOne(ctx context.Context, req TaskDTO) (*ent.Task, error) {
 	logger.Debug(fmt.Sprintf("%+v", req))
-	return dao.dbms.Task.Create().
+	create := dao.dbms.Task.Create().
 		SetProjectID(*req.ProjectID).
 		SetDatasetID(*req.DatasetID).
 		SetTitle(req.Title).
@@ -77,9 +165,17 @@ func (dao *TaskDAO) InsertOne(ctx context.Context, req TaskDTO) (*ent.Task, erro
 		SetEngineType(req.EngineType).
 		SetTargetMetric(req.TargetMetric).
 		SetParams(req.Params).
+		SetPipeline(req.Pipeline).
+		SetPipelineStatus(req.PipelineStatus).
+		SetSearchStatus(req.SearchStatus).
+		SetEarlyStop(req.EarlyStop).
 		SetCreatedAt(time.Now()).
-		SetUpdatedAt(time.Now()).
-		Save(ctx)
+		SetUpdatedAt(time.Now())
+	if req.Search != nil {
+		create.SetSearch(req.Search)
+	}
+
+	return create.Save(ctx)
 }
 
 func (dao *TaskDAO) SelectOne(ctx context.Context, id int) (*ent.Task, error) {
@@ -188,3 +284,98 @@ func (dao *TaskDAO) SelectByEngineType(ctx context.Context, engine_type string)
 		Where(task.EngineTypeEQ(engine_type)).
 		All(ctx)
 }
+
+func (dao *TaskDAO) SelectManyPipelineRunning(ctx context.Context) ([]*ent.Task, error) {
+	return dao.dbms.Task.Query().
+		Where(task.PipelineStatus(utils.PIPELINE_STATUS_RUN)).
+		WithModelings(withPipelineModelings).
+		All(ctx)
+}
+
+func (dao *TaskDAO) SelectPipeline(ctx context.Context, id int) (*ent.Task, error) {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	return dao.dbms.Task.Query().
+		Where(task.ID(id)).
+		WithModelings(withPipelineModelings).
+		Only(ctx)
+}
+
+func withPipelineModelings(q *ent.ModelingQuery) {
+	q.Select(
+		modeling.FieldID,
+		modeling.FieldTaskID,
+		modeling.FieldModelingType,
+		modeling.FieldModelingStep,
+		modeling.FieldPriority,
+		modeling.FieldUsername,
+		modeling.FieldFailReason,
+		modeling.FieldPipelineStage,
+	).
+		Where(modeling.PipelineStageGT(0)).
+		Order(modeling.ByPipelineStage(sql.OrderAsc()), modeling.ByID(sql.OrderAsc()))
+}
+
+func (dao *TaskDAO) UpdatePipelineStatus(ctx context.Context, id int, status string) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d, "status": %s}`, id, status))
+	return dao.dbms.Task.UpdateOneID(id).
+		SetPipelineStatus(status).
+		Exec(ctx)
+}
+
+func (dao *TaskDAO) SelectManySearchRunning(ctx context.Context) ([]*ent.Task, error) {
+	return dao.dbms.Task.Query().
+		Where(task.SearchStatus(utils.SEARCH_STATUS_RUN)).
+		WithModelings(withSearchModelings).
+		All(ctx)
+}
+
+func (dao *TaskDAO) SelectSearch(ctx context.Context, id int) (*ent.Task, error) {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	return dao.dbms.Task.Query().
+		Where(task.ID(id)).
+		WithModelings(withSearchModelings).
+		Only(ctx)
+}
+
+func withSearchModelings(q *ent.ModelingQuery) {
+	q.Select(
+		modeling.FieldID,
+		modeling.FieldTaskID,
+		modeling.FieldModelingType,
+		modeling.FieldModelingStep,
+		modeling.FieldPriority,
+		modeling.FieldUsername,
+		modeling.FieldFailReason,
+		modeling.FieldSearchTrial,
+	).
+		Where(modeling.SearchTrialGT(0)).
+		Order(modeling.BySearchTrial(sql.OrderAsc()), modeling.ByID(sql.OrderAsc()))
+}
+
+func (dao *TaskDAO) UpdateSearchStatus(ctx context.Context, id int, status string) error {
+	logger.Debug(fmt.Sprintf(`{"id": %d, "status": %s}`, id, status))
+	return dao.dbms.Task.UpdateOneID(id).
+		SetSearchStatus(status).
+		Exec(ctx)
+}
+
+func (dao *TaskDAO) SelectManyEarlyStop(ctx context.Context) ([]*ent.Task, error) {
+	return dao.dbms.Task.Query().
+		Where(
+			task.EarlyStopNotNil(),
+			task.HasModelingsWith(modeling.ModelingStep(utils.MODELING_STEP_RUN)),
+		).
+		All(ctx)
+}
+
+func (dao *TaskDAO) UpdateEarlyStop(ctx context.Context, id int, policies []schema.EarlyStopPolicy) (*ent.Task, error) {
+	logger.Debug(fmt.Sprintf(`{"id": %d, "policies": %+v}`, id, policies))
+	update := dao.dbms.Task.UpdateOneID(id).SetUpdatedAt(time.Now())
+	if len(policies) > 0 {
+		update.SetEarlyStop(policies)
+	} else {
+		update.ClearEarlyStop()
+	}
+
+	return update.Save(ctx)
+}
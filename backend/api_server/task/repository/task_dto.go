@@ -2,21 +2,29 @@ package repository
 
 import (
 	"api_server/ent"
+	"api_server/ent/schema"
 	"time"
 )
 
 type TaskDTO struct {
-	ID           int                    `json:"id,omitempty"`
-	ProjectID    *int                   `json:"project_id,omitempty"`
-	DatasetID    *int                   `json:"dataset_id,omitempty"`
-	Title        string                 `json:"title,omitempty"`
-	Description  string                 `json:"description,omitempty"`
-	EngineType   string                 `json:"engine_type,omitempty"`
-	TargetMetric string                 `json:"target_metric,omitempty"`
-	UserParams   map[string]interface{} `json:"user_params,omitempty"`
-	Params       []string               `json:"params,omitempty"`
-	CreatedAt    time.Time              `json:"created_at,omitempty"`
-	UpdatedAt    time.Time              `json:"updated_at,omitempty"`
+	ID             int                      `json:"id,omitempty"`
+	ProjectID      *int                     `json:"project_id,omitempty"`
+	DatasetID      *int                     `json:"dataset_id,omitempty"`
+	Title          string                   `json:"title,omitempty"`
+	Description    string                   `json:"description,omitempty"`
+	EngineType     string                   `json:"engine_type,omitempty"`
+	TargetMetric   string                   `json:"target_metric,omitempty"`
+	UserParams     map[string]interface{}   `json:"user_params,omitempty"`
+	Params         []string                 `json:"params,omitempty"`
+	Priority       int                      `json:"priority,omitempty"`
+	Username       string                   `json:"username,omitempty"`
+	Pipeline       []schema.PipelineStage   `json:"pipeline,omitempty"`
+	PipelineStatus string                   `json:"pipeline_status,omitempty"`
+	Search         *schema.HPSearch         `json:"search,omitempty"`
+	SearchStatus   string                   `json:"search_status,omitempty"`
+	EarlyStop      []schema.EarlyStopPolicy `json:"early_stop,omitempty"`
+	CreatedAt      time.Time                `json:"created_at,omitempty"`
+	UpdatedAt      time.Time                `json:"updated_at,omitempty"`
 
 	Modelings []*ModelingDTO `json:"modelings,omitempty"`
 }
@@ -28,17 +36,22 @@ type TaskPages struct {
 
 func ConvertTaskEntToDTO(entity *ent.Task) *TaskDTO {
 	return &TaskDTO{
-		ID:           entity.ID,
-		ProjectID:    &entity.ProjectID,
-		DatasetID:    &entity.DatasetID,
-		Title:        entity.Title,
-		Description:  entity.Description,
-		EngineType:   entity.EngineType,
-		TargetMetric: entity.TargetMetric,
-		Params:       entity.Params,
-		CreatedAt:    entity.CreatedAt,
-		UpdatedAt:    entity.UpdatedAt,
-		Modelings:    ConvertModelingEntsToDTOs(entity.Edges.Modelings),
+		ID:             entity.ID,
+		ProjectID:      &entity.ProjectID,
+		DatasetID:      &entity.DatasetID,
+		Title:          entity.Title,
+		Description:    entity.Description,
+		EngineType:     entity.EngineType,
+		TargetMetric:   entity.TargetMetric,
+		Params:         entity.Params,
+		Pipeline:       entity.Pipeline,
+		PipelineStatus: entity.PipelineStatus,
+		Search:         entity.Search,
+		SearchStatus:   entity.SearchStatus,
+		EarlyStop:      entity.EarlyStop,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+		Modelings:      ConvertModelingEntsToDTOs(entity.Edges.Modelings),
 	}
 }
 
@@ -79,3 +92,20 @@ func ConvertTaskEntsToTaskPages(ents []*ent.Task, pageCount int) *TaskPages {
 		TotalPage: pageCount,
 	}
 }
+
+// PipelineStatusDTO는 task pipeline 전체와 단계별 진행 상태입니다.
+type PipelineStatusDTO struct {
+	TaskID int                       `json:"task_id"`
+	Status string                    `json:"status"`
+	Stages []*PipelineStageStatusDTO `json:"stages"`
+}
+
+// PipelineStageStatusDTO는 pipeline 단계 하나의 상태입니다. 1단계는 학습입니다.
+type PipelineStageStatusDTO struct {
+	Stage        int    `json:"stage"`
+	Type         string `json:"type"`
+	DatasetID    int    `json:"dataset_id"`
+	ModelingID   int    `json:"modeling_id,omitempty"`
+	ModelingStep string `json:"modeling_step"`
+	FailReason   string `json:"fail_reason,omitempty"`
+}
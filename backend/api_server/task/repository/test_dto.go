@@ -6,6 +6,8 @@ type TestDTO struct {
 	ModelingID int    `json:"modeling_id"`
 	ModelName  string `json:"model_name"`
 	ModelType  string `json:"model_type"`
+	DeviceID   int    `json:"device_id"` // GPU가 설치된 device, GPU index가 여러 device에 있으면 필요
+	GpuIndex   int    `json:"gpu_index"` // device 안의 GPU index
 }
 
 type ColumnsResponse struct {
@@ -0,0 +1,58 @@
+package router
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"api_server/logger"
+	repo "api_server/task/repository"
+	"api_server/task/service"
+)
+
+type EarlyStopController struct {
+	svc service.IEarlyStopService
+}
+
+var onceEarlyStop sync.Once
+var instanceEarlyStop *EarlyStopController
+
+func NewEarlyStopController(svc service.IEarlyStopService) *EarlyStopController {
+	onceEarlyStop.Do(func() {
+		logger.Debug("EarlyStop Controller instance")
+		instanceEarlyStop = &EarlyStopController{
+			svc: svc,
+		}
+	})
+
+	return instanceEarlyStop
+}
+
+func (ctlr *EarlyStopController) GetByTask(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if task_id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.ReadByTask(task_id)
+		logger.ApiResponse(c, report, data)
+	}
+}
+
+func (ctlr *EarlyStopController) UpdateByTask(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	reqDTO := repo.EarlyStopStatusDTO{}
+	if task_id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.UpdatePolicies(task_id, reqDTO.Policies)
+		logger.ApiResponse(c, report, data)
+	}
+}
@@ -2,6 +2,7 @@ package router
 
 import (
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/gin-gonic/gin"
@@ -9,6 +10,7 @@ import (
 	"api_server/logger"
 	repo "api_server/task/repository"
 	"api_server/task/service"
+	"api_server/utils"
 )
 
 type ModelingController struct {
@@ -68,11 +70,20 @@ func (ctlr *ModelingController) GetModelingType(c *gin.Context) {
 func (ctlr *ModelingController) AddEvaluation(c *gin.Context) {
 	logger.ApiRequest(c)
 
+	ctxData, err := utils.GetDataFromToken(c)
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
 	reqDTO := repo.EvaluationDTO{}
 	if err := c.ShouldBindJSON(&reqDTO); err != nil {
 		r := logger.CreateReport(&logger.CODE_REQUEST, err)
 		logger.ApiResponse(c, r, nil)
+	} else if r := checkPriority(ctxData, reqDTO.Priority); r != nil {
+		logger.ApiResponse(c, r, nil)
 	} else {
+		reqDTO.Username = ctxData.Username
 		data, report := ctlr.svc.CreateEvaluation(reqDTO)
 		logger.ApiResponse(c, report, data)
 	}
@@ -101,3 +112,108 @@ func (ctlr *ModelingController) DeleteById(c *gin.Context) {
 		logger.ApiResponse(c, report, nil)
 	}
 }
+
+func (ctlr *ModelingController) UpdatePriority(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	reqDTO := repo.PriorityDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		report := ctlr.svc.UpdatePriority(reqDTO)
+		logger.ApiResponse(c, report, nil)
+	}
+}
+
+func (ctlr *ModelingController) BumpById(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		report := ctlr.svc.BumpOne(id)
+		logger.ApiResponse(c, report, nil)
+	}
+}
+
+func (ctlr *ModelingController) ReorderQueue(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	reqDTO := repo.QueueOrderDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		report := ctlr.svc.ReorderQueue(reqDTO)
+		logger.ApiResponse(c, report, nil)
+	}
+}
+
+func (ctlr *ModelingController) NotifyStep(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	reqDTO := repo.ModelingNotifyDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		report := ctlr.svc.NotifyStep(c.GetInt("device_id"), reqDTO)
+		logger.ApiResponse(c, report, nil)
+	}
+}
+
+func (ctlr *ModelingController) Reconcile(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	report, r := ctlr.svc.Reconcile()
+	logger.ApiResponse(c, r, report)
+}
+
+func (ctlr *ModelingController) GetQueue(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	queue, r := ctlr.svc.ReadQueue()
+	logger.ApiResponse(c, r, queue)
+}
+
+func (ctlr *ModelingController) GetLineage(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.ReadLineage(id)
+		logger.ApiResponse(c, report, data)
+	}
+}
+
+func (ctlr *ModelingController) GetComparison(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	ids := []int{}
+	for _, s := range strings.Split(c.Query("ids"), ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			r := logger.CreateReport(&logger.CODE_REQUEST, err)
+			logger.ApiResponse(c, r, nil)
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	if c.Query("format") == "csv" {
+		data, report := ctlr.svc.CompareCsv(ids)
+		if report != nil {
+			logger.ApiResponse(c, report, nil)
+			return
+		}
+		logger.ApiResponseWithCsv(c, "modeling_comparison.csv", data)
+		return
+	}
+
+	data, report := ctlr.svc.Compare(ids)
+	logger.ApiResponse(c, report, data)
+}
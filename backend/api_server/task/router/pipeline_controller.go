@@ -0,0 +1,41 @@
+package router
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"api_server/logger"
+	"api_server/task/service"
+)
+
+type PipelineController struct {
+	svc service.IPipelineService
+}
+
+var oncePipeline sync.Once
+var instancePipeline *PipelineController
+
+func NewPipelineController(svc service.IPipelineService) *PipelineController {
+	oncePipeline.Do(func() {
+		logger.Debug("Pipeline Controller instance")
+		instancePipeline = &PipelineController{
+			svc: svc,
+		}
+	})
+
+	return instancePipeline
+}
+
+func (ctlr *PipelineController) GetByTask(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if task_id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.ReadStatus(task_id)
+		logger.ApiResponse(c, report, data)
+	}
+}
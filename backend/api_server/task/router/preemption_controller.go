@@ -0,0 +1,48 @@
+package router
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"api_server/logger"
+	"api_server/task/service"
+)
+
+type PreemptionController struct {
+	svc service.IPreemptionService
+}
+
+var oncePreemption sync.Once
+var instancePreemption *PreemptionController
+
+func NewPreemptionController(svc service.IPreemptionService) *PreemptionController {
+	oncePreemption.Do(func() {
+		logger.Debug("Preemption Controller instance")
+		instancePreemption = &PreemptionController{
+			svc: svc,
+		}
+	})
+
+	return instancePreemption
+}
+
+func (ctlr *PreemptionController) GetList(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	data, report := ctlr.svc.ReadMany(0)
+	logger.ApiResponse(c, report, data)
+}
+
+func (ctlr *PreemptionController) GetByModeling(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if modeling_id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.ReadMany(modeling_id)
+		logger.ApiResponse(c, report, data)
+	}
+}
@@ -0,0 +1,162 @@
+package router
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"api_server/logger"
+	repo "api_server/task/repository"
+	"api_server/task/service"
+	"api_server/utils"
+)
+
+type ScheduleController struct {
+	svc service.IScheduleService
+}
+
+var onceSchedule sync.Once
+var instanceSchedule *ScheduleController
+
+func NewScheduleController(svc service.IScheduleService) *ScheduleController {
+	onceSchedule.Do(func() {
+		logger.Debug("Schedule Controller instance")
+		instanceSchedule = &ScheduleController{
+			svc: svc,
+		}
+	})
+
+	return instanceSchedule
+}
+
+func (ctlr *ScheduleController) CreateOne(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	ctxData, err := utils.GetDataFromToken(c)
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+	reqDTO := repo.ScheduleDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else if r := checkPriority(ctxData, reqDTO.Priority); r != nil {
+		logger.ApiResponse(c, r, nil)
+	} else {
+		reqDTO.Username = ctxData.Username
+		data, report := ctlr.svc.Create(reqDTO)
+		logger.ApiResponse(c, report, data)
+	}
+}
+
+func (ctlr *ScheduleController) GetByTask(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if task_id, err := strconv.Atoi(c.Param("task_id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.ReadByTask(task_id)
+		logger.ApiResponse(c, report, data)
+	}
+}
+
+// checkOwner는 schedule을 만든 사용자나 관리자만 schedule을 변경, 일시 정지, 삭제할 수 있게 합니다.
+func (ctlr *ScheduleController) checkOwner(ctxData *utils.TokenData, id int) *logger.Report {
+	schedule, r := ctlr.svc.ReadOne(id)
+	if r != nil {
+		return r
+	}
+	if schedule.Username != ctxData.Username && !ctxData.IsAdmin() {
+		return logger.CreateReport(&logger.CODE_REQUEST, fmt.Errorf("only the owner or admins can change schedule %d", id))
+	}
+
+	return nil
+}
+
+func (ctlr *ScheduleController) UpdateOne(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	ctxData, err := utils.GetDataFromToken(c)
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+	reqDTO := repo.ScheduleDTO{}
+	if err := c.ShouldBindJSON(&reqDTO); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else if r := checkPriority(ctxData, reqDTO.Priority); r != nil {
+		logger.ApiResponse(c, r, nil)
+	} else if r := ctlr.checkOwner(ctxData, reqDTO.ID); r != nil {
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.Edit(reqDTO)
+		logger.ApiResponse(c, report, data)
+	}
+}
+
+func (ctlr *ScheduleController) PauseById(c *gin.Context) {
+	ctlr.setPaused(c, true)
+}
+
+func (ctlr *ScheduleController) ResumeById(c *gin.Context) {
+	ctlr.setPaused(c, false)
+}
+
+func (ctlr *ScheduleController) setPaused(c *gin.Context, paused bool) {
+	logger.ApiRequest(c)
+
+	ctxData, err := utils.GetDataFromToken(c)
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+	if id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else if r := ctlr.checkOwner(ctxData, id); r != nil {
+		logger.ApiResponse(c, r, nil)
+	} else {
+		report := ctlr.svc.Pause(id, paused)
+		logger.ApiResponse(c, report, nil)
+	}
+}
+
+func (ctlr *ScheduleController) DeleteById(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	ctxData, err := utils.GetDataFromToken(c)
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+	if id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else if r := ctlr.checkOwner(ctxData, id); r != nil {
+		logger.ApiResponse(c, r, nil)
+	} else {
+		report := ctlr.svc.DeleteOne(id)
+		logger.ApiResponse(c, report, nil)
+	}
+}
+
+func (ctlr *ScheduleController) GetHistory(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.ReadHistory(id)
+		logger.ApiResponse(c, report, data)
+	}
+}
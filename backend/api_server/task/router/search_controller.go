@@ -0,0 +1,41 @@
+package router
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"api_server/logger"
+	"api_server/task/service"
+)
+
+type SearchController struct {
+	svc service.ISearchService
+}
+
+var onceSearch sync.Once
+var instanceSearch *SearchController
+
+func NewSearchController(svc service.ISearchService) *SearchController {
+	onceSearch.Do(func() {
+		logger.Debug("Search Controller instance")
+		instanceSearch = &SearchController{
+			svc: svc,
+		}
+	})
+
+	return instanceSearch
+}
+
+func (ctlr *SearchController) GetByTask(c *gin.Context) {
+	logger.ApiRequest(c)
+
+	if task_id, err := strconv.Atoi(c.Param("id")); err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+	} else {
+		data, report := ctlr.svc.ReadStatus(task_id)
+		logger.ApiResponse(c, report, data)
+	}
+}
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"errors"
 	"strconv"
 	"sync"
 
@@ -9,6 +10,7 @@ import (
 	"api_server/logger"
 	repo "api_server/task/repository"
 	"api_server/task/service"
+	"api_server/utils"
 )
 
 type TaskController struct {
@@ -29,14 +31,33 @@ func NewTaskController(svc service.ITaskService) *TaskController {
 	return instanceTask
 }
 
+// checkPriority는 관리자가 아닌 사용자가 0보다 높은 우선순위로 modeling을 만들지 못하게 합니다.
+// 우선순위 변경(PUT /modeling/priority)처럼 높은 우선순위는 관리자만 줄 수 있습니다.
+func checkPriority(ctxData *utils.TokenData, priority int) *logger.Report {
+	if priority > 0 && !ctxData.IsAdmin() {
+		return logger.CreateReport(&logger.CODE_REQUEST, errors.New("only admins can set a priority above 0"))
+	}
+
+	return nil
+}
+
 func (ctlr *TaskController) CreateOne(c *gin.Context) {
 	logger.ApiRequest(c)
 
+	ctxData, err := utils.GetDataFromToken(c)
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
 	reqDTO := repo.TaskDTO{}
 	if err := c.ShouldBindJSON(&reqDTO); err != nil {
 		r := logger.CreateReport(&logger.CODE_REQUEST, err)
 		logger.ApiResponse(c, r, nil)
+	} else if r := checkPriority(ctxData, reqDTO.Priority); r != nil {
+		logger.ApiResponse(c, r, nil)
 	} else {
+		reqDTO.Username = ctxData.Username
 		_, _, report := ctlr.svc.Create(reqDTO)
 		logger.ApiResponse(c, report, nil)
 	}
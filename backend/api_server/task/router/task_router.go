@@ -5,6 +5,7 @@ import (
 
 	repo_dataset "api_server/dataset/repository"
 	repo_device "api_server/device/repository"
+	router_device "api_server/device/router"
 	repo_task "api_server/task/repository"
 	service_task "api_server/task/service"
 	"api_server/utils"
@@ -20,28 +21,66 @@ func InitRouter(r *gin.Engine) {
 	task_service := service_task.NewTaskService(task_dao, modeling_dao, dataset_dao, device_dao)
 	taskController := NewTaskController(task_service)
 
+	modeling_service := service_task.NewModelingService(modeling_dao, modelingDetails_dao, dataset_dao, device_dao)
+	modelingController := NewModelingController(modeling_service)
+
+	pipeline_service := service_task.NewPipelineService(task_dao, modeling_service)
+	pipelineController := NewPipelineController(pipeline_service)
+
+	search_service := service_task.NewSearchService(task_dao, modeling_dao, task_service, modeling_service)
+	searchController := NewSearchController(search_service)
+
+	earlyStopController := NewEarlyStopController(service_task.NewEarlyStopService(repo_task.NewEarlyStopDAO(), task_dao, modeling_dao))
+
+	preemptionController := NewPreemptionController(service_task.NewPreemptionService(repo_task.NewPreemptionDAO()))
+
+	schedule_service := service_task.NewScheduleService(repo_task.NewScheduleDAO(), modeling_dao)
+	scheduleController := NewScheduleController(schedule_service)
+
 	apiRouter := r.Group(utils.API_BASE_URL_V1 + "/task")
 	{
-		apiRouter.POST("", taskController.CreateOne)
+		apiRouter.POST("", utils.JWTAuthMiddleware(), taskController.CreateOne)
 		apiRouter.GET("/list/:project_id", taskController.GetByProject)
 		apiRouter.GET("/:id", taskController.GetOne)
+		apiRouter.GET("/pipeline/:id", pipelineController.GetByTask)
+		apiRouter.GET("/search/:id", searchController.GetByTask)
+		apiRouter.GET("/early_stop/:id", earlyStopController.GetByTask)
+		apiRouter.PUT("/early_stop/:id", utils.JWTAuthMiddleware(), earlyStopController.UpdateByTask)
 		apiRouter.PUT("", taskController.UpdateById)
 		apiRouter.DELETE("/:id", taskController.DeleteById)
 	}
 
-	modeling_service := service_task.NewModelingService(modeling_dao, modelingDetails_dao, dataset_dao, device_dao)
-	modelingController := NewModelingController(modeling_service)
-
 	apiModelingRouter := r.Group(utils.API_BASE_URL_V1 + "/modeling")
 	{
 		apiModelingRouter.GET("/list/:task_id", modelingController.GetByTask)
 		apiModelingRouter.GET("/testable/:task_id", instanceModeling.GetModelingType)
+		apiModelingRouter.GET("/queue", modelingController.GetQueue)
+		apiModelingRouter.GET("/preemption", preemptionController.GetList)
+		apiModelingRouter.GET("/preemption/:id", preemptionController.GetByModeling)
+		apiModelingRouter.GET("/lineage/:id", modelingController.GetLineage)
+		apiModelingRouter.GET("/compare", modelingController.GetComparison)
 		apiModelingRouter.GET("/:id", modelingController.GetById)
-		apiModelingRouter.POST("/evaluation", modelingController.AddEvaluation)
+		apiModelingRouter.POST("/evaluation", utils.JWTAuthMiddleware(), modelingController.AddEvaluation)
+		apiModelingRouter.PUT("/priority", utils.JWTAuthMiddleware(), utils.GroupMiddleware(0, 1), modelingController.UpdatePriority)
+		apiModelingRouter.PUT("/bump/:id", utils.JWTAuthMiddleware(), utils.GroupMiddleware(0, 1), modelingController.BumpById)
+		apiModelingRouter.PUT("/queue", utils.JWTAuthMiddleware(), utils.GroupMiddleware(0, 1), modelingController.ReorderQueue)
+		apiModelingRouter.POST("/notify", router_device.DeviceTokenMiddleware(), modelingController.NotifyStep)
+		apiModelingRouter.POST("/reconcile", utils.JWTAuthMiddleware(), utils.GroupMiddleware(0, 1), modelingController.Reconcile)
 		apiModelingRouter.DELETE("/stop/:id", modelingController.StopModeling)
 		apiModelingRouter.DELETE("/:id", modelingController.DeleteById)
 	}
 
+	apiScheduleRouter := r.Group(utils.API_BASE_URL_V1 + "/schedule")
+	{
+		apiScheduleRouter.POST("", utils.JWTAuthMiddleware(), scheduleController.CreateOne)
+		apiScheduleRouter.GET("/list/:task_id", scheduleController.GetByTask)
+		apiScheduleRouter.GET("/history/:id", scheduleController.GetHistory)
+		apiScheduleRouter.PUT("", utils.JWTAuthMiddleware(), scheduleController.UpdateOne)
+		apiScheduleRouter.PUT("/pause/:id", utils.JWTAuthMiddleware(), scheduleController.PauseById)
+		apiScheduleRouter.PUT("/resume/:id", utils.JWTAuthMiddleware(), scheduleController.ResumeById)
+		apiScheduleRouter.DELETE("/:id", utils.JWTAuthMiddleware(), scheduleController.DeleteById)
+	}
+
 	testService := service_task.NewTestService(task_dao, modeling_dao)
 	testController := NewTestController(testService)
 
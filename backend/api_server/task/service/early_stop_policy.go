@@ -0,0 +1,268 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"api_server/ent/schema"
+	repo "api_server/task/repository"
+	"api_server/utils"
+)
+
+// trial_status에는 긴 이름으로 기록되므로 chart와 같은 짧은 이름도 받는다.
+var earlyStopMetricAliases = map[string]string{
+	"valid_loss":  "validation_loss",
+	"valid_score": "validation_target_score",
+	"train_score": "train_target_score",
+}
+
+// curvePoint는 trial 하나의 epoch별 metric 값입니다.
+type curvePoint struct {
+	epoch int
+	value float64
+}
+
+// earlyStopDecision은 policy가 trial을 중단하기로 한 결정입니다.
+type earlyStopDecision struct {
+	policy string
+	metric string
+	epoch  int
+	value  float64
+	reason string
+}
+
+// validateEarlyStop는 task에 지정한 early stopping policy들을 확인합니다.
+func validateEarlyStop(policies []schema.EarlyStopPolicy) error {
+	for _, policy := range policies {
+		switch policy.Type {
+		case utils.EARLY_STOP_POLICY_MEDIAN, utils.EARLY_STOP_POLICY_PATIENCE, utils.EARLY_STOP_POLICY_HALVING:
+		default:
+			return fmt.Errorf("invalid policy: %s", policy.Type)
+		}
+		if policy.Mode != "" && policy.Mode != utils.SEARCH_DIRECTION_MAX && policy.Mode != utils.SEARCH_DIRECTION_MIN {
+			return fmt.Errorf("%s: invalid mode %s", policy.Type, policy.Mode)
+		}
+		if policy.MinEpochs < 0 || policy.Patience < 0 || policy.MinDelta < 0 || policy.MinTrials < 0 {
+			return fmt.Errorf("%s: negative value", policy.Type)
+		}
+		if policy.ReductionFactor < 0 || policy.ReductionFactor == 1 {
+			return errors.New("reduction_factor must be 2 or more")
+		}
+	}
+
+	return nil
+}
+
+// earlyStopDefaults는 비어 있는 policy 값을 기본값으로 채웁니다.
+func earlyStopDefaults(policy schema.EarlyStopPolicy) schema.EarlyStopPolicy {
+	if policy.Metric == "" {
+		if policy.Type == utils.EARLY_STOP_POLICY_PATIENCE {
+			policy.Metric = "valid_loss"
+		} else {
+			policy.Metric = "valid_score"
+		}
+	}
+	if policy.Mode == "" {
+		if strings.Contains(policy.Metric, "loss") {
+			policy.Mode = utils.SEARCH_DIRECTION_MIN
+		} else {
+			policy.Mode = utils.SEARCH_DIRECTION_MAX
+		}
+	}
+	if policy.Patience == 0 {
+		policy.Patience = 5
+	}
+	if policy.MinTrials == 0 {
+		policy.MinTrials = 3
+	}
+	if policy.ReductionFactor == 0 {
+		policy.ReductionFactor = 3
+	}
+	if policy.Type == utils.EARLY_STOP_POLICY_HALVING && policy.MinEpochs == 0 {
+		policy.MinEpochs = 1
+	}
+
+	return policy
+}
+
+// earlyStopDecide는 trial을 policy로 평가해 중단해야 하면 결정을, 아니면 nil을 반환합니다.
+// others는 같은 task의 다른 trial들로, 끝났거나 이미 중단된 trial도 비교 대상에 포함됩니다.
+func earlyStopDecide(policy schema.EarlyStopPolicy, trial *repo.TrialCurveDTO, others []*repo.TrialCurveDTO) *earlyStopDecision {
+	policy = earlyStopDefaults(policy)
+	series := curveSeries(trial, policy.Metric)
+	if len(series) < 1 {
+		return nil
+	}
+	last := series[len(series)-1]
+	if last.epoch < policy.MinEpochs {
+		return nil
+	}
+
+	switch policy.Type {
+	case utils.EARLY_STOP_POLICY_PATIENCE:
+		return patienceDecide(policy, series)
+	case utils.EARLY_STOP_POLICY_MEDIAN:
+		return medianDecide(policy, series, others)
+	case utils.EARLY_STOP_POLICY_HALVING:
+		return halvingDecide(policy, series, others)
+	default:
+		return nil
+	}
+}
+
+// patienceDecide는 마지막 개선 이후 patience epoch가 지났으면 중단합니다.
+func patienceDecide(policy schema.EarlyStopPolicy, series []curvePoint) *earlyStopDecision {
+	best := series[0]
+	for _, p := range series[1:] {
+		if metricBetter(p.value, best.value+deltaFor(policy), policy.Mode) {
+			best = p
+		}
+	}
+
+	last := series[len(series)-1]
+	if last.epoch-best.epoch < policy.Patience {
+		return nil
+	}
+
+	return &earlyStopDecision{
+		policy: policy.Type,
+		metric: policy.Metric,
+		epoch:  last.epoch,
+		value:  last.value,
+		reason: fmt.Sprintf("%s has not improved for %d epochs (best %.4f at epoch %d)",
+			policy.Metric, last.epoch-best.epoch, best.value, best.epoch),
+	}
+}
+
+// medianDecide는 같은 epoch까지의 최고 값이 다른 trial들의 중앙값보다 나쁘면 중단합니다.
+func medianDecide(policy schema.EarlyStopPolicy, series []curvePoint, others []*repo.TrialCurveDTO) *earlyStopDecision {
+	epoch := series[len(series)-1].epoch
+	value := bestUpTo(series, epoch, policy.Mode)
+
+	values := reachedValues(policy, others, epoch)
+	if len(values) < policy.MinTrials {
+		return nil
+	}
+	sort.Float64s(values)
+	median := values[len(values)/2]
+	if len(values)%2 == 0 {
+		median = (values[len(values)/2-1] + values[len(values)/2]) / 2
+	}
+	if !metricBetter(median, value, policy.Mode) {
+		return nil
+	}
+
+	return &earlyStopDecision{
+		policy: policy.Type,
+		metric: policy.Metric,
+		epoch:  epoch,
+		value:  value,
+		reason: fmt.Sprintf("%s %.4f at epoch %d is worse than median %.4f of %d trials",
+			policy.Metric, value, epoch, median, len(values)),
+	}
+}
+
+// halvingDecide는 trial이 도달한 가장 높은 rung(min_epochs * reduction_factor^k)에서
+// 상위 1/reduction_factor 안에 들지 못하면 중단합니다.
+func halvingDecide(policy schema.EarlyStopPolicy, series []curvePoint, others []*repo.TrialCurveDTO) *earlyStopDecision {
+	eta := policy.ReductionFactor
+	last := series[len(series)-1].epoch
+	rung := 0
+	for r := policy.MinEpochs; r <= last; r *= eta {
+		rung = r
+	}
+	if rung < 1 {
+		return nil
+	}
+
+	value := bestUpTo(series, rung, policy.Mode)
+	values := reachedValues(policy, others, rung)
+	total := len(values) + 1
+	if total < eta {
+		return nil
+	}
+	keep := total / eta
+	rank := 1
+	for _, v := range values {
+		if metricBetter(v, value, policy.Mode) {
+			rank++
+		}
+	}
+	if rank <= keep {
+		return nil
+	}
+
+	return &earlyStopDecision{
+		policy: policy.Type,
+		metric: policy.Metric,
+		epoch:  rung,
+		value:  value,
+		reason: fmt.Sprintf("%s %.4f ranks %d of %d trials at rung epoch %d, only top %d continue",
+			policy.Metric, value, rank, total, rung, keep),
+	}
+}
+
+// reachedValues는 epoch까지 진행한 다른 trial들의 epoch까지의 최고 값입니다.
+func reachedValues(policy schema.EarlyStopPolicy, others []*repo.TrialCurveDTO, epoch int) []float64 {
+	values := []float64{}
+	for _, other := range others {
+		series := curveSeries(other, policy.Metric)
+		if len(series) < 1 || series[len(series)-1].epoch < epoch {
+			continue
+		}
+		values = append(values, bestUpTo(series, epoch, policy.Mode))
+	}
+
+	return values
+}
+
+// curveSeries는 trial의 metric 값을 epoch 순으로 꺼냅니다.
+func curveSeries(curve *repo.TrialCurveDTO, metric string) []curvePoint {
+	if alias, ok := earlyStopMetricAliases[metric]; ok {
+		metric = alias
+	}
+
+	series := []curvePoint{}
+	for i, metrics := range curve.Metrics {
+		if value, ok := metrics[metric]; ok {
+			series = append(series, curvePoint{epoch: curve.Epochs[i], value: value})
+		}
+	}
+
+	return series
+}
+
+// bestUpTo는 epoch까지의 가장 좋은 값입니다. series는 비어 있지 않아야 합니다.
+func bestUpTo(series []curvePoint, epoch int, mode string) float64 {
+	best := series[0].value
+	for _, p := range series {
+		if p.epoch > epoch {
+			break
+		}
+		if metricBetter(p.value, best, mode) {
+			best = p.value
+		}
+	}
+
+	return best
+}
+
+// metricBetter는 a가 b보다 좋은지 비교합니다.
+func metricBetter(a, b float64, mode string) bool {
+	if mode == utils.SEARCH_DIRECTION_MIN {
+		return a < b
+	}
+
+	return a > b
+}
+
+// deltaFor는 patience에서 개선으로 인정할 변화량을 mode 방향에 맞춰 부호를 붙입니다.
+func deltaFor(policy schema.EarlyStopPolicy) float64 {
+	if policy.Mode == utils.SEARCH_DIRECTION_MIN {
+		return -policy.MinDelta
+	}
+
+	return policy.MinDelta
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"testing"
+
+	"api_server/ent/schema"
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EarlyStopTestSuite struct {
+	suite.Suite
+}
+
+// testCurve는 epoch 1부터 values를 metric으로 기록한 trial을 만듭니다.
+func testCurve(uuid string, metric string, values ...float64) *repo.TrialCurveDTO {
+	c := &repo.TrialCurveDTO{TrialUUID: uuid, ModelingStep: utils.MODELING_STEP_RUN}
+	for i, v := range values {
+		c.Epochs = append(c.Epochs, i+1)
+		c.Metrics = append(c.Metrics, map[string]float64{metric: v})
+	}
+
+	return c
+}
+
+func (suite *EarlyStopTestSuite) TestValidate() {
+	suite.NoError(validateEarlyStop(nil))
+	suite.NoError(validateEarlyStop([]schema.EarlyStopPolicy{{Type: utils.EARLY_STOP_POLICY_MEDIAN}}))
+	suite.Error(validateEarlyStop([]schema.EarlyStopPolicy{{Type: "asha"}}))
+	suite.Error(validateEarlyStop([]schema.EarlyStopPolicy{{Type: utils.EARLY_STOP_POLICY_HALVING, ReductionFactor: 1}}))
+	suite.Error(validateEarlyStop([]schema.EarlyStopPolicy{{Type: utils.EARLY_STOP_POLICY_PATIENCE, Mode: "up"}}))
+}
+
+func (suite *EarlyStopTestSuite) TestPatience() {
+	policy := schema.EarlyStopPolicy{Type: utils.EARLY_STOP_POLICY_PATIENCE, Patience: 3, MinDelta: 0.01}
+
+	improving := testCurve("a", "validation_loss", 1.0, 0.9, 0.8, 0.7, 0.6)
+	suite.Nil(earlyStopDecide(policy, improving, nil))
+
+	// epoch 2 이후 0.01 넘게 줄지 않았다.
+	stalled := testCurve("b", "validation_loss", 1.0, 0.5, 0.495, 0.6, 0.7)
+	decision := earlyStopDecide(policy, stalled, nil)
+	suite.NotNil(decision)
+	suite.Equal(5, decision.epoch)
+	suite.Equal("valid_loss", decision.metric)
+	suite.Contains(decision.reason, "best 0.5000 at epoch 2")
+
+	policy.MinEpochs = 10
+	suite.Nil(earlyStopDecide(policy, stalled, nil))
+}
+
+func (suite *EarlyStopTestSuite) TestMedian() {
+	policy := schema.EarlyStopPolicy{Type: utils.EARLY_STOP_POLICY_MEDIAN, MinEpochs: 2}
+	others := []*repo.TrialCurveDTO{
+		testCurve("a", "validation_target_score", 0.5, 0.6, 0.7),
+		testCurve("b", "validation_target_score", 0.4, 0.7, 0.8),
+		testCurve("c", "validation_target_score", 0.3, 0.5),
+	}
+
+	suite.Nil(earlyStopDecide(policy, testCurve("d", "validation_target_score", 0.2), others))
+	suite.Nil(earlyStopDecide(policy, testCurve("d", "validation_target_score", 0.2, 0.65), others))
+	decision := earlyStopDecide(policy, testCurve("d", "validation_target_score", 0.2, 0.55), others)
+	suite.NotNil(decision)
+	suite.Contains(decision.reason, "median 0.6000 of 3 trials")
+
+	// 비교할 trial이 모자라면 중단하지 않는다.
+	suite.Nil(earlyStopDecide(policy, testCurve("d", "validation_target_score", 0.2, 0.1, 0.1), others))
+}
+
+func (suite *EarlyStopTestSuite) TestHalving() {
+	policy := schema.EarlyStopPolicy{Type: utils.EARLY_STOP_POLICY_HALVING, Metric: "valid_loss", ReductionFactor: 2}
+	others := []*repo.TrialCurveDTO{
+		testCurve("a", "validation_loss", 0.5, 0.4),
+		testCurve("b", "validation_loss", 0.6, 0.5),
+		testCurve("c", "validation_loss", 0.7, 0.3),
+	}
+
+	// rung epoch 2에서 4개 중 상위 2개만 계속한다.
+	suite.Nil(earlyStopDecide(policy, testCurve("d", "validation_loss", 0.9, 0.35), others))
+	decision := earlyStopDecide(policy, testCurve("d", "validation_loss", 0.9, 0.45, 0.1), others)
+	suite.NotNil(decision)
+	suite.Equal(2, decision.epoch)
+	suite.Contains(decision.reason, "ranks 3 of 4 trials at rung epoch 2")
+}
+
+func (suite *EarlyStopTestSuite) TestTrialLive() {
+	c := testCurve("a", "validation_loss", 1)
+	suite.True(trialLive(c))
+	c.TrialState = utils.MODELING_STEP_COMPLETE
+	suite.False(trialLive(c))
+	c.TrialState, c.Executor = "", utils.EXECUTOR_SLURM
+	suite.False(trialLive(c))
+}
+
+func TestEarlyStopTestSuite(t *testing.T) {
+	suite.Run(t, new(EarlyStopTestSuite))
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	config_service "api_server/configuration/service"
+	engine_client "api_server/engine/client"
+	"api_server/ent"
+	"api_server/ent/schema"
+	"api_server/logger"
+	repo "api_server/task/repository"
+	"api_server/utils"
+)
+
+type IEarlyStopService interface {
+	// Evaluate 함수는 실행 중인 trial들을 task의 early stopping policy로 평가하고,
+	// 중단하기로 한 trial은 engine에 중단을 요청한 뒤 사유와 함께 기록합니다.
+	Evaluate()
+
+	// WatchTrials 함수는 EARLY_STOP_INTERVAL(초)마다 Evaluate를 실행합니다.
+	WatchTrials()
+
+	// ReadByTask 함수는 task의 early stopping policy와 trial 중단 기록을 조회합니다.
+	//
+	// 매개변수:
+	//   - task_id: 조회할 task ID
+	//
+	// 반환값:
+	//   - *repo.EarlyStopStatusDTO: policy와 중단 기록
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadByTask(task_id int) (*repo.EarlyStopStatusDTO, *logger.Report)
+
+	// UpdatePolicies 함수는 task의 early stopping policy를 변경합니다. 실행 중인 trial에도 바로 적용됩니다.
+	//
+	// 매개변수:
+	//   - task_id: 변경할 task ID
+	//   - policies: 적용할 policy 목록, 비어 있으면 early stopping을 끔
+	//
+	// 반환값:
+	//   - *repo.EarlyStopStatusDTO: 변경된 policy와 중단 기록
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	UpdatePolicies(task_id int, policies []schema.EarlyStopPolicy) (*repo.EarlyStopStatusDTO, *logger.Report)
+}
+
+type EarlyStopService struct {
+	ctx          context.Context
+	dao          repo.IEarlyStopDAO
+	dao_task     repo.ITaskDAO
+	dao_modeling repo.IModelingDAO
+}
+
+var onceEarlyStop sync.Once
+var instanceEarlyStop *EarlyStopService
+
+func NewEarlyStopService(dao repo.IEarlyStopDAO, dao_task repo.ITaskDAO, dao_modeling repo.IModelingDAO) *EarlyStopService {
+	onceEarlyStop.Do(func() {
+		logger.Debug("EarlyStop service instance")
+		instanceEarlyStop = &EarlyStopService{
+			ctx:          context.Background(),
+			dao:          dao,
+			dao_task:     dao_task,
+			dao_modeling: dao_modeling,
+		}
+	})
+
+	return instanceEarlyStop
+}
+
+func (svc *EarlyStopService) WatchTrials() {
+	interval := config_service.NewStatic().GetInt("EARLY_STOP_INTERVAL", 30)
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		svc.Evaluate()
+	}
+}
+
+func (svc *EarlyStopService) Evaluate() {
+	tasks, err := svc.dao_task.SelectManyEarlyStop(svc.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	for _, task := range tasks {
+		curves, err := svc.dao.SelectTrialCurves(svc.ctx, task.ID)
+		if err != nil {
+			logger.CreateReport(&logger.CODE_DB_SELECT, err)
+			continue
+		}
+		stops, err := svc.dao.SelectByTask(svc.ctx, task.ID)
+		if err != nil {
+			logger.CreateReport(&logger.CODE_DB_SELECT, err)
+			continue
+		}
+		stopped := make(map[string]bool)
+		for _, stop := range stops {
+			stopped[stop.TrialUUID] = true
+		}
+
+		for i, curve := range curves {
+			if stopped[curve.TrialUUID] || !trialLive(curve) {
+				continue
+			}
+			others := append(append([]*repo.TrialCurveDTO{}, curves[:i]...), curves[i+1:]...)
+			for _, policy := range task.EarlyStop {
+				if decision := earlyStopDecide(policy, curve, others); decision != nil {
+					svc.stop(task, curve, decision)
+					break
+				}
+			}
+		}
+	}
+}
+
+// trialLive는 engine에 중단을 요청할 수 있는 trial인지 확인합니다.
+// slurm job 안의 engine은 KAI.S가 직접 호출할 수 없어 제외합니다.
+func trialLive(curve *repo.TrialCurveDTO) bool {
+	if curve.ModelingStep != utils.MODELING_STEP_RUN || curve.Executor == utils.EXECUTOR_SLURM {
+		return false
+	}
+
+	switch curve.TrialState {
+	case utils.MODELING_STEP_FINISH, utils.MODELING_STEP_COMPLETE, utils.MODELING_STEP_FAIL, utils.MODELING_STEP_CANCEL:
+		return false
+	default:
+		return true
+	}
+}
+
+// stop은 engine에 trial 중단을 요청하고 결정을 기록합니다.
+// 요청에 실패하면 기록하지 않으므로 다음 평가에서 다시 시도합니다.
+func (svc *EarlyStopService) stop(task *ent.Task, curve *repo.TrialCurveDTO, decision *earlyStopDecision) {
+	modeling, err := svc.dao_modeling.SelectOne(svc.ctx, curve.ModelingID)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+	p, err := NewTaskScheduler().locate(modeling)
+	if err != nil {
+		logger.Error(fmt.Sprintf("early stop of trial %s: %v", curve.TrialUUID, err))
+		return
+	}
+	if err := p.master().engine().StopTrial(svc.ctx, modeling.ID, engine_client.StopTrialRequest{
+		TrialUUID: curve.TrialUUID,
+		Reason:    decision.reason,
+	}); err != nil {
+		engine_client.Report(err)
+		return
+	}
+
+	logger.Info(fmt.Sprintf("early stop trial %s of modeling %d by %s: %s", curve.TrialUUID, modeling.ID, decision.policy, decision.reason))
+	if err := svc.dao.InsertOne(svc.ctx, repo.EarlyStopDTO{
+		TaskID:     task.ID,
+		ModelingID: modeling.ID,
+		TrialUUID:  curve.TrialUUID,
+		Policy:     decision.policy,
+		Metric:     decision.metric,
+		Epoch:      decision.epoch,
+		Value:      decision.value,
+		Reason:     decision.reason,
+	}); err != nil {
+		logger.CreateReport(&logger.CODE_DB_INSERT, err)
+	}
+}
+
+func (svc *EarlyStopService) ReadByTask(task_id int) (*repo.EarlyStopStatusDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf(`{"task_id": %d}`, task_id))
+	task, err := svc.dao_task.SelectOne(svc.ctx, task_id)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	stops, err := svc.dao.SelectByTask(svc.ctx, task_id)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	policies := task.EarlyStop
+	if policies == nil {
+		policies = []schema.EarlyStopPolicy{}
+	}
+
+	return &repo.EarlyStopStatusDTO{
+		TaskID:   task.ID,
+		Policies: policies,
+		Stops:    repo.ConvertEarlyStopEntsToDTOs(stops),
+	}, nil
+}
+
+func (svc *EarlyStopService) UpdatePolicies(task_id int, policies []schema.EarlyStopPolicy) (*repo.EarlyStopStatusDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf(`{"task_id": %d, "policies": %+v}`, task_id, policies))
+	if err := validateEarlyStop(policies); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_EARLY_STOP_INVALID, err)
+	}
+	if _, err := svc.dao_task.UpdateEarlyStop(svc.ctx, task_id, policies); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+
+	return svc.ReadByTask(task_id)
+}
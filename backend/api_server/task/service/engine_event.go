@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	config_service "api_server/configuration/service"
+	device_repo "api_server/device/repository"
+	engine_client "api_server/engine/client"
+	"api_server/engine/enginepb"
+	engine_repo "api_server/engine/repository"
+	"api_server/ent"
+	"api_server/logger"
+	repo "api_server/task/repository"
+	"api_server/utils"
+)
+
+type IEngineEventReceiver interface {
+	// WatchEngines 함수는 connection이 grpc인 사용 중인 device마다 engine의 Events stream을 열어 둡니다.
+	// device가 추가, 변경되거나 online이 되면 바로, 그 밖에는 DEVICE_HEALTH_INTERVAL(초)마다 stream이 끊어진 device에 다시 연결합니다.
+	WatchEngines()
+
+	// Apply 함수는 engine이 보낸 event 하나를 DB에 기록합니다.
+	// modeling 단계가 바뀌면 REST engine의 단계 알림과 같이 scheduler를 깨웁니다.
+	// event를 보낸 device에 배치되지 않은 modeling의 event는 기록하지 않습니다. modeling_id가 0인 log는 engine 자체의 log로 기록합니다.
+	//
+	// 매개변수:
+	//   - device_id: event를 보낸 device ID
+	//   - event: engine event
+	//
+	// 반환값:
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Apply(device_id int, event *enginepb.EngineEvent) *logger.Report
+}
+
+type EngineEventReceiver struct {
+	ctx        context.Context
+	dao        repo.IEngineEventDAO
+	dao_device device_repo.IDeviceDAO
+	mu         sync.Mutex
+	streams    map[int]*engineStream // device ID별 열린 stream
+}
+
+// engineStream은 device 하나의 Events stream입니다. addr가 바뀌면 다시 연결합니다.
+type engineStream struct {
+	addr   string
+	cancel context.CancelFunc
+}
+
+var onceEngineEvent sync.Once
+var instanceEngineEvent *EngineEventReceiver
+
+func NewEngineEventReceiver(dao repo.IEngineEventDAO, dao_device device_repo.IDeviceDAO) *EngineEventReceiver {
+	onceEngineEvent.Do(func() {
+		logger.Debug("Engine event receiver instance")
+		instanceEngineEvent = &EngineEventReceiver{
+			ctx:        context.Background(),
+			dao:        dao,
+			dao_device: dao_device,
+			streams:    make(map[int]*engineStream),
+		}
+	})
+
+	return instanceEngineEvent
+}
+
+func (receiver *EngineEventReceiver) WatchEngines() {
+	events := utils.NewNotifier().Subscribe(16,
+		utils.EVENT_DEVICE_UPDATED,
+		utils.EVENT_DEVICE_ONLINE,
+	)
+	interval := config_service.NewStatic().GetInt("DEVICE_HEALTH_INTERVAL", 30)
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+
+	receiver.connect()
+	for {
+		select {
+		case <-events:
+		case <-ticker.C:
+		}
+		receiver.connect()
+	}
+}
+
+// connect는 grpc device 중 stream이 없는 device에 연결하고, 더 이상 grpc가 아니거나 사용하지 않는 device의 stream은 닫습니다.
+func (receiver *EngineEventReceiver) connect() {
+	devices, err := receiver.dao_device.SelectActive(receiver.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+
+	wanted := make(map[int]*ent.Device)
+	for _, device := range devices {
+		if device.Connection == utils.DEVICE_CONNECTION_GRPC {
+			wanted[device.ID] = device
+		}
+	}
+	for id, stream := range receiver.streams {
+		if device, ok := wanted[id]; !ok || stream.addr != engineAddr(device) {
+			stream.cancel()
+			delete(receiver.streams, id)
+		}
+	}
+	for id, device := range wanted {
+		if _, ok := receiver.streams[id]; !ok {
+			receiver.streams[id] = receiver.receive(device)
+		}
+	}
+}
+
+func engineAddr(device *ent.Device) string {
+	return net.JoinHostPort(device.IP, strconv.Itoa(device.Port))
+}
+
+// receive는 device의 Events stream을 열고 끊어질 때까지 받은 event를 기록합니다.
+// stream이 끊어지면 목록에서 빠지고, 다음 connect에서 다시 연결합니다.
+func (receiver *EngineEventReceiver) receive(device *ent.Device) *engineStream {
+	ctx, cancel := context.WithCancel(receiver.ctx)
+	stream := &engineStream{addr: engineAddr(device), cancel: cancel}
+
+	go func() {
+		defer cancel()
+		logger.Debug(fmt.Sprintf("device %d (%s) events stream opened", device.ID, stream.addr))
+		err := engine_client.NewGRPC(stream.addr, 0).Events(ctx, func(event *enginepb.EngineEvent) error {
+			receiver.Apply(device.ID, event)
+			return nil
+		})
+		if ctx.Err() == nil {
+			logger.Debug(fmt.Sprintf("device %d (%s) events stream closed: %v", device.ID, stream.addr, err))
+		}
+
+		receiver.mu.Lock()
+		if receiver.streams[device.ID] == stream {
+			delete(receiver.streams, device.ID)
+		}
+		receiver.mu.Unlock()
+	}()
+
+	return stream
+}
+
+func (receiver *EngineEventReceiver) Apply(device_id int, event *enginepb.EngineEvent) *logger.Report {
+	at := time.Now()
+	if event.GetTime() != nil {
+		at = event.GetTime().AsTime()
+	}
+
+	if r := receiver.checkPlacement(device_id, event); r != nil {
+		return r
+	}
+
+	switch e := event.GetEvent().(type) {
+	case *enginepb.EngineEvent_Modeling:
+		req := repo.ModelingProgressDTO{
+			ModelingID:   int(e.Modeling.GetModelingId()),
+			ModelingStep: e.Modeling.GetModelingStep(),
+			Progress:     e.Modeling.GetProgress(),
+			At:           at,
+		}
+		if err := receiver.dao.UpdateModelingProgress(receiver.ctx, req); err != nil {
+			return logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+		if req.ModelingStep != "" {
+			utils.NewNotifier().Publish(utils.EVENT_MODELING_STEP, req.ModelingID)
+		}
+	case *enginepb.EngineEvent_Trial:
+		req := repo.TrialProgressDTO{
+			ModelingID:   int(e.Trial.GetModelingId()),
+			TrialUUID:    e.Trial.GetTrialUuid(),
+			State:        e.Trial.GetState(),
+			Progress:     e.Trial.GetProgress(),
+			SavePath:     e.Trial.GetSavePath(),
+			TargetMetric: e.Trial.GetTargetMetric(),
+			StatusJson:   e.Trial.GetStatusJson(),
+			At:           at,
+		}
+		if err := receiver.dao.UpsertTrial(receiver.ctx, req); err != nil {
+			return logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+	case *enginepb.EngineEvent_Log:
+		req := engine_repo.EngineLog{
+			ModelingID: int(e.Log.GetModelingId()),
+			Level:      e.Log.GetLevel(),
+			Filename:   e.Log.GetFilename(),
+			Line:       int(e.Log.GetLine()),
+			Message:    e.Log.GetMessage(),
+			CreatedAt:  at,
+		}
+		if err := receiver.dao.InsertLog(receiver.ctx, req); err != nil {
+			return logger.CreateReport(&logger.CODE_DB_INSERT, err)
+		}
+	default:
+		logger.Debug(fmt.Sprintf("device %d sent an unknown engine event: %v", device_id, event))
+	}
+
+	return nil
+}
+
+// checkPlacement는 event의 modeling이 event를 보낸 device에 배치되어 있는지 확인합니다.
+func (receiver *EngineEventReceiver) checkPlacement(device_id int, event *enginepb.EngineEvent) *logger.Report {
+	var modelingID int32
+	switch e := event.GetEvent().(type) {
+	case *enginepb.EngineEvent_Modeling:
+		modelingID = e.Modeling.GetModelingId()
+	case *enginepb.EngineEvent_Trial:
+		modelingID = e.Trial.GetModelingId()
+	case *enginepb.EngineEvent_Log:
+		if e.Log.GetModelingId() == 0 {
+			return nil
+		}
+		modelingID = e.Log.GetModelingId()
+	default:
+		return nil
+	}
+
+	placed, err := receiver.dao.IsPlacedOn(receiver.ctx, int(modelingID), device_id)
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	if !placed {
+		return logger.CreateReport(&logger.CODE_REQUEST, fmt.Errorf("device %d sent an event for modeling %d which is not placed on it", device_id, modelingID))
+	}
+
+	return nil
+}
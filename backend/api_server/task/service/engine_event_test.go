@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	device_repo "api_server/device/repository"
+	"api_server/engine/enginepb"
+	"api_server/engine/fake"
+	"api_server/ent/enginelog"
+	"api_server/ent/modeling"
+	"api_server/ent/trial"
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EngineEventTestSuite struct {
+	suite.Suite
+}
+
+// gRPC engine이 보낸 event가 DB에 기록되고, 다른 device에 배치된 modeling의 event는 무시되는지 확인한다.
+func (suite *EngineEventTestSuite) TestReceiveEvents() {
+	_, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+
+	engine := fake.NewGRPC(1)
+	defer engine.Close()
+	device, gpus := addTestDevice(suite.T(), client, engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_GRPC, 1)
+	_, others := addTestDevice(suite.T(), client, "10.0.0.2", 5000, utils.DEVICE_CONNECTION_GRPC, 1)
+
+	mine := addTestModeling(suite.T(), client, utils.JOB_TYPE_VISION_AD, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpus[0].ID}})
+	foreign := addTestModeling(suite.T(), client, utils.JOB_TYPE_VISION_AD, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{others[0].ID}})
+
+	steps := utils.NewNotifier().Subscribe(8, utils.EVENT_MODELING_STEP)
+	defer utils.NewNotifier().Unsubscribe(steps)
+
+	receiver := NewEngineEventReceiver(repo.NewEngineEventDAO(), device_repo.New())
+	stream := receiver.receive(device)
+	defer stream.cancel()
+
+	engine.Emit(&enginepb.EngineEvent{Event: &enginepb.EngineEvent_Modeling{Modeling: &enginepb.ModelingProgress{
+		ModelingId: int32(foreign.ID), ModelingStep: utils.MODELING_STEP_FAIL, Progress: 10,
+	}}})
+	engine.Emit(&enginepb.EngineEvent{Event: &enginepb.EngineEvent_Trial{Trial: &enginepb.TrialProgress{
+		ModelingId: int32(mine.ID), TrialUuid: "trial-1", State: "run", Progress: 50, SavePath: "/tmp/trial-1",
+	}}})
+	engine.Emit(&enginepb.EngineEvent{Event: &enginepb.EngineEvent_Log{Log: &enginepb.LogRecord{
+		ModelingId: int32(mine.ID), Level: "INFO", Filename: "train.py", Line: 10, Message: "epoch 1",
+	}}})
+	engine.Emit(&enginepb.EngineEvent{Event: &enginepb.EngineEvent_Modeling{Modeling: &enginepb.ModelingProgress{
+		ModelingId: int32(mine.ID), ModelingStep: utils.MODELING_STEP_FINISH, Progress: 100,
+	}}})
+
+	select {
+	case event := <-steps:
+		suite.Equal(mine.ID, event.ID)
+	case <-time.After(5 * time.Second):
+		suite.FailNow("modeling step event was not published")
+	}
+
+	updated := client.Modeling.Query().Where(modeling.ID(mine.ID)).OnlyX(ctx)
+	suite.Equal(utils.MODELING_STEP_FINISH, updated.ModelingStep)
+	suite.Equal(100.0, updated.Progress)
+
+	saved := client.Trial.Query().Where(trial.UUID("trial-1")).OnlyX(ctx)
+	suite.Equal(mine.ID, saved.ModelingID)
+	suite.Equal("run", saved.State)
+
+	logs := client.EngineLog.Query().Where(enginelog.ModelingID(mine.ID)).AllX(ctx)
+	suite.Len(logs, 1)
+	suite.Equal("epoch 1", logs[0].Message)
+
+	// 다른 device에 배치된 modeling은 바뀌지 않는다.
+	suite.Equal(utils.MODELING_STEP_RUN, client.Modeling.Query().Where(modeling.ID(foreign.ID)).OnlyX(ctx).ModelingStep)
+	suite.Len(steps, 0)
+}
+
+func (suite *EngineEventTestSuite) TestRejectUnplacedModeling() {
+	_, client := newTestScheduler(suite.T())
+
+	device, _ := addTestDevice(suite.T(), client, "10.0.0.1", 5000, utils.DEVICE_CONNECTION_GRPC, 1)
+	queued := addTestModeling(suite.T(), client, utils.JOB_TYPE_VISION_AD, utils.MODELING_STEP_IDLE, 0, repo.EngineParams{})
+
+	receiver := NewEngineEventReceiver(repo.NewEngineEventDAO(), device_repo.New())
+	suite.NotNil(receiver.Apply(device.ID, &enginepb.EngineEvent{Event: &enginepb.EngineEvent_Modeling{Modeling: &enginepb.ModelingProgress{
+		ModelingId: int32(queued.ID), ModelingStep: utils.MODELING_STEP_FINISH,
+	}}}))
+	suite.NotNil(receiver.Apply(device.ID, &enginepb.EngineEvent{Event: &enginepb.EngineEvent_Modeling{Modeling: &enginepb.ModelingProgress{
+		ModelingId: 9999, ModelingStep: utils.MODELING_STEP_FINISH,
+	}}}))
+
+	// modeling이 없는 engine log는 기록한다.
+	suite.Nil(receiver.Apply(device.ID, &enginepb.EngineEvent{Event: &enginepb.EngineEvent_Log{Log: &enginepb.LogRecord{
+		Level: "INFO", Message: "engine started",
+	}}}))
+}
+
+func TestEngineEventTestSuite(t *testing.T) {
+	suite.Run(t, new(EngineEventTestSuite))
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"api_server/logger"
+	repo "api_server/task/repository"
+	"api_server/utils"
+)
+
+func (svc *ModelingService) Compare(ids []int) (*repo.ComparisonDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf(`{"ids": %v}`, ids))
+	if len(ids) < 2 {
+		return nil, logger.CreateReport(&logger.CODE_REQUEST, errors.New("at least two modelings are required"))
+	}
+	seen := make(map[int]bool)
+	for _, id := range ids {
+		if seen[id] {
+			return nil, logger.CreateReport(&logger.CODE_REQUEST, fmt.Errorf("duplicated modeling %d", id))
+		}
+		seen[id] = true
+	}
+
+	comparison := &repo.ComparisonDTO{
+		Modelings: []*repo.ComparedModelingDTO{},
+		Curves:    []*repo.CompareCurveDTO{},
+	}
+	modelingList := []*repo.ModelingDB{}
+	for _, id := range ids {
+		modeling, err := svc.dao.SelectFull(svc.ctx, id)
+		if err != nil {
+			return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		}
+		task, err := svc.dao_task.SelectOne(svc.ctx, modeling.TaskID)
+		if err != nil {
+			return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		}
+		modelingList = append(modelingList, modeling)
+
+		datasetID := modeling.DatasetID
+		if datasetID == 0 {
+			datasetID = task.DatasetID
+		}
+		comparison.Modelings = append(comparison.Modelings, &repo.ComparedModelingDTO{
+			ID:           modeling.ID,
+			LocalID:      modeling.LocalID,
+			TaskID:       modeling.TaskID,
+			DatasetID:    datasetID,
+			EngineType:   task.EngineType,
+			ModelingType: modeling.ModelingType,
+			ModelingStep: modeling.ModelingStep,
+			BestModel:    svc.fillScores(modeling),
+			InfTime:      modeling.InfTime,
+		})
+
+		curve := &repo.CompareCurveDTO{ModelingID: modeling.ID}
+		if task.EngineType == utils.JOB_TYPE_TABLE_CLS || task.EngineType == utils.JOB_TYPE_TABLE_REG {
+			curve.Tabular, err = svc.dao_details.SelectTabularChart(modeling.ID)
+		} else {
+			curve.Loss, err = svc.dao_details.SelectLossChart(modeling.ID)
+		}
+		if err != nil {
+			return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		}
+		comparison.Curves = append(comparison.Curves, curve)
+	}
+
+	comparison.Params, comparison.Metrics = compareRows(modelingList)
+
+	return comparison, nil
+}
+
+func (svc *ModelingService) CompareCsv(ids []int) ([]byte, *logger.Report) {
+	comparison, report := svc.Compare(ids)
+	if report != nil {
+		return nil, report
+	}
+
+	data, err := comparisonCsv(comparison)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_FILE_WRITE, err)
+	}
+
+	return data, nil
+}
+
+// compareRows는 modeling들의 params와 score를 key별 행으로 맞춥니다.
+// params는 modeling 사이에 값이 다른 key만, score는 모든 metric을 key 순으로 반환합니다.
+func compareRows(modelingList []*repo.ModelingDB) ([]*repo.ParamRowDTO, []*repo.MetricRowDTO) {
+	paramsList := make([]map[string]interface{}, len(modelingList))
+	paramKeys := make(map[string]bool)
+	metricKeys := make(map[string]bool)
+	for i, m := range modelingList {
+		paramsList[i], _ = decodeModelingParams(m.Params)
+		for key := range paramsList[i] {
+			paramKeys[key] = true
+		}
+		for metric := range m.Scores {
+			metricKeys[metric] = true
+		}
+	}
+
+	paramRows := []*repo.ParamRowDTO{}
+	for _, key := range sortedKeys(paramKeys) {
+		row := &repo.ParamRowDTO{Key: key, Values: make([]interface{}, len(modelingList))}
+		differs := false
+		for i, params := range paramsList {
+			row.Values[i] = params[key]
+			if !reflect.DeepEqual(row.Values[0], row.Values[i]) {
+				differs = true
+			}
+		}
+		if differs {
+			paramRows = append(paramRows, row)
+		}
+	}
+
+	metricRows := []*repo.MetricRowDTO{}
+	for _, metric := range sortedKeys(metricKeys) {
+		row := &repo.MetricRowDTO{Metric: metric, Values: make([]*float64, len(modelingList))}
+		for i, m := range modelingList {
+			if value, ok := m.Scores[metric]; ok {
+				row.Values[i] = &value
+			}
+		}
+		metricRows = append(metricRows, row)
+	}
+
+	return paramRows, metricRows
+}
+
+func sortedKeys(keys map[string]bool) []string {
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	return sorted
+}
+
+// comparisonCsv는 비교 결과를 항목별 행, modeling별 열의 CSV로 만듭니다.
+// metric 행은 "metric."으로, params 행은 "param."으로 시작합니다.
+func comparisonCsv(comparison *repo.ComparisonDTO) ([]byte, error) {
+	rows := [][]string{{"field"}}
+	fields := []string{"local_id", "task_id", "dataset_id", "engine_type", "modeling_type", "modeling_step", "best_model", "inf_time"}
+	for _, field := range fields {
+		rows = append(rows, []string{field})
+	}
+	for _, m := range comparison.Modelings {
+		rows[0] = append(rows[0], "modeling_"+strconv.Itoa(m.ID))
+		values := []string{
+			strconv.Itoa(m.LocalID), strconv.Itoa(m.TaskID), strconv.Itoa(m.DatasetID), m.EngineType,
+			m.ModelingType, m.ModelingStep, m.BestModel, strconv.FormatFloat(m.InfTime, 'g', -1, 64),
+		}
+		for i, value := range values {
+			rows[i+1] = append(rows[i+1], value)
+		}
+	}
+
+	for _, metric := range comparison.Metrics {
+		row := []string{"metric." + metric.Metric}
+		for _, value := range metric.Values {
+			if value == nil {
+				row = append(row, "")
+			} else {
+				row = append(row, strconv.FormatFloat(*value, 'g', -1, 64))
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	for _, param := range comparison.Params {
+		row := []string{"param." + param.Key}
+		for _, value := range param.Values {
+			row = append(row, csvValue(value))
+		}
+		rows = append(rows, row)
+	}
+
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// csvValue는 params 값을 CSV 칸에 넣을 문자열로 바꿉니다. 목록이나 객체는 JSON으로 씁니다.
+func csvValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
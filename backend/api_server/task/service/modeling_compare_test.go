@@ -0,0 +1,63 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	repo "api_server/task/repository"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CompareTestSuite struct {
+	suite.Suite
+	modelingList []*repo.ModelingDB
+}
+
+func (suite *CompareTestSuite) SetupTest() {
+	suite.modelingList = []*repo.ModelingDB{
+		{ID: 1, Params: lineageParams(map[string]interface{}{"epochs": 10, "lr": 0.1, "gpus": []int{0}}), Scores: map[string]float64{"accuracy": 0.8, "f1": 0.7}},
+		{ID: 2, Params: lineageParams(map[string]interface{}{"epochs": 10, "lr": 0.01, "gpus": []int{0, 1}}), Scores: map[string]float64{"accuracy": 0.9}},
+		{ID: 3, Params: lineageParams(map[string]interface{}{"epochs": 10, "lr": 0.1, "seed": 3})},
+	}
+}
+
+func (suite *CompareTestSuite) TestRows() {
+	params, metrics := compareRows(suite.modelingList)
+
+	suite.Len(params, 3)
+	suite.Equal("gpus", params[0].Key)
+	suite.Equal("lr", params[1].Key)
+	suite.Equal([]interface{}{0.1, 0.01, 0.1}, params[1].Values)
+	suite.Equal("seed", params[2].Key)
+	suite.Nil(params[2].Values[0])
+
+	suite.Len(metrics, 2)
+	suite.Equal("accuracy", metrics[0].Metric)
+	suite.Equal(0.9, *metrics[0].Values[1])
+	suite.Nil(metrics[0].Values[2])
+	suite.Nil(metrics[1].Values[1])
+}
+
+func (suite *CompareTestSuite) TestCsv() {
+	params, metrics := compareRows(suite.modelingList)
+	comparison := &repo.ComparisonDTO{
+		Modelings: []*repo.ComparedModelingDTO{{ID: 1, BestModel: "resnet"}, {ID: 2, InfTime: 0.5}, {ID: 3}},
+		Params:    params,
+		Metrics:   metrics,
+	}
+
+	data, err := comparisonCsv(comparison)
+	suite.NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	suite.Equal("field,modeling_1,modeling_2,modeling_3", lines[0])
+	suite.Contains(lines, "best_model,resnet,,")
+	suite.Contains(lines, "inf_time,0,0.5,0")
+	suite.Contains(lines, "metric.accuracy,0.8,0.9,")
+	suite.Contains(lines, `param.gpus,[0],"[0,1]",`)
+	suite.Contains(lines, "param.lr,0.1,0.01,0.1")
+}
+
+func TestCompareTestSuite(t *testing.T) {
+	suite.Run(t, new(CompareTestSuite))
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"api_server/logger"
+	repo "api_server/task/repository"
+)
+
+func (svc *ModelingService) ReadLineage(id int) (*repo.LineageDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	modeling, err := svc.dao.SelectOne(svc.ctx, id)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	task, err := svc.dao_task.SelectOne(svc.ctx, modeling.TaskID)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	modelingList, report := svc.ReadByTask(task.ID)
+	if report != nil {
+		return nil, report
+	}
+
+	lineage, err := buildLineage(modelingList, id, task.DatasetID)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	return lineage, nil
+}
+
+// buildLineage는 task의 modeling 목록에서 id의 조상과 자손을 찾아 lineage를 만듭니다.
+// dataset_id가 0인 modeling은 task를 처음 만들 때의 modeling이므로 가장 가까운 조상의 dataset,
+// 없으면 task의 dataset을 사용한 것으로 봅니다.
+func buildLineage(modelingList []*repo.ModelingDB, id int, taskDatasetID int) (*repo.LineageDTO, error) {
+	byID := make(map[int]*repo.ModelingDB)
+	children := make(map[int][]*repo.ModelingDB)
+	for _, m := range modelingList {
+		byID[m.ID] = m
+	}
+	for _, m := range modelingList {
+		if _, ok := byID[m.ParentID]; ok && m.ParentID != m.ID {
+			children[m.ParentID] = append(children[m.ParentID], m)
+		}
+	}
+
+	target, ok := byID[id]
+	if !ok {
+		return nil, fmt.Errorf("modeling %d not found in task", id)
+	}
+
+	// 부모를 따라 올라가며 조상을 모은다. 잘못된 parent_id로 순환이 생겨도 멈춘다.
+	chain := []*repo.ModelingDB{target}
+	visited := map[int]bool{target.ID: true}
+	for cur := target; ; {
+		parent, ok := byID[cur.ParentID]
+		if !ok || visited[parent.ID] {
+			break
+		}
+		visited[parent.ID] = true
+		chain = append([]*repo.ModelingDB{parent}, chain...)
+		cur = parent
+	}
+
+	datasets := make(map[int]int)
+	datasetOf := func(m *repo.ModelingDB, parent *repo.ModelingDB) int {
+		if m.DatasetID > 0 {
+			return m.DatasetID
+		}
+		if parent != nil {
+			return datasets[parent.ID]
+		}
+		return taskDatasetID
+	}
+
+	lineage := &repo.LineageDTO{
+		ModelingID: target.ID,
+		TaskID:     target.TaskID,
+		Ancestors:  []*repo.LineageNodeDTO{},
+	}
+	var parent *repo.ModelingDB
+	for _, m := range chain {
+		datasets[m.ID] = datasetOf(m, parent)
+		node := lineageNode(m, parent, datasets[m.ID])
+		if m.ID == target.ID {
+			lineage.Tree = node
+		} else {
+			lineage.Ancestors = append(lineage.Ancestors, node)
+		}
+		parent = m
+	}
+
+	queue := []*repo.LineageNodeDTO{lineage.Tree}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, child := range children[node.ID] {
+			if visited[child.ID] {
+				continue
+			}
+			visited[child.ID] = true
+			datasets[child.ID] = datasetOf(child, byID[node.ID])
+			childNode := lineageNode(child, byID[node.ID], datasets[child.ID])
+			node.Children = append(node.Children, childNode)
+			queue = append(queue, childNode)
+		}
+	}
+
+	return lineage, nil
+}
+
+func lineageNode(m *repo.ModelingDB, parent *repo.ModelingDB, datasetID int) *repo.LineageNodeDTO {
+	node := &repo.LineageNodeDTO{
+		ID:            m.ID,
+		LocalID:       m.LocalID,
+		ParentID:      m.ParentID,
+		ParentLocalID: m.ParentLocalID,
+		ModelingType:  m.ModelingType,
+		ModelingStep:  m.ModelingStep,
+		DatasetID:     datasetID,
+		ParamsDiff:    []*repo.ParamDiffDTO{},
+		Scores:        m.Scores,
+		InfTime:       m.InfTime,
+		CreatedAt:     m.CreatedAt,
+		Children:      []*repo.LineageNodeDTO{},
+	}
+	if parent != nil {
+		parentParams, _ := decodeModelingParams(parent.Params)
+		params, _ := decodeModelingParams(m.Params)
+		node.ParamsDiff = diffParams(parentParams, params)
+	}
+
+	return node
+}
+
+// decodeModelingParams는 DB에 저장된 params(JSON 문자열 배열)의 첫 번째 값을 꺼냅니다.
+func decodeModelingParams(raw sql.NullString) (map[string]interface{}, error) {
+	params := make(map[string]interface{})
+	if !raw.Valid {
+		return params, nil
+	}
+
+	list := []string{}
+	if err := json.Unmarshal([]byte(raw.String), &list); err != nil {
+		return params, err
+	}
+	if len(list) < 1 {
+		return params, errors.New("empty params")
+	}
+	err := json.Unmarshal([]byte(list[0]), &params)
+
+	return params, err
+}
+
+// diffParams는 두 params에서 값이 다른 key들을 key 순으로 반환합니다.
+func diffParams(base, params map[string]interface{}) []*repo.ParamDiffDTO {
+	keys := make(map[string]bool)
+	for key := range base {
+		keys[key] = true
+	}
+	for key := range params {
+		keys[key] = true
+	}
+
+	diff := []*repo.ParamDiffDTO{}
+	for key := range keys {
+		if !reflect.DeepEqual(base[key], params[key]) {
+			diff = append(diff, &repo.ParamDiffDTO{Key: key, Parent: base[key], Value: params[key]})
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool { return diff[i].Key < diff[j].Key })
+
+	return diff
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LineageTestSuite struct {
+	suite.Suite
+	modelingList []*repo.ModelingDB
+}
+
+func lineageParams(params map[string]interface{}) sql.NullString {
+	inner, _ := json.Marshal(params)
+	outer, _ := json.Marshal([]string{string(inner)})
+	return sql.NullString{String: string(outer), Valid: true}
+}
+
+func (suite *LineageTestSuite) SetupTest() {
+	// 1 -> 2(update) -> 4(update), 1 -> 3(evaluation), 5는 다른 계보
+	suite.modelingList = []*repo.ModelingDB{
+		{ID: 1, LocalID: 1, TaskID: 9, ModelingType: utils.MODELING_TYPE_INITIAL, Params: lineageParams(map[string]interface{}{"epochs": 10, "lr": 0.1})},
+		{ID: 2, LocalID: 2, TaskID: 9, ParentID: 1, ParentLocalID: 1, ModelingType: utils.MODELING_TYPE_UPDATE, Params: lineageParams(map[string]interface{}{"epochs": 20, "lr": 0.1})},
+		{ID: 3, LocalID: 3, TaskID: 9, ParentID: 1, ParentLocalID: 1, DatasetID: 7, ModelingType: utils.MODELING_TYPE_EVALUATION, Params: lineageParams(map[string]interface{}{"epochs": 10})},
+		{ID: 4, LocalID: 4, TaskID: 9, ParentID: 2, ParentLocalID: 2, DatasetID: 8, ModelingType: utils.MODELING_TYPE_UPDATE, Params: lineageParams(map[string]interface{}{"epochs": 20, "lr": 0.01}), Scores: map[string]float64{"accuracy": 0.9}},
+		{ID: 5, LocalID: 5, TaskID: 9, ModelingType: utils.MODELING_TYPE_INITIAL},
+	}
+}
+
+func (suite *LineageTestSuite) TestTree() {
+	lineage, err := buildLineage(suite.modelingList, 1, 6)
+	suite.NoError(err)
+	suite.Empty(lineage.Ancestors)
+	suite.Equal(1, lineage.Tree.ID)
+	suite.Equal(6, lineage.Tree.DatasetID)
+	suite.Empty(lineage.Tree.ParamsDiff)
+	suite.Len(lineage.Tree.Children, 2)
+
+	update := lineage.Tree.Children[0]
+	suite.Equal(2, update.ID)
+	suite.Equal(6, update.DatasetID)
+	suite.Len(update.ParamsDiff, 1)
+	suite.Equal("epochs", update.ParamsDiff[0].Key)
+	suite.Len(update.Children, 1)
+	suite.Equal(8, update.Children[0].DatasetID)
+	suite.Equal(0.9, update.Children[0].Scores["accuracy"])
+
+	evaluation := lineage.Tree.Children[1]
+	suite.Equal(7, evaluation.DatasetID)
+	suite.Equal("lr", evaluation.ParamsDiff[0].Key)
+	suite.Nil(evaluation.ParamsDiff[0].Value)
+}
+
+func (suite *LineageTestSuite) TestAncestors() {
+	lineage, err := buildLineage(suite.modelingList, 4, 6)
+	suite.NoError(err)
+	suite.Len(lineage.Ancestors, 2)
+	suite.Equal(1, lineage.Ancestors[0].ID)
+	suite.Equal(2, lineage.Ancestors[1].ID)
+	suite.Equal(4, lineage.Tree.ID)
+	suite.Empty(lineage.Tree.Children)
+
+	_, err = buildLineage(suite.modelingList, 42, 6)
+	suite.Error(err)
+}
+
+func (suite *LineageTestSuite) TestCycle() {
+	suite.modelingList[0].ParentID = 4
+	lineage, err := buildLineage(suite.modelingList, 2, 6)
+	suite.NoError(err)
+	suite.Len(lineage.Ancestors, 2)
+	suite.Empty(lineage.Tree.Children)
+}
+
+func TestLineageTestSuite(t *testing.T) {
+	suite.Run(t, new(LineageTestSuite))
+}
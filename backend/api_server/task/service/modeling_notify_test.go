@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	dataset_repo "api_server/dataset/repository"
+	device_repo "api_server/device/repository"
+	"api_server/ent/modeling"
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ModelingNotifyTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ModelingNotifyTestSuite) TestChangedSteps() {
+	prev := map[int]string{1: utils.MODELING_STEP_REQUEST, 2: utils.MODELING_STEP_RUN, 3: utils.MODELING_STEP_RUN}
+	current := map[int]string{1: utils.MODELING_STEP_RUN, 3: utils.MODELING_STEP_RUN, 4: utils.MODELING_STEP_REQUEST}
+
+	// 1은 단계가 바뀌고 2는 끝났다. 새로 실행된 4는 알리지 않는다.
+	suite.Equal([]int{1, 2}, changedSteps(prev, current))
+	suite.Empty(changedSteps(current, current))
+}
+
+func (suite *ModelingNotifyTestSuite) TestNotifyStep() {
+	_, client := newTestScheduler(suite.T())
+	svc := NewModelingService(repo.NewModelingDAO(), repo.NewModelingDetailDAO(), dataset_repo.NewDatasetDAO(), device_repo.New())
+
+	device, gpus := addTestDevice(suite.T(), client, "10.0.0.1", 5000, utils.DEVICE_CONNECTION_REST, 1)
+	other, _ := addTestDevice(suite.T(), client, "10.0.0.2", 5000, utils.DEVICE_CONNECTION_REST, 1)
+	m := addTestModeling(suite.T(), client, utils.JOB_TYPE_VISION_AD, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpus[0].ID}})
+
+	steps := utils.NewNotifier().Subscribe(8, utils.EVENT_MODELING_STEP)
+	defer utils.NewNotifier().Unsubscribe(steps)
+
+	// 다른 device의 알림과 알 수 없는 단계는 거부한다.
+	suite.NotNil(svc.NotifyStep(other.ID, repo.ModelingNotifyDTO{ModelingID: m.ID, ModelingStep: utils.MODELING_STEP_FINISH}))
+	suite.NotNil(svc.NotifyStep(device.ID, repo.ModelingNotifyDTO{ModelingID: m.ID, ModelingStep: utils.MODELING_STEP_COMPLETE}))
+	suite.Len(steps, 0)
+
+	suite.Nil(svc.NotifyStep(device.ID, repo.ModelingNotifyDTO{ModelingID: m.ID, ModelingStep: utils.MODELING_STEP_FINISH}))
+	suite.Equal(utils.MODELING_STEP_FINISH, client.Modeling.Query().Where(modeling.ID(m.ID)).OnlyX(context.Background()).ModelingStep)
+	suite.Equal(m.ID, (<-steps).ID)
+
+	// 이미 기록된 단계는 다시 알리지 않는다.
+	suite.Nil(svc.NotifyStep(device.ID, repo.ModelingNotifyDTO{ModelingID: m.ID, ModelingStep: utils.MODELING_STEP_FINISH}))
+	suite.Len(steps, 0)
+
+	// 단계 없이 보낸 알림은 scheduler만 깨운다.
+	suite.Nil(svc.NotifyStep(device.ID, repo.ModelingNotifyDTO{ModelingID: m.ID}))
+	suite.Equal(m.ID, (<-steps).ID)
+}
+
+func TestModelingNotifyTestSuite(t *testing.T) {
+	suite.Run(t, new(ModelingNotifyTestSuite))
+}
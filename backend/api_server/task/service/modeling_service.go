@@ -1,11 +1,9 @@
 package service
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -14,9 +12,14 @@ import (
 	config_service "api_server/configuration/service"
 	repo_dataset "api_server/dataset/repository"
 	repo_device "api_server/device/repository"
+	engine_client "api_server/engine/client"
+	"api_server/ent"
 	"api_server/logger"
+	quota_repo "api_server/quota/repository"
+	quota_service "api_server/quota/service"
 	"api_server/task/csvformat"
 	repo "api_server/task/repository"
+	user_repo "api_server/user/repository"
 	"api_server/utils"
 )
 
@@ -163,6 +166,96 @@ type IModelingService interface {
 	//   - *repo.ModelingModels: 조회된 모델 리스트 DTO
 	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
 	ReadModelingModelsByTypeAndModelingID(modelingID int, data_type string) (*repo.ModelingModels, *logger.Report)
+
+	// UpdatePriority 함수는 대기 중인 modeling의 우선순위를 변경합니다.
+	//
+	// 매개변수:
+	//   - req: 변경할 modeling ID와 우선순위
+	//
+	// 반환값:
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	UpdatePriority(req repo.PriorityDTO) *logger.Report
+
+	// BumpOne 함수는 대기 중인 modeling을 대기열의 맨 앞으로 옮깁니다.
+	// 대기 중인 modeling 중 가장 높은 우선순위보다 1 높은 우선순위를 부여합니다.
+	//
+	// 매개변수:
+	//   - id: 옮길 modeling ID
+	//
+	// 반환값:
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	BumpOne(id int) *logger.Report
+
+	// ReorderQueue 함수는 대기 중인 modeling들의 실행 순서를 요청 순서대로 바꿉니다.
+	// 요청한 modeling들은 같은 우선순위의 다른 modeling보다 project/user 사용량과 관계없이 먼저, 요청 순서대로 실행됩니다.
+	// 이전 요청으로 지정한 순서는 지워집니다. 우선순위는 순서보다 먼저 비교하므로
+	// 높은 우선순위 modeling을 낮은 우선순위 modeling 뒤에 두는 요청은 거부합니다.
+	//
+	// 매개변수:
+	//   - req: 원하는 실행 순서의 modeling ID 목록
+	//
+	// 반환값:
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReorderQueue(req repo.QueueOrderDTO) *logger.Report
+
+	// NotifyStep 함수는 engine이 modeling 단계를 변경(완료, 실패 등)한 뒤 호출하는 알림을 처리합니다.
+	// modeling_step이 있고 DB의 단계와 다르면 기록한 뒤 scheduler가 바로 다음 작업을 배치하도록 깨웁니다.
+	// 알림을 보낸 device에 배치되지 않은 modeling의 알림은 거부합니다.
+	//
+	// 매개변수:
+	//   - device_id: 알림을 보낸 engine의 Device ID (enrollment token으로 인증)
+	//   - req: 단계가 바뀐 modeling ID와 단계
+	//
+	// 반환값:
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	NotifyStep(device_id int, req repo.ModelingNotifyDTO) *logger.Report
+
+	// Reconcile 함수는 engine이 실제로 실행 중인 modeling을 조회해 modeling 단계와 GPU 상태를 맞춥니다.
+	//
+	// 반환값:
+	//   - *repo.ReconcileReportDTO: 정리 결과
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Reconcile() (*repo.ReconcileReportDTO, *logger.Report)
+
+	// ReadQueue 함수는 대기 중인 modeling을 실행될 순서대로 조회합니다.
+	// 각 modeling의 대기 순번, 대기 사유, 예상 시작 시각을 포함합니다.
+	//
+	// 반환값:
+	//   - []*repo.QueueItemDTO: 대기 중인 modeling 목록
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadQueue() ([]*repo.QueueItemDTO, *logger.Report)
+
+	// ReadLineage 함수는 modeling의 조상과 자손 modeling을 task 안에서 찾아 트리로 조회합니다.
+	// 각 modeling은 부모와의 params 차이, 사용한 dataset, testset score를 포함합니다.
+	//
+	// 매개변수:
+	//   - id: 조회할 modeling ID
+	//
+	// 반환값:
+	//   - *repo.LineageDTO: 조상 목록과 자손 트리
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadLineage(id int) (*repo.LineageDTO, *logger.Report)
+
+	// Compare 함수는 여러 modeling을 나란히 비교합니다. 서로 다른 task의 modeling도 비교할 수 있습니다.
+	// 값이 다른 params, best model의 testset score와 추론 시간, 학습 곡선을 modeling 순서대로 정렬해 반환합니다.
+	//
+	// 매개변수:
+	//   - ids: 비교할 modeling ID 목록 (2개 이상)
+	//
+	// 반환값:
+	//   - *repo.ComparisonDTO: 비교 결과
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Compare(ids []int) (*repo.ComparisonDTO, *logger.Report)
+
+	// CompareCsv 함수는 Compare 결과를 CSV로 만듭니다. 학습 곡선은 포함하지 않습니다.
+	//
+	// 매개변수:
+	//   - ids: 비교할 modeling ID 목록 (2개 이상)
+	//
+	// 반환값:
+	//   - []byte: CSV 데이터, 첫 열은 항목 이름이고 modeling마다 열 하나
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	CompareCsv(ids []int) ([]byte, *logger.Report)
 }
 
 type ModelingService struct {
@@ -171,6 +264,9 @@ type ModelingService struct {
 	dao_details repo.IModelinDetailDAO
 	dao_dataset repo_dataset.DatasetDAOInterface
 	dao_device  repo_device.IDeviceDAO
+	dao_task    repo.ITaskDAO
+	dao_event   repo.IEngineEventDAO
+	svc_quota   quota_service.IQuotaService
 }
 
 var onceModeling sync.Once
@@ -189,6 +285,9 @@ func NewModelingService(dao repo.IModelingDAO,
 			dao_details: dao_details,
 			dao_dataset: dao_dataset,
 			dao_device:  dao_device,
+			dao_task:    repo.NewTaskDAO(),
+			dao_event:   repo.NewEngineEventDAO(),
+			svc_quota:   quota_service.NewQuotaService(quota_repo.NewQuotaDAO(), user_repo.NewUserDAO(), repo.NewTaskDAO(), repo.NewModelingDAO()),
 		}
 	})
 
@@ -199,10 +298,13 @@ func (svc *ModelingService) CreateEvaluation(req repo.EvaluationDTO) (*repo.Mode
 	logger.Debug(fmt.Sprintf("%+v", req))
 	if modeling, err := svc.makeModelingDTO(req); err != nil {
 		return nil, err
+	} else if r := svc.svc_quota.CheckTaskQueue(modeling.TaskID, modeling.Username); r != nil {
+		return nil, r
 	} else {
 		if inserted, err := svc.dao.InsertOne(svc.ctx, *modeling); err != nil {
 			return nil, logger.CreateReport(&logger.CODE_DB_INSERT, err)
 		} else {
+			utils.NewNotifier().Publish(utils.EVENT_MODELING_QUEUED, inserted.ID)
 			return repo.ConvertModelingEntToDTO(inserted), nil
 		}
 	}
@@ -214,19 +316,29 @@ func (svc *ModelingService) ReadByTask(task_id int) ([]*repo.ModelingDB, *logger
 		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
 	} else {
 		for _, dto := range modelingList {
-			sourceID := dto.ID
-			if dto.ParentID > 0 {
-				sourceID = dto.ParentID
-			}
-			if model, err := svc.dao.SelectBestModelByModeling(svc.ctx, sourceID); err == nil && model != "" {
-				dto.Scores = svc.dao.SelectTestScoreByModelAndModeling(svc.ctx, model, dto.ID)
-				dto.InfTime = svc.dao.SelectTestInfTimeByModelAndModeling(svc.ctx, model, dto.ID)
-			}
+			svc.fillScores(dto)
 		}
 		return modelingList, nil
 	}
 }
 
+// fillScores는 best model의 testset score와 추론 시간을 채우고 best model 이름을 반환합니다.
+// evaluation/blind modeling은 학습하지 않으므로 부모 modeling의 best model을 사용합니다.
+func (svc *ModelingService) fillScores(dto *repo.ModelingDB) string {
+	sourceID := dto.ID
+	if dto.ParentID > 0 {
+		sourceID = dto.ParentID
+	}
+	model, err := svc.dao.SelectBestModelByModeling(svc.ctx, sourceID)
+	if err != nil || model == "" {
+		return ""
+	}
+	dto.Scores = svc.dao.SelectTestScoreByModelAndModeling(svc.ctx, model, dto.ID)
+	dto.InfTime = svc.dao.SelectTestInfTimeByModelAndModeling(svc.ctx, model, dto.ID)
+
+	return model
+}
+
 func (svc *ModelingService) ReadModelingType(task_id int) ([]*repo.ModelingDB, *logger.Report) {
 	logger.Debug(fmt.Sprintf(`{"task_id": %d}`, task_id))
 	if modelingList, err := svc.dao.SelectModelingType(svc.ctx, task_id); err != nil {
@@ -274,11 +386,14 @@ func (svc *ModelingService) makeModelingDTO(req repo.EvaluationDTO) (*repo.Model
 			return nil, err
 		} else {
 			modeling := &repo.ModelingDTO{
-				Params:       params,
-				ModelingStep: utils.MODELING_STEP_IDLE,
-				TaskID:       parent.TaskID,
-				ParentID:     parent.ID,
-				DatasetID:    req.DatasetID,
+				Params:        params,
+				ModelingStep:  utils.MODELING_STEP_IDLE,
+				TaskID:        parent.TaskID,
+				ParentID:      parent.ID,
+				DatasetID:     req.DatasetID,
+				Priority:      req.Priority,
+				Username:      req.Username,
+				PipelineStage: req.PipelineStage,
 			}
 
 			if req.IsPath {
@@ -407,13 +522,8 @@ func (svc *ModelingService) DeleteOne(id int) *logger.Report {
 
 			targetPath := engineParams.SavePath + "/" + strconv.Itoa(id) + "/"
 			for _, device := range devices {
-				addr := "http://" + device.IP + ":" + strconv.Itoa(device.Port) + "/api/remove"
-				params := bytes.NewReader([]byte(fmt.Sprintf(`{"target_path":"%s"}`, targetPath)))
-				logger.Debug(addr, string(fmt.Sprintf(`{"target_path":%s}`, targetPath)))
-				if resp, err := http.Post(addr, "application/json", params); err != nil {
+				if err := engine_client.ForDevice(device.IP, device.Port, device.Connection, engine_client.DefaultTimeout).Remove(svc.ctx, targetPath); err != nil {
 					logger.Debug(&logger.CODE_REMOTE_SELECT, err)
-				} else {
-					logger.Debug(resp)
 				}
 			}
 		}
@@ -723,3 +833,155 @@ func (svc *ModelingService) ReadModelingModelsByTypeAndModelingID(modelingID int
 		return repo.ConvertModelingModelEntToDTO(modelingModel), nil
 	}
 }
+
+// UpdatePriority 함수는 대기 중인 modeling의 우선순위를 변경합니다.
+func (svc *ModelingService) UpdatePriority(req repo.PriorityDTO) *logger.Report {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	if modeling, err := svc.dao.SelectOne(svc.ctx, req.ModelingID); err != nil {
+		return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	} else if modeling.ModelingStep != utils.MODELING_STEP_IDLE {
+		return logger.CreateReport(&logger.CODE_MODELING_NOT_QUEUED, nil)
+	}
+
+	if err := svc.dao.UpdatePriority(svc.ctx, req.ModelingID, req.Priority); err != nil {
+		return logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+	utils.NewNotifier().Publish(utils.EVENT_MODELING_QUEUED, req.ModelingID)
+
+	return nil
+}
+
+// BumpOne 함수는 대기 중인 modeling을 대기열의 맨 앞으로 옮깁니다.
+func (svc *ModelingService) BumpOne(id int) *logger.Report {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	queued, err := svc.dao.SelectManyIdle(svc.ctx)
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	var target *ent.Modeling
+	top := 0
+	for i, m := range queued {
+		if i == 0 || m.Priority > top {
+			top = m.Priority
+		}
+		if m.ID == id {
+			target = m
+		}
+	}
+	if target == nil {
+		return logger.CreateReport(&logger.CODE_MODELING_NOT_QUEUED, nil)
+	}
+
+	// 이미 혼자 가장 높은 우선순위라면 그대로 둔다.
+	priority := top + 1
+	if target.Priority == top {
+		alone := true
+		for _, m := range queued {
+			if m.ID != id && m.Priority == top {
+				alone = false
+				break
+			}
+		}
+		if alone {
+			return nil
+		}
+	}
+
+	if err := svc.dao.UpdatePriority(svc.ctx, id, priority); err != nil {
+		return logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+	utils.NewNotifier().Publish(utils.EVENT_MODELING_QUEUED, id)
+
+	return nil
+}
+
+// ReorderQueue 함수는 대기 중인 modeling들의 실행 순서를 요청 순서대로 바꿉니다.
+func (svc *ModelingService) ReorderQueue(req repo.QueueOrderDTO) *logger.Report {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	queued, err := svc.dao.SelectManyIdle(svc.ctx)
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	queuedMap := make(map[int]*ent.Modeling)
+	for _, m := range queued {
+		queuedMap[m.ID] = m
+	}
+
+	var prev *ent.Modeling
+	for _, id := range req.ModelingIDs {
+		m, ok := queuedMap[id]
+		if !ok {
+			return logger.CreateReport(&logger.CODE_MODELING_NOT_QUEUED, fmt.Errorf("modeling %d is not queued", id))
+		}
+		// 우선순위는 순서보다 먼저 비교하므로 높은 우선순위 modeling을 뒤로 보낼 수 없다.
+		if prev != nil && m.Priority > prev.Priority {
+			return logger.CreateReport(&logger.CODE_REQUEST,
+				fmt.Errorf("modeling %d has a higher priority (%d) than modeling %d (%d); change the priority instead", m.ID, m.Priority, prev.ID, prev.Priority))
+		}
+		prev = m
+	}
+
+	if err := svc.dao.UpdateQueuePositions(svc.ctx, req.ModelingIDs); err != nil {
+		return logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+	if len(req.ModelingIDs) > 0 {
+		utils.NewNotifier().Publish(utils.EVENT_MODELING_QUEUED, req.ModelingIDs[0])
+	}
+
+	return nil
+}
+
+// engine이 알릴 수 있는 modeling 단계
+var notifiableSteps = map[string]bool{
+	utils.MODELING_STEP_RUN:    true,
+	utils.MODELING_STEP_FINISH: true,
+	utils.MODELING_STEP_FAIL:   true,
+	utils.MODELING_STEP_CANCEL: true,
+}
+
+// NotifyStep 함수는 engine이 modeling 단계 변경을 알리면 단계를 기록하고 scheduler를 깨웁니다.
+func (svc *ModelingService) NotifyStep(device_id int, req repo.ModelingNotifyDTO) *logger.Report {
+	logger.Debug(fmt.Sprintf(`{"device_id": %d, "modeling_id": %d, "modeling_step": %s}`, device_id, req.ModelingID, req.ModelingStep))
+	if req.ModelingStep != "" && !notifiableSteps[req.ModelingStep] {
+		return logger.CreateReport(&logger.CODE_REQUEST, fmt.Errorf("unknown modeling_step: %s", req.ModelingStep))
+	}
+
+	placed, err := svc.dao_event.IsPlacedOn(svc.ctx, req.ModelingID, device_id)
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+	if !placed {
+		return logger.CreateReport(&logger.CODE_REQUEST, fmt.Errorf("modeling %d is not placed on device %d", req.ModelingID, device_id))
+	}
+
+	if req.ModelingStep != "" {
+		m, err := svc.dao.SelectOne(svc.ctx, req.ModelingID)
+		if err != nil {
+			return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		}
+		if m.ModelingStep == req.ModelingStep {
+			return nil
+		}
+		if err := svc.dao.UpdateState(svc.ctx, req.ModelingID, req.ModelingStep); err != nil {
+			return logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+	}
+	utils.NewNotifier().Publish(utils.EVENT_MODELING_STEP, req.ModelingID)
+
+	return nil
+}
+
+// Reconcile 함수는 scheduler의 engine 상태 정리를 바로 실행합니다.
+func (svc *ModelingService) Reconcile() (*repo.ReconcileReportDTO, *logger.Report) {
+	return NewTaskScheduler().Reconcile(), nil
+}
+
+func (svc *ModelingService) ReadQueue() ([]*repo.QueueItemDTO, *logger.Report) {
+	if queue, err := NewTaskScheduler().Queue(); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	} else {
+		return queue, nil
+	}
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"api_server/ent"
+	"api_server/ent/schema"
+	"api_server/logger"
+	repo "api_server/task/repository"
+	"api_server/utils"
+)
+
+type IPipelineService interface {
+	// Advance 함수는 진행 중인 pipeline들의 다음 단계를 만듭니다.
+	// 마지막 단계가 complete이면 다음 단계 modeling을 대기열에 추가하고,
+	// fail/cancel이면 남은 단계를 건너뛰고 pipeline을 끝냅니다.
+	// 다음 단계가 대기열 quota를 넘으면 pipeline을 끝내지 않고 quota가 남을 때까지 기다립니다.
+	Advance()
+
+	// ReadStatus 함수는 task pipeline의 전체 상태와 단계별 상태를 조회합니다.
+	//
+	// 매개변수:
+	//   - task_id: 조회할 task ID
+	//
+	// 반환값:
+	//   - *repo.PipelineStatusDTO: pipeline 상태
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadStatus(task_id int) (*repo.PipelineStatusDTO, *logger.Report)
+}
+
+type PipelineService struct {
+	ctx          context.Context
+	dao_task     repo.ITaskDAO
+	svc_modeling IModelingService
+}
+
+var oncePipeline sync.Once
+var instancePipeline *PipelineService
+
+func NewPipelineService(dao_task repo.ITaskDAO, svc_modeling IModelingService) *PipelineService {
+	oncePipeline.Do(func() {
+		logger.Debug("Pipeline service instance")
+		instancePipeline = &PipelineService{
+			ctx:          context.Background(),
+			dao_task:     dao_task,
+			svc_modeling: svc_modeling,
+		}
+	})
+
+	return instancePipeline
+}
+
+func (svc *PipelineService) Advance() {
+	tasks, err := svc.dao_task.SelectManyPipelineRunning(svc.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	for _, task := range tasks {
+		status, stage := pipelineNext(task)
+		if stage > 0 {
+			if r := svc.createStage(task, stage); r != nil && r.Code == logger.CODE_QUOTA_EXCEEDED.Code {
+				// 대기열 quota가 남을 때까지 기다렸다가 다음 Advance에서 다시 만든다.
+				logger.Debug(fmt.Sprintf("pipeline of task %d, stage %d waits for queue quota: %v", task.ID, stage, r.Error))
+				continue
+			} else if r != nil {
+				logger.Error(fmt.Sprintf("pipeline of task %d, stage %d: %+v", task.ID, stage, r))
+				status = utils.PIPELINE_STATUS_FAIL
+			}
+		}
+		if status != "" {
+			if err := svc.dao_task.UpdatePipelineStatus(svc.ctx, task.ID, status); err != nil {
+				logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+			}
+		}
+	}
+}
+
+// pipelineNext는 task의 pipeline modeling들로 바꿀 pipeline 상태와 새로 만들 단계 번호를 정합니다.
+// task.Edges.Modelings는 pipeline_stage 순서로 정렬되어 있어야 합니다.
+func pipelineNext(task *ent.Task) (string, int) {
+	modelings := task.Edges.Modelings
+	if len(modelings) < 1 {
+		// 학습 modeling이 삭제된 경우
+		return utils.PIPELINE_STATUS_FAIL, 0
+	}
+
+	latest := modelings[len(modelings)-1]
+	switch latest.ModelingStep {
+	case utils.MODELING_STEP_COMPLETE:
+		if latest.PipelineStage-1 >= len(task.Pipeline) {
+			return utils.PIPELINE_STATUS_COMPLETE, 0
+		}
+		return "", latest.PipelineStage + 1
+	case utils.MODELING_STEP_FAIL:
+		return utils.PIPELINE_STATUS_FAIL, 0
+	case utils.MODELING_STEP_CANCEL:
+		return utils.PIPELINE_STATUS_CANCEL, 0
+	default:
+		return "", 0
+	}
+}
+
+// createStage는 학습 modeling을 parent로 하는 평가/blind test modeling을 만듭니다.
+func (svc *PipelineService) createStage(task *ent.Task, stage int) *logger.Report {
+	root := task.Edges.Modelings[0]
+	def := task.Pipeline[stage-2]
+
+	userParams := make(map[string]interface{})
+	for key, value := range def.UserParams {
+		userParams[key] = value
+	}
+	// GPU를 지정하지 않은 단계는 자동 배치한다.
+	if _, ok := userParams["gpus"]; !ok {
+		userParams["gpu_auto"] = true
+	}
+
+	_, r := svc.svc_modeling.CreateEvaluation(repo.EvaluationDTO{
+		TaskID:        task.ID,
+		ParentID:      root.ID,
+		DatasetID:     def.DatasetID,
+		EngineType:    task.EngineType,
+		TargetMetric:  task.TargetMetric,
+		IsPath:        def.Type == utils.MODELING_TYPE_BLIND,
+		UserParams:    userParams,
+		Priority:      root.Priority,
+		Username:      root.Username,
+		PipelineStage: stage,
+	})
+
+	return r
+}
+
+func (svc *PipelineService) ReadStatus(task_id int) (*repo.PipelineStatusDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf(`{"task_id": %d}`, task_id))
+	task, err := svc.dao_task.SelectPipeline(svc.ctx, task_id)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	} else if len(task.Pipeline) < 1 {
+		return nil, logger.CreateReport(&logger.CODE_REQUEST, errors.New("task has no pipeline"))
+	}
+
+	byStage := make(map[int]*ent.Modeling)
+	for _, modeling := range task.Edges.Modelings {
+		byStage[modeling.PipelineStage] = modeling
+	}
+
+	defs := append([]schema.PipelineStage{{Type: utils.PIPELINE_STAGE_TRAIN, DatasetID: task.DatasetID}}, task.Pipeline...)
+	status := &repo.PipelineStatusDTO{TaskID: task.ID, Status: task.PipelineStatus, Stages: []*repo.PipelineStageStatusDTO{}}
+	for i, def := range defs {
+		stage := &repo.PipelineStageStatusDTO{
+			Stage:        i + 1,
+			Type:         def.Type,
+			DatasetID:    def.DatasetID,
+			ModelingStep: utils.PIPELINE_STAGE_PENDING,
+		}
+		if modeling, ok := byStage[i+1]; ok {
+			stage.ModelingID = modeling.ID
+			stage.ModelingStep = modeling.ModelingStep
+			stage.FailReason = modeling.FailReason
+		} else if task.PipelineStatus == utils.PIPELINE_STATUS_FAIL || task.PipelineStatus == utils.PIPELINE_STATUS_CANCEL {
+			stage.ModelingStep = utils.MODELING_STEP_SKIP
+		}
+		status.Stages = append(status.Stages, stage)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"api_server/ent"
+	"api_server/ent/schema"
+	"api_server/logger"
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PipelineTestSuite struct {
+	suite.Suite
+	task *ent.Task
+}
+
+func (suite *PipelineTestSuite) SetupTest() {
+	suite.task = &ent.Task{
+		ID: 1,
+		Pipeline: []schema.PipelineStage{
+			{Type: utils.MODELING_TYPE_EVALUATION, DatasetID: 2},
+			{Type: utils.MODELING_TYPE_BLIND, DatasetID: 3},
+		},
+	}
+}
+
+func (suite *PipelineTestSuite) stages(steps ...string) {
+	suite.task.Edges.Modelings = []*ent.Modeling{}
+	for i, step := range steps {
+		suite.task.Edges.Modelings = append(suite.task.Edges.Modelings,
+			&ent.Modeling{ID: 10 + i, PipelineStage: i + 1, ModelingStep: step})
+	}
+}
+
+func (suite *PipelineTestSuite) TestAdvance() {
+	suite.stages(utils.MODELING_STEP_RUN)
+	status, stage := pipelineNext(suite.task)
+	suite.Equal("", status)
+	suite.Equal(0, stage)
+
+	suite.stages(utils.MODELING_STEP_COMPLETE)
+	_, stage = pipelineNext(suite.task)
+	suite.Equal(2, stage)
+
+	suite.stages(utils.MODELING_STEP_COMPLETE, utils.MODELING_STEP_COMPLETE)
+	_, stage = pipelineNext(suite.task)
+	suite.Equal(3, stage)
+
+	suite.stages(utils.MODELING_STEP_COMPLETE, utils.MODELING_STEP_COMPLETE, utils.MODELING_STEP_COMPLETE)
+	status, stage = pipelineNext(suite.task)
+	suite.Equal(utils.PIPELINE_STATUS_COMPLETE, status)
+	suite.Equal(0, stage)
+}
+
+func (suite *PipelineTestSuite) TestUpstreamFailed() {
+	suite.stages(utils.MODELING_STEP_FAIL)
+	status, stage := pipelineNext(suite.task)
+	suite.Equal(utils.PIPELINE_STATUS_FAIL, status)
+	suite.Equal(0, stage)
+
+	suite.stages(utils.MODELING_STEP_COMPLETE, utils.MODELING_STEP_CANCEL)
+	status, _ = pipelineNext(suite.task)
+	suite.Equal(utils.PIPELINE_STATUS_CANCEL, status)
+
+	suite.stages()
+	status, _ = pipelineNext(suite.task)
+	suite.Equal(utils.PIPELINE_STATUS_FAIL, status)
+}
+
+// fakePipelineTaskDAO는 pipeline이 진행 중인 task 조회와 상태 변경만 메모리로 구현합니다.
+type fakePipelineTaskDAO struct {
+	repo.ITaskDAO
+	tasks    []*ent.Task
+	statuses map[int]string
+}
+
+func (dao *fakePipelineTaskDAO) SelectManyPipelineRunning(ctx context.Context) ([]*ent.Task, error) {
+	return dao.tasks, nil
+}
+
+func (dao *fakePipelineTaskDAO) UpdatePipelineStatus(ctx context.Context, task_id int, status string) error {
+	dao.statuses[task_id] = status
+	return nil
+}
+
+// fakeStageModelingService는 pipeline 단계 생성 요청을 기록하고 report로 응답합니다.
+type fakeStageModelingService struct {
+	IModelingService
+	report   *logger.Report
+	requests []repo.EvaluationDTO
+}
+
+func (svc *fakeStageModelingService) CreateEvaluation(req repo.EvaluationDTO) (*repo.ModelingDTO, *logger.Report) {
+	svc.requests = append(svc.requests, req)
+	if svc.report != nil {
+		return nil, svc.report
+	}
+	return &repo.ModelingDTO{}, nil
+}
+
+// 다음 단계가 대기열 quota를 넘으면 pipeline을 끝내지 않고 다음 Advance에서 다시 만든다.
+func (suite *PipelineTestSuite) TestAdvanceQueueQuota() {
+	logger.InitLogger("", "/workspace/log/test.log")
+	suite.stages(utils.MODELING_STEP_COMPLETE)
+	dao := &fakePipelineTaskDAO{tasks: []*ent.Task{suite.task}, statuses: make(map[int]string)}
+	modelings := &fakeStageModelingService{report: &logger.Report{Code: logger.CODE_QUOTA_EXCEEDED.Code, Error: errors.New("project 1 has 1 of 1 queued modelings")}}
+	svc := &PipelineService{ctx: context.Background(), dao_task: dao, svc_modeling: modelings}
+
+	svc.Advance()
+	suite.Len(modelings.requests, 1)
+	suite.Empty(dao.statuses)
+
+	modelings.report = nil
+	svc.Advance()
+	suite.Len(modelings.requests, 2)
+	suite.Equal(2, modelings.requests[1].PipelineStage)
+	suite.Empty(dao.statuses)
+
+	// quota가 아닌 오류는 pipeline을 실패 처리한다.
+	modelings.report = &logger.Report{Code: logger.CODE_DB_INSERT.Code}
+	svc.Advance()
+	suite.Equal(utils.PIPELINE_STATUS_FAIL, dao.statuses[suite.task.ID])
+}
+
+func TestPipelineTestSuite(t *testing.T) {
+	suite.Run(t, new(PipelineTestSuite))
+}
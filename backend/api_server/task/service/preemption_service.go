@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"api_server/logger"
+	repo "api_server/task/repository"
+)
+
+type IPreemptionService interface {
+	// ReadMany 함수는 preemption 기록을 최신 순으로 조회합니다.
+	//
+	// 매개변수:
+	//   - modeling_id: 중단시켰거나 중단된 modeling ID, 0이면 전체
+	//
+	// 반환값:
+	//   - []*repo.PreemptionDTO: 누가 누구를 중단시켰는지에 대한 기록
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadMany(modeling_id int) ([]*repo.PreemptionDTO, *logger.Report)
+}
+
+type PreemptionService struct {
+	ctx context.Context
+	dao repo.IPreemptionDAO
+}
+
+var oncePreemption sync.Once
+var instancePreemption *PreemptionService
+
+func NewPreemptionService(dao repo.IPreemptionDAO) *PreemptionService {
+	oncePreemption.Do(func() {
+		logger.Debug("Preemption service instance")
+		instancePreemption = &PreemptionService{
+			ctx: context.Background(),
+			dao: dao,
+		}
+	})
+
+	return instancePreemption
+}
+
+func (svc *PreemptionService) ReadMany(modeling_id int) ([]*repo.PreemptionDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf(`{"modeling_id": %d}`, modeling_id))
+	if preemptions, err := svc.dao.SelectMany(svc.ctx, modeling_id); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	} else {
+		return repo.ConvertPreemptionEntsToDTOs(preemptions), nil
+	}
+}
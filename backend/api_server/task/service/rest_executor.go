@@ -0,0 +1,92 @@
+package service
+
+import (
+	"errors"
+
+	engine_client "api_server/engine/client"
+	"api_server/ent"
+	"api_server/logger"
+	"api_server/utils"
+)
+
+// RestExecutor는 KAI.S engine의 API로 modeling을 실행합니다. device의 connection에 따라 REST 또는 gRPC를 사용합니다.
+// GPU 배치는 scheduler의 GPU 정보를 사용합니다. 진행 상태는 REST engine은 직접 DB에 기록하고,
+// gRPC engine은 Events stream으로 보내 EngineEventReceiver가 기록합니다.
+type RestExecutor struct {
+	scheduler *TaskScheduler
+}
+
+var restExecutorRequests = map[string]engineRequest{
+	utils.EXECUTOR_JOB_TRAIN:      engine_client.Engine.Train,
+	utils.EXECUTOR_JOB_EVALUATION: engine_client.Engine.Evaluate,
+	utils.EXECUTOR_JOB_BLIND:      engine_client.Engine.Blind,
+}
+
+var restExecutorGPUStates = map[string]string{
+	utils.EXECUTOR_JOB_TRAIN:      utils.GPU_STATE_MODEING,
+	utils.EXECUTOR_JOB_EVALUATION: utils.GPU_STATE_EVALUATION,
+	utils.EXECUTOR_JOB_BLIND:      utils.GPU_STATE_EVALUATION,
+}
+
+func newRestExecutor(scheduler *TaskScheduler) *RestExecutor {
+	return &RestExecutor{scheduler: scheduler}
+}
+
+func (executor *RestExecutor) Name() string {
+	return utils.EXECUTOR_REST
+}
+
+func (executor *RestExecutor) Submit(task *ent.Modeling, job string) error {
+	scheduler := executor.scheduler
+
+	if job == utils.EXECUTOR_JOB_FINISH {
+		// 학습 후처리는 master node만 수행한다.
+		p, err := scheduler.locate(task)
+		if err != nil {
+			return err
+		}
+		return p.master().engine().FinishTrain(scheduler.ctx, task.ID)
+	}
+
+	request, ok := restExecutorRequests[job]
+	if !ok {
+		return errors.New("invalid executor job: " + job)
+	}
+
+	p, err := scheduler.matchGPU(task)
+	if err != nil {
+		return err
+	}
+	if err := scheduler.dispatch(p, request); err != nil {
+		return err
+	}
+	if state, ok := restExecutorGPUStates[job]; ok {
+		scheduler.updateGPUSRunning(p.gpuIDs(), state)
+	}
+	if err := scheduler.dao_modeling.UpdateJob(scheduler.ctx, task.ID, executor.Name(), "", job); err != nil {
+		logger.Error(err)
+	}
+
+	return nil
+}
+
+func (executor *RestExecutor) Cancel(task *ent.Modeling) error {
+	scheduler := executor.scheduler
+
+	p, err := scheduler.locate(task)
+	if err != nil {
+		return err
+	}
+	for _, node := range p.nodes {
+		if err := scheduler.cancelNode(node, task.ID); err != nil {
+			logger.Error(err)
+		}
+	}
+	scheduler.updateGPUIdle(p.gpuIDs())
+
+	return nil
+}
+
+func (executor *RestExecutor) Poll(task *ent.Modeling) (string, error) {
+	return "", nil
+}
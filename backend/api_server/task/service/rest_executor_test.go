@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	device_service "api_server/device/service"
+	engine_client "api_server/engine/client"
+	"api_server/engine/fake"
+	"api_server/logger"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RestExecutorTestSuite struct {
+	suite.Suite
+	engines   []*fake.Engine
+	scheduler *TaskScheduler
+}
+
+func (suite *RestExecutorTestSuite) SetupTest() {
+	logger.InitLogger("", "/workspace/log/test.log")
+	suite.engines = []*fake.Engine{fake.New(1), fake.New(1)}
+	suite.scheduler = &TaskScheduler{ctx: context.Background()}
+}
+
+func (suite *RestExecutorTestSuite) TearDownTest() {
+	for _, engine := range suite.engines {
+		engine.Close()
+	}
+}
+
+func (suite *RestExecutorTestSuite) placement(modelingID int) *placement {
+	p := &placement{modelingID: modelingID, masterPort: 29500}
+	for rank, engine := range suite.engines {
+		p.nodes = append(p.nodes, &placementNode{
+			Rank:      rank,
+			DeviceID:  rank + 1,
+			IP:        engine.IP(),
+			Port:      engine.Port(),
+			DeviceIDs: []int{rank + 1},
+		})
+	}
+
+	return p
+}
+
+func (suite *RestExecutorTestSuite) TestDispatchAndProbe() {
+	p := suite.placement(7)
+
+	suite.NoError(suite.scheduler.dispatch(p, restExecutorRequests[utils.EXECUTOR_JOB_TRAIN]))
+	for _, engine := range suite.engines {
+		suite.Equal([]int{7}, engine.Running())
+		suite.Len(engine.Requests("/api/train"), 1)
+	}
+	suite.True(suite.scheduler.probe(p))
+
+	for _, node := range p.nodes {
+		suite.NoError(suite.scheduler.cancelNode(node, 7))
+	}
+	suite.False(suite.scheduler.probe(p))
+}
+
+func (suite *RestExecutorTestSuite) TestProbeUnreachable() {
+	p := suite.placement(7)
+	suite.NoError(suite.scheduler.dispatch(p, restExecutorRequests[utils.EXECUTOR_JOB_TRAIN]))
+
+	// 오류로 응답한 engine은 실행 여부를 알 수 없으므로 실행 중으로 본다.
+	suite.engines[0].Fail(http.MethodGet, "/api/train/running", http.StatusInternalServerError)
+	suite.engines[1].Close()
+	suite.True(suite.scheduler.probe(p))
+
+	suite.engines[0].Close()
+	suite.False(suite.scheduler.probe(p))
+}
+
+// 모델을 올린 GPU가 설치된 device의 engine으로 connection에 맞게 추론하고 내리는지 확인한다.
+func (suite *RestExecutorTestSuite) TestInference() {
+	_, client := newTestScheduler(suite.T())
+	grpcEngine := fake.NewGRPC(1)
+	defer grpcEngine.Close()
+	restDevice, _ := addTestDevice(suite.T(), client, suite.engines[0].IP(), suite.engines[0].Port(), utils.DEVICE_CONNECTION_REST, 1)
+	grpcDevice, _ := addTestDevice(suite.T(), client, grpcEngine.IP(), grpcEngine.Port(), utils.DEVICE_CONNECTION_GRPC, 1)
+
+	svc := &TestService{ctx: context.Background()}
+	for testId, device := range []int{restDevice.ID, grpcDevice.ID} {
+		engine, r := device_service.DeviceEngine(device)
+		suite.Require().Nil(r)
+		loaded, err := engine.Load(svc.ctx, engine_client.LoadRequest{DeviceID: "0", ModelName: "xgboost_1", ModelType: "table"})
+		suite.Require().NoError(err)
+		svc.loaddedModels = append(svc.loaddedModels, loaddedModel{TestId: testId, ModelName: "xgboost_1", ModelType: "table", ModelNum: loaded.Models[0].ModelNum, DeviceID: device})
+
+		result, r := svc.InferenceTabular(testId, []map[string]interface{}{{"x1": 1, "x2": 2}})
+		suite.Nil(r)
+		suite.NotEmpty(result)
+	}
+	suite.Len(suite.engines[0].Requests("/api/tabular"), 1)
+
+	suite.Nil(svc.UnloadModel(0))
+	suite.Empty(svc.loaddedModels)
+	_, r := svc.InferenceTabular(0, nil)
+	suite.Equal(logger.CODE_REMOTE_NOT_FOUND_MODEL.Code, r.Code)
+}
+
+func TestRestExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(RestExecutorTestSuite))
+}
@@ -0,0 +1,455 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	config_service "api_server/configuration/service"
+	repo_dataset "api_server/dataset/repository"
+	"api_server/ent"
+	"api_server/logger"
+	quota_repo "api_server/quota/repository"
+	quota_service "api_server/quota/service"
+	repo "api_server/task/repository"
+	user_repo "api_server/user/repository"
+	"api_server/utils"
+)
+
+type IScheduleService interface {
+	// Create 함수는 task에 재학습 schedule을 추가합니다.
+	//
+	// 매개변수:
+	//   - req: schedule 정보. trigger가 cron이면 cron 표현식, dataset이면 dataset_id가 필요합니다.
+	//
+	// 반환값:
+	//   - *repo.ScheduleDTO: 추가된 schedule
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Create(req repo.ScheduleDTO) (*repo.ScheduleDTO, *logger.Report)
+
+	// ReadByTask 함수는 task의 schedule 목록을 조회합니다.
+	//
+	// 매개변수:
+	//   - task_id: task ID
+	//
+	// 반환값:
+	//   - []*repo.ScheduleDTO: schedule 목록
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadByTask(task_id int) ([]*repo.ScheduleDTO, *logger.Report)
+
+	// ReadOne 함수는 schedule을 조회합니다.
+	//
+	// 매개변수:
+	//   - id: schedule ID
+	//
+	// 반환값:
+	//   - *repo.ScheduleDTO: schedule
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadOne(id int) (*repo.ScheduleDTO, *logger.Report)
+
+	// Edit 함수는 schedule의 trigger, cron, dataset, parent, 우선순위를 변경합니다.
+	//
+	// 매개변수:
+	//   - req: 변경할 schedule 정보
+	//
+	// 반환값:
+	//   - *repo.ScheduleDTO: 변경된 schedule
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Edit(req repo.ScheduleDTO) (*repo.ScheduleDTO, *logger.Report)
+
+	// Pause 함수는 schedule을 일시 정지하거나 다시 시작합니다.
+	// 다시 시작하면 cron schedule은 현재 시각 기준으로 다음 실행 시각을 계산합니다.
+	//
+	// 매개변수:
+	//   - id: schedule ID
+	//   - paused: 일시 정지 여부
+	//
+	// 반환값:
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	Pause(id int, paused bool) *logger.Report
+
+	// DeleteOne 함수는 schedule과 실행 기록을 삭제합니다.
+	//
+	// 매개변수:
+	//   - id: schedule ID
+	//
+	// 반환값:
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	DeleteOne(id int) *logger.Report
+
+	// ReadHistory 함수는 schedule 실행 기록을 최신 순으로 조회합니다.
+	//
+	// 매개변수:
+	//   - id: schedule ID
+	//
+	// 반환값:
+	//   - []*repo.ScheduleHistoryDTO: 실행 기록
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadHistory(id int) ([]*repo.ScheduleHistoryDTO, *logger.Report)
+
+	// WatchSchedules 함수는 1분마다 실행 시각이 된 cron schedule과
+	// 마지막 학습 이후 fingerprint가 바뀌고 DATASET_SETTLE_PERIOD(초) 동안 그대로인 dataset의 schedule을 실행합니다.
+	WatchSchedules()
+}
+
+type ScheduleService struct {
+	ctx          context.Context
+	dao          repo.IScheduleDAO
+	dao_modeling repo.IModelingDAO
+	dao_dataset  repo_dataset.DatasetDAOInterface
+	svc_quota    quota_service.IQuotaService
+}
+
+var onceSchedule sync.Once
+var instanceSchedule *ScheduleService
+
+func NewScheduleService(dao repo.IScheduleDAO, dao_modeling repo.IModelingDAO) *ScheduleService {
+	onceSchedule.Do(func() {
+		logger.Debug("Schedule service instance")
+		instanceSchedule = &ScheduleService{
+			ctx:          context.Background(),
+			dao:          dao,
+			dao_modeling: dao_modeling,
+			dao_dataset:  repo_dataset.NewDatasetDAO(),
+			svc_quota:    quota_service.NewQuotaService(quota_repo.NewQuotaDAO(), user_repo.NewUserDAO(), repo.NewTaskDAO(), repo.NewModelingDAO()),
+		}
+	})
+
+	return instanceSchedule
+}
+
+// nextRun는 schedule의 다음 실행 시각을 계산합니다. dataset trigger나 일시 정지된 schedule은 nil 입니다.
+func nextRun(req repo.ScheduleDTO, now time.Time) (*time.Time, error) {
+	switch req.Trigger {
+	case utils.SCHEDULE_TRIGGER_CRON:
+		cron, err := utils.ParseCron(req.Cron)
+		if err != nil {
+			return nil, err
+		}
+		next, err := cron.Next(now)
+		if err != nil {
+			return nil, err
+		}
+		if req.Paused {
+			return nil, nil
+		}
+		return &next, nil
+	case utils.SCHEDULE_TRIGGER_DATASET:
+		if req.DatasetID < 1 {
+			return nil, errors.New("dataset trigger requires dataset_id")
+		}
+		return nil, nil
+	default:
+		return nil, errors.New("invalid trigger: " + req.Trigger)
+	}
+}
+
+func (svc *ScheduleService) Create(req repo.ScheduleDTO) (*repo.ScheduleDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	next, err := nextRun(req, time.Now())
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_SCHEDULE_INVALID, err)
+	}
+	req.NextRunAt = next
+
+	if inserted, err := svc.dao.InsertOne(svc.ctx, req); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_INSERT, err)
+	} else {
+		return repo.ConvertScheduleEntToDTO(inserted), nil
+	}
+}
+
+func (svc *ScheduleService) ReadByTask(task_id int) ([]*repo.ScheduleDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf(`{"task_id": %d}`, task_id))
+	if schedules, err := svc.dao.SelectByTask(svc.ctx, task_id); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	} else {
+		return repo.ConvertScheduleEntsToDTOs(schedules), nil
+	}
+}
+
+func (svc *ScheduleService) ReadOne(id int) (*repo.ScheduleDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	if schedule, err := svc.dao.SelectOne(svc.ctx, id); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	} else {
+		return repo.ConvertScheduleEntToDTO(schedule), nil
+	}
+}
+
+func (svc *ScheduleService) Edit(req repo.ScheduleDTO) (*repo.ScheduleDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf("%+v", req))
+	current, err := svc.dao.SelectOne(svc.ctx, req.ID)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	req.Paused = current.Paused
+	next, err := nextRun(req, time.Now())
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_SCHEDULE_INVALID, err)
+	}
+	req.NextRunAt = next
+
+	updated, err := svc.dao.UpdateOne(svc.ctx, req)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+	// 다른 dataset을 보게 되면 다음 확인 때 그 dataset의 현재 fingerprint를 기준으로 삼는다.
+	if current.Trigger != req.Trigger || current.DatasetID != req.DatasetID {
+		if err := svc.dao.UpdateTrainedFingerprint(svc.ctx, req.ID, ""); err != nil {
+			return nil, logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+		updated.TrainedFingerprint = ""
+	}
+
+	return repo.ConvertScheduleEntToDTO(updated), nil
+}
+
+func (svc *ScheduleService) Pause(id int, paused bool) *logger.Report {
+	logger.Debug(fmt.Sprintf(`{"id": %d, "paused": %t}`, id, paused))
+	current, err := svc.dao.SelectOne(svc.ctx, id)
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	}
+
+	req := *repo.ConvertScheduleEntToDTO(current)
+	req.Paused = paused
+	next, err := nextRun(req, time.Now())
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_SCHEDULE_INVALID, err)
+	}
+
+	if err := svc.dao.UpdatePaused(svc.ctx, id, paused, next); err != nil {
+		return logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+
+	return nil
+}
+
+func (svc *ScheduleService) DeleteOne(id int) *logger.Report {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	if err := svc.dao.DeleteOne(svc.ctx, id); err != nil {
+		return logger.CreateReport(&logger.CODE_DB_DELETE, err)
+	}
+
+	return nil
+}
+
+func (svc *ScheduleService) ReadHistory(id int) ([]*repo.ScheduleHistoryDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf(`{"id": %d}`, id))
+	if histories, err := svc.dao.SelectHistory(svc.ctx, id); err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	} else {
+		return repo.ConvertScheduleHistoryEntsToDTOs(histories), nil
+	}
+}
+
+func (svc *ScheduleService) WatchSchedules() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		svc.runDue(now)
+		svc.runDatasets(now)
+	}
+}
+
+func (svc *ScheduleService) runDue(now time.Time) {
+	schedules, err := svc.dao.SelectDue(svc.ctx, now)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	for _, schedule := range schedules {
+		svc.trigger(schedule, utils.SCHEDULE_TRIGGER_CRON)
+
+		next, err := nextRun(*repo.ConvertScheduleEntToDTO(schedule), now)
+		if err != nil {
+			logger.Error(fmt.Sprintf("schedule %d: %v", schedule.ID, err))
+		}
+		if err := svc.dao.UpdateRun(svc.ctx, schedule.ID, now, next); err != nil {
+			logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+	}
+}
+
+// runDatasets는 dataset fingerprint가 schedule이 마지막으로 학습한 값과 다르면 schedule을 실행합니다.
+// 파일을 복사하는 중에 학습하지 않도록 fingerprint가 DATASET_SETTLE_PERIOD(초) 동안 바뀌지 않아야 합니다.
+// modeling을 만든 경우에만 학습한 fingerprint를 기록하므로, 건너뛰거나 실패한 변경은 다음 확인 때 다시 시도합니다.
+func (svc *ScheduleService) runDatasets(now time.Time) {
+	schedules, err := svc.dao.SelectDatasetTriggers(svc.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	settle := time.Duration(config_service.NewStatic().GetInt("DATASET_SETTLE_PERIOD", 300)) * time.Second
+	for _, schedule := range schedules {
+		datasets, r := svc.dao_dataset.SelectDataSetByID(svc.ctx, schedule.DatasetID)
+		if r != nil || len(datasets) < 1 || datasets[0].Fingerprint == "" {
+			continue
+		}
+		dataset := datasets[0]
+
+		// 처음 확인하는 schedule은 현재 dataset을 학습한 것으로 보고 이후 변경부터 실행한다.
+		if schedule.TrainedFingerprint == "" {
+			if err := svc.dao.UpdateTrainedFingerprint(svc.ctx, schedule.ID, dataset.Fingerprint); err != nil {
+				logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+			}
+			continue
+		}
+		if dataset.Fingerprint == schedule.TrainedFingerprint {
+			continue
+		}
+		if dataset.FingerprintAt != nil && now.Sub(*dataset.FingerprintAt) < settle {
+			continue
+		}
+
+		if !svc.trigger(schedule, utils.SCHEDULE_TRIGGER_DATASET) {
+			continue
+		}
+		if err := svc.dao.UpdateTrainedFingerprint(svc.ctx, schedule.ID, dataset.Fingerprint); err != nil {
+			logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+		if err := svc.dao.UpdateRun(svc.ctx, schedule.ID, now, nil); err != nil {
+			logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+	}
+}
+
+// trigger는 schedule의 parent modeling params로 update modeling을 대기열에 추가하고 실행 기록을 남깁니다.
+// 이전에 만든 modeling이 아직 끝나지 않았거나 schedule을 만든 사용자의 대기열 quota를 넘으면 건너뜁니다.
+// dataset trigger는 매 확인마다 다시 시도하므로 같은 이유로 연달아 건너뛴 기록은 한 번만 남깁니다.
+// modeling을 만들었는지 반환합니다.
+func (svc *ScheduleService) trigger(schedule *ent.Schedule, trigger string) bool {
+	history := repo.ScheduleHistoryDTO{ScheduleID: schedule.ID, Trigger: trigger}
+	if modeling, skipped, err := svc.create(schedule); err != nil {
+		history.Status = utils.SCHEDULE_RUN_FAILED
+		history.Message = err.Error()
+		logger.Error(fmt.Sprintf("schedule %d: %v", schedule.ID, err))
+	} else if modeling == nil {
+		history.Status = utils.SCHEDULE_RUN_SKIPPED
+		history.Message = skipped
+	} else {
+		history.Status = utils.SCHEDULE_RUN_CREATED
+		history.ModelingID = modeling.ID
+		utils.NewNotifier().Publish(utils.EVENT_MODELING_QUEUED, modeling.ID)
+	}
+
+	if trigger == utils.SCHEDULE_TRIGGER_DATASET && history.Status == utils.SCHEDULE_RUN_SKIPPED && svc.isRepeated(history) {
+		return false
+	}
+	if err := svc.dao.InsertHistory(svc.ctx, history); err != nil {
+		logger.CreateReport(&logger.CODE_DB_INSERT, err)
+	}
+
+	return history.Status == utils.SCHEDULE_RUN_CREATED
+}
+
+// isRepeated는 schedule의 마지막 실행 기록이 history와 같은 trigger, 상태, 메시지인지 확인합니다.
+func (svc *ScheduleService) isRepeated(history repo.ScheduleHistoryDTO) bool {
+	histories, err := svc.dao.SelectHistory(svc.ctx, history.ScheduleID)
+	if err != nil || len(histories) < 1 {
+		return false
+	}
+	last := histories[0]
+
+	return last.Trigger == history.Trigger && last.Status == history.Status && last.Message == history.Message
+}
+
+// create는 update modeling을 만듭니다. 만들지 않고 건너뛰면 modeling은 nil이고 건너뛴 이유를 반환합니다.
+func (svc *ScheduleService) create(schedule *ent.Schedule) (*ent.Modeling, string, error) {
+	if running, err := svc.isRunning(schedule.ID); err != nil {
+		return nil, "", err
+	} else if running {
+		return nil, "previous run is still in progress", nil
+	}
+
+	var parent *ent.Modeling
+	var err error
+	if schedule.ParentID > 0 {
+		parent, err = svc.dao_modeling.SelectOne(svc.ctx, schedule.ParentID)
+	} else {
+		parent, err = svc.dao_modeling.SelectLatestTrained(svc.ctx, schedule.TaskID)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if r := svc.svc_quota.CheckTaskQueue(parent.TaskID, schedule.Username); r != nil {
+		if r.Code != logger.CODE_QUOTA_EXCEEDED.Code {
+			return nil, "", fmt.Errorf("%s: %v", r.Message, r.Error)
+		}
+		return nil, fmt.Sprintf("%s: %v", r.Message, r.Error), nil
+	}
+
+	params, err := updateParams(parent)
+	if err != nil {
+		return nil, "", err
+	}
+
+	modeling, err := svc.dao_modeling.InsertOne(svc.ctx, repo.ModelingDTO{
+		Params:       params,
+		ModelingType: utils.MODELING_TYPE_UPDATE,
+		ModelingStep: utils.MODELING_STEP_IDLE,
+		TaskID:       parent.TaskID,
+		ParentID:     parent.ID,
+		DatasetID:    parent.DatasetID,
+		Priority:     schedule.Priority,
+		Username:     schedule.Username,
+	})
+
+	return modeling, "", err
+}
+
+// isRunning는 schedule이 마지막으로 만든 modeling이 아직 끝나지 않았는지 확인합니다.
+func (svc *ScheduleService) isRunning(schedule_id int) (bool, error) {
+	histories, err := svc.dao.SelectHistory(svc.ctx, schedule_id)
+	if err != nil {
+		return false, err
+	}
+
+	for _, history := range histories {
+		if history.ModelingID < 1 {
+			continue
+		}
+		modeling, err := svc.dao_modeling.SelectOne(svc.ctx, history.ModelingID)
+		if ent.IsNotFound(err) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		step := modeling.ModelingStep
+		return step != utils.MODELING_STEP_COMPLETE && step != utils.MODELING_STEP_FAIL && step != utils.MODELING_STEP_CANCEL, nil
+	}
+
+	return false, nil
+}
+
+// updateParams는 parent modeling의 params에 재학습 원본 정보를 추가합니다.
+func updateParams(parent *ent.Modeling) ([]string, error) {
+	if len(parent.Params) < 1 {
+		return nil, errors.New("parent modeling has no params")
+	}
+
+	params := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(parent.Params[0]), &params); err != nil {
+		return nil, err
+	}
+	savePath, _ := params["save_path"].(string)
+	params["origin_id"] = parent.ID
+	params["origin_path"] = savePath + "/" + strconv.Itoa(parent.ID) + "/"
+	params["mode"] = "train"
+
+	paramstr, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{string(paramstr)}, nil
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	dataset_repo "api_server/dataset/repository"
+	"api_server/ent"
+	"api_server/ent/modeling"
+	quota_repo "api_server/quota/repository"
+	quota_service "api_server/quota/service"
+	repo "api_server/task/repository"
+	user_repo "api_server/user/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduleTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ScheduleTestSuite) TestNextRun() {
+	now := time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)
+
+	next, err := nextRun(repo.ScheduleDTO{Trigger: utils.SCHEDULE_TRIGGER_CRON, Cron: "@daily"}, now)
+	suite.NoError(err)
+	suite.Equal(time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), *next)
+
+	next, err = nextRun(repo.ScheduleDTO{Trigger: utils.SCHEDULE_TRIGGER_CRON, Cron: "@daily", Paused: true}, now)
+	suite.NoError(err)
+	suite.Nil(next)
+
+	_, err = nextRun(repo.ScheduleDTO{Trigger: utils.SCHEDULE_TRIGGER_CRON, Cron: "61 * * * *"}, now)
+	suite.Error(err)
+
+	_, err = nextRun(repo.ScheduleDTO{Trigger: utils.SCHEDULE_TRIGGER_DATASET}, now)
+	suite.Error(err)
+}
+
+func (suite *ScheduleTestSuite) TestUpdateParams() {
+	parent := &ent.Modeling{ID: 7, Params: []string{`{"save_path":"/save","mode":"blind"}`}}
+
+	params, err := updateParams(parent)
+	suite.NoError(err)
+
+	values := map[string]interface{}{}
+	suite.NoError(json.Unmarshal([]byte(params[0]), &values))
+	suite.Equal(float64(7), values["origin_id"])
+	suite.Equal("/save/7/", values["origin_path"])
+	suite.Equal("train", values["mode"])
+}
+
+// schedule이 만드는 modeling도 schedule을 만든 사용자와 task project의 대기열 quota를 따른다.
+func (suite *ScheduleTestSuite) TestTriggerQueueQuota() {
+	_, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	svc := &ScheduleService{
+		ctx:          ctx,
+		dao:          repo.NewScheduleDAO(),
+		dao_modeling: repo.NewModelingDAO(),
+		svc_quota:    quota_service.NewQuotaService(quota_repo.NewQuotaDAO(), user_repo.NewUserDAO(), repo.NewTaskDAO(), repo.NewModelingDAO()),
+	}
+
+	parent := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_COMPLETE, 0, repo.EngineParams{})
+	client.Task.UpdateOneID(parent.TaskID).SetProjectID(1).ExecX(ctx)
+	schedule := client.Schedule.Create().SetTaskID(parent.TaskID).SetParentID(parent.ID).SetUsername("alice").SaveX(ctx)
+	client.Quota.Create().SetTargetType(utils.QUOTA_TARGET_PROJECT).SetTargetID(1).SetMaxQueued(1).ExecX(ctx)
+	queued := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 0, repo.EngineParams{})
+	client.Modeling.UpdateOneID(queued.ID).SetTaskID(parent.TaskID).ExecX(ctx)
+
+	svc.trigger(schedule, utils.SCHEDULE_TRIGGER_CRON)
+	histories, err := svc.dao.SelectHistory(ctx, schedule.ID)
+	suite.Require().NoError(err)
+	suite.Require().Len(histories, 1)
+	suite.Equal(utils.SCHEDULE_RUN_SKIPPED, histories[0].Status)
+	suite.Contains(histories[0].Message, "queued")
+	suite.Equal(0, client.Modeling.Query().Where(modeling.ParentID(parent.ID)).CountX(ctx))
+
+	// 대기열이 비면 다시 만든다.
+	client.Modeling.UpdateOneID(queued.ID).SetModelingStep(utils.MODELING_STEP_COMPLETE).ExecX(ctx)
+	svc.trigger(schedule, utils.SCHEDULE_TRIGGER_CRON)
+	suite.Equal(1, client.Modeling.Query().Where(modeling.ParentID(parent.ID)).CountX(ctx))
+}
+
+// dataset schedule은 fingerprint가 settle 기간 동안 그대로일 때 실행하고, 건너뛴 변경은 다음 확인 때 다시 시도한다.
+func (suite *ScheduleTestSuite) TestDatasetFingerprint() {
+	_, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	svc := &ScheduleService{
+		ctx:          ctx,
+		dao:          repo.NewScheduleDAO(),
+		dao_modeling: repo.NewModelingDAO(),
+		dao_dataset:  dataset_repo.NewDatasetDAO(),
+		svc_quota:    quota_service.NewQuotaService(quota_repo.NewQuotaDAO(), user_repo.NewUserDAO(), repo.NewTaskDAO(), repo.NewModelingDAO()),
+	}
+	now := time.Now()
+
+	parent := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_COMPLETE, 0, repo.EngineParams{})
+	dataset := client.Dataset.Create().SetName("ds").SetPath("/ds").SetDataType("table").SetStat([]string{}).
+		SetFingerprint("a").SetFingerprintAt(now.Add(-time.Hour)).SaveX(ctx)
+	schedule := client.Schedule.Create().SetTaskID(parent.TaskID).SetParentID(parent.ID).
+		SetTrigger(utils.SCHEDULE_TRIGGER_DATASET).SetDatasetID(dataset.ID).SaveX(ctx)
+	created := func() int { return client.Modeling.Query().Where(modeling.ParentID(parent.ID)).CountX(ctx) }
+
+	// 처음 확인하면 현재 fingerprint를 기준으로 삼는다.
+	svc.runDatasets(now)
+	suite.Equal("a", client.Schedule.GetX(ctx, schedule.ID).TrainedFingerprint)
+	suite.Equal(0, created())
+
+	// 방금 바뀐 dataset은 settle 기간이 지날 때까지 기다린다.
+	client.Dataset.UpdateOneID(dataset.ID).SetFingerprint("b").SetFingerprintAt(now).ExecX(ctx)
+	svc.runDatasets(now.Add(time.Minute))
+	suite.Equal(0, created())
+
+	// 이전 modeling이 실행 중이면 건너뛰고, 같은 이유의 기록은 한 번만 남긴다.
+	running := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{})
+	suite.NoError(svc.dao.InsertHistory(ctx, repo.ScheduleHistoryDTO{ScheduleID: schedule.ID, ModelingID: running.ID, Trigger: utils.SCHEDULE_TRIGGER_DATASET, Status: utils.SCHEDULE_RUN_CREATED}))
+	svc.runDatasets(now.Add(10 * time.Minute))
+	svc.runDatasets(now.Add(11 * time.Minute))
+	histories, err := svc.dao.SelectHistory(ctx, schedule.ID)
+	suite.Require().NoError(err)
+	suite.Len(histories, 2)
+	suite.Equal(utils.SCHEDULE_RUN_SKIPPED, histories[0].Status)
+	suite.Equal("a", client.Schedule.GetX(ctx, schedule.ID).TrainedFingerprint)
+
+	// 이전 modeling이 끝나면 놓친 변경으로 다시 학습한다.
+	client.Modeling.UpdateOneID(running.ID).SetModelingStep(utils.MODELING_STEP_COMPLETE).ExecX(ctx)
+	svc.runDatasets(now.Add(12 * time.Minute))
+	suite.Equal(1, created())
+	suite.Equal("b", client.Schedule.GetX(ctx, schedule.ID).TrainedFingerprint)
+
+	svc.runDatasets(now.Add(13 * time.Minute))
+	suite.Equal(1, created())
+}
+
+func TestScheduleTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleTestSuite))
+}
@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"api_server/ent"
+	"api_server/logger"
+	quota_repo "api_server/quota/repository"
+	quota_service "api_server/quota/service"
+	repo "api_server/task/repository"
+	user_repo "api_server/user/repository"
+	"api_server/utils"
+)
+
+type ISearchService interface {
+	// Advance 함수는 진행 중인 hyperparameter 탐색들을 진행합니다.
+	// 끝난 trial의 metric을 기록하고, budget이 남아 있으면 다음 trial을 대기열에 추가합니다.
+	// trial 수나 시간 budget을 다 쓰면 남은 trial을 중단하고 탐색을 끝냅니다.
+	Advance()
+
+	// ReadStatus 함수는 task hyperparameter 탐색의 전체 상태와 trial별 설정, metric을 조회합니다.
+	//
+	// 매개변수:
+	//   - task_id: 조회할 task ID
+	//
+	// 반환값:
+	//   - *repo.SearchStatusDTO: 탐색 상태
+	//   - *logger.Report: 오류 발생 시 반환되는 리포트 객체
+	ReadStatus(task_id int) (*repo.SearchStatusDTO, *logger.Report)
+}
+
+type SearchService struct {
+	ctx          context.Context
+	dao_task     repo.ITaskDAO
+	dao_modeling repo.IModelingDAO
+	dao_history  repo.IHyperParamsDAO
+	svc_task     ITaskService
+	svc_modeling IModelingService
+	svc_quota    quota_service.IQuotaService
+}
+
+var onceSearch sync.Once
+var instanceSearch *SearchService
+
+func NewSearchService(dao_task repo.ITaskDAO, dao_modeling repo.IModelingDAO, svc_task ITaskService, svc_modeling IModelingService) *SearchService {
+	onceSearch.Do(func() {
+		logger.Debug("Search service instance")
+		instanceSearch = &SearchService{
+			ctx:          context.Background(),
+			dao_task:     dao_task,
+			dao_modeling: dao_modeling,
+			dao_history:  repo.NewHyperParamsDAO(),
+			svc_task:     svc_task,
+			svc_modeling: svc_modeling,
+			svc_quota:    quota_service.NewQuotaService(quota_repo.NewQuotaDAO(), user_repo.NewUserDAO(), dao_task, dao_modeling),
+		}
+	})
+
+	return instanceSearch
+}
+
+func (svc *SearchService) Advance() {
+	tasks, err := svc.dao_task.SelectManySearchRunning(svc.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	for _, task := range tasks {
+		if task.Search == nil {
+			continue
+		}
+		observed := svc.observe(task)
+		status, propose, stop := searchNext(task, len(observed), time.Now())
+		for _, m := range stop {
+			if r := svc.svc_modeling.StopModelingTask(m.ID); r != nil {
+				logger.Error(fmt.Sprintf("search of task %d, stop trial %d: %+v", task.ID, m.SearchTrial, r))
+			}
+		}
+		for i := 0; i < propose; i++ {
+			r := svc.createTrial(task, len(task.Edges.Modelings)+i+1, observed)
+			if r != nil && r.Code == logger.CODE_QUOTA_EXCEEDED.Code {
+				// quota가 풀리면 다음 스케줄링에서 다시 제안한다.
+				break
+			} else if r != nil {
+				logger.Error(fmt.Sprintf("search of task %d: %+v", task.ID, r))
+				status = utils.SEARCH_STATUS_FAIL
+				break
+			}
+		}
+		if status != "" {
+			if err := svc.dao_task.UpdateSearchStatus(svc.ctx, task.ID, status); err != nil {
+				logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+			}
+		}
+	}
+}
+
+// searchNext는 task의 trial modeling들로 바꿀 탐색 상태, 새로 만들 trial 수, 중단할 trial을 정합니다.
+// task.Edges.Modelings는 search_trial 순서로 정렬되어 있어야 하고, scored는 metric이 기록된 trial 수입니다.
+func searchNext(task *ent.Task, scored int, now time.Time) (string, int, []*ent.Modeling) {
+	trials := task.Edges.Modelings
+	if len(trials) < 1 {
+		// trial modeling이 삭제된 경우
+		return utils.SEARCH_STATUS_FAIL, 0, nil
+	}
+
+	unfinished := []*ent.Modeling{}
+	canceled := false
+	for _, m := range trials {
+		switch m.ModelingStep {
+		case utils.MODELING_STEP_COMPLETE, utils.MODELING_STEP_FAIL:
+		case utils.MODELING_STEP_CANCEL:
+			canceled = true
+		default:
+			unfinished = append(unfinished, m)
+		}
+	}
+	if canceled {
+		// 사용자가 trial을 중단하면 탐색 전체를 중단한다.
+		return utils.SEARCH_STATUS_CANCEL, 0, unfinished
+	}
+
+	budget := searchBudget(task.Search)
+	expired := task.Search.MaxDuration > 0 && now.After(task.CreatedAt.Add(time.Duration(task.Search.MaxDuration)*time.Second))
+	if expired || (len(trials) >= budget && len(unfinished) < 1) {
+		if scored < 1 {
+			return utils.SEARCH_STATUS_FAIL, 0, unfinished
+		}
+		return utils.SEARCH_STATUS_COMPLETE, 0, unfinished
+	}
+
+	parallel := task.Search.Parallel
+	if parallel < 1 {
+		parallel = 1
+	}
+	propose := parallel - len(unfinished)
+	if left := budget - len(trials); left < propose {
+		propose = left
+	}
+	if propose < 0 {
+		propose = 0
+	}
+
+	return "", propose, nil
+}
+
+// observe는 trial별 설정과 metric을 모읍니다. 끝났지만 아직 metric이 기록되지 않은 trial은
+// best model의 testset score를 읽어 hyper_params_history에 기록합니다.
+func (svc *SearchService) observe(task *ent.Task) []searchObservation {
+	history := svc.history(task)
+	metric := searchMetric(task)
+
+	observed := []searchObservation{}
+	for _, m := range task.Edges.Modelings {
+		h, ok := history[m.SearchTrial]
+		if !ok {
+			continue
+		}
+		config := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(h.Params), &config); err != nil {
+			logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, err)
+			continue
+		}
+
+		if h.Score == nil && m.ModelingStep == utils.MODELING_STEP_COMPLETE {
+			model, err := svc.dao_modeling.SelectBestModelByModeling(svc.ctx, m.ID)
+			if err != nil || model == "" {
+				continue
+			}
+			score, ok := svc.dao_modeling.SelectTestScoreByModelAndModeling(svc.ctx, model, m.ID)[metric]
+			if !ok {
+				continue
+			}
+			if err := svc.dao_history.UpdateScore(svc.ctx, h.ID, model, score); err != nil {
+				logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+				continue
+			}
+			h.Model, h.Score = model, &score
+		}
+		if h.Score != nil {
+			observed = append(observed, searchObservation{config: config, score: *h.Score})
+		}
+	}
+
+	return observed
+}
+
+// history는 task trial들의 hyper_params_history를 trial 번호로 찾을 수 있게 조회합니다.
+func (svc *SearchService) history(task *ent.Task) map[int]*ent.HyperParamsHistory {
+	ids := []int{}
+	for _, m := range task.Edges.Modelings {
+		ids = append(ids, m.ID)
+	}
+
+	byTrial := make(map[int]*ent.HyperParamsHistory)
+	rows, err := svc.dao_history.SelectManySearch(svc.ctx, ids)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return byTrial
+	}
+	for _, h := range rows {
+		byTrial[h.ModelNum] = h
+	}
+
+	return byTrial
+}
+
+// createTrial은 제안한 설정으로 trial modeling을 만들고 설정을 hyper_params_history에 기록합니다.
+// 우선순위와 사용자는 첫 번째 trial을 따릅니다.
+func (svc *SearchService) createTrial(task *ent.Task, trial int, observed []searchObservation) *logger.Report {
+	first := task.Edges.Modelings[0]
+	if r := svc.svc_quota.CheckTaskQueue(task.ID, first.Username); r != nil {
+		return r
+	}
+
+	modeling, r := svc.svc_task.makeModelingDTO(task)
+	if r != nil {
+		return r
+	}
+	config := proposeSearchTrial(task.Search, trial, observed)
+	if r := applySearchTrial(modeling, trial, config); r != nil {
+		return r
+	}
+	modeling.Priority = first.Priority
+	modeling.Username = first.Username
+
+	inserted, err := svc.dao_modeling.InsertOne(svc.ctx, *modeling)
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_DB_INSERT, err)
+	}
+	if r := recordSearchTrial(svc.ctx, svc.dao_history, inserted.ID, trial, config); r != nil {
+		return r
+	}
+	utils.NewNotifier().Publish(utils.EVENT_MODELING_QUEUED, inserted.ID)
+
+	return nil
+}
+
+// applySearchTrial은 trial 설정으로 modeling params를 덮어쓰고 trial 번호를 붙입니다.
+func applySearchTrial(modeling *repo.ModelingDTO, trial int, config map[string]interface{}) *logger.Report {
+	params := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(modeling.Params[0]), &params); err != nil {
+		return logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, err)
+	}
+	for key, value := range config {
+		params[key] = value
+	}
+
+	paramstr, err := json.Marshal(params)
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_JSON_MARSHAL, err)
+	}
+	modeling.Params = []string{string(paramstr)}
+	modeling.SearchTrial = trial
+
+	return nil
+}
+
+// recordSearchTrial은 KAI.S가 제안한 trial 설정을 hyper_params_history에 기록합니다.
+func recordSearchTrial(ctx context.Context, dao repo.IHyperParamsDAO, modeling_id int, trial int, config map[string]interface{}) *logger.Report {
+	paramstr, err := json.Marshal(config)
+	if err != nil {
+		return logger.CreateReport(&logger.CODE_JSON_MARSHAL, err)
+	}
+
+	if err := dao.InsertOne(ctx, repo.HyperParamsHistoryDTO{
+		TrialID:   modeling_id,
+		TrialUUID: fmt.Sprintf("%s%d", utils.SEARCH_TRIAL_UUID_PREFIX, modeling_id),
+		ModelNum:  trial,
+		Params:    string(paramstr),
+	}); err != nil {
+		return logger.CreateReport(&logger.CODE_DB_INSERT, err)
+	}
+
+	return nil
+}
+
+// searchMetric은 trial을 비교할 testset score 이름입니다.
+func searchMetric(task *ent.Task) string {
+	if task.Search.Metric != "" {
+		return task.Search.Metric
+	}
+
+	return task.TargetMetric
+}
+
+func (svc *SearchService) ReadStatus(task_id int) (*repo.SearchStatusDTO, *logger.Report) {
+	logger.Debug(fmt.Sprintf(`{"task_id": %d}`, task_id))
+	task, err := svc.dao_task.SelectSearch(svc.ctx, task_id)
+	if err != nil {
+		return nil, logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	} else if task.Search == nil {
+		return nil, logger.CreateReport(&logger.CODE_REQUEST, errors.New("task has no hyperparameter search"))
+	}
+
+	direction := task.Search.Direction
+	if direction == "" {
+		direction = utils.SEARCH_DIRECTION_MAX
+	}
+	status := &repo.SearchStatusDTO{
+		TaskID:    task.ID,
+		Status:    task.SearchStatus,
+		Strategy:  task.Search.Strategy,
+		Metric:    searchMetric(task),
+		Direction: direction,
+		MaxTrials: searchBudget(task.Search),
+		Trials:    []*repo.SearchTrialDTO{},
+	}
+
+	history := svc.history(task)
+	for _, m := range task.Edges.Modelings {
+		trial := &repo.SearchTrialDTO{
+			Trial:        m.SearchTrial,
+			ModelingID:   m.ID,
+			ModelingStep: m.ModelingStep,
+			FailReason:   m.FailReason,
+		}
+		if h, ok := history[m.SearchTrial]; ok {
+			if err := json.Unmarshal([]byte(h.Params), &trial.Params); err != nil {
+				logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, err)
+			}
+			trial.Model = h.Model
+			trial.Score = h.Score
+		}
+		if trial.Score != nil && (status.BestScore == nil ||
+			(direction == utils.SEARCH_DIRECTION_MAX && *trial.Score > *status.BestScore) ||
+			(direction == utils.SEARCH_DIRECTION_MIN && *trial.Score < *status.BestScore)) {
+			status.BestTrial, status.BestScore = trial.Trial, trial.Score
+		}
+		status.Trials = append(status.Trials, trial)
+	}
+
+	return status, nil
+}
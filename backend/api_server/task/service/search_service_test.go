@@ -0,0 +1,161 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"api_server/ent"
+	"api_server/ent/schema"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchTestSuite struct {
+	suite.Suite
+	search *schema.HPSearch
+	task   *ent.Task
+}
+
+func (suite *SearchTestSuite) SetupTest() {
+	suite.search = &schema.HPSearch{
+		Strategy:  utils.SEARCH_STRATEGY_RANDOM,
+		MaxTrials: 3,
+		Parallel:  2,
+		Seed:      7,
+		Space: []schema.HPParam{
+			{Name: "epochs", Type: utils.SEARCH_PARAM_INT, Min: 10, Max: 30, Step: 10},
+			{Name: "lr", Type: utils.SEARCH_PARAM_LOG, Min: 1e-4, Max: 1e-1},
+			{Name: "optimizer", Type: utils.SEARCH_PARAM_CHOICE, Choices: []interface{}{"adam", "sgd"}},
+		},
+	}
+	suite.task = &ent.Task{ID: 1, Search: suite.search, CreatedAt: time.Now()}
+}
+
+func (suite *SearchTestSuite) trials(steps ...string) {
+	suite.task.Edges.Modelings = []*ent.Modeling{}
+	for i, step := range steps {
+		suite.task.Edges.Modelings = append(suite.task.Edges.Modelings,
+			&ent.Modeling{ID: 10 + i, SearchTrial: i + 1, ModelingStep: step})
+	}
+}
+
+func (suite *SearchTestSuite) TestValidate() {
+	suite.NoError(validateSearch(suite.search))
+
+	suite.search.MaxTrials = 0
+	suite.Error(validateSearch(suite.search))
+	suite.search.Strategy = utils.SEARCH_STRATEGY_GRID
+	suite.NoError(validateSearch(suite.search))
+
+	suite.search.Space = append(suite.search.Space, schema.HPParam{Name: "lr", Type: utils.SEARCH_PARAM_FLOAT, Max: 1})
+	suite.Error(validateSearch(suite.search))
+	suite.search.Space[3] = schema.HPParam{Name: "wd", Type: utils.SEARCH_PARAM_LOG, Min: 0, Max: 1}
+	suite.Error(validateSearch(suite.search))
+}
+
+func (suite *SearchTestSuite) TestGrid() {
+	suite.search.Strategy = utils.SEARCH_STRATEGY_GRID
+	suite.search.MaxTrials = 0
+	suite.Equal(3*5*2, searchBudget(suite.search))
+
+	seen := make(map[string]bool)
+	for trial := 1; trial <= searchBudget(suite.search); trial++ {
+		config := proposeSearchTrial(suite.search, trial, nil)
+		key := ""
+		for _, p := range suite.search.Space {
+			key += "/" + fmt.Sprint(config[p.Name])
+		}
+		suite.False(seen[key], key)
+		seen[key] = true
+	}
+	first := proposeSearchTrial(suite.search, 1, nil)
+	suite.Equal(10, first["epochs"])
+	suite.InDelta(1e-4, first["lr"], 1e-12)
+	suite.Equal("adam", first["optimizer"])
+
+	suite.search.MaxTrials = 4
+	suite.Equal(4, searchBudget(suite.search))
+}
+
+func (suite *SearchTestSuite) TestRandom() {
+	config := proposeSearchTrial(suite.search, 2, nil)
+	suite.Equal(config, proposeSearchTrial(suite.search, 2, nil))
+
+	for trial := 1; trial <= 50; trial++ {
+		config := proposeSearchTrial(suite.search, trial, nil)
+		suite.GreaterOrEqual(config["epochs"], 10)
+		suite.LessOrEqual(config["epochs"], 30)
+		suite.GreaterOrEqual(config["lr"], 1e-4)
+		suite.LessOrEqual(config["lr"], 1e-1)
+		suite.Contains(suite.search.Space[2].Choices, config["optimizer"])
+	}
+}
+
+func (suite *SearchTestSuite) TestTPE() {
+	suite.search.Strategy = utils.SEARCH_STRATEGY_TPE
+	// lr이 작고 adam일수록 score가 높다.
+	observed := []searchObservation{}
+	for trial := 1; trial <= 20; trial++ {
+		config := proposeSearchTrial(suite.search, trial, nil)
+		score := -config["lr"].(float64)
+		if config["optimizer"] == "adam" {
+			score += 1
+		}
+		observed = append(observed, searchObservation{config: config, score: score})
+	}
+
+	adam := 0
+	for trial := 21; trial <= 40; trial++ {
+		config := proposeSearchTrial(suite.search, trial, observed)
+		if config["optimizer"] == "adam" {
+			adam++
+		}
+	}
+	suite.Greater(adam, 10)
+}
+
+func (suite *SearchTestSuite) TestBudget() {
+	suite.trials(utils.MODELING_STEP_RUN)
+	status, propose, stop := searchNext(suite.task, 0, time.Now())
+	suite.Equal("", status)
+	suite.Equal(1, propose)
+	suite.Empty(stop)
+
+	suite.trials(utils.MODELING_STEP_COMPLETE, utils.MODELING_STEP_RUN)
+	_, propose, _ = searchNext(suite.task, 1, time.Now())
+	suite.Equal(1, propose)
+
+	suite.trials(utils.MODELING_STEP_COMPLETE, utils.MODELING_STEP_FAIL, utils.MODELING_STEP_RUN)
+	status, propose, _ = searchNext(suite.task, 1, time.Now())
+	suite.Equal("", status)
+	suite.Equal(0, propose)
+
+	suite.trials(utils.MODELING_STEP_COMPLETE, utils.MODELING_STEP_FAIL, utils.MODELING_STEP_COMPLETE)
+	status, _, _ = searchNext(suite.task, 2, time.Now())
+	suite.Equal(utils.SEARCH_STATUS_COMPLETE, status)
+
+	suite.trials(utils.MODELING_STEP_FAIL, utils.MODELING_STEP_FAIL, utils.MODELING_STEP_FAIL)
+	status, _, _ = searchNext(suite.task, 0, time.Now())
+	suite.Equal(utils.SEARCH_STATUS_FAIL, status)
+}
+
+func (suite *SearchTestSuite) TestWallTime() {
+	suite.search.MaxDuration = 60
+	suite.trials(utils.MODELING_STEP_COMPLETE, utils.MODELING_STEP_RUN)
+
+	status, _, stop := searchNext(suite.task, 1, time.Now().Add(2*time.Minute))
+	suite.Equal(utils.SEARCH_STATUS_COMPLETE, status)
+	suite.Len(stop, 1)
+	suite.Equal(11, stop[0].ID)
+
+	suite.trials(utils.MODELING_STEP_CANCEL, utils.MODELING_STEP_IDLE)
+	status, _, stop = searchNext(suite.task, 0, time.Now())
+	suite.Equal(utils.SEARCH_STATUS_CANCEL, status)
+	suite.Len(stop, 1)
+}
+
+func TestSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
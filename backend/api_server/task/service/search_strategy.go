@@ -0,0 +1,305 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"api_server/ent/schema"
+	"api_server/utils"
+)
+
+const (
+	gridDefaultPoints = 5       // step이 없는 float/log param의 grid 값 개수
+	gridMaxSize       = 1 << 20 // grid 조합 수 상한, 넘으면 max_trials로 잘라 쓴다
+	tpeStartupTrials  = 5       // 이 수만큼 결과가 모이기 전에는 random으로 탐색한다
+	tpeGamma          = 0.25    // 상위 trial 비율
+	tpeCandidates     = 24      // trial 하나를 고를 때 비교하는 후보 수
+	tpeBandwidth      = 0.15    // [0, 1]로 정규화한 공간에서의 kernel 폭
+	tpePriorWeight    = 1.0     // 균등 분포 prior의 가중치
+)
+
+// searchObservation은 탐색 전략이 참고하는 끝난 trial의 설정과 metric입니다.
+type searchObservation struct {
+	config map[string]interface{}
+	score  float64
+}
+
+// validateSearch는 task에 선언된 탐색 설정을 확인합니다.
+func validateSearch(search *schema.HPSearch) error {
+	switch search.Strategy {
+	case utils.SEARCH_STRATEGY_GRID, utils.SEARCH_STRATEGY_RANDOM, utils.SEARCH_STRATEGY_TPE:
+	default:
+		return fmt.Errorf("invalid strategy: %s", search.Strategy)
+	}
+	if search.Direction != "" && search.Direction != utils.SEARCH_DIRECTION_MAX && search.Direction != utils.SEARCH_DIRECTION_MIN {
+		return fmt.Errorf("invalid direction: %s", search.Direction)
+	}
+	if search.MaxTrials < 0 || search.MaxDuration < 0 || search.Parallel < 0 {
+		return errors.New("max_trials, max_duration and parallel must not be negative")
+	}
+	if search.MaxTrials == 0 && search.Strategy != utils.SEARCH_STRATEGY_GRID {
+		return errors.New("max_trials is required")
+	}
+	if len(search.Space) < 1 {
+		return errors.New("empty search space")
+	}
+
+	names := make(map[string]bool)
+	for _, p := range search.Space {
+		if p.Name == "" || names[p.Name] {
+			return fmt.Errorf("invalid or duplicated param name: %q", p.Name)
+		}
+		names[p.Name] = true
+
+		switch p.Type {
+		case utils.SEARCH_PARAM_CHOICE:
+			if len(p.Choices) < 1 {
+				return fmt.Errorf("%s: empty choices", p.Name)
+			}
+		case utils.SEARCH_PARAM_INT, utils.SEARCH_PARAM_FLOAT, utils.SEARCH_PARAM_LOG:
+			if p.Min > p.Max || p.Step < 0 {
+				return fmt.Errorf("%s: invalid range", p.Name)
+			}
+			if p.Type == utils.SEARCH_PARAM_LOG && p.Min <= 0 {
+				return fmt.Errorf("%s: log range must be positive", p.Name)
+			}
+		default:
+			return fmt.Errorf("%s: invalid type %s", p.Name, p.Type)
+		}
+	}
+
+	return nil
+}
+
+// searchBudget은 만들 수 있는 최대 trial 수입니다. grid는 조합 수를 넘지 않습니다.
+func searchBudget(search *schema.HPSearch) int {
+	if search.Strategy != utils.SEARCH_STRATEGY_GRID {
+		return search.MaxTrials
+	}
+
+	size := 1
+	for _, p := range search.Space {
+		size *= min(gridCount(p), gridMaxSize)
+		if size > gridMaxSize {
+			size = gridMaxSize
+		}
+	}
+	if search.MaxTrials > 0 && search.MaxTrials < size {
+		return search.MaxTrials
+	}
+
+	return size
+}
+
+// proposeSearchTrial은 trial 번째(1부터) trial의 설정을 제안합니다.
+// random/tpe는 seed와 trial 번호로 난수를 만들기 때문에 같은 입력이면 같은 설정을 제안합니다.
+func proposeSearchTrial(search *schema.HPSearch, trial int, observed []searchObservation) map[string]interface{} {
+	rng := rand.New(rand.NewSource(search.Seed*1000003 + int64(trial)))
+
+	switch search.Strategy {
+	case utils.SEARCH_STRATEGY_GRID:
+		return gridConfig(search.Space, trial-1)
+	case utils.SEARCH_STRATEGY_TPE:
+		if len(observed) >= tpeStartupTrials {
+			return tpeConfig(search, observed, rng)
+		}
+	}
+
+	config := make(map[string]interface{})
+	for _, p := range search.Space {
+		config[p.Name] = fromUnit(p, rng.Float64())
+	}
+
+	return config
+}
+
+// gridCount는 param 하나의 grid 값 개수입니다.
+func gridCount(p schema.HPParam) int {
+	switch p.Type {
+	case utils.SEARCH_PARAM_CHOICE:
+		return len(p.Choices)
+	case utils.SEARCH_PARAM_INT:
+		step := p.Step
+		if step == 0 {
+			step = 1
+		}
+		return int(math.Floor((p.Max-p.Min)/step+1e-9)) + 1
+	case utils.SEARCH_PARAM_LOG:
+		if p.Step == 0 {
+			return gridDefaultPoints
+		}
+		return int(math.Floor((math.Log10(p.Max)-math.Log10(p.Min))/p.Step+1e-9)) + 1
+	default:
+		if p.Step == 0 {
+			return gridDefaultPoints
+		}
+		return int(math.Floor((p.Max-p.Min)/p.Step+1e-9)) + 1
+	}
+}
+
+// gridValue는 param 하나의 k번째 grid 값입니다.
+func gridValue(p schema.HPParam, k int) interface{} {
+	switch p.Type {
+	case utils.SEARCH_PARAM_CHOICE:
+		return p.Choices[k]
+	case utils.SEARCH_PARAM_INT:
+		step := p.Step
+		if step == 0 {
+			step = 1
+		}
+		return int(math.Round(p.Min + float64(k)*step))
+	case utils.SEARCH_PARAM_LOG:
+		if p.Step == 0 {
+			return fromUnit(p, float64(k)/float64(gridDefaultPoints-1))
+		}
+		return math.Pow(10, math.Log10(p.Min)+float64(k)*p.Step)
+	default:
+		if p.Step == 0 {
+			return fromUnit(p, float64(k)/float64(gridDefaultPoints-1))
+		}
+		return p.Min + float64(k)*p.Step
+	}
+}
+
+// gridConfig는 index번째 grid 조합입니다. 앞쪽 param이 가장 빨리 바뀝니다.
+func gridConfig(space []schema.HPParam, index int) map[string]interface{} {
+	config := make(map[string]interface{})
+	for _, p := range space {
+		count := gridCount(p)
+		config[p.Name] = gridValue(p, index%count)
+		index /= count
+	}
+
+	return config
+}
+
+// toUnit은 param 값을 [0, 1]로 정규화합니다. choice는 후보의 index를 반환합니다.
+func toUnit(p schema.HPParam, value interface{}) float64 {
+	if p.Type == utils.SEARCH_PARAM_CHOICE {
+		for i, choice := range p.Choices {
+			if fmt.Sprint(choice) == fmt.Sprint(value) {
+				return float64(i)
+			}
+		}
+		return -1
+	}
+
+	v, ok := value.(float64)
+	if i, isInt := value.(int); isInt {
+		v, ok = float64(i), true
+	}
+	if !ok || p.Max == p.Min {
+		return 0
+	}
+	if p.Type == utils.SEARCH_PARAM_LOG {
+		return (math.Log(v) - math.Log(p.Min)) / (math.Log(p.Max) - math.Log(p.Min))
+	}
+
+	return (v - p.Min) / (p.Max - p.Min)
+}
+
+// fromUnit은 [0, 1] 값을 param 값으로 바꿉니다. choice는 u로 후보를 균등하게 고릅니다.
+func fromUnit(p schema.HPParam, u float64) interface{} {
+	u = math.Min(math.Max(u, 0), 1)
+
+	switch p.Type {
+	case utils.SEARCH_PARAM_CHOICE:
+		return p.Choices[int(math.Min(u*float64(len(p.Choices)), float64(len(p.Choices)-1)))]
+	case utils.SEARCH_PARAM_INT:
+		return int(math.Round(p.Min + u*(p.Max-p.Min)))
+	case utils.SEARCH_PARAM_LOG:
+		return math.Exp(math.Log(p.Min) + u*(math.Log(p.Max)-math.Log(p.Min)))
+	default:
+		return p.Min + u*(p.Max-p.Min)
+	}
+}
+
+// tpeConfig는 Tree-structured Parzen Estimator 방식으로 설정을 고릅니다.
+// 끝난 trial을 상위(good)와 나머지(bad)로 나누고, good 분포에서 뽑은 후보 중
+// l(x)/g(x)가 가장 큰 설정을 제안합니다.
+func tpeConfig(search *schema.HPSearch, observed []searchObservation, rng *rand.Rand) map[string]interface{} {
+	sorted := append([]searchObservation{}, observed...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if search.Direction == utils.SEARCH_DIRECTION_MIN {
+			return sorted[i].score < sorted[j].score
+		}
+		return sorted[i].score > sorted[j].score
+	})
+	nGood := int(math.Ceil(tpeGamma * float64(len(sorted))))
+	good, bad := sorted[:nGood], sorted[nGood:]
+
+	var best map[string]interface{}
+	bestScore := math.Inf(-1)
+	for i := 0; i < tpeCandidates; i++ {
+		candidate := make(map[string]interface{})
+		score := 0.0
+		for _, p := range search.Space {
+			var value interface{}
+			if p.Type == utils.SEARCH_PARAM_CHOICE {
+				value = p.Choices[sampleChoice(p, good, rng)]
+			} else {
+				center := toUnit(p, good[rng.Intn(len(good))].config[p.Name])
+				value = fromUnit(p, center+rng.NormFloat64()*tpeBandwidth)
+			}
+			candidate[p.Name] = value
+			score += math.Log(parzen(p, value, good)) - math.Log(parzen(p, value, bad))
+		}
+		if score > bestScore {
+			best, bestScore = candidate, score
+		}
+	}
+
+	return best
+}
+
+// sampleChoice는 good trial에서 많이 쓰인 후보를 더 자주 고릅니다.
+func sampleChoice(p schema.HPParam, good []searchObservation, rng *rand.Rand) int {
+	weights := make([]float64, len(p.Choices))
+	total := 0.0
+	for i := range weights {
+		weights[i] = tpePriorWeight
+		for _, o := range good {
+			if int(toUnit(p, o.config[p.Name])) == i {
+				weights[i]++
+			}
+		}
+		total += weights[i]
+	}
+
+	r := rng.Float64() * total
+	for i, w := range weights {
+		if r < w {
+			return i
+		}
+		r -= w
+	}
+
+	return len(weights) - 1
+}
+
+// parzen은 observations로 만든 밀도 추정에서 value의 밀도입니다.
+// 균등 분포 prior를 섞어 관측이 적거나 없어도 0이 되지 않습니다.
+func parzen(p schema.HPParam, value interface{}, observations []searchObservation) float64 {
+	n := float64(len(observations))
+	if p.Type == utils.SEARCH_PARAM_CHOICE {
+		count := 0.0
+		for _, o := range observations {
+			if toUnit(p, o.config[p.Name]) == toUnit(p, value) {
+				count++
+			}
+		}
+		return (count + tpePriorWeight) / (n + tpePriorWeight*float64(len(p.Choices)))
+	}
+
+	u := toUnit(p, value)
+	density := tpePriorWeight
+	for _, o := range observations {
+		d := (u - toUnit(p, o.config[p.Name])) / tpeBandwidth
+		density += math.Exp(-d*d/2) / (tpeBandwidth * math.Sqrt(2*math.Pi))
+	}
+
+	return density / (n + tpePriorWeight)
+}
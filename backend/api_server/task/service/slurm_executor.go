@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"api_server/ent"
+	"api_server/logger"
+	repo "api_server/task/repository"
+	"api_server/utils"
+)
+
+// SlurmExecutor는 modeling을 slurm batch job으로 실행합니다.
+// job script는 `--modeling_id=<id> --job=<train|evaluation|blind|finish>` 인자를 받아 engine을 실행해야 합니다.
+// GPU는 slurm이 할당하므로 KAI.S의 GPU 정보는 사용하지 않습니다.
+type SlurmExecutor struct {
+	ctx        context.Context
+	slurm      *utils.Slurm
+	dao        repo.IModelingDAO
+	scriptPath string
+	partition  string
+}
+
+func newSlurmExecutor(dao repo.IModelingDAO, scriptPath string, partition string) *SlurmExecutor {
+	return &SlurmExecutor{
+		ctx:        context.Background(),
+		slurm:      utils.NewSlurm(),
+		dao:        dao,
+		scriptPath: scriptPath,
+		partition:  partition,
+	}
+}
+
+func (executor *SlurmExecutor) Name() string {
+	return utils.EXECUTOR_SLURM
+}
+
+func (executor *SlurmExecutor) Submit(task *ent.Modeling, job string) error {
+	if executor.scriptPath == "" {
+		return errors.New("slurm script path is not configured")
+	}
+
+	options := []string{
+		fmt.Sprintf("--job-name=kais-%d-%s", task.ID, job),
+		fmt.Sprintf("--gres=gpu:%d", slurmGPUCount(task)),
+	}
+	if executor.partition != "" {
+		options = append(options, "--partition="+executor.partition)
+	}
+	args := []string{
+		"--modeling_id=" + strconv.Itoa(task.ID),
+		"--job=" + job,
+	}
+
+	jobID, err := executor.slurm.SbatchWithOptions(options, executor.scriptPath, args)
+	if err != nil {
+		return err
+	}
+	logger.Debug(fmt.Sprintf(`{"modeling_id": %d, "job_id": %s}`, task.ID, jobID))
+
+	if err := executor.dao.UpdateJob(executor.ctx, task.ID, executor.Name(), jobID, job); err != nil {
+		return err
+	}
+	task.JobID = jobID
+	task.JobType = job
+
+	// finish job은 scheduler가 request로 바꾸지 않으므로 여기서 바꿔 중복 제출을 막는다.
+	if job == utils.EXECUTOR_JOB_FINISH {
+		return executor.dao.UpdateState(executor.ctx, task.ID, utils.MODELING_STEP_REQUEST)
+	}
+
+	return nil
+}
+
+func (executor *SlurmExecutor) Cancel(task *ent.Modeling) error {
+	if task.JobID == "" {
+		return nil
+	}
+
+	return executor.slurm.Scancel(task.JobID)
+}
+
+func (executor *SlurmExecutor) Poll(task *ent.Modeling) (string, error) {
+	if task.JobID == "" {
+		return "", nil
+	}
+
+	state, err := executor.slurm.JobState(task.JobID)
+	if err != nil {
+		return "", err
+	}
+
+	return slurmStateToStep(state, task.JobType), nil
+}
+
+// slurmStateToStep는 slurm job 상태를 MODELING_STEP_* 로 변환합니다.
+// 학습 job이 끝나면 finish(후처리 대기), 그 외 job이 끝나면 complete가 됩니다.
+func slurmStateToStep(state string, job string) string {
+	switch state {
+	case utils.SLURM_STATE_PENDING, "CONFIGURING", "REQUEUED", "RESIZING", "SUSPENDED":
+		return utils.MODELING_STEP_REQUEST
+	case utils.SLURM_STATE_RUNNING, "COMPLETING", "STAGE_OUT":
+		return utils.MODELING_STEP_RUN
+	case utils.SLURM_STATE_COMPLETED:
+		if job == utils.EXECUTOR_JOB_TRAIN {
+			return utils.MODELING_STEP_FINISH
+		}
+		return utils.MODELING_STEP_COMPLETE
+	case utils.SLURM_STATE_CANCELLED, "PREEMPTED", "REVOKED":
+		return utils.MODELING_STEP_CANCEL
+	case utils.SLURM_STATE_FAILED, "TIMEOUT", "NODE_FAIL", "OUT_OF_MEMORY", "BOOT_FAIL", "DEADLINE", "SPECIAL_EXIT":
+		return utils.MODELING_STEP_FAIL
+	default:
+		return ""
+	}
+}
+
+func slurmGPUCount(task *ent.Modeling) int {
+	engineParams := repo.EngineParams{}
+	if len(task.Params) > 0 {
+		if err := json.Unmarshal([]byte(task.Params[0]), &engineParams); err == nil {
+			if engineParams.GPUCount > 0 {
+				return engineParams.GPUCount
+			} else if len(engineParams.DeviceIDs) > 0 {
+				return len(engineParams.DeviceIDs)
+			}
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"api_server/ent"
+	"api_server/logger"
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeJobDAO는 slurm executor가 사용하는 IModelingDAO 메서드만 기록합니다.
+type fakeJobDAO struct {
+	repo.IModelingDAO
+	jobs  map[int]string
+	steps map[int]string
+}
+
+func (dao *fakeJobDAO) UpdateJob(ctx context.Context, modeling_id int, executor string, job_id string, job_type string) error {
+	dao.jobs[modeling_id] = executor + ":" + job_id + ":" + job_type
+	return nil
+}
+
+func (dao *fakeJobDAO) UpdateState(ctx context.Context, modeling_id int, state string) error {
+	dao.steps[modeling_id] = state
+	return nil
+}
+
+type SlurmExecutorTestSuite struct {
+	suite.Suite
+	bin string
+	dao *fakeJobDAO
+}
+
+func (suite *SlurmExecutorTestSuite) SetupTest() {
+	logger.InitLogger("", "/workspace/log/test.log")
+
+	suite.bin = suite.T().TempDir()
+	suite.T().Setenv("PATH", suite.bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+	suite.dao = &fakeJobDAO{jobs: map[int]string{}, steps: map[int]string{}}
+
+	suite.script("sbatch", `echo "$@" > "$(dirname "$0")/sbatch.args"; echo "Submitted batch job 42"`)
+	suite.script("scancel", `echo "$1" > "$(dirname "$0")/scancel.args"`)
+	suite.script("sacct", `echo "CANCELLED by 1000"`)
+}
+
+// script는 PATH 앞에 가짜 slurm 명령을 만듭니다.
+func (suite *SlurmExecutorTestSuite) script(name string, body string) {
+	path := filepath.Join(suite.bin, name)
+	suite.Require().NoError(os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0755))
+}
+
+func (suite *SlurmExecutorTestSuite) read(name string) string {
+	data, err := os.ReadFile(filepath.Join(suite.bin, name))
+	suite.Require().NoError(err)
+	return string(data)
+}
+
+func (suite *SlurmExecutorTestSuite) TestSubmit() {
+	executor := newSlurmExecutor(suite.dao, "/kaier/slurm/run.sh", "gpu")
+	task := &ent.Modeling{ID: 7, Params: []string{`{"gpu_count":2}`}}
+
+	suite.NoError(executor.Submit(task, utils.EXECUTOR_JOB_TRAIN))
+	suite.Equal("slurm:42:train", suite.dao.jobs[7])
+	suite.Equal("42", task.JobID)
+	suite.Equal("--job-name=kais-7-train --gres=gpu:2 --partition=gpu /kaier/slurm/run.sh --modeling_id=7 --job=train\n",
+		suite.read("sbatch.args"))
+
+	suite.NoError(executor.Submit(task, utils.EXECUTOR_JOB_FINISH))
+	suite.Equal(utils.MODELING_STEP_REQUEST, suite.dao.steps[7])
+}
+
+func (suite *SlurmExecutorTestSuite) TestPoll() {
+	executor := newSlurmExecutor(suite.dao, "/kaier/slurm/run.sh", "")
+	task := &ent.Modeling{ID: 7, JobID: "42", JobType: utils.EXECUTOR_JOB_TRAIN}
+
+	suite.script("squeue", `echo "RUNNING"`)
+	step, err := executor.Poll(task)
+	suite.NoError(err)
+	suite.Equal(utils.MODELING_STEP_RUN, step)
+
+	suite.script("squeue", `exit 0`)
+	step, err = executor.Poll(task)
+	suite.NoError(err)
+	suite.Equal(utils.MODELING_STEP_CANCEL, step)
+
+	suite.script("sacct", `echo "COMPLETED"`)
+	step, _ = executor.Poll(task)
+	suite.Equal(utils.MODELING_STEP_FINISH, step)
+
+	task.JobType = utils.EXECUTOR_JOB_EVALUATION
+	step, _ = executor.Poll(task)
+	suite.Equal(utils.MODELING_STEP_COMPLETE, step)
+
+	suite.script("sacct", `echo "OUT_OF_MEMORY"`)
+	step, _ = executor.Poll(task)
+	suite.Equal(utils.MODELING_STEP_FAIL, step)
+}
+
+func (suite *SlurmExecutorTestSuite) TestCancel() {
+	executor := newSlurmExecutor(suite.dao, "/kaier/slurm/run.sh", "")
+
+	suite.NoError(executor.Cancel(&ent.Modeling{ID: 7, JobID: "42"}))
+	suite.Equal("42\n", suite.read("scancel.args"))
+}
+
+func TestSlurmExecutorTestSuite(t *testing.T) {
+	suite.Run(t, new(SlurmExecutorTestSuite))
+}
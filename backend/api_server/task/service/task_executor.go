@@ -0,0 +1,62 @@
+package service
+
+import (
+	"encoding/json"
+
+	"api_server/ent"
+	repo "api_server/task/repository"
+	"api_server/utils"
+)
+
+// ITaskExecutor는 scheduler가 modeling을 실제로 실행시키는 backend입니다.
+// scheduler는 실행 순서만 결정하고, 실행/취소/상태 조회는 executor에 위임합니다.
+type ITaskExecutor interface {
+	// Name 함수는 executor 이름(modeling.executor 값)을 반환합니다.
+	Name() string
+
+	// Submit 함수는 modeling을 실행 요청합니다.
+	//
+	// 매개변수:
+	//   - task: 실행할 modeling
+	//   - job: 실행할 작업 종류 (utils.EXECUTOR_JOB_*)
+	//
+	// 반환값:
+	//   - error: 자원이 부족하거나 요청에 실패한 경우
+	Submit(task *ent.Modeling, job string) error
+
+	// Cancel 함수는 실행 중인 modeling을 중지합니다.
+	//
+	// 매개변수:
+	//   - task: 중지할 modeling
+	//
+	// 반환값:
+	//   - error: 중지 요청에 실패한 경우
+	Cancel(task *ent.Modeling) error
+
+	// Poll 함수는 executor에서 modeling의 현재 단계를 조회합니다.
+	// 엔진이 직접 상태를 기록하는 executor는 빈 문자열을 반환합니다.
+	//
+	// 매개변수:
+	//   - task: 조회할 modeling
+	//
+	// 반환값:
+	//   - string: utils.MODELING_STEP_* 값, 변경이 없거나 알 수 없으면 빈 문자열
+	//   - error: 조회에 실패한 경우
+	Poll(task *ent.Modeling) (string, error)
+}
+
+// executorName는 modeling이 사용할 executor 이름을 params에서 읽습니다.
+// 지정되지 않았으면 def를 사용합니다.
+func executorName(task *ent.Modeling, def string) string {
+	engineParams := repo.EngineParams{}
+	if len(task.Params) > 0 {
+		if err := json.Unmarshal([]byte(task.Params[0]), &engineParams); err == nil && engineParams.Executor != "" {
+			return engineParams.Executor
+		}
+	}
+	if def == "" {
+		return utils.EXECUTOR_REST
+	}
+
+	return def
+}
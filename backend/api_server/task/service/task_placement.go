@@ -0,0 +1,237 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	engine_client "api_server/engine/client"
+	"api_server/ent"
+	repo "api_server/task/repository"
+)
+
+// placementNode는 modeling 하나가 한 device(engine)에 배치된 정보입니다.
+type placementNode struct {
+	Rank       int    `json:"rank"`
+	DeviceID   int    `json:"device_id"`
+	IP         string `json:"ip"`
+	Port       int    `json:"port"`
+	DeviceIDs  []int  `json:"device_ids"`
+	Connection string `json:"-"` // engine 호출 방법, engine에 보내지 않는다.
+}
+
+func (node *placementNode) addr() string {
+	return node.engine().Addr()
+}
+
+func (node *placementNode) engine() engine_client.Engine {
+	return engine_client.ForDevice(node.IP, node.Port, node.Connection, engine_client.DefaultTimeout)
+}
+
+// placement는 modeling 하나가 배치된 device들입니다. rank 0 node가 master입니다.
+type placement struct {
+	modelingID int
+	masterPort int
+	nodes      []*placementNode
+}
+
+// newPlacement는 GPU와 device 목록으로 placement를 만듭니다.
+// GPU가 많이 배치된 device가 낮은 rank를 받습니다.
+func newPlacement(modelingID int, gpus []*ent.Gpu, devices []*ent.Device) (*placement, error) {
+	deviceMap := make(map[int]*ent.Device)
+	for _, device := range devices {
+		deviceMap[device.ID] = device
+	}
+
+	nodeMap := make(map[int]*placementNode)
+	nodes := []*placementNode{}
+	for _, gpu := range gpus {
+		node, ok := nodeMap[gpu.DeviceID]
+		if !ok {
+			device, exist := deviceMap[gpu.DeviceID]
+			if !exist {
+				return nil, fmt.Errorf("device of gpu %d does not exist", gpu.ID)
+			}
+			node = &placementNode{DeviceID: device.ID, IP: device.IP, Port: device.Port, Connection: device.Connection}
+			nodeMap[gpu.DeviceID] = node
+			nodes = append(nodes, node)
+		}
+		node.DeviceIDs = append(node.DeviceIDs, gpu.ID)
+	}
+
+	if len(nodes) < 1 {
+		return nil, errNotEnoughGPUs
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if len(nodes[i].DeviceIDs) != len(nodes[j].DeviceIDs) {
+			return len(nodes[i].DeviceIDs) > len(nodes[j].DeviceIDs)
+		}
+		return nodes[i].DeviceID < nodes[j].DeviceID
+	})
+	for i, node := range nodes {
+		node.Rank = i
+	}
+
+	return &placement{modelingID: modelingID, nodes: nodes}, nil
+}
+
+func (p *placement) isMultiNode() bool {
+	return len(p.nodes) > 1
+}
+
+func (p *placement) master() *placementNode {
+	return p.nodes[0]
+}
+
+func (p *placement) gpuIDs() []int {
+	ids := []int{}
+	for _, node := range p.nodes {
+		ids = append(ids, node.DeviceIDs...)
+	}
+
+	return ids
+}
+
+// multiNodeRequest는 여러 engine이 함께 학습할 때 각 engine에 보내는 요청입니다.
+type multiNodeRequest struct {
+	ModelingID int              `json:"modeling_id"`
+	Rank       int              `json:"rank"`
+	WorldSize  int              `json:"world_size"`
+	MasterAddr string           `json:"master_addr"`
+	MasterPort int              `json:"master_port"`
+	DeviceIDs  []int            `json:"device_ids"`
+	Nodes      []*placementNode `json:"nodes"`
+}
+
+// body는 node에 보낼 요청 본문을 만듭니다. 단일 node면 기존과 같이 modeling_id만 보냅니다.
+func (p *placement) body(node *placementNode) interface{} {
+	if !p.isMultiNode() {
+		return engine_client.ModelingRequest{ModelingID: p.modelingID}
+	}
+
+	return multiNodeRequest{
+		ModelingID: p.modelingID,
+		Rank:       node.Rank,
+		WorldSize:  len(p.nodes),
+		MasterAddr: p.master().IP,
+		MasterPort: p.masterPort,
+		DeviceIDs:  node.DeviceIDs,
+		Nodes:      p.nodes,
+	}
+}
+
+// gpuRequest는 modeling 하나가 요청한 GPU 조건입니다.
+type gpuRequest struct {
+	count         int     // 필요한 GPU 수
+	minFreeMemory float64 // GPU 당 필요한 여유 메모리(MiB), 0이면 검사하지 않음
+	multiNode     bool    // 여러 device에 나누어 배치할 수 있는지
+}
+
+func newGPURequest(engineParams repo.EngineParams) gpuRequest {
+	return gpuRequest{
+		count:         engineParams.GPUCount,
+		minFreeMemory: engineParams.MinFreeMemory,
+		multiNode:     engineParams.MultiNode && !engineParams.SameDevice,
+	}
+}
+
+// freeMemory는 engine이 보고한 GPU 여유 메모리(MiB)입니다.
+func freeMemory(gpu *ent.Gpu) float64 {
+	return gpu.MemoryTotal - gpu.MemoryUsed
+}
+
+// fits는 GPU가 요청한 여유 메모리를 만족하는지 확인합니다.
+// 메모리 정보를 보고하지 않은 GPU는 메모리 조건이 있는 요청에 사용하지 않습니다.
+func (req gpuRequest) fits(gpu *ent.Gpu) bool {
+	if req.minFreeMemory <= 0 {
+		return true
+	}
+
+	return gpu.MemoryTotal > 0 && freeMemory(gpu) >= req.minFreeMemory
+}
+
+// less는 한 device 안에서 GPU를 고르는 순서입니다.
+// 메모리 조건이 있으면 조건을 겨우 만족하는 GPU부터(best-fit), 없으면 사용률이 낮은 GPU부터 고릅니다.
+func (req gpuRequest) less(a *ent.Gpu, b *ent.Gpu) bool {
+	if req.minFreeMemory > 0 && freeMemory(a) != freeMemory(b) {
+		return freeMemory(a) < freeMemory(b)
+	}
+	if a.UtilizationGpu != b.UtilizationGpu {
+		return a.UtilizationGpu < b.UtilizationGpu
+	}
+	if freeMemory(a) != freeMemory(b) {
+		return freeMemory(a) > freeMemory(b)
+	}
+
+	return a.ID < b.ID
+}
+
+// selectGPUs는 idle GPU 중에서 조건에 맞는 GPU를 count개 고릅니다.
+//
+// 한 device에서 모두 할당할 수 있으면 후보 GPU가 가장 적은 device를 사용합니다(best-fit bin-packing).
+// 작은 작업이 이미 일부 사용 중인 device를 채우므로 큰 작업을 위한 빈 device가 남습니다.
+// 그렇지 않으면 multiNode일 때만 후보 GPU가 많은 device부터 나누어 할당합니다.
+func selectGPUs(idle []*ent.Gpu, req gpuRequest) ([]*ent.Gpu, error) {
+	count := req.count
+	if count < 1 {
+		count = 1
+	}
+	if len(idle) < 1 {
+		return nil, errNoIdleGPU
+	}
+
+	byDevice := make(map[int][]*ent.Gpu)
+	deviceIDs := []int{}
+	total := 0
+	for _, gpu := range idle {
+		if !req.fits(gpu) {
+			continue
+		}
+		if _, ok := byDevice[gpu.DeviceID]; !ok {
+			deviceIDs = append(deviceIDs, gpu.DeviceID)
+		}
+		byDevice[gpu.DeviceID] = append(byDevice[gpu.DeviceID], gpu)
+		total++
+	}
+	for _, id := range deviceIDs {
+		sort.SliceStable(byDevice[id], func(i, j int) bool {
+			return req.less(byDevice[id][i], byDevice[id][j])
+		})
+	}
+
+	// 후보 GPU가 적은 device부터, 같으면 device ID 순서
+	sort.SliceStable(deviceIDs, func(i, j int) bool {
+		if len(byDevice[deviceIDs[i]]) != len(byDevice[deviceIDs[j]]) {
+			return len(byDevice[deviceIDs[i]]) < len(byDevice[deviceIDs[j]])
+		}
+		return deviceIDs[i] < deviceIDs[j]
+	})
+	for _, id := range deviceIDs {
+		if len(byDevice[id]) >= count {
+			return byDevice[id][:count], nil
+		}
+	}
+
+	if !req.multiNode || total < count {
+		return nil, errNotEnoughGPUs
+	}
+
+	// 후보 GPU가 많은 device부터 채워 node 수를 줄인다.
+	sort.SliceStable(deviceIDs, func(i, j int) bool {
+		if len(byDevice[deviceIDs[i]]) != len(byDevice[deviceIDs[j]]) {
+			return len(byDevice[deviceIDs[i]]) > len(byDevice[deviceIDs[j]])
+		}
+		return deviceIDs[i] < deviceIDs[j]
+	})
+	selected := []*ent.Gpu{}
+	for _, id := range deviceIDs {
+		for _, gpu := range byDevice[id] {
+			if len(selected) == count {
+				return selected, nil
+			}
+			selected = append(selected, gpu)
+		}
+	}
+
+	return selected, nil
+}
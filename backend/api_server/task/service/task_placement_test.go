@@ -0,0 +1,131 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+
+	"api_server/ent"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PlacementTestSuite struct {
+	suite.Suite
+}
+
+func gpuOn(id int, device int) *ent.Gpu {
+	return &ent.Gpu{ID: id, DeviceID: device, IsUse: true, State: "idle"}
+}
+
+func gpuIDsOf(gpus []*ent.Gpu) []int {
+	ids := []int{}
+	for _, gpu := range gpus {
+		ids = append(ids, gpu.ID)
+	}
+
+	return ids
+}
+
+func (suite *PlacementTestSuite) TestSingleDevicePreferred() {
+	idle := []*ent.Gpu{gpuOn(1, 1), gpuOn(2, 2), gpuOn(3, 2)}
+
+	gpus, err := selectGPUs(idle, gpuRequest{count: 2, multiNode: true})
+	suite.NoError(err)
+	suite.Equal([]int{2, 3}, gpuIDsOf(gpus))
+}
+
+func (suite *PlacementTestSuite) TestMultiNodeRequired() {
+	idle := []*ent.Gpu{gpuOn(1, 1), gpuOn(2, 2), gpuOn(3, 2)}
+
+	_, err := selectGPUs(idle, gpuRequest{count: 3, multiNode: false})
+	suite.ErrorIs(err, errNotEnoughGPUs)
+
+	gpus, err := selectGPUs(idle, gpuRequest{count: 3, multiNode: true})
+	suite.NoError(err)
+	suite.Equal([]int{2, 3, 1}, gpuIDsOf(gpus))
+
+	_, err = selectGPUs(nil, gpuRequest{count: 1, multiNode: true})
+	suite.ErrorIs(err, errNoIdleGPU)
+}
+
+func gpuWithMemory(id int, device int, total float64, used float64, utilization float64) *ent.Gpu {
+	gpu := gpuOn(id, device)
+	gpu.MemoryTotal = total
+	gpu.MemoryUsed = used
+	gpu.UtilizationGpu = utilization
+	return gpu
+}
+
+func (suite *PlacementTestSuite) TestBestFitDevice() {
+	// device 1은 GPU 1개만 남아 있으므로 1개짜리 작업은 device 1에 채운다.
+	idle := []*ent.Gpu{gpuOn(1, 2), gpuOn(2, 2), gpuOn(3, 2), gpuOn(4, 1)}
+
+	gpus, err := selectGPUs(idle, gpuRequest{count: 1})
+	suite.NoError(err)
+	suite.Equal([]int{4}, gpuIDsOf(gpus))
+
+	gpus, err = selectGPUs(idle, gpuRequest{count: 2})
+	suite.NoError(err)
+	suite.Equal([]int{1, 2}, gpuIDsOf(gpus))
+}
+
+func (suite *PlacementTestSuite) TestMinFreeMemory() {
+	idle := []*ent.Gpu{
+		gpuWithMemory(1, 1, 24000, 10000, 0), // 14000 free
+		gpuWithMemory(2, 1, 24000, 2000, 0),  // 22000 free
+		gpuWithMemory(3, 2, 40000, 0, 0),     // 40000 free
+		gpuWithMemory(4, 2, 40000, 15000, 0), // 25000 free
+		gpuOn(5, 3),                          // 메모리 정보 없음
+	}
+
+	gpus, err := selectGPUs(idle, gpuRequest{count: 1, minFreeMemory: 20000})
+	suite.NoError(err)
+	suite.Equal([]int{2}, gpuIDsOf(gpus))
+
+	gpus, err = selectGPUs(idle, gpuRequest{count: 2, minFreeMemory: 20000})
+	suite.NoError(err)
+	suite.Equal([]int{4, 3}, gpuIDsOf(gpus))
+
+	_, err = selectGPUs(idle, gpuRequest{count: 3, minFreeMemory: 20000})
+	suite.ErrorIs(err, errNotEnoughGPUs)
+
+	gpus, err = selectGPUs(idle, gpuRequest{count: 3, minFreeMemory: 20000, multiNode: true})
+	suite.NoError(err)
+	suite.Equal([]int{4, 3, 2}, gpuIDsOf(gpus))
+}
+
+func (suite *PlacementTestSuite) TestUtilization() {
+	idle := []*ent.Gpu{gpuWithMemory(1, 1, 24000, 0, 80), gpuWithMemory(2, 1, 24000, 0, 5)}
+
+	gpus, err := selectGPUs(idle, gpuRequest{count: 1})
+	suite.NoError(err)
+	suite.Equal([]int{2}, gpuIDsOf(gpus))
+}
+
+func (suite *PlacementTestSuite) TestMultiNodeRequest() {
+	devices := []*ent.Device{
+		{ID: 1, IP: "10.0.0.1", Port: 5000},
+		{ID: 2, IP: "10.0.0.2", Port: 5000},
+	}
+	p, err := newPlacement(7, []*ent.Gpu{gpuOn(1, 1), gpuOn(2, 2), gpuOn(3, 2)}, devices)
+	suite.NoError(err)
+	p.masterPort = 29500
+
+	suite.True(p.isMultiNode())
+	suite.Equal(2, p.master().DeviceID)
+	suite.Equal([]int{2, 3, 1}, p.gpuIDs())
+
+	body, _ := json.Marshal(p.body(p.nodes[1]))
+	req := multiNodeRequest{}
+	suite.NoError(json.Unmarshal(body, &req))
+	suite.Equal(7, req.ModelingID)
+	suite.Equal(1, req.Rank)
+	suite.Equal(2, req.WorldSize)
+	suite.Equal("10.0.0.2", req.MasterAddr)
+	suite.Equal([]int{1}, req.DeviceIDs)
+	suite.Len(req.Nodes, 2)
+}
+
+func TestPlacementTestSuite(t *testing.T) {
+	suite.Run(t, new(PlacementTestSuite))
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	config_service "api_server/configuration/service"
+	"api_server/ent"
+	"api_server/logger"
+	repo "api_server/task/repository"
+	"api_server/utils"
+)
+
+// victim은 preemption 후보인 실행 중 modeling과 그 modeling이 사용 중인 GPU입니다.
+type victim struct {
+	modeling *ent.Modeling
+	gpus     []*ent.Gpu
+}
+
+func (v *victim) gpuIDs() []int {
+	ids := []int{}
+	for _, gpu := range v.gpus {
+		ids = append(ids, gpu.ID)
+	}
+
+	return ids
+}
+
+// preempt는 GPU가 없어 실행하지 못한 modeling의 우선순위가 PREEMPTION_MIN_PRIORITY 이상이면
+// 필요한 GPU를 사용 중인 낮은 우선순위 modeling을 engine에서 취소하고 idle로 되돌립니다.
+// 0보다 높은 우선순위는 관리자만 줄 수 있으므로, PREEMPTION_MIN_PRIORITY가 0 이하여도 우선순위가 0보다 높아야 선점합니다.
+// 중단된 modeling은 원래 params와 대기 시각을 유지하므로 GPU가 생기면 다시 실행됩니다.
+// 모든 modeling을 중단했으면 task를 배치할 GPU ID를 반환하고, 아니면 nil을 반환합니다.
+func (scheduler *TaskScheduler) preempt(task *ent.Modeling) []int {
+	if task.Priority < max(config_service.NewStatic().GetInt("PREEMPTION_MIN_PRIORITY", 100), 1) {
+		return nil
+	}
+	if executorName(task, config_service.NewStatic().Get("DEFAULT_EXECUTOR")) != utils.EXECUTOR_REST {
+		return nil
+	}
+
+	engineParams := repo.EngineParams{}
+	if err := json.Unmarshal([]byte(task.Params[0]), &engineParams); err != nil {
+		return nil
+	}
+	manual := []int{}
+	if !engineParams.GPUAuto {
+		manual = engineParams.DeviceIDs
+	}
+
+	candidates, err := scheduler.victims(task.Priority)
+	if err != nil {
+		logger.Error(fmt.Sprintf("preemption for modeling %d: %v", task.ID, err))
+		return nil
+	}
+	idle, err := scheduler.dao_gpu.SelectIdle(scheduler.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return nil
+	}
+	// 다른 project에 예약된 GPU는 비워도 배치할 수 없다.
+	reserved, err := scheduler.reservedGPUs(task)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return nil
+	}
+	// task의 engine type을 실행할 수 없는 device의 GPU도 마찬가지다.
+	incompatible, err := scheduler.incompatibleDevices(task)
+	if err != nil {
+		logger.Debug(fmt.Sprintf("preemption for modeling %d: %v", task.ID, err))
+		return nil
+	}
+	idle = withoutDevices(withoutGPUs(idle, reserved), incompatible)
+	for _, candidate := range candidates {
+		candidate.gpus = withoutDevices(withoutGPUs(candidate.gpus, reserved), incompatible)
+	}
+
+	req := newGPURequest(engineParams)
+	victims := pickVictims(req, manual, idle, candidates)
+	if len(victims) < 1 {
+		return nil
+	}
+	gpuIDs := manual
+	if len(manual) < 1 {
+		gpus, err := selectGPUs(releaseGPUs(idle, victims), req)
+		if err != nil {
+			return nil
+		}
+		gpuIDs = []int{}
+		for _, gpu := range gpus {
+			gpuIDs = append(gpuIDs, gpu.ID)
+		}
+	}
+
+	stopped := true
+	for _, v := range victims {
+		if err := scheduler.executorOf(v.modeling).Cancel(v.modeling); err != nil {
+			logger.Error(fmt.Sprintf("preempt modeling %d: %v", v.modeling.ID, err))
+			stopped = false
+			continue
+		}
+		scheduler.requeue(v.modeling.ID)
+
+		record := repo.PreemptionDTO{
+			ModelingID:     task.ID,
+			Priority:       task.Priority,
+			Username:       task.Username,
+			VictimID:       v.modeling.ID,
+			VictimPriority: v.modeling.Priority,
+			VictimUsername: v.modeling.Username,
+			GPUIDs:         v.gpuIDs(),
+		}
+		if err := scheduler.dao_preemption.InsertOne(scheduler.ctx, record); err != nil {
+			logger.CreateReport(&logger.CODE_DB_INSERT, err)
+		}
+		logger.Warn(fmt.Sprintf("modeling %d (priority %d, %s) preempted modeling %d (priority %d, %s) on gpus %v",
+			task.ID, task.Priority, task.Username, v.modeling.ID, v.modeling.Priority, v.modeling.Username, v.gpuIDs()))
+	}
+	if !stopped {
+		return nil
+	}
+
+	return gpuIDs
+}
+
+// requeue는 engine에서 취소한 modeling을 idle로 되돌립니다.
+// REST engine은 취소 요청을 처리하면서 직접 cancel 단계를 기록하므로, 이미 cancel로 바뀌었어도 되돌리고
+// PREEMPTION_REQUEUE_WINDOW(초) 동안은 다음 스케줄링마다 cancel로 덮어쓴 단계를 다시 되돌립니다.
+// 그 사이 끝났거나 실패한 modeling은 그대로 둡니다.
+func (scheduler *TaskScheduler) requeue(modelingID int) {
+	requeued, err := scheduler.dao_modeling.UpdateStateFrom(scheduler.ctx, modelingID, utils.MODELING_STEP_IDLE,
+		utils.MODELING_STEP_REQUEST, utils.MODELING_STEP_RUN, utils.MODELING_STEP_CANCEL)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		return
+	}
+	if !requeued {
+		logger.Warn(fmt.Sprintf("modeling %d ended before it was preempted and is not requeued", modelingID))
+		return
+	}
+
+	window := time.Duration(config_service.NewStatic().GetInt("PREEMPTION_REQUEUE_WINDOW", 60)) * time.Second
+	scheduler.muRequeue.Lock()
+	scheduler.requeues[modelingID] = time.Now().Add(window)
+	scheduler.muRequeue.Unlock()
+}
+
+// forgetRequeue는 다시 실행되었거나 사용자가 취소한 modeling을 되돌릴 대상에서 뺍니다.
+func (scheduler *TaskScheduler) forgetRequeue(modelingID int) {
+	scheduler.muRequeue.Lock()
+	delete(scheduler.requeues, modelingID)
+	scheduler.muRequeue.Unlock()
+}
+
+// requeuePreempted는 preemption으로 되돌린 뒤 engine이 cancel로 덮어쓴 modeling을 다시 idle로 되돌립니다.
+func (scheduler *TaskScheduler) requeuePreempted() {
+	now := time.Now()
+	pending := []int{}
+	scheduler.muRequeue.Lock()
+	for id, until := range scheduler.requeues {
+		if now.After(until) {
+			delete(scheduler.requeues, id)
+			continue
+		}
+		pending = append(pending, id)
+	}
+	scheduler.muRequeue.Unlock()
+
+	for _, id := range pending {
+		if requeued, err := scheduler.dao_modeling.UpdateStateFrom(scheduler.ctx, id, utils.MODELING_STEP_IDLE, utils.MODELING_STEP_CANCEL); err != nil {
+			logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		} else if requeued {
+			logger.Debug(fmt.Sprintf("modeling %d was cancelled by the engine after preemption and is requeued", id))
+		}
+	}
+}
+
+// victims는 priority보다 낮은 우선순위로 실행 중인 REST executor modeling을 중단할 순서로 반환합니다.
+// 우선순위가 낮은 modeling, 같으면 나중에 등록된 modeling이 먼저입니다.
+func (scheduler *TaskScheduler) victims(priority int) ([]*victim, error) {
+	running, err := scheduler.dao_modeling.SelectManyRunning(scheduler.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []*victim{}
+	for _, task := range running {
+		if task.Priority >= priority || (task.Executor != "" && task.Executor != utils.EXECUTOR_REST) {
+			continue
+		}
+		engineParams := repo.EngineParams{}
+		if err := json.Unmarshal([]byte(task.Params[0]), &engineParams); err != nil || len(engineParams.DeviceIDs) < 1 {
+			continue
+		}
+		// drain 중인 device의 GPU는 비워도 배치할 수 없으므로 그 위의 modeling은 끝까지 실행한다.
+		if err := scheduler.checkDrain(engineParams.DeviceIDs); errors.Is(err, errDeviceDrain) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		gpus, err := scheduler.dao_gpu.SelectMany(scheduler.ctx, engineParams.DeviceIDs)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, &victim{modeling: task, gpus: gpus})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i].modeling, candidates[j].modeling
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return a.ID > b.ID
+	})
+
+	return candidates, nil
+}
+
+// pickVictims는 candidates 순서대로 중단했을 때 req를 배치할 수 있는 가장 적은 modeling들을 고릅니다.
+// manual이 있으면 사용자가 지정한 GPU를 사용 중인 modeling만 중단합니다. 배치할 수 없으면 nil 입니다.
+func pickVictims(req gpuRequest, manual []int, idle []*ent.Gpu, candidates []*victim) []*victim {
+	if len(manual) > 0 {
+		return pickManualVictims(manual, idle, candidates)
+	}
+
+	chosen := []*victim{}
+	for _, candidate := range candidates {
+		chosen = append(chosen, candidate)
+		if _, err := selectGPUs(releaseGPUs(idle, chosen), req); err == nil {
+			break
+		}
+	}
+	if _, err := selectGPUs(releaseGPUs(idle, chosen), req); err != nil {
+		return nil
+	}
+
+	// 배치에 필요 없는 modeling은 중단하지 않는다.
+	for i := 0; i < len(chosen); {
+		rest := append(append([]*victim{}, chosen[:i]...), chosen[i+1:]...)
+		if _, err := selectGPUs(releaseGPUs(idle, rest), req); err == nil {
+			chosen = rest
+		} else {
+			i++
+		}
+	}
+
+	return chosen
+}
+
+func pickManualVictims(manual []int, idle []*ent.Gpu, candidates []*victim) []*victim {
+	free := make(map[int]bool)
+	for _, gpu := range idle {
+		free[gpu.ID] = true
+	}
+	needed := make(map[int]bool)
+	for _, id := range manual {
+		needed[id] = true
+	}
+
+	chosen := []*victim{}
+	for _, candidate := range candidates {
+		for _, gpu := range candidate.gpus {
+			if needed[gpu.ID] {
+				chosen = append(chosen, candidate)
+				for _, released := range candidate.gpus {
+					free[released.ID] = true
+				}
+				break
+			}
+		}
+	}
+	for _, id := range manual {
+		if !free[id] {
+			return nil
+		}
+	}
+
+	return chosen
+}
+
+// releaseGPUs는 victims가 중단된 뒤의 idle GPU 목록입니다.
+func releaseGPUs(idle []*ent.Gpu, victims []*victim) []*ent.Gpu {
+	gpus := append([]*ent.Gpu{}, idle...)
+	for _, v := range victims {
+		for _, gpu := range v.gpus {
+			if !gpu.IsUse {
+				continue
+			}
+			released := *gpu
+			released.State = utils.GPU_STATE_IDLE
+			released.MemoryUsed = 0
+			released.UtilizationGpu = 0
+			released.UtilizationMemory = 0
+			gpus = append(gpus, &released)
+		}
+	}
+
+	return gpus
+}
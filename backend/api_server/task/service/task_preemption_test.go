@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"api_server/engine/fake"
+	"api_server/ent"
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PreemptionTestSuite struct {
+	suite.Suite
+}
+
+func victimOn(id int, priority int, gpus ...*ent.Gpu) *victim {
+	for _, gpu := range gpus {
+		gpu.State = "modeling"
+	}
+	return &victim{modeling: &ent.Modeling{ID: id, Priority: priority}, gpus: gpus}
+}
+
+func victimIDsOf(victims []*victim) []int {
+	ids := []int{}
+	for _, v := range victims {
+		ids = append(ids, v.modeling.ID)
+	}
+
+	return ids
+}
+
+func (suite *PreemptionTestSuite) TestFewestVictims() {
+	idle := []*ent.Gpu{gpuOn(1, 1)}
+	candidates := []*victim{
+		victimOn(10, 0, gpuOn(2, 2)),
+		victimOn(11, 0, gpuOn(3, 1)),
+	}
+
+	// device 1의 GPU만 모아도 되므로 device 2의 modeling은 중단하지 않는다.
+	victims := pickVictims(gpuRequest{count: 2}, nil, idle, candidates)
+	suite.Equal([]int{11}, victimIDsOf(victims))
+
+	suite.Nil(pickVictims(gpuRequest{count: 4}, nil, idle, candidates))
+}
+
+func (suite *PreemptionTestSuite) TestManualGPUs() {
+	idle := []*ent.Gpu{gpuOn(1, 1)}
+	candidates := []*victim{
+		victimOn(10, 0, gpuOn(2, 1)),
+		victimOn(11, 0, gpuOn(3, 1)),
+	}
+
+	victims := pickVictims(gpuRequest{count: 2}, []int{1, 3}, idle, candidates)
+	suite.Equal([]int{11}, victimIDsOf(victims))
+
+	// GPU 4는 후보가 아닌 modeling이 사용 중이다.
+	suite.Nil(pickVictims(gpuRequest{count: 2}, []int{1, 4}, idle, candidates))
+}
+
+func (suite *PreemptionTestSuite) TestPreemptOnEngine() {
+	scheduler, client := newTestScheduler(suite.T())
+	engine := fake.New(1)
+	defer engine.Close()
+	_, gpus := addTestDevice(suite.T(), client, engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_REST, 1)
+
+	running := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpus[0].ID}})
+	p, err := scheduler.locate(running)
+	suite.NoError(err)
+	suite.NoError(scheduler.dispatch(p, restExecutorRequests[utils.EXECUTOR_JOB_TRAIN]))
+	scheduler.updateGPUSRunning(p.gpuIDs(), utils.GPU_STATE_MODEING)
+	suite.Equal([]int{running.ID}, engine.Running())
+
+	// 관리자만 줄 수 있는 0보다 높은 우선순위도 PREEMPTION_MIN_PRIORITY보다 낮으면 선점하지 않는다.
+	waiting := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 10, repo.EngineParams{GPUAuto: true, GPUCount: 1})
+	suite.Nil(scheduler.preempt(waiting))
+	suite.Equal([]int{running.ID}, engine.Running())
+
+	urgent := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 100, repo.EngineParams{GPUAuto: true, GPUCount: 1})
+	suite.Equal([]int{gpus[0].ID}, scheduler.preempt(urgent))
+	suite.Empty(engine.Running())
+
+	stopped := client.Modeling.GetX(context.Background(), running.ID)
+	suite.Equal(utils.MODELING_STEP_IDLE, stopped.ModelingStep)
+	suite.Equal(utils.GPU_STATE_IDLE, client.Gpu.GetX(context.Background(), gpus[0].ID).State)
+	records, err := scheduler.dao_preemption.SelectMany(context.Background(), urgent.ID)
+	suite.NoError(err)
+	suite.Len(records, 1)
+	suite.Equal(running.ID, records[0].VictimID)
+}
+
+// 중단된 modeling의 GPU 메모리 사용량이 DB에 남아 있어도 비운 GPU에 바로 배치한다.
+func (suite *PreemptionTestSuite) TestPreemptStaleMemory() {
+	scheduler, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	engine := fake.New(1)
+	defer engine.Close()
+	_, gpus := addTestDevice(suite.T(), client, engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_REST, 1)
+	client.Gpu.UpdateOneID(gpus[0].ID).SetMemoryTotal(16000).SetMemoryUsed(15000).ExecX(ctx)
+
+	running := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpus[0].ID}})
+	p, err := scheduler.locate(running)
+	suite.Require().NoError(err)
+	suite.Require().NoError(scheduler.dispatch(p, restExecutorRequests[utils.EXECUTOR_JOB_TRAIN]))
+	scheduler.updateGPUSRunning(p.gpuIDs(), utils.GPU_STATE_MODEING)
+
+	urgent := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 100, repo.EngineParams{GPUAuto: true, GPUCount: 1, MinFreeMemory: 8000})
+	client.Modeling.UpdateOneID(urgent.ID).SetModelingType(utils.MODELING_TYPE_INITIAL).ExecX(ctx)
+	scheduler.runTasks()
+	suite.Equal([]int{urgent.ID}, engine.Running())
+	suite.Equal(utils.MODELING_STEP_REQUEST, client.Modeling.GetX(ctx, urgent.ID).ModelingStep)
+	suite.Equal(utils.MODELING_STEP_IDLE, client.Modeling.GetX(ctx, running.ID).ModelingStep)
+	suite.Equal(utils.GPU_STATE_MODEING, client.Gpu.GetX(ctx, gpus[0].ID).State)
+	suite.Empty(scheduler.claimed)
+}
+
+// engine이 취소를 처리하면서 cancel 단계를 늦게 기록해도 중단된 modeling은 대기열에 남는다.
+func (suite *PreemptionTestSuite) TestRequeueAfterEngineCancel() {
+	scheduler, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	engine := fake.New(2)
+	defer engine.Close()
+	_, gpus := addTestDevice(suite.T(), client, engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_REST, 2)
+
+	victims := []*ent.Modeling{}
+	for _, gpu := range gpus {
+		v := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpu.ID}})
+		p, err := scheduler.locate(v)
+		suite.Require().NoError(err)
+		suite.Require().NoError(scheduler.dispatch(p, restExecutorRequests[utils.EXECUTOR_JOB_TRAIN]))
+		victims = append(victims, v)
+	}
+	scheduler.updateGPUSRunning([]int{gpus[0].ID, gpus[1].ID}, utils.GPU_STATE_MODEING)
+
+	urgent := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 100, repo.EngineParams{GPUAuto: true, GPUCount: 2})
+	suite.Len(scheduler.preempt(urgent), 2)
+	for _, v := range victims {
+		client.Modeling.UpdateOneID(v.ID).SetModelingStep(utils.MODELING_STEP_CANCEL).ExecX(ctx)
+	}
+
+	// 사용자가 취소한 modeling은 되돌리지 않는다.
+	scheduler.CancelTask(client.Modeling.GetX(ctx, victims[1].ID))
+	scheduler.requeuePreempted()
+	suite.Equal(utils.MODELING_STEP_IDLE, client.Modeling.GetX(ctx, victims[0].ID).ModelingStep)
+	suite.Equal(utils.MODELING_STEP_CANCEL, client.Modeling.GetX(ctx, victims[1].ID).ModelingStep)
+}
+
+func TestPreemptionTestSuite(t *testing.T) {
+	suite.Run(t, new(PreemptionTestSuite))
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"api_server/ent"
+	repo "api_server/task/repository"
+)
+
+// queueItem은 대기열에 있는 modeling 하나와 fair-share 계산에 필요한 정보를 담습니다.
+type queueItem struct {
+	modeling  *ent.Modeling
+	projectID int
+	username  string
+	gpus      int
+}
+
+// taskQueue는 idle modeling들을 우선순위와 fair-share 기준으로 꺼내는 대기열입니다.
+//
+// 꺼내는 순서:
+//  1. priority가 높은 modeling
+//  2. 관리자가 순서(queue_position)를 지정한 modeling, 지정한 순서대로
+//  3. 현재 GPU를 적게 사용 중인 project의 modeling
+//  4. 현재 GPU를 적게 사용 중인 user의 modeling
+//  5. 먼저 대기열에 들어온 modeling
+//
+// 사용량은 실행 중인 modeling과 이번 주기에 배치된 modeling(charge)으로 계산하므로,
+// 한 사용자가 여러 개를 등록해도 다른 project/user의 modeling과 번갈아 실행됩니다.
+type taskQueue struct {
+	items        []*queueItem
+	projectUsage map[int]int
+	userUsage    map[string]int
+}
+
+func newTaskQueue(idle []*ent.Modeling, running []*ent.Modeling) *taskQueue {
+	q := &taskQueue{
+		items:        make([]*queueItem, 0, len(idle)),
+		projectUsage: make(map[int]int),
+		userUsage:    make(map[string]int),
+	}
+
+	for _, m := range running {
+		q.charge(newQueueItem(m))
+	}
+
+	for _, m := range idle {
+		q.items = append(q.items, newQueueItem(m))
+	}
+
+	return q
+}
+
+func newQueueItem(m *ent.Modeling) *queueItem {
+	item := &queueItem{
+		modeling: m,
+		username: m.Username,
+		gpus:     repo.RequestedGPUCount(m),
+	}
+	if m.Edges.Task != nil {
+		item.projectID = m.Edges.Task.ProjectID
+	}
+
+	return item
+}
+
+// pop은 현재 사용량 기준으로 가장 먼저 실행되어야 할 modeling을 꺼냅니다.
+func (q *taskQueue) pop() *queueItem {
+	if len(q.items) < 1 {
+		return nil
+	}
+
+	best := 0
+	for i := 1; i < len(q.items); i++ {
+		if q.less(q.items[i], q.items[best]) {
+			best = i
+		}
+	}
+
+	item := q.items[best]
+	q.items = append(q.items[:best], q.items[best+1:]...)
+
+	return item
+}
+
+// charge는 배치된 modeling의 GPU 사용량을 project/user 사용량에 더합니다.
+func (q *taskQueue) charge(item *queueItem) {
+	q.projectUsage[item.projectID] += item.gpus
+	q.userUsage[item.username] += item.gpus
+}
+
+func (q *taskQueue) less(a, b *queueItem) bool {
+	if a.modeling.Priority != b.modeling.Priority {
+		return a.modeling.Priority > b.modeling.Priority
+	}
+	if pa, pb := a.modeling.QueuePosition, b.modeling.QueuePosition; pa != nil || pb != nil {
+		if pa == nil || pb == nil {
+			return pb == nil
+		}
+		if *pa != *pb {
+			return *pa < *pb
+		}
+	}
+	if pa, pb := q.projectUsage[a.projectID], q.projectUsage[b.projectID]; pa != pb {
+		return pa < pb
+	}
+	if ua, ub := q.userUsage[a.username], q.userUsage[b.username]; ua != ub {
+		return ua < ub
+	}
+	if !a.modeling.QueuedAt.Equal(b.modeling.QueuedAt) {
+		return a.modeling.QueuedAt.Before(b.modeling.QueuedAt)
+	}
+
+	return a.modeling.ID < b.modeling.ID
+}
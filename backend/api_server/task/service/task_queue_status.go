@@ -0,0 +1,166 @@
+package service
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"api_server/ent"
+	repo "api_server/task/repository"
+)
+
+// queueWait은 대기 중인 modeling이 마지막 스케줄링에서 실행되지 않은 이유입니다.
+type queueWait struct {
+	reason string
+	detail string
+}
+
+// gpuRelease는 실행 중인 modeling이 끝나 GPU가 반환될 것으로 예상되는 시각입니다.
+type gpuRelease struct {
+	at   time.Time
+	gpus int
+}
+
+// Queue는 대기 중인 modeling을 스케줄러가 꺼내는 순서대로 반환합니다.
+// 각 modeling의 대기 사유는 마지막 스케줄링 결과이며,
+// 예상 시작 시각은 같은 engine type modeling의 평균 실행 시간으로 GPU가 반환되는 시각을 추정해 계산합니다.
+// engine 요청으로 오래 걸릴 수 있는 스케줄링을 기다리지 않도록 대기 사유만 복사하고 DB는 잠금 없이 조회합니다.
+func (scheduler *TaskScheduler) Queue() ([]*repo.QueueItemDTO, error) {
+	scheduler.muWaits.RLock()
+	waits := make(map[int]queueWait, len(scheduler.waits))
+	for id, wait := range scheduler.waits {
+		waits[id] = wait
+	}
+	scheduler.muWaits.RUnlock()
+
+	idle, err := scheduler.dao_modeling.SelectManyIdle(scheduler.ctx)
+	if err != nil {
+		return nil, err
+	}
+	running, err := scheduler.dao_modeling.SelectManyRunning(scheduler.ctx)
+	if err != nil {
+		return nil, err
+	}
+	idleGPUs, err := scheduler.dao_gpu.SelectIdle(scheduler.ctx)
+	if err != nil {
+		return nil, err
+	}
+	durations, err := scheduler.dao_modeling.SelectDurations(scheduler.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := newTaskQueue(idle, running)
+	items := []*repo.QueueItemDTO{}
+	for item := queue.pop(); item != nil; item = queue.pop() {
+		items = append(items, queueItemDTO(item, len(items)+1, waits))
+		queue.charge(item)
+	}
+
+	now := time.Now()
+	releases := []gpuRelease{}
+	for _, m := range running {
+		if end, ok := expectedEnd(m, durations, now); ok {
+			releases = append(releases, gpuRelease{at: end, gpus: repo.RequestedGPUCount(m)})
+		}
+	}
+	estimateStarts(items, len(idleGPUs), releases, durations, now)
+
+	return items, nil
+}
+
+// queueItemDTO는 대기열 항목을 조회 결과로 바꿉니다. waits는 마지막 스케줄링의 대기 사유입니다.
+func queueItemDTO(item *queueItem, position int, waits map[int]queueWait) *repo.QueueItemDTO {
+	m := item.modeling
+	dto := &repo.QueueItemDTO{
+		ModelingID:   m.ID,
+		TaskID:       m.TaskID,
+		ProjectID:    item.projectID,
+		ModelingType: m.ModelingType,
+		Priority:     m.Priority,
+		Username:     m.Username,
+		QueuedAt:     m.QueuedAt,
+		Position:     position,
+		GPUCount:     item.gpus,
+	}
+	if m.Edges.Task != nil {
+		dto.EngineType = m.Edges.Task.EngineType
+	}
+
+	engineParams := repo.EngineParams{}
+	if len(m.Params) > 0 && json.Unmarshal([]byte(m.Params[0]), &engineParams) == nil && !engineParams.GPUAuto {
+		dto.DeviceIDs = engineParams.DeviceIDs
+	}
+	if wait, ok := waits[m.ID]; ok {
+		dto.WaitReason = wait.reason
+		dto.WaitDetail = wait.detail
+	}
+
+	return dto
+}
+
+// averageDuration은 engine type의 평균 실행 시간입니다. 기록이 없으면 전체 평균을 사용합니다.
+func averageDuration(engineType string, durations map[string]time.Duration) (time.Duration, bool) {
+	if d, ok := durations[engineType]; ok {
+		return d, true
+	}
+	if len(durations) < 1 {
+		return 0, false
+	}
+
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+
+	return total / time.Duration(len(durations)), true
+}
+
+// expectedEnd는 실행 중인 modeling이 끝날 것으로 예상되는 시각입니다.
+// 평균보다 오래 실행 중이면 곧 끝난다고 보며, 실행 시간 기록이 없으면 false를 반환합니다.
+func expectedEnd(m *ent.Modeling, durations map[string]time.Duration, now time.Time) (time.Time, bool) {
+	engineType := ""
+	if m.Edges.Task != nil {
+		engineType = m.Edges.Task.EngineType
+	}
+	d, ok := averageDuration(engineType, durations)
+	if !ok {
+		return time.Time{}, false
+	}
+	started := now
+	if m.StartedAt != nil {
+		started = *m.StartedAt
+	}
+	if end := started.Add(d); end.After(now) {
+		return end, true
+	}
+
+	return now, true
+}
+
+// estimateStarts는 대기 순서대로 GPU를 배정한다고 가정하고 각 modeling의 예상 시작 시각을 채웁니다.
+// device 배치 조건과 quota는 고려하지 않습니다. 실행 시간 기록이 없는 modeling의 GPU는 반환되지 않는다고 보며,
+// GPU가 끝내 모자라면 예상 시작 시각을 비워 둡니다.
+func estimateStarts(items []*repo.QueueItemDTO, free int, releases []gpuRelease, durations map[string]time.Duration, now time.Time) {
+	cursor := now
+	for _, item := range items {
+		sort.Slice(releases, func(i, j int) bool { return releases[i].at.Before(releases[j].at) })
+		for free < item.GPUCount && len(releases) > 0 {
+			free += releases[0].gpus
+			if releases[0].at.After(cursor) {
+				cursor = releases[0].at
+			}
+			releases = releases[1:]
+		}
+		if free < item.GPUCount {
+			continue
+		}
+
+		start := cursor
+		item.EstimatedStartAt = &start
+		free -= item.GPUCount
+		if d, ok := averageDuration(item.EngineType, durations); ok {
+			releases = append(releases, gpuRelease{at: start.Add(d), gpus: item.GPUCount})
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type QueueStatusTestSuite struct {
+	suite.Suite
+}
+
+func (suite *QueueStatusTestSuite) TestEstimateStarts() {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	durations := map[string]time.Duration{"vision": time.Hour}
+	items := []*repo.QueueItemDTO{
+		{ModelingID: 1, EngineType: "vision", GPUCount: 1},
+		{ModelingID: 2, EngineType: "vision", GPUCount: 2},
+		{ModelingID: 3, EngineType: "vision", GPUCount: 8},
+	}
+	releases := []gpuRelease{{at: now.Add(30 * time.Minute), gpus: 1}}
+
+	estimateStarts(items, 1, releases, durations, now)
+
+	suite.Equal(now, *items[0].EstimatedStartAt)
+	suite.Equal(now.Add(time.Hour), *items[1].EstimatedStartAt)
+	suite.Nil(items[2].EstimatedStartAt)
+}
+
+func (suite *QueueStatusTestSuite) TestAverageDuration() {
+	durations := map[string]time.Duration{"vision": time.Hour, "table": 3 * time.Hour}
+
+	d, ok := averageDuration("table", durations)
+	suite.True(ok)
+	suite.Equal(3*time.Hour, d)
+
+	d, ok = averageDuration("ts", durations)
+	suite.True(ok)
+	suite.Equal(2*time.Hour, d)
+
+	_, ok = averageDuration("ts", nil)
+	suite.False(ok)
+}
+
+func (suite *QueueStatusTestSuite) TestWaitReason() {
+	suite.Equal(utils.QUEUE_WAIT_DEVICE_OFFLINE, waitReason(fmt.Errorf("gpu 1: %w", errDeviceOffline)))
+	suite.Equal(utils.QUEUE_WAIT_DEVICE_DRAIN, waitReason(fmt.Errorf("device 1 (driver update): %w", errDeviceDrain)))
+	suite.Equal(utils.QUEUE_WAIT_RESERVED, waitReason(fmt.Errorf("gpu 1 of device 1: %w", errGPUReserved)))
+	suite.Equal(utils.QUEUE_WAIT_NO_IDLE_GPU, waitReason(errNoIdleGPU))
+	suite.Empty(waitReason(errors.New("db")))
+}
+
+// 스케줄링이 engine 응답을 기다리는 동안에도 대기열은 마지막 스케줄링의 대기 사유와 함께 조회된다.
+func (suite *QueueStatusTestSuite) TestQueueDuringSchedule() {
+	scheduler, client := newTestScheduler(suite.T())
+	scheduler.dao_modeling = noDurationsDAO{scheduler.dao_modeling}
+	addTestDevice(suite.T(), client, "10.0.0.1", 5000, utils.DEVICE_CONNECTION_REST, 0)
+	queued := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 0, repo.EngineParams{GPUAuto: true, GPUCount: 1})
+	client.Modeling.UpdateOneID(queued.ID).SetModelingType(utils.MODELING_TYPE_INITIAL).ExecX(context.Background())
+	scheduler.runTasks()
+
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+	done := make(chan []*repo.QueueItemDTO)
+	go func() {
+		items, err := scheduler.Queue()
+		suite.NoError(err)
+		done <- items
+	}()
+	select {
+	case items := <-done:
+		suite.Require().Len(items, 1)
+		suite.Equal(queued.ID, items[0].ModelingID)
+		suite.Equal(utils.QUEUE_WAIT_NO_IDLE_GPU, items[0].WaitReason)
+	case <-time.After(5 * time.Second):
+		suite.Fail("Queue waited for the scheduling round")
+	}
+}
+
+func TestQueueStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(QueueStatusTestSuite))
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dataset_repo "api_server/dataset/repository"
+	device_repo "api_server/device/repository"
+	"api_server/ent"
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TaskQueueTestSuite struct {
+	suite.Suite
+	base time.Time
+}
+
+func (suite *TaskQueueTestSuite) SetupTest() {
+	suite.base = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (suite *TaskQueueTestSuite) modeling(id int, project int, user string, priority int, queued int) *ent.Modeling {
+	return &ent.Modeling{
+		ID:       id,
+		Params:   []string{`{"device_ids":[1]}`},
+		Priority: priority,
+		Username: user,
+		QueuedAt: suite.base.Add(time.Duration(queued) * time.Minute),
+		Edges:    ent.ModelingEdges{Task: &ent.Task{ProjectID: project}},
+	}
+}
+
+func (suite *TaskQueueTestSuite) popAll(q *taskQueue) []int {
+	ids := []int{}
+	for item := q.pop(); item != nil; item = q.pop() {
+		ids = append(ids, item.modeling.ID)
+		q.charge(item)
+	}
+
+	return ids
+}
+
+func (suite *TaskQueueTestSuite) TestPriorityFirst() {
+	q := newTaskQueue([]*ent.Modeling{
+		suite.modeling(1, 1, "a", 0, 0),
+		suite.modeling(2, 1, "a", 5, 1),
+	}, nil)
+
+	suite.Equal([]int{2, 1}, suite.popAll(q))
+}
+
+func (suite *TaskQueueTestSuite) TestFairShareAcrossProjects() {
+	// project 1이 먼저 세 개를 등록해도 project 2의 modeling이 중간에 실행된다.
+	q := newTaskQueue([]*ent.Modeling{
+		suite.modeling(1, 1, "a", 0, 0),
+		suite.modeling(2, 1, "a", 0, 1),
+		suite.modeling(3, 1, "a", 0, 2),
+		suite.modeling(4, 2, "b", 0, 3),
+	}, nil)
+
+	suite.Equal([]int{1, 4, 2, 3}, suite.popAll(q))
+}
+
+func (suite *TaskQueueTestSuite) TestRunningUsageCounts() {
+	running := []*ent.Modeling{suite.modeling(10, 1, "a", 0, 0)}
+	q := newTaskQueue([]*ent.Modeling{
+		suite.modeling(1, 1, "a", 0, 0),
+		suite.modeling(2, 2, "b", 0, 5),
+	}, running)
+
+	suite.Equal([]int{2, 1}, suite.popAll(q))
+}
+
+func (suite *TaskQueueTestSuite) TestFairShareAcrossUsers() {
+	q := newTaskQueue([]*ent.Modeling{
+		suite.modeling(1, 1, "a", 0, 0),
+		suite.modeling(2, 1, "a", 0, 1),
+		suite.modeling(3, 1, "b", 0, 2),
+	}, []*ent.Modeling{suite.modeling(10, 2, "c", 0, 0)})
+
+	// project 사용량이 같아질 때까지는 project 1, 그 다음 user 사용량으로 정렬된다.
+	suite.Equal([]int{1, 3, 2}, suite.popAll(q))
+}
+
+// 관리자가 정한 순서는 같은 우선순위 안에서 project/user 사용량보다 먼저 비교한다.
+func (suite *TaskQueueTestSuite) TestQueuePosition() {
+	first, second := 1, 2
+	positioned := suite.modeling(3, 1, "a", 0, 2)
+	positioned.QueuePosition = &first
+	next := suite.modeling(4, 2, "b", 0, 3)
+	next.QueuePosition = &second
+	q := newTaskQueue([]*ent.Modeling{
+		suite.modeling(1, 2, "b", 0, 0),
+		suite.modeling(2, 3, "c", 0, 1),
+		next,
+		positioned,
+		suite.modeling(5, 1, "a", 5, 4),
+	}, []*ent.Modeling{suite.modeling(10, 1, "a", 0, 0)})
+
+	suite.Equal([]int{5, 3, 4, 2, 1}, suite.popAll(q))
+}
+
+func (suite *TaskQueueTestSuite) TestReorderQueue() {
+	_, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	svc := NewModelingService(repo.NewModelingDAO(), repo.NewModelingDetailDAO(), dataset_repo.NewDatasetDAO(), device_repo.New())
+	a := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 0, repo.EngineParams{})
+	b := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 0, repo.EngineParams{})
+	urgent := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 10, repo.EngineParams{})
+
+	suite.Nil(svc.ReorderQueue(repo.QueueOrderDTO{ModelingIDs: []int{b.ID, a.ID}}))
+	suite.Equal(1, *client.Modeling.GetX(ctx, b.ID).QueuePosition)
+	suite.Equal(2, *client.Modeling.GetX(ctx, a.ID).QueuePosition)
+
+	// 새 순서를 지정하면 이전 순서는 지워진다.
+	suite.Nil(svc.ReorderQueue(repo.QueueOrderDTO{ModelingIDs: []int{a.ID}}))
+	suite.Equal(1, *client.Modeling.GetX(ctx, a.ID).QueuePosition)
+	suite.Nil(client.Modeling.GetX(ctx, b.ID).QueuePosition)
+
+	// 높은 우선순위 modeling을 뒤로 보내는 순서는 적용할 수 없으므로 거부한다.
+	suite.NotNil(svc.ReorderQueue(repo.QueueOrderDTO{ModelingIDs: []int{a.ID, urgent.ID}}))
+	suite.Equal(1, *client.Modeling.GetX(ctx, a.ID).QueuePosition)
+}
+
+func TestTaskQueueTestSuite(t *testing.T) {
+	suite.Run(t, new(TaskQueueTestSuite))
+}
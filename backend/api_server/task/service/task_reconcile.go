@@ -0,0 +1,170 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	engine_client "api_server/engine/client"
+	"api_server/ent"
+	"api_server/logger"
+	repo "api_server/task/repository"
+	"api_server/utils"
+)
+
+const (
+	reconcileRunning = "running" // engine이 실행 중, 그대로 둔다
+	reconcileRequeue = "requeue" // 아직 시작 전이었으므로 대기열로 되돌린다
+	reconcileFail    = "fail"    // 실행 중이던 modeling이 사라졌으므로 실패 처리한다
+	reconcileSkip    = "skip"    // 응답하지 않는 device가 있어 판단할 수 없다
+)
+
+// Reconcile은 각 engine이 실제로 실행 중인 modeling을 조회해 DB의 modeling 단계와 GPU 상태를 맞춥니다.
+// KAI.S가 재시작되는 동안 engine이 작업을 끝냈거나 잃어버린 경우를 정리하기 위해 부팅 시와 요청 시 실행합니다.
+// 상태를 직접 조회하는 executor(slurm 등)의 modeling은 pollTasks가 처리하므로 제외합니다.
+func (scheduler *TaskScheduler) Reconcile() *repo.ReconcileReportDTO {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	report := &repo.ReconcileReportDTO{
+		StartedAt:          time.Now(),
+		Devices:            []int{},
+		UnreachableDevices: []int{},
+		Running:            []int{},
+		Requeued:           []int{},
+		Failed:             []int{},
+		Skipped:            []int{},
+		ReleasedGPUs:       []int{},
+	}
+
+	devices, err := scheduler.dao_device.SelectActive(scheduler.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return report
+	}
+	running := make(map[int]map[int]bool)
+	for _, device := range devices {
+		ids, err := scheduler.runningOn(device)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("reconcile: device %d (%s:%d): %v", device.ID, device.IP, device.Port, err))
+			report.UnreachableDevices = append(report.UnreachableDevices, device.ID)
+			continue
+		}
+		running[device.ID] = ids
+		report.Devices = append(report.Devices, device.ID)
+	}
+
+	tasks, err := scheduler.dao_modeling.SelectManyRunning(scheduler.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return report
+	}
+
+	// 실행 중으로 확인됐거나 판단할 수 없는 modeling의 GPU는 유지한다.
+	keep := make(map[int]bool)
+	for _, task := range tasks {
+		if task.Executor != "" && task.Executor != utils.EXECUTOR_REST {
+			continue
+		}
+
+		// device_ids가 없어 배치를 찾을 수 없는 modeling은 어느 engine에서도 실행 중이 아니다.
+		p, err := scheduler.locate(task)
+		if err != nil {
+			p = &placement{modelingID: task.ID}
+		}
+		deviceIDs := []int{}
+		for _, node := range p.nodes {
+			deviceIDs = append(deviceIDs, node.DeviceID)
+		}
+
+		switch reconcileAction(task.ID, task.ModelingStep, deviceIDs, running) {
+		case reconcileRunning:
+			report.Running = append(report.Running, task.ID)
+			if state, ok := restExecutorGPUStates[task.JobType]; ok {
+				scheduler.updateGPUSRunning(p.gpuIDs(), state)
+			}
+			for _, id := range p.gpuIDs() {
+				keep[id] = true
+			}
+		case reconcileSkip:
+			report.Skipped = append(report.Skipped, task.ID)
+			for _, id := range p.gpuIDs() {
+				keep[id] = true
+			}
+		case reconcileRequeue:
+			if err := scheduler.dao_modeling.UpdateState(scheduler.ctx, task.ID, utils.MODELING_STEP_IDLE); err != nil {
+				logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+				continue
+			}
+			report.Requeued = append(report.Requeued, task.ID)
+		case reconcileFail:
+			if err := scheduler.dao_modeling.UpdateFail(scheduler.ctx, task.ID, "engine is no longer running the modeling"); err != nil {
+				logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+				continue
+			}
+			report.Failed = append(report.Failed, task.ID)
+		}
+	}
+
+	// 응답한 device의 학습 중 GPU 중 어떤 modeling도 사용하지 않는 GPU는 idle로 되돌린다.
+	// 추론 모델이 올라간 GPU는 modeling과 무관하므로 건드리지 않는다.
+	if gpus, err := scheduler.dao_gpu.SelectBusy(scheduler.ctx); err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+	} else {
+		for _, gpu := range gpus {
+			if gpu.State != utils.GPU_STATE_MODEING {
+				continue
+			}
+			if _, reachable := running[gpu.DeviceID]; reachable && !keep[gpu.ID] {
+				report.ReleasedGPUs = append(report.ReleasedGPUs, gpu.ID)
+			}
+		}
+		if len(report.ReleasedGPUs) > 0 {
+			scheduler.updateGPUIdle(report.ReleasedGPUs)
+		}
+	}
+
+	report.FinishedAt = time.Now()
+	if jsonBytes, err := json.Marshal(report); err == nil {
+		logger.Info("reconcile report: " + string(jsonBytes))
+	}
+	if len(report.Requeued) > 0 {
+		utils.NewNotifier().Publish(utils.EVENT_MODELING_QUEUED, report.Requeued[0])
+	}
+
+	return report
+}
+
+// runningOn은 engine에 실행 중인 modeling ID 목록을 묻습니다.
+func (scheduler *TaskScheduler) runningOn(device *ent.Device) (map[int]bool, error) {
+	modelingIDs, err := engine_client.ForDevice(device.IP, device.Port, device.Connection, engine_client.ProbeTimeout).Running(scheduler.ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[int]bool)
+	for _, id := range modelingIDs {
+		ids[id] = true
+	}
+
+	return ids, nil
+}
+
+// reconcileAction은 modeling이 배치된 device들의 응답으로 처리 방법을 정합니다.
+// running에 없는 device는 응답하지 않은 device입니다.
+func reconcileAction(modelingID int, step string, deviceIDs []int, running map[int]map[int]bool) string {
+	for _, deviceID := range deviceIDs {
+		ids, reachable := running[deviceID]
+		if !reachable {
+			return reconcileSkip
+		}
+		if ids[modelingID] {
+			return reconcileRunning
+		}
+	}
+
+	if step == utils.MODELING_STEP_REQUEST {
+		return reconcileRequeue
+	}
+
+	return reconcileFail
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"api_server/engine/fake"
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ReconcileTestSuite struct {
+	suite.Suite
+	running map[int]map[int]bool
+}
+
+func (suite *ReconcileTestSuite) SetupTest() {
+	// device 1은 modeling 10을 실행 중, device 2는 아무것도 실행하지 않음, device 3은 응답 없음
+	suite.running = map[int]map[int]bool{
+		1: {10: true},
+		2: {},
+	}
+}
+
+func (suite *ReconcileTestSuite) TestRunning() {
+	suite.Equal(reconcileRunning, reconcileAction(10, utils.MODELING_STEP_RUN, []int{1}, suite.running))
+	suite.Equal(reconcileRunning, reconcileAction(10, utils.MODELING_STEP_RUN, []int{2, 1}, suite.running))
+}
+
+func (suite *ReconcileTestSuite) TestLost() {
+	suite.Equal(reconcileRequeue, reconcileAction(11, utils.MODELING_STEP_REQUEST, []int{1, 2}, suite.running))
+	suite.Equal(reconcileFail, reconcileAction(11, utils.MODELING_STEP_RUN, []int{2}, suite.running))
+	suite.Equal(reconcileRequeue, reconcileAction(11, utils.MODELING_STEP_REQUEST, []int{}, suite.running))
+}
+
+func (suite *ReconcileTestSuite) TestUnreachable() {
+	suite.Equal(reconcileSkip, reconcileAction(11, utils.MODELING_STEP_RUN, []int{2, 3}, suite.running))
+}
+
+// engine의 응답으로 modeling과 GPU 상태를 맞추되 추론 모델이 올라간 GPU는 그대로 둔다.
+func (suite *ReconcileTestSuite) TestReconcileEngine() {
+	scheduler, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	engine := fake.New(4)
+	defer engine.Close()
+	offline := fake.New(1)
+	_, gpus := addTestDevice(suite.T(), client, engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_REST, 4)
+	_, offlineGPUs := addTestDevice(suite.T(), client, offline.IP(), offline.Port(), utils.DEVICE_CONNECTION_REST, 1)
+	offline.Close()
+
+	alive := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpus[0].ID}})
+	p, err := scheduler.locate(alive)
+	suite.Require().NoError(err)
+	suite.Require().NoError(scheduler.dispatch(p, restExecutorRequests[utils.EXECUTOR_JOB_TRAIN]))
+	lost := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpus[1].ID}})
+	requested := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_REQUEST, 0, repo.EngineParams{DeviceIDs: []int{gpus[1].ID}})
+	unknown := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{offlineGPUs[0].ID}})
+	scheduler.updateGPUSRunning([]int{gpus[0].ID, gpus[1].ID, gpus[3].ID, offlineGPUs[0].ID}, utils.GPU_STATE_MODEING)
+	scheduler.updateGPUSRunning([]int{gpus[2].ID}, utils.GPU_STATE_EVALUATION)
+
+	report := scheduler.Reconcile()
+	suite.Equal([]int{alive.ID}, report.Running)
+	suite.Equal([]int{lost.ID}, report.Failed)
+	suite.Equal([]int{requested.ID}, report.Requeued)
+	suite.Equal([]int{unknown.ID}, report.Skipped)
+	suite.Equal([]int{offlineGPUs[0].DeviceID}, report.UnreachableDevices)
+	suite.ElementsMatch([]int{gpus[1].ID, gpus[3].ID}, report.ReleasedGPUs)
+
+	suite.Equal(utils.MODELING_STEP_FAIL, client.Modeling.GetX(ctx, lost.ID).ModelingStep)
+	suite.Equal(utils.MODELING_STEP_IDLE, client.Modeling.GetX(ctx, requested.ID).ModelingStep)
+	suite.Equal(utils.GPU_STATE_MODEING, client.Gpu.GetX(ctx, gpus[0].ID).State)
+	suite.Equal(utils.GPU_STATE_IDLE, client.Gpu.GetX(ctx, gpus[1].ID).State)
+	suite.Equal(utils.GPU_STATE_EVALUATION, client.Gpu.GetX(ctx, gpus[2].ID).State)
+	suite.Equal(utils.GPU_STATE_IDLE, client.Gpu.GetX(ctx, gpus[3].ID).State)
+	suite.Equal(utils.GPU_STATE_MODEING, client.Gpu.GetX(ctx, offlineGPUs[0].ID).State)
+}
+
+func TestReconcileTestSuite(t *testing.T) {
+	suite.Run(t, new(ReconcileTestSuite))
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"api_server/logger"
+	repo "api_server/task/repository"
+	"api_server/utils"
+)
+
+// enforceReservations는 시작된 예약의 GPU에서 예약한 project나 user가 아닌 modeling이 실행 중이면 중단하고 대기열로 되돌립니다.
+// 배치할 때는 예상 실행 시간으로 예약을 피하지만, 예상보다 오래 실행된 modeling은 예약이 시작되어도 GPU를 계속 사용하기 때문입니다.
+// 중단된 modeling은 preemption과 같이 원래 params와 대기 시각을 유지하므로 예약되지 않은 GPU가 생기면 다시 실행됩니다.
+// 상태를 직접 조회하는 executor(slurm 등)의 modeling은 KAI.S의 GPU를 사용하지 않으므로 제외합니다.
+func (scheduler *TaskScheduler) enforceReservations() {
+	now := time.Now()
+	reservations, err := scheduler.reservation.Load(now)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+	if reservations.Empty() {
+		return
+	}
+
+	running, err := scheduler.dao_modeling.SelectManyRunning(scheduler.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+	for _, task := range running {
+		if task.Executor != "" && task.Executor != utils.EXECUTOR_REST {
+			continue
+		}
+		engineParams := repo.EngineParams{}
+		if err := json.Unmarshal([]byte(task.Params[0]), &engineParams); err != nil {
+			continue
+		}
+
+		blocked := reservations.Blocked(task, now, now)
+		reserved := []int{}
+		for _, id := range engineParams.DeviceIDs {
+			if blocked[id] {
+				reserved = append(reserved, id)
+			}
+		}
+		if len(reserved) < 1 {
+			continue
+		}
+
+		if err := scheduler.executorOf(task).Cancel(task); err != nil {
+			logger.Error(fmt.Sprintf("stop modeling %d on reserved gpus %v: %v", task.ID, reserved, err))
+			continue
+		}
+		if err := scheduler.dao_modeling.UpdateState(scheduler.ctx, task.ID, utils.MODELING_STEP_IDLE); err != nil {
+			logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+			continue
+		}
+		logger.Warn(fmt.Sprintf("modeling %d (%s) was stopped because gpus %v are reserved for another project or user", task.ID, task.Username, reserved))
+	}
+}
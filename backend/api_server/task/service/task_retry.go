@@ -0,0 +1,65 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	config_service "api_server/configuration/service"
+	"api_server/ent"
+	"api_server/logger"
+	"api_server/utils"
+)
+
+// failAttempt는 실행 요청에 실패한 modeling의 시도 횟수를 늘리고 다음 시도 시각을 정합니다.
+// DISPATCH_MAX_ATTEMPTS 만큼 실패하면 modeling을 fail 상태로 바꾸고 실패 사유를 남깁니다.
+func (scheduler *TaskScheduler) failAttempt(task *ent.Modeling, cause error) {
+	cf := config_service.NewStatic()
+	attempts := task.Attempts + 1
+	reason := cause.Error()
+
+	if attempts >= cf.GetInt("DISPATCH_MAX_ATTEMPTS", 5) {
+		reason = fmt.Sprintf("dispatch failed %d times: %s", attempts, reason)
+		if err := scheduler.dao_modeling.UpdateFail(scheduler.ctx, task.ID, reason); err != nil {
+			logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		}
+		return
+	}
+
+	delay := dispatchBackoff(attempts,
+		time.Duration(cf.GetInt("DISPATCH_BACKOFF_BASE", 10))*time.Second,
+		time.Duration(cf.GetInt("DISPATCH_BACKOFF_MAX", 600))*time.Second,
+	)
+	if err := scheduler.dao_modeling.UpdateAttempt(scheduler.ctx, task.ID, attempts, time.Now().Add(delay), reason); err != nil {
+		logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+		return
+	}
+
+	// sweep 주기를 기다리지 않고 재시도 시각에 다시 스케줄링한다.
+	time.AfterFunc(delay, func() {
+		utils.NewNotifier().Publish(utils.EVENT_MODELING_QUEUED, task.ID)
+	})
+}
+
+// resetAttempt는 실행 요청에 성공한 modeling의 시도 횟수를 되돌려, 이후 다시 대기열에 들어갔을 때
+// (preemption, 예약 등) 이전 실패가 DISPATCH_MAX_ATTEMPTS에 합산되지 않도록 합니다.
+func (scheduler *TaskScheduler) resetAttempt(task *ent.Modeling) {
+	if task.Attempts < 1 && task.NextAttemptAt == nil {
+		return
+	}
+	if err := scheduler.dao_modeling.ResetAttempt(scheduler.ctx, task.ID); err != nil {
+		logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+	}
+}
+
+// dispatchBackoff는 attempts 번째 실패 후 기다릴 시간(base * 2^(attempts-1), 최대 max)을 반환합니다.
+func dispatchBackoff(attempts int, base time.Duration, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		return max
+	}
+
+	return delay
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"api_server/engine/fake"
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RetryTestSuite struct {
+	suite.Suite
+}
+
+func (suite *RetryTestSuite) TestDispatchBackoff() {
+	suite.Equal(10*time.Second, dispatchBackoff(1, 10*time.Second, 600*time.Second))
+	suite.Equal(20*time.Second, dispatchBackoff(2, 10*time.Second, 600*time.Second))
+	suite.Equal(80*time.Second, dispatchBackoff(4, 10*time.Second, 600*time.Second))
+	suite.Equal(600*time.Second, dispatchBackoff(7, 10*time.Second, 600*time.Second))
+	// 시도 횟수가 커도 넘치지 않는다.
+	suite.Equal(600*time.Second, dispatchBackoff(1000, 10*time.Second, 600*time.Second))
+}
+
+func (suite *RetryTestSuite) TestFailAttempt() {
+	scheduler, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	task := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 0, repo.EngineParams{GPUAuto: true})
+
+	before := time.Now()
+	scheduler.failAttempt(task, errors.New("engine refused"))
+	retried := client.Modeling.GetX(ctx, task.ID)
+	suite.Equal(utils.MODELING_STEP_IDLE, retried.ModelingStep)
+	suite.Equal(1, retried.Attempts)
+	suite.Equal("engine refused", retried.FailReason)
+	suite.Require().NotNil(retried.NextAttemptAt)
+	suite.WithinDuration(before.Add(10*time.Second), *retried.NextAttemptAt, 2*time.Second)
+
+	// DISPATCH_MAX_ATTEMPTS번째 실패하면 fail 상태가 된다.
+	client.Modeling.UpdateOneID(task.ID).SetAttempts(4).ExecX(ctx)
+	scheduler.failAttempt(client.Modeling.GetX(ctx, task.ID), errors.New("engine refused"))
+	failed := client.Modeling.GetX(ctx, task.ID)
+	suite.Equal(utils.MODELING_STEP_FAIL, failed.ModelingStep)
+	suite.Equal("dispatch failed 5 times: engine refused", failed.FailReason)
+	suite.Nil(failed.NextAttemptAt)
+}
+
+// 실행 요청에 성공하면 이전 실패는 다음에 다시 대기열에 들어갔을 때 합산되지 않는다.
+func (suite *RetryTestSuite) TestResetAttemptOnDispatch() {
+	scheduler, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	engine := fake.New(1)
+	defer engine.Close()
+	addTestDevice(suite.T(), client, engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_REST, 1)
+
+	task := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 0, repo.EngineParams{GPUAuto: true, GPUCount: 1})
+	client.Modeling.UpdateOneID(task.ID).
+		SetModelingType(utils.MODELING_TYPE_INITIAL).
+		SetAttempts(3).
+		SetNextAttemptAt(time.Now().Add(-time.Second)).
+		SetFailReason("engine refused").
+		ExecX(ctx)
+
+	scheduler.runTasks()
+	suite.Equal([]int{task.ID}, engine.Running())
+	dispatched := client.Modeling.GetX(ctx, task.ID)
+	suite.Equal(utils.MODELING_STEP_REQUEST, dispatched.ModelingStep)
+	suite.Equal(0, dispatched.Attempts)
+	suite.Nil(dispatched.NextAttemptAt)
+	suite.Empty(dispatched.FailReason)
+}
+
+func TestRetryTestSuite(t *testing.T) {
+	suite.Run(t, new(RetryTestSuite))
+}
@@ -1,45 +1,102 @@
 package service
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
-	"strconv"
+	"sort"
 	"sync"
 	"time"
 
+	config_service "api_server/configuration/service"
+	dataset_repo "api_server/dataset/repository"
 	device_repo "api_server/device/repository"
+	device_service "api_server/device/service"
+	engine_client "api_server/engine/client"
 	"api_server/ent"
 	"api_server/logger"
+	quota_repo "api_server/quota/repository"
+	quota_service "api_server/quota/service"
+	reservation_repo "api_server/reservation/repository"
+	reservation_service "api_server/reservation/service"
 	repo "api_server/task/repository"
+	user_repo "api_server/user/repository"
 	"api_server/utils"
 )
 
 type ITaskScheduler interface {
 	WatchTasks()
-	matchGPU(task *ent.Modeling) (string, io.Reader, []int, error)
+	Reconcile() *repo.ReconcileReportDTO
+	Watchdog()
+	schedule()
+	matchGPU(task *ent.Modeling) (*placement, error)
+	locate(task *ent.Modeling) (*placement, error)
+	dispatch(p *placement, request engineRequest) error
 	updateGPUSRunning(gpu_ids []int, state string)
-	updateGPUIdle(gpu_id int)
+	updateGPUIdle(gpu_ids []int)
 	runComplete(task *ent.Modeling)
-	runModeling(task *ent.Modeling)
-	runEvaluation(task *ent.Modeling)
+	submit(task *ent.Modeling, job string) error
+	pollTasks()
+	runModeling(task *ent.Modeling) error
+	runEvaluation(task *ent.Modeling) error
+	runBlind(task *ent.Modeling) error
+	runTask(task *ent.Modeling) error
 	completeTasks()
+	releaseEvaluations()
 	runTasks()
 	CancelTask(task *ent.Modeling)
 }
 
 type TaskScheduler struct {
-	ctx          context.Context
-	dao_task     repo.ITaskDAO
-	dao_modeling repo.IModelingDAO
-	dao_device   device_repo.DeviceDAO
-	dao_gpu      device_repo.GPUDAO
+	ctx            context.Context
+	dao_task       repo.ITaskDAO
+	dao_modeling   repo.IModelingDAO
+	dao_device     device_repo.DeviceDAO
+	dao_gpu        device_repo.GPUDAO
+	dao_preemption repo.IPreemptionDAO
+	executors      map[string]ITaskExecutor
+	pipeline       IPipelineService
+	search         ISearchService
+	quota          quota_service.IQuotaService
+	reservation    reservation_service.IReservationService
+	waits          map[int]queueWait // 마지막 스케줄링에서 modeling이 실행되지 않은 이유
+	claimed        map[int][]int     // preemption으로 비운 GPU. 메모리를 다시 확인하지 않고 이 GPU에 배치한다
+	requeues       map[int]time.Time // preemption으로 대기열에 되돌린 modeling과 engine의 취소 단계를 되돌릴 기한
+	mu             sync.Mutex        // 스케줄링 한 번(engine 요청 포함) 동안 잡는다
+	muWaits        sync.RWMutex      // waits만 보호한다. 대기열 조회가 스케줄링을 기다리지 않도록 mu와 따로 둔다
+	muRequeue      sync.Mutex
 }
 
+// GPU가 모자라거나 device가 꺼져 있거나 drain 중이거나 다른 project에 예약되어 배치하지 못한 경우.
+// 예약된 GPU를 제외한 경우에는 낮은 우선순위 modeling이 먼저 실행되지 않도록 대기열을 멈춘다.
+// 예약된 GPU는 예약이 끝날 때까지 사용할 수 없으므로 다른 modeling이 남은 GPU를 사용하도록 대기열을 멈추지 않는다.
+var (
+	errNoIdleGPU     = errors.New("no idle gpus")
+	errNotEnoughGPUs = errors.New("not enough gpus")
+	errDeviceOffline = errors.New("device offline")
+	errDeviceDrain   = errors.New("device draining")
+	errGPUReserved   = errors.New("gpu reserved")
+)
+
+// waitReason은 배치하지 못한 이유를 대기열 조회용 사유로 바꿉니다. 배치와 관계없는 오류이면 ""를 반환합니다.
+func waitReason(err error) string {
+	switch {
+	case errors.Is(err, errDeviceOffline):
+		return utils.QUEUE_WAIT_DEVICE_OFFLINE
+	case errors.Is(err, errDeviceDrain):
+		return utils.QUEUE_WAIT_DEVICE_DRAIN
+	case errors.Is(err, errGPUReserved):
+		return utils.QUEUE_WAIT_RESERVED
+	case errors.Is(err, errNoIdleGPU), errors.Is(err, errNotEnoughGPUs):
+		return utils.QUEUE_WAIT_NO_IDLE_GPU
+	default:
+		return ""
+	}
+}
+
+var _ ITaskScheduler = (*TaskScheduler)(nil)
+
 var onceTaskScheduler sync.Once
 var instanceTaskScheduler *TaskScheduler
 
@@ -47,129 +104,483 @@ func NewTaskScheduler() *TaskScheduler {
 	onceTaskScheduler.Do(func() {
 		logger.Debug("TaskScheduler Service instance")
 		instanceTaskScheduler = &TaskScheduler{
-			ctx:          context.Background(),
-			dao_task:     repo.NewTaskDAO(),
-			dao_modeling: repo.NewModelingDAO(),
-			dao_device:   *device_repo.New(),
-			dao_gpu:      *device_repo.NewGPUDAO(),
+			ctx:            context.Background(),
+			dao_task:       repo.NewTaskDAO(),
+			dao_modeling:   repo.NewModelingDAO(),
+			dao_device:     *device_repo.New(),
+			dao_gpu:        *device_repo.NewGPUDAO(),
+			dao_preemption: repo.NewPreemptionDAO(),
+			quota:          quota_service.NewQuotaService(quota_repo.NewQuotaDAO(), user_repo.NewUserDAO(), repo.NewTaskDAO(), repo.NewModelingDAO()),
+			reservation:    reservation_service.NewReservationService(reservation_repo.NewReservationDAO(), device_repo.New(), device_repo.NewGPUDAO(), device_repo.NewMaintenanceDAO()),
+			claimed:        make(map[int][]int),
+			requeues:       make(map[int]time.Time),
+		}
+		svc_modeling := NewModelingService(repo.NewModelingDAO(), repo.NewModelingDetailDAO(), dataset_repo.NewDatasetDAO(), device_repo.New())
+		instanceTaskScheduler.pipeline = NewPipelineService(instanceTaskScheduler.dao_task, svc_modeling)
+		instanceTaskScheduler.search = NewSearchService(
+			instanceTaskScheduler.dao_task,
+			instanceTaskScheduler.dao_modeling,
+			NewTaskService(repo.NewTaskDAO(), repo.NewModelingDAO(), dataset_repo.NewDatasetDAO(), device_repo.New()),
+			svc_modeling,
+		)
+
+		cf := config_service.NewStatic()
+		instanceTaskScheduler.executors = map[string]ITaskExecutor{
+			utils.EXECUTOR_REST: newRestExecutor(instanceTaskScheduler),
+			utils.EXECUTOR_SLURM: newSlurmExecutor(
+				instanceTaskScheduler.dao_modeling,
+				cf.Get("SLURM_SCRIPT_PATH"),
+				cf.Get("SLURM_PARTITION"),
+			),
 		}
 	})
 
 	return instanceTaskScheduler
 }
 
+// WatchTasks는 task 등록, GPU 반환, engine 완료 알림이 오면 바로 스케줄링합니다.
+// DB에 단계를 직접 기록하는 REST engine을 위해 SCHEDULER_STEP_POLL_INTERVAL(초)마다 실행 중인 modeling의 단계를 확인해
+// 바뀌었으면 단계 변경 알림을 발행하고, 알림이 유실되는 경우를 대비해 SCHEDULER_SWEEP_INTERVAL(초)마다 전체를 다시 확인합니다.
+// 이때 heartbeat가 끊긴 modeling도 함께 정리합니다.
 func (scheduler *TaskScheduler) WatchTasks() {
-	// KAI.S 재시작 하는 것에 엔진이 영향을 받나? 안 받을 것 같은데?
-	// scheduler.dao_modeling.UpdateCancel(scheduler.ctx)
+	// KAI.S가 꺼져 있는 동안 engine이 끝냈거나 잃어버린 modeling을 먼저 정리한다.
+	scheduler.Reconcile()
+
+	events := utils.NewNotifier().Subscribe(64,
+		utils.EVENT_TASK_CREATED,
+		utils.EVENT_MODELING_QUEUED,
+		utils.EVENT_MODELING_STEP,
+		utils.EVENT_GPU_IDLE,
+		utils.EVENT_DEVICE_UPDATED,
+		utils.EVENT_DEVICE_ONLINE,
+		utils.EVENT_GPU_RESERVED,
+	)
+	cf := config_service.NewStatic()
+	sweep := time.NewTicker(time.Duration(max(cf.GetInt("SCHEDULER_SWEEP_INTERVAL", 60), 1)) * time.Second)
+	defer sweep.Stop()
+	stepPoll := time.NewTicker(time.Duration(max(cf.GetInt("SCHEDULER_STEP_POLL_INTERVAL", 10), 1)) * time.Second)
+	defer stepPoll.Stop()
+
+	steps := scheduler.runningSteps()
+	scheduler.schedule()
+	for {
+		select {
+		case event := <-events:
+			logger.Debug(fmt.Sprintf("schedule by event: %+v", event))
+			scheduler.drain(events)
+			scheduler.schedule()
+		case <-stepPoll.C:
+			current := scheduler.runningSteps()
+			if current == nil {
+				continue
+			}
+			for _, id := range changedSteps(steps, current) {
+				utils.NewNotifier().Publish(utils.EVENT_MODELING_STEP, id)
+			}
+			steps = current
+		case <-sweep.C:
+			scheduler.Watchdog()
+			scheduler.schedule()
+		}
+	}
+}
+
+// runningSteps는 실행 중(request, run)인 modeling ID별 단계입니다. 조회에 실패하면 nil입니다.
+func (scheduler *TaskScheduler) runningSteps() map[int]string {
+	tasks, err := scheduler.dao_modeling.SelectManyRunning(scheduler.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return nil
+	}
+
+	steps := make(map[int]string, len(tasks))
+	for _, task := range tasks {
+		steps[task.ID] = task.ModelingStep
+	}
+
+	return steps
+}
+
+// changedSteps는 prev에서 실행 중이던 modeling 중 단계가 바뀌었거나 더 이상 실행 중이 아닌 modeling ID입니다.
+// 새로 실행된 modeling은 scheduler가 실행한 것이므로 포함하지 않습니다.
+func changedSteps(prev map[int]string, current map[int]string) []int {
+	changed := []int{}
+	for id, step := range prev {
+		if current[id] != step {
+			changed = append(changed, id)
+		}
+	}
+	sort.Ints(changed)
+
+	return changed
+}
+
+// drain은 짧은 시간 동안 몰려온 이벤트를 모아 한 번만 스케줄링하도록 합니다.
+func (scheduler *TaskScheduler) drain(events <-chan utils.Event) {
+	timer := time.NewTimer(500 * time.Millisecond)
+	defer timer.Stop()
 
 	for {
-		time.Sleep(10 * time.Second)
-		scheduler.completeTasks()
-		scheduler.runTasks()
+		select {
+		case <-events:
+		case <-timer.C:
+			return
+		}
+	}
+}
+
+func (scheduler *TaskScheduler) schedule() {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	scheduler.pollTasks()
+	scheduler.completeTasks()
+	scheduler.releaseEvaluations()
+	scheduler.requeuePreempted()
+	scheduler.enforceReservations()
+	scheduler.pipeline.Advance()
+	scheduler.search.Advance()
+	scheduler.runTasks()
+}
+
+// executorFor는 아직 실행되지 않은 modeling이 사용할 executor를 params와 DEFAULT_EXECUTOR 설정으로 결정합니다.
+func (scheduler *TaskScheduler) executorFor(task *ent.Modeling) (ITaskExecutor, error) {
+	name := executorName(task, config_service.NewStatic().Get("DEFAULT_EXECUTOR"))
+	if executor, ok := scheduler.executors[name]; ok {
+		return executor, nil
 	}
+
+	return nil, errors.New("unknown executor: " + name)
+}
+
+// executorOf는 이미 실행된 modeling의 executor를 반환합니다.
+func (scheduler *TaskScheduler) executorOf(task *ent.Modeling) ITaskExecutor {
+	if executor, ok := scheduler.executors[task.Executor]; ok {
+		return executor
+	}
+
+	return scheduler.executors[utils.EXECUTOR_REST]
 }
 
-func (scheduler *TaskScheduler) matchGPU(task *ent.Modeling) (string, io.Reader, []int, error) {
-	// engineParams := make(map[string]interface{})
+// submit는 modeling을 executor에 실행 요청합니다.
+func (scheduler *TaskScheduler) submit(task *ent.Modeling, job string) error {
+	executor, err := scheduler.executorFor(task)
+	if err != nil {
+		return err
+	}
+
+	return executor.Submit(task, job)
+}
+
+func (scheduler *TaskScheduler) matchGPU(task *ent.Modeling) (*placement, error) {
 	engineParams := repo.EngineParams{}
-	addr := ""
 	if err := json.Unmarshal([]byte(task.Params[0]), &engineParams); err != nil {
-		return "", nil, nil, err
+		return nil, err
+	}
+	if gpuIDs, ok := scheduler.claimed[task.ID]; ok {
+		return scheduler.matchClaimed(task, engineParams, gpuIDs)
 	}
 
-	engineParams.ModelingID = task.ID
+	reserved, err := scheduler.reservedGPUs(task)
+	if err != nil {
+		return nil, err
+	}
+	incompatible, err := scheduler.incompatibleDevices(task)
+	if err != nil {
+		return nil, err
+	}
 
+	var gpus []*ent.Gpu
 	if engineParams.GPUAuto {
 		// 자동 배치
-		gpus, err := scheduler.dao_gpu.SelectIdle(scheduler.ctx)
+		idle, err := scheduler.dao_gpu.SelectIdle(scheduler.ctx)
 		if err != nil {
-			return "", nil, nil, err
-		} else if len(gpus) < 1 {
-			return "", nil, nil, errors.New("no idle gpus")
+			return nil, err
+		}
+		idle = withoutDevices(idle, incompatible)
+		if gpus, err = selectGPUs(withoutGPUs(idle, reserved), newGPURequest(engineParams)); err != nil {
+			// 예약된 GPU만 아니면 배치할 수 있었다면 예약 때문에 기다리는 것이다.
+			if _, free := selectGPUs(idle, newGPURequest(engineParams)); free == nil {
+				return nil, fmt.Errorf("%v: %w", err, errGPUReserved)
+			}
+			return nil, err
 		}
 
-		engineParams.DeviceIDs = []int{gpus[0].ID}
+		engineParams.DeviceIDs = []int{}
+		for _, gpu := range gpus {
+			engineParams.DeviceIDs = append(engineParams.DeviceIDs, gpu.ID)
+		}
+		if err := scheduler.updateDeviceIDs(task, engineParams.DeviceIDs); err != nil {
+			return nil, err
+		}
+	} else {
+		// 사용자가 지정한 GPU가 모두 idle 상태이고 메모리 조건을 만족해야 run
+		selected, err := scheduler.dao_gpu.SelectMany(scheduler.ctx, engineParams.DeviceIDs)
+		if err != nil {
+			return nil, err
+		} else if len(selected) < 1 || len(selected) != len(engineParams.DeviceIDs) {
+			return nil, errNotEnoughGPUs
+		}
+		for _, gpu := range selected {
+			if !gpu.IsUse {
+				return nil, fmt.Errorf("gpu %d of device %d: %w", gpu.ID, gpu.DeviceID, errDeviceOffline)
+			}
+			if cause, ok := incompatible[gpu.DeviceID]; ok {
+				return nil, fmt.Errorf("gpu %d: %w", gpu.ID, cause)
+			}
+			if reserved[gpu.ID] {
+				return nil, fmt.Errorf("gpu %d of device %d: %w", gpu.ID, gpu.DeviceID, errGPUReserved)
+			}
+			if gpu.State != utils.GPU_STATE_IDLE || !newGPURequest(engineParams).fits(gpu) {
+				return nil, errNotEnoughGPUs
+			}
+		}
+		if err := scheduler.checkDrain(engineParams.DeviceIDs); err != nil {
+			return nil, err
+		}
+		gpus = selected
+	}
+
+	logger.Debug(fmt.Sprintf("%+v", engineParams))
 
-		if jsonBytes, err := json.Marshal(engineParams); err == nil {
-			scheduler.dao_modeling.UpdateParams(scheduler.ctx, task.ID, []string{string(jsonBytes)})
+	return scheduler.newPlacement(task.ID, gpus)
+}
+
+// matchClaimed는 preemption으로 비운 GPU에 배치합니다.
+// 중단된 modeling의 GPU 메모리 사용량은 다음 상태 조회 전까지 DB에 남아 있으므로 메모리 조건은 다시 확인하지 않습니다.
+func (scheduler *TaskScheduler) matchClaimed(task *ent.Modeling, engineParams repo.EngineParams, gpuIDs []int) (*placement, error) {
+	gpus, err := scheduler.dao_gpu.SelectMany(scheduler.ctx, gpuIDs)
+	if err != nil {
+		return nil, err
+	} else if len(gpus) != len(gpuIDs) {
+		return nil, errNotEnoughGPUs
+	}
+	if engineParams.GPUAuto {
+		if err := scheduler.updateDeviceIDs(task, gpuIDs); err != nil {
+			return nil, err
 		}
 	}
 
-	if devices, err := scheduler.dao_device.SelectIdleByGPU(scheduler.ctx, engineParams.DeviceIDs); err != nil {
-		return "", nil, nil, err
-	} else {
-		if len(devices) > 1 {
-			// TODO : multi node
-			return "", nil, nil, errors.New("not implemented yet")
-		} else if len(devices) > 0 {
-			// gpu가 idle 상태면 run
-			addr = "http://" + devices[0].IP + ":" + strconv.Itoa(devices[0].Port)
-		} else {
-			return "", nil, nil, errors.New("not enough gpus")
+	return scheduler.newPlacement(task.ID, gpus)
+}
+
+// checkDrain은 사용자가 지정한 GPU 중 drain 중인 device의 GPU가 있으면 errDeviceDrain을 반환합니다.
+func (scheduler *TaskScheduler) checkDrain(gpuIDs []int) error {
+	devices, err := scheduler.dao_device.SelectByGPU(scheduler.ctx, gpuIDs)
+	if err != nil {
+		return err
+	}
+	for _, device := range devices {
+		if device.Drain {
+			return fmt.Errorf("device %d (%s): %w", device.ID, device.DrainReason, errDeviceDrain)
 		}
 	}
 
-	logger.Debug(fmt.Sprintf("%+v", engineParams))
+	return nil
+}
+
+// reservedGPUs는 task가 실행되는 동안 다른 project나 user의 예약과 겹치는 GPU ID 입니다.
+// 실행 시간은 같은 engine type modeling의 평균 실행 시간으로 추정하고, 기록이 없으면 RESERVATION_DEFAULT_DURATION(초)으로 봅니다.
+func (scheduler *TaskScheduler) reservedGPUs(task *ent.Modeling) (map[int]bool, error) {
+	now := time.Now()
+	reservations, err := scheduler.reservation.Load(now)
+	if err != nil {
+		return nil, err
+	}
+	if reservations.Empty() {
+		return map[int]bool{}, nil
+	}
+
+	durations, err := scheduler.dao_modeling.SelectDurations(scheduler.ctx)
+	if err != nil {
+		return nil, err
+	}
+	d, ok := averageDuration(engineTypeOf(task), durations)
+	if !ok {
+		d = time.Duration(config_service.NewStatic().GetInt("RESERVATION_DEFAULT_DURATION", 3600)) * time.Second
+	}
+
+	return reservations.Blocked(task, now, now.Add(d)), nil
+}
+
+// incompatibleDevices는 task의 engine type을 실행할 수 없는 active device와 그 이유입니다.
+// active device가 있는데 모두 실행할 수 없으면 기다려도 배치할 수 없으므로 오류를 반환합니다.
+func (scheduler *TaskScheduler) incompatibleDevices(task *ent.Modeling) (map[int]error, error) {
+	devices, err := scheduler.dao_device.SelectActive(scheduler.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	engineType := engineTypeOf(task)
+	incompatible := make(map[int]error)
+	causes := []error{}
+	for _, device := range devices {
+		if err := device_service.CheckEngine(device.Capabilities, engineType); err != nil {
+			incompatible[device.ID] = fmt.Errorf("device %d (%s): %w", device.ID, device.Name, err)
+			causes = append(causes, incompatible[device.ID])
+		}
+	}
+	if len(devices) > 0 && len(causes) == len(devices) {
+		return nil, fmt.Errorf("no device can run engine type %s: %w", engineType, errors.Join(causes...))
+	}
+
+	return incompatible, nil
+}
+
+// engineTypeOf는 modeling이 속한 task의 engine type 입니다. task를 함께 조회하지 않았으면 ""입니다.
+func engineTypeOf(task *ent.Modeling) string {
+	if task.Edges.Task == nil {
+		return ""
+	}
+
+	return task.Edges.Task.EngineType
+}
+
+// withoutDevices는 gpus에서 excluded에 있는 device의 GPU를 뺀 목록입니다.
+func withoutDevices(gpus []*ent.Gpu, excluded map[int]error) []*ent.Gpu {
+	rest := []*ent.Gpu{}
+	for _, gpu := range gpus {
+		if _, ok := excluded[gpu.DeviceID]; !ok {
+			rest = append(rest, gpu)
+		}
+	}
+
+	return rest
+}
+
+// withoutGPUs는 gpus에서 excluded에 있는 GPU를 뺀 목록입니다.
+func withoutGPUs(gpus []*ent.Gpu, excluded map[int]bool) []*ent.Gpu {
+	rest := []*ent.Gpu{}
+	for _, gpu := range gpus {
+		if !excluded[gpu.ID] {
+			rest = append(rest, gpu)
+		}
+	}
+
+	return rest
+}
+
+// locate는 이미 배치된 modeling이 사용 중인 device들을 찾습니다.
+func (scheduler *TaskScheduler) locate(task *ent.Modeling) (*placement, error) {
+	engineParams := repo.EngineParams{}
+	if err := json.Unmarshal([]byte(task.Params[0]), &engineParams); err != nil {
+		return nil, err
+	}
+
+	gpus, err := scheduler.dao_gpu.SelectMany(scheduler.ctx, engineParams.DeviceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduler.newPlacement(task.ID, gpus)
+}
+
+func (scheduler *TaskScheduler) newPlacement(modelingID int, gpus []*ent.Gpu) (*placement, error) {
+	gpuIDs := []int{}
+	for _, gpu := range gpus {
+		gpuIDs = append(gpuIDs, gpu.ID)
+	}
+
+	devices, err := scheduler.dao_device.SelectByGPU(scheduler.ctx, gpuIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := newPlacement(modelingID, gpus, devices)
+	if err != nil {
+		return nil, err
+	}
+	p.masterPort = config_service.NewStatic().GetInt("MULTI_NODE_MASTER_PORT", 29500)
+
+	return p, nil
+}
+
+// updateDeviceIDs는 자동 배치된 GPU를 modeling params에 기록합니다. 나머지 params는 그대로 둡니다.
+func (scheduler *TaskScheduler) updateDeviceIDs(task *ent.Modeling, gpuIDs []int) error {
+	params := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(task.Params[0]), &params); err != nil {
+		return err
+	}
+	params["device_ids"] = gpuIDs
+
+	jsonBytes, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	task.Params = []string{string(jsonBytes)}
+
+	return scheduler.dao_modeling.UpdateParams(scheduler.ctx, task.ID, task.Params)
+}
+
+// engineRequest는 placement의 node 하나에 modeling 실행을 요청하는 engine client 함수입니다.
+type engineRequest func(c engine_client.Engine, ctx context.Context, req interface{}) error
+
+// dispatch는 placement의 모든 node에 요청을 보냅니다.
+// 한 node라도 실패하면 이미 요청을 받은 node들을 취소하고 GPU를 idle로 되돌립니다.
+func (scheduler *TaskScheduler) dispatch(p *placement, request engineRequest) error {
+	succeeded := []*placementNode{}
+	for _, node := range p.nodes {
+		if err := request(node.engine(), scheduler.ctx, p.body(node)); err != nil {
+			for _, done := range succeeded {
+				scheduler.cancelNode(done, p.modelingID)
+			}
+			scheduler.updateGPUIdle(p.gpuIDs())
+
+			return fmt.Errorf("%s (rank %d): %w", node.addr(), node.Rank, err)
+		}
+		succeeded = append(succeeded, node)
+	}
 
-	return addr, bytes.NewReader([]byte(fmt.Sprintf(`{"modeling_id":%d}`, task.ID))), engineParams.DeviceIDs, nil
+	return nil
+}
+
+func (scheduler *TaskScheduler) cancelNode(node *placementNode, modelingID int) error {
+	return node.engine().CancelTrain(scheduler.ctx, modelingID)
+}
+
+func (scheduler *TaskScheduler) updateGPUSRunning(gpu_ids []int, state string) {
+	scheduler.dao_gpu.UpdateManyState(scheduler.ctx, gpu_ids, state)
 }
 
 func (scheduler *TaskScheduler) updateGPUIdle(gpu_ids []int) {
-	scheduler.dao_gpu.UpdateManyState(scheduler.ctx, gpu_ids, utils.GPU_STATE_IDLE)
+	if err := scheduler.dao_gpu.UpdateManyState(scheduler.ctx, gpu_ids, utils.GPU_STATE_IDLE); err == nil {
+		for _, id := range gpu_ids {
+			utils.NewNotifier().Publish(utils.EVENT_GPU_IDLE, id)
+		}
+	}
 }
 
 func (scheduler *TaskScheduler) runComplete(task *ent.Modeling) {
-	if addr, params, _, err := scheduler.matchGPU(task); err != nil {
-		logger.Error(err)
-	} else if resp, err := http.Post(
-		addr+"/api/train/finish", "application/json",
-		params); err != nil {
+	if err := scheduler.executorOf(task).Submit(task, utils.EXECUTOR_JOB_FINISH); err != nil {
 		logger.Error(err)
-	} else if respBody, err := io.ReadAll(resp.Body); err != nil {
-		logger.Error(err)
-	} else {
-		logger.Debug(respBody)
 	}
 }
 
-func (scheduler *TaskScheduler) runModeling(task *ent.Modeling) {
-	if addr, params, _, err := scheduler.matchGPU(task); err != nil {
-		logger.Error(err)
-	} else if resp, err := http.Post(
-		addr+"/api/train", "application/json",
-		params); err != nil {
-		logger.Error(err)
-	} else if respBody, err := io.ReadAll(resp.Body); err != nil {
-		logger.Error(err)
-	} else {
-		logger.Debug(string(respBody))
-		scheduler.dao_modeling.UpdateState(scheduler.ctx, task.ID, utils.MODELING_STEP_REQUEST)
+func (scheduler *TaskScheduler) runModeling(task *ent.Modeling) error {
+	if err := scheduler.submit(task, utils.EXECUTOR_JOB_TRAIN); err != nil {
+		return err
 	}
+	scheduler.dao_modeling.UpdateState(scheduler.ctx, task.ID, utils.MODELING_STEP_REQUEST)
+
+	return nil
 }
 
-func (scheduler *TaskScheduler) runEvaluation(task *ent.Modeling) {
-	if addr, params, _, err := scheduler.matchGPU(task); err != nil {
-		logger.Error(err)
-	} else if resp, err := http.Post(
-		addr+"/api/evaluation",
-		"application/json", params); err != nil {
-		logger.Error(err)
-	} else if respBody, err := io.ReadAll(resp.Body); err != nil {
-		logger.Error(err)
-	} else {
-		logger.Debug(string(respBody))
-		scheduler.dao_modeling.UpdateState(scheduler.ctx, task.ID, utils.MODELING_STEP_REQUEST)
+func (scheduler *TaskScheduler) runEvaluation(task *ent.Modeling) error {
+	if err := scheduler.submit(task, utils.EXECUTOR_JOB_EVALUATION); err != nil {
+		return err
 	}
+	scheduler.dao_modeling.UpdateState(scheduler.ctx, task.ID, utils.MODELING_STEP_REQUEST)
+
+	return nil
 }
 
-func (scheduler *TaskScheduler) runBlind(task *ent.Modeling) {
-	if addr, params, _, err := scheduler.matchGPU(task); err != nil {
-		logger.Error(err)
-	} else if resp, err := http.Post(addr+"/api/vision/blind", "application/json", params); err != nil {
-		logger.Error(err)
-	} else {
-		logger.Debug(resp)
+func (scheduler *TaskScheduler) runBlind(task *ent.Modeling) error {
+	if err := scheduler.submit(task, utils.EXECUTOR_JOB_BLIND); err != nil {
+		return err
 	}
+	scheduler.dao_modeling.UpdateState(scheduler.ctx, task.ID, utils.MODELING_STEP_REQUEST)
+
+	return nil
 }
 
 func (scheduler *TaskScheduler) completeTasks() {
@@ -182,59 +593,175 @@ func (scheduler *TaskScheduler) completeTasks() {
 	}
 }
 
-func (scheduler *TaskScheduler) CancelTask(task *ent.Modeling) {
-	params := make(map[string]interface{})
-	if err := json.Unmarshal([]byte(task.Params[0]), &params); err != nil {
-		logger.Error(err)
+// releaseEvaluations는 평가나 blind test에 사용된 GPU 중 실행 중(request, run)인 modeling이 사용하지 않는 GPU를 idle로 되돌립니다.
+// 평가와 blind test의 GPU는 modeling이 끝나면(complete, fail, cancel 등) 다음 스케줄링에서 반환됩니다.
+func (scheduler *TaskScheduler) releaseEvaluations() {
+	gpus, err := scheduler.dao_gpu.SelectBusy(scheduler.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+	running, err := scheduler.dao_modeling.SelectManyRunning(scheduler.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
 		return
 	}
 
-	gpus := []float64{}
-	gpu_ids := []int{}
-	deviceBytes, _ := json.Marshal(params["device_ids"])
-	if err := json.Unmarshal(deviceBytes, &gpus); err == nil {
-		for _, v := range gpus {
-			gpu_ids = append(gpu_ids, int(v))
+	used := make(map[int]bool)
+	for _, task := range running {
+		engineParams := repo.EngineParams{}
+		if err := json.Unmarshal([]byte(task.Params[0]), &engineParams); err != nil {
+			// 어떤 GPU를 사용하는지 알 수 없으면 반환하지 않는다.
+			return
+		}
+		for _, id := range engineParams.DeviceIDs {
+			used[id] = true
 		}
 	}
 
-	if devices, err := scheduler.dao_device.SelectByGPU(scheduler.ctx, gpu_ids); err != nil {
-		return
-	} else if len(devices) > 0 {
-		client := &http.Client{}
-		addr := "http://" + devices[0].IP + ":" + strconv.Itoa(devices[0].Port) + "/api/train/" + strconv.Itoa(task.ID)
-		if req, err := http.NewRequest("DELETE", addr, nil); err != nil {
-			logger.Error(err)
-		} else if resp, err := client.Do(req); err != nil {
-			logger.Error(err)
-		} else if respBody, err := io.ReadAll(resp.Body); err != nil {
+	released := []int{}
+	for _, gpu := range gpus {
+		if gpu.State == utils.GPU_STATE_EVALUATION && !used[gpu.ID] {
+			released = append(released, gpu.ID)
+		}
+	}
+	if len(released) > 0 {
+		scheduler.updateGPUIdle(released)
+	}
+}
+
+// pollTasks는 상태를 직접 기록하지 않는 executor(slurm 등)에 실행 중인 modeling의 상태를 물어 반영합니다.
+func (scheduler *TaskScheduler) pollTasks() {
+	for name, executor := range scheduler.executors {
+		if name == utils.EXECUTOR_REST {
+			continue
+		}
+
+		taskEnts, err := scheduler.dao_modeling.SelectManyByExecutor(scheduler.ctx, name,
+			utils.MODELING_STEP_REQUEST, utils.MODELING_STEP_RUN)
+		if err != nil {
+			logger.CreateReport(&logger.CODE_DB_SELECT, err)
+			continue
+		}
+
+		for _, task := range taskEnts {
+			if step, err := executor.Poll(task); err != nil {
+				logger.Error(fmt.Sprintf("modeling %d: %v", task.ID, err))
+			} else if step != "" && step != task.ModelingStep {
+				scheduler.dao_modeling.UpdateState(scheduler.ctx, task.ID, step)
+			}
+		}
+	}
+}
+
+func (scheduler *TaskScheduler) CancelTask(task *ent.Modeling) {
+	// 사용자가 취소한 modeling은 preemption으로 되돌릴 대상이 아니다.
+	scheduler.forgetRequeue(task.ID)
+
+	if task.ModelingStep != utils.MODELING_STEP_IDLE {
+		if err := scheduler.executorOf(task).Cancel(task); err != nil {
 			logger.Error(err)
-		} else {
-			logger.Debug(respBody)
-			scheduler.updateGPUIdle(gpu_ids)
 		}
 	}
 
 	scheduler.dao_modeling.UpdateState(scheduler.ctx, task.ID, utils.MODELING_STEP_CANCEL)
 }
 
+func (scheduler *TaskScheduler) runTask(task *ent.Modeling) error {
+	switch task.ModelingType {
+	case utils.MODELING_TYPE_INITIAL:
+		fallthrough
+	case utils.MODELING_TYPE_UPDATE:
+		return scheduler.runModeling(task)
+	case utils.MODELING_TYPE_EVALUATION:
+		return scheduler.runEvaluation(task)
+	case utils.MODELING_TYPE_BLIND:
+		return scheduler.runBlind(task)
+	default:
+		return errors.New("invalid modeling task")
+	}
+}
+
 func (scheduler *TaskScheduler) runTasks() {
-	if taskEnts, err := scheduler.dao_modeling.SelectManyIdle(scheduler.ctx); err != nil {
+	taskEnts, err := scheduler.dao_modeling.SelectManyIdle(scheduler.ctx)
+	if err != nil {
 		logger.CreateReport(&logger.CODE_DB_SELECT, err)
-	} else {
-		for _, task := range taskEnts {
-			switch task.ModelingType {
-			case utils.MODELING_TYPE_INITIAL:
-				fallthrough
-			case utils.MODELING_TYPE_UPDATE:
-				scheduler.runModeling(task)
-			case utils.MODELING_TYPE_EVALUATION:
-				scheduler.runEvaluation(task)
-			case utils.MODELING_TYPE_BLIND:
-				scheduler.runBlind(task)
-			default:
-				logger.Error("Invalid modeling task")
+		return
+	}
+
+	running, err := scheduler.dao_modeling.SelectManyRunning(scheduler.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	limits, err := scheduler.quota.Load()
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	queue := newTaskQueue(taskEnts, running)
+	waits := make(map[int]queueWait)
+	defer func() {
+		scheduler.muWaits.Lock()
+		scheduler.waits = waits
+		scheduler.muWaits.Unlock()
+	}()
+
+	blocked := false
+	blockedPriority := 0
+	now := time.Now()
+	for item := queue.pop(); item != nil; item = queue.pop() {
+		// 재시도 대기 중인 modeling은 건너뛰며, 대기열을 막지도 않는다.
+		if item.modeling.NextAttemptAt != nil && item.modeling.NextAttemptAt.After(now) {
+			waits[item.modeling.ID] = queueWait{utils.QUEUE_WAIT_RETRY,
+				fmt.Sprintf("next attempt at %s: %s", item.modeling.NextAttemptAt.Format(time.RFC3339), item.modeling.FailReason)}
+			continue
+		}
+
+		// 높은 우선순위 modeling이 GPU를 기다리는 동안 낮은 우선순위 modeling은 실행하지 않는다.
+		if blocked && item.modeling.Priority < blockedPriority {
+			waits[item.modeling.ID] = queueWait{utils.QUEUE_WAIT_PRIORITY,
+				fmt.Sprintf("modeling with priority %d is waiting for gpus", blockedPriority)}
+			continue
+		}
+
+		// GPU quota를 넘는 modeling은 건너뛰며, 대기열을 막지도 않는다.
+		if err := limits.CheckGPUs(item.modeling); err != nil {
+			logger.Debug(fmt.Sprintf("modeling %d: %v", item.modeling.ID, err))
+			waits[item.modeling.ID] = queueWait{utils.QUEUE_WAIT_QUOTA, err.Error()}
+			continue
+		}
+
+		err := scheduler.runTask(item.modeling)
+		// 우선순위가 높은 modeling은 낮은 우선순위 modeling을 중단시키고 비운 GPU에 바로 다시 시도한다.
+		if errors.Is(err, errNoIdleGPU) || errors.Is(err, errNotEnoughGPUs) {
+			if gpuIDs := scheduler.preempt(item.modeling); len(gpuIDs) > 0 {
+				scheduler.claimed[item.modeling.ID] = gpuIDs
+				err = scheduler.runTask(item.modeling)
+				delete(scheduler.claimed, item.modeling.ID)
+			}
+		}
+		if err != nil {
+			if reason := waitReason(err); reason != "" {
+				logger.Debug(fmt.Sprintf("modeling %d: %v", item.modeling.ID, err))
+				waits[item.modeling.ID] = queueWait{reason, err.Error()}
+				if !blocked && reason != utils.QUEUE_WAIT_RESERVED {
+					blocked = true
+					blockedPriority = item.modeling.Priority
+				}
+			} else {
+				logger.Error(fmt.Sprintf("modeling %d: %v", item.modeling.ID, err))
+				waits[item.modeling.ID] = queueWait{utils.QUEUE_WAIT_RETRY, err.Error()}
+				scheduler.failAttempt(item.modeling, err)
 			}
+			continue
 		}
+
+		scheduler.resetAttempt(item.modeling)
+		scheduler.forgetRequeue(item.modeling.ID)
+		queue.charge(item)
+		limits.Charge(item.modeling)
 	}
 }
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	device_repo "api_server/device/repository"
+	"api_server/ent"
+	"api_server/ent/modeling"
+	"api_server/logger"
+	quota_repo "api_server/quota/repository"
+	quota_service "api_server/quota/service"
+	reservation_repo "api_server/reservation/repository"
+	reservation_service "api_server/reservation/service"
+	repo "api_server/task/repository"
+	user_repo "api_server/user/repository"
+	"api_server/utils"
+	"api_server/utils/dbtest"
+)
+
+// newTestScheduler는 in-memory DB를 사용하는 TaskScheduler를 만듭니다. 호출할 때마다 DB는 비워집니다.
+func newTestScheduler(t *testing.T) (*TaskScheduler, *ent.Client) {
+	logger.InitLogger("", "/workspace/log/test.log")
+	client := dbtest.Open(t)
+
+	scheduler := &TaskScheduler{
+		ctx:            context.Background(),
+		dao_task:       repo.NewTaskDAO(),
+		dao_modeling:   repo.NewModelingDAO(),
+		dao_device:     *device_repo.New(),
+		dao_gpu:        *device_repo.NewGPUDAO(),
+		dao_preemption: repo.NewPreemptionDAO(),
+		quota:          quota_service.NewQuotaService(quota_repo.NewQuotaDAO(), user_repo.NewUserDAO(), repo.NewTaskDAO(), repo.NewModelingDAO()),
+		reservation:    reservation_service.NewReservationService(reservation_repo.NewReservationDAO(), device_repo.New(), device_repo.NewGPUDAO(), device_repo.NewMaintenanceDAO()),
+		waits:          make(map[int]queueWait),
+		claimed:        make(map[int][]int),
+		requeues:       make(map[int]time.Time),
+	}
+	scheduler.executors = map[string]ITaskExecutor{utils.EXECUTOR_REST: newRestExecutor(scheduler)}
+
+	return scheduler, client
+}
+
+// addTestDevice는 ip:port의 engine을 available device로 등록하고 idle GPU gpus개를 붙입니다.
+func addTestDevice(t *testing.T, client *ent.Client, ip string, port int, connection string, gpus int) (*ent.Device, []*ent.Gpu) {
+	ctx := context.Background()
+	device, err := client.Device.Create().
+		SetName(fmt.Sprintf("%s:%d", ip, port)).
+		SetIP(ip).
+		SetPort(port).
+		SetConnection(connection).
+		SetAvailable("true").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created := []*ent.Gpu{}
+	for i := 0; i < gpus; i++ {
+		gpu, err := client.Gpu.Create().
+			SetUUID(fmt.Sprintf("GPU-%d-%d", device.ID, i)).
+			SetIndex(i).
+			SetName("Test GPU").
+			SetDeviceID(device.ID).
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		created = append(created, gpu)
+	}
+
+	return device, created
+}
+
+// addTestModeling은 engineType task의 modeling을 step 상태로 만듭니다.
+func addTestModeling(t *testing.T, client *ent.Client, engineType string, step string, priority int, params repo.EngineParams) *ent.Modeling {
+	ctx := context.Background()
+	task, err := client.Task.Create().SetDatasetID(1).SetEngineType(engineType).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := client.Modeling.Create().
+		SetTaskID(task.ID).
+		SetParams([]string{string(encoded)}).
+		SetModelingStep(step).
+		SetPriority(priority).
+		SetProgress(0).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client.Modeling.Query().Where(modeling.ID(m.ID)).WithTask().OnlyX(ctx)
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	device_service "api_server/device/service"
+	"api_server/engine/fake"
+	"api_server/ent"
+	"api_server/ent/schema"
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SchedulerTestSuite struct {
+	suite.Suite
+}
+
+// setEngineTypes는 device의 engine이 engineTypes만 실행할 수 있다고 보고한 것처럼 기록합니다.
+func setEngineTypes(client *ent.Client, device *ent.Device, engineTypes ...string) {
+	client.Device.UpdateOneID(device.ID).
+		SetCapabilities(&schema.DeviceCapabilities{EngineTypes: engineTypes}).
+		ExecX(context.Background())
+}
+
+func (suite *SchedulerTestSuite) TestIncompatibleDevices() {
+	scheduler, client := newTestScheduler(suite.T())
+	tabular, _ := addTestDevice(suite.T(), client, "10.0.0.1", 5000, utils.DEVICE_CONNECTION_REST, 1)
+	vision, _ := addTestDevice(suite.T(), client, "10.0.0.2", 5000, utils.DEVICE_CONNECTION_REST, 1)
+	setEngineTypes(client, tabular, utils.JOB_TYPE_TABLE_CLS)
+	setEngineTypes(client, vision, utils.JOB_TYPE_VISION_AD, utils.JOB_TYPE_VISION_CLS_SL)
+
+	task := addTestModeling(suite.T(), client, utils.JOB_TYPE_VISION_AD, utils.MODELING_STEP_IDLE, 0, repo.EngineParams{GPUAuto: true})
+	incompatible, err := scheduler.incompatibleDevices(task)
+	suite.NoError(err)
+	suite.Len(incompatible, 1)
+	suite.ErrorIs(incompatible[tabular.ID], device_service.ErrEngineUnsupported)
+
+	// 어느 device도 실행할 수 없으면 오류
+	unknown := addTestModeling(suite.T(), client, "tod", utils.MODELING_STEP_IDLE, 0, repo.EngineParams{GPUAuto: true})
+	_, err = scheduler.incompatibleDevices(unknown)
+	suite.ErrorIs(err, device_service.ErrEngineUnsupported)
+}
+
+// 자동 배치는 engine type을 실행할 수 없는 device의 GPU를 사용하지 않는다.
+func (suite *SchedulerTestSuite) TestMatchCompatibleGPU() {
+	scheduler, client := newTestScheduler(suite.T())
+	// 후보 GPU가 적은 device를 먼저 고르므로 engine type을 확인하지 않으면 tabular device가 선택된다.
+	tabular, _ := addTestDevice(suite.T(), client, "10.0.0.1", 5000, utils.DEVICE_CONNECTION_REST, 1)
+	vision, visionGPUs := addTestDevice(suite.T(), client, "10.0.0.2", 5000, utils.DEVICE_CONNECTION_REST, 2)
+	setEngineTypes(client, tabular, utils.JOB_TYPE_TABLE_CLS)
+	setEngineTypes(client, vision, utils.JOB_TYPE_VISION_AD)
+
+	task := addTestModeling(suite.T(), client, utils.JOB_TYPE_VISION_AD, utils.MODELING_STEP_IDLE, 0, repo.EngineParams{GPUAuto: true, GPUCount: 1})
+	p, err := scheduler.matchGPU(task)
+	suite.Require().NoError(err)
+	suite.Equal(vision.ID, p.master().DeviceID)
+	suite.Contains([]int{visionGPUs[0].ID, visionGPUs[1].ID}, p.gpuIDs()[0])
+}
+
+// 사용자가 지정한 GPU의 device가 engine type을 실행할 수 없으면 그 이유로 실패한다.
+func (suite *SchedulerTestSuite) TestMatchPinnedIncompatibleGPU() {
+	scheduler, client := newTestScheduler(suite.T())
+	tabular, tabularGPUs := addTestDevice(suite.T(), client, "10.0.0.1", 5000, utils.DEVICE_CONNECTION_REST, 1)
+	addTestDevice(suite.T(), client, "10.0.0.2", 5000, utils.DEVICE_CONNECTION_REST, 1)
+	setEngineTypes(client, tabular, utils.JOB_TYPE_TABLE_CLS)
+
+	task := addTestModeling(suite.T(), client, utils.JOB_TYPE_VISION_AD, utils.MODELING_STEP_IDLE, 0, repo.EngineParams{DeviceIDs: []int{tabularGPUs[0].ID}})
+	_, err := scheduler.matchGPU(task)
+	suite.ErrorIs(err, device_service.ErrEngineUnsupported)
+}
+
+// reserve는 device의 gpus를 지금부터 한 시간 동안 username에게 예약합니다.
+func reserve(client *ent.Client, device *ent.Device, username string, gpus ...*ent.Gpu) {
+	ids := []int{}
+	for _, gpu := range gpus {
+		ids = append(ids, gpu.ID)
+	}
+	client.Reservation.Create().
+		SetDeviceID(device.ID).
+		SetGpuIds(ids).
+		SetUsername(username).
+		SetStartsAt(time.Now().Add(-time.Minute)).
+		SetEndsAt(time.Now().Add(time.Hour)).
+		ExecX(context.Background())
+}
+
+// 예약이 시작되면 예약한 user가 아닌 modeling은 예약된 GPU에서 중단하고 대기열로 되돌린다.
+func (suite *SchedulerTestSuite) TestEnforceReservations() {
+	scheduler, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	engine := fake.New(2)
+	defer engine.Close()
+	device, gpus := addTestDevice(suite.T(), client, engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_REST, 2)
+
+	other := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpus[0].ID}})
+	owner := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpus[1].ID}})
+	client.Modeling.UpdateOneID(other.ID).SetUsername("alice").ExecX(ctx)
+	client.Modeling.UpdateOneID(owner.ID).SetUsername("bob").ExecX(ctx)
+	for _, m := range []*ent.Modeling{other, owner} {
+		p, err := scheduler.locate(m)
+		suite.Require().NoError(err)
+		suite.Require().NoError(scheduler.dispatch(p, restExecutorRequests[utils.EXECUTOR_JOB_TRAIN]))
+		scheduler.updateGPUSRunning(p.gpuIDs(), utils.GPU_STATE_MODEING)
+	}
+
+	// 아직 시작하지 않은 예약은 실행 중인 modeling을 중단하지 않는다.
+	client.Reservation.Create().SetDeviceID(device.ID).SetUsername("bob").
+		SetStartsAt(time.Now().Add(time.Hour)).SetEndsAt(time.Now().Add(2 * time.Hour)).ExecX(ctx)
+	scheduler.enforceReservations()
+	suite.ElementsMatch([]int{other.ID, owner.ID}, engine.Running())
+
+	reserve(client, device, "bob", gpus...)
+	scheduler.enforceReservations()
+	suite.Equal([]int{owner.ID}, engine.Running())
+	suite.Equal(utils.MODELING_STEP_IDLE, client.Modeling.GetX(ctx, other.ID).ModelingStep)
+	suite.Equal(utils.MODELING_STEP_RUN, client.Modeling.GetX(ctx, owner.ID).ModelingStep)
+	suite.Equal(utils.GPU_STATE_IDLE, client.Gpu.GetX(ctx, gpus[0].ID).State)
+	suite.Equal(utils.GPU_STATE_MODEING, client.Gpu.GetX(ctx, gpus[1].ID).State)
+}
+
+// noDurationsDAO는 PostgreSQL 전용 SQL로 평균 실행 시간을 조회하는 SelectDurations만 기록이 없는 것으로 바꿉니다.
+type noDurationsDAO struct {
+	repo.IModelingDAO
+}
+
+func (dao noDurationsDAO) SelectDurations(ctx context.Context) (map[string]time.Duration, error) {
+	return map[string]time.Duration{}, nil
+}
+
+// 예약된 GPU를 기다리는 modeling은 우선순위가 높아도 다른 modeling의 실행을 막지 않는다.
+func (suite *SchedulerTestSuite) TestReservedDoesNotBlockQueue() {
+	scheduler, client := newTestScheduler(suite.T())
+	scheduler.dao_modeling = noDurationsDAO{scheduler.dao_modeling}
+	ctx := context.Background()
+	engine := fake.New(2)
+	defer engine.Close()
+	device, gpus := addTestDevice(suite.T(), client, engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_REST, 2)
+	reserve(client, device, "bob", gpus[0])
+
+	pinned := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 10, repo.EngineParams{DeviceIDs: []int{gpus[0].ID}})
+	auto := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 10, repo.EngineParams{GPUAuto: true, GPUCount: 2})
+	low := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_IDLE, 0, repo.EngineParams{GPUAuto: true, GPUCount: 1})
+	for _, m := range []*ent.Modeling{pinned, auto, low} {
+		client.Modeling.UpdateOneID(m.ID).SetModelingType(utils.MODELING_TYPE_INITIAL).SetUsername("alice").ExecX(ctx)
+	}
+
+	scheduler.runTasks()
+	suite.Equal(utils.QUEUE_WAIT_RESERVED, scheduler.waits[pinned.ID].reason)
+	suite.Equal(utils.QUEUE_WAIT_RESERVED, scheduler.waits[auto.ID].reason)
+	suite.Equal([]int{low.ID}, engine.Running())
+	suite.Equal(utils.GPU_STATE_MODEING, client.Gpu.GetX(ctx, gpus[1].ID).State)
+}
+
+// blind test도 평가처럼 GPU를 사용 중으로 표시하고 끝나면 반환한다.
+func (suite *SchedulerTestSuite) TestBlindGPUs() {
+	scheduler, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	engine := fake.New(3)
+	defer engine.Close()
+	_, gpus := addTestDevice(suite.T(), client, engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_REST, 3)
+
+	blind := addTestModeling(suite.T(), client, utils.JOB_TYPE_VISION_CLS_SL, utils.MODELING_STEP_IDLE, 0, repo.EngineParams{DeviceIDs: []int{gpus[0].ID}})
+	suite.Require().NoError(scheduler.runBlind(blind))
+	suite.Equal(utils.GPU_STATE_EVALUATION, client.Gpu.GetX(ctx, gpus[0].ID).State)
+	suite.Equal(utils.MODELING_STEP_REQUEST, client.Modeling.GetX(ctx, blind.ID).ModelingStep)
+
+	// 실행 중인 blind test의 GPU와 학습 GPU는 그대로 두고 끝난 평가의 GPU만 반환한다.
+	scheduler.updateGPUSRunning([]int{gpus[1].ID}, utils.GPU_STATE_EVALUATION)
+	scheduler.updateGPUSRunning([]int{gpus[2].ID}, utils.GPU_STATE_MODEING)
+	scheduler.releaseEvaluations()
+	suite.Equal(utils.GPU_STATE_EVALUATION, client.Gpu.GetX(ctx, gpus[0].ID).State)
+	suite.Equal(utils.GPU_STATE_IDLE, client.Gpu.GetX(ctx, gpus[1].ID).State)
+	suite.Equal(utils.GPU_STATE_MODEING, client.Gpu.GetX(ctx, gpus[2].ID).State)
+
+	client.Modeling.UpdateOneID(blind.ID).SetModelingStep(utils.MODELING_STEP_COMPLETE).ExecX(ctx)
+	scheduler.releaseEvaluations()
+	suite.Equal(utils.GPU_STATE_IDLE, client.Gpu.GetX(ctx, gpus[0].ID).State)
+}
+
+func TestSchedulerTestSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerTestSuite))
+}
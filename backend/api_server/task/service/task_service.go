@@ -1,22 +1,24 @@
 package service
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
 	"strconv"
 	"sync"
 
 	config_service "api_server/configuration/service"
 	repo_dataset "api_server/dataset/repository"
 	repo_device "api_server/device/repository"
+	engine_client "api_server/engine/client"
 	"api_server/ent"
 	"api_server/logger"
+	quota_repo "api_server/quota/repository"
+	quota_service "api_server/quota/service"
 	"api_server/task/csvformat"
 	repo "api_server/task/repository"
+	user_repo "api_server/user/repository"
 	"api_server/utils"
 )
 
@@ -89,6 +91,8 @@ type TaskService struct {
 	dao_modeling repo.IModelingDAO
 	dao_dataset  repo_dataset.DatasetDAOInterface
 	dao_device   repo_device.IDeviceDAO
+	dao_history  repo.IHyperParamsDAO
+	svc_quota    quota_service.IQuotaService
 }
 
 var onceTask sync.Once
@@ -107,6 +111,8 @@ func NewTaskService(dao repo.ITaskDAO,
 			dao_modeling: dao_modeling,
 			dao_dataset:  dao_dataset,
 			dao_device:   dao_device,
+			dao_history:  repo.NewHyperParamsDAO(),
+			svc_quota:    quota_service.NewQuotaService(quota_repo.NewQuotaDAO(), user_repo.NewUserDAO(), repo.NewTaskDAO(), repo.NewModelingDAO()),
 		}
 	})
 
@@ -117,6 +123,32 @@ func (svc *TaskService) Create(req repo.TaskDTO) (*repo.TaskDTO, *repo.ModelingD
 
 	req.UserParams["engine_type"] = req.EngineType
 
+	for _, stage := range req.Pipeline {
+		if (stage.Type != utils.MODELING_TYPE_EVALUATION && stage.Type != utils.MODELING_TYPE_BLIND) || stage.DatasetID < 1 {
+			return nil, nil, logger.CreateReport(&logger.CODE_PIPELINE_INVALID, fmt.Errorf("%+v", stage))
+		}
+	}
+	if len(req.Pipeline) > 0 {
+		req.PipelineStatus = utils.PIPELINE_STATUS_RUN
+	}
+	if req.Search != nil {
+		if err := validateSearch(req.Search); err != nil {
+			return nil, nil, logger.CreateReport(&logger.CODE_SEARCH_INVALID, err)
+		}
+		req.SearchStatus = utils.SEARCH_STATUS_RUN
+	}
+	if err := validateEarlyStop(req.EarlyStop); err != nil {
+		return nil, nil, logger.CreateReport(&logger.CODE_EARLY_STOP_INVALID, err)
+	}
+
+	project_id := 0
+	if req.ProjectID != nil {
+		project_id = *req.ProjectID
+	}
+	if r := svc.svc_quota.CheckQueue(project_id, req.Username); r != nil {
+		return nil, nil, r
+	}
+
 	if jsonstr, err := json.Marshal(req.UserParams); err != nil {
 		return nil, nil, logger.CreateReport(&logger.CODE_JSON_MARSHAL, err)
 	} else {
@@ -131,10 +163,29 @@ func (svc *TaskService) Create(req repo.TaskDTO) (*repo.TaskDTO, *repo.ModelingD
 	if r != nil {
 		return nil, nil, r
 	}
+	modeling.Priority = req.Priority
+	modeling.Username = req.Username
+	if len(req.Pipeline) > 0 {
+		modeling.PipelineStage = 1
+	}
+	// hyperparameter 탐색이면 첫 번째 modeling이 첫 trial이 되고, 나머지 trial은 SearchService가 만든다.
+	var config map[string]interface{}
+	if task.Search != nil {
+		config = proposeSearchTrial(task.Search, 1, nil)
+		if r := applySearchTrial(modeling, 1, config); r != nil {
+			return nil, nil, r
+		}
+	}
 	m, err := svc.dao_modeling.InsertOne(svc.ctx, *modeling)
 	if err != nil {
 		return nil, nil, logger.CreateReport(&logger.CODE_DB_INSERT, err)
 	}
+	if config != nil {
+		if r := recordSearchTrial(svc.ctx, svc.dao_history, m.ID, 1, config); r != nil {
+			return nil, nil, r
+		}
+	}
+	utils.NewNotifier().Publish(utils.EVENT_TASK_CREATED, task.ID)
 	return repo.ConvertTaskEntToDTO(task), repo.ConvertModelingEntToDTO(m), nil
 }
 
@@ -194,13 +245,8 @@ func (svc *TaskService) DeleteOne(id int) *logger.Report {
 			return logger.CreateReport(&logger.CODE_DB_SELECT, errors.New("there are no active devices"))
 		} else {
 			for _, device := range devices {
-				addr := "http://" + device.IP + ":" + strconv.Itoa(device.Port) + "/api/remove"
-				params := bytes.NewReader([]byte(fmt.Sprintf(`{"target_path":"%s"}`, targetPath)))
-				logger.Debug(addr, string(fmt.Sprintf(`{"target_path":%s}`, targetPath)))
-				if resp, err := http.Post(addr, "application/json", params); err != nil {
+				if err := engine_client.ForDevice(device.IP, device.Port, device.Connection, engine_client.DefaultTimeout).Remove(svc.ctx, targetPath); err != nil {
 					logger.Debug(&logger.CODE_REMOTE_SELECT, err)
-				} else {
-					logger.Debug(resp)
 				}
 			}
 		}
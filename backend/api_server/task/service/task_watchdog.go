@@ -0,0 +1,122 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	config_service "api_server/configuration/service"
+	engine_client "api_server/engine/client"
+	"api_server/ent"
+	"api_server/logger"
+	"api_server/utils"
+)
+
+// Watchdog은 heartbeat가 끊긴 modeling을 찾아 engine에 실행 여부를 확인합니다.
+// heartbeat는 modeling.updated_at, 최근 enginelog, 최근 trial_status 중 가장 늦은 시각입니다.
+// WATCHDOG_TIMEOUT_<ENGINE_TYPE>(초, 예: WATCHDOG_TIMEOUT_VCLS_SL), 없으면 WATCHDOG_TIMEOUT(초) 동안 heartbeat가 없고
+// engine도 modeling을 실행 중이라고 답하지 않으면 실패 처리하고 GPU를 idle로 되돌립니다.
+// 상태를 직접 조회하는 executor(slurm 등)의 modeling은 pollTasks가 처리하므로 제외합니다.
+func (scheduler *TaskScheduler) Watchdog() {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+
+	tasks, err := scheduler.dao_modeling.SelectManyRunning(scheduler.ctx)
+	if err != nil {
+		logger.CreateReport(&logger.CODE_DB_SELECT, err)
+		return
+	}
+
+	now := time.Now()
+	for _, task := range tasks {
+		if task.Executor != "" && task.Executor != utils.EXECUTOR_REST {
+			continue
+		}
+
+		heartbeat, err := scheduler.dao_modeling.SelectHeartbeat(scheduler.ctx, task.ID)
+		if err != nil {
+			logger.CreateReport(&logger.CODE_DB_SELECT, err)
+			continue
+		}
+		timeout := watchdogTimeout(task)
+		if !isStalled(heartbeat, now, timeout) {
+			continue
+		}
+
+		// 배치를 찾을 수 없으면 engine에 확인할 수도, GPU를 되돌릴 수도 없으므로 실패 처리하지 않는다.
+		p, err := scheduler.locate(task)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("watchdog: modeling %d has no heartbeat since %s but its placement is unknown: %v", task.ID, heartbeat, err))
+			continue
+		}
+		if scheduler.probe(p) {
+			logger.Debug(fmt.Sprintf("watchdog: modeling %d has no heartbeat since %s but engine is running it", task.ID, heartbeat))
+			continue
+		}
+
+		reason := fmt.Sprintf("no heartbeat since %s (timeout %s) and engine did not answer", heartbeat.Format(time.RFC3339), timeout)
+		if err := scheduler.dao_modeling.UpdateFail(scheduler.ctx, task.ID, reason); err != nil {
+			logger.CreateReport(&logger.CODE_DB_UPDATE, err)
+			continue
+		}
+		logger.Warn(fmt.Sprintf("watchdog: modeling %d: %s", task.ID, reason))
+		if len(p.gpuIDs()) > 0 {
+			scheduler.updateGPUIdle(p.gpuIDs())
+		}
+		utils.NewNotifier().Publish(utils.EVENT_MODELING_STALL, task.ID)
+	}
+}
+
+// probe는 modeling이 배치된 engine 중 하나라도 modeling을 실행 중이라고 답하는지 확인합니다.
+// 응답은 했지만 오류를 돌려준 engine은 실행 여부를 알 수 없으므로 실행 중으로 봅니다.
+// 다만 실행 목록 API가 없는 engine(404, 501)은 확인할 수 없으므로 heartbeat 제한 시간만으로 판단합니다.
+func (scheduler *TaskScheduler) probe(p *placement) bool {
+	for _, node := range p.nodes {
+		ids, err := scheduler.runningOn(&ent.Device{ID: node.DeviceID, IP: node.IP, Port: node.Port, Connection: node.Connection})
+		if err != nil {
+			logger.Warn(fmt.Sprintf("watchdog: device %d (%s:%d): %v", node.DeviceID, node.IP, node.Port, err))
+			if !engine_client.IsUnreachable(err) && !isUnsupported(err) {
+				return true
+			}
+			continue
+		}
+		if ids[p.modelingID] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isUnsupported는 engine이 요청한 API를 제공하지 않아 생긴 오류인지 확인합니다.
+func isUnsupported(err error) bool {
+	status := engine_client.StatusCode(err)
+	return status == http.StatusNotFound || status == http.StatusNotImplemented
+}
+
+// watchdogTimeout은 modeling의 engine type에 맞는 heartbeat 제한 시간을 설정에서 읽습니다.
+func watchdogTimeout(task *ent.Modeling) time.Duration {
+	cf := config_service.NewStatic()
+	seconds := cf.GetInt("WATCHDOG_TIMEOUT", 1800)
+	if task.Edges.Task != nil && task.Edges.Task.EngineType != "" {
+		seconds = cf.GetInt(watchdogTimeoutKey(task.Edges.Task.EngineType), seconds)
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// watchdogTimeoutKey는 engine type별 제한 시간 설정 key입니다.
+// engine type을 대문자로 바꾸고 '-'를 '_'로 바꿉니다. 예: vcls-sl -> WATCHDOG_TIMEOUT_VCLS_SL
+func watchdogTimeoutKey(engineType string) string {
+	return "WATCHDOG_TIMEOUT_" + strings.ReplaceAll(strings.ToUpper(engineType), "-", "_")
+}
+
+// isStalled는 heartbeat 이후 timeout이 지났는지 확인합니다. timeout이 0 이하이면 watchdog을 사용하지 않습니다.
+func isStalled(heartbeat time.Time, now time.Time, timeout time.Duration) bool {
+	if timeout <= 0 {
+		return false
+	}
+
+	return now.Sub(heartbeat) > timeout
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"api_server/engine/fake"
+	"api_server/ent"
+	repo "api_server/task/repository"
+	"api_server/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WatchdogTestSuite struct {
+	suite.Suite
+}
+
+func (suite *WatchdogTestSuite) TestIsStalled() {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	suite.False(isStalled(now.Add(-10*time.Minute), now, 30*time.Minute))
+	suite.True(isStalled(now.Add(-31*time.Minute), now, 30*time.Minute))
+	suite.False(isStalled(now.Add(-24*time.Hour), now, 0))
+}
+
+// stall은 modeling의 heartbeat를 WATCHDOG_TIMEOUT보다 오래 전으로 돌립니다.
+func stall(client *ent.Client, m *ent.Modeling) {
+	client.Modeling.UpdateOneID(m.ID).SetUpdatedAt(time.Now().Add(-24 * time.Hour)).ExecX(context.Background())
+}
+
+func (suite *WatchdogTestSuite) TestProbeEngine() {
+	scheduler, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	engine := fake.New(2)
+	defer engine.Close()
+	_, gpus := addTestDevice(suite.T(), client, engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_REST, 2)
+
+	alive := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpus[0].ID}})
+	p, err := scheduler.locate(alive)
+	suite.Require().NoError(err)
+	suite.Require().NoError(scheduler.dispatch(p, restExecutorRequests[utils.EXECUTOR_JOB_TRAIN]))
+	lost := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpus[1].ID}})
+	scheduler.updateGPUSRunning([]int{gpus[0].ID, gpus[1].ID}, utils.GPU_STATE_MODEING)
+	stall(client, alive)
+	stall(client, lost)
+
+	// engine이 실행 중이 아니라고 답한 modeling만 실패 처리하고 GPU를 되돌린다.
+	scheduler.Watchdog()
+	suite.Equal(utils.MODELING_STEP_RUN, client.Modeling.GetX(ctx, alive.ID).ModelingStep)
+	suite.Equal(utils.MODELING_STEP_FAIL, client.Modeling.GetX(ctx, lost.ID).ModelingStep)
+	suite.Equal(utils.GPU_STATE_MODEING, client.Gpu.GetX(ctx, gpus[0].ID).State)
+	suite.Equal(utils.GPU_STATE_IDLE, client.Gpu.GetX(ctx, gpus[1].ID).State)
+}
+
+// 응답은 했지만 오류를 돌려준 engine이나 배치를 알 수 없는 modeling은 실패 처리하지 않는다.
+func (suite *WatchdogTestSuite) TestUncertain() {
+	scheduler, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	engine := fake.New(1)
+	defer engine.Close()
+	_, gpus := addTestDevice(suite.T(), client, engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_REST, 1)
+
+	placed := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpus[0].ID}})
+	unknown := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{})
+	client.Modeling.UpdateOneID(unknown.ID).SetParams([]string{"{"}).ExecX(ctx)
+	scheduler.updateGPUSRunning([]int{gpus[0].ID}, utils.GPU_STATE_MODEING)
+	stall(client, placed)
+	stall(client, unknown)
+
+	engine.Fail(http.MethodGet, "/api/train/running", http.StatusInternalServerError)
+	scheduler.Watchdog()
+	suite.Equal(utils.MODELING_STEP_RUN, client.Modeling.GetX(ctx, placed.ID).ModelingStep)
+	suite.Equal(utils.MODELING_STEP_RUN, client.Modeling.GetX(ctx, unknown.ID).ModelingStep)
+	suite.Equal(utils.GPU_STATE_MODEING, client.Gpu.GetX(ctx, gpus[0].ID).State)
+
+	// 연결할 수 없는 engine은 실행 중이 아니다.
+	engine.Close()
+	stall(client, placed)
+	scheduler.Watchdog()
+	suite.Equal(utils.MODELING_STEP_FAIL, client.Modeling.GetX(ctx, placed.ID).ModelingStep)
+	suite.Equal(utils.GPU_STATE_IDLE, client.Gpu.GetX(ctx, gpus[0].ID).State)
+}
+
+// 실행 목록 API가 없는 engine은 실행 여부를 확인할 수 없으므로 heartbeat 제한 시간으로 판단한다.
+func (suite *WatchdogTestSuite) TestUnsupportedProbe() {
+	scheduler, client := newTestScheduler(suite.T())
+	ctx := context.Background()
+	engine := fake.New(1)
+	defer engine.Close()
+	_, gpus := addTestDevice(suite.T(), client, engine.IP(), engine.Port(), utils.DEVICE_CONNECTION_REST, 1)
+
+	task := addTestModeling(suite.T(), client, utils.JOB_TYPE_TABLE_CLS, utils.MODELING_STEP_RUN, 0, repo.EngineParams{DeviceIDs: []int{gpus[0].ID}})
+	scheduler.updateGPUSRunning([]int{gpus[0].ID}, utils.GPU_STATE_MODEING)
+	stall(client, task)
+
+	engine.Fail(http.MethodGet, "/api/train/running", http.StatusNotFound)
+	scheduler.Watchdog()
+	suite.Equal(utils.MODELING_STEP_FAIL, client.Modeling.GetX(ctx, task.ID).ModelingStep)
+	suite.Equal(utils.GPU_STATE_IDLE, client.Gpu.GetX(ctx, gpus[0].ID).State)
+}
+
+func (suite *WatchdogTestSuite) TestTimeoutKey() {
+	suite.Equal("WATCHDOG_TIMEOUT_TCLS", watchdogTimeoutKey(utils.JOB_TYPE_TABLE_CLS))
+	suite.Equal("WATCHDOG_TIMEOUT_VCLS_SL", watchdogTimeoutKey(utils.JOB_TYPE_VISION_CLS_SL))
+}
+
+func TestWatchdogTestSuite(t *testing.T) {
+	suite.Run(t, new(WatchdogTestSuite))
+}
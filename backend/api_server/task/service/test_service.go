@@ -1,18 +1,17 @@
 package service
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
-	"net/http"
 	"strconv"
 	"strings"
 	"sync"
 
+	device_service "api_server/device/service"
+	engine_client "api_server/engine/client"
 	"api_server/logger"
 	repo "api_server/task/repository"
 )
@@ -33,6 +32,8 @@ type loaddedModel struct {
 	ModelName string
 	ModelType string
 	ModelNum  int
+	DeviceID  int
+	GPUIndex  int
 }
 type tapiLoaddedModel struct {
 	TestId    int    `json:"test_id"`
@@ -108,69 +109,35 @@ func (s *TestService) LoadModel(reqDTO repo.TestDTO) (*loaddedModel, *logger.Rep
 		}
 	}
 
-	// API 서버 요청 준비
-	url := "http://localhost:5000/api/load"
-
-	// 요청 파라미터 구성
-	reqData := map[string]string{
-		"device_id":  "0",
-		"model_name": reqDTO.ModelName,
-		"model_path": modelPath,
-		"model_type": reqDTO.ModelType,
+	gpu, r := device_service.NewStatic().ViewGpu(reqDTO.DeviceID, reqDTO.GpuIndex)
+	if r != nil {
+		return nil, r
 	}
-
-	jsonData, err := json.Marshal(reqData)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_JSON_MARSHAL, err)
+	engine, r := device_service.DeviceEngine(gpu.DeviceID)
+	if r != nil {
+		return nil, r
 	}
 
-	// HTTP 요청 생성
-	req, err := http.NewRequestWithContext(s.ctx, "POST", url, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_CREATE_REQ, err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	// HTTP 요청 실행
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_REQUEST, err)
-	}
-	defer resp.Body.Close()
-
-	// 응답 처리
-	body, err := io.ReadAll(resp.Body)
+	resp, err := engine.Load(s.ctx, engine_client.LoadRequest{
+		DeviceID:  strconv.Itoa(gpu.Index),
+		ModelName: reqDTO.ModelName,
+		ModelPath: modelPath,
+		ModelType: reqDTO.ModelType,
+	})
 	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
-	}
-
-	contentType := resp.Header.Get("Content-Type")
-	if !strings.Contains(contentType, "application/json") {
-		logger.Debug("Non-JSON response body: ", string(body))
-		return nil, logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, errors.New(string(body)))
+		return nil, engine_client.Report(err)
 	}
-
-	// 응답 데이터 파싱
-	var apiResponse struct {
-		Name   string `json:"name"`
-		Models []struct {
-			ModelNum  int    `json:"model_num"`
-			ModelFile string `json:"model_file"`
-			Engine    string `json:"engine"`
-		} `json:"models"`
-	}
-
-	if err := json.Unmarshal(body, &apiResponse); err != nil {
-		return nil, logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, err)
+	if len(resp.Models) < 1 {
+		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, fmt.Errorf("engine loaded no model for %s", reqDTO.ModelName))
 	}
 
 	loadded := loaddedModel{
 		TestId:    len(s.loaddedModels),
 		ModelName: reqDTO.ModelName,
 		ModelType: reqDTO.ModelType,
-		ModelNum:  apiResponse.Models[0].ModelNum,
+		ModelNum:  resp.Models[0].ModelNum,
+		DeviceID:  gpu.DeviceID,
+		GPUIndex:  gpu.Index,
 	}
 
 	s.loaddedModels = append(s.loaddedModels, loadded)
@@ -182,60 +149,31 @@ func (s *TestService) LoadModel(reqDTO repo.TestDTO) (*loaddedModel, *logger.Rep
 func (s *TestService) UnloadModel(testId int) *logger.Report {
 	logger.Debug("TAPI Unload model: ", testId, s.tapiLoaddedModels)
 
-	url := "http://localhost:5000/api/model"
-
 	for _, loadded := range s.loaddedModels {
 		if loadded.TestId == testId {
-			reqData := map[string]interface{}{
-				"device_id":  "0",
-				"model_name": loadded.ModelName,
-				"model_num":  loadded.ModelNum,
+			engine, r := device_service.DeviceEngine(loadded.DeviceID)
+			if r != nil {
+				return r
 			}
-
-			jsonData, err := json.Marshal(reqData)
-			if err != nil {
-				return logger.CreateReport(&logger.CODE_JSON_MARSHAL, err)
-			}
-
-			// HTTP 요청 생성
-			req, err := http.NewRequestWithContext(s.ctx, "DELETE", url, bytes.NewBuffer(jsonData))
+			err := engine.Unload(s.ctx, engine_client.UnloadRequest{
+				DeviceID:  strconv.Itoa(loadded.GPUIndex),
+				ModelName: loadded.ModelName,
+				ModelNum:  loadded.ModelNum,
+			})
 			if err != nil {
-				return logger.CreateReport(&logger.CODE_REMOTE_CREATE_REQ, err)
+				return engine_client.Report(err)
 			}
 
-			req.Header.Set("Content-Type", "application/json")
-
-			// HTTP 요청 실행
-			client := &http.Client{}
-			resp, err := client.Do(req)
-			if err != nil {
-				return logger.CreateReport(&logger.CODE_REMOTE_REQUEST, err)
-			}
-			defer resp.Body.Close()
-
-			// 응답 처리
-			body, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
-			}
-
-			bodyStr := string(body)
-			logger.Debug(bodyStr)
-
-			// "Successfully" 포함 시 성공 처리
-			if strings.Contains(bodyStr, "Successfully") {
-				targetModelName := loadded.ModelName
-				filtered := make([]loaddedModel, 0)
-				for _, m := range s.loaddedModels {
-					if m.ModelName != targetModelName {
-						filtered = append(filtered, m)
-					}
+			targetModelName := loadded.ModelName
+			filtered := make([]loaddedModel, 0)
+			for _, m := range s.loaddedModels {
+				if m.ModelName != targetModelName {
+					filtered = append(filtered, m)
 				}
-				s.loaddedModels = filtered
-
-				return nil
 			}
-			return logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, fmt.Errorf("unload failed: %s", bodyStr))
+			s.loaddedModels = filtered
+
+			return nil
 		}
 	}
 
@@ -267,81 +205,27 @@ func (s *TestService) InferenceVCLS(testId int, filename string, image multipart
 		return nil, logger.CreateReport(&logger.CODE_REMOTE_NOT_FOUND_MODEL, fmt.Errorf("model with test ID %d not found", testId))
 	}
 
-	// multipart/form-data 준비
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
-
-	// 이미지 파일 추가
-	fw, err := w.CreateFormFile("file", filename)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
-	}
-
 	// 파일 위치를 처음으로 되돌리기 (이미 읽혔을 수 있음)
 	if seeker, ok := image.(io.Seeker); ok {
-		_, err = seeker.Seek(0, io.SeekStart)
-		if err != nil {
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
 			return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
 		}
 	}
 
-	if _, err = io.Copy(fw, image); err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
-	}
-
-	// 추가 파라미터 설정
-	params := map[string]string{
-		"device_id":  "0",
-		"model_name": model.ModelName,
-		"model_num":  fmt.Sprintf("%d", model.ModelNum),
-		"heatmap":    "true",
-	}
-
-	for key, value := range params {
-		if err = w.WriteField(key, value); err != nil {
-			return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
-		}
-	}
-
-	// multipart writer 닫기
-	if err = w.Close(); err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
-	}
-
-	// HTTP 요청 준비
-	url := "http://localhost:5000/api/vcls"
-	req, err := http.NewRequestWithContext(s.ctx, "POST", url, &b)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_CREATE_REQ, err)
-	}
-
-	// Content-Type 헤더 설정
-	req.Header.Set("Content-Type", w.FormDataContentType())
-
-	// HTTP 요청 실행
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_REQUEST, err)
+	engine, r := device_service.DeviceEngine(model.DeviceID)
+	if r != nil {
+		return nil, r
 	}
-	defer resp.Body.Close()
-
-	// 응답 본문 읽기
-	body, err := io.ReadAll(resp.Body)
+	result, err := engine.InferVCLS(s.ctx, engine_client.VCLSRequest{
+		DeviceID:  strconv.Itoa(model.GPUIndex),
+		ModelName: model.ModelName,
+		ModelNum:  model.ModelNum,
+		Heatmap:   "true",
+		Filename:  filename,
+		Image:     image,
+	})
 	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
-	}
-
-	// 응답 상태 코드 확인
-	if resp.StatusCode != http.StatusOK {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE,
-			fmt.Errorf("server returned non-OK status: %d, body: %s", resp.StatusCode, string(body)))
-	}
-
-	// 응답 파싱
-	var result map[string]interface{}
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, err)
+		return nil, engine_client.Report(err)
 	}
 
 	if heatmapObj, exists := result["heatmap"].(map[string]interface{}); exists {
@@ -385,68 +269,18 @@ func (s *TestService) InferenceTabular(testId int, xFeatures []map[string]interf
 		return nil, logger.CreateReport(&logger.CODE_JSON_MARSHAL, err)
 	}
 
-	// multipart/form-data 준비
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
-
-	// 파라미터 설정
-	params := map[string]string{
-		"device_id":  "0",
-		"model_name": model.ModelName,
-		"model_num":  fmt.Sprintf("%d", model.ModelNum),
-		"x_input":    string(xInputJSON),
-	}
-
-	for key, value := range params {
-		if err := w.WriteField(key, value); err != nil {
-			return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
-		}
-	}
-
-	// multipart writer 닫기
-	if err := w.Close(); err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REQUEST, err)
+	engine, r := device_service.DeviceEngine(model.DeviceID)
+	if r != nil {
+		return nil, r
 	}
-
-	// HTTP 요청 준비
-	url := "http://localhost:5000/api/tabular"
-	req, err := http.NewRequestWithContext(s.ctx, "POST", url, &b)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_CREATE_REQ, err)
-	}
-
-	// Content-Type 헤더 설정
-	req.Header.Set("Content-Type", w.FormDataContentType())
-
-	// HTTP 요청 실행
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_REQUEST, err)
-	}
-	defer resp.Body.Close()
-
-	// 응답 본문 읽기
-	body, err := io.ReadAll(resp.Body)
+	result, err := engine.InferTabular(s.ctx, engine_client.TabularRequest{
+		DeviceID:  strconv.Itoa(model.GPUIndex),
+		ModelName: model.ModelName,
+		ModelNum:  model.ModelNum,
+		XInput:    string(xInputJSON),
+	})
 	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
-	}
-
-	// 응답 상태 코드 확인
-	if resp.StatusCode != http.StatusOK {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE,
-			fmt.Errorf("server returned non-OK status: %d, body: %s", resp.StatusCode, string(body)))
-	}
-
-	// JSON 형식이 아닐 경우 대비
-	if !json.Valid(body) {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, fmt.Errorf("response is not valid JSON: %s", string(body)))
-	}
-
-	// 응답 파싱
-	var result map[string]interface{}
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, err)
+		return nil, engine_client.Report(err)
 	}
 
 	// 성공 응답 반환
@@ -485,49 +319,11 @@ func (s *TestService) PredictTabular(reqDTO repo.TabularTestRequest) (map[string
 		"data_inform_path": modelingPath + "/data_inform.json",
 	}
 
-	// JSON 요청 본문 생성
-	jsonData, err := json.Marshal(params)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_JSON_MARSHAL, err)
-	}
-
-	// HTTP 요청 준비
-	url := "http://localhost:5000/api/tabular/predict_x"
-	req, err := http.NewRequestWithContext(s.ctx, "POST", url, bytes.NewBuffer(jsonData))
+	result, err := device_service.RESTEngine().PredictTabular(s.ctx, params)
 	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_CREATE_REQ, err)
+		return nil, engine_client.Report(err)
 	}
-
-	// Content-Type 헤더 설정
-	req.Header.Set("Content-Type", "application/json")
-
-	// HTTP 요청 실행
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_REQUEST, err)
-	}
-	defer resp.Body.Close()
-
-	// 응답 본문 읽기
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
-	}
-
-	// 응답 상태 코드 확인
-	if resp.StatusCode != http.StatusOK {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE,
-			fmt.Errorf("server returned non-OK status: %d, body: %s", resp.StatusCode, string(body)))
-	}
-
-	// 이전 함수와 달리 이 엔드포인트는 응답 본문이 없을 수 있음
-	// 응답 본문이 비어있지 않다면 파싱 시도
-	var result map[string]interface{}
-	if len(body) > 0 {
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, err)
-		}
+	if result != nil {
 		return result, nil
 	}
 
@@ -552,58 +348,12 @@ func (s *TestService) GetDatasetColumns(modelingId int) (*repo.ColumnsResponse,
 		}
 	}
 
-	// multipart/form-data 생성
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-
-	// form 필드 추가
-	err = writer.WriteField("data_inform_path", dataInformPath)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_API_PARAM_ENGINE, err)
-	}
-
-	// form 닫기
-	err = writer.Close()
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_API_PARAM_ENGINE, err)
-	}
-
-	// Flask 서버로 요청 보내기
-	req, err := http.NewRequest("POST", "http://localhost:5000/api/tabular/columns", body)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_CREATE_REQ, err)
-	}
-
-	// 헤더 설정
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-
-	// 요청 보내기
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_REQUEST, err)
-	}
-	defer resp.Body.Close()
-
-	// 응답 읽기
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
-	}
-
-	// 상태 코드 확인
-	if resp.StatusCode != http.StatusOK {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
-	}
-
-	// 성공 응답 파싱
-	var columnsResp repo.ColumnsResponse
-	err = json.Unmarshal(respBody, &columnsResp)
+	columnsResp, err := device_service.RESTEngine().TabularColumns(s.ctx, dataInformPath)
 	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, err)
+		return nil, engine_client.Report(err)
 	}
 
-	return &columnsResp, nil
+	return columnsResp, nil
 }
 
 func (s *TestService) FeatureImportanceLIME(reqDTO repo.TabularTestRequest) (map[string]interface{}, *logger.Report) {
@@ -633,49 +383,11 @@ func (s *TestService) FeatureImportanceLIME(reqDTO repo.TabularTestRequest) (map
 		"data_inform_path": modelingPath + "/data_inform.json",
 	}
 
-	// JSON 요청 본문 생성
-	jsonData, err := json.Marshal(params)
+	result, err := device_service.RESTEngine().LimeTabular(s.ctx, params)
 	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_JSON_MARSHAL, err)
+		return nil, engine_client.Report(err)
 	}
-
-	// HTTP 요청 준비
-	url := "http://localhost:5000/api/tabular/lime"
-	req, err := http.NewRequestWithContext(s.ctx, "POST", url, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_CREATE_REQ, err)
-	}
-
-	// Content-Type 헤더 설정
-	req.Header.Set("Content-Type", "application/json")
-
-	// HTTP 요청 실행
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_REQUEST, err)
-	}
-	defer resp.Body.Close()
-
-	// 응답 본문 읽기
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE, err)
-	}
-
-	// 응답 상태 코드 확인
-	if resp.StatusCode != http.StatusOK {
-		return nil, logger.CreateReport(&logger.CODE_REMOTE_RESPONSE,
-			fmt.Errorf("server returned non-OK status: %d, body: %s", resp.StatusCode, string(body)))
-	}
-
-	// 이전 함수와 달리 이 엔드포인트는 응답 본문이 없을 수 있음
-	// 응답 본문이 비어있지 않다면 파싱 시도
-	var result map[string]interface{}
-	if len(body) > 0 {
-		if err := json.Unmarshal(body, &result); err != nil {
-			return nil, logger.CreateReport(&logger.CODE_JSON_UNMARSHAL, err)
-		}
+	if result != nil {
 		return result, nil
 	}
 
@@ -61,11 +61,76 @@ const (
 	MODELING_STEP_CANCEL   = "cancel"
 	MODELING_STEP_FAIL     = "fail"
 	MODELING_STEP_REQUEST  = "request" // sended to engine
+	MODELING_STEP_SKIP     = "skip"    // pipeline 상위 단계가 실패해 실행하지 않음
+
+	PIPELINE_STATUS_RUN      = "run"
+	PIPELINE_STATUS_COMPLETE = "complete"
+	PIPELINE_STATUS_FAIL     = "fail"
+	PIPELINE_STATUS_CANCEL   = "cancel"
+	PIPELINE_STAGE_PENDING   = "pending" // 상위 단계가 끝나기를 기다리는 중
+	PIPELINE_STAGE_TRAIN     = "train"
+
+	SEARCH_STATUS_RUN      = "run"
+	SEARCH_STATUS_COMPLETE = "complete"
+	SEARCH_STATUS_FAIL     = "fail"
+	SEARCH_STATUS_CANCEL   = "cancel"
+
+	SEARCH_STRATEGY_GRID   = "grid"
+	SEARCH_STRATEGY_RANDOM = "random"
+	SEARCH_STRATEGY_TPE    = "tpe"
+
+	SEARCH_PARAM_INT    = "int"
+	SEARCH_PARAM_FLOAT  = "float"
+	SEARCH_PARAM_LOG    = "log" // log scale float
+	SEARCH_PARAM_CHOICE = "choice"
+
+	SEARCH_DIRECTION_MAX = "max"
+	SEARCH_DIRECTION_MIN = "min"
+
+	SEARCH_TRIAL_UUID_PREFIX = "kais-search-" // engine이 기록한 hyper_params_history와 구분
+
+	EARLY_STOP_POLICY_MEDIAN   = "median"   // 같은 epoch의 다른 trial 중앙값보다 나쁘면 중단
+	EARLY_STOP_POLICY_PATIENCE = "patience" // patience epoch 동안 개선이 없으면 중단
+	EARLY_STOP_POLICY_HALVING  = "halving"  // successive halving rung에서 상위 1/reduction_factor 밖이면 중단
+
+	SCHEDULE_TRIGGER_CRON    = "cron"
+	SCHEDULE_TRIGGER_DATASET = "dataset"
+
+	SCHEDULE_RUN_CREATED = "created"
+	SCHEDULE_RUN_SKIPPED = "skipped"
+	SCHEDULE_RUN_FAILED  = "failed"
+
+	// 대기 중인 modeling이 아직 실행되지 않은 이유
+	QUEUE_WAIT_NO_IDLE_GPU    = "no idle gpus"
+	QUEUE_WAIT_QUOTA          = "quota"
+	QUEUE_WAIT_DEVICE_OFFLINE = "device offline"
+	QUEUE_WAIT_DEVICE_DRAIN   = "device draining" // 지정한 GPU의 device가 점검을 위해 drain 중
+	QUEUE_WAIT_RESERVED       = "reserved"        // 지정한 GPU가 다른 project나 user에게 예약됨
+	QUEUE_WAIT_PRIORITY       = "higher priority" // 높은 우선순위 modeling이 GPU를 기다리는 중
+	QUEUE_WAIT_RETRY          = "retry"           // 실행 요청 실패 후 재시도 대기 중
+
+	CALENDAR_ENTRY_RESERVATION = "reservation"
+	CALENDAR_ENTRY_MAINTENANCE = "maintenance"
+
+	QUOTA_TARGET_PROJECT    = "project"
+	QUOTA_TARGET_USER_GROUP = "user_group" // target_id는 user group level
 
 	GPU_STATE_IDLE       = "idle"
 	GPU_STATE_MODEING    = "modeling"
 	GPU_STATE_EVALUATION = "inference"
 	GPU_STATE_COMPLETE   = "complete"
+
+	EXECUTOR_REST  = "rest"
+	EXECUTOR_SLURM = "slurm"
+
+	EXECUTOR_JOB_TRAIN      = "train"
+	EXECUTOR_JOB_EVALUATION = "evaluation"
+	EXECUTOR_JOB_BLIND      = "blind"
+	EXECUTOR_JOB_FINISH     = "finish"
+
+	// device의 engine을 호출하는 방법(Device.connection)
+	DEVICE_CONNECTION_REST = "rest"
+	DEVICE_CONNECTION_GRPC = "grpc"
 )
 
 const (
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CronTestSuite struct {
+	suite.Suite
+	base time.Time
+}
+
+func (suite *CronTestSuite) SetupTest() {
+	// 2024-01-03 수요일 10:30
+	suite.base = time.Date(2024, 1, 3, 10, 30, 0, 0, time.UTC)
+}
+
+func (suite *CronTestSuite) next(expr string) time.Time {
+	c, err := ParseCron(expr)
+	suite.Require().NoError(err)
+	t, err := c.Next(suite.base)
+	suite.Require().NoError(err)
+	return t
+}
+
+func (suite *CronTestSuite) TestNext() {
+	suite.Equal(time.Date(2024, 1, 3, 10, 31, 0, 0, time.UTC), suite.next("* * * * *"))
+	suite.Equal(time.Date(2024, 1, 3, 10, 45, 0, 0, time.UTC), suite.next("*/15 * * * *"))
+	suite.Equal(time.Date(2024, 1, 4, 2, 0, 0, 0, time.UTC), suite.next("0 2 * * *"))
+	suite.Equal(time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC), suite.next("@weekly"))
+	suite.Equal(time.Date(2024, 1, 8, 3, 0, 0, 0, time.UTC), suite.next("0 3 * * 1"))
+	suite.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), suite.next("@monthly"))
+	suite.Equal(time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC), suite.next("0 9 * * 1-5/2"))
+	suite.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), suite.next("0 0 29 2 *"))
+	// 일과 요일이 모두 지정되면 둘 중 하나
+	suite.Equal(time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), suite.next("0 0 15 * 5"))
+}
+
+func (suite *CronTestSuite) TestInvalid() {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "*/0 * * * *", "a * * * *", "5-1 * * * *"} {
+		_, err := ParseCron(expr)
+		suite.Error(err, expr)
+	}
+
+	c, err := ParseCron("0 0 31 2 *")
+	suite.Require().NoError(err)
+	_, err = c.Next(suite.base)
+	suite.Error(err)
+}
+
+func TestCronTestSuite(t *testing.T) {
+	suite.Run(t, new(CronTestSuite))
+}
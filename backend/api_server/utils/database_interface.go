@@ -76,6 +76,12 @@ func GetEntClient() *ent.Client {
 	return entClient
 }
 
+// SetEntClient는 DB client를 바꿉니다. 테스트에서 PostgreSQL 대신 sqlite를 사용할 때 호출합니다.
+// DAO는 처음 만들어질 때의 client를 계속 사용하므로 DAO를 만들기 전에 호출해야 합니다.
+func SetEntClient(client *ent.Client) {
+	entClient = client
+}
+
 func InitDBMS() {
 	if entClient == nil {
 		initEntClient()
@@ -0,0 +1,61 @@
+// Package dbtest는 DAO를 사용하는 테스트가 PostgreSQL 없이 in-memory sqlite DB를 사용하게 합니다.
+package dbtest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+
+	"api_server/ent"
+	"api_server/ent/enttest"
+	"api_server/ent/migrate"
+	"api_server/utils"
+)
+
+var (
+	once   sync.Once
+	driver *entsql.Driver
+	client *ent.Client
+)
+
+// Open은 test binary마다 한 번 in-memory sqlite DB를 만들어 utils의 DB client로 지정하고, 모든 table을 비운 client를 반환합니다.
+// DAO는 처음 만들어질 때의 client를 계속 사용하므로 DAO를 만들기 전에 호출해야 합니다.
+func Open(t enttest.TestingT) *ent.Client {
+	once.Do(func() {
+		drv, err := entsql.Open("sqlite3", "file:kais?mode=memory&cache=shared&_fk=1")
+		if err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		// in-memory DB는 connection마다 따로 만들어지지 않도록 하나만 사용한다.
+		drv.DB().SetMaxOpenConns(1)
+		driver = drv
+		client = enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
+		// task_id, device_id 처럼 0을 기본값으로 쓰는 foreign key가 있어 test data는 foreign key를 확인하지 않는다.
+		if _, err := drv.DB().Exec("PRAGMA foreign_keys = OFF"); err != nil {
+			t.Error(err)
+			t.FailNow()
+		}
+		utils.SetEntClient(client)
+	})
+
+	if err := truncate(context.Background()); err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+
+	return client
+}
+
+func truncate(ctx context.Context) error {
+	for _, table := range migrate.Tables {
+		if _, err := driver.DB().ExecContext(ctx, fmt.Sprintf("DELETE FROM `%s`", table.Name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/csv"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"path/filepath"
@@ -285,6 +286,35 @@ func ReadDirs(path string) ([]os.DirEntry, error) {
 	return dirs, err
 }
 
+// DirFingerprint returns a value that changes when files under the path are added, removed or modified.
+// It is made of the file count, the total size and the latest modification time.
+func DirFingerprint(path string) (string, error) {
+	count, size, latest := 0, int64(0), int64(0)
+	err := filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		count++
+		size += info.Size()
+		if mod := info.ModTime().UnixNano(); mod > latest {
+			latest = mod
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d-%d-%d", count, size, latest), nil
+}
+
 func ReadFiles(path string, accept []string, filter []string) ([]os.DirEntry, error) {
 	f, err := os.Open(path)
 	if err != nil {
@@ -18,6 +18,11 @@ type TokenData struct {
 	Group    int    `json:"group"`    // 사용자 그룹
 }
 
+// IsAdmin은 관리자 그룹(0, 1)인지 확인합니다. 관리자 API의 GroupMiddleware(0, 1)와 같은 기준입니다.
+func (data *TokenData) IsAdmin() bool {
+	return data.Group == 0 || data.Group == 1
+}
+
 // GetDataFromToken은 Gin 컨텍스트에서 JWT를 통해 추출된 사용자 정보를 반환합니다.
 //
 // 이 함수는 JWTAuthMiddleware에서 c.Set으로 저장된 "username" 및 "group" 값을 기반으로
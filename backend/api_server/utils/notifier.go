@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"sync"
+)
+
+const (
+	EVENT_TASK_CREATED    = "task.created"    // 새 task와 초기 modeling이 등록됨
+	EVENT_MODELING_QUEUED = "modeling.queued" // modeling이 대기열에 추가되거나 순서가 바뀜
+	EVENT_MODELING_STEP   = "modeling.step"   // engine이 modeling 단계 변경을 알림
+	EVENT_GPU_IDLE        = "gpu.idle"        // GPU가 idle 상태가 됨
+	EVENT_DEVICE_UPDATED  = "device.updated"  // device/GPU 정보가 갱신됨
+	EVENT_DATASET_CHANGED = "dataset.changed" // dataset 파일이 추가/삭제/수정됨
+	EVENT_MODELING_STALL  = "modeling.stall"  // 응답 없는 modeling을 watchdog이 실패 처리함
+	EVENT_DEVICE_ONLINE   = "device.online"   // 응답하지 않던 device가 다시 응답함
+	EVENT_DEVICE_OFFLINE  = "device.offline"  // device가 health check에 응답하지 않음
+	EVENT_GPU_TELEMETRY   = "gpu.telemetry"   // device의 GPU telemetry가 새로 수집됨
+	EVENT_DEVICE_DRAINED  = "device.drained"  // drain 중인 device에서 실행 중인 작업과 올라간 모델이 모두 끝남
+	EVENT_GPU_RESERVED    = "gpu.reserved"    // GPU 예약이 추가, 변경되거나 삭제됨
+)
+
+// Event는 Notifier로 전달되는 알림입니다. ID는 topic에 따라 task, modeling, device ID 입니다.
+type Event struct {
+	Topic string
+	ID    int
+}
+
+// Notifier는 서비스 간 이벤트를 전달하는 프로세스 내부 pub/sub 입니다.
+//
+// Publish는 block 되지 않으며, 구독자의 버퍼가 가득 차면 이벤트를 버립니다.
+// 구독자는 이벤트를 "다시 확인하라"는 신호로만 사용하고 상태는 DB에서 읽어야 합니다.
+type Notifier struct {
+	mu          sync.RWMutex
+	subscribers map[string][]chan Event
+}
+
+var onceNotifier sync.Once
+var instanceNotifier *Notifier
+
+func NewNotifier() *Notifier {
+	onceNotifier.Do(func() {
+		instanceNotifier = &Notifier{
+			subscribers: make(map[string][]chan Event),
+		}
+	})
+
+	return instanceNotifier
+}
+
+// Subscribe는 topics 중 하나라도 발행되면 이벤트를 받는 채널을 반환합니다.
+func (n *Notifier) Subscribe(buffer int, topics ...string) <-chan Event {
+	ch := make(chan Event, buffer)
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, topic := range topics {
+		n.subscribers[topic] = append(n.subscribers[topic], ch)
+	}
+
+	return ch
+}
+
+// Publish는 topic 구독자들에게 이벤트를 보냅니다.
+func (n *Notifier) Publish(topic string, id int) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	event := Event{Topic: topic, ID: id}
+	for _, ch := range n.subscribers[topic] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Unsubscribe는 Subscribe로 받은 채널을 모든 topic에서 제거합니다.
+// websocket 연결처럼 구독자가 먼저 끝나는 경우에 사용합니다. 채널은 닫지 않습니다.
+func (n *Notifier) Unsubscribe(ch <-chan Event) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for topic, subscribers := range n.subscribers {
+		kept := subscribers[:0]
+		for _, s := range subscribers {
+			if s != ch {
+				kept = append(kept, s)
+			}
+		}
+		n.subscribers[topic] = kept
+	}
+}
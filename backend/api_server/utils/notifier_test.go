@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type NotifierTestSuite struct {
+	suite.Suite
+	notifier *Notifier
+}
+
+func (suite *NotifierTestSuite) SetupTest() {
+	suite.notifier = &Notifier{subscribers: make(map[string][]chan Event)}
+}
+
+func (suite *NotifierTestSuite) TestPublishByTopic() {
+	steps := suite.notifier.Subscribe(4, EVENT_MODELING_STEP, EVENT_GPU_IDLE)
+	devices := suite.notifier.Subscribe(4, EVENT_DEVICE_ONLINE)
+
+	suite.notifier.Publish(EVENT_MODELING_STEP, 7)
+	suite.notifier.Publish(EVENT_GPU_IDLE, 3)
+	suite.notifier.Publish(EVENT_TASK_CREATED, 1)
+
+	suite.Equal(Event{Topic: EVENT_MODELING_STEP, ID: 7}, <-steps)
+	suite.Equal(Event{Topic: EVENT_GPU_IDLE, ID: 3}, <-steps)
+	suite.Len(steps, 0)
+	suite.Len(devices, 0)
+}
+
+// 구독자의 버퍼가 가득 차면 Publish는 block 되지 않고 이벤트를 버린다.
+func (suite *NotifierTestSuite) TestPublishDropsWhenFull() {
+	ch := suite.notifier.Subscribe(1, EVENT_MODELING_STEP)
+
+	suite.notifier.Publish(EVENT_MODELING_STEP, 1)
+	suite.notifier.Publish(EVENT_MODELING_STEP, 2)
+
+	suite.Equal(1, (<-ch).ID)
+	suite.Len(ch, 0)
+}
+
+func (suite *NotifierTestSuite) TestUnsubscribe() {
+	kept := suite.notifier.Subscribe(4, EVENT_MODELING_STEP)
+	removed := suite.notifier.Subscribe(4, EVENT_MODELING_STEP, EVENT_GPU_IDLE)
+
+	suite.notifier.Unsubscribe(removed)
+	suite.notifier.Publish(EVENT_MODELING_STEP, 1)
+	suite.notifier.Publish(EVENT_GPU_IDLE, 2)
+
+	suite.Len(kept, 1)
+	suite.Len(removed, 0)
+}
+
+func TestNotifierTestSuite(t *testing.T) {
+	suite.Run(t, new(NotifierTestSuite))
+}
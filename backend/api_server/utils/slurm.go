@@ -6,6 +6,7 @@ import (
 	"io"
 	"os/exec"
 	"regexp"
+	"strings"
 
 	"api_server/logger"
 )
@@ -22,10 +23,23 @@ func NewSlurm() *Slurm {
 	return slurm
 }
 
+const (
+	SLURM_STATE_PENDING   = "PENDING"
+	SLURM_STATE_RUNNING   = "RUNNING"
+	SLURM_STATE_COMPLETED = "COMPLETED"
+	SLURM_STATE_CANCELLED = "CANCELLED"
+	SLURM_STATE_FAILED    = "FAILED"
+)
+
 func (slurm *Slurm) Sbatch(scriptPath string, scriptArgs []string) (string, error) {
+	return slurm.SbatchWithOptions(nil, scriptPath, scriptArgs)
+}
+
+// SbatchWithOptions는 sbatch 옵션(--gres, --partition 등)을 script 앞에 붙여 job을 제출합니다.
+func (slurm *Slurm) SbatchWithOptions(options []string, scriptPath string, scriptArgs []string) (string, error) {
 	var out bytes.Buffer
 
-	sbatchArgs := append([]string{scriptPath}, scriptArgs...)
+	sbatchArgs := append(append(append([]string{}, options...), scriptPath), scriptArgs...)
 	cmd := exec.Command("sbatch", sbatchArgs...)
 	cmd.Stdout = &out
 	err := cmd.Run()
@@ -95,3 +109,43 @@ func (slurm *Slurm) IsTerminatedJob(jobID string) bool {
 		return false
 	}
 }
+
+// JobState는 job의 slurm 상태(PENDING, RUNNING, COMPLETED ...)를 반환합니다.
+// squeue에서 사라진 job은 sacct로 최종 상태를 조회하며, 이마저 알 수 없으면 빈 문자열을 반환합니다.
+func (slurm *Slurm) JobState(jobID string) (string, error) {
+	var out bytes.Buffer
+
+	cmd := exec.Command("squeue", "-h", "-j", jobID, "-o", "%T")
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		logger.Debug(err)
+	}
+	if state := firstLine(out.String()); state != "" {
+		return state, nil
+	}
+
+	out.Reset()
+	cmd = exec.Command("sacct", "-n", "-X", "-P", "-j", jobID, "-o", "State")
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return "", err
+	}
+
+	// "CANCELLED by 1000" 과 같은 형식도 있으므로 첫 단어만 사용한다.
+	fields := strings.Fields(firstLine(out.String()))
+	if len(fields) < 1 {
+		return "", nil
+	}
+
+	return fields[0], nil
+}
+
+func firstLine(output string) string {
+	for _, line := range strings.Split(output, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+
+	return ""
+}
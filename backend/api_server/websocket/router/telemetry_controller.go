@@ -0,0 +1,65 @@
+package router
+
+import (
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	device_repo "api_server/device/repository"
+	device_service "api_server/device/service"
+	"api_server/logger"
+	"api_server/utils"
+	"api_server/websocket/dto"
+)
+
+// HandleTelemetrySocket는 연결 직후와 GPU telemetry가 수집될 때마다 GPU별 최신 값을 전송합니다.
+// 클라이언트가 보내는 메시지는 연결 종료 확인에만 사용합니다.
+func HandleTelemetrySocket(c *gin.Context) {
+	ws, err := utils.WSUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		r := logger.CreateReport(&logger.CODE_REQUEST, err)
+		logger.ApiResponse(c, r, nil)
+		return
+	}
+	defer ws.Close()
+
+	notifier := utils.NewNotifier()
+	events := notifier.Subscribe(16, utils.EVENT_GPU_TELEMETRY)
+	defer notifier.Unsubscribe(events)
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := ws.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	svc := device_service.NewTelemetryService(device_repo.NewTelemetryDAO(), device_repo.NewGPUDAO())
+	if err := writeTelemetry(ws, svc); err != nil {
+		return
+	}
+	for {
+		select {
+		case <-closed:
+			return
+		case <-events:
+			if err := writeTelemetry(ws, svc); err != nil {
+				logger.Debug(err)
+				return
+			}
+		}
+	}
+}
+
+func writeTelemetry(ws *websocket.Conn, svc device_service.ITelemetryService) error {
+	message, _ := json.Marshal(dto.WebSocketDTO{
+		MessageType: "GPU_TELEMETRY",
+		Data:        map[string]interface{}{"gpus": svc.ReadLatest()},
+	})
+
+	return ws.WriteMessage(websocket.TextMessage, message)
+}
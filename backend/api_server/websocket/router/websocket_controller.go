@@ -9,9 +9,12 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 
+	dataset_repo "api_server/dataset/repository"
+	device_repo "api_server/device/repository"
 	"api_server/logger"
 	project_repo "api_server/project/repository"
 	task_repo "api_server/task/repository"
+	task_service "api_server/task/service"
 	"api_server/utils"
 	"api_server/websocket/dto"
 )
@@ -71,6 +74,11 @@ func HandleWebSocketMessage(c *gin.Context) {
 		case "ENGINE_LOG":
 			logger.Debug(webSocketDTO.Data)
 			getEngineLog(ws, data, enginelog_dao)
+		case "GET_QUEUE":
+			getQueue(ws)
+		case "GET_LINEAGE":
+			logger.Debug(webSocketDTO.Data)
+			getLineage(ws, data)
 		}
 	}
 }
@@ -108,6 +116,40 @@ func getProject(ctx context.Context, ws *websocket.Conn) { //, data map[string]i
 	//}
 }
 
+// getQueue는 대기 중인 modeling의 순번, 대기 사유, 예상 시작 시각을 전송합니다.
+func getQueue(ws *websocket.Conn) {
+	queue, err := task_service.NewTaskScheduler().Queue()
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	message, _ := json.Marshal(dto.WebSocketDTO{
+		MessageType: "GET_QUEUE",
+		Data:        map[string]interface{}{"queue": queue},
+	})
+
+	_ = ws.WriteMessage(websocket.TextMessage, message)
+}
+
+// getLineage는 modeling의 조상과 자손 트리를 전송합니다. UI가 update로 model이 바뀐 과정을 그릴 때 사용합니다.
+func getLineage(ws *websocket.Conn, data map[string]interface{}) {
+	if modeling_id, ok := data["modeling_id"].(float64); ok {
+		svc := task_service.NewModelingService(task_repo.NewModelingDAO(), task_repo.NewModelingDetailDAO(), dataset_repo.NewDatasetDAO(), device_repo.New())
+		lineage, report := svc.ReadLineage(int(modeling_id))
+		if report != nil {
+			return
+		}
+
+		message, _ := json.Marshal(dto.WebSocketDTO{
+			MessageType: strings.Join([]string{"GET_LINEAGE_", strconv.Itoa(int(modeling_id))}, ""),
+			Data:        map[string]interface{}{"lineage": lineage},
+		})
+
+		_ = ws.WriteMessage(websocket.TextMessage, message)
+	}
+}
+
 func getTask(ctx context.Context, ws *websocket.Conn, data map[string]interface{}) {
 	id := int(data["id"].(float64))
 	dao_task := task_repo.NewTaskDAO()
@@ -10,5 +10,6 @@ func InitWebSocketRouter(r *gin.Engine) {
 	wsRouter := r.Group(utils.WS_BASE_URL_v1)
 	{
 		wsRouter.GET("", HandleWebSocketMessage)
+		wsRouter.GET("/telemetry", HandleTelemetrySocket)
 	}
 }